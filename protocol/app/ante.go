@@ -21,13 +21,14 @@ import (
 )
 
 // HandlerOptions are the options required for constructing an SDK AnteHandler.
-// Note: This struct is defined here in order to add `ClobKeeper`. We use
+// Note: This struct is defined here in order to add `ClobKeeper` and `AccountPlusKeeper`. We use
 // struct embedding to include the normal cosmos-sdk `HandlerOptions`.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	Codec        codec.Codec
-	AuthStoreKey storetypes.StoreKey
-	ClobKeeper   clobtypes.ClobKeeper
+	Codec             codec.Codec
+	AuthStoreKey      storetypes.StoreKey
+	ClobKeeper        clobtypes.ClobKeeper
+	AccountPlusKeeper customante.AuthenticatorKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -71,6 +72,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "clob keeper is required for ante builder")
 	}
 
+	if options.AccountPlusKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "accountplus keeper is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
@@ -94,8 +99,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		validateBasic:            ante.NewValidateBasicDecorator(),
 		validateSigCount:         ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		incrementSequence:        ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		sigVerification:          customante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
-		consumeTxSizeGas:         ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		sigVerification: customante.NewSigVerificationDecorator(
+			options.AccountKeeper,
+			options.AccountPlusKeeper,
+			options.SignModeHandler,
+		),
+		consumeTxSizeGas: ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		deductFee: ante.NewDeductFeeDecorator(
 			options.AccountKeeper,
 			options.BankKeeper,
@@ -207,8 +216,13 @@ func (h *lockingAnteHandler) clobAnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	if ctx, err = h.consumeTxSizeGas.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
 		return ctx, err
 	}
-	if ctx, err = h.setPubKey.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
-		return ctx, err
+	// Transactions signed by an authenticator are not signed by the account's key, so the account's pub key
+	// must not be set from the transaction. The signature is verified against the authenticator's pub key
+	// by the sig verification decorator.
+	if _, usesAuthenticator := customante.GetAuthenticatorTxExtension(tx); !usesAuthenticator {
+		if ctx, err = h.setPubKey.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
+			return ctx, err
+		}
 	}
 	if ctx, err = h.validateSigCount.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
 		return ctx, err
@@ -365,8 +379,13 @@ func (h *lockingAnteHandler) otherMsgAnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	if ctx, err = h.deductFee.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
 		return ctx, err
 	}
-	if ctx, err = h.setPubKey.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
-		return ctx, err
+	// Transactions signed by an authenticator are not signed by the account's key, so the account's pub key
+	// must not be set from the transaction. The signature is verified against the authenticator's pub key
+	// by the sig verification decorator.
+	if _, usesAuthenticator := customante.GetAuthenticatorTxExtension(tx); !usesAuthenticator {
+		if ctx, err = h.setPubKey.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
+			return ctx, err
+		}
 	}
 	if ctx, err = h.validateSigCount.AnteHandle(ctx, tx, simulate, noOpAnteHandle); err != nil {
 		return ctx, err
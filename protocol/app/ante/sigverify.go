@@ -6,32 +6,46 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	txsigning "cosmossdk.io/x/tx/signing"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	accountplustypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	gometrics "github.com/hashicorp/go-metrics"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+// AuthenticatorKeeper defines the expected keeper used to look up the authenticators registered by an account.
+type AuthenticatorKeeper interface {
+	GetAuthenticator(ctx sdk.Context, owner string, id uint64) (accountplustypes.Authenticator, bool)
+}
+
 // SigVerificationDecorator verifies all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
 //
-// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+// If the tx selects an authenticator through its `TxExtension`, the signature is verified against the
+// authenticator's public key instead of the account's public key, and the tx messages must be permitted
+// by the authenticator. Authenticators are only supported for txs with a single signer.
+//
+// CONTRACT: Pubkeys are set in context for all signers not using an authenticator before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              sdkante.AccountKeeper
+	apk             AuthenticatorKeeper
 	signModeHandler *txsigning.HandlerMap
 }
 
 func NewSigVerificationDecorator(
 	ak sdkante.AccountKeeper,
+	apk AuthenticatorKeeper,
 	signModeHandler *txsigning.HandlerMap,
 ) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		ak:              ak,
+		apk:             apk,
 		signModeHandler: signModeHandler,
 	}
 }
@@ -70,6 +84,15 @@ func (svd SigVerificationDecorator) AnteHandle(
 		return ctx, err
 	}
 
+	authenticatorExtension, usesAuthenticator := GetAuthenticatorTxExtension(tx)
+	if usesAuthenticator && len(signers) != 1 {
+		return ctx, errorsmod.Wrapf(
+			accountplustypes.ErrMultipleSignersNotSupported,
+			"got %d signers",
+			len(signers),
+		)
+	}
+
 	// Sequence number validation can be skipped if the given transaction consists of
 	// only messages that use `GoodTilBlock` for replay protection.
 	skipSequenceValidation := ShouldSkipSequenceValidation(tx.GetMsgs())
@@ -81,9 +104,17 @@ func (svd SigVerificationDecorator) AnteHandle(
 		}
 
 		// retrieve pubkey
-		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
-			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		var pubKey cryptotypes.PubKey
+		if usesAuthenticator {
+			pubKey, err = svd.getAuthenticatorPubKey(ctx, acc, authenticatorExtension.AuthenticatorId, tx.GetMsgs())
+			if err != nil {
+				return ctx, err
+			}
+		} else {
+			pubKey = acc.GetPubKey()
+			if !simulate && pubKey == nil {
+				return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+			}
 		}
 
 		// Check account sequence number.
@@ -163,3 +194,26 @@ func (svd SigVerificationDecorator) AnteHandle(
 
 	return next(ctx, tx, simulate)
 }
+
+// getAuthenticatorPubKey returns the public key of the account's authenticator with the given id. Returns an
+// error if the authenticator does not exist, has expired, or does not permit all of the messages.
+func (svd SigVerificationDecorator) getAuthenticatorPubKey(
+	ctx sdk.Context,
+	acc sdk.AccountI,
+	authenticatorId uint64,
+	msgs []sdk.Msg,
+) (cryptotypes.PubKey, error) {
+	authenticator, found := svd.apk.GetAuthenticator(ctx, acc.GetAddress().String(), authenticatorId)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			accountplustypes.ErrAuthenticatorNotFound,
+			"owner: %s, id: %d",
+			acc.GetAddress().String(),
+			authenticatorId,
+		)
+	}
+	if err := authenticator.AuthorizeMsgs(msgs, ctx.BlockTime()); err != nil {
+		return nil, err
+	}
+	return authenticator.GetPubKey(), nil
+}
@@ -3,13 +3,16 @@ package ante_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -19,6 +22,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	customante "github.com/dydxprotocol/v4-chain/protocol/app/ante"
 	testante "github.com/dydxprotocol/v4-chain/protocol/testutil/ante"
+	accountplustypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/golang/mock/gomock"
@@ -85,7 +89,11 @@ func TestSigVerification(t *testing.T) {
 		txConfigOpts,
 	)
 	require.NoError(t, err)
-	svd := customante.NewSigVerificationDecorator(suite.AccountKeeper, anteTxConfig.SignModeHandler())
+	svd := customante.NewSigVerificationDecorator(
+		suite.AccountKeeper,
+		fakeAuthenticatorKeeper{},
+		anteTxConfig.SignModeHandler(),
+	)
 	antehandler := sdk.ChainAnteDecorators(spkd, svd)
 	defaultSignMode, err := authsign.APISignModeToInternal(anteTxConfig.SignModeHandler().DefaultMode())
 	require.NoError(t, err)
@@ -321,7 +329,11 @@ func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...crypto
 
 	spkd := sdkante.NewSetPubKeyDecorator(suite.AccountKeeper)
 	svgc := sdkante.NewSigGasConsumeDecorator(suite.AccountKeeper, sdkante.DefaultSigVerificationGasConsumer)
-	svd := customante.NewSigVerificationDecorator(suite.AccountKeeper, suite.ClientCtx.TxConfig.SignModeHandler())
+	svd := customante.NewSigVerificationDecorator(
+		suite.AccountKeeper,
+		fakeAuthenticatorKeeper{},
+		suite.ClientCtx.TxConfig.SignModeHandler(),
+	)
 	antehandler := sdk.ChainAnteDecorators(spkd, svgc, svd)
 
 	txBytes, err := suite.ClientCtx.TxConfig.TxEncoder()(tx)
@@ -336,6 +348,185 @@ func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...crypto
 	return after - before, err
 }
 
+func TestSigVerification_Authenticator(t *testing.T) {
+	suite := testante.SetupTestSuite(t, true)
+	suite.Ctx = suite.Ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+
+	ownerPriv, _, owner := testdata.KeyTestPubAddr()
+	authenticatorPriv, _, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.AccountKeeper.NewAccountWithAddress(suite.Ctx, owner)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	require.NoError(t, acc.SetPubKey(ownerPriv.PubKey()))
+	require.NoError(t, acc.SetSequence(5))
+	suite.AccountKeeper.SetAccount(suite.Ctx, acc)
+
+	authenticatorKeeper := fakeAuthenticatorKeeper{
+		owner.String(): {
+			{
+				Id:                0,
+				PublicKey:         authenticatorPriv.PubKey().Bytes(),
+				MsgTypeUrls:       []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+				ClobPairIds:       []uint32{0},
+				SubaccountNumbers: []uint32{0},
+			},
+			{
+				Id:               1,
+				PublicKey:        authenticatorPriv.PubKey().Bytes(),
+				MsgTypeUrls:      []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+				GoodTilBlockTime: 999,
+			},
+		},
+	}
+	svd := customante.NewSigVerificationDecorator(
+		suite.AccountKeeper,
+		authenticatorKeeper,
+		suite.ClientCtx.TxConfig.SignModeHandler(),
+	)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	statefulPlaceOrder := newPlaceOrderMessageForAddr(owner).(*clobtypes.MsgPlaceOrder)
+	statefulPlaceOrder.Order.OrderId.OrderFlags = clobtypes.OrderIdFlags_LongTerm
+	otherClobPairPlaceOrder := newPlaceOrderMessageForAddr(owner).(*clobtypes.MsgPlaceOrder)
+	otherClobPairPlaceOrder.Order.OrderId.ClobPairId = 1
+	otherSubaccountPlaceOrder := newPlaceOrderMessageForAddr(owner).(*clobtypes.MsgPlaceOrder)
+	otherSubaccountPlaceOrder.Order.OrderId.SubaccountId.Number = 1
+
+	tests := map[string]struct {
+		msg              sdk.Msg
+		priv             cryptotypes.PrivKey
+		useAuthenticator bool
+		authenticatorId  uint64
+		seq              uint64
+		expectedErr      error
+	}{
+		"short-term order signed by authenticator": {
+			msg:              newPlaceOrderMessageForAddr(owner),
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			seq:              0,
+		},
+		"stateful order signed by authenticator": {
+			msg:              statefulPlaceOrder,
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			seq:              5,
+		},
+		"stateful order signed by authenticator with wrong sequence": {
+			msg:              statefulPlaceOrder,
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			seq:              4,
+			expectedErr:      sdkerrors.ErrWrongSequence,
+		},
+		"order signed by account key": {
+			msg:  newPlaceOrderMessageForAddr(owner),
+			priv: ownerPriv,
+		},
+		"order signed by account key with authenticator selected": {
+			msg:              newPlaceOrderMessageForAddr(owner),
+			priv:             ownerPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			expectedErr:      sdkerrors.ErrUnauthorized,
+		},
+		"order signed by other key": {
+			msg:              newPlaceOrderMessageForAddr(owner),
+			priv:             otherPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			expectedErr:      sdkerrors.ErrUnauthorized,
+		},
+		"authenticator not found": {
+			msg:              newPlaceOrderMessageForAddr(owner),
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  2,
+			expectedErr:      accountplustypes.ErrAuthenticatorNotFound,
+		},
+		"authenticator expired": {
+			msg:              newPlaceOrderMessageForAddr(owner),
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  1,
+			expectedErr:      accountplustypes.ErrAuthenticatorExpired,
+		},
+		"msg type not permitted": {
+			msg: &clobtypes.MsgCancelOrder{
+				OrderId: clobtypes.OrderId{SubaccountId: satypes.SubaccountId{Owner: owner.String()}},
+			},
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			expectedErr:      accountplustypes.ErrMsgNotAuthorized,
+		},
+		"clob pair not permitted": {
+			msg:              otherClobPairPlaceOrder,
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			expectedErr:      accountplustypes.ErrMsgNotAuthorized,
+		},
+		"subaccount not permitted": {
+			msg:              otherSubaccountPlaceOrder,
+			priv:             authenticatorPriv,
+			useAuthenticator: true,
+			authenticatorId:  0,
+			expectedErr:      accountplustypes.ErrMsgNotAuthorized,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			suite.TxBuilder = suite.ClientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.TxBuilder.SetMsgs(tc.msg))
+			if tc.useAuthenticator {
+				extension, err := codectypes.NewAnyWithValue(
+					&accountplustypes.TxExtension{AuthenticatorId: tc.authenticatorId},
+				)
+				require.NoError(t, err)
+				suite.TxBuilder.(authtx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(extension)
+			}
+
+			tx, err := suite.CreateTestTx(
+				suite.Ctx,
+				[]cryptotypes.PrivKey{tc.priv},
+				[]uint64{acc.GetAccountNumber()},
+				[]uint64{tc.seq},
+				suite.Ctx.ChainID(),
+				signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.Ctx, tx, false)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type fakeAuthenticatorKeeper map[string][]accountplustypes.Authenticator
+
+func (k fakeAuthenticatorKeeper) GetAuthenticator(
+	ctx sdk.Context,
+	owner string,
+	id uint64,
+) (accountplustypes.Authenticator, bool) {
+	for _, authenticator := range k[owner] {
+		if authenticator.Id == id {
+			return authenticator, true
+		}
+	}
+	return accountplustypes.Authenticator{}, false
+}
+
 func newPlaceOrderMessageForAddr(addr sdk.AccAddress) sdk.Msg {
 	return &clobtypes.MsgPlaceOrder{
 		Order: clobtypes.Order{
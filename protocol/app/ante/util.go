@@ -2,6 +2,8 @@ package ante
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	accountplustypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 )
 
@@ -34,6 +36,10 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 			}
 			// This is a `GoodTilBlock` message, continue to check the next message.
 			continue
+		case
+			*clobtypes.MsgBatchPlaceOrder:
+			// All orders in a batch are Short-Term orders, continue to check the next message.
+			continue
 		default:
 			// Early return for messages that require sequence number validation.
 			return false
@@ -42,3 +48,18 @@ func ShouldSkipSequenceValidation(msgs []sdk.Msg) (shouldSkipValidation bool) {
 	// All messages use `GoodTilBlock`.
 	return true
 }
+
+// GetAuthenticatorTxExtension returns the tx extension option selecting the authenticator that signed
+// the transaction. The second return value is false if the transaction is signed by the signer's account key.
+func GetAuthenticatorTxExtension(tx sdk.Tx) (*accountplustypes.TxExtension, bool) {
+	extensionOptionsTx, ok := tx.(sdkante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, false
+	}
+	for _, option := range extensionOptionsTx.GetNonCriticalExtensionOptions() {
+		if extension, ok := option.GetCachedValue().(*accountplustypes.TxExtension); ok {
+			return extension, true
+		}
+	}
+	return nil, false
+}
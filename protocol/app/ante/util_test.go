@@ -28,6 +28,12 @@ func TestSkipSequenceValidation(t *testing.T) {
 			},
 			shouldSkipValidation: true,
 		},
+		"single batch place order message": {
+			msgs: []sdk.Msg{
+				constants.Msg_BatchPlaceOrder,
+			},
+			shouldSkipValidation: true,
+		},
 		"single transfer message": {
 			msgs: []sdk.Msg{
 				constants.Msg_Transfer,
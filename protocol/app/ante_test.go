@@ -22,9 +22,10 @@ func newHandlerOptions() app.HandlerOptions {
 			FeegrantKeeper:  dydxApp.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
-		ClobKeeper:   dydxApp.ClobKeeper,
-		Codec:        encodingConfig.Codec,
-		AuthStoreKey: dydxApp.CommitMultiStore().(*rootmulti.Store).StoreKeysByName()[authtypes.StoreKey],
+		ClobKeeper:        dydxApp.ClobKeeper,
+		AccountPlusKeeper: &dydxApp.AccountPlusKeeper,
+		Codec:             encodingConfig.Codec,
+		AuthStoreKey:      dydxApp.CommitMultiStore().(*rootmulti.Store).StoreKeysByName()[authtypes.StoreKey],
 	}
 }
 
@@ -56,6 +57,10 @@ func TestNewAnteHandler_Error(t *testing.T) {
 			handlerMutation: func(options *app.HandlerOptions) { options.ClobKeeper = nil },
 			errorMsg:        "clob keeper is required for ante builder",
 		},
+		"nil AccountPlusKeeper": {
+			handlerMutation: func(options *app.HandlerOptions) { options.AccountPlusKeeper = nil },
+			errorMsg:        "accountplus keeper is required for ante builder",
+		},
 		"nil Codec": {
 			handlerMutation: func(options *app.HandlerOptions) { options.Codec = nil },
 			errorMsg:        "codec is required for ante builder",
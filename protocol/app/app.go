@@ -131,6 +131,9 @@ import (
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 
 	// Modules
+	accountplusmodule "github.com/dydxprotocol/v4-chain/protocol/x/accountplus"
+	accountplusmodulekeeper "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/keeper"
+	accountplusmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	assetsmodule "github.com/dydxprotocol/v4-chain/protocol/x/assets"
 	assetsmodulekeeper "github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	assetsmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
@@ -316,6 +319,8 @@ type App struct {
 	EpochsKeeper epochsmodulekeeper.Keeper
 
 	VaultKeeper vaultmodulekeeper.Keeper
+
+	AccountPlusKeeper accountplusmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	ModuleManager *module.Manager
@@ -425,6 +430,7 @@ func New(
 		epochsmoduletypes.StoreKey,
 		govplusmoduletypes.StoreKey,
 		vaultmoduletypes.StoreKey,
+		accountplusmoduletypes.StoreKey,
 	)
 	keys[authtypes.StoreKey] = keys[authtypes.StoreKey].WithLocking()
 	tkeys := storetypes.NewTransientStoreKeys(
@@ -434,6 +440,7 @@ func New(
 		rewardsmoduletypes.TransientStoreKey,
 		indexer_manager.TransientStoreKey,
 		perpetualsmoduletypes.TransientStoreKey,
+		streamingtypes.TransientStoreKey,
 	)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, clobmoduletypes.MemStoreKey)
 
@@ -718,7 +725,11 @@ func New(
 		indexerFlags.SendOffchainData,
 	)
 
-	app.GrpcStreamingManager = getGrpcStreamingManagerFromOptions(appFlags, logger)
+	app.GrpcStreamingManager = getGrpcStreamingManagerFromOptions(
+		appFlags,
+		logger,
+		tkeys[streamingtypes.TransientStoreKey],
+	)
 
 	timeProvider := &timelib.TimeProviderImpl{}
 
@@ -894,6 +905,11 @@ func New(
 		keys[assetsmoduletypes.StoreKey],
 		app.PricesKeeper,
 		app.IndexerEventManager,
+		// set the governance and delaymsg module accounts as the authority for conducting upgrades
+		[]string{
+			lib.GovModuleAddress.String(),
+			delaymsgmoduletypes.ModuleAddress.String(),
+		},
 	)
 	assetsModule := assetsmodule.NewAppModule(appCodec, app.AssetsKeeper)
 
@@ -1000,6 +1016,7 @@ func New(
 		app.PerpetualsKeeper,
 		app.BlockTimeKeeper,
 		app.IndexerEventManager,
+		app.GrpcStreamingManager,
 	)
 	subaccountsModule := subaccountsmodule.NewAppModule(
 		appCodec,
@@ -1095,6 +1112,12 @@ func New(
 	)
 	vaultModule := vaultmodule.NewAppModule(appCodec, app.VaultKeeper)
 
+	app.AccountPlusKeeper = *accountplusmodulekeeper.NewKeeper(
+		appCodec,
+		keys[accountplusmoduletypes.StoreKey],
+	)
+	accountPlusModule := accountplusmodule.NewAppModule(appCodec, app.AccountPlusKeeper)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -1163,6 +1186,7 @@ func New(
 		epochsModule,
 		rateLimitModule,
 		vaultModule,
+		accountPlusModule,
 	)
 
 	app.ModuleManager.SetOrderPreBlockers(
@@ -1210,6 +1234,7 @@ func New(
 		govplusmoduletypes.ModuleName,
 		delaymsgmoduletypes.ModuleName,
 		vaultmoduletypes.ModuleName,
+		accountplusmoduletypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderPrepareCheckStaters(
@@ -1250,6 +1275,7 @@ func New(
 		govplusmoduletypes.ModuleName,
 		delaymsgmoduletypes.ModuleName,
 		vaultmoduletypes.ModuleName,
+		accountplusmoduletypes.ModuleName,
 		authz.ModuleName,                // No-op.
 		blocktimemoduletypes.ModuleName, // Must be last
 	)
@@ -1294,6 +1320,7 @@ func New(
 		govplusmoduletypes.ModuleName,
 		delaymsgmoduletypes.ModuleName,
 		vaultmoduletypes.ModuleName,
+		accountplusmoduletypes.ModuleName,
 		authz.ModuleName,
 	)
 
@@ -1334,6 +1361,7 @@ func New(
 		govplusmoduletypes.ModuleName,
 		delaymsgmoduletypes.ModuleName,
 		vaultmoduletypes.ModuleName,
+		accountplusmoduletypes.ModuleName,
 		authz.ModuleName,
 
 		// Auth must be migrated after staking.
@@ -1670,6 +1698,8 @@ func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	}
 	block := app.IndexerEventManager.ProduceBlock(ctx)
 	app.IndexerEventManager.SendOnchainData(block)
+	// Fills and subaccount updates are streamed once all transactions and end blockers of the block have run.
+	app.GrpcStreamingManager.SendFinalizedBlockUpdates(ctx)
 	return response, err
 }
 
@@ -1818,9 +1848,10 @@ func (app *App) buildAnteHandler(txConfig client.TxConfig) sdk.AnteHandler {
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			ClobKeeper:   app.ClobKeeper,
-			Codec:        app.appCodec,
-			AuthStoreKey: app.keys[authtypes.StoreKey],
+			ClobKeeper:        app.ClobKeeper,
+			AccountPlusKeeper: &app.AccountPlusKeeper,
+			Codec:             app.appCodec,
+			AuthStoreKey:      app.keys[authtypes.StoreKey],
 		},
 	)
 	if err != nil {
@@ -1928,10 +1959,17 @@ func getIndexerFromOptions(
 func getGrpcStreamingManagerFromOptions(
 	appFlags flags.Flags,
 	logger log.Logger,
+	transientStoreKey storetypes.StoreKey,
 ) (manager streamingtypes.GrpcStreamingManager) {
 	if appFlags.GrpcStreamingEnabled {
 		logger.Info("GRPC streaming is enabled")
-		return streaming.NewGrpcStreamingManager()
+		return streaming.NewGrpcStreamingManager(
+			logger,
+			appFlags.GrpcStreamingBufferSize,
+			appFlags.GrpcStreamingDropPolicy == flags.GrpcStreamingDropPolicyResnapshot,
+			appFlags.GrpcStreamingReplayBufferSize,
+			transientStoreKey,
+		)
 	}
 	return streaming.NewNoopGrpcStreamingManager()
 }
@@ -22,6 +22,7 @@ import (
 	delaymsgmodule "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg"
 
 	custommodule "github.com/dydxprotocol/v4-chain/protocol/app/module"
+	accountplusmodule "github.com/dydxprotocol/v4-chain/protocol/x/accountplus"
 	assetsmodule "github.com/dydxprotocol/v4-chain/protocol/x/assets"
 	blocktimemodule "github.com/dydxprotocol/v4-chain/protocol/x/blocktime"
 	bridgemodule "github.com/dydxprotocol/v4-chain/protocol/x/bridge"
@@ -93,5 +94,6 @@ var (
 		ratelimitmodule.AppModuleBasic{},
 		govplusmodule.AppModuleBasic{},
 		vaultmodule.AppModuleBasic{},
+		accountplusmodule.AppModuleBasic{},
 	)
 )
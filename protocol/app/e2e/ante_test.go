@@ -5,19 +5,27 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/types"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/dydxprotocol/v4-chain/protocol/app/config"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	accountplustypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -269,3 +277,163 @@ func TestParallelAnteHandler_ClobAndOther(t *testing.T) {
 		)
 	}
 }
+
+func TestAnteHandler_AuthenticatorSignedMsgs(t *testing.T) {
+	authenticatorPrivKey := secp256k1.GenPrivKey()
+	shortTermOrder := testapp.MustScaleOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20, Clob_0)
+	longTermOrder := testapp.MustScaleOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5, Clob_0)
+	// The test chain starts at the unix epoch.
+	longTermOrder.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{
+		GoodTilBlockTime: lib.MustConvertIntegerToUint32(int64(time.Hour.Seconds())),
+	}
+	replacementOrder := longTermOrder
+	replacementOrder.Quantums *= 2
+
+	tests := map[string]struct {
+		// Orders placed by the account's key before the message is sent.
+		placedOrders []clobtypes.Order
+		msg          sdktypes.Msg
+	}{
+		"MsgPlaceOrder": {
+			msg: clobtypes.NewMsgPlaceOrder(shortTermOrder),
+		},
+		"MsgCancelOrder": {
+			msg: clobtypes.NewMsgCancelOrderShortTerm(shortTermOrder.OrderId, 20),
+		},
+		"MsgReplaceOrder": {
+			placedOrders: []clobtypes.Order{longTermOrder},
+			msg:          &clobtypes.MsgReplaceOrder{Order: replacementOrder},
+		},
+		"MsgBatchCancel": {
+			msg: &clobtypes.MsgBatchCancel{
+				SubaccountId: constants.Alice_Num0,
+				ShortTermCancels: []clobtypes.OrderBatch{
+					{ClobPairId: 0, ClientIds: []uint32{0}},
+				},
+				GoodTilBlock: 20,
+			},
+		},
+		"MsgBatchPlaceOrder": {
+			msg: &clobtypes.MsgBatchPlaceOrder{
+				SubaccountId:    constants.Alice_Num0,
+				ShortTermOrders: []clobtypes.Order{shortTermOrder},
+			},
+		},
+		"MsgCancelAllOrders": {
+			msg: &clobtypes.MsgCancelAllOrders{
+				SubaccountId: constants.Alice_Num0,
+				ClobPairIds:  []uint32{0},
+			},
+		},
+		"MsgHeartbeat": {
+			msg: &clobtypes.MsgHeartbeat{
+				SubaccountId:   constants.Alice_Num0,
+				TimeoutSeconds: 60,
+			},
+		},
+		"MsgSetMarketMakerProtectionConfig": {
+			msg: &clobtypes.MsgSetMarketMakerProtectionConfig{
+				SubaccountId: constants.Alice_Num0,
+				ClobPairId:   0,
+				Config: clobtypes.MarketMakerProtectionConfig{
+					WindowBlocks:      10,
+					FreezeBlocks:      10,
+					MaxFilledQuantums: 1_000,
+				},
+			},
+		},
+		"MsgResetMarketMakerProtection": {
+			msg: &clobtypes.MsgResetMarketMakerProtection{
+				SubaccountId: constants.Alice_Num0,
+				ClobPairId:   0,
+			},
+		},
+	}
+
+	// Every message type an authenticator may be permitted to sign is covered.
+	require.Len(t, tests, len(accountplustypes.AllowedMsgTypeUrls))
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Contains(t, accountplustypes.AllowedMsgTypeUrls, sdktypes.MsgTypeURL(tc.msg))
+
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *accountplustypes.GenesisState) {
+						genesisState.Accounts = []accountplustypes.AccountAuthenticators{
+							{
+								Owner: constants.AliceAccAddress.String(),
+								Authenticators: []accountplustypes.Authenticator{
+									{
+										Id:                0,
+										PublicKey:         authenticatorPrivKey.PubKey().Bytes(),
+										MsgTypeUrls:       []string{sdktypes.MsgTypeURL(tc.msg)},
+										SubaccountNumbers: []uint32{0},
+									},
+								},
+							},
+						}
+						genesisState.NextAuthenticatorId = 1
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			for _, order := range tc.placedOrders {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*clobtypes.NewMsgPlaceOrder(order),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected response to be ok: %+v", resp)
+				}
+			}
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+			account := tApp.App.AccountKeeper.GetAccount(ctx, constants.AliceAccAddress)
+			txBuilder := tApp.App.TxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			extension, err := codectypes.NewAnyWithValue(&accountplustypes.TxExtension{AuthenticatorId: 0})
+			require.NoError(t, err)
+			txBuilder.(authtx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(extension)
+			txBuilder.SetFeeAmount(constants.TestFeeCoins_5Cents)
+			txBuilder.SetGasLimit(1_000_000)
+
+			// Sign the transaction with the authenticator's key instead of the account's key.
+			signMode := signing.SignMode_SIGN_MODE_DIRECT
+			require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   authenticatorPrivKey.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signMode},
+				Sequence: account.GetSequence(),
+			}))
+			signature, err := clienttx.SignWithPrivKey(
+				ctx,
+				signMode,
+				authsigning.SignerData{
+					Address:       constants.AliceAccAddress.String(),
+					ChainID:       ctx.ChainID(),
+					AccountNumber: account.GetAccountNumber(),
+					Sequence:      account.GetSequence(),
+					PubKey:        authenticatorPrivKey.PubKey(),
+				},
+				txBuilder,
+				authenticatorPrivKey,
+				tApp.App.TxConfig(),
+				account.GetSequence(),
+			)
+			require.NoError(t, err)
+			require.NoError(t, txBuilder.SetSignatures(signature))
+
+			bytes, err := tApp.App.TxConfig().TxEncoder()(txBuilder.GetTx())
+			require.NoError(t, err)
+			resp := tApp.CheckTx(abcitypes.RequestCheckTx{
+				Tx:   bytes,
+				Type: abcitypes.CheckTxType_New,
+			})
+			require.Conditionf(t, resp.IsOK, "Expected response to be ok: %+v", resp)
+		})
+	}
+}
@@ -21,8 +21,11 @@ type Flags struct {
 	GrpcEnable  bool
 
 	// Grpc Streaming
-	GrpcStreamingEnabled bool
-	VEOracleEnabled      bool // Slinky Vote Extensions
+	GrpcStreamingEnabled          bool
+	GrpcStreamingBufferSize       uint32
+	GrpcStreamingDropPolicy       string
+	GrpcStreamingReplayBufferSize uint32
+	VEOracleEnabled               bool // Slinky Vote Extensions
 }
 
 // List of CLI flags.
@@ -37,7 +40,10 @@ const (
 	GrpcEnable  = "grpc.enable"
 
 	// Grpc Streaming
-	GrpcStreamingEnabled = "grpc-streaming-enabled"
+	GrpcStreamingEnabled          = "grpc-streaming-enabled"
+	GrpcStreamingBufferSize       = "grpc-streaming-buffer-size"
+	GrpcStreamingDropPolicy       = "grpc-streaming-drop-policy"
+	GrpcStreamingReplayBufferSize = "grpc-streaming-replay-buffer-size"
 
 	// Slinky VEs enabled
 	VEOracleEnabled = "slinky-vote-extension-oracle-enabled"
@@ -50,8 +56,19 @@ const (
 	DefaultNonValidatingFullNode = false
 	DefaultDdErrorTrackingFormat = false
 
-	DefaultGrpcStreamingEnabled = false
-	DefaultVEOracleEnabled      = true
+	DefaultGrpcStreamingEnabled          = false
+	DefaultGrpcStreamingBufferSize       = 1000
+	DefaultGrpcStreamingDropPolicy       = GrpcStreamingDropPolicyDisconnect
+	DefaultGrpcStreamingReplayBufferSize = 100
+	DefaultVEOracleEnabled               = true
+)
+
+// Drop policies applied to a gRPC streaming subscription whose buffer is full.
+const (
+	// Disconnect the subscription.
+	GrpcStreamingDropPolicyDisconnect = "disconnect"
+	// Discard the buffered updates and resend snapshots to the subscription.
+	GrpcStreamingDropPolicyResnapshot = "resnapshot"
 )
 
 // AddFlagsToCmd adds flags to app initialization.
@@ -85,6 +102,26 @@ func AddFlagsToCmd(cmd *cobra.Command) {
 		DefaultGrpcStreamingEnabled,
 		"Whether to enable grpc streaming for full nodes",
 	)
+	cmd.Flags().Uint32(
+		GrpcStreamingBufferSize,
+		DefaultGrpcStreamingBufferSize,
+		"Maximum number of updates buffered for each grpc streaming subscription",
+	)
+	cmd.Flags().String(
+		GrpcStreamingDropPolicy,
+		DefaultGrpcStreamingDropPolicy,
+		fmt.Sprintf(
+			"Policy applied to a grpc streaming subscription whose buffer is full. One of %s, %s",
+			GrpcStreamingDropPolicyDisconnect,
+			GrpcStreamingDropPolicyResnapshot,
+		),
+	)
+	cmd.Flags().Uint32(
+		GrpcStreamingReplayBufferSize,
+		DefaultGrpcStreamingReplayBufferSize,
+		"Number of responses retained for each grpc stream to replay missed updates to resumed streams. "+
+			"Resuming streams is disabled if zero",
+	)
 	cmd.Flags().Bool(
 		VEOracleEnabled,
 		DefaultVEOracleEnabled,
@@ -104,6 +141,13 @@ func (f *Flags) Validate() error {
 		if !f.GrpcEnable {
 			return fmt.Errorf("grpc.enable must be set to true - grpc streaming requires gRPC server")
 		}
+		if f.GrpcStreamingBufferSize == 0 {
+			return fmt.Errorf("grpc streaming buffer size must be positive")
+		}
+		if f.GrpcStreamingDropPolicy != GrpcStreamingDropPolicyDisconnect &&
+			f.GrpcStreamingDropPolicy != GrpcStreamingDropPolicyResnapshot {
+			return fmt.Errorf("invalid grpc streaming drop policy: %s", f.GrpcStreamingDropPolicy)
+		}
 	}
 	return nil
 }
@@ -124,8 +168,11 @@ func GetFlagValuesFromOptions(
 		GrpcAddress: config.DefaultGRPCAddress,
 		GrpcEnable:  true,
 
-		GrpcStreamingEnabled: DefaultGrpcStreamingEnabled,
-		VEOracleEnabled:      true,
+		GrpcStreamingEnabled:          DefaultGrpcStreamingEnabled,
+		GrpcStreamingBufferSize:       DefaultGrpcStreamingBufferSize,
+		GrpcStreamingDropPolicy:       DefaultGrpcStreamingDropPolicy,
+		GrpcStreamingReplayBufferSize: DefaultGrpcStreamingReplayBufferSize,
+		VEOracleEnabled:               true,
 	}
 
 	// Populate the flags if they exist.
@@ -171,6 +218,24 @@ func GetFlagValuesFromOptions(
 		}
 	}
 
+	if option := appOpts.Get(GrpcStreamingBufferSize); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.GrpcStreamingBufferSize = v
+		}
+	}
+
+	if option := appOpts.Get(GrpcStreamingDropPolicy); option != nil {
+		if v, err := cast.ToStringE(option); err == nil && len(v) > 0 {
+			result.GrpcStreamingDropPolicy = v
+		}
+	}
+
+	if option := appOpts.Get(GrpcStreamingReplayBufferSize); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.GrpcStreamingReplayBufferSize = v
+		}
+	}
+
 	if option := appOpts.Get(VEOracleEnabled); option != nil {
 		if v, err := cast.ToBoolE(option); err == nil {
 			result.VEOracleEnabled = v
@@ -32,6 +32,15 @@ func TestAddFlagsToCommand(t *testing.T) {
 		fmt.Sprintf("Has %s flag", flags.GrpcStreamingEnabled): {
 			flagName: flags.GrpcStreamingEnabled,
 		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingBufferSize): {
+			flagName: flags.GrpcStreamingBufferSize,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingDropPolicy): {
+			flagName: flags.GrpcStreamingDropPolicy,
+		},
+		fmt.Sprintf("Has %s flag", flags.GrpcStreamingReplayBufferSize): {
+			flagName: flags.GrpcStreamingReplayBufferSize,
+		},
 	}
 
 	for name, tc := range tests {
@@ -63,9 +72,20 @@ func TestValidate(t *testing.T) {
 		},
 		"success - gRPC streaming enabled for validating nodes": {
 			flags: flags.Flags{
-				NonValidatingFullNode: false,
-				GrpcEnable:            true,
-				GrpcStreamingEnabled:  true,
+				NonValidatingFullNode:   false,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+				GrpcStreamingDropPolicy: flags.DefaultGrpcStreamingDropPolicy,
+			},
+		},
+		"success - gRPC streaming enabled with resnapshot drop policy": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: 1,
+				GrpcStreamingDropPolicy: flags.GrpcStreamingDropPolicyResnapshot,
 			},
 		},
 		"failure - gRPC disabled": {
@@ -82,6 +102,26 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("grpc.enable must be set to true - grpc streaming requires gRPC server"),
 		},
+		"failure - gRPC streaming enabled with zero buffer size": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: 0,
+				GrpcStreamingDropPolicy: flags.DefaultGrpcStreamingDropPolicy,
+			},
+			expectedErr: fmt.Errorf("grpc streaming buffer size must be positive"),
+		},
+		"failure - gRPC streaming enabled with invalid drop policy": {
+			flags: flags.Flags{
+				NonValidatingFullNode:   true,
+				GrpcEnable:              true,
+				GrpcStreamingEnabled:    true,
+				GrpcStreamingBufferSize: flags.DefaultGrpcStreamingBufferSize,
+				GrpcStreamingDropPolicy: "block",
+			},
+			expectedErr: fmt.Errorf("invalid grpc streaming drop policy: block"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -107,6 +147,9 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 		expectedGrpcAddress               string
 		expectedGrpcEnable                bool
 		expectedGrpcStreamingEnable       bool
+		expectedGrpcStreamingBufferSize   uint32
+		expectedGrpcStreamingDropPolicy   string
+		expectedGrpcStreamingReplaySize   uint32
 	}{
 		"Sets to default if unset": {
 			expectedNonValidatingFullNodeFlag: false,
@@ -115,15 +158,21 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedGrpcAddress:               "localhost:9090",
 			expectedGrpcEnable:                true,
 			expectedGrpcStreamingEnable:       false,
+			expectedGrpcStreamingBufferSize:   1000,
+			expectedGrpcStreamingDropPolicy:   "disconnect",
+			expectedGrpcStreamingReplaySize:   100,
 		},
 		"Sets values from options": {
 			optsMap: map[string]any{
-				flags.NonValidatingFullNodeFlag: true,
-				flags.DdAgentHost:               "agentHostTest",
-				flags.DdTraceAgentPort:          uint16(777),
-				flags.GrpcEnable:                false,
-				flags.GrpcAddress:               "localhost:9091",
-				flags.GrpcStreamingEnabled:      "true",
+				flags.NonValidatingFullNodeFlag:     true,
+				flags.DdAgentHost:                   "agentHostTest",
+				flags.DdTraceAgentPort:              uint16(777),
+				flags.GrpcEnable:                    false,
+				flags.GrpcAddress:                   "localhost:9091",
+				flags.GrpcStreamingEnabled:          "true",
+				flags.GrpcStreamingBufferSize:       uint32(50),
+				flags.GrpcStreamingDropPolicy:       "resnapshot",
+				flags.GrpcStreamingReplayBufferSize: uint32(0),
 			},
 			expectedNonValidatingFullNodeFlag: true,
 			expectedDdAgentHost:               "agentHostTest",
@@ -131,6 +180,9 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedGrpcEnable:                false,
 			expectedGrpcAddress:               "localhost:9091",
 			expectedGrpcStreamingEnable:       true,
+			expectedGrpcStreamingBufferSize:   50,
+			expectedGrpcStreamingDropPolicy:   "resnapshot",
+			expectedGrpcStreamingReplaySize:   0,
 		},
 	}
 
@@ -168,6 +220,21 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				tc.expectedGrpcAddress,
 				flags.GrpcAddress,
 			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingBufferSize,
+				flags.GrpcStreamingBufferSize,
+			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingDropPolicy,
+				flags.GrpcStreamingDropPolicy,
+			)
+			require.Equal(
+				t,
+				tc.expectedGrpcStreamingReplaySize,
+				flags.GrpcStreamingReplayBufferSize,
+			)
 		})
 	}
 }
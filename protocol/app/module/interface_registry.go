@@ -80,12 +80,30 @@ func NewInterfaceRegistry(addrPrefix string, valAddrPrefix string) (types.Interf
 				"dydxprotocol.clob.MsgBatchCancel": getLegacyMsgSignerFn(
 					[]string{"subaccount_id", "owner"},
 				),
+				"dydxprotocol.clob.MsgBatchPlaceOrder": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
+				"dydxprotocol.clob.MsgCancelAllOrders": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
 				"dydxprotocol.clob.MsgCancelOrder": getLegacyMsgSignerFn(
 					[]string{"order_id", "subaccount_id", "owner"},
 				),
+				"dydxprotocol.clob.MsgHeartbeat": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
 				"dydxprotocol.clob.MsgPlaceOrder": getLegacyMsgSignerFn(
 					[]string{"order", "order_id", "subaccount_id", "owner"},
 				),
+				"dydxprotocol.clob.MsgReplaceOrder": getLegacyMsgSignerFn(
+					[]string{"order", "order_id", "subaccount_id", "owner"},
+				),
+				"dydxprotocol.clob.MsgResetMarketMakerProtection": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
+				"dydxprotocol.clob.MsgSetMarketMakerProtectionConfig": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
 				"dydxprotocol.sending.MsgCreateTransfer": getLegacyMsgSignerFn(
 					[]string{"transfer", "sender", "owner"},
 				),
@@ -95,6 +113,9 @@ func NewInterfaceRegistry(addrPrefix string, valAddrPrefix string) (types.Interf
 				"dydxprotocol.vault.MsgDepositToVault": getLegacyMsgSignerFn(
 					[]string{"subaccount_id", "owner"},
 				),
+				"dydxprotocol.vault.MsgWithdrawFromVault": getLegacyMsgSignerFn(
+					[]string{"subaccount_id", "owner"},
+				),
 
 				// App injected messages have no signers.
 				"dydxprotocol.bridge.MsgAcknowledgeBridges":  noSigners,
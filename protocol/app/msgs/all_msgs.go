@@ -148,6 +148,19 @@ var (
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse":    {},
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal":       {},
 
+		// accountplus
+		"/dydxprotocol.accountplus.MsgAddAuthenticator":            {},
+		"/dydxprotocol.accountplus.MsgAddAuthenticatorResponse":    {},
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticator":         {},
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticatorResponse": {},
+		"/dydxprotocol.accountplus.TxExtension":                    {},
+
+		// assets
+		"/dydxprotocol.assets.MsgCreateAsset":         {},
+		"/dydxprotocol.assets.MsgCreateAssetResponse": {},
+		"/dydxprotocol.assets.MsgUpdateAsset":         {},
+		"/dydxprotocol.assets.MsgUpdateAssetResponse": {},
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         {},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": {},
@@ -167,14 +180,26 @@ var (
 		// clob
 		"/dydxprotocol.clob.MsgBatchCancel":                                {},
 		"/dydxprotocol.clob.MsgBatchCancelResponse":                        {},
+		"/dydxprotocol.clob.MsgBatchPlaceOrder":                            {},
+		"/dydxprotocol.clob.MsgBatchPlaceOrderResponse":                    {},
+		"/dydxprotocol.clob.MsgCancelAllOrders":                            {},
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse":                    {},
 		"/dydxprotocol.clob.MsgCancelOrder":                                {},
 		"/dydxprotocol.clob.MsgCancelOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgCreateClobPair":                             {},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     {},
+		"/dydxprotocol.clob.MsgHeartbeat":                                  {},
+		"/dydxprotocol.clob.MsgHeartbeatResponse":                          {},
 		"/dydxprotocol.clob.MsgPlaceOrder":                                 {},
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":                         {},
 		"/dydxprotocol.clob.MsgProposedOperations":                         {},
 		"/dydxprotocol.clob.MsgProposedOperationsResponse":                 {},
+		"/dydxprotocol.clob.MsgReplaceOrder":                               {},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":                       {},
+		"/dydxprotocol.clob.MsgResetMarketMakerProtection":                 {},
+		"/dydxprotocol.clob.MsgResetMarketMakerProtectionResponse":         {},
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfig":             {},
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfigResponse":     {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  {},
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             {},
@@ -235,10 +260,14 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": {},
 
 		// vault
-		"/dydxprotocol.vault.MsgDepositToVault":         {},
-		"/dydxprotocol.vault.MsgDepositToVaultResponse": {},
-		"/dydxprotocol.vault.MsgUpdateParams":           {},
-		"/dydxprotocol.vault.MsgUpdateParamsResponse":   {},
+		"/dydxprotocol.vault.MsgDepositToVault":            {},
+		"/dydxprotocol.vault.MsgDepositToVaultResponse":    {},
+		"/dydxprotocol.vault.MsgSetVaultParams":            {},
+		"/dydxprotocol.vault.MsgSetVaultParamsResponse":    {},
+		"/dydxprotocol.vault.MsgUpdateParams":              {},
+		"/dydxprotocol.vault.MsgUpdateParamsResponse":      {},
+		"/dydxprotocol.vault.MsgWithdrawFromVault":         {},
+		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse": {},
 
 		// vest
 		"/dydxprotocol.vest.MsgSetVestEntry":            {},
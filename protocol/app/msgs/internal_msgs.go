@@ -16,6 +16,7 @@ import (
 	ibcclient "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	ibcconn "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktime "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -103,6 +104,12 @@ var (
 
 	// Custom modules
 	InternalMsgSamplesDydxCustom = map[string]sdk.Msg{
+		// assets
+		"/dydxprotocol.assets.MsgCreateAsset":         &assets.MsgCreateAsset{},
+		"/dydxprotocol.assets.MsgCreateAssetResponse": nil,
+		"/dydxprotocol.assets.MsgUpdateAsset":         &assets.MsgUpdateAsset{},
+		"/dydxprotocol.assets.MsgUpdateAssetResponse": nil,
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams":         &blocktime.MsgUpdateDowntimeParams{},
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse": nil,
@@ -174,8 +181,10 @@ var (
 		"/dydxprotocol.stats.MsgUpdateParamsResponse": nil,
 
 		// vault
-		"/dydxprotocol.vault.MsgUpdateParams":         &vault.MsgUpdateParams{},
-		"/dydxprotocol.vault.MsgUpdateParamsResponse": nil,
+		"/dydxprotocol.vault.MsgSetVaultParams":         &vault.MsgSetVaultParams{},
+		"/dydxprotocol.vault.MsgSetVaultParamsResponse": nil,
+		"/dydxprotocol.vault.MsgUpdateParams":           &vault.MsgUpdateParams{},
+		"/dydxprotocol.vault.MsgUpdateParamsResponse":   nil,
 
 		// vest
 		"/dydxprotocol.vest.MsgSetVestEntry":            &vest.MsgSetVestEntry{},
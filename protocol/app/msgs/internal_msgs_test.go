@@ -63,6 +63,12 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
 		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse",
 
+		// assets
+		"/dydxprotocol.assets.MsgCreateAsset",
+		"/dydxprotocol.assets.MsgCreateAssetResponse",
+		"/dydxprotocol.assets.MsgUpdateAsset",
+		"/dydxprotocol.assets.MsgUpdateAssetResponse",
+
 		// blocktime
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParams",
 		"/dydxprotocol.blocktime.MsgUpdateDowntimeParamsResponse",
@@ -134,6 +140,8 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		"/dydxprotocol.stats.MsgUpdateParamsResponse",
 
 		// vault
+		"/dydxprotocol.vault.MsgSetVaultParams",
+		"/dydxprotocol.vault.MsgSetVaultParamsResponse",
 		"/dydxprotocol.vault.MsgUpdateParams",
 		"/dydxprotocol.vault.MsgUpdateParamsResponse",
 
@@ -17,6 +17,7 @@ import (
 	ibcconn "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	ibccore "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	accountplus "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	sending "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
 	vault "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
@@ -215,13 +216,32 @@ var (
 
 	// Custom modules
 	NormalMsgsDydxCustom = map[string]sdk.Msg{
+		// accountplus
+		"/dydxprotocol.accountplus.MsgAddAuthenticator":            &accountplus.MsgAddAuthenticator{},
+		"/dydxprotocol.accountplus.MsgAddAuthenticatorResponse":    nil,
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticator":         &accountplus.MsgRemoveAuthenticator{},
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticatorResponse": nil,
+		"/dydxprotocol.accountplus.TxExtension":                    nil,
+
 		// clob
-		"/dydxprotocol.clob.MsgBatchCancel":         &clob.MsgBatchCancel{},
-		"/dydxprotocol.clob.MsgBatchCancelResponse": nil,
-		"/dydxprotocol.clob.MsgCancelOrder":         &clob.MsgCancelOrder{},
-		"/dydxprotocol.clob.MsgCancelOrderResponse": nil,
-		"/dydxprotocol.clob.MsgPlaceOrder":          &clob.MsgPlaceOrder{},
-		"/dydxprotocol.clob.MsgPlaceOrderResponse":  nil,
+		"/dydxprotocol.clob.MsgBatchCancel":                            &clob.MsgBatchCancel{},
+		"/dydxprotocol.clob.MsgBatchCancelResponse":                    nil,
+		"/dydxprotocol.clob.MsgBatchPlaceOrder":                        &clob.MsgBatchPlaceOrder{},
+		"/dydxprotocol.clob.MsgBatchPlaceOrderResponse":                nil,
+		"/dydxprotocol.clob.MsgCancelAllOrders":                        &clob.MsgCancelAllOrders{},
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse":                nil,
+		"/dydxprotocol.clob.MsgCancelOrder":                            &clob.MsgCancelOrder{},
+		"/dydxprotocol.clob.MsgCancelOrderResponse":                    nil,
+		"/dydxprotocol.clob.MsgHeartbeat":                              &clob.MsgHeartbeat{},
+		"/dydxprotocol.clob.MsgHeartbeatResponse":                      nil,
+		"/dydxprotocol.clob.MsgPlaceOrder":                             &clob.MsgPlaceOrder{},
+		"/dydxprotocol.clob.MsgPlaceOrderResponse":                     nil,
+		"/dydxprotocol.clob.MsgReplaceOrder":                           &clob.MsgReplaceOrder{},
+		"/dydxprotocol.clob.MsgReplaceOrderResponse":                   nil,
+		"/dydxprotocol.clob.MsgResetMarketMakerProtection":             &clob.MsgResetMarketMakerProtection{},
+		"/dydxprotocol.clob.MsgResetMarketMakerProtectionResponse":     nil,
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfig":         &clob.MsgSetMarketMakerProtectionConfig{},
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfigResponse": nil,
 
 		// perpetuals
 
@@ -236,7 +256,9 @@ var (
 		"/dydxprotocol.sending.MsgWithdrawFromSubaccountResponse": nil,
 
 		// vault
-		"/dydxprotocol.vault.MsgDepositToVault":         &vault.MsgDepositToVault{},
-		"/dydxprotocol.vault.MsgDepositToVaultResponse": nil,
+		"/dydxprotocol.vault.MsgDepositToVault":            &vault.MsgDepositToVault{},
+		"/dydxprotocol.vault.MsgDepositToVaultResponse":    nil,
+		"/dydxprotocol.vault.MsgWithdrawFromVault":         &vault.MsgWithdrawFromVault{},
+		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse": nil,
 	}
 )
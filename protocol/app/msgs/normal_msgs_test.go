@@ -118,13 +118,32 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
 		"/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 
+		// accountplus
+		"/dydxprotocol.accountplus.MsgAddAuthenticator",
+		"/dydxprotocol.accountplus.MsgAddAuthenticatorResponse",
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticator",
+		"/dydxprotocol.accountplus.MsgRemoveAuthenticatorResponse",
+		"/dydxprotocol.accountplus.TxExtension",
+
 		// clob
 		"/dydxprotocol.clob.MsgBatchCancel",
 		"/dydxprotocol.clob.MsgBatchCancelResponse",
+		"/dydxprotocol.clob.MsgBatchPlaceOrder",
+		"/dydxprotocol.clob.MsgBatchPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgCancelAllOrders",
+		"/dydxprotocol.clob.MsgCancelAllOrdersResponse",
 		"/dydxprotocol.clob.MsgCancelOrder",
 		"/dydxprotocol.clob.MsgCancelOrderResponse",
+		"/dydxprotocol.clob.MsgHeartbeat",
+		"/dydxprotocol.clob.MsgHeartbeatResponse",
 		"/dydxprotocol.clob.MsgPlaceOrder",
 		"/dydxprotocol.clob.MsgPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgReplaceOrder",
+		"/dydxprotocol.clob.MsgReplaceOrderResponse",
+		"/dydxprotocol.clob.MsgResetMarketMakerProtection",
+		"/dydxprotocol.clob.MsgResetMarketMakerProtectionResponse",
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfig",
+		"/dydxprotocol.clob.MsgSetMarketMakerProtectionConfigResponse",
 
 		// perpetuals
 
@@ -141,6 +160,8 @@ func TestNormalMsgs_Key(t *testing.T) {
 		// vault
 		"/dydxprotocol.vault.MsgDepositToVault",
 		"/dydxprotocol.vault.MsgDepositToVaultResponse",
+		"/dydxprotocol.vault.MsgWithdrawFromVault",
+		"/dydxprotocol.vault.MsgWithdrawFromVaultResponse",
 
 		// ibc application module: ICA
 		"/ibc.applications.interchain_accounts.v1.InterchainAccount",
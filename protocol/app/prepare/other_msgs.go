@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	gometrics "github.com/hashicorp/go-metrics"
 
+	"github.com/dydxprotocol/v4-chain/protocol/app/process"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/ante"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
@@ -36,6 +37,8 @@ func GetGroupMsgOther(availableTxs [][]byte, maxBytes uint64) ([][]byte, [][]byt
 }
 
 // RemoveDisallowMsgs removes any txs that contain a disallowed msg.
+// This includes Short-Term clob msgs which are not allowed in OtherTxs, since not all of them
+// (e.g. `MsgBatchPlaceOrder`) are excluded from the mempool.
 func RemoveDisallowMsgs(
 	ctx sdk.Context,
 	decoder sdk.TxDecoder,
@@ -60,7 +63,7 @@ func RemoveDisallowMsgs(
 		// For each msg in tx, check if it is disallowed.
 		containsDisallowMsg := false
 		for _, msg := range tx.GetMsgs() {
-			if ante.IsDisallowExternalSubmitMsg(msg) {
+			if ante.IsDisallowExternalSubmitMsg(msg) || process.IsDisallowClobOrderMsgInOtherTxs(msg) {
 				telemetry.IncrCounterWithLabels(
 					[]string{ModuleName, metrics.RemoveDisallowMsgs, metrics.DisallowMsg, metrics.Count},
 					1,
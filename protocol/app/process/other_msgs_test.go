@@ -95,6 +95,13 @@ func TestDecodeOtherMsgsTx(t *testing.T) {
 				"Msg type *types.MsgBatchCancel is not allowed in OtherTxs",
 			),
 		},
+		"Error: batch place order is not allowed": {
+			txBytes: constants.Msg_BatchPlaceOrder_TxBtyes,
+			expectedErr: errorsmod.Wrap(
+				process.ErrUnexpectedMsgType,
+				"Msg type *types.MsgBatchPlaceOrder is not allowed in OtherTxs",
+			),
+		},
 		"Valid: single msg": {
 			txBytes:      constants.Msg_Send_TxBytes,
 			expectedMsgs: []sdk.Msg{constants.Msg_Send},
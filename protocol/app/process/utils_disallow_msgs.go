@@ -18,7 +18,7 @@ func IsDisallowClobOrderMsgInOtherTxs(targetMsg sdk.Msg) bool {
 		order := msg.GetOrder()
 		orderId := order.GetOrderId()
 		return !orderId.IsStatefulOrder() // not stateful -> returns true -> disallow
-	case *clobtypes.MsgBatchCancel:
+	case *clobtypes.MsgBatchCancel, *clobtypes.MsgBatchPlaceOrder:
 		return true
 	}
 	return false
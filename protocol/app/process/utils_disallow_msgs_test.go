@@ -25,7 +25,10 @@ func TestIsDisallowClobOrderMsgInOtherTxs(t *testing.T) {
 	for _, msg := range allMsgSamples {
 		result := process.IsDisallowClobOrderMsgInOtherTxs(msg)
 		switch msg.(type) {
-		case *clobtypes.MsgCancelOrder, *clobtypes.MsgPlaceOrder, *clobtypes.MsgBatchCancel:
+		case *clobtypes.MsgCancelOrder,
+			*clobtypes.MsgPlaceOrder,
+			*clobtypes.MsgBatchCancel,
+			*clobtypes.MsgBatchPlaceOrder:
 			// The sample msgs are short-term orders, so we expect these to be disallowed.
 			require.True(t, result) // true -> disallow
 		default:
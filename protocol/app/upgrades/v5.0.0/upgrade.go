@@ -300,6 +300,15 @@ func initializeVaultModuleParams(
 	ctx.Logger().Info("Successfully set vault module parameters")
 }
 
+// Initialize the auto-deleveraging information and ranks of existing perpetual positions.
+func initializeAdlPositions(
+	ctx sdk.Context,
+	subaccountsKeeper satypes.SubaccountsKeeper,
+) {
+	subaccountsKeeper.InitializeAdlPositions(ctx)
+	ctx.Logger().Info("Successfully initialized auto-deleveraging information and ranks of perpetual positions")
+}
+
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -336,6 +345,9 @@ func CreateUpgradeHandler(
 		// Initialize `x/vault` module params.
 		initializeVaultModuleParams(sdkCtx, vaultKeeper)
 
+		// Initialize the auto-deleveraging information and ranks of existing perpetual positions.
+		initializeAdlPositions(sdkCtx, subaccountsKeeper)
+
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	assetstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -28,6 +29,7 @@ type Client struct {
 	PerpetualsQueryClient    perptypes.QueryClient
 	PricesQueryClient        pricestypes.QueryClient
 	ClobQueryClient          clobtypes.QueryClient
+	AssetsQueryClient        assetstypes.QueryClient
 	LiquidationServiceClient api.LiquidationServiceClient
 
 	// include HealthCheckable to track the health of the daemon.
@@ -96,6 +98,7 @@ func (c *Client) Start(
 	c.PerpetualsQueryClient = perptypes.NewQueryClient(queryConn)
 	c.PricesQueryClient = pricestypes.NewQueryClient(queryConn)
 	c.ClobQueryClient = clobtypes.NewQueryClient(queryConn)
+	c.AssetsQueryClient = assetstypes.NewQueryClient(queryConn)
 	c.LiquidationServiceClient = api.NewLiquidationServiceClient(daemonConn)
 
 	ticker := time.NewTicker(time.Duration(flags.Liquidation.LoopDelayMs) * time.Millisecond)
@@ -11,6 +11,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assetstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -121,6 +122,45 @@ func (c *Client) GetAllLiquidityTiers(
 	return liquidityTiers, nil
 }
 
+// GetAllAssets queries gRPC server and returns a list of assets.
+func (c *Client) GetAllAssets(
+	ctx context.Context,
+	pageLimit uint64,
+) (
+	assets []assetstypes.Asset,
+	err error,
+) {
+	defer metrics.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		metrics.DaemonGetAllAssetsLatency,
+		time.Now(),
+	)
+
+	assets = make([]assetstypes.Asset, 0)
+
+	var nextKey []byte
+	for {
+		assetsFromKey, next, err := getAssetsFromKey(
+			ctx,
+			c.AssetsQueryClient,
+			nextKey,
+			pageLimit,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		assets = append(assets, assetsFromKey...)
+		nextKey = next
+
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	return assets, nil
+}
+
 // GetAllMarketPrices queries gRPC server and returns a list of market prices.
 func (c *Client) GetAllMarketPrices(
 	ctx context.Context,
@@ -160,6 +200,45 @@ func (c *Client) GetAllMarketPrices(
 	return marketPrices, nil
 }
 
+// GetAllMarkPrices queries gRPC server and returns a list of mark prices.
+func (c *Client) GetAllMarkPrices(
+	ctx context.Context,
+	pageLimit uint64,
+) (
+	markPrices []perptypes.MarkPrice,
+	err error,
+) {
+	defer metrics.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		metrics.DaemonGetAllMarkPricesLatency,
+		time.Now(),
+	)
+
+	markPrices = make([]perptypes.MarkPrice, 0)
+
+	var nextKey []byte
+	for {
+		markPricesFromKey, next, err := getMarkPricesFromKey(
+			ctx,
+			c.PerpetualsQueryClient,
+			nextKey,
+			pageLimit,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		markPrices = append(markPrices, markPricesFromKey...)
+		nextKey = next
+
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	return markPrices, nil
+}
+
 // GetAllSubaccounts queries a gRPC server and returns a list of subaccounts and
 // their balances and open positions.
 func (c *Client) GetAllSubaccounts(
@@ -336,6 +415,39 @@ func getMarketPricesFromKey(
 	return response.MarketPrices, nextKey, nil
 }
 
+func getMarkPricesFromKey(
+	ctx context.Context,
+	client perptypes.QueryClient,
+	pageRequestKey []byte,
+	limit uint64,
+) (
+	markPrices []perptypes.MarkPrice,
+	nextKey []byte,
+	err error,
+) {
+	defer metrics.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		metrics.DaemonGetMarkPricesPaginatedLatency,
+		time.Now(),
+	)
+
+	query := &perptypes.QueryAllMarkPricesRequest{
+		Pagination: &query.PageRequest{
+			Key:   pageRequestKey,
+			Limit: limit,
+		},
+	}
+
+	response, err := client.AllMarkPrices(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.Pagination != nil {
+		nextKey = response.Pagination.NextKey
+	}
+	return response.MarkPrices, nextKey, nil
+}
+
 func getPerpetualsFromKey(
 	ctx context.Context,
 	client perptypes.QueryClient,
@@ -401,3 +513,36 @@ func getLiquidityTiersFromKey(
 	}
 	return response.LiquidityTiers, nextKey, nil
 }
+
+func getAssetsFromKey(
+	ctx context.Context,
+	client assetstypes.QueryClient,
+	pageRequestKey []byte,
+	limit uint64,
+) (
+	assets []assetstypes.Asset,
+	nextKey []byte,
+	err error,
+) {
+	defer metrics.ModuleMeasureSince(
+		metrics.LiquidationDaemon,
+		metrics.DaemonGetAssetsPaginatedLatency,
+		time.Now(),
+	)
+
+	query := &assetstypes.QueryAllAssetsRequest{
+		Pagination: &query.PageRequest{
+			Key:   pageRequestKey,
+			Limit: limit,
+		},
+	}
+
+	response, err := client.AllAssets(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.Pagination != nil {
+		nextKey = response.Pagination.NextKey
+	}
+	return response.Asset, nextKey, nil
+}
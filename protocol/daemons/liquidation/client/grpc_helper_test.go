@@ -14,6 +14,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
+	assetstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -270,6 +271,102 @@ func TestGetAllPerpetuals(t *testing.T) {
 	}
 }
 
+func TestGetAllMarkPrices(t *testing.T) {
+	markPrices := []perptypes.MarkPrice{
+		{PerpetualId: 0, Price: 5_000_500_000, Exponent: -5, BasisPpm: 100},
+		{PerpetualId: 1, Price: 3_004_500_000, Exponent: -6, BasisPpm: 1_500},
+	}
+	tests := map[string]struct {
+		// mocks
+		setupMocks func(ctx context.Context, mck *mocks.QueryClient)
+		limit      uint64
+
+		// expectations
+		expectedMarkPrices []perptypes.MarkPrice
+		expectedError      error
+	}{
+		"Success": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &perptypes.QueryAllMarkPricesRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1_000,
+					},
+				}
+				response := &perptypes.QueryAllMarkPricesResponse{
+					MarkPrices: markPrices,
+				}
+				mck.On("AllMarkPrices", mock.Anything, req).Return(response, nil)
+			},
+			limit:              1_000,
+			expectedMarkPrices: markPrices,
+		},
+		"Success Paginated": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &perptypes.QueryAllMarkPricesRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1,
+					},
+				}
+				nextKey := []byte("next key")
+				response := &perptypes.QueryAllMarkPricesResponse{
+					MarkPrices: []perptypes.MarkPrice{
+						markPrices[0],
+					},
+					Pagination: &query.PageResponse{
+						NextKey: nextKey,
+					},
+				}
+				mck.On("AllMarkPrices", mock.Anything, req).Return(response, nil)
+				req2 := &perptypes.QueryAllMarkPricesRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 1,
+					},
+				}
+				response2 := &perptypes.QueryAllMarkPricesResponse{
+					MarkPrices: []perptypes.MarkPrice{
+						markPrices[1],
+					},
+				}
+				mck.On("AllMarkPrices", mock.Anything, req2).Return(response2, nil)
+			},
+			limit:              1,
+			expectedMarkPrices: markPrices,
+		},
+		"Errors are propagated": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &perptypes.QueryAllMarkPricesRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1_000,
+					},
+				}
+				mck.On("AllMarkPrices", mock.Anything, req).Return(nil, errors.New("test error"))
+			},
+			limit:         1_000,
+			expectedError: errors.New("test error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			queryClientMock := &mocks.QueryClient{}
+			tc.setupMocks(grpc.Ctx, queryClientMock)
+
+			daemon := client.NewClient(log.NewNopLogger())
+			daemon.PerpetualsQueryClient = queryClientMock
+			actual, err := daemon.GetAllMarkPrices(
+				grpc.Ctx,
+				tc.limit,
+			)
+			if err != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedMarkPrices, actual)
+			}
+		})
+	}
+}
+
 func TestGetAllLiquidityTiers(t *testing.T) {
 	tests := map[string]struct {
 		// mocks
@@ -358,6 +455,99 @@ func TestGetAllLiquidityTiers(t *testing.T) {
 	}
 }
 
+func TestGetAllAssets(t *testing.T) {
+	assets := []assetstypes.Asset{
+		*constants.Usdc,
+		*constants.BtcUsd,
+	}
+
+	tests := map[string]struct {
+		// mocks
+		setupMocks func(ctx context.Context, mck *mocks.QueryClient)
+		limit      uint64
+
+		// expectations
+		expectedAssets []assetstypes.Asset
+		expectedError  error
+	}{
+		"Success": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &assetstypes.QueryAllAssetsRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1_000,
+					},
+				}
+				response := &assetstypes.QueryAllAssetsResponse{
+					Asset: assets,
+				}
+				mck.On("AllAssets", mock.Anything, req).Return(response, nil)
+			},
+			limit:          1_000,
+			expectedAssets: assets,
+		},
+		"Success Paginated": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &assetstypes.QueryAllAssetsRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1,
+					},
+				}
+				nextKey := []byte("next key")
+				response := &assetstypes.QueryAllAssetsResponse{
+					Asset: assets[0:1],
+					Pagination: &query.PageResponse{
+						NextKey: nextKey,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, req).Return(response, nil)
+				req2 := &assetstypes.QueryAllAssetsRequest{
+					Pagination: &query.PageRequest{
+						Key:   nextKey,
+						Limit: 1,
+					},
+				}
+				response2 := &assetstypes.QueryAllAssetsResponse{
+					Asset: assets[1:],
+				}
+				mck.On("AllAssets", mock.Anything, req2).Return(response2, nil)
+			},
+			limit:          1,
+			expectedAssets: assets,
+		},
+		"Errors are propagated": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				req := &assetstypes.QueryAllAssetsRequest{
+					Pagination: &query.PageRequest{
+						Limit: 1_000,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, req).Return(nil, errors.New("test error"))
+			},
+			limit:         1_000,
+			expectedError: errors.New("test error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			queryClientMock := &mocks.QueryClient{}
+			tc.setupMocks(grpc.Ctx, queryClientMock)
+
+			daemon := client.NewClient(log.NewNopLogger())
+			daemon.AssetsQueryClient = queryClientMock
+			actual, err := daemon.GetAllAssets(
+				grpc.Ctx,
+				tc.limit,
+			)
+			if err != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.Equal(t, tc.expectedAssets, actual)
+			}
+		})
+	}
+}
+
 func TestGetAllMarketPrices(t *testing.T) {
 	tests := map[string]struct {
 		// mocks
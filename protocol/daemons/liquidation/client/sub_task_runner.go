@@ -10,6 +10,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assetskeeper "github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	assetstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	clobkeeper "github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -57,8 +58,10 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 	// 1. Fetch all information needed to calculate total net collateral and margin requirements.
 	subaccounts,
 		marketPrices,
+		markPrices,
 		perpetuals,
 		liquidityTiers,
+		assets,
 		err := daemonClient.FetchApplicationStateAtBlockHeight(
 		ctx,
 		lastCommittedBlockHeight,
@@ -68,14 +71,17 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 		return err
 	}
 
-	// 2. Check collateralization statuses of subaccounts with at least one open position.
+	// 2. Check collateralization statuses of subaccounts with at least one open position
+	// or borrowed USDC.
 	liquidatableSubaccountIds,
 		negativeTncSubaccountIds,
 		err := daemonClient.GetLiquidatableSubaccountIds(
 		subaccounts,
 		marketPrices,
+		markPrices,
 		perpetuals,
 		liquidityTiers,
+		assets,
 	)
 	if err != nil {
 		return err
@@ -103,8 +109,10 @@ func (s *SubTaskRunnerImpl) RunLiquidationDaemonTaskLoop(
 // - Last committed block height.
 // - Subaccounts including their open positions.
 // - Market prices.
+// - Mark prices.
 // - Perpetuals.
 // - Liquidity tiers.
+// - Assets.
 func (c *Client) FetchApplicationStateAtBlockHeight(
 	ctx context.Context,
 	blockHeight uint32,
@@ -112,8 +120,10 @@ func (c *Client) FetchApplicationStateAtBlockHeight(
 ) (
 	subaccounts []satypes.Subaccount,
 	marketPricesMap map[uint32]pricestypes.MarketPrice,
+	markPricesMap map[uint32]perptypes.MarkPrice,
 	perpetualsMap map[uint32]perptypes.Perpetual,
 	liquidityTiersMap map[uint32]perptypes.LiquidityTier,
+	assetsMap map[uint32]assetstypes.Asset,
 	err error,
 ) {
 	defer telemetry.ModuleMeasureSince(
@@ -129,22 +139,31 @@ func (c *Client) FetchApplicationStateAtBlockHeight(
 	// Subaccounts
 	subaccounts, err = c.GetAllSubaccounts(queryCtx, liqFlags.QueryPageLimit)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	// Market prices
 	marketPrices, err := c.GetAllMarketPrices(queryCtx, liqFlags.QueryPageLimit)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	marketPricesMap = lib.UniqueSliceToMap(marketPrices, func(m pricestypes.MarketPrice) uint32 {
 		return m.Id
 	})
 
+	// Mark prices
+	markPrices, err := c.GetAllMarkPrices(queryCtx, liqFlags.QueryPageLimit)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+	markPricesMap = lib.UniqueSliceToMap(markPrices, func(m perptypes.MarkPrice) uint32 {
+		return m.PerpetualId
+	})
+
 	// Perpetuals
 	perpetuals, err := c.GetAllPerpetuals(queryCtx, liqFlags.QueryPageLimit)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	perpetualsMap = lib.UniqueSliceToMap(perpetuals, func(p perptypes.Perpetual) uint32 {
 		return p.Params.Id
@@ -153,22 +172,35 @@ func (c *Client) FetchApplicationStateAtBlockHeight(
 	// Liquidity tiers
 	liquidityTiers, err := c.GetAllLiquidityTiers(queryCtx, liqFlags.QueryPageLimit)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
 	}
 	liquidityTiersMap = lib.UniqueSliceToMap(liquidityTiers, func(l perptypes.LiquidityTier) uint32 {
 		return l.Id
 	})
 
-	return subaccounts, marketPricesMap, perpetualsMap, liquidityTiersMap, nil
+	// Assets
+	assets, err := c.GetAllAssets(queryCtx, liqFlags.QueryPageLimit)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+	assetsMap = lib.UniqueSliceToMap(assets, func(a assetstypes.Asset) uint32 {
+		return a.Id
+	})
+
+	return subaccounts, marketPricesMap, markPricesMap, perpetualsMap, liquidityTiersMap, assetsMap, nil
 }
 
 // GetLiquidatableSubaccountIds verifies collateralization statuses of subaccounts with
-// at least one open position and returns a list of unique and potentially liquidatable subaccount ids.
+// at least one open position or borrowed USDC and returns a list of unique and potentially
+// liquidatable subaccount ids, as well as the ids of subaccounts with negative net collateral.
+// Only subaccounts with at least one open position are considered liquidatable.
 func (c *Client) GetLiquidatableSubaccountIds(
 	subaccounts []satypes.Subaccount,
 	marketPrices map[uint32]pricestypes.MarketPrice,
+	markPrices map[uint32]perptypes.MarkPrice,
 	perpetuals map[uint32]perptypes.Perpetual,
 	liquidityTiers map[uint32]perptypes.LiquidityTier,
+	assets map[uint32]assetstypes.Asset,
 ) (
 	liquidatableSubaccountIds []satypes.SubaccountId,
 	negativeTncSubaccountIds []satypes.SubaccountId,
@@ -184,8 +216,10 @@ func (c *Client) GetLiquidatableSubaccountIds(
 	liquidatableSubaccountIds = make([]satypes.SubaccountId, 0)
 	negativeTncSubaccountIds = make([]satypes.SubaccountId, 0)
 	for _, subaccount := range subaccounts {
-		// Skip subaccounts with no open positions.
-		if len(subaccount.PerpetualPositions) == 0 {
+		// Skip subaccounts with no open positions and no borrowed USDC, since their
+		// net collateral cannot be negative.
+		hasOpenPositions := len(subaccount.PerpetualPositions) > 0
+		if !hasOpenPositions && subaccount.GetUsdcPosition().Sign() >= 0 {
 			continue
 		}
 
@@ -193,15 +227,17 @@ func (c *Client) GetLiquidatableSubaccountIds(
 		isLiquidatable, hasNegativeTnc, err := c.CheckSubaccountCollateralization(
 			subaccount,
 			marketPrices,
+			markPrices,
 			perpetuals,
 			liquidityTiers,
+			assets,
 		)
 		if err != nil {
 			c.logger.Error("Error checking collateralization status", "error", err)
 			return nil, nil, err
 		}
 
-		if isLiquidatable {
+		if isLiquidatable && hasOpenPositions {
 			liquidatableSubaccountIds = append(liquidatableSubaccountIds, *subaccount.Id)
 		}
 		if hasNegativeTnc {
@@ -272,15 +308,17 @@ func (c *Client) GetSubaccountOpenPositionInfo(
 }
 
 // CheckSubaccountCollateralization performs the same collateralization check as the application
-// using the provided market prices, perpetuals, and liquidity tiers.
-//
-// Note that current implementation assumes that the only asset is USDC and multi-collateral support
-// is not yet implemented.
+// using the provided market prices, mark prices, perpetuals, liquidity tiers, and assets.
+// Like `IsLiquidatable`, whether the subaccount is liquidatable is determined with perpetual positions
+// valued at their risk prices. Like `CanDeleverageSubaccount`, whether the subaccount has negative net
+// collateral is determined at the oracle prices, at which liquidations and deleveraging are settled.
 func (c *Client) CheckSubaccountCollateralization(
 	unsettledSubaccount satypes.Subaccount,
 	marketPrices map[uint32]pricestypes.MarketPrice,
+	markPrices map[uint32]perptypes.MarkPrice,
 	perpetuals map[uint32]perptypes.Perpetual,
 	liquidityTiers map[uint32]perptypes.LiquidityTier,
+	assets map[uint32]assetstypes.Asset,
 ) (
 	isLiquidatable bool,
 	hasNegativeTnc bool,
@@ -304,21 +342,18 @@ func (c *Client) CheckSubaccountCollateralization(
 	}
 
 	bigTotalNetCollateral := big.NewInt(0)
-	bigTotalMaintenanceMargin := big.NewInt(0)
+	bigTotalRiskNetCollateral := big.NewInt(0)
+	bigTotalRiskMaintenanceMargin := big.NewInt(0)
 
-	// Calculate the net collateral and maintenance margin for each of the asset positions.
-	// Note that we only expect USDC before multi-collateral support is added.
+	// Calculate the net collateral for each of the asset positions.
+	// Margin requirements for asset positions are zero, since only USDC can be negative.
 	for _, assetPosition := range settledSubaccount.AssetPositions {
-		if assetPosition.AssetId != assetstypes.AssetUsdc.Id {
-			return false, false, errorsmod.Wrapf(
-				assetstypes.ErrNotImplementedMulticollateral,
-				"Asset %d is not supported",
-				assetPosition.AssetId,
-			)
+		bigNetCollateralQuoteQuantums, err := getAssetNetCollateral(assetPosition, marketPrices, assets)
+		if err != nil {
+			return false, false, err
 		}
-		// Net collateral for USDC is the quantums of the position.
-		// Margin requirements for USDC are zero.
-		bigTotalNetCollateral.Add(bigTotalNetCollateral, assetPosition.GetBigQuantums())
+		bigTotalNetCollateral.Add(bigTotalNetCollateral, bigNetCollateralQuoteQuantums)
+		bigTotalRiskNetCollateral.Add(bigTotalRiskNetCollateral, bigNetCollateralQuoteQuantums)
 	}
 
 	// Calculate the net collateral and maintenance margin for each of the perpetual positions.
@@ -341,11 +376,16 @@ func (c *Client) CheckSubaccountCollateralization(
 			)
 		}
 
+		// The mark price is the zero value if it has not been computed.
+		riskPrice := perpkeeper.GetRiskPrice(perpetual, marketPrice, markPrices[perpetual.Params.Id])
+
 		bigQuantums := perpetualPosition.GetBigQuantums()
 
 		// Get the net collateral for the position.
 		bigNetCollateralQuoteQuantums := perpkeeper.GetNetNotionalInQuoteQuantums(perpetual, marketPrice, bigQuantums)
 		bigTotalNetCollateral.Add(bigTotalNetCollateral, bigNetCollateralQuoteQuantums)
+		bigRiskNetCollateralQuoteQuantums := perpkeeper.GetNetNotionalInQuoteQuantums(perpetual, riskPrice, bigQuantums)
+		bigTotalRiskNetCollateral.Add(bigTotalRiskNetCollateral, bigRiskNetCollateralQuoteQuantums)
 
 		liquidityTier, ok := liquidityTiers[perpetual.Params.LiquidityTier]
 		if !ok {
@@ -356,17 +396,68 @@ func (c *Client) CheckSubaccountCollateralization(
 			)
 		}
 
-		// Get the maintenance margin requirement for the position.
-		_, bigMaintenanceMarginQuoteQuantums := perpkeeper.GetMarginRequirementsInQuoteQuantums(
+		// Get the maintenance margin requirement for the position at the risk price.
+		_, bigRiskMaintenanceMarginQuoteQuantums := perpkeeper.GetMarginRequirementsInQuoteQuantums(
 			perpetual,
-			marketPrice,
+			riskPrice,
 			liquidityTier,
 			bigQuantums,
 		)
-		bigTotalMaintenanceMargin.Add(bigTotalMaintenanceMargin, bigMaintenanceMarginQuoteQuantums)
+		bigTotalRiskMaintenanceMargin.Add(bigTotalRiskMaintenanceMargin, bigRiskMaintenanceMarginQuoteQuantums)
 	}
 
-	return clobkeeper.CanLiquidateSubaccount(bigTotalNetCollateral, bigTotalMaintenanceMargin),
+	return clobkeeper.CanLiquidateSubaccount(bigTotalRiskNetCollateral, bigTotalRiskMaintenanceMargin),
 		bigTotalNetCollateral.Sign() == -1,
 		nil
 }
+
+// getAssetNetCollateral returns the net collateral that an asset position contributes to a subaccount,
+// matching `GetNetCollateral` in the `x/assets` module.
+func getAssetNetCollateral(
+	assetPosition *satypes.AssetPosition,
+	marketPrices map[uint32]pricestypes.MarketPrice,
+	assets map[uint32]assetstypes.Asset,
+) (
+	bigNetCollateralQuoteQuantums *big.Int,
+	err error,
+) {
+	bigQuantums := assetPosition.GetBigQuantums()
+
+	// Net collateral for USDC is the quantums of the position.
+	if assetPosition.AssetId == assetstypes.AssetUsdc.Id {
+		return bigQuantums, nil
+	}
+
+	asset, ok := assets[assetPosition.AssetId]
+	if !ok {
+		return nil, errorsmod.Wrapf(
+			assetstypes.ErrAssetDoesNotExist,
+			"Asset not found for asset id %d",
+			assetPosition.AssetId,
+		)
+	}
+
+	if bigQuantums.Sign() < 0 {
+		return nil, errorsmod.Wrapf(
+			assetstypes.ErrNotImplementedMargin,
+			"Negative balance for asset %+v",
+			asset,
+		)
+	}
+
+	// Assets without an oracle price do not count towards collateral.
+	if !asset.HasMarket || bigQuantums.Sign() == 0 {
+		return big.NewInt(0), nil
+	}
+
+	marketPrice, ok := marketPrices[asset.MarketId]
+	if !ok {
+		return nil, errorsmod.Wrapf(
+			pricestypes.ErrMarketPriceDoesNotExist,
+			"MarketPrice not found for asset %+v",
+			asset,
+		)
+	}
+
+	return assetskeeper.GetNetCollateralInQuoteQuantums(asset, marketPrice, bigQuantums), nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/grpc"
+	assetstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -22,6 +23,54 @@ import (
 )
 
 func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
+	// Carl is short 1 BTC with $54,999 and 0.2 BTC worth $10,000 as collateral,
+	// which keeps the subaccount above the maintenance margin.
+	carlWithBtcCollateral := constants.Carl_Num0_1BTC_Short_54999USD
+	carlWithBtcCollateral.AssetPositions = []*satypes.AssetPosition{
+		{
+			AssetId:  constants.Usdc.Id,
+			Quantums: dtypes.NewInt(54_999_000_000), // $54,999
+		},
+		{
+			AssetId:  constants.BtcUsd.Id,
+			Quantums: dtypes.NewInt(20_000_000), // 0.2 BTC
+		},
+	}
+
+	// Alice has borrowed $55,000 against 1 BTC worth $50,000.
+	aliceWithBorrowedUsdc := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			{
+				AssetId:  constants.Usdc.Id,
+				Quantums: dtypes.NewInt(-55_000_000_000), // -$55,000
+			},
+			{
+				AssetId:  constants.BtcUsd.Id,
+				Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+			},
+		},
+	}
+
+	// BTC enables the mark price, which is $50,500.
+	btcWithMarkPrice := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+	btcWithMarkPrice.Params.MarkPriceConfig = &perptypes.MarkPriceConfig{Enabled: true}
+	btcMarkPrice := perptypes.MarkPrice{
+		PerpetualId: 0,
+		Price:       5_050_000_000,
+		Exponent:    constants.BtcUsdExponent,
+	}
+
+	// Carl is short 1 BTC with $50,100, which is positive net collateral at the oracle price and
+	// negative net collateral at the mark price.
+	carlWithPositiveTncAtOraclePrice := constants.Carl_Num0_1BTC_Short_55000USD
+	carlWithPositiveTncAtOraclePrice.AssetPositions = []*satypes.AssetPosition{
+		{
+			AssetId:  constants.Usdc.Id,
+			Quantums: dtypes.NewInt(50_100_000_000), // $50,100
+		},
+	}
+
 	tests := map[string]struct {
 		// mocks
 		setupMocks func(ctx context.Context, mck *mocks.QueryClient)
@@ -63,12 +112,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -123,12 +186,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -184,12 +261,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight:               uint32(50),
@@ -244,12 +335,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight:                uint32(50),
@@ -314,12 +419,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -394,12 +513,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -474,12 +607,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight:               uint32(50),
@@ -552,12 +699,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight:               uint32(50),
@@ -611,12 +772,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -674,12 +849,26 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				}
 				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
 
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
 				// Liquidity tiers.
 				res5 := &perptypes.QueryAllLiquidityTiersResponse{
 					LiquidityTiers: constants.LiquidityTiers,
 				}
 				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
 
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
 				// Sends liquidatable subaccount ids to the server.
 				req := &api.LiquidateSubaccountsRequest{
 					BlockHeight: uint32(50),
@@ -703,6 +892,298 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 				mck.On("LiquidateSubaccounts", ctx, req).Return(response3, nil)
 			},
 		},
+		"Non-USDC asset positions count towards collateral": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				// Block height.
+				res := &blocktimetypes.QueryPreviousBlockInfoResponse{
+					Info: &blocktimetypes.BlockInfo{
+						Height:    uint32(50),
+						Timestamp: constants.TimeTen,
+					},
+				}
+				mck.On("PreviousBlockInfo", mock.Anything, mock.Anything).Return(res, nil)
+
+				// Subaccount.
+				res2 := &satypes.QuerySubaccountAllResponse{
+					Subaccount: []satypes.Subaccount{
+						carlWithBtcCollateral,
+					},
+				}
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(res2, nil)
+
+				// Market prices.
+				res3 := &pricestypes.QueryAllMarketPricesResponse{
+					MarketPrices: constants.TestMarketPrices,
+				}
+				mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(res3, nil)
+
+				// Perpetuals.
+				res4 := &perptypes.QueryAllPerpetualsResponse{
+					Perpetual: []perptypes.Perpetual{
+						constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+					},
+				}
+				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
+
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
+				// Liquidity tiers.
+				res5 := &perptypes.QueryAllLiquidityTiersResponse{
+					LiquidityTiers: constants.LiquidityTiers,
+				}
+				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
+
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+						*constants.BtcUsd,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
+				// Sends liquidatable subaccount ids to the server.
+				req := &api.LiquidateSubaccountsRequest{
+					BlockHeight:               uint32(50),
+					LiquidatableSubaccountIds: []satypes.SubaccountId{},
+					NegativeTncSubaccountIds:  []satypes.SubaccountId{},
+					SubaccountOpenPositionInfo: []clobtypes.SubaccountOpenPositionInfo{
+						{
+							PerpetualId:                 0,
+							SubaccountsWithLongPosition: []satypes.SubaccountId{},
+							SubaccountsWithShortPosition: []satypes.SubaccountId{
+								constants.Carl_Num0,
+							},
+						},
+					},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req).Return(response3, nil)
+			},
+		},
+		"Subaccount without open positions with borrowed USDC can have negative TNC": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				// Block height.
+				res := &blocktimetypes.QueryPreviousBlockInfoResponse{
+					Info: &blocktimetypes.BlockInfo{
+						Height:    uint32(50),
+						Timestamp: constants.TimeTen,
+					},
+				}
+				mck.On("PreviousBlockInfo", mock.Anything, mock.Anything).Return(res, nil)
+
+				// Subaccount.
+				res2 := &satypes.QuerySubaccountAllResponse{
+					Subaccount: []satypes.Subaccount{
+						aliceWithBorrowedUsdc,
+					},
+				}
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(res2, nil)
+
+				// Market prices.
+				res3 := &pricestypes.QueryAllMarketPricesResponse{
+					MarketPrices: constants.TestMarketPrices,
+				}
+				mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(res3, nil)
+
+				// Perpetuals.
+				res4 := &perptypes.QueryAllPerpetualsResponse{
+					Perpetual: []perptypes.Perpetual{
+						constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+					},
+				}
+				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
+
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{},
+					nil,
+				)
+
+				// Liquidity tiers.
+				res5 := &perptypes.QueryAllLiquidityTiersResponse{
+					LiquidityTiers: constants.LiquidityTiers,
+				}
+				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
+
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+						*constants.BtcUsd,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
+				// Sends liquidatable subaccount ids to the server.
+				req := &api.LiquidateSubaccountsRequest{
+					BlockHeight:               uint32(50),
+					LiquidatableSubaccountIds: []satypes.SubaccountId{},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{
+						constants.Alice_Num0,
+					},
+					SubaccountOpenPositionInfo: []clobtypes.SubaccountOpenPositionInfo{},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req).Return(response3, nil)
+			},
+		},
+		"Liquidatable subaccounts are determined at the mark price": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				// Block height.
+				res := &blocktimetypes.QueryPreviousBlockInfoResponse{
+					Info: &blocktimetypes.BlockInfo{
+						Height:    uint32(50),
+						Timestamp: constants.TimeTen,
+					},
+				}
+				mck.On("PreviousBlockInfo", mock.Anything, mock.Anything).Return(res, nil)
+
+				// Subaccount. Carl is well collateralized at the oracle price, but not at the mark price.
+				res2 := &satypes.QuerySubaccountAllResponse{
+					Subaccount: []satypes.Subaccount{
+						constants.Carl_Num0_1BTC_Short_55000USD,
+					},
+				}
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(res2, nil)
+
+				// Market prices.
+				res3 := &pricestypes.QueryAllMarketPricesResponse{
+					MarketPrices: constants.TestMarketPrices,
+				}
+				mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(res3, nil)
+
+				// Perpetuals.
+				res4 := &perptypes.QueryAllPerpetualsResponse{
+					Perpetual: []perptypes.Perpetual{
+						btcWithMarkPrice,
+					},
+				}
+				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
+
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{
+						MarkPrices: []perptypes.MarkPrice{btcMarkPrice},
+					},
+					nil,
+				)
+
+				// Liquidity tiers.
+				res5 := &perptypes.QueryAllLiquidityTiersResponse{
+					LiquidityTiers: constants.LiquidityTiers,
+				}
+				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
+
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
+				// Sends liquidatable subaccount ids to the server.
+				req := &api.LiquidateSubaccountsRequest{
+					BlockHeight: uint32(50),
+					LiquidatableSubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{},
+					SubaccountOpenPositionInfo: []clobtypes.SubaccountOpenPositionInfo{
+						{
+							PerpetualId:                 0,
+							SubaccountsWithLongPosition: []satypes.SubaccountId{},
+							SubaccountsWithShortPosition: []satypes.SubaccountId{
+								constants.Carl_Num0,
+							},
+						},
+					},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req).Return(response3, nil)
+			},
+		},
+		"Negative tnc subaccounts are determined at the oracle price": {
+			setupMocks: func(ctx context.Context, mck *mocks.QueryClient) {
+				// Block height.
+				res := &blocktimetypes.QueryPreviousBlockInfoResponse{
+					Info: &blocktimetypes.BlockInfo{
+						Height:    uint32(50),
+						Timestamp: constants.TimeTen,
+					},
+				}
+				mck.On("PreviousBlockInfo", mock.Anything, mock.Anything).Return(res, nil)
+
+				// Subaccount.
+				res2 := &satypes.QuerySubaccountAllResponse{
+					Subaccount: []satypes.Subaccount{
+						carlWithPositiveTncAtOraclePrice,
+					},
+				}
+				mck.On("SubaccountAll", mock.Anything, mock.Anything).Return(res2, nil)
+
+				// Market prices.
+				res3 := &pricestypes.QueryAllMarketPricesResponse{
+					MarketPrices: constants.TestMarketPrices,
+				}
+				mck.On("AllMarketPrices", mock.Anything, mock.Anything).Return(res3, nil)
+
+				// Perpetuals.
+				res4 := &perptypes.QueryAllPerpetualsResponse{
+					Perpetual: []perptypes.Perpetual{
+						btcWithMarkPrice,
+					},
+				}
+				mck.On("AllPerpetuals", mock.Anything, mock.Anything).Return(res4, nil)
+
+				// Mark prices.
+				mck.On("AllMarkPrices", mock.Anything, mock.Anything).Return(
+					&perptypes.QueryAllMarkPricesResponse{
+						MarkPrices: []perptypes.MarkPrice{btcMarkPrice},
+					},
+					nil,
+				)
+
+				// Liquidity tiers.
+				res5 := &perptypes.QueryAllLiquidityTiersResponse{
+					LiquidityTiers: constants.LiquidityTiers,
+				}
+				mck.On("AllLiquidityTiers", mock.Anything, mock.Anything).Return(res5, nil)
+
+				// Assets.
+				res6 := &assetstypes.QueryAllAssetsResponse{
+					Asset: []assetstypes.Asset{
+						*constants.Usdc,
+					},
+				}
+				mck.On("AllAssets", mock.Anything, mock.Anything).Return(res6, nil)
+
+				// Sends liquidatable subaccount ids to the server.
+				req := &api.LiquidateSubaccountsRequest{
+					BlockHeight: uint32(50),
+					LiquidatableSubaccountIds: []satypes.SubaccountId{
+						constants.Carl_Num0,
+					},
+					NegativeTncSubaccountIds: []satypes.SubaccountId{},
+					SubaccountOpenPositionInfo: []clobtypes.SubaccountOpenPositionInfo{
+						{
+							PerpetualId:                 0,
+							SubaccountsWithLongPosition: []satypes.SubaccountId{},
+							SubaccountsWithShortPosition: []satypes.SubaccountId{
+								constants.Carl_Num0,
+							},
+						},
+					},
+				}
+				response3 := &api.LiquidateSubaccountsResponse{}
+				mck.On("LiquidateSubaccounts", ctx, req).Return(response3, nil)
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -718,6 +1199,7 @@ func TestRunLiquidationDaemonTaskLoop(t *testing.T) {
 			c.PerpetualsQueryClient = queryClientMock
 			c.PricesQueryClient = queryClientMock
 			c.BlocktimeQueryClient = queryClientMock
+			c.AssetsQueryClient = queryClientMock
 
 			err := s.RunLiquidationDaemonTaskLoop(
 				grpc.Ctx,
@@ -0,0 +1,16 @@
+package events
+
+// NewUpdateAssetEvent creates a UpdateAssetEventV1 representing an update of an asset.
+func NewUpdateAssetEvent(
+	id uint32,
+	hasMarket bool,
+	marketId uint32,
+	haircutPpm uint32,
+) *UpdateAssetEventV1 {
+	return &UpdateAssetEventV1{
+		Id:         id,
+		HasMarket:  hasMarket,
+		MarketId:   marketId,
+		HaircutPpm: haircutPpm,
+	}
+}
@@ -0,0 +1,23 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateAssetEvent_Success(t *testing.T) {
+	updateAssetEvent := NewUpdateAssetEvent(
+		1,
+		true,
+		2,
+		100_000,
+	)
+	expectedUpdateAssetEventProto := &UpdateAssetEventV1{
+		Id:         1,
+		HasMarket:  true,
+		MarketId:   2,
+		HaircutPpm: 100_000,
+	}
+	require.Equal(t, expectedUpdateAssetEventProto, updateAssetEvent)
+}
@@ -19,6 +19,9 @@ const (
 	SubtypeDeleveraging       = "deleveraging"
 	SubtypeTradingReward      = "trading_reward"
 	SubtypeOpenInterestUpdate = "open_interest_update"
+	SubtypeSpotMarket         = "spot_market"
+	SubtypeMarkPriceUpdate    = "mark_price_update"
+	SubtypeUpdateAsset        = "update_asset"
 )
 
 const (
@@ -37,6 +40,9 @@ const (
 	DeleveragingEventVersion     uint32 = 1
 	TradingRewardVersion         uint32 = 1
 	OpenInterestUpdateVersion    uint32 = 1
+	SpotMarketEventVersion       uint32 = 1
+	MarkPriceUpdateEventVersion  uint32 = 1
+	UpdateAssetEventVersion      uint32 = 1
 )
 
 var OnChainEventSubtypes = []string{
@@ -53,4 +59,6 @@ var OnChainEventSubtypes = []string{
 	SubtypeUpdateClobPair,
 	SubtypeDeleveraging,
 	SubtypeTradingReward,
+	SubtypeSpotMarket,
+	SubtypeUpdateAsset,
 }
@@ -512,13 +512,11 @@ func (m *MarketModifyEventV1) GetBase() *MarketBaseEventV1 {
 
 // SourceOfFunds is the source of funds in a transfer event.
 type SourceOfFunds struct {
-	//	one of below
-	//
+	//  one of below
 	// - a subaccount ID
 	// - a wallet address
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*SourceOfFunds_SubaccountId
 	//	*SourceOfFunds_Address
 	Source isSourceOfFunds_Source `protobuf_oneof:"source"`
@@ -706,7 +704,6 @@ type OrderFillEventV1 struct {
 	// The type of order fill this event represents.
 	//
 	// Types that are valid to be assigned to TakerOrder:
-	//
 	//	*OrderFillEventV1_Order
 	//	*OrderFillEventV1_LiquidationOrder
 	TakerOrder isOrderFillEventV1_TakerOrder `protobuf_oneof:"taker_order"`
@@ -1122,13 +1119,14 @@ type StatefulOrderEventV1 struct {
 	// The type of event that this StatefulOrderEvent contains.
 	//
 	// Types that are valid to be assigned to Event:
-	//
 	//	*StatefulOrderEventV1_OrderPlace
 	//	*StatefulOrderEventV1_OrderRemoval
 	//	*StatefulOrderEventV1_ConditionalOrderPlacement
 	//	*StatefulOrderEventV1_ConditionalOrderTriggered
 	//	*StatefulOrderEventV1_LongTermOrderPlacement
 	//	*StatefulOrderEventV1_OrderReplace
+	//	*StatefulOrderEventV1_ConditionalOrderTriggerUpdated
+	//	*StatefulOrderEventV1_TwapOrderPlacement
 	Event isStatefulOrderEventV1_Event `protobuf_oneof:"event"`
 }
 
@@ -1189,13 +1187,21 @@ type StatefulOrderEventV1_LongTermOrderPlacement struct {
 type StatefulOrderEventV1_OrderReplace struct {
 	OrderReplace *StatefulOrderEventV1_LongTermOrderReplacementV1 `protobuf:"bytes,8,opt,name=order_replace,json=orderReplace,proto3,oneof" json:"order_replace,omitempty"`
 }
+type StatefulOrderEventV1_ConditionalOrderTriggerUpdated struct {
+	ConditionalOrderTriggerUpdated *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 `protobuf:"bytes,9,opt,name=conditional_order_trigger_updated,json=conditionalOrderTriggerUpdated,proto3,oneof" json:"conditional_order_trigger_updated,omitempty"`
+}
+type StatefulOrderEventV1_TwapOrderPlacement struct {
+	TwapOrderPlacement *StatefulOrderEventV1_TwapOrderPlacementV1 `protobuf:"bytes,10,opt,name=twap_order_placement,json=twapOrderPlacement,proto3,oneof" json:"twap_order_placement,omitempty"`
+}
 
-func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                {}
-func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()              {}
-func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event() {}
-func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()    {}
-func (*StatefulOrderEventV1_OrderReplace) isStatefulOrderEventV1_Event()              {}
+func (*StatefulOrderEventV1_OrderPlace) isStatefulOrderEventV1_Event()                     {}
+func (*StatefulOrderEventV1_OrderRemoval) isStatefulOrderEventV1_Event()                   {}
+func (*StatefulOrderEventV1_ConditionalOrderPlacement) isStatefulOrderEventV1_Event()      {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggered) isStatefulOrderEventV1_Event()      {}
+func (*StatefulOrderEventV1_LongTermOrderPlacement) isStatefulOrderEventV1_Event()         {}
+func (*StatefulOrderEventV1_OrderReplace) isStatefulOrderEventV1_Event()                   {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdated) isStatefulOrderEventV1_Event() {}
+func (*StatefulOrderEventV1_TwapOrderPlacement) isStatefulOrderEventV1_Event()             {}
 
 func (m *StatefulOrderEventV1) GetEvent() isStatefulOrderEventV1_Event {
 	if m != nil {
@@ -1247,6 +1253,20 @@ func (m *StatefulOrderEventV1) GetOrderReplace() *StatefulOrderEventV1_LongTermO
 	return nil
 }
 
+func (m *StatefulOrderEventV1) GetConditionalOrderTriggerUpdated() *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_ConditionalOrderTriggerUpdated); ok {
+		return x.ConditionalOrderTriggerUpdated
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1) GetTwapOrderPlacement() *StatefulOrderEventV1_TwapOrderPlacementV1 {
+	if x, ok := m.GetEvent().(*StatefulOrderEventV1_TwapOrderPlacement); ok {
+		return x.TwapOrderPlacement
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1256,6 +1276,8 @@ func (*StatefulOrderEventV1) XXX_OneofWrappers() []interface{} {
 		(*StatefulOrderEventV1_ConditionalOrderTriggered)(nil),
 		(*StatefulOrderEventV1_LongTermOrderPlacement)(nil),
 		(*StatefulOrderEventV1_OrderReplace)(nil),
+		(*StatefulOrderEventV1_ConditionalOrderTriggerUpdated)(nil),
+		(*StatefulOrderEventV1_TwapOrderPlacement)(nil),
 	}
 }
 
@@ -1565,6 +1587,115 @@ func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) GetOrder() *types.Inde
 	return nil
 }
 
+// A conditional order trigger update event contains an order id and the new
+// trigger price of the order. It is emitted when the trigger price of an
+// untriggered trailing stop order moves with the oracle price.
+type StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 struct {
+	OrderId                         *types.IndexerOrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ConditionalOrderTriggerSubticks uint64                `protobuf:"varint,2,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Reset() {
+	*m = StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{13, 6}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) GetOrderId() *types.IndexerOrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) GetConditionalOrderTriggerSubticks() uint64 {
+	if m != nil {
+		return m.ConditionalOrderTriggerSubticks
+	}
+	return 0
+}
+
+// A TWAP order placement contains an order. The suborders generated by the
+// TWAP order are included in order fill events when they are matched.
+type StatefulOrderEventV1_TwapOrderPlacementV1 struct {
+	Order *types.IndexerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) Reset() {
+	*m = StatefulOrderEventV1_TwapOrderPlacementV1{}
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) String() string {
+	return proto.CompactTextString(m)
+}
+func (*StatefulOrderEventV1_TwapOrderPlacementV1) ProtoMessage() {}
+func (*StatefulOrderEventV1_TwapOrderPlacementV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{13, 7}
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatefulOrderEventV1_TwapOrderPlacementV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatefulOrderEventV1_TwapOrderPlacementV1.Merge(m, src)
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatefulOrderEventV1_TwapOrderPlacementV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatefulOrderEventV1_TwapOrderPlacementV1 proto.InternalMessageInfo
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) GetOrder() *types.IndexerOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
 // AssetCreateEventV1 message contains all the information about an new Asset on
 // the dYdX chain.
 type AssetCreateEventV1 struct {
@@ -2419,6 +2550,118 @@ func (m *OpenInterestUpdate) GetPerpetualId() uint32 {
 	return 0
 }
 
+// MarkPriceUpdateEventV1 is used for mark price update events.
+type MarkPriceUpdateEventV1 struct {
+	// The list of all mark price updates in the block.
+	MarkPriceUpdates []*MarkPriceUpdate `protobuf:"bytes,1,rep,name=mark_price_updates,json=markPriceUpdates,proto3" json:"mark_price_updates,omitempty"`
+}
+
+func (m *MarkPriceUpdateEventV1) Reset()         { *m = MarkPriceUpdateEventV1{} }
+func (m *MarkPriceUpdateEventV1) String() string { return proto.CompactTextString(m) }
+func (*MarkPriceUpdateEventV1) ProtoMessage()    {}
+func (*MarkPriceUpdateEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{24}
+}
+func (m *MarkPriceUpdateEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkPriceUpdateEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkPriceUpdateEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkPriceUpdateEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPriceUpdateEventV1.Merge(m, src)
+}
+func (m *MarkPriceUpdateEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkPriceUpdateEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPriceUpdateEventV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPriceUpdateEventV1 proto.InternalMessageInfo
+
+func (m *MarkPriceUpdateEventV1) GetMarkPriceUpdates() []*MarkPriceUpdate {
+	if m != nil {
+		return m.MarkPriceUpdates
+	}
+	return nil
+}
+
+// MarkPriceUpdate contains a single mark price update for a perpetual.
+type MarkPriceUpdate struct {
+	// The ID of the perpetual market.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The new mark price of the perpetual market, in the same exponent as the
+	// oracle price of the market.
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// The smoothed basis of the mark price relative to the oracle price, in
+	// parts-per-million.
+	BasisPpm int32 `protobuf:"zigzag32,3,opt,name=basis_ppm,json=basisPpm,proto3" json:"basis_ppm,omitempty"`
+}
+
+func (m *MarkPriceUpdate) Reset()         { *m = MarkPriceUpdate{} }
+func (m *MarkPriceUpdate) String() string { return proto.CompactTextString(m) }
+func (*MarkPriceUpdate) ProtoMessage()    {}
+func (*MarkPriceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{25}
+}
+func (m *MarkPriceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkPriceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkPriceUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkPriceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPriceUpdate.Merge(m, src)
+}
+func (m *MarkPriceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkPriceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPriceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPriceUpdate proto.InternalMessageInfo
+
+func (m *MarkPriceUpdate) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MarkPriceUpdate) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *MarkPriceUpdate) GetBasisPpm() int32 {
+	if m != nil {
+		return m.BasisPpm
+	}
+	return 0
+}
+
 // LiquidationEventV2 message contains all the information needed to update
 // the liquidity tiers. It contains all the fields from V1 along with the
 // open interest caps.
@@ -2449,7 +2692,7 @@ func (m *LiquidityTierUpsertEventV2) Reset()         { *m = LiquidityTierUpsertE
 func (m *LiquidityTierUpsertEventV2) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTierUpsertEventV2) ProtoMessage()    {}
 func (*LiquidityTierUpsertEventV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6331dfb59c6fd2bb, []int{24}
+	return fileDescriptor_6331dfb59c6fd2bb, []int{26}
 }
 func (m *LiquidityTierUpsertEventV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,238 +2771,446 @@ func (m *LiquidityTierUpsertEventV2) GetOpenInterestUpperCap() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
-	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
-	proto.RegisterType((*FundingEventV1)(nil), "dydxprotocol.indexer.events.FundingEventV1")
-	proto.RegisterType((*MarketEventV1)(nil), "dydxprotocol.indexer.events.MarketEventV1")
-	proto.RegisterType((*MarketPriceUpdateEventV1)(nil), "dydxprotocol.indexer.events.MarketPriceUpdateEventV1")
-	proto.RegisterType((*MarketBaseEventV1)(nil), "dydxprotocol.indexer.events.MarketBaseEventV1")
-	proto.RegisterType((*MarketCreateEventV1)(nil), "dydxprotocol.indexer.events.MarketCreateEventV1")
-	proto.RegisterType((*MarketModifyEventV1)(nil), "dydxprotocol.indexer.events.MarketModifyEventV1")
-	proto.RegisterType((*SourceOfFunds)(nil), "dydxprotocol.indexer.events.SourceOfFunds")
-	proto.RegisterType((*TransferEventV1)(nil), "dydxprotocol.indexer.events.TransferEventV1")
-	proto.RegisterType((*OrderFillEventV1)(nil), "dydxprotocol.indexer.events.OrderFillEventV1")
-	proto.RegisterType((*DeleveragingEventV1)(nil), "dydxprotocol.indexer.events.DeleveragingEventV1")
-	proto.RegisterType((*LiquidationOrderV1)(nil), "dydxprotocol.indexer.events.LiquidationOrderV1")
-	proto.RegisterType((*SubaccountUpdateEventV1)(nil), "dydxprotocol.indexer.events.SubaccountUpdateEventV1")
-	proto.RegisterType((*StatefulOrderEventV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1")
-	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderPlacementV1")
-	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderRemovalV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderRemovalV1")
-	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
-	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
-	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
-	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderReplacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderReplacementV1")
-	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
-	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
-	proto.RegisterType((*PerpetualMarketCreateEventV2)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV2")
-	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
-	proto.RegisterType((*UpdateClobPairEventV1)(nil), "dydxprotocol.indexer.events.UpdateClobPairEventV1")
-	proto.RegisterType((*UpdatePerpetualEventV1)(nil), "dydxprotocol.indexer.events.UpdatePerpetualEventV1")
-	proto.RegisterType((*TradingRewardsEventV1)(nil), "dydxprotocol.indexer.events.TradingRewardsEventV1")
-	proto.RegisterType((*AddressTradingReward)(nil), "dydxprotocol.indexer.events.AddressTradingReward")
-	proto.RegisterType((*OpenInterestUpdateEventV1)(nil), "dydxprotocol.indexer.events.OpenInterestUpdateEventV1")
-	proto.RegisterType((*OpenInterestUpdate)(nil), "dydxprotocol.indexer.events.OpenInterestUpdate")
-	proto.RegisterType((*LiquidityTierUpsertEventV2)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV2")
+// SpotMarketCreateEventV1 message contains all the information about a
+// new Spot Market on the dYdX chain.
+type SpotMarketCreateEventV1 struct {
+	// Unique clob pair Id associated with this spot market
+	// Defined in clob.clob_pair
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Id of the asset being bought and sold.
+	// Defined in clob.clob_pair
+	BaseAssetId uint32 `protobuf:"varint,2,opt,name=base_asset_id,json=baseAssetId,proto3" json:"base_asset_id,omitempty"`
+	// Id of the asset the base asset is priced in.
+	// Defined in clob.clob_pair
+	QuoteAssetId uint32 `protobuf:"varint,3,opt,name=quote_asset_id,json=quoteAssetId,proto3" json:"quote_asset_id,omitempty"`
+	// Status of the CLOB
+	Status types.ClobPairStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dydxprotocol.indexer.protocol.v1.ClobPairStatus" json:"status,omitempty"`
+	// `10^Exponent` gives the number of QuoteQuantums traded per BaseQuantum
+	// per Subtick.
+	// Defined in clob.clob_pair
+	QuantumConversionExponent int32 `protobuf:"zigzag32,5,opt,name=quantum_conversion_exponent,json=quantumConversionExponent,proto3" json:"quantum_conversion_exponent,omitempty"`
+	// Defines the tick size of the orderbook by defining how many subticks
+	// are in one tick. That is, the subticks of any valid order must be a
+	// multiple of this value.
+	// Defined in clob.clob_pair
+	SubticksPerTick uint32 `protobuf:"varint,6,opt,name=subticks_per_tick,json=subticksPerTick,proto3" json:"subticks_per_tick,omitempty"`
+	// Minimum increment in the size of orders on the CLOB, in base quantums.
+	// Defined in clob.clob_pair
+	StepBaseQuantums uint64 `protobuf:"varint,7,opt,name=step_base_quantums,json=stepBaseQuantums,proto3" json:"step_base_quantums,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dydxprotocol/indexer/events/events.proto", fileDescriptor_6331dfb59c6fd2bb)
+func (m *SpotMarketCreateEventV1) Reset()         { *m = SpotMarketCreateEventV1{} }
+func (m *SpotMarketCreateEventV1) String() string { return proto.CompactTextString(m) }
+func (*SpotMarketCreateEventV1) ProtoMessage()    {}
+func (*SpotMarketCreateEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{27}
 }
-
-var fileDescriptor_6331dfb59c6fd2bb = []byte{
-	// 2305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x8f, 0xed, 0x8e, 0xe3, 0x3c, 0xc7, 0x19, 0xa7, 0xc6, 0xc9, 0x38, 0x09, 0x64, 0x86, 0x96,
-	0x90, 0x46, 0xb3, 0xbb, 0xce, 0x24, 0xec, 0xa2, 0xd5, 0x1e, 0x10, 0x71, 0x3e, 0x36, 0x8e, 0x92,
-	0x8c, 0xb7, 0x93, 0xcc, 0xee, 0x0e, 0x68, 0x9b, 0x4a, 0x77, 0xd9, 0x29, 0xa5, 0xbf, 0xa6, 0xab,
-	0x9d, 0x99, 0x8c, 0x84, 0xc4, 0x0d, 0x0e, 0x48, 0x20, 0x21, 0x0e, 0x1c, 0x90, 0xb8, 0xc0, 0x01,
-	0x89, 0x03, 0x12, 0x57, 0x0e, 0x88, 0xcb, 0xde, 0x18, 0x71, 0x01, 0x71, 0x58, 0xa1, 0x99, 0x03,
-	0xff, 0x05, 0x42, 0xf5, 0xd1, 0xed, 0x6f, 0x8f, 0x67, 0x92, 0x95, 0x10, 0xe2, 0x64, 0xd7, 0x7b,
-	0xf5, 0x7e, 0xef, 0xd5, 0x7b, 0xaf, 0xaa, 0x5e, 0xbd, 0x86, 0xbb, 0xf6, 0xa5, 0xfd, 0x34, 0x08,
-	0xfd, 0xc8, 0xb7, 0x7c, 0x67, 0x95, 0x7a, 0x36, 0x79, 0x4a, 0xc2, 0x55, 0x72, 0x41, 0xbc, 0x88,
-	0xa9, 0x9f, 0x8a, 0x60, 0xa3, 0xe5, 0xce, 0x99, 0x15, 0x35, 0xb3, 0x22, 0xa7, 0x2c, 0x2d, 0x5a,
-	0x3e, 0x73, 0x7d, 0x66, 0x0a, 0xfe, 0xaa, 0x1c, 0x48, 0xb9, 0xa5, 0x52, 0xd3, 0x6f, 0xfa, 0x92,
-	0xce, 0xff, 0x29, 0xea, 0xfd, 0x81, 0x7a, 0xd9, 0x19, 0x0e, 0x89, 0xbd, 0x1a, 0x12, 0xd7, 0xbf,
-	0xc0, 0x8e, 0x19, 0x12, 0xcc, 0x7c, 0x4f, 0x49, 0xbc, 0x35, 0x50, 0x22, 0x21, 0x5c, 0xac, 0xad,
-	0x5a, 0x8e, 0x7f, 0x3a, 0x12, 0xbe, 0x73, 0x72, 0x40, 0xc2, 0x80, 0x44, 0x2d, 0xec, 0x28, 0x89,
-	0xb5, 0x57, 0x4a, 0xb0, 0xd6, 0x29, 0xb6, 0x2c, 0xbf, 0xe5, 0x45, 0x52, 0x44, 0xff, 0x4b, 0x0a,
-	0x6e, 0xec, 0xb4, 0x3c, 0x9b, 0x7a, 0xcd, 0x93, 0xc0, 0xc6, 0x11, 0x79, 0xb8, 0x86, 0xbe, 0x06,
-	0x33, 0x09, 0xb2, 0x49, 0xed, 0x72, 0xea, 0x4e, 0xea, 0x6e, 0xc1, 0xc8, 0x27, 0xb4, 0x9a, 0x8d,
-	0xee, 0xc1, 0x5c, 0x43, 0x4a, 0x99, 0x17, 0xd8, 0x69, 0x11, 0x33, 0x08, 0xdc, 0x72, 0xfa, 0x4e,
-	0xea, 0xee, 0xa4, 0x71, 0x43, 0x31, 0x1e, 0x72, 0x7a, 0x3d, 0x70, 0x91, 0x0b, 0x85, 0x78, 0xae,
-	0x30, 0xa9, 0x9c, 0xb9, 0x93, 0xba, 0x3b, 0x53, 0xdd, 0xfd, 0xfc, 0x8b, 0xdb, 0x13, 0xff, 0xf8,
-	0xe2, 0xf6, 0xb7, 0x9b, 0x34, 0x3a, 0x6b, 0x9d, 0x56, 0x2c, 0xdf, 0x5d, 0xed, 0xb2, 0xff, 0xe2,
-	0xdd, 0x77, 0xac, 0x33, 0x4c, 0xbd, 0xf6, 0x02, 0xec, 0xe8, 0x32, 0x20, 0xac, 0x72, 0x44, 0x42,
-	0x8a, 0x1d, 0xfa, 0x0c, 0x9f, 0x3a, 0xa4, 0xe6, 0x45, 0xc6, 0x8c, 0x82, 0xaf, 0x71, 0x74, 0xfd,
-	0x67, 0x69, 0x98, 0x55, 0x2b, 0xda, 0xe6, 0x81, 0x7d, 0xb8, 0x86, 0xf6, 0x61, 0xaa, 0x25, 0x16,
-	0xc7, 0xca, 0xa9, 0x3b, 0x99, 0xbb, 0xf9, 0xf5, 0xb7, 0x2b, 0x23, 0x12, 0xa1, 0xd2, 0xe3, 0x8f,
-	0xaa, 0xc6, 0x2d, 0x35, 0x62, 0x08, 0xb4, 0x05, 0x1a, 0xb7, 0x43, 0x2c, 0x77, 0x76, 0xfd, 0xfe,
-	0x38, 0x50, 0xca, 0x90, 0xca, 0xf1, 0x65, 0x40, 0x0c, 0x21, 0xad, 0xbb, 0xa0, 0xf1, 0x11, 0x2a,
-	0x41, 0xf1, 0xf8, 0xd3, 0xfa, 0xb6, 0x79, 0x72, 0x78, 0x54, 0xdf, 0xde, 0xac, 0xed, 0xd4, 0xb6,
-	0xb7, 0x8a, 0x13, 0xe8, 0x16, 0xdc, 0x14, 0xd4, 0xba, 0xb1, 0x7d, 0x50, 0x3b, 0x39, 0x30, 0x8f,
-	0x36, 0x0e, 0xea, 0xfb, 0xdb, 0xc5, 0x14, 0xba, 0x0d, 0xcb, 0x82, 0xb1, 0x73, 0x72, 0xb8, 0x55,
-	0x3b, 0xfc, 0xd0, 0x34, 0x36, 0x8e, 0xb7, 0xcd, 0x8d, 0xc3, 0x2d, 0xb3, 0x76, 0xb8, 0xb5, 0xfd,
-	0x49, 0x31, 0x8d, 0xe6, 0x61, 0xae, 0x4b, 0xf2, 0xe1, 0x83, 0xe3, 0xed, 0x62, 0x46, 0xff, 0x73,
-	0x1a, 0x0a, 0x07, 0x38, 0x3c, 0x27, 0x51, 0xec, 0x94, 0x65, 0x98, 0x76, 0x05, 0xa1, 0x1d, 0xe2,
-	0x9c, 0x24, 0xd4, 0x6c, 0xf4, 0x08, 0x66, 0x82, 0x90, 0x5a, 0xc4, 0x94, 0x8b, 0x16, 0x6b, 0xcd,
-	0xaf, 0xbf, 0x37, 0x72, 0xad, 0x12, 0xbe, 0xce, 0xc5, 0xa4, 0xeb, 0x94, 0xa6, 0xdd, 0x09, 0x23,
-	0x1f, 0xb4, 0xa9, 0xe8, 0x63, 0x28, 0x28, 0xc5, 0x56, 0x48, 0x38, 0x78, 0x46, 0x80, 0xdf, 0x1f,
-	0x03, 0x7c, 0x53, 0x08, 0xb4, 0x71, 0x67, 0xdc, 0x0e, 0x72, 0x07, 0xb0, 0xeb, 0xdb, 0xb4, 0x71,
-	0x59, 0xd6, 0xc6, 0x06, 0x3e, 0x10, 0x02, 0x7d, 0xc0, 0x92, 0x5c, 0x9d, 0x82, 0x49, 0x31, 0x5b,
-	0xdf, 0x83, 0xf2, 0xb0, 0x55, 0xa2, 0x0a, 0xdc, 0x94, 0x2e, 0x7b, 0x42, 0xa3, 0x33, 0x93, 0x3c,
-	0x0d, 0x7c, 0x8f, 0x78, 0x91, 0xf0, 0xac, 0x66, 0xcc, 0x09, 0xd6, 0xc7, 0x34, 0x3a, 0xdb, 0x56,
-	0x0c, 0xfd, 0x13, 0x98, 0x93, 0x58, 0x55, 0xcc, 0x12, 0x10, 0x04, 0x5a, 0x80, 0x69, 0x28, 0xa4,
-	0xa6, 0x0d, 0xf1, 0x1f, 0xad, 0x42, 0xc9, 0xa5, 0x9e, 0x29, 0xc1, 0xad, 0x33, 0xec, 0x35, 0xdb,
-	0xdb, 0xad, 0x60, 0xcc, 0xb9, 0xd4, 0x13, 0xd6, 0x6c, 0x0a, 0x4e, 0x3d, 0x70, 0xf5, 0x16, 0xdc,
-	0x1c, 0xe0, 0x2e, 0x54, 0x05, 0xed, 0x14, 0x33, 0x22, 0xb0, 0xf3, 0xeb, 0x95, 0x31, 0xbc, 0xd2,
-	0x61, 0x99, 0x21, 0x64, 0xd1, 0x12, 0xe4, 0x92, 0x95, 0x71, 0xfd, 0x73, 0x46, 0x32, 0xd6, 0x3f,
-	0x8d, 0xd5, 0x76, 0x39, 0xf3, 0x3a, 0xd4, 0xea, 0xbf, 0x4b, 0x41, 0xe1, 0xc8, 0x6f, 0x85, 0x16,
-	0x79, 0xd0, 0xe0, 0x5b, 0x8a, 0xa1, 0xef, 0x42, 0xa1, 0x7d, 0x96, 0xc5, 0x19, 0x3c, 0x34, 0x43,
-	0x13, 0xc2, 0xc5, 0x5a, 0xa5, 0x26, 0x69, 0x47, 0x89, 0x74, 0xcd, 0xe6, 0x01, 0x67, 0x1d, 0x63,
-	0xf4, 0x2e, 0x4c, 0x61, 0xdb, 0x0e, 0x09, 0x63, 0x62, 0x95, 0xd3, 0xd5, 0xf2, 0x5f, 0xff, 0xf0,
-	0x4e, 0x49, 0x5d, 0x09, 0x1b, 0x92, 0x73, 0x14, 0x85, 0xd4, 0x6b, 0xee, 0x4e, 0x18, 0xf1, 0xd4,
-	0x6a, 0x0e, 0xb2, 0x4c, 0x18, 0xa9, 0xff, 0x36, 0x03, 0x37, 0x8e, 0x43, 0xec, 0xb1, 0x06, 0x09,
-	0x63, 0x3f, 0x34, 0xa1, 0xc4, 0x88, 0x67, 0x93, 0xd0, 0xbc, 0x3e, 0xc3, 0x0d, 0x24, 0x21, 0x3b,
-	0x69, 0xc8, 0x85, 0x5b, 0x21, 0xb1, 0x68, 0x40, 0x89, 0x17, 0xf5, 0xe8, 0x4a, 0x5f, 0x45, 0xd7,
-	0x7c, 0x82, 0xda, 0xa5, 0x6e, 0x11, 0x72, 0x98, 0x31, 0x79, 0x8c, 0x64, 0x44, 0x4a, 0x4e, 0x89,
-	0x71, 0xcd, 0x46, 0x0b, 0x90, 0xc5, 0x2e, 0x9f, 0x26, 0x76, 0xa2, 0x66, 0xa8, 0x11, 0xaa, 0x42,
-	0x56, 0xda, 0x5d, 0x9e, 0x14, 0x06, 0xdd, 0x1b, 0x99, 0x14, 0x5d, 0x81, 0x37, 0x94, 0x24, 0xda,
-	0x85, 0xe9, 0xc4, 0x9e, 0x72, 0xf6, 0xb5, 0x61, 0xda, 0xc2, 0xfa, 0xdf, 0x32, 0x50, 0x7c, 0x10,
-	0xda, 0x24, 0xdc, 0xa1, 0x8e, 0x13, 0x47, 0xeb, 0x04, 0xf2, 0x2e, 0x3e, 0x27, 0xa1, 0xe9, 0x73,
-	0xce, 0xe8, 0xe4, 0x1d, 0xe0, 0x38, 0x81, 0xa7, 0x2e, 0x0e, 0x10, 0x40, 0x82, 0x82, 0x76, 0x60,
-	0x52, 0x02, 0xa6, 0xdf, 0x04, 0x70, 0x77, 0xc2, 0x90, 0xe2, 0xe8, 0x33, 0x98, 0x73, 0xe8, 0xe3,
-	0x16, 0xb5, 0x71, 0x44, 0x7d, 0x4f, 0x19, 0x29, 0x8f, 0xbb, 0xd5, 0x91, 0x5e, 0xd8, 0x6f, 0x4b,
-	0x09, 0x48, 0x71, 0xda, 0x15, 0x9d, 0x1e, 0x2a, 0xba, 0x0d, 0xf9, 0x06, 0x75, 0x1c, 0x53, 0x85,
-	0x2f, 0x23, 0xc2, 0x07, 0x9c, 0xb4, 0x21, 0x43, 0x28, 0x6e, 0x0f, 0xee, 0x9f, 0x06, 0x21, 0x22,
-	0x8a, 0x88, 0xdf, 0x1e, 0xe7, 0x24, 0xdc, 0x21, 0x84, 0x33, 0xa3, 0x84, 0x99, 0x95, 0xcc, 0x28,
-	0x66, 0xbe, 0x0d, 0x28, 0xf2, 0x23, 0xec, 0x98, 0x1c, 0x8d, 0xd8, 0xa6, 0x90, 0x2a, 0x4f, 0x09,
-	0x0d, 0x45, 0xc1, 0xd9, 0x11, 0x8c, 0x03, 0x4e, 0xef, 0x9b, 0x2d, 0x60, 0xca, 0xb9, 0xbe, 0xd9,
-	0xc7, 0x9c, 0x5e, 0x2d, 0x40, 0x3e, 0x6a, 0x47, 0x4d, 0xff, 0x71, 0x06, 0x6e, 0x6e, 0x11, 0x87,
-	0x5c, 0x90, 0x10, 0x37, 0x3b, 0xea, 0x81, 0xef, 0x00, 0xc4, 0x2b, 0x26, 0x57, 0xdb, 0x80, 0x71,
-	0x88, 0xdb, 0x70, 0x1c, 0xdc, 0x6f, 0x34, 0x18, 0x89, 0x22, 0xea, 0x35, 0xaf, 0xb4, 0xe3, 0x62,
-	0xf0, 0x36, 0x5c, 0x5f, 0x69, 0x96, 0xe9, 0x2f, 0xcd, 0x7a, 0x42, 0xa7, 0xf5, 0x85, 0xee, 0x3e,
-	0x94, 0xa4, 0x4b, 0x1f, 0xb7, 0xfc, 0x88, 0x98, 0x8f, 0x5b, 0xd8, 0x8b, 0x5a, 0x2e, 0x13, 0x51,
-	0xd4, 0x0c, 0xe9, 0xee, 0x8f, 0x38, 0xeb, 0x23, 0xc5, 0x41, 0xf3, 0x90, 0xa5, 0xcc, 0x3c, 0x6d,
-	0x5d, 0x8a, 0x60, 0xe6, 0x8c, 0x49, 0xca, 0xaa, 0xad, 0x4b, 0x7e, 0xe3, 0x51, 0x66, 0x36, 0xa8,
-	0x87, 0x1d, 0x93, 0x1b, 0xe8, 0x10, 0x97, 0x6f, 0xc6, 0x29, 0x31, 0x67, 0x8e, 0xb2, 0x1d, 0xce,
-	0x39, 0x4a, 0x18, 0xfa, 0x8f, 0xd2, 0x80, 0xfa, 0xf3, 0xef, 0xcb, 0x8d, 0xc6, 0x1d, 0x98, 0xe1,
-	0x25, 0xb5, 0xc9, 0x6f, 0xd2, 0xf8, 0x04, 0x2c, 0x18, 0xc0, 0x69, 0x75, 0x4c, 0xc3, 0x9a, 0x3d,
-	0x8e, 0x4b, 0xbf, 0x0a, 0x20, 0x3d, 0xc6, 0xe8, 0x33, 0xa2, 0x3c, 0x3a, 0x2d, 0x28, 0x47, 0xf4,
-	0x19, 0xe9, 0x70, 0xcf, 0x64, 0xa7, 0x7b, 0x96, 0x20, 0xc7, 0x5a, 0xa7, 0x11, 0xb5, 0xce, 0x99,
-	0xf0, 0x9b, 0x66, 0x24, 0x63, 0xfd, 0x5f, 0x69, 0xb8, 0xd5, 0xb6, 0xbc, 0xbb, 0x90, 0x78, 0x74,
-	0x9d, 0x57, 0x5b, 0xcf, 0xc5, 0xf6, 0x0c, 0x96, 0x65, 0x45, 0x67, 0x9b, 0xed, 0x45, 0x07, 0x3e,
-	0xa3, 0x3c, 0x20, 0xac, 0x9c, 0x11, 0xd5, 0xf1, 0x07, 0x63, 0x6b, 0xaa, 0xc7, 0x18, 0x75, 0x05,
-	0x61, 0x2c, 0x2a, 0xf8, 0x3e, 0x0e, 0x43, 0x1e, 0xdc, 0x8a, 0x75, 0xcb, 0x0b, 0xa3, 0xad, 0x57,
-	0x13, 0x7a, 0xbf, 0x39, 0xb6, 0xde, 0x0d, 0x2e, 0x9f, 0xe8, 0x9c, 0x57, 0xb0, 0x5d, 0x54, 0xb6,
-	0xa7, 0xe5, 0xd2, 0xc5, 0x8c, 0xfe, 0xef, 0x3c, 0x94, 0x8e, 0x22, 0x1c, 0x91, 0x46, 0xcb, 0x11,
-	0x19, 0x17, 0xbb, 0xf9, 0x31, 0xe4, 0xc5, 0x29, 0x61, 0x06, 0x0e, 0xb6, 0xe2, 0xf2, 0x64, 0x6f,
-	0xf4, 0x15, 0x32, 0x00, 0xa7, 0x9b, 0x58, 0xe7, 0x58, 0xae, 0x60, 0x54, 0xd3, 0xe5, 0xd4, 0x2e,
-	0xdf, 0xbd, 0x09, 0x1d, 0xf9, 0x50, 0x90, 0x2a, 0xd5, 0xe3, 0x50, 0x9d, 0xd8, 0xbb, 0x57, 0x54,
-	0x6a, 0x48, 0x34, 0x59, 0xb8, 0xfa, 0x1d, 0x14, 0xf4, 0x93, 0x14, 0x2c, 0x5b, 0xbe, 0x67, 0x0b,
-	0x8f, 0x60, 0xc7, 0xec, 0x58, 0xb0, 0xd8, 0xaa, 0xf2, 0xfa, 0x3d, 0x78, 0x7d, 0xfd, 0x9b, 0x6d,
-	0xd0, 0xde, 0x75, 0xef, 0x4e, 0x18, 0x8b, 0xd6, 0x30, 0xf6, 0x10, 0x8b, 0xa2, 0x90, 0x36, 0x9b,
-	0x24, 0x24, 0xb6, 0xba, 0xc9, 0xaf, 0xc1, 0xa2, 0xe3, 0x18, 0x72, 0xb0, 0x45, 0x09, 0x1b, 0xfd,
-	0x30, 0x05, 0x8b, 0x8e, 0xef, 0x35, 0xcd, 0x88, 0x84, 0x6e, 0x9f, 0x87, 0xa6, 0xde, 0x34, 0x2d,
-	0xf6, 0x7d, 0xaf, 0x79, 0x4c, 0x42, 0x77, 0x80, 0x7b, 0x16, 0x9c, 0x81, 0x3c, 0xc4, 0xda, 0xe9,
-	0x21, 0x73, 0x32, 0x27, 0x94, 0xef, 0x5f, 0x51, 0xb9, 0x41, 0x82, 0x2e, 0xf5, 0x71, 0x8a, 0x08,
-	0xea, 0xd2, 0xf7, 0xa0, 0x3c, 0x2c, 0x83, 0xd1, 0x56, 0x5c, 0xad, 0xbc, 0x51, 0xf9, 0xa3, 0x6a,
-	0x95, 0xa5, 0x3f, 0xa6, 0x60, 0x61, 0x70, 0xbe, 0xa2, 0x47, 0x50, 0x14, 0x5b, 0x81, 0xd8, 0xca,
-	0xf1, 0xc9, 0x69, 0x77, 0xff, 0xf5, 0x74, 0xd5, 0x6c, 0x63, 0x56, 0x21, 0xa9, 0x31, 0xfa, 0x10,
-	0xb2, 0xb2, 0xf7, 0xa2, 0x1e, 0xea, 0x43, 0xea, 0x22, 0xd9, 0xae, 0xa9, 0x74, 0x1a, 0x66, 0x08,
-	0x31, 0x43, 0x89, 0x2f, 0x59, 0xb0, 0x3c, 0x22, 0xdd, 0xaf, 0xc9, 0x49, 0xdf, 0xef, 0x57, 0xd2,
-	0x91, 0xc1, 0xe8, 0x33, 0x40, 0xc9, 0x1e, 0xb9, 0xba, 0xab, 0x8a, 0x09, 0x96, 0xa2, 0xf0, 0x2c,
-	0x18, 0x96, 0xb0, 0xd7, 0xb4, 0xc0, 0x53, 0x58, 0x1a, 0x9e, 0x95, 0xd7, 0xa3, 0x23, 0x79, 0xa7,
-	0xcb, 0xa3, 0x7f, 0x4f, 0xcb, 0x65, 0x8a, 0x9a, 0xfe, 0xeb, 0x14, 0x20, 0x71, 0x33, 0x74, 0xbf,
-	0x86, 0x67, 0x21, 0x9d, 0xf4, 0x3d, 0xd2, 0x54, 0xbc, 0x55, 0xd8, 0xa5, 0x7b, 0xea, 0x3b, 0xf2,
-	0xc5, 0x67, 0xa8, 0x11, 0xbf, 0xfb, 0xcf, 0x30, 0x33, 0x65, 0x3f, 0x40, 0x14, 0x07, 0x39, 0x63,
-	0xfa, 0x0c, 0x33, 0xf9, 0x54, 0xed, 0xee, 0xa2, 0x68, 0x3d, 0x5d, 0x94, 0xb7, 0x60, 0x0e, 0x47,
-	0xbe, 0x4b, 0x2d, 0x33, 0x24, 0xcc, 0x77, 0x5a, 0x3c, 0xb8, 0xe2, 0xcc, 0x9d, 0x33, 0x8a, 0x92,
-	0x61, 0x24, 0x74, 0xfd, 0x4f, 0x19, 0xf8, 0x4a, 0x72, 0x6b, 0x0e, 0x7a, 0xbf, 0xf7, 0x5a, 0xfc,
-	0xea, 0xd2, 0x66, 0x01, 0xb2, 0xbc, 0xdc, 0x20, 0xa1, 0xb0, 0x7b, 0xda, 0x50, 0xa3, 0xd1, 0x46,
-	0xef, 0x42, 0x96, 0x45, 0x38, 0x6a, 0xc9, 0x82, 0x70, 0x76, 0x9c, 0xf4, 0xda, 0x54, 0x2a, 0x8f,
-	0x84, 0x9c, 0xa1, 0xe4, 0xd1, 0xb7, 0x60, 0x59, 0x15, 0x97, 0xa6, 0xe5, 0x7b, 0x17, 0x24, 0x64,
-	0xfc, 0xad, 0x92, 0xf4, 0x0f, 0xb2, 0xc2, 0x11, 0x8b, 0x6a, 0xca, 0x66, 0x32, 0x23, 0xee, 0x90,
-	0x0c, 0x76, 0xdf, 0xd4, 0x60, 0xf7, 0xa1, 0x7b, 0x30, 0x17, 0x57, 0x57, 0xbc, 0xb4, 0x31, 0xf9,
-	0x3f, 0x71, 0x80, 0x16, 0x8c, 0x1b, 0x31, 0xa3, 0x4e, 0xc2, 0x63, 0x6a, 0x9d, 0xf3, 0x47, 0x05,
-	0x8b, 0x48, 0x60, 0x9e, 0x62, 0xd6, 0x51, 0xff, 0x4e, 0xcb, 0x47, 0x05, 0xe7, 0x54, 0x31, 0x6b,
-	0x57, 0xbf, 0x5f, 0x87, 0x59, 0x59, 0x50, 0xd2, 0xe8, 0xd2, 0x8c, 0x28, 0x09, 0xcb, 0x20, 0x60,
-	0x0b, 0x09, 0xf5, 0x98, 0x92, 0xf0, 0x83, 0x74, 0x39, 0xa5, 0xff, 0x5c, 0x1b, 0x19, 0xc3, 0xf5,
-	0xff, 0xc7, 0xf0, 0xbf, 0x3a, 0x86, 0xe8, 0x21, 0x7f, 0xf5, 0x0b, 0xa7, 0x8a, 0x0e, 0x6f, 0x5e,
-	0x38, 0x6f, 0x8c, 0xc2, 0xbb, 0x27, 0xe6, 0xa2, 0xcd, 0x0b, 0x6e, 0xf2, 0x5f, 0xff, 0x55, 0x1a,
-	0x96, 0xf6, 0x3b, 0x35, 0x9d, 0x04, 0x8c, 0x84, 0xd1, 0xb0, 0x9d, 0x8d, 0x40, 0xf3, 0xb0, 0x4b,
-	0xd4, 0x49, 0x24, 0xfe, 0xf3, 0xf5, 0x52, 0x8f, 0x46, 0x14, 0x3b, 0xfc, 0x2c, 0x6a, 0x52, 0x4f,
-	0xf4, 0x00, 0xe5, 0x63, 0xa5, 0xa8, 0x38, 0x07, 0x82, 0x51, 0x0f, 0x5c, 0xf4, 0x3e, 0x94, 0x5d,
-	0x4c, 0xbd, 0x88, 0x78, 0xd8, 0xb3, 0x88, 0xd9, 0x08, 0xb1, 0x25, 0x1a, 0x05, 0x5c, 0x46, 0x26,
-	0xcb, 0x42, 0x07, 0x7f, 0x47, 0xb1, 0xa5, 0xe4, 0x82, 0x70, 0x69, 0x5c, 0x9c, 0x9b, 0x9e, 0x2f,
-	0xef, 0x24, 0xf9, 0x3e, 0xe4, 0x55, 0xad, 0x51, 0xe2, 0x33, 0xe2, 0x42, 0xfb, 0x50, 0xf1, 0xf7,
-	0xb4, 0x5c, 0xb6, 0x38, 0xb5, 0xa7, 0xe5, 0xa6, 0x8a, 0x39, 0xe3, 0x96, 0x1f, 0x10, 0xcf, 0xe4,
-	0x0a, 0x42, 0xc2, 0x22, 0xd3, 0xf1, 0x9f, 0x90, 0xd0, 0xb4, 0x70, 0xd0, 0xcb, 0x68, 0x05, 0x81,
-	0x64, 0xe8, 0xbf, 0x4c, 0xc3, 0xbc, 0x7c, 0x07, 0xc5, 0x99, 0x18, 0x7b, 0xa7, 0x77, 0x8f, 0xa4,
-	0xfa, 0xf6, 0x48, 0x3b, 0xdd, 0xd3, 0x5f, 0x6e, 0xba, 0x67, 0x5e, 0x95, 0xee, 0x03, 0x33, 0x58,
-	0x7b, 0x9d, 0x0c, 0x9e, 0x1c, 0x9c, 0xc1, 0xfa, 0xef, 0x53, 0xb0, 0x20, 0xfd, 0x93, 0x24, 0xdb,
-	0x88, 0xab, 0x4c, 0x1d, 0x19, 0xe9, 0xe1, 0x47, 0x46, 0x66, 0x9c, 0xbb, 0x4a, 0x1b, 0xb2, 0x51,
-	0xfb, 0xb7, 0xd3, 0xe4, 0x80, 0xed, 0xa4, 0x33, 0x98, 0x3f, 0x0e, 0xb1, 0x4d, 0xbd, 0xa6, 0x41,
-	0x9e, 0xe0, 0xd0, 0x66, 0xed, 0x27, 0xee, 0x8d, 0x48, 0x32, 0xcc, 0x50, 0x72, 0xd4, 0x87, 0x99,
-	0xb5, 0x91, 0xb5, 0xae, 0xea, 0xbc, 0x76, 0x61, 0x1a, 0xb3, 0x51, 0x97, 0x0a, 0xfd, 0x17, 0x29,
-	0x28, 0x0d, 0x9a, 0x88, 0x4a, 0x30, 0xe9, 0x3f, 0xf1, 0x48, 0xdc, 0x5c, 0x97, 0x03, 0x74, 0x0e,
-	0x33, 0x36, 0xf1, 0x7c, 0x37, 0xee, 0x97, 0xa4, 0xaf, 0xf9, 0xe3, 0x54, 0x5e, 0xa0, 0xcb, 0xd6,
-	0x8b, 0xfe, 0x83, 0x14, 0x2c, 0x3e, 0x08, 0x88, 0x57, 0x53, 0xf9, 0xdf, 0xfd, 0xf0, 0xb7, 0x60,
-	0xbe, 0x77, 0x77, 0x74, 0x7e, 0xb4, 0x1a, 0xdd, 0xd8, 0xeb, 0x87, 0x35, 0x6e, 0xfa, 0x7d, 0x34,
-	0xa6, 0xff, 0x26, 0x05, 0xa8, 0x7f, 0xee, 0x38, 0xdf, 0xfc, 0x5c, 0x28, 0x74, 0x99, 0x77, 0xed,
-	0xae, 0x9a, 0xe9, 0xb4, 0x57, 0x7f, 0x3e, 0xea, 0xcc, 0x5c, 0xff, 0xdf, 0x38, 0x33, 0xd1, 0x7b,
-	0x30, 0xec, 0xa4, 0x54, 0x2d, 0xa3, 0x52, 0xa7, 0x4f, 0xf6, 0x39, 0x73, 0x13, 0x07, 0xfd, 0x62,
-	0xc9, 0x39, 0xaa, 0x1a, 0xa9, 0xa5, 0xee, 0xd0, 0x07, 0x42, 0xac, 0x6a, 0x7c, 0xfe, 0x62, 0x25,
-	0xf5, 0xfc, 0xc5, 0x4a, 0xea, 0x9f, 0x2f, 0x56, 0x52, 0x3f, 0x7d, 0xb9, 0x32, 0xf1, 0xfc, 0xe5,
-	0xca, 0xc4, 0xdf, 0x5f, 0xae, 0x4c, 0x3c, 0x7a, 0x7f, 0xfc, 0xe0, 0x75, 0x7f, 0x5f, 0x3f, 0xcd,
-	0x0a, 0xc6, 0x37, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x34, 0x4e, 0xed, 0x85, 0x1f, 0x00,
-	0x00,
+func (m *SpotMarketCreateEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpotMarketCreateEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotMarketCreateEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *FundingUpdateV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpotMarketCreateEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotMarketCreateEventV1.Merge(m, src)
+}
+func (m *SpotMarketCreateEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotMarketCreateEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotMarketCreateEventV1.DiscardUnknown(m)
 }
 
-func (m *FundingUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FundingIndex.Size()
-		i -= size
-		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+var xxx_messageInfo_SpotMarketCreateEventV1 proto.InternalMessageInfo
+
+func (m *SpotMarketCreateEventV1) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.FundingValuePpm != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FundingValuePpm))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *SpotMarketCreateEventV1) GetBaseAssetId() uint32 {
+	if m != nil {
+		return m.BaseAssetId
 	}
-	if m.PerpetualId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SpotMarketCreateEventV1) GetQuoteAssetId() uint32 {
+	if m != nil {
+		return m.QuoteAssetId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FundingEventV1) Marshal() (dAtA []byte, err error) {
+func (m *SpotMarketCreateEventV1) GetStatus() types.ClobPairStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types.ClobPairStatus_CLOB_PAIR_STATUS_UNSPECIFIED
+}
+
+func (m *SpotMarketCreateEventV1) GetQuantumConversionExponent() int32 {
+	if m != nil {
+		return m.QuantumConversionExponent
+	}
+	return 0
+}
+
+func (m *SpotMarketCreateEventV1) GetSubticksPerTick() uint32 {
+	if m != nil {
+		return m.SubticksPerTick
+	}
+	return 0
+}
+
+func (m *SpotMarketCreateEventV1) GetStepBaseQuantums() uint64 {
+	if m != nil {
+		return m.StepBaseQuantums
+	}
+	return 0
+}
+
+// UpdateAssetEventV1 message contains all the information about an update to
+// an Asset on the dYdX chain.
+type UpdateAssetEventV1 struct {
+	// Unique asset id.
+	// Defined in assets.asset
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// `true` if this `Asset` has a valid `MarketId` value.
+	HasMarket bool `protobuf:"varint,2,opt,name=has_market,json=hasMarket,proto3" json:"has_market,omitempty"`
+	// The `Id` of the `Market` associated with this `Asset`. It acts as the
+	// oracle price for the purposes of calculating collateral.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// The haircut applied to the value of this `Asset` when it is used as
+	// collateral, in parts-per-million.
+	HaircutPpm uint32 `protobuf:"varint,4,opt,name=haircut_ppm,json=haircutPpm,proto3" json:"haircut_ppm,omitempty"`
+}
+
+func (m *UpdateAssetEventV1) Reset()         { *m = UpdateAssetEventV1{} }
+func (m *UpdateAssetEventV1) String() string { return proto.CompactTextString(m) }
+func (*UpdateAssetEventV1) ProtoMessage()    {}
+func (*UpdateAssetEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{28}
+}
+func (m *UpdateAssetEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAssetEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAssetEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateAssetEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAssetEventV1.Merge(m, src)
+}
+func (m *UpdateAssetEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAssetEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAssetEventV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAssetEventV1 proto.InternalMessageInfo
+
+func (m *UpdateAssetEventV1) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateAssetEventV1) GetHasMarket() bool {
+	if m != nil {
+		return m.HasMarket
+	}
+	return false
+}
+
+func (m *UpdateAssetEventV1) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *UpdateAssetEventV1) GetHaircutPpm() uint32 {
+	if m != nil {
+		return m.HaircutPpm
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
+	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
+	proto.RegisterType((*FundingEventV1)(nil), "dydxprotocol.indexer.events.FundingEventV1")
+	proto.RegisterType((*MarketEventV1)(nil), "dydxprotocol.indexer.events.MarketEventV1")
+	proto.RegisterType((*MarketPriceUpdateEventV1)(nil), "dydxprotocol.indexer.events.MarketPriceUpdateEventV1")
+	proto.RegisterType((*MarketBaseEventV1)(nil), "dydxprotocol.indexer.events.MarketBaseEventV1")
+	proto.RegisterType((*MarketCreateEventV1)(nil), "dydxprotocol.indexer.events.MarketCreateEventV1")
+	proto.RegisterType((*MarketModifyEventV1)(nil), "dydxprotocol.indexer.events.MarketModifyEventV1")
+	proto.RegisterType((*SourceOfFunds)(nil), "dydxprotocol.indexer.events.SourceOfFunds")
+	proto.RegisterType((*TransferEventV1)(nil), "dydxprotocol.indexer.events.TransferEventV1")
+	proto.RegisterType((*OrderFillEventV1)(nil), "dydxprotocol.indexer.events.OrderFillEventV1")
+	proto.RegisterType((*DeleveragingEventV1)(nil), "dydxprotocol.indexer.events.DeleveragingEventV1")
+	proto.RegisterType((*LiquidationOrderV1)(nil), "dydxprotocol.indexer.events.LiquidationOrderV1")
+	proto.RegisterType((*SubaccountUpdateEventV1)(nil), "dydxprotocol.indexer.events.SubaccountUpdateEventV1")
+	proto.RegisterType((*StatefulOrderEventV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1")
+	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_StatefulOrderRemovalV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.StatefulOrderRemovalV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggeredV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggeredV1")
+	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderPlacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_LongTermOrderReplacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.LongTermOrderReplacementV1")
+	proto.RegisterType((*StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.ConditionalOrderTriggerUpdatedV1")
+	proto.RegisterType((*StatefulOrderEventV1_TwapOrderPlacementV1)(nil), "dydxprotocol.indexer.events.StatefulOrderEventV1.TwapOrderPlacementV1")
+	proto.RegisterType((*AssetCreateEventV1)(nil), "dydxprotocol.indexer.events.AssetCreateEventV1")
+	proto.RegisterType((*PerpetualMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV1")
+	proto.RegisterType((*PerpetualMarketCreateEventV2)(nil), "dydxprotocol.indexer.events.PerpetualMarketCreateEventV2")
+	proto.RegisterType((*LiquidityTierUpsertEventV1)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV1")
+	proto.RegisterType((*UpdateClobPairEventV1)(nil), "dydxprotocol.indexer.events.UpdateClobPairEventV1")
+	proto.RegisterType((*UpdatePerpetualEventV1)(nil), "dydxprotocol.indexer.events.UpdatePerpetualEventV1")
+	proto.RegisterType((*TradingRewardsEventV1)(nil), "dydxprotocol.indexer.events.TradingRewardsEventV1")
+	proto.RegisterType((*AddressTradingReward)(nil), "dydxprotocol.indexer.events.AddressTradingReward")
+	proto.RegisterType((*OpenInterestUpdateEventV1)(nil), "dydxprotocol.indexer.events.OpenInterestUpdateEventV1")
+	proto.RegisterType((*OpenInterestUpdate)(nil), "dydxprotocol.indexer.events.OpenInterestUpdate")
+	proto.RegisterType((*MarkPriceUpdateEventV1)(nil), "dydxprotocol.indexer.events.MarkPriceUpdateEventV1")
+	proto.RegisterType((*MarkPriceUpdate)(nil), "dydxprotocol.indexer.events.MarkPriceUpdate")
+	proto.RegisterType((*LiquidityTierUpsertEventV2)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV2")
+	proto.RegisterType((*SpotMarketCreateEventV1)(nil), "dydxprotocol.indexer.events.SpotMarketCreateEventV1")
+	proto.RegisterType((*UpdateAssetEventV1)(nil), "dydxprotocol.indexer.events.UpdateAssetEventV1")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/indexer/events/events.proto", fileDescriptor_6331dfb59c6fd2bb)
+}
+
+var fileDescriptor_6331dfb59c6fd2bb = []byte{
+	// 2552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcd, 0x6f, 0x23, 0x49,
+	0xf5, 0x69, 0xdb, 0xb1, 0x9d, 0xe7, 0x38, 0xe3, 0xd4, 0x38, 0x19, 0x27, 0xf9, 0xfd, 0x92, 0x6c,
+	0x0b, 0xa4, 0xd1, 0x7e, 0x38, 0x93, 0xb0, 0x8b, 0x56, 0x7b, 0x40, 0xc4, 0xf9, 0xd8, 0x38, 0x9b,
+	0x64, 0xbc, 0x1d, 0x67, 0x76, 0x77, 0x58, 0x6d, 0x53, 0xe9, 0xae, 0x38, 0xad, 0xf4, 0xd7, 0x74,
+	0xb5, 0x93, 0xcd, 0x48, 0x48, 0x70, 0x82, 0x03, 0x12, 0x48, 0x88, 0x03, 0x07, 0x04, 0x17, 0x38,
+	0x20, 0x71, 0x40, 0xe2, 0x82, 0x04, 0x07, 0xc4, 0x65, 0x6f, 0xac, 0xb8, 0x80, 0x38, 0xac, 0xd0,
+	0xee, 0x01, 0xf1, 0x5f, 0xa0, 0xfa, 0xe8, 0xf6, 0xb7, 0xc7, 0x99, 0x78, 0x24, 0x84, 0x38, 0xc5,
+	0xfd, 0x5e, 0xbd, 0x8f, 0x7a, 0x1f, 0xf5, 0x5e, 0xbd, 0x0a, 0xdc, 0x37, 0xaf, 0xcd, 0x8f, 0xfd,
+	0xc0, 0x0b, 0x3d, 0xc3, 0xb3, 0xd7, 0x2c, 0xd7, 0x24, 0x1f, 0x93, 0x60, 0x8d, 0x5c, 0x12, 0x37,
+	0xa4, 0xf2, 0x4f, 0x99, 0xa3, 0xd1, 0x52, 0xfb, 0xca, 0xb2, 0x5c, 0x59, 0x16, 0x4b, 0x16, 0x17,
+	0x0c, 0x8f, 0x3a, 0x1e, 0xd5, 0x39, 0x7e, 0x4d, 0x7c, 0x08, 0xba, 0xc5, 0x62, 0xc3, 0x6b, 0x78,
+	0x02, 0xce, 0x7e, 0x49, 0xe8, 0x83, 0xbe, 0x72, 0xe9, 0x39, 0x0e, 0x88, 0xb9, 0x16, 0x10, 0xc7,
+	0xbb, 0xc4, 0xb6, 0x1e, 0x10, 0x4c, 0x3d, 0x57, 0x52, 0xbc, 0xd2, 0x97, 0x22, 0x06, 0x5c, 0xae,
+	0xaf, 0x19, 0xb6, 0x77, 0x3a, 0x94, 0x7d, 0xfb, 0x62, 0x9f, 0x04, 0x3e, 0x09, 0x9b, 0xd8, 0x96,
+	0x14, 0xeb, 0xcf, 0xa4, 0xa0, 0xcd, 0x53, 0x6c, 0x18, 0x5e, 0xd3, 0x0d, 0x05, 0x89, 0xfa, 0x67,
+	0x05, 0xee, 0xec, 0x36, 0x5d, 0xd3, 0x72, 0x1b, 0x27, 0xbe, 0x89, 0x43, 0xf2, 0x68, 0x1d, 0xbd,
+	0x04, 0xd3, 0x31, 0x67, 0xdd, 0x32, 0x4b, 0xca, 0xaa, 0x72, 0x3f, 0xaf, 0xe5, 0x62, 0x58, 0xd5,
+	0x44, 0x2f, 0xc3, 0xec, 0x99, 0xa0, 0xd2, 0x2f, 0xb1, 0xdd, 0x24, 0xba, 0xef, 0x3b, 0xa5, 0xc4,
+	0xaa, 0x72, 0x7f, 0x52, 0xbb, 0x23, 0x11, 0x8f, 0x18, 0xbc, 0xe6, 0x3b, 0xc8, 0x81, 0x7c, 0xb4,
+	0x96, 0xab, 0x54, 0x4a, 0xae, 0x2a, 0xf7, 0xa7, 0x2b, 0x7b, 0x9f, 0x7c, 0xb6, 0x32, 0xf1, 0xf7,
+	0xcf, 0x56, 0xbe, 0xde, 0xb0, 0xc2, 0xf3, 0xe6, 0x69, 0xd9, 0xf0, 0x9c, 0xb5, 0x0e, 0xfd, 0x2f,
+	0x5f, 0x7f, 0xcd, 0x38, 0xc7, 0x96, 0xdb, 0xda, 0x80, 0x19, 0x5e, 0xfb, 0x84, 0x96, 0x8f, 0x49,
+	0x60, 0x61, 0xdb, 0x7a, 0x8a, 0x4f, 0x6d, 0x52, 0x75, 0x43, 0x6d, 0x5a, 0xb2, 0xaf, 0x32, 0xee,
+	0xea, 0x8f, 0x12, 0x30, 0x23, 0x77, 0xb4, 0xc3, 0x1c, 0xfb, 0x68, 0x1d, 0x1d, 0x40, 0xa6, 0xc9,
+	0x37, 0x47, 0x4b, 0xca, 0x6a, 0xf2, 0x7e, 0x6e, 0xe3, 0xd5, 0xf2, 0x90, 0x40, 0x28, 0x77, 0xd9,
+	0xa3, 0x92, 0x62, 0x9a, 0x6a, 0x11, 0x0b, 0xb4, 0x0d, 0x29, 0xa6, 0x07, 0xdf, 0xee, 0xcc, 0xc6,
+	0x83, 0x51, 0x58, 0x49, 0x45, 0xca, 0xf5, 0x6b, 0x9f, 0x68, 0x9c, 0x5a, 0x75, 0x20, 0xc5, 0xbe,
+	0x50, 0x11, 0x0a, 0xf5, 0x0f, 0x6a, 0x3b, 0xfa, 0xc9, 0xd1, 0x71, 0x6d, 0x67, 0xab, 0xba, 0x5b,
+	0xdd, 0xd9, 0x2e, 0x4c, 0xa0, 0x7b, 0x70, 0x97, 0x43, 0x6b, 0xda, 0xce, 0x61, 0xf5, 0xe4, 0x50,
+	0x3f, 0xde, 0x3c, 0xac, 0x1d, 0xec, 0x14, 0x14, 0xb4, 0x02, 0x4b, 0x1c, 0xb1, 0x7b, 0x72, 0xb4,
+	0x5d, 0x3d, 0x7a, 0x5b, 0xd7, 0x36, 0xeb, 0x3b, 0xfa, 0xe6, 0xd1, 0xb6, 0x5e, 0x3d, 0xda, 0xde,
+	0x79, 0xbf, 0x90, 0x40, 0x73, 0x30, 0xdb, 0x41, 0xf9, 0xe8, 0x61, 0x7d, 0xa7, 0x90, 0x54, 0xff,
+	0x94, 0x80, 0xfc, 0x21, 0x0e, 0x2e, 0x48, 0x18, 0x19, 0x65, 0x09, 0xa6, 0x1c, 0x0e, 0x68, 0xb9,
+	0x38, 0x2b, 0x00, 0x55, 0x13, 0x3d, 0x86, 0x69, 0x3f, 0xb0, 0x0c, 0xa2, 0x8b, 0x4d, 0xf3, 0xbd,
+	0xe6, 0x36, 0xde, 0x18, 0xba, 0x57, 0xc1, 0xbe, 0xc6, 0xc8, 0x84, 0xe9, 0xa4, 0xa4, 0xbd, 0x09,
+	0x2d, 0xe7, 0xb7, 0xa0, 0xe8, 0x3d, 0xc8, 0x4b, 0xc1, 0x46, 0x40, 0x18, 0xf3, 0x24, 0x67, 0xfe,
+	0x60, 0x04, 0xe6, 0x5b, 0x01, 0xe9, 0xe0, 0x3b, 0xed, 0xb4, 0x81, 0xdb, 0x18, 0x3b, 0x9e, 0x69,
+	0x9d, 0x5d, 0x97, 0x52, 0x23, 0x33, 0x3e, 0xe4, 0x04, 0x3d, 0x8c, 0x05, 0xb8, 0x92, 0x81, 0x49,
+	0xbe, 0x5a, 0xdd, 0x87, 0xd2, 0xa0, 0x5d, 0xa2, 0x32, 0xdc, 0x15, 0x26, 0xbb, 0xb2, 0xc2, 0x73,
+	0x9d, 0x7c, 0xec, 0x7b, 0x2e, 0x71, 0x43, 0x6e, 0xd9, 0x94, 0x36, 0xcb, 0x51, 0xef, 0x59, 0xe1,
+	0xf9, 0x8e, 0x44, 0xa8, 0xef, 0xc3, 0xac, 0xe0, 0x55, 0xc1, 0x34, 0x66, 0x82, 0x20, 0xe5, 0x63,
+	0x2b, 0xe0, 0x54, 0x53, 0x1a, 0xff, 0x8d, 0xd6, 0xa0, 0xe8, 0x58, 0xae, 0x2e, 0x98, 0x1b, 0xe7,
+	0xd8, 0x6d, 0xb4, 0xd2, 0x2d, 0xaf, 0xcd, 0x3a, 0x96, 0xcb, 0xb5, 0xd9, 0xe2, 0x98, 0x9a, 0xef,
+	0xa8, 0x4d, 0xb8, 0xdb, 0xc7, 0x5c, 0xa8, 0x02, 0xa9, 0x53, 0x4c, 0x09, 0xe7, 0x9d, 0xdb, 0x28,
+	0x8f, 0x60, 0x95, 0x36, 0xcd, 0x34, 0x4e, 0x8b, 0x16, 0x21, 0x1b, 0xef, 0x8c, 0xc9, 0x9f, 0xd5,
+	0xe2, 0x6f, 0xf5, 0x83, 0x48, 0x6c, 0x87, 0x31, 0xc7, 0x21, 0x56, 0xfd, 0xb5, 0x02, 0xf9, 0x63,
+	0xaf, 0x19, 0x18, 0xe4, 0xe1, 0x19, 0x4b, 0x29, 0x8a, 0x3e, 0x84, 0x7c, 0xeb, 0x2c, 0x8b, 0x22,
+	0x78, 0x60, 0x84, 0xc6, 0x80, 0xcb, 0xf5, 0x72, 0x55, 0xc0, 0x8e, 0x63, 0xea, 0xaa, 0xc9, 0x1c,
+	0x4e, 0xdb, 0xbe, 0xd1, 0xeb, 0x90, 0xc1, 0xa6, 0x19, 0x10, 0x4a, 0xf9, 0x2e, 0xa7, 0x2a, 0xa5,
+	0xbf, 0xfc, 0xf6, 0xb5, 0xa2, 0x2c, 0x09, 0x9b, 0x02, 0x73, 0x1c, 0x06, 0x96, 0xdb, 0xd8, 0x9b,
+	0xd0, 0xa2, 0xa5, 0x95, 0x2c, 0xa4, 0x29, 0x57, 0x52, 0xfd, 0x55, 0x12, 0xee, 0xd4, 0x03, 0xec,
+	0xd2, 0x33, 0x12, 0x44, 0x76, 0x68, 0x40, 0x91, 0x12, 0xd7, 0x24, 0x81, 0x3e, 0x3e, 0xc5, 0x35,
+	0x24, 0x58, 0xb6, 0xc3, 0x90, 0x03, 0xf7, 0x02, 0x62, 0x58, 0xbe, 0x45, 0xdc, 0xb0, 0x4b, 0x56,
+	0xe2, 0x36, 0xb2, 0xe6, 0x62, 0xae, 0x1d, 0xe2, 0x16, 0x20, 0x8b, 0x29, 0x15, 0xc7, 0x48, 0x92,
+	0x87, 0x64, 0x86, 0x7f, 0x57, 0x4d, 0x34, 0x0f, 0x69, 0xec, 0xb0, 0x65, 0x3c, 0x13, 0x53, 0x9a,
+	0xfc, 0x42, 0x15, 0x48, 0x0b, 0xbd, 0x4b, 0x93, 0x5c, 0xa1, 0x97, 0x87, 0x06, 0x45, 0x87, 0xe3,
+	0x35, 0x49, 0x89, 0xf6, 0x60, 0x2a, 0xd6, 0xa7, 0x94, 0xbe, 0x31, 0x9b, 0x16, 0xb1, 0xfa, 0xd7,
+	0x24, 0x14, 0x1e, 0x06, 0x26, 0x09, 0x76, 0x2d, 0xdb, 0x8e, 0xbc, 0x75, 0x02, 0x39, 0x07, 0x5f,
+	0x90, 0x40, 0xf7, 0x18, 0x66, 0x78, 0xf0, 0xf6, 0x31, 0x1c, 0xe7, 0x27, 0x0b, 0x07, 0x70, 0x46,
+	0x1c, 0x82, 0x76, 0x61, 0x52, 0x30, 0x4c, 0x3c, 0x0f, 0xc3, 0xbd, 0x09, 0x4d, 0x90, 0xa3, 0x8f,
+	0x60, 0xd6, 0xb6, 0x9e, 0x34, 0x2d, 0x13, 0x87, 0x96, 0xe7, 0x4a, 0x25, 0xc5, 0x71, 0xb7, 0x36,
+	0xd4, 0x0a, 0x07, 0x2d, 0x2a, 0xce, 0x92, 0x9f, 0x76, 0x05, 0xbb, 0x0b, 0x8a, 0x56, 0x20, 0x77,
+	0x66, 0xd9, 0xb6, 0x2e, 0xdd, 0x97, 0xe4, 0xee, 0x03, 0x06, 0xda, 0x14, 0x2e, 0xe4, 0xd5, 0x83,
+	0xd9, 0xe7, 0x8c, 0x10, 0xee, 0x45, 0xc4, 0xaa, 0xc7, 0x05, 0x09, 0x76, 0x09, 0x61, 0xc8, 0x30,
+	0x46, 0xa6, 0x05, 0x32, 0x8c, 0x90, 0xaf, 0x02, 0x0a, 0xbd, 0x10, 0xdb, 0x3a, 0xe3, 0x46, 0x4c,
+	0x9d, 0x53, 0x95, 0x32, 0x5c, 0x42, 0x81, 0x63, 0x76, 0x39, 0xe2, 0x90, 0xc1, 0x7b, 0x56, 0x73,
+	0x36, 0xa5, 0x6c, 0xcf, 0xea, 0x3a, 0x83, 0x57, 0xf2, 0x90, 0x0b, 0x5b, 0x5e, 0x53, 0xbf, 0x9f,
+	0x84, 0xbb, 0xdb, 0xc4, 0x26, 0x97, 0x24, 0xc0, 0x8d, 0xb6, 0x7e, 0xe0, 0x1b, 0x00, 0xd1, 0x8e,
+	0xc9, 0xed, 0x12, 0x30, 0x72, 0x71, 0x8b, 0x1d, 0x63, 0xee, 0x9d, 0x9d, 0x51, 0x12, 0x86, 0x96,
+	0xdb, 0x28, 0x25, 0xc6, 0xc0, 0xbc, 0xc5, 0xae, 0xa7, 0x35, 0x4b, 0xf6, 0xb6, 0x66, 0x5d, 0xae,
+	0x4b, 0xf5, 0xb8, 0xee, 0x01, 0x14, 0x85, 0x49, 0x9f, 0x34, 0xbd, 0x90, 0xe8, 0x4f, 0x9a, 0xd8,
+	0x0d, 0x9b, 0x0e, 0xe5, 0x5e, 0x4c, 0x69, 0xc2, 0xdc, 0xef, 0x32, 0xd4, 0xbb, 0x12, 0x83, 0xe6,
+	0x20, 0x6d, 0x51, 0xfd, 0xb4, 0x79, 0xcd, 0x9d, 0x99, 0xd5, 0x26, 0x2d, 0x5a, 0x69, 0x5e, 0xb3,
+	0x8a, 0x67, 0x51, 0xfd, 0xcc, 0x72, 0xb1, 0xad, 0x33, 0x05, 0x6d, 0xe2, 0xb0, 0x64, 0xcc, 0xf0,
+	0x35, 0xb3, 0x16, 0xdd, 0x65, 0x98, 0xe3, 0x18, 0xa1, 0x7e, 0x2f, 0x01, 0xa8, 0x37, 0xfe, 0x5e,
+	0xac, 0x37, 0x56, 0x61, 0x9a, 0xb5, 0xd4, 0x3a, 0xab, 0xa4, 0xd1, 0x09, 0x98, 0xd7, 0x80, 0xc1,
+	0x6a, 0xd8, 0x0a, 0xaa, 0xe6, 0x28, 0x26, 0xfd, 0x7f, 0x00, 0x61, 0x31, 0x6a, 0x3d, 0x25, 0xd2,
+	0xa2, 0x53, 0x1c, 0x72, 0x6c, 0x3d, 0x25, 0x6d, 0xe6, 0x99, 0x6c, 0x37, 0xcf, 0x22, 0x64, 0x69,
+	0xf3, 0x34, 0xb4, 0x8c, 0x0b, 0xca, 0xed, 0x96, 0xd2, 0xe2, 0x6f, 0xf5, 0x9f, 0x09, 0xb8, 0xd7,
+	0xd2, 0xbc, 0xb3, 0x91, 0x78, 0x3c, 0xce, 0xd2, 0xd6, 0x55, 0xd8, 0x9e, 0xc2, 0x92, 0xe8, 0xe8,
+	0x4c, 0xbd, 0xb5, 0x69, 0xdf, 0xa3, 0x16, 0x73, 0x08, 0x2d, 0x25, 0x79, 0x77, 0xfc, 0xd6, 0xc8,
+	0x92, 0x6a, 0x11, 0x8f, 0x9a, 0x64, 0xa1, 0x2d, 0x48, 0xf6, 0x3d, 0x18, 0x8a, 0x5c, 0xb8, 0x17,
+	0xc9, 0x16, 0x05, 0xa3, 0x25, 0x37, 0xc5, 0xe5, 0x7e, 0x75, 0x64, 0xb9, 0x9b, 0x8c, 0x3e, 0x96,
+	0x39, 0x27, 0xd9, 0x76, 0x40, 0xe9, 0x7e, 0x2a, 0x9b, 0x28, 0x24, 0xd5, 0xdf, 0x15, 0xa0, 0x78,
+	0x1c, 0xe2, 0x90, 0x9c, 0x35, 0x6d, 0x1e, 0x71, 0x91, 0x99, 0x9f, 0x40, 0x8e, 0x9f, 0x12, 0xba,
+	0x6f, 0x63, 0x23, 0x6a, 0x4f, 0xf6, 0x87, 0x97, 0x90, 0x3e, 0x7c, 0x3a, 0x81, 0x35, 0xc6, 0xcb,
+	0xe1, 0x88, 0x4a, 0xa2, 0xa4, 0xec, 0xb1, 0xec, 0x8d, 0xe1, 0xc8, 0x83, 0xbc, 0x10, 0x29, 0x2f,
+	0x87, 0xf2, 0xc4, 0xde, 0xbb, 0xa5, 0x50, 0x4d, 0x70, 0x13, 0x8d, 0xab, 0xd7, 0x06, 0x41, 0x3f,
+	0x50, 0x60, 0xc9, 0xf0, 0x5c, 0x93, 0x5b, 0x04, 0xdb, 0x7a, 0xdb, 0x86, 0x79, 0xaa, 0x8a, 0xf2,
+	0x7b, 0x78, 0x73, 0xf9, 0x5b, 0x2d, 0xa6, 0xdd, 0xfb, 0xde, 0x9b, 0xd0, 0x16, 0x8c, 0x41, 0xe8,
+	0x01, 0x1a, 0x85, 0x81, 0xd5, 0x68, 0x90, 0x80, 0x98, 0xa5, 0xf4, 0xb8, 0x34, 0xaa, 0x47, 0x2c,
+	0xfb, 0x6b, 0x14, 0xa3, 0xd1, 0x77, 0x15, 0x58, 0xb0, 0x3d, 0xb7, 0xa1, 0x87, 0x24, 0x70, 0x7a,
+	0x2c, 0x94, 0x79, 0xde, 0xb0, 0x38, 0xf0, 0xdc, 0x46, 0x9d, 0x04, 0x4e, 0x1f, 0xf3, 0xcc, 0xdb,
+	0x7d, 0x71, 0x88, 0xb6, 0xc2, 0x43, 0xc4, 0x64, 0x96, 0x0b, 0x3f, 0xb8, 0xa5, 0x70, 0x8d, 0xf8,
+	0x1d, 0xe2, 0xa7, 0xbd, 0x36, 0x28, 0xfa, 0x99, 0x02, 0x2f, 0x0d, 0x74, 0x88, 0xbc, 0xfe, 0x99,
+	0xa5, 0x29, 0xae, 0x89, 0x36, 0x36, 0xb7, 0x88, 0x13, 0x4f, 0xf8, 0x66, 0xd9, 0x18, 0xba, 0x06,
+	0x3d, 0x85, 0x62, 0x78, 0x85, 0xfd, 0x1e, 0xd7, 0x00, 0xd7, 0x69, 0xf7, 0xe6, 0x3a, 0xd5, 0xaf,
+	0xb0, 0xdf, 0xc7, 0x2d, 0x28, 0xec, 0x81, 0x2f, 0x7e, 0x13, 0x4a, 0x83, 0xf2, 0x1b, 0x6d, 0x47,
+	0xbd, 0xdc, 0x73, 0x35, 0x87, 0xb2, 0x93, 0x5b, 0xfc, 0x83, 0x02, 0xf3, 0xfd, 0xb3, 0x19, 0x3d,
+	0x86, 0x02, 0x3f, 0x28, 0x88, 0x29, 0xf7, 0x1e, 0xd7, 0x82, 0x07, 0x37, 0x93, 0x55, 0x35, 0xb5,
+	0x19, 0xc9, 0x49, 0x7e, 0xa3, 0xb7, 0x21, 0x2d, 0x26, 0x53, 0x72, 0x8c, 0x31, 0xa0, 0x6b, 0x14,
+	0xc3, 0xac, 0x72, 0xbb, 0x62, 0x1a, 0x27, 0xd3, 0x24, 0xf9, 0xa2, 0x01, 0x4b, 0x43, 0x0e, 0x83,
+	0x31, 0x19, 0xe9, 0x5b, 0xbd, 0x42, 0xda, 0xf2, 0x1b, 0x7d, 0x04, 0x28, 0x3e, 0x41, 0x6e, 0x6f,
+	0xaa, 0x42, 0xcc, 0x4b, 0x42, 0x58, 0x14, 0x0c, 0x4a, 0xe7, 0x31, 0x6d, 0xf0, 0x14, 0x16, 0x07,
+	0xe7, 0xec, 0x98, 0x64, 0xfc, 0x5e, 0x81, 0xd5, 0x67, 0xa5, 0x23, 0x7a, 0x07, 0xb2, 0xb7, 0x36,
+	0x60, 0xc6, 0x13, 0x3f, 0xd0, 0x3b, 0xa0, 0x0e, 0x3e, 0x5a, 0xe2, 0xde, 0x28, 0xc1, 0x7b, 0xa3,
+	0x95, 0x01, 0xa7, 0xc0, 0xb1, 0x5c, 0xb6, 0xf8, 0x21, 0x14, 0xfb, 0x25, 0xee, 0x78, 0x8c, 0x13,
+	0x8f, 0x78, 0x44, 0xd7, 0xb0, 0x9f, 0xca, 0x26, 0x0b, 0x29, 0xf5, 0x17, 0x0a, 0x20, 0xde, 0x54,
+	0x74, 0x0e, 0x52, 0x66, 0x20, 0x11, 0x8f, 0xcc, 0x12, 0x16, 0xbf, 0xe6, 0xd2, 0x6b, 0xe7, 0xd4,
+	0xb3, 0xc5, 0xb0, 0x40, 0x93, 0x5f, 0xac, 0x6d, 0x3c, 0xc7, 0x54, 0x17, 0xa3, 0x24, 0xde, 0x57,
+	0x66, 0xb5, 0xa9, 0x73, 0x4c, 0xc5, 0x94, 0xa3, 0x73, 0x00, 0x97, 0xea, 0x1a, 0xc0, 0xbd, 0x02,
+	0xb3, 0x38, 0xf4, 0x1c, 0xcb, 0xd0, 0x03, 0x42, 0x3d, 0xbb, 0xc9, 0x0c, 0xc3, 0xcb, 0xf5, 0xac,
+	0x56, 0x10, 0x08, 0x2d, 0x86, 0xab, 0x7f, 0x4c, 0xc2, 0xff, 0xc5, 0x0d, 0x57, 0xbf, 0xd1, 0x4f,
+	0xb7, 0xc6, 0xcf, 0xee, 0x8a, 0xe7, 0x21, 0xcd, 0xcc, 0x4e, 0x02, 0xae, 0xf7, 0x94, 0x26, 0xbf,
+	0x86, 0x2b, 0xbd, 0x07, 0x69, 0x1a, 0xe2, 0xb0, 0x29, 0xee, 0x12, 0x33, 0xa3, 0x84, 0xce, 0x96,
+	0x14, 0x79, 0xcc, 0xe9, 0x34, 0x49, 0x8f, 0xbe, 0x06, 0x4b, 0xf2, 0x5e, 0xa2, 0x1b, 0x9e, 0x7b,
+	0x49, 0x02, 0xca, 0xae, 0xb9, 0xf1, 0xe8, 0x29, 0xcd, 0x0d, 0xb1, 0x20, 0x97, 0x6c, 0xc5, 0x2b,
+	0xa2, 0xe1, 0x5a, 0x7f, 0xf3, 0x65, 0xfa, 0x9b, 0x8f, 0x0d, 0xb3, 0xa3, 0x60, 0x64, 0x5d, 0xb1,
+	0xce, 0x7e, 0xf1, 0xda, 0x9b, 0xd7, 0xee, 0x44, 0x88, 0x1a, 0x09, 0xea, 0x96, 0x71, 0xc1, 0xee,
+	0xa3, 0x34, 0x24, 0xbe, 0xce, 0xc6, 0x52, 0xad, 0xab, 0xd3, 0x94, 0xb8, 0x8f, 0x32, 0x0c, 0x1b,
+	0x5e, 0xc5, 0x17, 0xa7, 0x2f, 0xc3, 0x8c, 0xb8, 0x8b, 0x58, 0xe1, 0xb5, 0x1e, 0x5a, 0x24, 0xe0,
+	0x45, 0x2b, 0xaf, 0xe5, 0x63, 0x68, 0xdd, 0x22, 0xc1, 0x5b, 0x89, 0x92, 0xa2, 0xfe, 0x38, 0x35,
+	0xd4, 0x87, 0x1b, 0xff, 0xf3, 0xe1, 0x7f, 0xb4, 0x0f, 0xd1, 0x23, 0xc8, 0x09, 0x1b, 0xea, 0xfc,
+	0x71, 0x20, 0xc7, 0x8d, 0x37, 0xc2, 0x9d, 0xad, 0xcb, 0xe7, 0xfc, 0x85, 0x00, 0x9c, 0xf8, 0xb7,
+	0xfa, 0xf3, 0x04, 0x2c, 0x1e, 0xb4, 0x4b, 0x3a, 0xf1, 0x29, 0x09, 0xc2, 0x41, 0x99, 0x8d, 0x20,
+	0xe5, 0x62, 0x87, 0xc8, 0x93, 0x88, 0xff, 0x66, 0xfb, 0xb5, 0x5c, 0x2b, 0xb4, 0xb0, 0xcd, 0xce,
+	0xa2, 0x06, 0x9b, 0x25, 0xfb, 0x8e, 0xbc, 0xe7, 0x16, 0x24, 0xe6, 0x90, 0x23, 0xd8, 0x73, 0xcd,
+	0x9b, 0x50, 0x72, 0xb0, 0xe5, 0x86, 0xc4, 0xc5, 0xae, 0x41, 0xf4, 0xb3, 0x00, 0x1b, 0x7c, 0xc6,
+	0xc4, 0x68, 0x44, 0xb0, 0xcc, 0xb7, 0xe1, 0x77, 0x25, 0x5a, 0x50, 0xce, 0x73, 0x93, 0x46, 0xf7,
+	0x3a, 0xdd, 0xf5, 0xc4, 0x79, 0x2e, 0x46, 0x0b, 0xec, 0x42, 0xa4, 0x15, 0xd9, 0x8a, 0xe8, 0x8e,
+	0x76, 0x24, 0xf1, 0xfb, 0xa9, 0x6c, 0xba, 0x90, 0xd9, 0x4f, 0x65, 0x33, 0x85, 0xac, 0x76, 0xcf,
+	0xf3, 0x89, 0xab, 0x33, 0x01, 0x01, 0xa1, 0xa1, 0x6e, 0x7b, 0x57, 0x24, 0xd0, 0x0d, 0xec, 0x77,
+	0x23, 0x9a, 0xbe, 0x2f, 0x10, 0xea, 0x4f, 0x13, 0x30, 0x27, 0x2a, 0x58, 0x14, 0x89, 0x91, 0x75,
+	0xba, 0x73, 0x44, 0xe9, 0xc9, 0x91, 0x56, 0xb8, 0x27, 0x5e, 0x6c, 0xb8, 0x27, 0x9f, 0x15, 0xee,
+	0x7d, 0x23, 0x38, 0x75, 0x93, 0x08, 0x9e, 0xec, 0x1f, 0xc1, 0xea, 0x6f, 0x14, 0x98, 0x17, 0xf6,
+	0x89, 0x83, 0x6d, 0x48, 0x29, 0x93, 0x47, 0x46, 0x62, 0xf0, 0x91, 0x91, 0x1c, 0xa5, 0x56, 0xa5,
+	0x06, 0x24, 0x6a, 0x6f, 0x3a, 0x4d, 0xf6, 0x49, 0x27, 0x95, 0xc2, 0x5c, 0x3d, 0xc0, 0xec, 0xed,
+	0x4c, 0x23, 0x57, 0x38, 0x30, 0x69, 0x6b, 0x3a, 0x72, 0x27, 0x14, 0x08, 0x3d, 0x10, 0x18, 0xf9,
+	0xa6, 0xb7, 0x3e, 0xf4, 0x22, 0x20, 0x87, 0xf6, 0x1d, 0x3c, 0xb5, 0x99, 0xb0, 0x43, 0x84, 0xfa,
+	0x13, 0x05, 0x8a, 0xfd, 0x16, 0xa2, 0x22, 0x4c, 0x7a, 0x57, 0x2e, 0x89, 0xde, 0x65, 0xc4, 0x07,
+	0xba, 0x80, 0x69, 0x93, 0xb8, 0x9e, 0x13, 0x8d, 0xda, 0x12, 0x63, 0x7e, 0xd7, 0xcc, 0x71, 0xee,
+	0x62, 0x6a, 0xa7, 0x7e, 0x5b, 0x81, 0x85, 0x87, 0x3e, 0x71, 0xab, 0x32, 0xfe, 0x3b, 0x67, 0x46,
+	0x06, 0xcc, 0x75, 0x67, 0x47, 0xfb, 0x7b, 0xe7, 0xf0, 0x99, 0x70, 0x2f, 0x5b, 0xed, 0xae, 0xd7,
+	0x03, 0xa3, 0xea, 0x2f, 0x15, 0x40, 0xbd, 0x6b, 0x47, 0x79, 0x2e, 0x76, 0x20, 0xdf, 0xa1, 0xde,
+	0xd8, 0x4d, 0x35, 0xdd, 0xae, 0xaf, 0x1a, 0xc2, 0x3c, 0x3b, 0x4d, 0xfb, 0x3c, 0xd2, 0x3d, 0x06,
+	0xc4, 0xc2, 0x56, 0x6f, 0x7f, 0xdc, 0x1c, 0xed, 0x51, 0xb8, 0x8b, 0xa1, 0x56, 0x70, 0x3a, 0x01,
+	0x54, 0x6d, 0xc0, 0x9d, 0xae, 0x45, 0xa3, 0x98, 0xa6, 0x08, 0x93, 0x5c, 0x19, 0xd9, 0x06, 0x8b,
+	0x0f, 0x96, 0x6f, 0xa7, 0x98, 0x5a, 0x34, 0x3e, 0xa9, 0x67, 0xb5, 0x2c, 0x07, 0xb0, 0xf7, 0xbd,
+	0x4f, 0x87, 0x95, 0x84, 0x8d, 0xff, 0x8e, 0x92, 0x80, 0xde, 0x80, 0x41, 0x85, 0x40, 0x0e, 0x53,
+	0x8b, 0xed, 0x2e, 0x3f, 0x60, 0xc8, 0x2d, 0xec, 0xf7, 0x92, 0xc5, 0x65, 0xa2, 0x94, 0xe9, 0x25,
+	0x3b, 0x61, 0xc8, 0x2d, 0xec, 0xab, 0xff, 0x62, 0xf3, 0x58, 0xdf, 0x0b, 0xfb, 0x35, 0xcf, 0xcf,
+	0x2e, 0x22, 0x2a, 0xe4, 0xf9, 0x2e, 0xe3, 0x77, 0x30, 0xd1, 0x8b, 0xe5, 0x18, 0x70, 0x53, 0xbe,
+	0x85, 0x7d, 0x09, 0x66, 0xc4, 0xbc, 0xbd, 0xeb, 0xb1, 0x6c, 0x9a, 0x43, 0xa3, 0x55, 0xad, 0x72,
+	0x94, 0x7a, 0xb1, 0xe5, 0x68, 0xf2, 0xb9, 0xca, 0x51, 0xfa, 0x26, 0xe5, 0x28, 0x33, 0xa0, 0x1c,
+	0x7d, 0x47, 0x01, 0x24, 0xf2, 0x83, 0xef, 0x7a, 0x50, 0x29, 0xea, 0xbc, 0x3d, 0x25, 0x86, 0xde,
+	0x9e, 0xba, 0x2b, 0xd2, 0x0a, 0xe4, 0xce, 0xb1, 0x15, 0x18, 0xcd, 0xb0, 0x2d, 0x46, 0x41, 0x82,
+	0x6a, 0xbe, 0x53, 0xd1, 0x1e, 0xbf, 0x39, 0xfa, 0x99, 0xd3, 0xf9, 0x1f, 0x45, 0x9f, 0x7c, 0xbe,
+	0xac, 0x7c, 0xfa, 0xf9, 0xb2, 0xf2, 0x8f, 0xcf, 0x97, 0x95, 0x1f, 0x7e, 0xb1, 0x3c, 0xf1, 0xe9,
+	0x17, 0xcb, 0x13, 0x7f, 0xfb, 0x62, 0x79, 0xe2, 0x34, 0xcd, 0x09, 0xbe, 0xf2, 0xef, 0x01, 0x00,
+	0x32, 0x70, 0x3c, 0x81, 0x8f, 0x24, 0x00, 0x00,
+}
+
+func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingUpdateV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingUpdateV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FundingValuePpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FundingValuePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingEventV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3658,6 +4109,48 @@ func (m *StatefulOrderEventV1_OrderReplace) MarshalToSizedBuffer(dAtA []byte) (i
 	}
 	return len(dAtA) - i, nil
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConditionalOrderTriggerUpdated != nil {
+		{
+			size, err := m.ConditionalOrderTriggerUpdated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TwapOrderPlacement != nil {
+		{
+			size, err := m.TwapOrderPlacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3873,6 +4366,81 @@ func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != nil {
+		{
+			size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != nil {
+		{
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AssetCreateEventV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4383,7 +4951,82 @@ func (m *OpenInterestUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityTierUpsertEventV2) Marshal() (dAtA []byte, err error) {
+func (m *MarkPriceUpdateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkPriceUpdateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkPriceUpdateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarkPriceUpdates) > 0 {
+		for iNdEx := len(m.MarkPriceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarkPriceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkPriceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkPriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.BasisPpm)<<1)^uint32((m.BasisPpm>>31))))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityTierUpsertEventV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4443,6 +5086,112 @@ func (m *LiquidityTierUpsertEventV2) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SpotMarketCreateEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotMarketCreateEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotMarketCreateEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StepBaseQuantums != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StepBaseQuantums))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SubticksPerTick != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SubticksPerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QuantumConversionExponent != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64((uint32(m.QuantumConversionExponent)<<1)^uint32((m.QuantumConversionExponent>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QuoteAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QuoteAssetId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseAssetId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BaseAssetId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAssetEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAssetEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAssetEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaircutPpm != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HaircutPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasMarket {
+		i--
+		if m.HasMarket {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -4878,6 +5627,30 @@ func (m *StatefulOrderEventV1_OrderReplace) Size() (n int) {
 	}
 	return n
 }
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionalOrderTriggerUpdated != nil {
+		l = m.ConditionalOrderTriggerUpdated.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TwapOrderPlacement != nil {
+		l = m.TwapOrderPlacement.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *StatefulOrderEventV1_StatefulOrderPlacementV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4959,6 +5732,35 @@ func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Size() (n int) {
 	return n
 }
 
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != nil {
+		l = m.OrderId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ConditionalOrderTriggerSubticks != 0 {
+		n += 1 + sovEvents(uint64(m.ConditionalOrderTriggerSubticks))
+	}
+	return n
+}
+
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *AssetCreateEventV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5200,6 +6002,39 @@ func (m *OpenInterestUpdate) Size() (n int) {
 	return n
 }
 
+func (m *MarkPriceUpdateEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarkPriceUpdates) > 0 {
+		for _, e := range m.MarkPriceUpdates {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarkPriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovEvents(uint64(m.PerpetualId))
+	}
+	if m.Price != 0 {
+		n += 1 + sovEvents(uint64(m.Price))
+	}
+	if m.BasisPpm != 0 {
+		n += 1 + sozEvents(uint64(m.BasisPpm))
+	}
+	return n
+}
+
 func (m *LiquidityTierUpsertEventV2) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5231,17 +6066,68 @@ func (m *LiquidityTierUpsertEventV2) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SpotMarketCreateEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovEvents(uint64(m.ClobPairId))
+	}
+	if m.BaseAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.BaseAssetId))
+	}
+	if m.QuoteAssetId != 0 {
+		n += 1 + sovEvents(uint64(m.QuoteAssetId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.QuantumConversionExponent != 0 {
+		n += 1 + sozEvents(uint64(m.QuantumConversionExponent))
+	}
+	if m.SubticksPerTick != 0 {
+		n += 1 + sovEvents(uint64(m.SubticksPerTick))
+	}
+	if m.StepBaseQuantums != 0 {
+		n += 1 + sovEvents(uint64(m.StepBaseQuantums))
+	}
+	return n
 }
-func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *UpdateAssetEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.HasMarket {
+		n += 2
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	if m.HaircutPpm != 0 {
+		n += 1 + sovEvents(uint64(m.HaircutPpm))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FundingUpdateV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -7384,6 +8270,76 @@ func (m *StatefulOrderEventV1) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &StatefulOrderEventV1_OrderReplace{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerUpdated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_ConditionalOrderTriggerUpdated{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StatefulOrderEventV1_TwapOrderPlacementV1{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &StatefulOrderEventV1_TwapOrderPlacement{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -7940,7 +8896,7 @@ func (m *StatefulOrderEventV1_LongTermOrderReplacementV1) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
+func (m *StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7963,36 +8919,17 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetCreateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConditionalOrderTriggerUpdatedV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConditionalOrderTriggerUpdatedV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8002,29 +8939,33 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if m.OrderId == nil {
+				m.OrderId = &types.IndexerOrderId{}
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMarket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrderTriggerSubticks", wireType)
 			}
-			var v int
+			m.ConditionalOrderTriggerSubticks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8034,36 +8975,66 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ConditionalOrderTriggerSubticks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMarket = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatefulOrderEventV1_TwapOrderPlacementV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapOrderPlacementV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapOrderPlacementV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8073,13 +9044,28 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
-			m.AtomicResolution = v
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &types.IndexerOrder{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -8101,7 +9087,7 @@ func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
+func (m *AssetCreateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8124,10 +9110,10 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetCreateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8150,27 +9136,8 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
-			}
-			m.ClobPairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8198,7 +9165,187 @@ func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMarket", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMarket = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtomicResolution", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.AtomicResolution = v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMarketCreateEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
@@ -9484,7 +10631,7 @@ func (m *OpenInterestUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
+func (m *MarkPriceUpdateEventV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9507,36 +10654,17 @@ func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityTierUpsertEventV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarkPriceUpdateEventV1: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityTierUpsertEventV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarkPriceUpdateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9546,59 +10674,271 @@ func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MarkPriceUpdates = append(m.MarkPriceUpdates, &MarkPriceUpdate{})
+			if err := m.MarkPriceUpdates[len(m.MarkPriceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.InitialMarginPpm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialMarginPpm |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaintenanceFractionPpm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
-				if b < 0x80 {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkPriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkPriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.BasisPpm = v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityTierUpsertEventV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityTierUpsertEventV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			}
+			m.InitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
+			}
+			m.MaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
@@ -9680,6 +11020,318 @@ func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpotMarketCreateEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotMarketCreateEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotMarketCreateEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetId", wireType)
+			}
+			m.BaseAssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseAssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetId", wireType)
+			}
+			m.QuoteAssetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteAssetId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.ClobPairStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantumConversionExponent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.QuantumConversionExponent = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubticksPerTick", wireType)
+			}
+			m.SubticksPerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubticksPerTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepBaseQuantums", wireType)
+			}
+			m.StepBaseQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepBaseQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAssetEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAssetEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAssetEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMarket", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMarket = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutPpm", wireType)
+			}
+			m.HaircutPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaircutPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,28 @@
+package events
+
+import (
+	v1 "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// NewSpotMarketCreateEvent creates a SpotMarketCreateEvent
+// representing creation of a spot market.
+func NewSpotMarketCreateEvent(
+	clobPairId uint32,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	status clobtypes.ClobPair_Status,
+	quantumConversionExponent int32,
+	subticksPerTick uint32,
+	stepBaseQuantums uint64,
+) *SpotMarketCreateEventV1 {
+	return &SpotMarketCreateEventV1{
+		ClobPairId:                clobPairId,
+		BaseAssetId:               baseAssetId,
+		QuoteAssetId:              quoteAssetId,
+		Status:                    v1.ConvertToClobPairStatus(status),
+		QuantumConversionExponent: quantumConversionExponent,
+		SubticksPerTick:           subticksPerTick,
+		StepBaseQuantums:          stepBaseQuantums,
+	}
+}
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"testing"
+
+	v1types "github.com/dydxprotocol/v4-chain/protocol/indexer/protocol/v1/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpotMarketCreateEvent_Success(t *testing.T) {
+	spotMarketCreateEvent := NewSpotMarketCreateEvent(
+		1,
+		1,
+		0,
+		clobtypes.ClobPair_STATUS_ACTIVE,
+		-8,
+		5,
+		5,
+	)
+	expectedSpotMarketCreateEventProto := &SpotMarketCreateEventV1{
+		ClobPairId:                1,
+		BaseAssetId:               1,
+		QuoteAssetId:              0,
+		Status:                    v1types.ClobPairStatus_CLOB_PAIR_STATUS_ACTIVE,
+		QuantumConversionExponent: -8,
+		SubticksPerTick:           5,
+		StepBaseQuantums:          5,
+	}
+	require.Equal(t, expectedSpotMarketCreateEventProto, spotMarketCreateEvent)
+}
@@ -20,6 +20,20 @@ func NewLongTermOrderPlacementEvent(
 	}
 }
 
+func NewLongTermOrderReplacementEvent(
+	order clobtypes.Order,
+) *StatefulOrderEventV1 {
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	orderReplace := StatefulOrderEventV1_LongTermOrderReplacementV1{
+		Order: &indexerOrder,
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_OrderReplace{
+			OrderReplace: &orderReplace,
+		},
+	}
+}
+
 func NewStatefulOrderRemovalEvent(
 	removedOrderId clobtypes.OrderId,
 	reason sharedtypes.OrderRemovalReason,
@@ -63,3 +77,33 @@ func NewConditionalOrderTriggeredEvent(
 		},
 	}
 }
+
+func NewConditionalOrderTriggerUpdatedEvent(
+	orderId clobtypes.OrderId,
+	triggerSubticks clobtypes.Subticks,
+) *StatefulOrderEventV1 {
+	indexerOrderId := v1.OrderIdToIndexerOrderId(orderId)
+	triggerUpdated := StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{
+		OrderId:                         &indexerOrderId,
+		ConditionalOrderTriggerSubticks: triggerSubticks.ToUint64(),
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_ConditionalOrderTriggerUpdated{
+			ConditionalOrderTriggerUpdated: &triggerUpdated,
+		},
+	}
+}
+
+func NewTwapOrderPlacementEvent(
+	order clobtypes.Order,
+) *StatefulOrderEventV1 {
+	indexerOrder := v1.OrderToIndexerOrder(order)
+	orderPlace := StatefulOrderEventV1_TwapOrderPlacementV1{
+		Order: &indexerOrder,
+	}
+	return &StatefulOrderEventV1{
+		Event: &StatefulOrderEventV1_TwapOrderPlacement{
+			TwapOrderPlacement: &orderPlace,
+		},
+	}
+}
@@ -30,6 +30,18 @@ func TestLongTermOrderPlacementEvent_Success(t *testing.T) {
 	require.Equal(t, expectedStatefulOrderEventProto, longTermOrderPlacementEvent)
 }
 
+func TestLongTermOrderReplacementEvent_Success(t *testing.T) {
+	longTermOrderReplacementEvent := events.NewLongTermOrderReplacementEvent(order)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_OrderReplace{
+			OrderReplace: &events.StatefulOrderEventV1_LongTermOrderReplacementV1{
+				Order: &indexerOrder,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, longTermOrderReplacementEvent)
+}
+
 func TestStatefulOrderRemovalEvent_Success(t *testing.T) {
 	statefulOrderRemovalEvent := events.NewStatefulOrderRemovalEvent(orderId, reason)
 	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
@@ -66,3 +78,28 @@ func TestConditionalOrderTriggeredEvent_Success(t *testing.T) {
 	}
 	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggeredEvent)
 }
+
+func TestConditionalOrderTriggerUpdatedEvent_Success(t *testing.T) {
+	conditionalOrderTriggerUpdatedEvent := events.NewConditionalOrderTriggerUpdatedEvent(orderId, 25)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_ConditionalOrderTriggerUpdated{
+			ConditionalOrderTriggerUpdated: &events.StatefulOrderEventV1_ConditionalOrderTriggerUpdatedV1{
+				OrderId:                         &indexerOrderId,
+				ConditionalOrderTriggerSubticks: 25,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, conditionalOrderTriggerUpdatedEvent)
+}
+
+func TestTwapOrderPlacementEvent_Success(t *testing.T) {
+	twapOrderPlacementEvent := events.NewTwapOrderPlacementEvent(order)
+	expectedStatefulOrderEventProto := &events.StatefulOrderEventV1{
+		Event: &events.StatefulOrderEventV1_TwapOrderPlacement{
+			TwapOrderPlacement: &events.StatefulOrderEventV1_TwapOrderPlacementV1{
+				Order: &indexerOrder,
+			},
+		},
+	}
+	require.Equal(t, expectedStatefulOrderEventProto, twapOrderPlacementEvent)
+}
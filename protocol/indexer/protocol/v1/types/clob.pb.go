@@ -178,18 +178,25 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	IndexerOrder_CONDITION_TYPE_TAKE_PROFIT IndexerOrder_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed offset when the oracle price moves
+	// in the favorable direction.
+	IndexerOrder_CONDITION_TYPE_TRAILING_STOP IndexerOrder_ConditionType = 3
 )
 
 var IndexerOrder_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var IndexerOrder_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x IndexerOrder_ConditionType) String() string {
@@ -330,6 +337,16 @@ type IndexerOrder struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// trailing_offset_subticks is the distance, in subticks, that the trigger
+	// price of a CONDITION_TYPE_TRAILING_STOP order trails the oracle price.
+	TrailingOffsetSubticks uint64 `protobuf:"varint,12,opt,name=trailing_offset_subticks,json=trailingOffsetSubticks,proto3" json:"trailing_offset_subticks,omitempty"`
+	// trailing_offset_ppm is the distance, in parts-per-million of the oracle
+	// price, that the trigger price of a CONDITION_TYPE_TRAILING_STOP order
+	// trails the oracle price.
+	TrailingOffsetPpm uint32 `protobuf:"varint,13,opt,name=trailing_offset_ppm,json=trailingOffsetPpm,proto3" json:"trailing_offset_ppm,omitempty"`
+	// twap_parameters are the parameters of a TWAP order. Nil for all other
+	// orders.
+	TwapParameters *TwapParameters `protobuf:"bytes,14,opt,name=twap_parameters,json=twapParameters,proto3" json:"twap_parameters,omitempty"`
 }
 
 func (m *IndexerOrder) Reset()         { *m = IndexerOrder{} }
@@ -465,6 +482,27 @@ func (m *IndexerOrder) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *IndexerOrder) GetTrailingOffsetSubticks() uint64 {
+	if m != nil {
+		return m.TrailingOffsetSubticks
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetTrailingOffsetPpm() uint32 {
+	if m != nil {
+		return m.TrailingOffsetPpm
+	}
+	return 0
+}
+
+func (m *IndexerOrder) GetTwapParameters() *TwapParameters {
+	if m != nil {
+		return m.TwapParameters
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*IndexerOrder) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -473,6 +511,62 @@ func (*IndexerOrder) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// TwapParameters represents the parameters of a TWAP order.
+// Defined in clob.order.
+type TwapParameters struct {
+	// Duration of the TWAP order in seconds.
+	Duration uint32 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Interval between the suborders of the TWAP order in seconds.
+	Interval uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *TwapParameters) Reset()         { *m = TwapParameters{} }
+func (m *TwapParameters) String() string { return proto.CompactTextString(m) }
+func (*TwapParameters) ProtoMessage()    {}
+func (*TwapParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac8923e70f7ca3c, []int{2}
+}
+func (m *TwapParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapParameters.Merge(m, src)
+}
+func (m *TwapParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapParameters proto.InternalMessageInfo
+
+func (m *TwapParameters) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *TwapParameters) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.ClobPairStatus", ClobPairStatus_name, ClobPairStatus_value)
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_Side", IndexerOrder_Side_name, IndexerOrder_Side_value)
@@ -480,6 +574,7 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.indexer.protocol.v1.IndexerOrder_ConditionType", IndexerOrder_ConditionType_name, IndexerOrder_ConditionType_value)
 	proto.RegisterType((*IndexerOrderId)(nil), "dydxprotocol.indexer.protocol.v1.IndexerOrderId")
 	proto.RegisterType((*IndexerOrder)(nil), "dydxprotocol.indexer.protocol.v1.IndexerOrder")
+	proto.RegisterType((*TwapParameters)(nil), "dydxprotocol.indexer.protocol.v1.TwapParameters")
 }
 
 func init() {
@@ -487,60 +582,67 @@ func init() {
 }
 
 var fileDescriptor_fac8923e70f7ca3c = []byte{
-	// 833 bytes of a gzipped FileDescriptorProto
+	// 950 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0x8e, 0xd3, 0xac, 0x4d, 0x4f, 0x7e, 0x60, 0x2e, 0x43, 0x33, 0xed, 0x96, 0x66, 0x91, 0x80,
-	0x0a, 0x44, 0x42, 0x37, 0x78, 0x00, 0xf1, 0x92, 0xb8, 0xce, 0x76, 0x55, 0xd7, 0x0e, 0xf6, 0x2d,
-	0x52, 0x27, 0xc1, 0xc5, 0xb1, 0x6f, 0xb3, 0xab, 0x39, 0xbe, 0xc5, 0x71, 0xaa, 0xe5, 0x8d, 0x3f,
-	0x81, 0x3f, 0x6b, 0x8f, 0x13, 0x0f, 0x88, 0x27, 0x84, 0xda, 0x7f, 0x82, 0x47, 0x74, 0x6d, 0xe3,
-	0x25, 0xcd, 0x44, 0xd7, 0x37, 0xdf, 0xef, 0x7c, 0xe7, 0xd3, 0xf9, 0xbe, 0x73, 0x6d, 0xc3, 0xe7,
-	0xc1, 0x22, 0x78, 0x79, 0x1e, 0x8b, 0x44, 0xf8, 0x22, 0xec, 0xf1, 0x28, 0x60, 0x2f, 0x59, 0xdc,
-	0x2b, 0x80, 0x8b, 0x83, 0x9e, 0x1f, 0x8a, 0x71, 0x37, 0x05, 0x50, 0x7b, 0x99, 0xdc, 0xcd, 0xc9,
-	0xdd, 0x02, 0xb8, 0x38, 0xd8, 0x39, 0xb8, 0x51, 0x6e, 0x36, 0x1f, 0x7b, 0xbe, 0x2f, 0xe6, 0x51,
-	0x92, 0x35, 0xee, 0xdc, 0x9d, 0x88, 0x89, 0x48, 0x1f, 0x7b, 0xf2, 0x29, 0x43, 0x3b, 0xbf, 0x2b,
-	0xd0, 0xc4, 0x59, 0xbb, 0x1d, 0x07, 0x2c, 0xc6, 0x01, 0xfa, 0x19, 0x1a, 0x6f, 0x9a, 0x29, 0x0f,
-	0x34, 0xa5, 0xad, 0xec, 0xd7, 0x1e, 0x7d, 0xdd, 0xbd, 0x69, 0xaa, 0x6e, 0x2e, 0xe4, 0x16, 0xdd,
-	0x38, 0x18, 0x54, 0x5e, 0xfd, 0xb5, 0x57, 0x72, 0xea, 0xb3, 0x25, 0x0c, 0xed, 0xc2, 0xb6, 0x1f,
-	0x72, 0x96, 0xa9, 0x97, 0xdb, 0xca, 0xfe, 0x96, 0x53, 0xcd, 0x00, 0x1c, 0xa0, 0x3d, 0xa8, 0x09,
-	0x39, 0x09, 0x3d, 0x0b, 0xbd, 0xc9, 0x4c, 0xdb, 0x68, 0x2b, 0xfb, 0x0d, 0x07, 0x52, 0x68, 0x28,
-	0x11, 0xd4, 0x86, 0xba, 0xcc, 0x8a, 0x9e, 0x7b, 0x3c, 0x96, 0x02, 0x95, 0x8c, 0x21, 0xb1, 0x91,
-	0xc7, 0x63, 0x1c, 0x74, 0xfe, 0xd8, 0x82, 0xfa, 0xb2, 0x29, 0xf4, 0x3d, 0x54, 0x33, 0xcd, 0xc2,
-	0xcd, 0x97, 0xef, 0xec, 0x26, 0x8f, 0x25, 0x37, 0xb2, 0x25, 0xf2, 0x94, 0x9e, 0x40, 0x65, 0xc6,
-	0x03, 0x96, 0x8e, 0xdf, 0x7c, 0xf4, 0xf8, 0x76, 0x72, 0x5d, 0x97, 0x07, 0xcc, 0x49, 0x05, 0xd0,
-	0x0e, 0x54, 0x7f, 0x99, 0x7b, 0x51, 0x32, 0x9f, 0x66, 0x66, 0x2b, 0x4e, 0x71, 0x96, 0xb5, 0xd9,
-	0x7c, 0x9c, 0x70, 0xff, 0xc5, 0x2c, 0xb5, 0x59, 0x71, 0x8a, 0x33, 0xfa, 0x04, 0x9a, 0x13, 0x21,
-	0x02, 0x9a, 0xf0, 0x90, 0x8e, 0x43, 0xe1, 0xbf, 0xd0, 0xee, 0xc8, 0x20, 0x9e, 0x96, 0x9c, 0xba,
-	0xc4, 0x09, 0x0f, 0x07, 0x12, 0x45, 0x3d, 0xf8, 0x60, 0x95, 0x47, 0x13, 0x3e, 0x65, 0xda, 0xa6,
-	0x8c, 0xfd, 0x69, 0xc9, 0x51, 0x97, 0xc9, 0x84, 0x4f, 0x19, 0xfa, 0x09, 0x1a, 0x92, 0x41, 0x79,
-	0x44, 0xcf, 0x44, 0xec, 0x33, 0x6d, 0x2b, 0xb5, 0xf8, 0xed, 0x2d, 0x2d, 0x4a, 0x2d, 0x1c, 0x0d,
-	0xa5, 0x82, 0x53, 0x4b, 0xde, 0x1c, 0xe4, 0x82, 0x63, 0x16, 0xcc, 0x7d, 0x46, 0x45, 0x14, 0x2e,
-	0xb4, 0x6a, 0x5b, 0xd9, 0xaf, 0x3a, 0x90, 0x41, 0x76, 0x14, 0x2e, 0xd0, 0xa7, 0xf0, 0x5e, 0x7e,
-	0x3d, 0xa6, 0x2c, 0xf1, 0x02, 0x2f, 0xf1, 0xb4, 0xed, 0x74, 0xc7, 0xcd, 0x0c, 0x3e, 0xce, 0x51,
-	0xe4, 0x43, 0xd3, 0x17, 0x51, 0xc0, 0x13, 0x2e, 0x22, 0x9a, 0x2c, 0xce, 0x99, 0x06, 0xe9, 0xa8,
-	0xdf, 0xdd, 0x72, 0x54, 0xfd, 0x3f, 0x11, 0xb2, 0x38, 0x67, 0x4e, 0xc3, 0x5f, 0x3e, 0xa2, 0x23,
-	0xe8, 0x14, 0x80, 0x17, 0xd2, 0xec, 0x1e, 0x25, 0x31, 0x9f, 0x4c, 0x58, 0x4c, 0x8b, 0xed, 0xd4,
-	0xd2, 0xed, 0xec, 0x2d, 0x31, 0x53, 0x69, 0x92, 0xf1, 0xdc, 0x9c, 0xd6, 0xf9, 0x06, 0x2a, 0x72,
-	0xf5, 0xe8, 0x2e, 0xa8, 0x2e, 0x3e, 0x34, 0xe8, 0x89, 0xe5, 0x8e, 0x0c, 0x1d, 0x0f, 0xb1, 0x71,
-	0xa8, 0x96, 0x50, 0x1d, 0xaa, 0x29, 0x3a, 0x38, 0x39, 0x55, 0x15, 0xd4, 0x80, 0xed, 0xf4, 0xe4,
-	0x1a, 0xa6, 0xa9, 0x96, 0x3b, 0xbf, 0x2a, 0x50, 0x5b, 0xca, 0x14, 0x3d, 0x80, 0x8f, 0x08, 0x3e,
-	0x36, 0x28, 0xb6, 0xe8, 0xd0, 0x76, 0xf4, 0xeb, 0x5a, 0x1f, 0xc2, 0xfb, 0xab, 0x65, 0x6c, 0xeb,
-	0xaa, 0x82, 0x76, 0xe1, 0xde, 0x2a, 0x3c, 0xb2, 0x5d, 0x42, 0x6d, 0xcb, 0x3c, 0x55, 0xcb, 0xa8,
-	0x05, 0x3b, 0xab, 0xc5, 0x21, 0x36, 0x4d, 0x6a, 0x3b, 0xf4, 0x08, 0x9b, 0xa6, 0xba, 0xd1, 0x99,
-	0x42, 0x63, 0x25, 0x2a, 0xd9, 0xa0, 0xdb, 0xd6, 0x21, 0x26, 0xd8, 0xb6, 0x28, 0x39, 0x1d, 0x5d,
-	0x1f, 0xe2, 0x3e, 0x68, 0xd7, 0xea, 0x2e, 0xb1, 0x47, 0xd4, 0xb4, 0x5d, 0x57, 0x55, 0xde, 0xd2,
-	0x4d, 0xfa, 0x47, 0x06, 0x1d, 0x39, 0xf6, 0x10, 0x13, 0xb5, 0x3c, 0x50, 0x97, 0x6e, 0xb8, 0x88,
-	0x98, 0x38, 0xfb, 0xec, 0x1f, 0x05, 0x9a, 0x7a, 0xfe, 0x9e, 0xbb, 0x89, 0x97, 0xcc, 0xe5, 0xd7,
-	0xe0, 0xbe, 0x6e, 0xda, 0x03, 0x3a, 0xea, 0x63, 0x87, 0xba, 0xa4, 0x4f, 0x4e, 0xdc, 0x6b, 0x43,
-	0xec, 0xc2, 0xbd, 0x35, 0x46, 0x5f, 0x27, 0xf8, 0x07, 0x23, 0xcb, 0x63, 0xad, 0x38, 0xea, 0x9f,
-	0xb8, 0xc6, 0xa1, 0x5a, 0x7e, 0xab, 0xb6, 0xde, 0xb7, 0x74, 0xc3, 0xcc, 0x12, 0xdb, 0x48, 0x2d,
-	0xac, 0xb5, 0x17, 0x89, 0x56, 0xd0, 0x43, 0x78, 0xb0, 0x56, 0xc7, 0x16, 0x26, 0xb8, 0x6f, 0xe2,
-	0x67, 0xd8, 0x7a, 0xa2, 0xde, 0x41, 0x1f, 0xc3, 0xc3, 0x35, 0xca, 0x10, 0x5b, 0x7d, 0x93, 0xba,
-	0x06, 0x21, 0xa6, 0x71, 0x6c, 0x58, 0x44, 0xdd, 0x1c, 0xfc, 0xf8, 0xea, 0xb2, 0xa5, 0xbc, 0xbe,
-	0x6c, 0x29, 0x7f, 0x5f, 0xb6, 0x94, 0xdf, 0xae, 0x5a, 0xa5, 0xd7, 0x57, 0xad, 0xd2, 0x9f, 0x57,
-	0xad, 0xd2, 0x33, 0x7d, 0xc2, 0x93, 0xe7, 0xf3, 0x71, 0xd7, 0x17, 0xd3, 0xde, 0xca, 0x6f, 0xe1,
-	0xe2, 0xab, 0x2f, 0xfc, 0xe7, 0x1e, 0x8f, 0x7a, 0xff, 0xfb, 0xa3, 0x90, 0xef, 0xcd, 0x6c, 0xbc,
-	0x99, 0x42, 0x8f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xd9, 0x1f, 0x8a, 0xa8, 0x06, 0x00,
-	0x00,
+	0x14, 0x8e, 0xdb, 0xac, 0x4d, 0x6f, 0x93, 0xcc, 0xbb, 0x1b, 0xcc, 0xb4, 0x5b, 0x9a, 0x45, 0x02,
+	0x2a, 0x10, 0x09, 0xdd, 0x40, 0x02, 0xc4, 0x4b, 0x92, 0x3a, 0xed, 0x55, 0x5d, 0xdb, 0xd8, 0x2e,
+	0x52, 0x27, 0xc1, 0xc5, 0xb1, 0x6f, 0xb3, 0xab, 0x39, 0xbe, 0xc6, 0xbe, 0xe9, 0xd6, 0x37, 0xfe,
+	0x03, 0xf8, 0x8b, 0x78, 0xde, 0xe3, 0xc4, 0x13, 0x4f, 0x08, 0xb5, 0xff, 0x04, 0x8f, 0xe8, 0x5e,
+	0xa7, 0x6e, 0x92, 0x4e, 0xdb, 0xfa, 0x96, 0xf3, 0x7d, 0xe7, 0xfb, 0x74, 0x7e, 0xdc, 0x1c, 0x83,
+	0xcf, 0xc3, 0xb3, 0xf0, 0x65, 0x92, 0x32, 0xce, 0x02, 0x16, 0x75, 0x68, 0x1c, 0x92, 0x97, 0x24,
+	0xed, 0x14, 0xc0, 0xe9, 0x4e, 0x27, 0x88, 0xd8, 0xb0, 0x2d, 0x01, 0xd8, 0x9c, 0x4d, 0x6e, 0x4f,
+	0x93, 0xdb, 0x05, 0x70, 0xba, 0xb3, 0xb1, 0xf3, 0x4e, 0xbb, 0x6c, 0x32, 0xf4, 0x83, 0x80, 0x4d,
+	0x62, 0x9e, 0x0b, 0x37, 0xee, 0x8d, 0xd8, 0x88, 0xc9, 0x9f, 0x1d, 0xf1, 0x2b, 0x47, 0x5b, 0x7f,
+	0x29, 0xa0, 0x8e, 0x72, 0xb9, 0x95, 0x86, 0x24, 0x45, 0x21, 0xfc, 0x05, 0xd4, 0xae, 0xc4, 0x98,
+	0x86, 0x9a, 0xd2, 0x54, 0xb6, 0xd7, 0x1f, 0x7f, 0xdd, 0x7e, 0x57, 0x55, 0xed, 0xa9, 0x91, 0x5b,
+	0xa8, 0x51, 0xd8, 0x2b, 0xbf, 0xfa, 0x67, 0xab, 0xe4, 0x54, 0xb3, 0x19, 0x0c, 0x6e, 0x82, 0xb5,
+	0x20, 0xa2, 0x24, 0x77, 0x5f, 0x6a, 0x2a, 0xdb, 0xab, 0x4e, 0x25, 0x07, 0x50, 0x08, 0xb7, 0xc0,
+	0x3a, 0x13, 0x95, 0xe0, 0x93, 0xc8, 0x1f, 0x65, 0xda, 0x72, 0x53, 0xd9, 0xae, 0x39, 0x40, 0x42,
+	0x03, 0x81, 0xc0, 0x26, 0xa8, 0x8a, 0x59, 0xe1, 0xc4, 0xa7, 0xa9, 0x30, 0x28, 0xe7, 0x19, 0x02,
+	0xb3, 0x7d, 0x9a, 0xa2, 0xb0, 0xf5, 0xe7, 0x1a, 0xa8, 0xce, 0x36, 0x05, 0x7f, 0x00, 0x95, 0xdc,
+	0xb3, 0xe8, 0xe6, 0xcb, 0xf7, 0xee, 0x66, 0x3a, 0x96, 0x69, 0x23, 0xab, 0x6c, 0x3a, 0xa5, 0x3d,
+	0x50, 0xce, 0x68, 0x48, 0x64, 0xf9, 0xf5, 0xc7, 0x4f, 0x6e, 0x66, 0xd7, 0x76, 0x69, 0x48, 0x1c,
+	0x69, 0x00, 0x37, 0x40, 0xe5, 0xd7, 0x89, 0x1f, 0xf3, 0xc9, 0x38, 0x6f, 0xb6, 0xec, 0x14, 0xb1,
+	0xe0, 0xb2, 0xc9, 0x90, 0xd3, 0xe0, 0x79, 0x26, 0xdb, 0x2c, 0x3b, 0x45, 0x0c, 0x3f, 0x01, 0xf5,
+	0x11, 0x63, 0x21, 0xe6, 0x34, 0xc2, 0xc3, 0x88, 0x05, 0xcf, 0xb5, 0x5b, 0x62, 0x10, 0xfb, 0x25,
+	0xa7, 0x2a, 0x70, 0x8f, 0x46, 0x3d, 0x81, 0xc2, 0x0e, 0xb8, 0x3b, 0x9f, 0x87, 0x39, 0x1d, 0x13,
+	0x6d, 0x45, 0x8c, 0x7d, 0xbf, 0xe4, 0xa8, 0xb3, 0xc9, 0x1e, 0x1d, 0x13, 0xf8, 0x33, 0xa8, 0x89,
+	0x0c, 0x4c, 0x63, 0x7c, 0xc2, 0xd2, 0x80, 0x68, 0xab, 0xb2, 0xc5, 0xef, 0x6e, 0xd8, 0xa2, 0xf0,
+	0x42, 0xf1, 0x40, 0x38, 0x38, 0xeb, 0xfc, 0x2a, 0x10, 0x0b, 0x4e, 0x49, 0x38, 0x09, 0x08, 0x66,
+	0x71, 0x74, 0xa6, 0x55, 0x9a, 0xca, 0x76, 0xc5, 0x01, 0x39, 0x64, 0xc5, 0xd1, 0x19, 0xfc, 0x14,
+	0xdc, 0x9e, 0x3e, 0x8f, 0x31, 0xe1, 0x7e, 0xe8, 0x73, 0x5f, 0x5b, 0x93, 0x3b, 0xae, 0xe7, 0xf0,
+	0xe1, 0x14, 0x85, 0x01, 0xa8, 0x07, 0x2c, 0x0e, 0x29, 0xa7, 0x2c, 0xc6, 0xfc, 0x2c, 0x21, 0x1a,
+	0x90, 0xa5, 0x7e, 0x7f, 0xc3, 0x52, 0xfb, 0x97, 0x26, 0xde, 0x59, 0x42, 0x9c, 0x5a, 0x30, 0x1b,
+	0xc2, 0x03, 0xd0, 0x2a, 0x00, 0x3f, 0xc2, 0xf9, 0x3b, 0xe2, 0x29, 0x1d, 0x8d, 0x48, 0x8a, 0x8b,
+	0xed, 0xac, 0xcb, 0xed, 0x6c, 0xcd, 0x64, 0x4a, 0x6b, 0x2f, 0xcf, 0x73, 0x2f, 0x97, 0xf6, 0x0d,
+	0xd0, 0x78, 0xea, 0xd3, 0x88, 0xc6, 0x23, 0xcc, 0x4e, 0x4e, 0x32, 0xc2, 0xaf, 0x2c, 0xaa, 0xd2,
+	0xe2, 0xc3, 0x4b, 0xde, 0x92, 0x74, 0xa1, 0x6c, 0x83, 0xbb, 0x8b, 0xca, 0x24, 0x19, 0x6b, 0x35,
+	0x39, 0x98, 0x3b, 0xf3, 0x22, 0x3b, 0x19, 0xc3, 0x63, 0x70, 0x9b, 0xbf, 0xf0, 0x13, 0x9c, 0xf8,
+	0xa9, 0x3f, 0x26, 0x9c, 0xa4, 0x99, 0x56, 0x7f, 0xdf, 0x97, 0xef, 0xbd, 0xf0, 0x13, 0xbb, 0xd0,
+	0x39, 0x75, 0x3e, 0x17, 0xb7, 0xbe, 0x05, 0x65, 0xf1, 0x7e, 0xe1, 0x3d, 0xa0, 0xba, 0x68, 0x57,
+	0xc7, 0x47, 0xa6, 0x6b, 0xeb, 0x7d, 0x34, 0x40, 0xfa, 0xae, 0x5a, 0x82, 0x55, 0x50, 0x91, 0x68,
+	0xef, 0xe8, 0x58, 0x55, 0x60, 0x0d, 0xac, 0xc9, 0xc8, 0xd5, 0x0d, 0x43, 0x5d, 0x6a, 0xfd, 0xa6,
+	0x80, 0xf5, 0x99, 0x87, 0x01, 0x1f, 0x82, 0x8f, 0x3c, 0x74, 0xa8, 0x63, 0x64, 0xe2, 0x81, 0xe5,
+	0xf4, 0x17, 0xbd, 0x3e, 0x00, 0x77, 0xe6, 0x69, 0x64, 0xf5, 0x55, 0x05, 0x6e, 0x82, 0xfb, 0xf3,
+	0xb0, 0x6d, 0xb9, 0x1e, 0xb6, 0x4c, 0xe3, 0x58, 0x5d, 0x82, 0x0d, 0xb0, 0x31, 0x4f, 0x0e, 0x90,
+	0x61, 0x60, 0xcb, 0xc1, 0x07, 0xc8, 0x30, 0xd4, 0xe5, 0xd6, 0xef, 0x0a, 0xa8, 0xcd, 0x2d, 0x5c,
+	0x28, 0xfa, 0x96, 0xb9, 0x8b, 0x3c, 0x64, 0x99, 0xd8, 0x3b, 0xb6, 0x17, 0xab, 0x78, 0x00, 0xb4,
+	0x05, 0xde, 0xf5, 0x2c, 0x1b, 0x1b, 0x96, 0xeb, 0xaa, 0xca, 0x1b, 0xd4, 0x5e, 0xf7, 0x40, 0xc7,
+	0xb6, 0x63, 0x0d, 0x90, 0xa7, 0x2e, 0xc1, 0x26, 0x78, 0xb0, 0xc8, 0x3b, 0x5d, 0x64, 0x20, 0x73,
+	0x4f, 0xda, 0xa8, 0xcb, 0x3d, 0x75, 0xe6, 0x9f, 0xcc, 0x62, 0xc2, 0x4e, 0x5a, 0xfb, 0xa0, 0x3e,
+	0xbf, 0x03, 0x71, 0x09, 0xc2, 0x49, 0xea, 0x8b, 0x9a, 0xe5, 0x05, 0xab, 0x39, 0x45, 0x2c, 0x38,
+	0x1a, 0x73, 0x92, 0x9e, 0xfa, 0x91, 0x3c, 0x47, 0x35, 0xa7, 0x88, 0x3f, 0xfb, 0x4f, 0x01, 0xf5,
+	0xfe, 0xf4, 0x32, 0xba, 0xdc, 0xe7, 0x93, 0x4c, 0x16, 0x64, 0x58, 0x3d, 0x6c, 0x77, 0x91, 0x83,
+	0x5d, 0xaf, 0xeb, 0x1d, 0xb9, 0x0b, 0x0d, 0x6f, 0x82, 0xfb, 0xd7, 0x32, 0xba, 0x7d, 0x0f, 0xfd,
+	0xa8, 0xab, 0xca, 0x1b, 0x49, 0xbb, 0x7b, 0xe4, 0xea, 0xbb, 0xd3, 0x66, 0x17, 0xc9, 0x7e, 0xd7,
+	0xec, 0xeb, 0x46, 0xbe, 0x9e, 0x65, 0x39, 0xae, 0x6b, 0xf2, 0x62, 0x7d, 0x65, 0xf8, 0x08, 0x3c,
+	0xbc, 0xc6, 0x23, 0x13, 0x79, 0xa8, 0x6b, 0xa0, 0xa7, 0xc8, 0xdc, 0x53, 0x6f, 0xc1, 0x8f, 0xc1,
+	0xa3, 0x6b, 0x29, 0x03, 0x64, 0x76, 0x0d, 0xec, 0xea, 0x9e, 0x67, 0xe8, 0x87, 0xba, 0xe9, 0xa9,
+	0x2b, 0xbd, 0x9f, 0x5e, 0x9d, 0x37, 0x94, 0xd7, 0xe7, 0x0d, 0xe5, 0xdf, 0xf3, 0x86, 0xf2, 0xc7,
+	0x45, 0xa3, 0xf4, 0xfa, 0xa2, 0x51, 0xfa, 0xfb, 0xa2, 0x51, 0x7a, 0xda, 0x1f, 0x51, 0xfe, 0x6c,
+	0x32, 0x6c, 0x07, 0x6c, 0xdc, 0x99, 0xfb, 0x90, 0x9e, 0x7e, 0xf5, 0x45, 0xf0, 0xcc, 0xa7, 0x71,
+	0xe7, 0xad, 0x9f, 0x56, 0x71, 0x69, 0xb2, 0xe1, 0x8a, 0x84, 0x9e, 0xfc, 0x3f, 0x00, 0x78, 0xca,
+	0x0b, 0xad, 0xda, 0x07, 0x00, 0x00,
 }
 
 func (m *IndexerOrderId) Marshal() (dAtA []byte, err error) {
@@ -612,6 +714,28 @@ func (m *IndexerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TwapParameters != nil {
+		{
+			size, err := m.TwapParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClob(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TrailingOffsetPpm != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.TrailingOffsetPpm))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TrailingOffsetSubticks != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.TrailingOffsetSubticks))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintClob(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -704,6 +828,39 @@ func (m *IndexerOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int,
 	dAtA[i] = 0x35
 	return len(dAtA) - i, nil
 }
+func (m *TwapParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Duration != 0 {
+		i = encodeVarintClob(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClob(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClob(v)
 	base := offset
@@ -770,6 +927,16 @@ func (m *IndexerOrder) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovClob(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.TrailingOffsetSubticks != 0 {
+		n += 1 + sovClob(uint64(m.TrailingOffsetSubticks))
+	}
+	if m.TrailingOffsetPpm != 0 {
+		n += 1 + sovClob(uint64(m.TrailingOffsetPpm))
+	}
+	if m.TwapParameters != nil {
+		l = m.TwapParameters.Size()
+		n += 1 + l + sovClob(uint64(l))
+	}
 	return n
 }
 
@@ -791,6 +958,20 @@ func (m *IndexerOrder_GoodTilBlockTime) Size() (n int) {
 	n += 5
 	return n
 }
+func (m *TwapParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		n += 1 + sovClob(uint64(m.Duration))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovClob(uint64(m.Interval))
+	}
+	return n
+}
 
 func sovClob(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1175,6 +1356,168 @@ func (m *IndexerOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetSubticks", wireType)
+			}
+			m.TrailingOffsetSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetPpm", wireType)
+			}
+			m.TrailingOffsetPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClob
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClob
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TwapParameters == nil {
+				m.TwapParameters = &TwapParameters{}
+			}
+			if err := m.TwapParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClob(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClob
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClob
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClob
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClob(dAtA[iNdEx:])
@@ -145,6 +145,9 @@ func orderToIndexerOrder_GoodTilBlock(
 		ClientMetadata:                  order.ClientMetadata,
 		ConditionType:                   OrderConditionTypeToIndexerOrderConditionType(order.ConditionType),
 		ConditionalOrderTriggerSubticks: order.ConditionalOrderTriggerSubticks,
+		TrailingOffsetSubticks:          order.TrailingOffsetSubticks,
+		TrailingOffsetPpm:               order.TrailingOffsetPpm,
+		TwapParameters:                  TwapParametersToIndexerTwapParameters(order.TwapParameters),
 	}
 }
 
@@ -163,6 +166,23 @@ func orderToIndexerOrder_GoodTilBlockTime(
 		ClientMetadata:                  order.ClientMetadata,
 		ConditionType:                   OrderConditionTypeToIndexerOrderConditionType(order.ConditionType),
 		ConditionalOrderTriggerSubticks: order.ConditionalOrderTriggerSubticks,
+		TrailingOffsetSubticks:          order.TrailingOffsetSubticks,
+		TrailingOffsetPpm:               order.TrailingOffsetPpm,
+		TwapParameters:                  TwapParametersToIndexerTwapParameters(order.TwapParameters),
+	}
+}
+
+// TwapParametersToIndexerTwapParameters converts the TWAP parameters of an order to the Indexer
+// TWAP parameters. Returns nil if the order is not a TWAP order.
+func TwapParametersToIndexerTwapParameters(
+	twapParameters *clobtypes.TwapParameters,
+) *v1types.TwapParameters {
+	if twapParameters == nil {
+		return nil
+	}
+	return &v1types.TwapParameters{
+		Duration: twapParameters.Duration,
+		Interval: twapParameters.Interval,
 	}
 }
 
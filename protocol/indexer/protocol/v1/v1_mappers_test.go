@@ -305,6 +305,7 @@ func TestOrderConditionTypeToIndexerOrderConditionType(t *testing.T) {
 func TestOrderToIndexerOrderV1(t *testing.T) {
 	shortTermOrder := constants.Order_Alice_Num1_Id2_Clob1_Buy67_Price5_GTB20
 	statefulOrder := constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
 
 	tests := map[string]struct {
 		// Input
@@ -363,6 +364,32 @@ func TestOrderToIndexerOrderV1(t *testing.T) {
 				ConditionalOrderTriggerSubticks: statefulOrder.ConditionalOrderTriggerSubticks,
 			},
 		},
+		"Maps TWAP order to IndexerOrderV1": {
+			order: twapOrder,
+			expectedOrder: v1types.IndexerOrder{
+				OrderId: v1types.IndexerOrderId{
+					SubaccountId: v1types.IndexerSubaccountId{
+						Owner:  twapOrder.OrderId.SubaccountId.Owner,
+						Number: twapOrder.OrderId.SubaccountId.Number,
+					},
+					ClientId:   twapOrder.OrderId.ClientId,
+					ClobPairId: twapOrder.OrderId.ClobPairId,
+					OrderFlags: twapOrder.OrderId.OrderFlags,
+				},
+				Side:     v1.OrderSideToIndexerOrderSide(twapOrder.Side),
+				Quantums: twapOrder.Quantums,
+				Subticks: twapOrder.Subticks,
+				GoodTilOneof: &v1types.IndexerOrder_GoodTilBlockTime{
+					GoodTilBlockTime: twapOrder.GoodTilOneof.(*clobtypes.Order_GoodTilBlockTime).GoodTilBlockTime,
+				},
+				TimeInForce:   v1.OrderTimeInForceToIndexerOrderTimeInForce(twapOrder.TimeInForce),
+				ConditionType: v1.OrderConditionTypeToIndexerOrderConditionType(twapOrder.ConditionType),
+				TwapParameters: &v1types.TwapParameters{
+					Duration: 300,
+					Interval: 60,
+				},
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -26,10 +26,21 @@ func ConvertOrderRemovalReasonToIndexerOrderRemovalReason(
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_ERROR
 	case clobtypes.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED:
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED
-	case clobtypes.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK:
+	case clobtypes.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK,
+		clobtypes.OrderRemoval_REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK:
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_IMMEDIATE_OR_CANCEL_WOULD_REST_ON_BOOK
 	case clobtypes.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS
+	case clobtypes.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER
+	case clobtypes.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH
+	case clobtypes.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL
+	case clobtypes.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND
+	case clobtypes.OrderRemoval_REMOVAL_REASON_MARKET_MAKER_PROTECTION:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION
 	default:
 		panic("ConvertOrderRemovalReasonToIndexerOrderRemovalReason: unspecified removal reason not allowed")
 	}
@@ -66,6 +77,14 @@ func GetOrderRemovalReason(
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE, nil
 	case clobtypes.ViolatesIsolatedSubaccountConstraints:
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS, nil
+	case clobtypes.SelfTradeCancelTaker:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER, nil
+	case clobtypes.SelfTradeCancelBoth:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH, nil
+	case clobtypes.SelfTradeDecrementAndCancel:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL, nil
+	case clobtypes.OutsidePriceBand:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND, nil
 	default:
 		return 0, fmt.Errorf("unrecognized order status %d and error \"%w\"", orderStatus, orderError)
 	}
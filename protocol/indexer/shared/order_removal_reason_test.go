@@ -40,6 +40,26 @@ func TestGetOrderRemovalReason_Success(t *testing.T) {
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS,
 			expectedErr:    nil,
 		},
+		"Gets order removal reason for order status SelfTradeCancelTaker": {
+			orderStatus:    clobtypes.SelfTradeCancelTaker,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status SelfTradeCancelBoth": {
+			orderStatus:    clobtypes.SelfTradeCancelBoth,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status SelfTradeDecrementAndCancel": {
+			orderStatus:    clobtypes.SelfTradeDecrementAndCancel,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status OutsidePriceBand": {
+			orderStatus:    clobtypes.OutsidePriceBand,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+			expectedErr:    nil,
+		},
 		"Gets order removal reason for order error ErrFokOrderCouldNotBeFullyFilled": {
 			orderError:     clobtypes.ErrFokOrderCouldNotBeFullyFilled,
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED,
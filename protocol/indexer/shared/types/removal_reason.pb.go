@@ -73,6 +73,24 @@ const (
 	// The order has been removed since filling it would lead to the subaccount
 	// violating isolated subaccount constraints.
 	OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS OrderRemovalReason = 15
+	// The order was a taker order with self-trade prevention mode cancel-taker
+	// that would have matched against an order placed by the same subaccount.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER OrderRemovalReason = 16
+	// The order was part of a self trade where the taker order had self-trade
+	// prevention mode cancel-both.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH OrderRemovalReason = 17
+	// The order was part of a self trade where the taker order had self-trade
+	// prevention mode decrement-and-cancel.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL OrderRemovalReason = 18
+	// The order was canceled since another order in its order group was filled
+	// or removed.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED OrderRemovalReason = 19
+	// The order was removed since market-maker protection of its subaccount was
+	// tripped on its clob pair.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION OrderRemovalReason = 20
+	// The remaining size of the order was canceled since it would have crossed
+	// orders priced outside of the price band of its clob pair.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND OrderRemovalReason = 21
 )
 
 var OrderRemovalReason_name = map[int32]string{
@@ -92,6 +110,12 @@ var OrderRemovalReason_name = map[int32]string{
 	13: "ORDER_REMOVAL_REASON_EQUITY_TIER",
 	14: "ORDER_REMOVAL_REASON_FINAL_SETTLEMENT",
 	15: "ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS",
+	16: "ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER",
+	17: "ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH",
+	18: "ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL",
+	19: "ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED",
+	20: "ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION",
+	21: "ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND",
 }
 
 var OrderRemovalReason_value = map[string]int32{
@@ -111,6 +135,12 @@ var OrderRemovalReason_value = map[string]int32{
 	"ORDER_REMOVAL_REASON_EQUITY_TIER":                              13,
 	"ORDER_REMOVAL_REASON_FINAL_SETTLEMENT":                         14,
 	"ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS": 15,
+	"ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER":                  16,
+	"ORDER_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH":                   17,
+	"ORDER_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL":          18,
+	"ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED":                     19,
+	"ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION":                  20,
+	"ORDER_REMOVAL_REASON_OUTSIDE_PRICE_BAND":                       21,
 }
 
 func (x OrderRemovalReason) String() string {
@@ -130,37 +160,42 @@ func init() {
 }
 
 var fileDescriptor_0d5eea5cab8c58ba = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4d, 0x6f, 0x13, 0x3f,
-	0x10, 0xc6, 0x93, 0xff, 0x9f, 0x16, 0x30, 0x6f, 0x96, 0xaf, 0xc0, 0xaa, 0x85, 0x96, 0x96, 0xb7,
-	0x04, 0x09, 0x84, 0x10, 0xaf, 0x72, 0xec, 0x89, 0x64, 0xd5, 0xb1, 0xc3, 0xd8, 0x5b, 0x92, 0x5c,
-	0xac, 0x34, 0x59, 0x91, 0x48, 0x6d, 0xb6, 0xda, 0x84, 0xaa, 0xfd, 0x16, 0x7c, 0x2c, 0x8e, 0x3d,
-	0x72, 0x44, 0xc9, 0x77, 0xe0, 0x8c, 0xba, 0x09, 0x08, 0xa4, 0xdd, 0xcb, 0x6a, 0x25, 0x3f, 0xbf,
-	0x67, 0x1e, 0x7b, 0x66, 0xc8, 0xb3, 0xe1, 0xd9, 0xf0, 0xf4, 0x38, 0x4b, 0x67, 0xe9, 0x20, 0x3d,
-	0xac, 0x8f, 0x27, 0xc3, 0xe4, 0x34, 0xc9, 0xea, 0xd3, 0x51, 0x3f, 0x4b, 0x86, 0xf5, 0x2c, 0x39,
-	0x4a, 0x4f, 0xfa, 0x87, 0x21, 0x4b, 0xfa, 0xd3, 0x74, 0x52, 0xcb, 0x65, 0xec, 0xf6, 0xdf, 0x44,
-	0x6d, 0x45, 0xd4, 0x96, 0xc4, 0xa3, 0x9f, 0x6b, 0x84, 0xd9, 0x6c, 0x98, 0x64, 0xb8, 0x44, 0x31,
-	0x27, 0xd9, 0x16, 0xd9, 0xb0, 0x28, 0x01, 0x03, 0x42, 0xcb, 0xee, 0x73, 0x1d, 0x10, 0xb8, 0xb3,
-	0x26, 0xc4, 0xc6, 0xb5, 0x41, 0xa8, 0xa6, 0x02, 0x49, 0x2b, 0x6c, 0x83, 0xdc, 0x29, 0x54, 0x41,
-	0xa7, 0xad, 0x10, 0x24, 0xad, 0xb2, 0x07, 0xe4, 0x5e, 0xb1, 0x8f, 0x03, 0x0c, 0x82, 0x1b, 0x01,
-	0x1a, 0x24, 0xfd, 0x8f, 0x3d, 0x21, 0xbb, 0x25, 0xf5, 0x24, 0xa0, 0xb0, 0x5a, 0x73, 0x0f, 0xc8,
-	0xb5, 0xea, 0x81, 0xa4, 0xff, 0xb3, 0x1d, 0x72, 0xbf, 0x50, 0xad, 0x8c, 0x07, 0x34, 0x5c, 0x07,
-	0x40, 0xb4, 0x48, 0x2f, 0xb1, 0x87, 0x64, 0xbb, 0x50, 0xe8, 0x40, 0x37, 0x83, 0x47, 0x2e, 0x61,
-	0x25, 0x5d, 0x63, 0xaf, 0xc9, 0xcb, 0x42, 0x69, 0xdb, 0x3a, 0x1f, 0xac, 0xd1, 0xdd, 0xf0, 0xc9,
-	0xc6, 0x5a, 0x06, 0x81, 0xd6, 0xb9, 0xd0, 0xe2, 0x7b, 0x80, 0x21, 0x07, 0xe8, 0x3a, 0xfb, 0x40,
-	0xde, 0x14, 0xe7, 0x69, 0xb5, 0x40, 0x2a, 0xee, 0x21, 0xd8, 0xdf, 0xb7, 0x5d, 0xb9, 0x20, 0xe4,
-	0xae, 0xa1, 0x61, 0xed, 0x1e, 0xbd, 0xcc, 0xde, 0x92, 0x57, 0x85, 0x06, 0x4d, 0xbb, 0xb7, 0x2c,
-	0x12, 0x44, 0x8e, 0x19, 0xeb, 0x43, 0x03, 0x42, 0x33, 0xd6, 0xba, 0x9b, 0x7f, 0x41, 0xd2, 0x2b,
-	0xec, 0x31, 0xd9, 0x29, 0xa4, 0x11, 0x64, 0x2c, 0x60, 0x19, 0x1e, 0xc1, 0xa9, 0x1e, 0xd0, 0xab,
-	0x6c, 0x97, 0x6c, 0x95, 0xbc, 0x9d, 0x84, 0x0e, 0xe0, 0x9f, 0xde, 0x11, 0xb6, 0x49, 0xee, 0x96,
-	0xd8, 0xb6, 0x35, 0x17, 0x20, 0xe9, 0x35, 0xb6, 0x4d, 0x36, 0x8b, 0x73, 0x2f, 0x03, 0xaa, 0x3c,
-	0xe0, 0xf5, 0xd2, 0x69, 0x82, 0x8f, 0xb1, 0xf2, 0xdd, 0xe0, 0x15, 0x20, 0xbd, 0x51, 0xda, 0xac,
-	0xa6, 0xba, 0x68, 0xa9, 0x03, 0xef, 0x35, 0xb4, 0xc0, 0x78, 0x7a, 0x93, 0x71, 0xf2, 0xae, 0x50,
-	0xba, 0xaf, 0xec, 0xc5, 0xa4, 0xb8, 0xa0, 0x5c, 0xfe, 0x23, 0x83, 0x8b, 0x1b, 0x5c, 0x08, 0x1b,
-	0x1b, 0x1f, 0x84, 0x35, 0xce, 0x23, 0x57, 0xc6, 0x3b, 0x7a, 0xab, 0xd1, 0xf9, 0x36, 0x8f, 0xaa,
-	0xe7, 0xf3, 0xa8, 0xfa, 0x63, 0x1e, 0x55, 0xbf, 0x2e, 0xa2, 0xca, 0xf9, 0x22, 0xaa, 0x7c, 0x5f,
-	0x44, 0x95, 0xde, 0xfb, 0xcf, 0xe3, 0xd9, 0xe8, 0xcb, 0x41, 0x6d, 0x90, 0x1e, 0xd5, 0xff, 0x59,
-	0xb6, 0x93, 0x17, 0x4f, 0x07, 0xa3, 0xfe, 0x78, 0x52, 0x2f, 0x5b, 0xbf, 0xd9, 0xd9, 0x71, 0x32,
-	0x3d, 0x58, 0xcf, 0x8f, 0x9f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x04, 0x74, 0x55, 0x04, 0xaa,
-	0x03, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdb, 0x4e, 0x14, 0x31,
+	0x1c, 0xc6, 0x77, 0x3d, 0x20, 0xd6, 0x53, 0xad, 0x7a, 0xa5, 0x6e, 0x40, 0x41, 0x40, 0x60, 0x97,
+	0x44, 0x62, 0x88, 0xc7, 0x74, 0xdb, 0xff, 0x6a, 0xb3, 0xdd, 0x76, 0xfc, 0xb7, 0x83, 0xc0, 0x4d,
+	0xb3, 0xb0, 0x13, 0x21, 0x01, 0x96, 0x0c, 0x48, 0xe0, 0x2d, 0x7c, 0x05, 0xdf, 0xc6, 0x4b, 0x2e,
+	0xbd, 0x34, 0xf0, 0x22, 0x66, 0x67, 0xc7, 0x53, 0x32, 0x13, 0xbc, 0x99, 0x4c, 0x66, 0xbe, 0xdf,
+	0xbf, 0x5f, 0xe7, 0xfb, 0xa6, 0x64, 0xa1, 0x77, 0xdc, 0x3b, 0xda, 0x4b, 0xfb, 0x07, 0xfd, 0x8d,
+	0xfe, 0x76, 0x63, 0x6b, 0xb7, 0x97, 0x1c, 0x25, 0x69, 0x63, 0x7f, 0xb3, 0x9b, 0x26, 0xbd, 0x46,
+	0x9a, 0xec, 0xf4, 0x0f, 0xbb, 0xdb, 0x21, 0x4d, 0xba, 0xfb, 0xfd, 0xdd, 0x7a, 0x26, 0x63, 0xf7,
+	0xff, 0x26, 0xea, 0x39, 0x51, 0x1f, 0x12, 0x4f, 0xbf, 0x8e, 0x12, 0x66, 0xd3, 0x5e, 0x92, 0xe2,
+	0x10, 0xc5, 0x8c, 0x64, 0x13, 0x64, 0xcc, 0xa2, 0x04, 0x0c, 0x08, 0x1d, 0xbb, 0xcc, 0x75, 0x40,
+	0xe0, 0xce, 0x9a, 0x10, 0x1b, 0x17, 0x81, 0x50, 0x2d, 0x05, 0x92, 0x56, 0xd8, 0x18, 0x79, 0x50,
+	0xa8, 0x82, 0x95, 0x48, 0x21, 0x48, 0x5a, 0x65, 0x4f, 0xc8, 0xa3, 0xe2, 0x39, 0x0e, 0x30, 0x08,
+	0x6e, 0x04, 0x68, 0x90, 0xf4, 0x02, 0x9b, 0x23, 0xd3, 0x25, 0xeb, 0x49, 0x40, 0x61, 0xb5, 0xe6,
+	0x1e, 0x90, 0x6b, 0xb5, 0x06, 0x92, 0x5e, 0x64, 0x53, 0xe4, 0x71, 0xa1, 0x5a, 0x19, 0x0f, 0x68,
+	0xb8, 0x0e, 0x80, 0x68, 0x91, 0x5e, 0x62, 0x33, 0x64, 0xb2, 0x50, 0xe8, 0x40, 0xb7, 0x82, 0x47,
+	0x2e, 0x21, 0x97, 0x5e, 0x66, 0x2f, 0xc8, 0xf3, 0x42, 0x69, 0x64, 0x9d, 0x0f, 0xd6, 0xe8, 0xd5,
+	0xf0, 0xd1, 0xc6, 0x5a, 0x06, 0x81, 0xd6, 0xb9, 0xd0, 0xe1, 0x6d, 0xc0, 0x90, 0x01, 0x74, 0x84,
+	0xbd, 0x25, 0x2f, 0x8b, 0xfd, 0x74, 0x3a, 0x20, 0x15, 0xf7, 0x10, 0xec, 0xaf, 0xdd, 0xe6, 0x53,
+	0x10, 0xb2, 0xa9, 0xa1, 0x69, 0x6d, 0x9b, 0x5e, 0x61, 0xaf, 0xc8, 0x52, 0xe1, 0x80, 0x96, 0x6d,
+	0x0f, 0x17, 0x09, 0x22, 0xc3, 0x8c, 0xf5, 0xa1, 0x09, 0xa1, 0x15, 0x6b, 0xbd, 0x9a, 0x5d, 0x41,
+	0xd2, 0x51, 0x36, 0x4b, 0xa6, 0x0a, 0x69, 0x04, 0x19, 0x0b, 0x18, 0x9a, 0x47, 0x70, 0x6a, 0x0d,
+	0xe8, 0x55, 0x36, 0x4d, 0x26, 0x4a, 0xbe, 0x9d, 0x84, 0x15, 0xc0, 0xdf, 0xd9, 0x11, 0x36, 0x4e,
+	0x1e, 0x96, 0x8c, 0x8d, 0x34, 0x17, 0x20, 0xe9, 0x35, 0x36, 0x49, 0xc6, 0x8b, 0x7d, 0x0f, 0x0d,
+	0xaa, 0xcc, 0xe0, 0xf5, 0xd2, 0x36, 0xc1, 0x87, 0x58, 0xf9, 0xd5, 0xe0, 0x15, 0x20, 0xbd, 0x51,
+	0x1a, 0x56, 0x4b, 0x0d, 0x22, 0x75, 0xe0, 0xbd, 0x86, 0x0e, 0x18, 0x4f, 0x6f, 0x32, 0x4e, 0x5e,
+	0x17, 0x4a, 0x97, 0x95, 0x1d, 0x34, 0xc5, 0x05, 0xe5, 0xb2, 0x1b, 0x19, 0x5c, 0xdc, 0xe4, 0x42,
+	0xd8, 0xd8, 0xf8, 0x20, 0xac, 0x71, 0x1e, 0xb9, 0x32, 0xde, 0xd1, 0x5b, 0x6c, 0x81, 0xcc, 0x9d,
+	0x57, 0x8d, 0x3c, 0x31, 0x3f, 0xc8, 0x9a, 0x52, 0xd6, 0x20, 0xb3, 0xff, 0x49, 0x34, 0xad, 0x7f,
+	0x4f, 0x6f, 0xb3, 0x25, 0xb2, 0x78, 0x1e, 0x20, 0x41, 0x60, 0xb6, 0xa9, 0xc0, 0x8d, 0xcc, 0x71,
+	0xca, 0xd8, 0x3c, 0x99, 0x29, 0x24, 0x87, 0x0f, 0xdf, 0xa1, 0x8d, 0xa3, 0x3f, 0x7f, 0xcf, 0x9d,
+	0xd2, 0xbd, 0x74, 0x38, 0xb6, 0xc1, 0xe7, 0x65, 0x8d, 0xd0, 0x7a, 0x10, 0x5e, 0x59, 0x43, 0xef,
+	0x96, 0x56, 0xc6, 0xc6, 0xde, 0x29, 0x09, 0x21, 0x42, 0x25, 0x20, 0x34, 0xb9, 0x91, 0xf4, 0x5e,
+	0x73, 0xe5, 0xdb, 0x69, 0xad, 0x7a, 0x72, 0x5a, 0xab, 0xfe, 0x38, 0xad, 0x55, 0xbf, 0x9c, 0xd5,
+	0x2a, 0x27, 0x67, 0xb5, 0xca, 0xf7, 0xb3, 0x5a, 0x65, 0xed, 0xcd, 0xa7, 0xad, 0x83, 0xcd, 0xcf,
+	0xeb, 0xf5, 0x8d, 0xfe, 0x4e, 0xe3, 0x9f, 0x73, 0xe9, 0x70, 0x71, 0x7e, 0x63, 0xb3, 0xbb, 0xb5,
+	0xdb, 0x28, 0x3b, 0xa9, 0x0e, 0x8e, 0xf7, 0x92, 0xfd, 0xf5, 0x91, 0xec, 0xf5, 0xb3, 0x9f, 0x03,
+	0x00, 0xdd, 0x92, 0xfd, 0xf1, 0xd5, 0x04, 0x00, 0x00,
 }
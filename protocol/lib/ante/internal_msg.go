@@ -15,6 +15,7 @@ import (
 	ibctransfer "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcclient "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	ibcconn "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	assets "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktime "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridge "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
 	clob "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -68,6 +69,10 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*upgrade.MsgSoftwareUpgrade,
 
 		// ------- Custom modules
+		// assets
+		*assets.MsgCreateAsset,
+		*assets.MsgUpdateAsset,
+
 		// blocktime
 		*blocktime.MsgUpdateDowntimeParams,
 
@@ -117,6 +122,7 @@ func IsInternalMsg(msg sdk.Msg) bool {
 		*stats.MsgUpdateParams,
 
 		// vault
+		*vault.MsgSetVaultParams,
 		*vault.MsgUpdateParams,
 
 		// vest
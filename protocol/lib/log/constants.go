@@ -47,19 +47,24 @@ const (
 	// Module tag values are prefixed with `x/`
 	Clob = "x/clob"
 
-	CheckTx        = "check_tx"
-	RecheckTx      = "recheck_tx"
-	DeliverTx      = "deliver_tx"
-	MsgBatchCancel = "msg_batch_cancel"
+	CheckTx            = "check_tx"
+	RecheckTx          = "recheck_tx"
+	DeliverTx          = "deliver_tx"
+	MsgBatchCancel     = "msg_batch_cancel"
+	MsgBatchPlaceOrder = "msg_batch_place_order"
 )
 
 // Special tag values that should be PascalCased (i.e function names)
 const (
-	AnteHandler        = "AnteHandler"
-	PlaceOrder         = "PlaceOrder"
-	CancelOrder        = "CancelOrder"
-	ProposedOperations = "ProposedOperations"
-	BeginBlocker       = "BeginBlocker"
-	EndBlocker         = "EndBlocker"
-	PrepareCheckState  = "PrepareCheckState"
+	AnteHandler           = "AnteHandler"
+	PlaceOrder            = "PlaceOrder"
+	CancelOrder           = "CancelOrder"
+	CancelAllOrders       = "CancelAllOrders"
+	Heartbeat             = "Heartbeat"
+	MarketMakerProtection = "MarketMakerProtection"
+	ReplaceOrder          = "ReplaceOrder"
+	ProposedOperations    = "ProposedOperations"
+	BeginBlocker          = "BeginBlocker"
+	EndBlocker            = "EndBlocker"
+	PrepareCheckState     = "PrepareCheckState"
 )
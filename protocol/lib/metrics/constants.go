@@ -99,6 +99,7 @@ const (
 	BestBid                                                 = "best_bid"
 	BestBidClobPair                                         = "best_bid_clob_pair"
 	Buy                                                     = "buy"
+	CancelAllOrders                                         = "cancel_all_orders"
 	CancelOrder                                             = "cancel_order"
 	CancelOrderAccounts                                     = "cancel_order_accounts"
 	CancelShortTermOrder                                    = "cancel_short_term_order"
@@ -120,6 +121,7 @@ const (
 	Expired                                                 = "expired"
 	FullyFilled                                             = "fully_filled"
 	GetFillQuoteQuantums                                    = "get_fill_quote_quantums"
+	Heartbeat                                               = "heartbeat"
 	Hydrate                                                 = "hydrate"
 	IsLong                                                  = "is_long"
 	IterateOverPendingMatches                               = "iterate_over_pending_matches"
@@ -149,6 +151,7 @@ const (
 	OperationsQueueLength                                   = "operations_queue_length"
 	OrderConflictsWithClobPairStatus                        = "order_conflicts_with_clob_pair_status"
 	OrderFlag                                               = "order_flag"
+	OrderGroupCanceled                                      = "order_group_canceled"
 	OrderSide                                               = "order_side"
 	OrderId                                                 = "order_id"
 	PartiallyFilled                                         = "partially_filled"
@@ -167,6 +170,9 @@ const (
 	ReduceOnly                                              = "reduce_only"
 	RemovalReason                                           = "removal_reason"
 	RemoveAndClearOperationsQueue                           = "remove_and_clear_operations_queue"
+	ReplaceOrder                                            = "replace_order"
+	ReplaceStatefulOrder                                    = "replace_stateful_order"
+	ReplaceStatefulOrdersFromLastBlock                      = "replace_stateful_orders_from_last_block"
 	ReplayOperations                                        = "replay_operations"
 	SortLiquidationOrders                                   = "sort_liquidation_orders"
 	SendCancelOrderOffchainUpdates                          = "send_cancel_order_offchain_updates"
@@ -27,6 +27,15 @@ const (
 	ClobRateLimitPlaceOrderCount                       = "clob_rate_limit_place_order_count"
 	ClobRateLimitCancelOrderCount                      = "clob_rate_limit_cancel_order_count"
 	ClobRateLimitBatchCancelCount                      = "clob_rate_limit_batch_cancel_count"
+	ClobRateLimitBatchPlaceOrderCount                  = "clob_rate_limit_batch_place_order_count"
+	ClobRateLimitReplaceOrderCount                     = "clob_rate_limit_replace_order_count"
+	ClobRateLimitCancelAllOrdersCount                  = "clob_rate_limit_cancel_all_orders_count"
+	ClobTwapSuborderPlaced                             = "clob_twap_suborder_placed"
+	ClobTwapOrderCompleted                             = "clob_twap_order_completed"
+	ClobHeartbeatExpired                               = "clob_heartbeat_expired"
+	ClobMarketMakerProtectionTripped                   = "clob_market_maker_protection_tripped"
+	ClobCircuitBreakerTripped                          = "clob_circuit_breaker_tripped"
+	ClobCircuitBreakerRestored                         = "clob_circuit_breaker_restored"
 
 	// Gauges
 	InsuranceFundBalance                      = "insurance_fund_balance"
@@ -57,17 +66,28 @@ const (
 	DaemonGetPreviousBlockInfoLatency                 = "daemon_get_previous_block_info_latency"
 	DaemonGetAllMarketPricesLatency                   = "daemon_get_all_market_prices_latency"
 	DaemonGetMarketPricesPaginatedLatency             = "daemon_get_market_prices_paginated_latency"
+	DaemonGetAllMarkPricesLatency                     = "daemon_get_all_mark_prices_latency"
+	DaemonGetMarkPricesPaginatedLatency               = "daemon_get_mark_prices_paginated_latency"
 	DaemonGetAllLiquidityTiersLatency                 = "daemon_get_all_liquidity_tiers_latency"
 	DaemonGetLiquidityTiersPaginatedLatency           = "daemon_get_liquidity_tiers_paginated_latency"
 	DaemonGetAllPerpetualsLatency                     = "daemon_get_all_perpetuals_latency"
 	DaemonGetPerpetualsPaginatedLatency               = "daemon_get_perpetuals_paginated_latency"
+	DaemonGetAllAssetsLatency                         = "daemon_get_all_assets_latency"
+	DaemonGetAssetsPaginatedLatency                   = "daemon_get_assets_paginated_latency"
 	MevLatency                                        = "mev_latency"
 	GateWithdrawalsIfNegativeTncSubaccountSeenLatency = "gate_withdrawals_if_negative_tnc_subaccount_seen_latency"
 
 	// Full node grpc
-	FullNodeGrpc                    = "full_node_grpc"
-	GrpcSendOrderbookUpdatesLatency = "grpc_send_orderbook_updates_latency"
-	GrpcSendOrderbookFillsLatency   = "grpc_send_orderbook_fills_latency"
-	EndBlocker                      = "end_blocker"
-	EndBlockerLag                   = "end_blocker_lag"
+	FullNodeGrpc                     = "full_node_grpc"
+	GrpcSendOrderbookUpdatesLatency  = "grpc_send_orderbook_updates_latency"
+	GrpcSendOrderbookFillsLatency    = "grpc_send_orderbook_fills_latency"
+	GrpcSendSubaccountUpdatesLatency = "grpc_send_subaccount_updates_latency"
+	GrpcSendFinalizedUpdatesLatency  = "grpc_send_finalized_updates_latency"
+	GrpcStreamSubscriberCount        = "grpc_stream_subscriber_count"
+	GrpcSubscriptionChannelLength    = "grpc_subscription_channel_length"
+	GrpcDroppedSubscriptions         = "grpc_dropped_subscriptions"
+	GrpcResnapshottedSubscriptions   = "grpc_resnapshotted_subscriptions"
+	GrpcResumedStreams               = "grpc_resumed_streams"
+	EndBlocker                       = "end_blocker"
+	EndBlockerLag                    = "end_blocker_lag"
 )
@@ -93,6 +93,75 @@ func (_m *ClobKeeper) BatchCancelShortTermOrder(ctx types.Context, msg *clobtype
 	return r0, r1, r2
 }
 
+// BatchPlaceShortTermOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) BatchPlaceShortTermOrder(ctx types.Context, msg *clobtypes.MsgBatchPlaceOrder) ([]clobtypes.OrderId, []clobtypes.OrderPlacementFailure, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchPlaceShortTermOrder")
+	}
+
+	var r0 []clobtypes.OrderId
+	var r1 []clobtypes.OrderPlacementFailure
+	var r2 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceOrder) ([]clobtypes.OrderId, []clobtypes.OrderPlacementFailure, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceOrder) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgBatchPlaceOrder) []clobtypes.OrderPlacementFailure); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]clobtypes.OrderPlacementFailure)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, *clobtypes.MsgBatchPlaceOrder) error); ok {
+		r2 = rf(ctx, msg)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CancelAllShortTermOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) CancelAllShortTermOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) ([]clobtypes.OrderId, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelAllShortTermOrders")
+	}
+
+	var r0 []clobtypes.OrderId
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) ([]clobtypes.OrderId, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) []clobtypes.OrderId); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.OrderId)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelShortTermOrder provides a mock function with given fields: ctx, msg
 func (_m *ClobKeeper) CancelShortTermOrder(ctx types.Context, msg *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, msg)
@@ -175,6 +244,34 @@ func (_m *ClobKeeper) CreatePerpetualClobPair(ctx types.Context, clobPairId uint
 	return r0, r1
 }
 
+// CreateSpotClobPair provides a mock function with given fields: ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status
+func (_m *ClobKeeper) CreateSpotClobPair(ctx types.Context, clobPairId uint32, baseAssetId uint32, quoteAssetId uint32, stepSizeInBaseQuantums subaccountstypes.BaseQuantums, quantumConversionExponent int32, subticksPerTick uint32, status clobtypes.ClobPair_Status) (clobtypes.ClobPair, error) {
+	ret := _m.Called(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSpotClobPair")
+	}
+
+	var r0 clobtypes.ClobPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) (clobtypes.ClobPair, error)); ok {
+		return rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) clobtypes.ClobPair); ok {
+		r0 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r0 = ret.Get(0).(clobtypes.ClobPair)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, uint32, uint32, subaccountstypes.BaseQuantums, int32, uint32, clobtypes.ClobPair_Status) error); ok {
+		r1 = rf(ctx, clobPairId, baseAssetId, quoteAssetId, stepSizeInBaseQuantums, quantumConversionExponent, subticksPerTick, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteLongTermOrderPlacement provides a mock function with given fields: ctx, orderId
 func (_m *ClobKeeper) DeleteLongTermOrderPlacement(ctx types.Context, orderId clobtypes.OrderId) {
 	_m.Called(ctx, orderId)
@@ -200,6 +297,26 @@ func (_m *ClobKeeper) GetAllClobPairs(ctx types.Context) []clobtypes.ClobPair {
 	return r0
 }
 
+// GetAllStatefulOrdersForSubaccount provides a mock function with given fields: ctx, subaccountId
+func (_m *ClobKeeper) GetAllStatefulOrdersForSubaccount(ctx types.Context, subaccountId subaccountstypes.SubaccountId) []clobtypes.Order {
+	ret := _m.Called(ctx, subaccountId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllStatefulOrdersForSubaccount")
+	}
+
+	var r0 []clobtypes.Order
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId) []clobtypes.Order); ok {
+		r0 = rf(ctx, subaccountId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]clobtypes.Order)
+		}
+	}
+
+	return r0
+}
+
 // GetBankruptcyPriceInQuoteQuantums provides a mock function with given fields: ctx, subaccountId, perpetualId, deltaQuantums
 func (_m *ClobKeeper) GetBankruptcyPriceInQuoteQuantums(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32, deltaQuantums *big.Int) (*big.Int, error) {
 	ret := _m.Called(ctx, subaccountId, perpetualId, deltaQuantums)
@@ -625,6 +742,24 @@ func (_m *ClobKeeper) GetSubaccountMaxNotionalLiquidatable(ctx types.Context, su
 	return r0, r1
 }
 
+// HandleMsgCancelAllOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgCancelAllOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleMsgCancelAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // HandleMsgCancelOrder provides a mock function with given fields: ctx, msg
 func (_m *ClobKeeper) HandleMsgCancelOrder(ctx types.Context, msg *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, msg)
@@ -643,6 +778,11 @@ func (_m *ClobKeeper) HandleMsgCancelOrder(ctx types.Context, msg *clobtypes.Msg
 	return r0
 }
 
+// HandleMsgHeartbeat provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgHeartbeat(ctx types.Context, msg *clobtypes.MsgHeartbeat) {
+	_m.Called(ctx, msg)
+}
+
 // HandleMsgPlaceOrder provides a mock function with given fields: ctx, msg, isInternalOrder
 func (_m *ClobKeeper) HandleMsgPlaceOrder(ctx types.Context, msg *clobtypes.MsgPlaceOrder, isInternalOrder bool) error {
 	ret := _m.Called(ctx, msg, isInternalOrder)
@@ -661,6 +801,60 @@ func (_m *ClobKeeper) HandleMsgPlaceOrder(ctx types.Context, msg *clobtypes.MsgP
 	return r0
 }
 
+// HandleMsgReplaceOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgReplaceOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleMsgReplaceOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HandleMsgResetMarketMakerProtection provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgResetMarketMakerProtection(ctx types.Context, msg *clobtypes.MsgResetMarketMakerProtection) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleMsgResetMarketMakerProtection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgResetMarketMakerProtection) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HandleMsgSetMarketMakerProtectionConfig provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgSetMarketMakerProtectionConfig(ctx types.Context, msg *clobtypes.MsgSetMarketMakerProtectionConfig) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleMsgSetMarketMakerProtectionConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgSetMarketMakerProtectionConfig) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *ClobKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -1092,6 +1286,42 @@ func (_m *ClobKeeper) RateLimitBatchCancel(ctx types.Context, order *clobtypes.M
 	return r0
 }
 
+// RateLimitBatchPlaceOrder provides a mock function with given fields: ctx, order
+func (_m *ClobKeeper) RateLimitBatchPlaceOrder(ctx types.Context, order *clobtypes.MsgBatchPlaceOrder) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateLimitBatchPlaceOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgBatchPlaceOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RateLimitCancelAllOrders provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) RateLimitCancelAllOrders(ctx types.Context, msg *clobtypes.MsgCancelAllOrders) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateLimitCancelAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgCancelAllOrders) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RateLimitCancelOrder provides a mock function with given fields: ctx, order
 func (_m *ClobKeeper) RateLimitCancelOrder(ctx types.Context, order *clobtypes.MsgCancelOrder) error {
 	ret := _m.Called(ctx, order)
@@ -1128,6 +1358,24 @@ func (_m *ClobKeeper) RateLimitPlaceOrder(ctx types.Context, order *clobtypes.Ms
 	return r0
 }
 
+// RateLimitReplaceOrder provides a mock function with given fields: ctx, order
+func (_m *ClobKeeper) RateLimitReplaceOrder(ctx types.Context, order *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateLimitReplaceOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveClobPair provides a mock function with given fields: ctx, id
 func (_m *ClobKeeper) RemoveClobPair(ctx types.Context, id clobtypes.ClobPairId) {
 	_m.Called(ctx, id)
@@ -1158,11 +1406,47 @@ func (_m *ClobKeeper) RemoveOrderFillAmount(ctx types.Context, orderId clobtypes
 	_m.Called(ctx, orderId)
 }
 
+// ReplaceStatefulOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) ReplaceStatefulOrder(ctx types.Context, msg *clobtypes.MsgReplaceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceStatefulOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReplaceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendOrderbookUpdates provides a mock function with given fields: ctx, offchainUpdates, snapshot
 func (_m *ClobKeeper) SendOrderbookUpdates(ctx types.Context, offchainUpdates *clobtypes.OffchainUpdates, snapshot bool) {
 	_m.Called(ctx, offchainUpdates, snapshot)
 }
 
+// SetClobPairPriceProtectionConfigs provides a mock function with given fields: ctx, clobPairId, priceBandConfig, circuitBreakerConfig
+func (_m *ClobKeeper) SetClobPairPriceProtectionConfigs(ctx types.Context, clobPairId clobtypes.ClobPairId, priceBandConfig *clobtypes.PriceBandConfig, circuitBreakerConfig *clobtypes.CircuitBreakerConfig) error {
+	ret := _m.Called(ctx, clobPairId, priceBandConfig, circuitBreakerConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetClobPairPriceProtectionConfigs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId, *clobtypes.PriceBandConfig, *clobtypes.CircuitBreakerConfig) error); ok {
+		r0 = rf(ctx, clobPairId, priceBandConfig, circuitBreakerConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLongTermOrderPlacement provides a mock function with given fields: ctx, order, blockHeight
 func (_m *ClobKeeper) SetLongTermOrderPlacement(ctx types.Context, order clobtypes.Order, blockHeight uint32) {
 	_m.Called(ctx, order, blockHeight)
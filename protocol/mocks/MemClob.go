@@ -50,6 +50,26 @@ func (_m *MemClob) CancelOrder(ctx types.Context, msgCancelOrder *clobtypes.MsgC
 	return r0, r1
 }
 
+// CloneFromOrderbookSnapshot provides a mock function with given fields: ctx, clobPair, snapshot
+func (_m *MemClob) CloneFromOrderbookSnapshot(ctx types.Context, clobPair clobtypes.ClobPair, snapshot clobtypes.OrderbookSnapshot) clobtypes.MemClob {
+	ret := _m.Called(ctx, clobPair, snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromOrderbookSnapshot")
+	}
+
+	var r0 clobtypes.MemClob
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPair, clobtypes.OrderbookSnapshot) clobtypes.MemClob); ok {
+		r0 = rf(ctx, clobPair, snapshot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(clobtypes.MemClob)
+		}
+	}
+
+	return r0
+}
+
 // CountSubaccountShortTermOrders provides a mock function with given fields: ctx, subaccountId
 func (_m *MemClob) CountSubaccountShortTermOrders(ctx types.Context, subaccountId subaccountstypes.SubaccountId) uint32 {
 	ret := _m.Called(ctx, subaccountId)
@@ -232,7 +252,7 @@ func (_m *MemClob) GetOperationsRaw(ctx types.Context) []clobtypes.OperationRaw
 }
 
 // GetOperationsToReplay provides a mock function with given fields: ctx
-func (_m *MemClob) GetOperationsToReplay(ctx types.Context) ([]clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte) {
+func (_m *MemClob) GetOperationsToReplay(ctx types.Context) ([]clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte, map[clobtypes.OrderHash]uint32) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
@@ -241,7 +261,8 @@ func (_m *MemClob) GetOperationsToReplay(ctx types.Context) ([]clobtypes.Interna
 
 	var r0 []clobtypes.InternalOperation
 	var r1 map[clobtypes.OrderHash][]byte
-	if rf, ok := ret.Get(0).(func(types.Context) ([]clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte)); ok {
+	var r2 map[clobtypes.OrderHash]uint32
+	if rf, ok := ret.Get(0).(func(types.Context) ([]clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte, map[clobtypes.OrderHash]uint32)); ok {
 		return rf(ctx)
 	}
 	if rf, ok := ret.Get(0).(func(types.Context) []clobtypes.InternalOperation); ok {
@@ -260,7 +281,15 @@ func (_m *MemClob) GetOperationsToReplay(ctx types.Context) ([]clobtypes.Interna
 		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(types.Context) map[clobtypes.OrderHash]uint32); ok {
+		r2 = rf(ctx)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(map[clobtypes.OrderHash]uint32)
+		}
+	}
+
+	return r0, r1, r2
 }
 
 // GetOrder provides a mock function with given fields: ctx, orderId
@@ -337,6 +366,24 @@ func (_m *MemClob) GetOrderRemainingAmount(ctx types.Context, order clobtypes.Or
 	return r0, r1
 }
 
+// GetOrderbookSnapshot provides a mock function with given fields: ctx, clobPairId
+func (_m *MemClob) GetOrderbookSnapshot(ctx types.Context, clobPairId clobtypes.ClobPairId) clobtypes.OrderbookSnapshot {
+	ret := _m.Called(ctx, clobPairId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderbookSnapshot")
+	}
+
+	var r0 clobtypes.OrderbookSnapshot
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) clobtypes.OrderbookSnapshot); ok {
+		r0 = rf(ctx, clobPairId)
+	} else {
+		r0 = ret.Get(0).(clobtypes.OrderbookSnapshot)
+	}
+
+	return r0
+}
+
 // GetOrderbookUpdatesForOrderPlacement provides a mock function with given fields: ctx, order
 func (_m *MemClob) GetOrderbookUpdatesForOrderPlacement(ctx types.Context, order clobtypes.Order) *clobtypes.OffchainUpdates {
 	ret := _m.Called(ctx, order)
@@ -583,17 +630,61 @@ func (_m *MemClob) RemoveOrderIfFilled(ctx types.Context, orderId clobtypes.Orde
 	_m.Called(ctx, orderId)
 }
 
-// ReplayOperations provides a mock function with given fields: ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates
-func (_m *MemClob) ReplayOperations(ctx types.Context, localOperations []clobtypes.InternalOperation, shortTermOrderTxBytes map[clobtypes.OrderHash][]byte, existingOffchainUpdates *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates {
-	ret := _m.Called(ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates)
+// ReplaceStatefulOrder provides a mock function with given fields: ctx, order
+func (_m *MemClob) ReplaceStatefulOrder(ctx types.Context, order clobtypes.Order) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error) {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceStatefulOrder")
+	}
+
+	var r0 subaccountstypes.BaseQuantums
+	var r1 clobtypes.OrderStatus
+	var r2 *clobtypes.OffchainUpdates
+	var r3 error
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.Order) (subaccountstypes.BaseQuantums, clobtypes.OrderStatus, *clobtypes.OffchainUpdates, error)); ok {
+		return rf(ctx, order)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.Order) subaccountstypes.BaseQuantums); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Get(0).(subaccountstypes.BaseQuantums)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.Order) clobtypes.OrderStatus); ok {
+		r1 = rf(ctx, order)
+	} else {
+		r1 = ret.Get(1).(clobtypes.OrderStatus)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.Order) *clobtypes.OffchainUpdates); ok {
+		r2 = rf(ctx, order)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*clobtypes.OffchainUpdates)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(types.Context, clobtypes.Order) error); ok {
+		r3 = rf(ctx, order)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// ReplayOperations provides a mock function with given fields: ctx, localOperations, shortTermOrderTxBytes, shortTermOrderBatchIndexes, existingOffchainUpdates
+func (_m *MemClob) ReplayOperations(ctx types.Context, localOperations []clobtypes.InternalOperation, shortTermOrderTxBytes map[clobtypes.OrderHash][]byte, shortTermOrderBatchIndexes map[clobtypes.OrderHash]uint32, existingOffchainUpdates *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates {
+	ret := _m.Called(ctx, localOperations, shortTermOrderTxBytes, shortTermOrderBatchIndexes, existingOffchainUpdates)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReplayOperations")
 	}
 
 	var r0 *clobtypes.OffchainUpdates
-	if rf, ok := ret.Get(0).(func(types.Context, []clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte, *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates); ok {
-		r0 = rf(ctx, localOperations, shortTermOrderTxBytes, existingOffchainUpdates)
+	if rf, ok := ret.Get(0).(func(types.Context, []clobtypes.InternalOperation, map[clobtypes.OrderHash][]byte, map[clobtypes.OrderHash]uint32, *clobtypes.OffchainUpdates) *clobtypes.OffchainUpdates); ok {
+		r0 = rf(ctx, localOperations, shortTermOrderTxBytes, shortTermOrderBatchIndexes, existingOffchainUpdates)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*clobtypes.OffchainUpdates)
@@ -234,6 +234,41 @@ func (_m *MemClobKeeper) GetOrderFillAmount(ctx types.Context, orderId clobtypes
 	return r0, r1, r2
 }
 
+// GetPriceBandSubticks provides a mock function with given fields: ctx, clobPairId
+func (_m *MemClobKeeper) GetPriceBandSubticks(ctx types.Context, clobPairId clobtypes.ClobPairId) (uint64, uint64, bool) {
+	ret := _m.Called(ctx, clobPairId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceBandSubticks")
+	}
+
+	var r0 uint64
+	var r1 uint64
+	var r2 bool
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) (uint64, uint64, bool)); ok {
+		return rf(ctx, clobPairId)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, clobtypes.ClobPairId) uint64); ok {
+		r0 = rf(ctx, clobPairId)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, clobtypes.ClobPairId) uint64); ok {
+		r1 = rf(ctx, clobPairId)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, clobtypes.ClobPairId) bool); ok {
+		r2 = rf(ctx, clobPairId)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
 // GetStatePosition provides a mock function with given fields: ctx, subaccountId, clobPairId
 func (_m *MemClobKeeper) GetStatePosition(ctx types.Context, subaccountId subaccountstypes.SubaccountId, clobPairId clobtypes.ClobPairId) *big.Int {
 	ret := _m.Called(ctx, subaccountId, clobPairId)
@@ -282,6 +317,24 @@ func (_m *MemClobKeeper) IsLiquidatable(ctx types.Context, subaccountId subaccou
 	return r0, r1
 }
 
+// IsMarketMakerProtectionTripped provides a mock function with given fields: ctx, subaccountId, clobPairId
+func (_m *MemClobKeeper) IsMarketMakerProtectionTripped(ctx types.Context, subaccountId subaccountstypes.SubaccountId, clobPairId clobtypes.ClobPairId) bool {
+	ret := _m.Called(ctx, subaccountId, clobPairId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMarketMakerProtectionTripped")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.SubaccountId, clobtypes.ClobPairId) bool); ok {
+		r0 = rf(ctx, subaccountId, clobPairId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Logger provides a mock function with given fields: ctx
 func (_m *MemClobKeeper) Logger(ctx types.Context) log.Logger {
 	ret := _m.Called(ctx)
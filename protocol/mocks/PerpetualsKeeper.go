@@ -422,6 +422,62 @@ func (_m *PerpetualsKeeper) SetParams(ctx types.Context, params perpetualstypes.
 	return r0
 }
 
+// SetPerpetualFundingConfig provides a mock function with given fields: ctx, id, fundingConfig
+func (_m *PerpetualsKeeper) SetPerpetualFundingConfig(ctx types.Context, id uint32, fundingConfig *perpetualstypes.PerpetualFundingConfig) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, fundingConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPerpetualFundingConfig")
+	}
+
+	var r0 perpetualstypes.Perpetual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, *perpetualstypes.PerpetualFundingConfig) (perpetualstypes.Perpetual, error)); ok {
+		return rf(ctx, id, fundingConfig)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, *perpetualstypes.PerpetualFundingConfig) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, fundingConfig)
+	} else {
+		r0 = ret.Get(0).(perpetualstypes.Perpetual)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, *perpetualstypes.PerpetualFundingConfig) error); ok {
+		r1 = rf(ctx, id, fundingConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPerpetualMarkPriceConfig provides a mock function with given fields: ctx, id, markPriceConfig
+func (_m *PerpetualsKeeper) SetPerpetualMarkPriceConfig(ctx types.Context, id uint32, markPriceConfig *perpetualstypes.MarkPriceConfig) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, markPriceConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPerpetualMarkPriceConfig")
+	}
+
+	var r0 perpetualstypes.Perpetual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, *perpetualstypes.MarkPriceConfig) (perpetualstypes.Perpetual, error)); ok {
+		return rf(ctx, id, markPriceConfig)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, *perpetualstypes.MarkPriceConfig) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, markPriceConfig)
+	} else {
+		r0 = ret.Get(0).(perpetualstypes.Perpetual)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, *perpetualstypes.MarkPriceConfig) error); ok {
+		r1 = rf(ctx, id, markPriceConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPerpetualMarketType provides a mock function with given fields: ctx, id, marketType
 func (_m *PerpetualsKeeper) SetPerpetualMarketType(ctx types.Context, id uint32, marketType perpetualstypes.PerpetualMarketType) (perpetualstypes.Perpetual, error) {
 	ret := _m.Called(ctx, id, marketType)
@@ -450,6 +506,11 @@ func (_m *PerpetualsKeeper) SetPerpetualMarketType(ctx types.Context, id uint32,
 	return r0, r1
 }
 
+// UpdateMarkPrices provides a mock function with given fields: ctx
+func (_m *PerpetualsKeeper) UpdateMarkPrices(ctx types.Context) {
+	_m.Called(ctx)
+}
+
 // ValidateAndSetPerpetual provides a mock function with given fields: ctx, perpetual
 func (_m *PerpetualsKeeper) ValidateAndSetPerpetual(ctx types.Context, perpetual perpetualstypes.Perpetual) error {
 	ret := _m.Called(ctx, perpetual)
@@ -4,6 +4,8 @@ package mocks
 
 import (
 	api "github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/api"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 
 	context "context"
@@ -22,7 +24,7 @@ import (
 
 	subaccountstypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 
-	types "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 )
 
 // QueryClient is an autogenerated mock type for the QueryClient type
@@ -67,8 +69,82 @@ func (_m *QueryClient) AddBridgeEvents(ctx context.Context, in *api.AddBridgeEve
 	return r0, r1
 }
 
+// AdlRank provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AdlRank(ctx context.Context, in *subaccountstypes.QueryAdlRankRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryAdlRankResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdlRank")
+	}
+
+	var r0 *subaccountstypes.QueryAdlRankResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryAdlRankRequest, ...grpc.CallOption) (*subaccountstypes.QueryAdlRankResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QueryAdlRankRequest, ...grpc.CallOption) *subaccountstypes.QueryAdlRankResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QueryAdlRankResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QueryAdlRankRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllAssets provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllAssets(ctx context.Context, in *types.QueryAllAssetsRequest, opts ...grpc.CallOption) (*types.QueryAllAssetsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllAssets")
+	}
+
+	var r0 *types.QueryAllAssetsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllAssetsRequest, ...grpc.CallOption) (*types.QueryAllAssetsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllAssetsRequest, ...grpc.CallOption) *types.QueryAllAssetsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAllAssetsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllAssetsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllDowntimeInfo provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) AllDowntimeInfo(ctx context.Context, in *types.QueryAllDowntimeInfoRequest, opts ...grpc.CallOption) (*types.QueryAllDowntimeInfoResponse, error) {
+func (_m *QueryClient) AllDowntimeInfo(ctx context.Context, in *blocktimetypes.QueryAllDowntimeInfoRequest, opts ...grpc.CallOption) (*blocktimetypes.QueryAllDowntimeInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -82,20 +158,20 @@ func (_m *QueryClient) AllDowntimeInfo(ctx context.Context, in *types.QueryAllDo
 		panic("no return value specified for AllDowntimeInfo")
 	}
 
-	var r0 *types.QueryAllDowntimeInfoResponse
+	var r0 *blocktimetypes.QueryAllDowntimeInfoResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllDowntimeInfoRequest, ...grpc.CallOption) (*types.QueryAllDowntimeInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryAllDowntimeInfoRequest, ...grpc.CallOption) (*blocktimetypes.QueryAllDowntimeInfoResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllDowntimeInfoRequest, ...grpc.CallOption) *types.QueryAllDowntimeInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryAllDowntimeInfoRequest, ...grpc.CallOption) *blocktimetypes.QueryAllDowntimeInfoResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryAllDowntimeInfoResponse)
+			r0 = ret.Get(0).(*blocktimetypes.QueryAllDowntimeInfoResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllDowntimeInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *blocktimetypes.QueryAllDowntimeInfoRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -141,6 +217,43 @@ func (_m *QueryClient) AllLiquidityTiers(ctx context.Context, in *perpetualstype
 	return r0, r1
 }
 
+// AllMarkPrices provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllMarkPrices(ctx context.Context, in *perpetualstypes.QueryAllMarkPricesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryAllMarkPricesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllMarkPrices")
+	}
+
+	var r0 *perpetualstypes.QueryAllMarkPricesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryAllMarkPricesRequest, ...grpc.CallOption) (*perpetualstypes.QueryAllMarkPricesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryAllMarkPricesRequest, ...grpc.CallOption) *perpetualstypes.QueryAllMarkPricesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryAllMarkPricesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryAllMarkPricesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllMarketParams provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) AllMarketParams(ctx context.Context, in *pricestypes.QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*pricestypes.QueryAllMarketParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -252,6 +365,43 @@ func (_m *QueryClient) AllPerpetuals(ctx context.Context, in *perpetualstypes.Qu
 	return r0, r1
 }
 
+// Asset provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Asset(ctx context.Context, in *types.QueryAssetRequest, opts ...grpc.CallOption) (*types.QueryAssetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Asset")
+	}
+
+	var r0 *types.QueryAssetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAssetRequest, ...grpc.CallOption) (*types.QueryAssetResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAssetRequest, ...grpc.CallOption) *types.QueryAssetResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAssetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAssetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockRateLimitConfiguration provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) BlockRateLimitConfiguration(ctx context.Context, in *clobtypes.QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*clobtypes.QueryBlockRateLimitConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -401,7 +551,7 @@ func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccount
 }
 
 // DowntimeParams provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDowntimeParamsRequest, opts ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error) {
+func (_m *QueryClient) DowntimeParams(ctx context.Context, in *blocktimetypes.QueryDowntimeParamsRequest, opts ...grpc.CallOption) (*blocktimetypes.QueryDowntimeParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -415,20 +565,20 @@ func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDownti
 		panic("no return value specified for DowntimeParams")
 	}
 
-	var r0 *types.QueryDowntimeParamsResponse
+	var r0 *blocktimetypes.QueryDowntimeParamsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryDowntimeParamsRequest, ...grpc.CallOption) (*blocktimetypes.QueryDowntimeParamsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) *types.QueryDowntimeParamsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryDowntimeParamsRequest, ...grpc.CallOption) *blocktimetypes.QueryDowntimeParamsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryDowntimeParamsResponse)
+			r0 = ret.Get(0).(*blocktimetypes.QueryDowntimeParamsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDowntimeParamsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *blocktimetypes.QueryDowntimeParamsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -585,6 +735,43 @@ func (_m *QueryClient) LiquidationsConfiguration(ctx context.Context, in *clobty
 	return r0, r1
 }
 
+// MarkPrice provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarkPrice(ctx context.Context, in *perpetualstypes.QueryMarkPriceRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryMarkPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPrice")
+	}
+
+	var r0 *perpetualstypes.QueryMarkPriceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryMarkPriceRequest, ...grpc.CallOption) (*perpetualstypes.QueryMarkPriceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryMarkPriceRequest, ...grpc.CallOption) *perpetualstypes.QueryMarkPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryMarkPriceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryMarkPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketParam provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MarketParam(ctx context.Context, in *pricestypes.QueryMarketParamRequest, opts ...grpc.CallOption) (*pricestypes.QueryMarketParamResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -733,6 +920,43 @@ func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryPara
 	return r0, r1
 }
 
+// Orderbook provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) Orderbook(ctx context.Context, in *clobtypes.QueryOrderbookRequest, opts ...grpc.CallOption) (*clobtypes.QueryOrderbookResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Orderbook")
+	}
+
+	var r0 *clobtypes.QueryOrderbookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookRequest, ...grpc.CallOption) (*clobtypes.QueryOrderbookResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryOrderbookRequest, ...grpc.CallOption) *clobtypes.QueryOrderbookResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryOrderbookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryOrderbookRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Perpetual provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryPerpetualRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPerpetualResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -770,6 +994,43 @@ func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryP
 	return r0, r1
 }
 
+// PredictedFunding provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PredictedFunding(ctx context.Context, in *perpetualstypes.QueryPredictedFundingRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPredictedFundingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PredictedFunding")
+	}
+
+	var r0 *perpetualstypes.QueryPredictedFundingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPredictedFundingRequest, ...grpc.CallOption) (*perpetualstypes.QueryPredictedFundingResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPredictedFundingRequest, ...grpc.CallOption) *perpetualstypes.QueryPredictedFundingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPredictedFundingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPredictedFundingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PremiumSamples provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -845,7 +1106,7 @@ func (_m *QueryClient) PremiumVotes(ctx context.Context, in *perpetualstypes.Que
 }
 
 // PreviousBlockInfo provides a mock function with given fields: ctx, in, opts
-func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPreviousBlockInfoRequest, opts ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error) {
+func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *blocktimetypes.QueryPreviousBlockInfoRequest, opts ...grpc.CallOption) (*blocktimetypes.QueryPreviousBlockInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -859,20 +1120,57 @@ func (_m *QueryClient) PreviousBlockInfo(ctx context.Context, in *types.QueryPre
 		panic("no return value specified for PreviousBlockInfo")
 	}
 
-	var r0 *types.QueryPreviousBlockInfoResponse
+	var r0 *blocktimetypes.QueryPreviousBlockInfoResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) (*types.QueryPreviousBlockInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryPreviousBlockInfoRequest, ...grpc.CallOption) (*blocktimetypes.QueryPreviousBlockInfoResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) *types.QueryPreviousBlockInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *blocktimetypes.QueryPreviousBlockInfoRequest, ...grpc.CallOption) *blocktimetypes.QueryPreviousBlockInfoResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.QueryPreviousBlockInfoResponse)
+			r0 = ret.Get(0).(*blocktimetypes.QueryPreviousBlockInfoResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPreviousBlockInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *blocktimetypes.QueryPreviousBlockInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateOrder provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SimulateOrder(ctx context.Context, in *clobtypes.QuerySimulateOrderRequest, opts ...grpc.CallOption) (*clobtypes.QuerySimulateOrderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateOrder")
+	}
+
+	var r0 *clobtypes.QuerySimulateOrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySimulateOrderRequest, ...grpc.CallOption) (*clobtypes.QuerySimulateOrderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySimulateOrderRequest, ...grpc.CallOption) *clobtypes.QuerySimulateOrderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QuerySimulateOrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QuerySimulateOrderRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1029,6 +1327,43 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountRisk provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountRisk(ctx context.Context, in *clobtypes.QuerySubaccountRiskRequest, opts ...grpc.CallOption) (*clobtypes.QuerySubaccountRiskResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubaccountRisk")
+	}
+
+	var r0 *clobtypes.QuerySubaccountRiskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySubaccountRiskRequest, ...grpc.CallOption) (*clobtypes.QuerySubaccountRiskResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QuerySubaccountRiskRequest, ...grpc.CallOption) *clobtypes.QuerySubaccountRiskResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QuerySubaccountRiskResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QuerySubaccountRiskRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -73,6 +73,26 @@ func (_m *SubaccountsKeeper) DepositFundsFromAccountToSubaccount(ctx types.Conte
 	return r0
 }
 
+// GetAdlRankedSubaccountIds provides a mock function with given fields: ctx, perpetualId, isLong, limit
+func (_m *SubaccountsKeeper) GetAdlRankedSubaccountIds(ctx types.Context, perpetualId uint32, isLong bool, limit uint32) []subaccountstypes.SubaccountId {
+	ret := _m.Called(ctx, perpetualId, isLong, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdlRankedSubaccountIds")
+	}
+
+	var r0 []subaccountstypes.SubaccountId
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, bool, uint32) []subaccountstypes.SubaccountId); ok {
+		r0 = rf(ctx, perpetualId, isLong, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]subaccountstypes.SubaccountId)
+		}
+	}
+
+	return r0
+}
+
 // GetAllSubaccount provides a mock function with given fields: ctx
 func (_m *SubaccountsKeeper) GetAllSubaccount(ctx types.Context) []subaccountstypes.Subaccount {
 	ret := _m.Called(ctx)
@@ -176,6 +196,54 @@ func (_m *SubaccountsKeeper) GetNetCollateralAndMarginRequirements(ctx types.Con
 	return r0, r1, r2, r3
 }
 
+// GetNetCollateralAndMarginRequirementsAtRiskPrices provides a mock function with given fields: ctx, update
+func (_m *SubaccountsKeeper) GetNetCollateralAndMarginRequirementsAtRiskPrices(ctx types.Context, update subaccountstypes.Update) (*big.Int, *big.Int, *big.Int, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetCollateralAndMarginRequirementsAtRiskPrices")
+	}
+
+	var r0 *big.Int
+	var r1 *big.Int
+	var r2 *big.Int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.Update) (*big.Int, *big.Int, *big.Int, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, subaccountstypes.Update) *big.Int); ok {
+		r0 = rf(ctx, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, subaccountstypes.Update) *big.Int); ok {
+		r1 = rf(ctx, update)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, subaccountstypes.Update) *big.Int); ok {
+		r2 = rf(ctx, update)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(types.Context, subaccountstypes.Update) error); ok {
+		r3 = rf(ctx, update)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // GetRandomSubaccount provides a mock function with given fields: ctx, _a1
 func (_m *SubaccountsKeeper) GetRandomSubaccount(ctx types.Context, _a1 *rand.Rand) (subaccountstypes.Subaccount, error) {
 	ret := _m.Called(ctx, _a1)
@@ -222,6 +290,11 @@ func (_m *SubaccountsKeeper) GetSubaccount(ctx types.Context, id subaccountstype
 	return r0
 }
 
+// InitializeAdlPositions provides a mock function with given fields: ctx
+func (_m *SubaccountsKeeper) InitializeAdlPositions(ctx types.Context) {
+	_m.Called(ctx)
+}
+
 // LegacyGetNegativeTncSubaccountSeenAtBlock provides a mock function with given fields: ctx
 func (_m *SubaccountsKeeper) LegacyGetNegativeTncSubaccountSeenAtBlock(ctx types.Context) (uint32, bool) {
 	ret := _m.Called(ctx)
@@ -1,44 +1,129 @@
 package grpc
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"slices"
+	"sort"
 	"sync"
 	"time"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
 	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 var _ types.GrpcStreamingManager = (*GrpcStreamingManagerImpl)(nil)
 
+// Maximum number of closed streams retained so that they can be resumed.
+const maxRetainedClosedStreams = 100
+
+// Length in bytes of the secret required to resume a stream.
+const resumeSecretLength = 16
+
 // GrpcStreamingManagerImpl is an implementation for managing gRPC streaming subscriptions.
 type GrpcStreamingManagerImpl struct {
 	sync.Mutex
 
+	logger log.Logger
+
+	// Transient store key used to stage the updates of the block being finalized.
+	transientStoreKey storetypes.StoreKey
+
 	// orderbookSubscriptions maps subscription IDs to their respective orderbook subscriptions.
 	orderbookSubscriptions map[uint32]*OrderbookSubscription
 	nextSubscriptionId     uint32
+
+	// Maximum number of responses buffered for each subscription.
+	subscriptionBufferSize uint32
+
+	// Whether a subscription whose buffer is full is re-initialized with fresh snapshots
+	// instead of being disconnected.
+	resnapshotOnOverflow bool
+
+	// Maximum number of responses retained for each stream to replay missed updates to
+	// resumed streams. Streams cannot be resumed if zero.
+	replayBufferSize uint32
+
+	// closedSubscriptions maps the ids of recently closed streams to their subscriptions so
+	// that the streams can be resumed. Updates keep being sequenced and retained for closed
+	// streams so that they are replayed once resumed. closedSubscriptionIds holds the same ids
+	// from oldest to newest and is used to evict the oldest closed stream.
+	closedSubscriptions   map[uint32]*OrderbookSubscription
+	closedSubscriptionIds []uint32
 }
 
 // OrderbookSubscription represents a active subscription to the orderbook updates stream.
 type OrderbookSubscription struct {
-	// Initialize the subscription with orderbook snapshots.
-	initialize sync.Once
+	// Id of the subscription. Also used as the id of the stream.
+	subscriptionId uint32
+
+	// Random secret of the stream. Stream ids are sequential and guessable, so a stream
+	// can only be resumed by a client presenting its secret.
+	resumeSecret []byte
+
+	// Whether orderbook snapshots have been sent for this subscription.
+	orderbookInitialized bool
 
 	// Clob pair ids to subscribe to.
 	clobPairIds []uint32
 
+	// Whether subaccount snapshots have been sent for this subscription. Subaccount
+	// updates are only sent once the snapshots have been sent.
+	subaccountsInitialized bool
+
+	// Subaccount ids to subscribe to.
+	subaccountIds []satypes.SubaccountId
+
 	// Stream
 	srv clobtypes.Query_StreamOrderbookUpdatesServer
+
+	// Buffered channel of responses to be sent through the stream. The channel is drained
+	// by the goroutine serving the stream so that slow clients never block the senders.
+	updatesChannel chan *clobtypes.StreamOrderbookUpdatesResponse
+
+	// Error returned to the client once the subscription has been removed.
+	closeErr error
+
+	// Sequence number of the next update sent on the stream.
+	nextSequenceNumber uint64
+
+	// Most recent responses sent on the stream, used to replay missed updates to a
+	// resumed stream.
+	replayBuffer *replayBuffer
+
+	// Sequence number of the first update sent after the stream was closed. Zero while the
+	// stream is active.
+	closedAtSequenceNumber uint64
 }
 
-func NewGrpcStreamingManager() *GrpcStreamingManagerImpl {
+func NewGrpcStreamingManager(
+	logger log.Logger,
+	subscriptionBufferSize uint32,
+	resnapshotOnOverflow bool,
+	replayBufferSize uint32,
+	transientStoreKey storetypes.StoreKey,
+) *GrpcStreamingManagerImpl {
+	logger = logger.With(log.ModuleKey, "grpc-streaming")
 	return &GrpcStreamingManagerImpl{
+		logger:                 logger,
+		transientStoreKey:      transientStoreKey,
 		orderbookSubscriptions: make(map[uint32]*OrderbookSubscription),
+		subscriptionBufferSize: subscriptionBufferSize,
+		resnapshotOnOverflow:   resnapshotOnOverflow,
+		replayBufferSize:       replayBufferSize,
+		closedSubscriptions:    make(map[uint32]*OrderbookSubscription),
+		closedSubscriptionIds:  make([]uint32, 0),
 	}
 }
 
@@ -46,7 +131,11 @@ func (sm *GrpcStreamingManagerImpl) Enabled() bool {
 	return true
 }
 
-// Subscribe subscribes to the orderbook updates stream.
+// Subscribe subscribes to the orderbook updates stream. The call blocks and sends the
+// buffered updates of the subscription through the stream until the stream is closed,
+// a send fails, or the subscription is dropped. If the request contains a resume token
+// and the updates missed by the client are still retained, the previous stream is resumed
+// and the missed updates are replayed. Otherwise, a new stream is started.
 func (sm *GrpcStreamingManagerImpl) Subscribe(
 	req clobtypes.StreamOrderbookUpdatesRequest,
 	srv clobtypes.Query_StreamOrderbookUpdatesServer,
@@ -54,28 +143,278 @@ func (sm *GrpcStreamingManagerImpl) Subscribe(
 	err error,
 ) {
 	clobPairIds := req.GetClobPairId()
+	subaccountIds := make([]satypes.SubaccountId, 0, len(req.GetSubaccountIds()))
+	for _, subaccountId := range req.GetSubaccountIds() {
+		if subaccountId == nil {
+			return clobtypes.ErrInvalidGrpcStreamingRequest
+		}
+		subaccountIds = append(subaccountIds, *subaccountId)
+	}
 
 	// Perform some basic validation on the request.
-	if len(clobPairIds) == 0 {
+	if len(clobPairIds) == 0 && len(subaccountIds) == 0 {
 		return clobtypes.ErrInvalidGrpcStreamingRequest
 	}
 
 	subscription := &OrderbookSubscription{
-		clobPairIds: clobPairIds,
-		srv:         srv,
+		clobPairIds:        clobPairIds,
+		subaccountIds:      subaccountIds,
+		srv:                srv,
+		updatesChannel:     make(chan *clobtypes.StreamOrderbookUpdatesResponse, sm.subscriptionBufferSize),
+		nextSequenceNumber: 1,
+		replayBuffer:       newReplayBuffer(sm.replayBufferSize),
+	}
+
+	resumeSecret := make([]byte, resumeSecretLength)
+	if _, err := rand.Read(resumeSecret); err != nil {
+		return err
+	}
+
+	sm.Lock()
+	missedResponses, resumed := sm.resumeSubscriptionWithLock(subscription, req.GetResumeToken())
+	if !resumed {
+		subscription.subscriptionId = sm.nextSubscriptionId
+		subscription.resumeSecret = resumeSecret
+		sm.nextSubscriptionId++
+	}
+	sm.orderbookSubscriptions[subscription.subscriptionId] = subscription
+	sm.emitSubscriptionCountMetric()
+	sm.Unlock()
+
+	// Replay the updates missed by the client before any new update.
+	for _, response := range missedResponses {
+		if err := sm.sendToStream(subscription, response); err != nil {
+			return err
+		}
+	}
+
+	// Drain the subscription channel in the goroutine serving the stream.
+	for {
+		select {
+		case response, ok := <-subscription.updatesChannel:
+			if !ok {
+				// The channel is only closed when the subscription has been removed.
+				return subscription.closeErr
+			}
+			if err := sm.sendToStream(subscription, response); err != nil {
+				return err
+			}
+		case <-srv.Context().Done():
+			sm.removeSubscription(subscription.subscriptionId, nil)
+			return nil
+		}
+	}
+}
+
+// sendToStream sends the response through the stream of the subscription. The subscription
+// is removed if the send fails.
+func (sm *GrpcStreamingManagerImpl) sendToStream(
+	subscription *OrderbookSubscription,
+	response *clobtypes.StreamOrderbookUpdatesResponse,
+) error {
+	err := subscription.srv.Send(response)
+	if err != nil {
+		sm.logger.Info(
+			"Failed to send update to gRPC stream, removing subscription",
+			"subscriptionId", subscription.subscriptionId,
+			"error", err,
+		)
+		sm.removeSubscription(subscription.subscriptionId, err)
+	}
+	return err
+}
+
+// resumeSubscriptionWithLock resumes the stream identified by the resume token with the given
+// subscription. It returns the responses missed by the client and true if the stream can be
+// resumed, and false otherwise. A stream can only be resumed once it is closed and with the
+// secret of the stream. The resumed subscription keeps the id, the secret, the clob pair ids
+// and the subaccount ids of the previous stream. The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) resumeSubscriptionWithLock(
+	subscription *OrderbookSubscription,
+	resumeToken *clobtypes.StreamResumeToken,
+) (
+	missedResponses []*clobtypes.StreamOrderbookUpdatesResponse,
+	resumed bool,
+) {
+	if resumeToken == nil || sm.replayBufferSize == 0 {
+		return nil, false
+	}
+	defer func() {
+		metrics.IncrCounterWithLabels(
+			metrics.GrpcResumedStreams,
+			1,
+			metrics.GetLabelForBoolValue(metrics.Success, resumed),
+		)
+	}()
+
+	// Never take over a stream that is still active. The client must wait for the
+	// previous stream to be closed or start a new stream.
+	if _, ok := sm.orderbookSubscriptions[resumeToken.StreamId]; ok {
+		return nil, false
+	}
+
+	previous, ok := sm.closedSubscriptions[resumeToken.StreamId]
+	if !ok || subtle.ConstantTimeCompare(previous.resumeSecret, resumeToken.ResumeSecret) != 1 {
+		return nil, false
+	}
+	missedResponses, ok = previous.replayBuffer.GetResponsesAfter(
+		resumeToken.LastSequenceNumber,
+		previous.nextSequenceNumber,
+	)
+	if !ok {
+		return nil, false
 	}
 
+	sm.deleteClosedSubscriptionWithLock(previous.subscriptionId)
+
+	subscription.subscriptionId = previous.subscriptionId
+	subscription.resumeSecret = previous.resumeSecret
+	subscription.orderbookInitialized = previous.orderbookInitialized
+	subscription.clobPairIds = previous.clobPairIds
+	subscription.subaccountsInitialized = previous.subaccountsInitialized
+	subscription.subaccountIds = previous.subaccountIds
+	subscription.nextSequenceNumber = previous.nextSequenceNumber
+	subscription.replayBuffer = previous.replayBuffer
+	return missedResponses, true
+}
+
+// removeSubscription removes the subscription with the given id and closes its channel.
+func (sm *GrpcStreamingManagerImpl) removeSubscription(subscriptionId uint32, closeErr error) {
 	sm.Lock()
 	defer sm.Unlock()
 
-	sm.orderbookSubscriptions[sm.nextSubscriptionId] = subscription
-	sm.nextSubscriptionId++
+	sm.removeSubscriptionWithLock(subscriptionId, closeErr)
+}
+
+// removeSubscriptionWithLock removes the subscription with the given id and closes its channel.
+// The subscription is retained so that its stream can be resumed, evicting the oldest closed
+// stream if needed. The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) removeSubscriptionWithLock(subscriptionId uint32, closeErr error) {
+	subscription, ok := sm.orderbookSubscriptions[subscriptionId]
+	if !ok {
+		return
+	}
+	subscription.closeErr = closeErr
+	close(subscription.updatesChannel)
+	delete(sm.orderbookSubscriptions, subscriptionId)
+	sm.emitSubscriptionCountMetric()
+
+	if sm.replayBufferSize == 0 {
+		return
+	}
+	subscription.closedAtSequenceNumber = subscription.nextSequenceNumber
+	sm.closedSubscriptions[subscriptionId] = subscription
+	sm.closedSubscriptionIds = append(sm.closedSubscriptionIds, subscriptionId)
+	if len(sm.closedSubscriptionIds) > maxRetainedClosedStreams {
+		sm.deleteClosedSubscriptionWithLock(sm.closedSubscriptionIds[0])
+	}
+}
+
+// deleteClosedSubscriptionWithLock stops retaining the closed stream with the given id.
+// The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) deleteClosedSubscriptionWithLock(subscriptionId uint32) {
+	delete(sm.closedSubscriptions, subscriptionId)
+	for i, id := range sm.closedSubscriptionIds {
+		if id == subscriptionId {
+			sm.closedSubscriptionIds = append(sm.closedSubscriptionIds[:i], sm.closedSubscriptionIds[i+1:]...)
+			break
+		}
+	}
+}
 
-	return nil
+// subscriptionsWithLock returns the active subscriptions followed by the closed subscriptions
+// that can still be resumed. The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) subscriptionsWithLock() []*OrderbookSubscription {
+	subscriptions := make([]*OrderbookSubscription, 0, len(sm.orderbookSubscriptions)+len(sm.closedSubscriptionIds))
+	for _, subscription := range sm.orderbookSubscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	for _, id := range sm.closedSubscriptionIds {
+		subscriptions = append(subscriptions, sm.closedSubscriptions[id])
+	}
+	return subscriptions
+}
+
+// sendResponseWithLock stamps the updates of the response with the sequence numbers of the
+// stream and enqueues the response on the subscription channel without blocking.
+// If the channel is full, the subscription is either disconnected or marked for fresh
+// snapshots depending on the drop policy of the manager. If the stream is closed, the response
+// is only retained to be replayed once the stream is resumed, and the stream can no longer be
+// resumed once the first update sent after it was closed is evicted from its replay buffer.
+// The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) sendResponseWithLock(
+	subscription *OrderbookSubscription,
+	response *clobtypes.StreamOrderbookUpdatesResponse,
+) {
+	nextSequenceNumber := subscription.nextSequenceNumber
+	response.StreamId = subscription.subscriptionId
+	response.ResumeSecret = subscription.resumeSecret
+	for i := range response.Updates {
+		response.Updates[i].SequenceNumber = subscription.nextSequenceNumber
+		subscription.nextSequenceNumber++
+	}
+
+	if subscription.closedAtSequenceNumber != 0 {
+		subscription.replayBuffer.Add(response)
+		if oldest, ok := subscription.replayBuffer.OldestSequenceNumber(); ok &&
+			oldest > subscription.closedAtSequenceNumber {
+			sm.deleteClosedSubscriptionWithLock(subscription.subscriptionId)
+		}
+		return
+	}
+
+	select {
+	case subscription.updatesChannel <- response:
+		subscription.replayBuffer.Add(response)
+		metrics.AddSample(
+			metrics.GrpcSubscriptionChannelLength,
+			float32(len(subscription.updatesChannel)),
+		)
+		return
+	default:
+	}
+
+	if !sm.resnapshotOnOverflow {
+		sm.logger.Error(
+			"gRPC stream subscription buffer is full, removing subscription",
+			"subscriptionId", subscription.subscriptionId,
+		)
+		metrics.IncrCounter(metrics.GrpcDroppedSubscriptions, 1)
+		// Retain the dropped response so that it is replayed if the stream is resumed.
+		subscription.replayBuffer.Add(response)
+		sm.removeSubscriptionWithLock(
+			subscription.subscriptionId,
+			clobtypes.ErrGrpcStreamingSubscriptionBufferFull,
+		)
+		return
+	}
+
+	sm.logger.Error(
+		"gRPC stream subscription buffer is full, resending snapshots",
+		"subscriptionId", subscription.subscriptionId,
+	)
+	metrics.IncrCounter(metrics.GrpcResnapshottedSubscriptions, 1)
+
+	// The dropped response is neither sequenced nor retained, so the sequence numbers received
+	// by the client stay contiguous. The buffered responses are still delivered, and further
+	// updates are held back until the snapshots are sent in the next block.
+	subscription.nextSequenceNumber = nextSequenceNumber
+	subscription.orderbookInitialized = false
+	subscription.subaccountsInitialized = false
+}
+
+// emitSubscriptionCountMetric emits the number of active subscriptions.
+// The caller must hold the manager lock.
+func (sm *GrpcStreamingManagerImpl) emitSubscriptionCountMetric() {
+	metrics.SetGauge(
+		metrics.GrpcStreamSubscriberCount,
+		float32(len(sm.orderbookSubscriptions)),
+	)
 }
 
 // SendOrderbookUpdates groups updates by their clob pair ids and
-// sends messages to the subscribers.
+// sends messages to the subscribers. Updates that are not snapshots are only sent
+// to subscriptions that have already received the orderbook snapshots.
 func (sm *GrpcStreamingManagerImpl) SendOrderbookUpdates(
 	offchainUpdates *clobtypes.OffchainUpdates,
 	snapshot bool,
@@ -111,9 +450,12 @@ func (sm *GrpcStreamingManagerImpl) SendOrderbookUpdates(
 	sm.Lock()
 	defer sm.Unlock()
 
-	// Send updates to subscribers.
-	idsToRemove := make([]uint32, 0)
-	for id, subscription := range sm.orderbookSubscriptions {
+	// Send updates to subscribers. Updates that are not snapshots are only sent to subscriptions
+	// that have already received the orderbook snapshots.
+	for _, subscription := range sm.subscriptionsWithLock() {
+		if !subscription.orderbookInitialized && !snapshot {
+			continue
+		}
 		updatesToSend := make([]ocutypes.OffChainUpdateV1, 0)
 		for _, clobPairId := range subscription.clobPairIds {
 			if updates, ok := v1updates[clobPairId]; ok {
@@ -130,27 +472,21 @@ func (sm *GrpcStreamingManagerImpl) SendOrderbookUpdates(
 					},
 				},
 			}
-			if err := subscription.srv.Send(
+			sm.sendResponseWithLock(
+				subscription,
 				&clobtypes.StreamOrderbookUpdatesResponse{
 					Updates:     []clobtypes.StreamUpdate{streamUpdates},
 					BlockHeight: blockHeight,
 					ExecMode:    uint32(execMode),
 				},
-			); err != nil {
-				idsToRemove = append(idsToRemove, id)
-			}
+			)
 		}
 	}
-
-	// Clean up subscriptions that have been closed.
-	// If a Send update has failed for any clob pair id, the whole subscription will be removed.
-	for _, id := range idsToRemove {
-		delete(sm.orderbookSubscriptions, id)
-	}
 }
 
 // SendOrderbookFillUpdates groups fills by their clob pair ids and
-// sends messages to the subscribers.
+// sends messages to the subscribers. Fills are only sent to subscriptions
+// that have already received the orderbook snapshots.
 func (sm *GrpcStreamingManagerImpl) SendOrderbookFillUpdates(
 	ctx sdk.Context,
 	orderbookFills []clobtypes.StreamOrderbookFill,
@@ -184,9 +520,12 @@ func (sm *GrpcStreamingManagerImpl) SendOrderbookFillUpdates(
 	sm.Lock()
 	defer sm.Unlock()
 
-	// Send updates to subscribers.
-	idsToRemove := make([]uint32, 0)
-	for id, subscription := range sm.orderbookSubscriptions {
+	// Send updates to subscribers. Fills are only sent to subscriptions that have already
+	// received the orderbook snapshots.
+	for _, subscription := range sm.subscriptionsWithLock() {
+		if !subscription.orderbookInitialized {
+			continue
+		}
 		streamUpdatesForSubscription := make([]clobtypes.StreamUpdate, 0)
 		for _, clobPairId := range subscription.clobPairIds {
 			if update, ok := updatesByClobPairId[clobPairId]; ok {
@@ -195,22 +534,199 @@ func (sm *GrpcStreamingManagerImpl) SendOrderbookFillUpdates(
 		}
 
 		if len(streamUpdatesForSubscription) > 0 {
-			if err := subscription.srv.Send(
+			sm.sendResponseWithLock(
+				subscription,
 				&clobtypes.StreamOrderbookUpdatesResponse{
 					Updates:     streamUpdatesForSubscription,
 					BlockHeight: blockHeight,
 					ExecMode:    uint32(execMode),
 				},
-			); err != nil {
-				idsToRemove = append(idsToRemove, id)
+			)
+		}
+	}
+}
+
+// SendSubaccountUpdates groups subaccount updates by their subaccount ids and
+// sends messages to the subscribers. Updates that are not snapshots are only sent
+// to subscriptions that have already received the subaccount snapshots.
+func (sm *GrpcStreamingManagerImpl) SendSubaccountUpdates(
+	subaccountUpdates []satypes.StreamSubaccountUpdate,
+	blockHeight uint32,
+	execMode sdk.ExecMode,
+) {
+	defer metrics.ModuleMeasureSince(
+		metrics.FullNodeGrpc,
+		metrics.GrpcSendSubaccountUpdatesLatency,
+		time.Now(),
+	)
+
+	// Group subaccount updates by subaccount id.
+	updatesBySubaccountId := make(map[satypes.SubaccountId][]clobtypes.StreamUpdate)
+	for i := range subaccountUpdates {
+		subaccountUpdate := &subaccountUpdates[i]
+		subaccountId := subaccountUpdate.SubaccountId
+		streamUpdate := clobtypes.StreamUpdate{
+			UpdateMessage: &clobtypes.StreamUpdate_SubaccountUpdate{
+				SubaccountUpdate: subaccountUpdate,
+			},
+		}
+		updatesBySubaccountId[subaccountId] = append(updatesBySubaccountId[subaccountId], streamUpdate)
+	}
+
+	sm.Lock()
+	defer sm.Unlock()
+
+	// Send updates to subscribers.
+	for _, subscription := range sm.subscriptionsWithLock() {
+		streamUpdatesForSubscription := make([]clobtypes.StreamUpdate, 0)
+		for _, subaccountId := range subscription.subaccountIds {
+			for _, update := range updatesBySubaccountId[subaccountId] {
+				if !subscription.subaccountsInitialized && !update.GetSubaccountUpdate().Snapshot {
+					continue
+				}
+				streamUpdatesForSubscription = append(streamUpdatesForSubscription, update)
 			}
 		}
+
+		if len(streamUpdatesForSubscription) > 0 {
+			sm.sendResponseWithLock(
+				subscription,
+				&clobtypes.StreamOrderbookUpdatesResponse{
+					Updates:     streamUpdatesForSubscription,
+					BlockHeight: blockHeight,
+					ExecMode:    uint32(execMode),
+				},
+			)
+		}
 	}
+}
+
+// StageFinalizeBlockFill stages a fill of the block being finalized in the transient store.
+// Staged updates are discarded along with the other state changes of a failed transaction.
+func (sm *GrpcStreamingManagerImpl) StageFinalizeBlockFill(
+	ctx sdk.Context,
+	orderbookFill clobtypes.StreamOrderbookFill,
+) {
+	sm.stageFinalizeBlockUpdate(
+		ctx,
+		clobtypes.StreamUpdate{
+			UpdateMessage: &clobtypes.StreamUpdate_OrderFill{
+				OrderFill: &orderbookFill,
+			},
+		},
+	)
+}
 
-	// Clean up subscriptions that have been closed.
-	// If a Send update has failed for any clob pair id, the whole subscription will be removed.
-	for _, id := range idsToRemove {
-		delete(sm.orderbookSubscriptions, id)
+// StageFinalizeBlockSubaccountUpdate stages a subaccount update of the block being finalized in
+// the transient store. Staged updates are discarded along with the other state changes of a failed
+// transaction.
+func (sm *GrpcStreamingManagerImpl) StageFinalizeBlockSubaccountUpdate(
+	ctx sdk.Context,
+	subaccountUpdate satypes.StreamSubaccountUpdate,
+) {
+	sm.stageFinalizeBlockUpdate(
+		ctx,
+		clobtypes.StreamUpdate{
+			UpdateMessage: &clobtypes.StreamUpdate_SubaccountUpdate{
+				SubaccountUpdate: &subaccountUpdate,
+			},
+		},
+	)
+}
+
+// stageFinalizeBlockUpdate appends the update to the updates staged within the block. Fills and
+// subaccount updates share a single queue so that they are sent in the order they happened.
+func (sm *GrpcStreamingManagerImpl) stageFinalizeBlockUpdate(
+	ctx sdk.Context,
+	update clobtypes.StreamUpdate,
+) {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.TransientStore(sm.transientStoreKey)
+	count := getStagedFinalizeBlockUpdatesCount(store)
+	updateStore := prefix.NewStore(store, []byte(types.StagedFinalizeBlockUpdateKeyPrefix))
+	bytes, err := update.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	updateStore.Set(lib.Uint32ToKey(count), bytes)
+	store.Set([]byte(types.StagedFinalizeBlockUpdateCountKey), lib.Uint32ToKey(count+1))
+}
+
+// GetStagedFinalizeBlockUpdates returns the updates staged within the block, in the order they were
+// staged.
+func (sm *GrpcStreamingManagerImpl) GetStagedFinalizeBlockUpdates(ctx sdk.Context) []clobtypes.StreamUpdate {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.TransientStore(sm.transientStoreKey)
+	count := getStagedFinalizeBlockUpdatesCount(store)
+	updateStore := prefix.NewStore(store, []byte(types.StagedFinalizeBlockUpdateKeyPrefix))
+	updates := make([]clobtypes.StreamUpdate, count)
+	for i := uint32(0); i < count; i++ {
+		if err := updates[i].Unmarshal(updateStore.Get(lib.Uint32ToKey(i))); err != nil {
+			panic(err)
+		}
+	}
+	return updates
+}
+
+// getStagedFinalizeBlockUpdatesCount returns the number of updates staged within the block.
+func getStagedFinalizeBlockUpdatesCount(store storetypes.KVStore) uint32 {
+	b := store.Get([]byte(types.StagedFinalizeBlockUpdateCountKey))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+// SendFinalizedBlockUpdates sends the updates staged within the block to the subscribers, keeping
+// the order in which fills and subaccount updates were staged. It should only be called in EndBlocker
+// once all transactions of the block have been delivered. Fills are only sent to subscriptions that
+// have already received the orderbook snapshots, and subaccount updates that are not snapshots are
+// only sent to subscriptions that have already received the subaccount snapshots.
+func (sm *GrpcStreamingManagerImpl) SendFinalizedBlockUpdates(ctx sdk.Context) {
+	defer metrics.ModuleMeasureSince(
+		metrics.FullNodeGrpc,
+		metrics.GrpcSendFinalizedUpdatesLatency,
+		time.Now(),
+	)
+
+	updates := sm.GetStagedFinalizeBlockUpdates(ctx)
+	if len(updates) == 0 {
+		return
+	}
+
+	sm.Lock()
+	defer sm.Unlock()
+
+	for _, subscription := range sm.subscriptionsWithLock() {
+		streamUpdatesForSubscription := make([]clobtypes.StreamUpdate, 0)
+		for _, update := range updates {
+			if orderFill := update.GetOrderFill(); orderFill != nil {
+				// All orders of a fill share the same clob pair id.
+				clobPairId := orderFill.Orders[0].OrderId.ClobPairId
+				if !subscription.orderbookInitialized || !slices.Contains(subscription.clobPairIds, clobPairId) {
+					continue
+				}
+			} else if subaccountUpdate := update.GetSubaccountUpdate(); subaccountUpdate != nil {
+				if !subscription.subaccountsInitialized && !subaccountUpdate.Snapshot {
+					continue
+				}
+				if !slices.Contains(subscription.subaccountIds, subaccountUpdate.SubaccountId) {
+					continue
+				}
+			}
+			streamUpdatesForSubscription = append(streamUpdatesForSubscription, update)
+		}
+
+		if len(streamUpdatesForSubscription) > 0 {
+			sm.sendResponseWithLock(
+				subscription,
+				&clobtypes.StreamOrderbookUpdatesResponse{
+					Updates:     streamUpdatesForSubscription,
+					BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+					ExecMode:    uint32(sdk.ExecModeFinalize),
+				},
+			)
+		}
 	}
 }
 
@@ -221,18 +737,48 @@ func (sm *GrpcStreamingManagerImpl) GetUninitializedClobPairIds() []uint32 {
 
 	clobPairIds := make(map[uint32]bool)
 	for _, subscription := range sm.orderbookSubscriptions {
-		subscription.initialize.Do(
-			func() {
-				for _, clobPairId := range subscription.clobPairIds {
-					clobPairIds[clobPairId] = true
-				}
-			},
-		)
+		if subscription.orderbookInitialized {
+			continue
+		}
+		for _, clobPairId := range subscription.clobPairIds {
+			clobPairIds[clobPairId] = true
+		}
+		subscription.orderbookInitialized = true
 	}
 
 	return lib.GetSortedKeys[lib.Sortable[uint32]](clobPairIds)
 }
 
+// GetUninitializedSubaccountIds returns the subaccount ids that have not been initialized.
+// Subscriptions returned by this method are marked as initialized, so the caller is expected
+// to send the subaccount snapshots right after.
+func (sm *GrpcStreamingManagerImpl) GetUninitializedSubaccountIds() []satypes.SubaccountId {
+	sm.Lock()
+	defer sm.Unlock()
+
+	subaccountIds := make(map[satypes.SubaccountId]bool)
+	for _, subscription := range sm.orderbookSubscriptions {
+		if subscription.subaccountsInitialized {
+			continue
+		}
+		for _, subaccountId := range subscription.subaccountIds {
+			subaccountIds[subaccountId] = true
+		}
+		subscription.subaccountsInitialized = true
+	}
+
+	sortedSubaccountIds := make([]satypes.SubaccountId, 0, len(subaccountIds))
+	for subaccountId := range subaccountIds {
+		sortedSubaccountIds = append(sortedSubaccountIds, subaccountId)
+	}
+	sort.Slice(sortedSubaccountIds, func(i, j int) bool {
+		return sortedSubaccountIds[i].Owner < sortedSubaccountIds[j].Owner ||
+			(sortedSubaccountIds[i].Owner == sortedSubaccountIds[j].Owner &&
+				sortedSubaccountIds[i].Number < sortedSubaccountIds[j].Number)
+	})
+	return sortedSubaccountIds
+}
+
 // GetOffchainUpdatesV1 unmarshals messages in offchain updates to OffchainUpdateV1.
 func GetOffchainUpdatesV1(offchainUpdates *clobtypes.OffchainUpdates) ([]ocutypes.OffChainUpdateV1, error) {
 	v1updates := make([]ocutypes.OffChainUpdateV1, 0)
@@ -0,0 +1,738 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	grpclib "google.golang.org/grpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockStreamServer is a stream server whose sends block until `unblock` is closed.
+type mockStreamServer struct {
+	grpclib.ServerStream
+
+	ctx     context.Context
+	unblock chan struct{}
+	sent    chan *clobtypes.StreamOrderbookUpdatesResponse
+}
+
+func newMockStreamServer(ctx context.Context, blocked bool) *mockStreamServer {
+	srv := &mockStreamServer{
+		ctx:     ctx,
+		unblock: make(chan struct{}),
+		sent:    make(chan *clobtypes.StreamOrderbookUpdatesResponse, 100),
+	}
+	if !blocked {
+		close(srv.unblock)
+	}
+	return srv
+}
+
+func (m *mockStreamServer) Send(response *clobtypes.StreamOrderbookUpdatesResponse) error {
+	select {
+	case <-m.unblock:
+	case <-m.ctx.Done():
+		return m.ctx.Err()
+	}
+	m.sent <- response
+	return nil
+}
+
+func (m *mockStreamServer) Context() context.Context {
+	return m.ctx
+}
+
+func newFill(clobPairId uint32) clobtypes.StreamOrderbookFill {
+	return clobtypes.StreamOrderbookFill{
+		Orders: []clobtypes.Order{
+			{
+				OrderId: clobtypes.OrderId{
+					ClobPairId: clobPairId,
+				},
+			},
+		},
+	}
+}
+
+// subscribe subscribes the server in a new goroutine, waits until the subscription is
+// registered and returns a channel with the result of the subscription.
+func subscribe(
+	t *testing.T,
+	sm *grpc.GrpcStreamingManagerImpl,
+	clobPairIds []uint32,
+	srv *mockStreamServer,
+) chan error {
+	return subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId: clobPairIds,
+		},
+		srv,
+	)
+}
+
+// subscribeWithRequest is like subscribe, but with a custom request.
+func subscribeWithRequest(
+	t *testing.T,
+	sm *grpc.GrpcStreamingManagerImpl,
+	req clobtypes.StreamOrderbookUpdatesRequest,
+	srv *mockStreamServer,
+) chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- sm.Subscribe(req, srv)
+	}()
+
+	// The clob pair ids of a new subscription are returned exactly once.
+	require.Eventually(
+		t,
+		func() bool {
+			return len(sm.GetUninitializedClobPairIds()) > 0
+		},
+		time.Second,
+		time.Millisecond,
+	)
+	return result
+}
+
+func TestSubscribe_InvalidRequest(t *testing.T) {
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 0, nil)
+	err := sm.Subscribe(
+		clobtypes.StreamOrderbookUpdatesRequest{},
+		newMockStreamServer(context.Background(), false),
+	)
+	require.ErrorIs(t, err, clobtypes.ErrInvalidGrpcStreamingRequest)
+}
+
+func TestSendOrderbookFillUpdates_StuckClientDoesNotBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 2, false, 0, nil)
+
+	stuckSrv := newMockStreamServer(ctx, true)
+	stuckResult := subscribe(t, sm, []uint32{0}, stuckSrv)
+	healthySrv := newMockStreamServer(ctx, false)
+	healthyResult := subscribe(t, sm, []uint32{0}, healthySrv)
+
+	// Sending more updates than the buffer size must not block on the stuck client,
+	// and the healthy client keeps receiving all updates in order.
+	for i := 0; i < 10; i++ {
+		done := make(chan struct{})
+		go func() {
+			sm.SendOrderbookFillUpdates(
+				sdk.Context{},
+				[]clobtypes.StreamOrderbookFill{newFill(0)},
+				uint32(i),
+				sdk.ExecModeFinalize,
+			)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("SendOrderbookFillUpdates was blocked by a stuck client")
+		}
+
+		select {
+		case response := <-healthySrv.sent:
+			require.Equal(t, uint32(i), response.BlockHeight)
+			require.Len(t, response.Updates, 1)
+			require.NotNil(t, response.Updates[0].GetOrderFill())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("healthy client did not receive update %d", i)
+		}
+	}
+
+	// The stuck client is dropped once its buffer is full, and its stream is closed as soon
+	// as the pending send returns.
+	close(stuckSrv.unblock)
+	select {
+	case err := <-stuckResult:
+		require.ErrorIs(t, err, clobtypes.ErrGrpcStreamingSubscriptionBufferFull)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stuck client was not disconnected")
+	}
+
+	// Closing the stream removes the healthy subscription.
+	cancel()
+	select {
+	case err := <-healthyResult:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription was not closed")
+	}
+}
+
+func TestSendOrderbookFillUpdates_ResnapshotOnOverflow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 2, true, 0, nil)
+
+	stuckSrv := newMockStreamServer(ctx, true)
+	stuckResult := subscribe(t, sm, []uint32{1}, stuckSrv)
+	require.Empty(t, sm.GetUninitializedClobPairIds())
+
+	for i := 0; i < 10; i++ {
+		sm.SendOrderbookFillUpdates(
+			sdk.Context{},
+			[]clobtypes.StreamOrderbookFill{newFill(1)},
+			uint32(i),
+			sdk.ExecModeFinalize,
+		)
+	}
+
+	// The subscription is not disconnected, but it is marked for fresh snapshots.
+	require.Equal(t, []uint32{1}, sm.GetUninitializedClobPairIds())
+	select {
+	case err := <-stuckResult:
+		t.Fatalf("subscription was unexpectedly closed: %v", err)
+	default:
+	}
+
+	// Once the client catches up and the snapshots are resent, it keeps receiving updates.
+	close(stuckSrv.unblock)
+	require.Eventually(
+		t,
+		func() bool {
+			sm.GetUninitializedClobPairIds()
+			sm.SendOrderbookFillUpdates(
+				sdk.Context{},
+				[]clobtypes.StreamOrderbookFill{newFill(1)},
+				100,
+				sdk.ExecModeFinalize,
+			)
+			for {
+				select {
+				case response := <-stuckSrv.sent:
+					if response.BlockHeight == 100 {
+						return true
+					}
+				case <-time.After(10 * time.Millisecond):
+					return false
+				}
+			}
+		},
+		5*time.Second,
+		time.Millisecond,
+	)
+}
+
+func TestSendOrderbookUpdates_ResnapshotOnOverflowHoldsUpdatesUntilSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 1, true, 10, nil)
+
+	stuckSrv := newMockStreamServer(ctx, true)
+	subscribe(t, sm, []uint32{0}, stuckSrv)
+
+	// At most one response is being sent and one is buffered, so the buffer overflows by the third one.
+	for i := 1; i <= 3; i++ {
+		sm.SendOrderbookFillUpdates(
+			sdk.Context{},
+			[]clobtypes.StreamOrderbookFill{newFill(0)},
+			uint32(i),
+			sdk.ExecModeFinalize,
+		)
+	}
+
+	// Updates sent before the snapshots are held back.
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		4,
+		sdk.ExecModeFinalize,
+	)
+	sm.SendOrderbookUpdates(
+		&clobtypes.OffchainUpdates{
+			Messages: []clobtypes.OffchainUpdateMessage{{OrderId: clobtypes.OrderId{ClobPairId: 0}}},
+		},
+		false,
+		4,
+		sdk.ExecModeFinalize,
+	)
+
+	// The client catches up before the snapshots are sent in the next block.
+	close(stuckSrv.unblock)
+	responses := []*clobtypes.StreamOrderbookUpdatesResponse{receive(t, stuckSrv)}
+	for received := true; received; {
+		select {
+		case response := <-stuckSrv.sent:
+			responses = append(responses, response)
+		case <-time.After(100 * time.Millisecond):
+			received = false
+		}
+	}
+	for _, response := range responses {
+		require.Less(t, response.BlockHeight, uint32(4))
+		require.NotNil(t, response.Updates[0].GetOrderFill())
+	}
+
+	require.Equal(t, []uint32{0}, sm.GetUninitializedClobPairIds())
+	sm.SendOrderbookUpdates(
+		&clobtypes.OffchainUpdates{
+			Messages: []clobtypes.OffchainUpdateMessage{{OrderId: clobtypes.OrderId{ClobPairId: 0}}},
+		},
+		true,
+		5,
+		sdk.ExecModeFinalize,
+	)
+	snapshot := receive(t, stuckSrv)
+	responses = append(responses, snapshot)
+
+	// The client receives the updates sent before the overflow followed by the snapshot,
+	// with contiguous sequence numbers.
+	for i, response := range responses {
+		require.Len(t, response.Updates, 1)
+		require.Equal(t, uint64(i+1), response.Updates[0].SequenceNumber)
+	}
+	require.Equal(t, uint32(5), snapshot.BlockHeight)
+	require.True(t, snapshot.Updates[0].GetOrderbookUpdate().Snapshot)
+}
+
+// receive returns the next response sent on the stream.
+func receive(t *testing.T, srv *mockStreamServer) *clobtypes.StreamOrderbookUpdatesResponse {
+	select {
+	case response := <-srv.sent:
+		return response
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response received")
+		return nil
+	}
+}
+
+func TestSendOrderbookFillUpdates_SequenceNumbers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 10, nil)
+	srv := newMockStreamServer(ctx, false)
+	subscribe(t, sm, []uint32{0, 1}, srv)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0), newFill(1)},
+		1,
+		sdk.ExecModeFinalize,
+	)
+	// Fills of clob pairs that are not subscribed to do not consume sequence numbers.
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(2)},
+		2,
+		sdk.ExecModeFinalize,
+	)
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(1)},
+		3,
+		sdk.ExecModeFinalize,
+	)
+
+	response := receive(t, srv)
+	require.Equal(t, uint32(1), response.BlockHeight)
+	require.Len(t, response.Updates, 2)
+	require.Equal(t, uint64(1), response.Updates[0].SequenceNumber)
+	require.Equal(t, uint64(2), response.Updates[1].SequenceNumber)
+
+	response = receive(t, srv)
+	require.Equal(t, uint32(3), response.BlockHeight)
+	require.Len(t, response.Updates, 1)
+	require.Equal(t, uint64(3), response.Updates[0].SequenceNumber)
+}
+
+func TestSubscribe_ResumeStream(t *testing.T) {
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 10, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newMockStreamServer(ctx, false)
+	result := subscribe(t, sm, []uint32{0}, srv)
+
+	for i := 0; i < 3; i++ {
+		sm.SendOrderbookFillUpdates(
+			sdk.Context{},
+			[]clobtypes.StreamOrderbookFill{newFill(0)},
+			uint32(i),
+			sdk.ExecModeFinalize,
+		)
+	}
+	response := receive(t, srv)
+	require.Equal(t, uint64(1), response.Updates[0].SequenceNumber)
+	require.Len(t, response.ResumeSecret, 16)
+	streamId := response.StreamId
+	resumeSecret := response.ResumeSecret
+
+	// The client disconnects after receiving the first update.
+	cancel()
+	require.NoError(t, <-result)
+
+	// Fills sent while the client is disconnected are sequenced and retained.
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		3,
+		sdk.ExecModeFinalize,
+	)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumedSrv := newMockStreamServer(ctx, false)
+	resumedResult := make(chan error, 1)
+	go func() {
+		resumedResult <- sm.Subscribe(
+			clobtypes.StreamOrderbookUpdatesRequest{
+				ClobPairId: []uint32{0},
+				ResumeToken: &clobtypes.StreamResumeToken{
+					StreamId:           streamId,
+					LastSequenceNumber: 1,
+					ResumeSecret:       resumeSecret,
+				},
+			},
+			resumedSrv,
+		)
+	}()
+
+	// The missed updates, including the fill sent while disconnected, are replayed on the
+	// resumed stream.
+	for i := 2; i <= 4; i++ {
+		response := receive(t, resumedSrv)
+		require.Equal(t, streamId, response.StreamId)
+		require.Equal(t, resumeSecret, response.ResumeSecret)
+		require.Equal(t, uint32(i-1), response.BlockHeight)
+		require.Len(t, response.Updates, 1)
+		require.Equal(t, uint64(i), response.Updates[0].SequenceNumber)
+		require.NotNil(t, response.Updates[0].GetOrderFill())
+	}
+
+	// The resumed stream is already initialized and keeps its sequence numbers.
+	require.Empty(t, sm.GetUninitializedClobPairIds())
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		4,
+		sdk.ExecModeFinalize,
+	)
+	response = receive(t, resumedSrv)
+	require.Equal(t, streamId, response.StreamId)
+	require.Equal(t, uint32(4), response.BlockHeight)
+	require.Equal(t, uint64(5), response.Updates[0].SequenceNumber)
+	select {
+	case err := <-resumedResult:
+		t.Fatalf("resumed stream was unexpectedly closed: %v", err)
+	default:
+	}
+}
+
+func TestSubscribe_ResumeStreamExpiresOnceMissedUpdatesAreEvicted(t *testing.T) {
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 2, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newMockStreamServer(ctx, false)
+	result := subscribe(t, sm, []uint32{0}, srv)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		0,
+		sdk.ExecModeFinalize,
+	)
+	response := receive(t, srv)
+	streamId := response.StreamId
+	resumeSecret := response.ResumeSecret
+	cancel()
+	require.NoError(t, <-result)
+
+	// More fills than the replay buffer retains are sent while the client is disconnected,
+	// so the first missed fill is evicted and the stream can no longer be resumed.
+	for i := 1; i <= 3; i++ {
+		sm.SendOrderbookFillUpdates(
+			sdk.Context{},
+			[]clobtypes.StreamOrderbookFill{newFill(0)},
+			uint32(i),
+			sdk.ExecModeFinalize,
+		)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumedSrv := newMockStreamServer(ctx, false)
+	subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId: []uint32{0},
+			ResumeToken: &clobtypes.StreamResumeToken{
+				StreamId:           streamId,
+				LastSequenceNumber: 1,
+				ResumeSecret:       resumeSecret,
+			},
+		},
+		resumedSrv,
+	)
+
+	// A new stream is started instead.
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		4,
+		sdk.ExecModeFinalize,
+	)
+	response = receive(t, resumedSrv)
+	require.NotEqual(t, streamId, response.StreamId)
+	require.Equal(t, uint64(1), response.Updates[0].SequenceNumber)
+}
+
+func TestSubscribe_ResumeStreamFallsBackToSnapshot(t *testing.T) {
+	// Only the last response of each stream is retained.
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 1, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newMockStreamServer(ctx, false)
+	result := subscribe(t, sm, []uint32{0}, srv)
+
+	for i := 0; i < 3; i++ {
+		sm.SendOrderbookFillUpdates(
+			sdk.Context{},
+			[]clobtypes.StreamOrderbookFill{newFill(0)},
+			uint32(i),
+			sdk.ExecModeFinalize,
+		)
+	}
+	response := receive(t, srv)
+	streamId := response.StreamId
+	resumeSecret := response.ResumeSecret
+	cancel()
+	require.NoError(t, <-result)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumedSrv := newMockStreamServer(ctx, false)
+	subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId: []uint32{0},
+			ResumeToken: &clobtypes.StreamResumeToken{
+				StreamId:           streamId,
+				LastSequenceNumber: 1,
+				ResumeSecret:       resumeSecret,
+			},
+		},
+		resumedSrv,
+	)
+
+	// A new stream is started since update 2 is no longer retained.
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		3,
+		sdk.ExecModeFinalize,
+	)
+	response = receive(t, resumedSrv)
+	require.NotEqual(t, streamId, response.StreamId)
+	require.Equal(t, uint64(1), response.Updates[0].SequenceNumber)
+}
+
+func TestSubscribe_ResumeStreamRequiresSecret(t *testing.T) {
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 10, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := newMockStreamServer(ctx, false)
+	result := subscribe(t, sm, []uint32{0}, srv)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		1,
+		sdk.ExecModeFinalize,
+	)
+	response := receive(t, srv)
+	streamId := response.StreamId
+	cancel()
+	require.NoError(t, <-result)
+
+	// Resuming the stream with a guessed secret starts a new stream.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	resumedSrv := newMockStreamServer(ctx, false)
+	subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId: []uint32{0},
+			ResumeToken: &clobtypes.StreamResumeToken{
+				StreamId:           streamId,
+				LastSequenceNumber: 0,
+				ResumeSecret:       make([]byte, 16),
+			},
+		},
+		resumedSrv,
+	)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		2,
+		sdk.ExecModeFinalize,
+	)
+	response = receive(t, resumedSrv)
+	require.NotEqual(t, streamId, response.StreamId)
+	require.Equal(t, uint32(2), response.BlockHeight)
+	require.Equal(t, uint64(1), response.Updates[0].SequenceNumber)
+}
+
+func TestSubscribe_ResumeStreamDoesNotCloseActiveStream(t *testing.T) {
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 10, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	srv := newMockStreamServer(ctx, false)
+	result := subscribe(t, sm, []uint32{0}, srv)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		1,
+		sdk.ExecModeFinalize,
+	)
+	response := receive(t, srv)
+	streamId := response.StreamId
+
+	// Resuming a stream that is still active starts a new stream, even with the secret.
+	otherSrv := newMockStreamServer(ctx, false)
+	subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId: []uint32{0},
+			ResumeToken: &clobtypes.StreamResumeToken{
+				StreamId:           streamId,
+				LastSequenceNumber: 1,
+				ResumeSecret:       response.ResumeSecret,
+			},
+		},
+		otherSrv,
+	)
+
+	sm.SendOrderbookFillUpdates(
+		sdk.Context{},
+		[]clobtypes.StreamOrderbookFill{newFill(0)},
+		2,
+		sdk.ExecModeFinalize,
+	)
+	otherResponse := receive(t, otherSrv)
+	require.NotEqual(t, streamId, otherResponse.StreamId)
+	require.NotEqual(t, response.ResumeSecret, otherResponse.ResumeSecret)
+
+	// The active stream keeps receiving its updates.
+	response = receive(t, srv)
+	require.Equal(t, streamId, response.StreamId)
+	require.Equal(t, uint64(2), response.Updates[0].SequenceNumber)
+	select {
+	case err := <-result:
+		t.Fatalf("active stream was unexpectedly closed: %v", err)
+	default:
+	}
+}
+
+// newStagingContext returns a context with the transient store of the streaming manager mounted.
+func newStagingContext(t *testing.T, storeKey storetypes.StoreKey) sdk.Context {
+	ctx, stateStore, db := sdktest.NewSdkContextWithMultistore()
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	return ctx.WithBlockHeight(5)
+}
+
+func newSubaccountUpdate(subaccountId satypes.SubaccountId) satypes.StreamSubaccountUpdate {
+	return satypes.StreamSubaccountUpdate{
+		SubaccountId: subaccountId,
+	}
+}
+
+func TestStageFinalizeBlockUpdates_DiscardedWithFailedTx(t *testing.T) {
+	storeKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 0, storeKey)
+	ctx := newStagingContext(t, storeKey)
+	require.Empty(t, sm.GetStagedFinalizeBlockUpdates(ctx))
+
+	// Updates staged in a committed context are kept.
+	committedCtx, writeCache := ctx.CacheContext()
+	sm.StageFinalizeBlockFill(committedCtx, newFill(0))
+	writeCache()
+
+	// Updates staged in a context that is discarded, e.g. a failed transaction, are dropped.
+	discardedCtx, _ := ctx.CacheContext()
+	sm.StageFinalizeBlockSubaccountUpdate(discardedCtx, newSubaccountUpdate(constants.Alice_Num0))
+	require.Len(t, sm.GetStagedFinalizeBlockUpdates(discardedCtx), 2)
+
+	updates := sm.GetStagedFinalizeBlockUpdates(ctx)
+	require.Len(t, updates, 1)
+	require.NotNil(t, updates[0].GetOrderFill())
+}
+
+func TestSendFinalizedBlockUpdates_FillsAndSubaccountUpdatesInOrder(t *testing.T) {
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storeKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	sm := grpc.NewGrpcStreamingManager(log.NewNopLogger(), 10, false, 10, storeKey)
+	ctx := newStagingContext(t, storeKey)
+	srv := newMockStreamServer(streamCtx, false)
+	subscribeWithRequest(
+		t,
+		sm,
+		clobtypes.StreamOrderbookUpdatesRequest{
+			ClobPairId:    []uint32{0},
+			SubaccountIds: []*satypes.SubaccountId{&constants.Alice_Num0},
+		},
+		srv,
+	)
+	require.Equal(t, []satypes.SubaccountId{constants.Alice_Num0}, sm.GetUninitializedSubaccountIds())
+
+	// Stage the updates of two matches of Alice, interleaved with updates of clob pairs and
+	// subaccounts that are not subscribed to.
+	sm.StageFinalizeBlockSubaccountUpdate(ctx, newSubaccountUpdate(constants.Alice_Num0))
+	sm.StageFinalizeBlockFill(ctx, newFill(0))
+	sm.StageFinalizeBlockSubaccountUpdate(ctx, newSubaccountUpdate(constants.Bob_Num0))
+	sm.StageFinalizeBlockFill(ctx, newFill(1))
+	sm.StageFinalizeBlockSubaccountUpdate(ctx, newSubaccountUpdate(constants.Alice_Num0))
+	sm.StageFinalizeBlockFill(ctx, newFill(0))
+
+	// Nothing is sent until the block is finalized.
+	select {
+	case response := <-srv.sent:
+		t.Fatalf("unexpected response sent before the block is finalized: %v", response)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// Fills and subaccount updates are sent in a single response, in the order they were staged.
+	sm.SendFinalizedBlockUpdates(ctx)
+	response := receive(t, srv)
+	require.Equal(t, uint32(5), response.BlockHeight)
+	require.Equal(t, uint32(sdk.ExecModeFinalize), response.ExecMode)
+	require.Len(t, response.Updates, 4)
+	for i, update := range response.Updates {
+		require.Equal(t, uint64(i+1), update.SequenceNumber)
+		if i%2 == 0 {
+			require.Equal(t, constants.Alice_Num0, update.GetSubaccountUpdate().SubaccountId)
+		} else {
+			require.Equal(t, uint32(0), update.GetOrderFill().Orders[0].OrderId.ClobPairId)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/streaming/grpc/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 var _ types.GrpcStreamingManager = (*NoopGrpcStreamingManager)(nil)
@@ -46,3 +47,33 @@ func (sm *NoopGrpcStreamingManager) SendOrderbookFillUpdates(
 func (sm *NoopGrpcStreamingManager) GetUninitializedClobPairIds() []uint32 {
 	return []uint32{}
 }
+
+func (sm *NoopGrpcStreamingManager) SendSubaccountUpdates(
+	subaccountUpdates []satypes.StreamSubaccountUpdate,
+	blockHeight uint32,
+	execMode sdk.ExecMode,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) GetUninitializedSubaccountIds() []satypes.SubaccountId {
+	return []satypes.SubaccountId{}
+}
+
+func (sm *NoopGrpcStreamingManager) StageFinalizeBlockFill(
+	ctx sdk.Context,
+	orderbookFill clobtypes.StreamOrderbookFill,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) StageFinalizeBlockSubaccountUpdate(
+	ctx sdk.Context,
+	subaccountUpdate satypes.StreamSubaccountUpdate,
+) {
+}
+
+func (sm *NoopGrpcStreamingManager) GetStagedFinalizeBlockUpdates(ctx sdk.Context) []clobtypes.StreamUpdate {
+	return []clobtypes.StreamUpdate{}
+}
+
+func (sm *NoopGrpcStreamingManager) SendFinalizedBlockUpdates(ctx sdk.Context) {
+}
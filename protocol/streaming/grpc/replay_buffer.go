@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// replayBuffer is a bounded ring of the most recent responses sent on a stream. It is used
+// to replay the updates missed by a client that resumes a stream after a disconnect.
+type replayBuffer struct {
+	responses []*clobtypes.StreamOrderbookUpdatesResponse
+	// Index of the oldest response in `responses`.
+	start int
+	// Number of responses currently retained.
+	size int
+}
+
+func newReplayBuffer(capacity uint32) *replayBuffer {
+	return &replayBuffer{
+		responses: make([]*clobtypes.StreamOrderbookUpdatesResponse, capacity),
+	}
+}
+
+// Add adds a response to the buffer, evicting the oldest response if the buffer is full.
+// Responses must be added in sequence number order and must contain at least one update.
+func (b *replayBuffer) Add(response *clobtypes.StreamOrderbookUpdatesResponse) {
+	capacity := len(b.responses)
+	if capacity == 0 {
+		return
+	}
+	if b.size < capacity {
+		b.responses[(b.start+b.size)%capacity] = response
+		b.size++
+		return
+	}
+	b.responses[b.start] = response
+	b.start = (b.start + 1) % capacity
+}
+
+// OldestSequenceNumber returns the sequence number of the oldest retained update. The second
+// return value is false if no response is retained.
+func (b *replayBuffer) OldestSequenceNumber() (uint64, bool) {
+	if b.size == 0 {
+		return 0, false
+	}
+	return b.responses[b.start].Updates[0].SequenceNumber, true
+}
+
+// GetResponsesAfter returns the retained responses containing updates with a sequence number
+// strictly greater than `sequenceNumber`, in order. Updates with a sequence number lower than
+// or equal to `sequenceNumber` are trimmed from the returned responses. The second return
+// value is false if some of the updates after `sequenceNumber` are no longer retained.
+func (b *replayBuffer) GetResponsesAfter(
+	sequenceNumber uint64,
+	nextSequenceNumber uint64,
+) (
+	responses []*clobtypes.StreamOrderbookUpdatesResponse,
+	ok bool,
+) {
+	if sequenceNumber >= nextSequenceNumber {
+		return nil, false
+	}
+	// No updates were missed.
+	if sequenceNumber+1 == nextSequenceNumber {
+		return []*clobtypes.StreamOrderbookUpdatesResponse{}, true
+	}
+	if oldest, ok := b.OldestSequenceNumber(); !ok || oldest > sequenceNumber+1 {
+		return nil, false
+	}
+
+	capacity := len(b.responses)
+
+	responses = make([]*clobtypes.StreamOrderbookUpdatesResponse, 0)
+	for i := 0; i < b.size; i++ {
+		response := b.responses[(b.start+i)%capacity]
+		if response.Updates[len(response.Updates)-1].SequenceNumber <= sequenceNumber {
+			continue
+		}
+
+		// Trim the updates that were already received.
+		if response.Updates[0].SequenceNumber <= sequenceNumber {
+			trimmed := *response
+			trimmed.Updates = response.Updates[sequenceNumber+1-response.Updates[0].SequenceNumber:]
+			response = &trimmed
+		}
+		responses = append(responses, response)
+	}
+	return responses, true
+}
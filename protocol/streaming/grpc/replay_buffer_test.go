@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"testing"
+
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newResponse returns a response with updates for the given sequence numbers.
+func newResponse(sequenceNumbers ...uint64) *clobtypes.StreamOrderbookUpdatesResponse {
+	updates := make([]clobtypes.StreamUpdate, 0, len(sequenceNumbers))
+	for _, sequenceNumber := range sequenceNumbers {
+		updates = append(updates, clobtypes.StreamUpdate{SequenceNumber: sequenceNumber})
+	}
+	return &clobtypes.StreamOrderbookUpdatesResponse{Updates: updates}
+}
+
+func TestReplayBuffer_GetResponsesAfter(t *testing.T) {
+	tests := map[string]struct {
+		capacity           uint32
+		responses          []*clobtypes.StreamOrderbookUpdatesResponse
+		sequenceNumber     uint64
+		nextSequenceNumber uint64
+
+		expectedResponses []*clobtypes.StreamOrderbookUpdatesResponse
+		expectedOk        bool
+	}{
+		"No updates missed": {
+			capacity:           2,
+			responses:          []*clobtypes.StreamOrderbookUpdatesResponse{newResponse(1, 2)},
+			sequenceNumber:     2,
+			nextSequenceNumber: 3,
+			expectedResponses:  []*clobtypes.StreamOrderbookUpdatesResponse{},
+			expectedOk:         true,
+		},
+		"No updates missed on an empty stream": {
+			capacity:           2,
+			sequenceNumber:     0,
+			nextSequenceNumber: 1,
+			expectedResponses:  []*clobtypes.StreamOrderbookUpdatesResponse{},
+			expectedOk:         true,
+		},
+		"Sequence number from the future": {
+			capacity:           2,
+			responses:          []*clobtypes.StreamOrderbookUpdatesResponse{newResponse(1, 2)},
+			sequenceNumber:     3,
+			nextSequenceNumber: 3,
+			expectedOk:         false,
+		},
+		"Replays all missed responses": {
+			capacity: 3,
+			responses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(1),
+				newResponse(2, 3),
+				newResponse(4),
+			},
+			sequenceNumber:     1,
+			nextSequenceNumber: 5,
+			expectedResponses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(2, 3),
+				newResponse(4),
+			},
+			expectedOk: true,
+		},
+		"Trims updates already received": {
+			capacity: 3,
+			responses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(1, 2, 3),
+				newResponse(4),
+			},
+			sequenceNumber:     2,
+			nextSequenceNumber: 5,
+			expectedResponses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(3),
+				newResponse(4),
+			},
+			expectedOk: true,
+		},
+		"Oldest responses are evicted": {
+			capacity: 2,
+			responses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(1),
+				newResponse(2),
+				newResponse(3),
+				newResponse(4),
+			},
+			sequenceNumber:     2,
+			nextSequenceNumber: 5,
+			expectedResponses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(3),
+				newResponse(4),
+			},
+			expectedOk: true,
+		},
+		"Missed updates are no longer retained": {
+			capacity: 2,
+			responses: []*clobtypes.StreamOrderbookUpdatesResponse{
+				newResponse(1),
+				newResponse(2),
+				newResponse(3),
+				newResponse(4),
+			},
+			sequenceNumber:     1,
+			nextSequenceNumber: 5,
+			expectedOk:         false,
+		},
+		"Zero capacity": {
+			capacity:           0,
+			responses:          []*clobtypes.StreamOrderbookUpdatesResponse{newResponse(1)},
+			sequenceNumber:     0,
+			nextSequenceNumber: 2,
+			expectedOk:         false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			buffer := newReplayBuffer(tc.capacity)
+			for _, response := range tc.responses {
+				buffer.Add(response)
+			}
+
+			responses, ok := buffer.GetResponsesAfter(tc.sequenceNumber, tc.nextSequenceNumber)
+			require.Equal(t, tc.expectedOk, ok)
+			if tc.expectedOk {
+				require.Equal(t, tc.expectedResponses, responses)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+const (
+	// TransientStoreKey defines the transient store key of the gRPC streaming manager.
+	TransientStoreKey = "tmp_grpc_streaming"
+)
+
+// Transient store
+const (
+	// StagedFinalizeBlockUpdateCountKey is the key to retrieve the count of the stream updates staged
+	// within the block being finalized.
+	StagedFinalizeBlockUpdateCountKey = "StagedCount"
+	// StagedFinalizeBlockUpdateKeyPrefix is the prefix of the staged stream updates. Each update is
+	// stored at a big endian encoded uint32 starting from 0 upto and not including count.
+	StagedFinalizeBlockUpdateKeyPrefix = "Staged:"
+)
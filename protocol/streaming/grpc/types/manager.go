@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 type GrpcStreamingManager interface {
@@ -16,6 +17,7 @@ type GrpcStreamingManager interface {
 		err error,
 	)
 	GetUninitializedClobPairIds() []uint32
+	GetUninitializedSubaccountIds() []satypes.SubaccountId
 	SendOrderbookUpdates(
 		offchainUpdates *clobtypes.OffchainUpdates,
 		snapshot bool,
@@ -28,4 +30,23 @@ type GrpcStreamingManager interface {
 		blockHeight uint32,
 		execMode sdk.ExecMode,
 	)
+
+	SendSubaccountUpdates(
+		subaccountUpdates []satypes.StreamSubaccountUpdate,
+		blockHeight uint32,
+		execMode sdk.ExecMode,
+	)
+
+	// Updates of the block being finalized, which are staged until the block is finalized
+	// and then sent in the order they were staged.
+	StageFinalizeBlockFill(
+		ctx sdk.Context,
+		orderbookFill clobtypes.StreamOrderbookFill,
+	)
+	StageFinalizeBlockSubaccountUpdate(
+		ctx sdk.Context,
+		subaccountUpdate satypes.StreamSubaccountUpdate,
+	)
+	GetStagedFinalizeBlockUpdates(ctx sdk.Context) []clobtypes.StreamUpdate
+	SendFinalizedBlockUpdates(ctx sdk.Context)
 }
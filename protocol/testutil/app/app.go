@@ -48,6 +48,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/appoptions"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	testlog "github.com/dydxprotocol/v4-chain/protocol/testutil/logger"
+	accountplustypes "github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
@@ -202,7 +203,8 @@ type GenesisStates interface {
 		govtypesv1.GenesisState |
 		ratelimittypes.GenesisState |
 		govplus.GenesisState |
-		vaulttypes.GenesisState
+		vaulttypes.GenesisState |
+		accountplustypes.GenesisState
 }
 
 // UpdateGenesisDocWithAppStateForModule updates the supplied genesis doc using the provided function. The function
@@ -258,6 +260,8 @@ func UpdateGenesisDocWithAppStateForModule[T GenesisStates](genesisDoc *types.Ge
 		moduleName = govplus.ModuleName
 	case vaulttypes.GenesisState:
 		moduleName = vaulttypes.ModuleName
+	case accountplustypes.GenesisState:
+		moduleName = accountplustypes.ModuleName
 	default:
 		panic(fmt.Errorf("Unsupported type %T", t))
 	}
@@ -1330,7 +1334,10 @@ func launchValidatorInDir(
 
 // MustMakeCheckTxsWithClobMsg creates one signed RequestCheckTx for each msg passed in.
 // The messsage must use one of the hard-coded well known subaccount owners otherwise this will panic.
-func MustMakeCheckTxsWithClobMsg[T clobtypes.MsgPlaceOrder | clobtypes.MsgCancelOrder | clobtypes.MsgBatchCancel](
+func MustMakeCheckTxsWithClobMsg[
+	T clobtypes.MsgPlaceOrder | clobtypes.MsgCancelOrder | clobtypes.MsgBatchCancel | clobtypes.MsgBatchPlaceOrder |
+		clobtypes.MsgCancelAllOrders,
+](
 	ctx sdk.Context,
 	app *app.App,
 	messages ...T,
@@ -1349,6 +1356,12 @@ func MustMakeCheckTxsWithClobMsg[T clobtypes.MsgPlaceOrder | clobtypes.MsgCancel
 		case clobtypes.MsgBatchCancel:
 			signerAddress = v.SubaccountId.Owner
 			m = &v
+		case clobtypes.MsgBatchPlaceOrder:
+			signerAddress = v.SubaccountId.Owner
+			m = &v
+		case clobtypes.MsgCancelAllOrders:
+			signerAddress = v.SubaccountId.Owner
+			m = &v
 		default:
 			panic(fmt.Errorf("MustMakeCheckTxsWithClobMsg: Unknown message type %T", msg))
 		}
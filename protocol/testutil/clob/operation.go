@@ -244,3 +244,23 @@ func NewOrderRemovalOperationRaw(
 		},
 	}
 }
+
+// NewSelfTradeOrderRemovalOperationRaw returns a new raw order removal operation for an order removed due to
+// self-trade prevention, where `takerOrderId` is the id of the taker order of the self trade.
+func NewSelfTradeOrderRemovalOperationRaw(
+	orderId types.OrderId,
+	reason types.OrderRemoval_RemovalReason,
+	takerOrderId types.OrderId,
+	decrementQuantums uint64,
+) types.OperationRaw {
+	return types.OperationRaw{
+		Operation: &types.OperationRaw_OrderRemoval{
+			OrderRemoval: &types.OrderRemoval{
+				OrderId:               orderId,
+				RemovalReason:         reason,
+				SelfTradeTakerOrderId: &takerOrderId,
+				DecrementQuantums:     decrementQuantums,
+			},
+		},
+	}
+}
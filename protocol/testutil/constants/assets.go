@@ -44,6 +44,18 @@ var (
 		HasMarket:        true,
 		MarketId:         uint32(0),
 		AtomicResolution: int32(-8),
+		HaircutPpm:       uint32(100_000), // 10%
+	}
+
+	// EthNoMarket is an asset without an oracle market, so it cannot be used as collateral.
+	EthNoMarket = &asstypes.Asset{
+		Id:               2,
+		Symbol:           "ETH",
+		Denom:            "eth-denom",
+		DenomExponent:    int32(-9),
+		HasMarket:        false,
+		MarketId:         uint32(0),
+		AtomicResolution: int32(-9),
 	}
 
 	Usdc = &asstypes.Asset{
@@ -30,12 +30,17 @@ const GenesisState = `{
   },
   "app_hash": "",
   "app_state": {
+    "accountplus": {
+      "accounts": [],
+      "next_authenticator_id": "0"
+    },
     "assets": {
       "assets": [
         {
           "atomic_resolution": -6,
           "denom": "ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",
           "denom_exponent": "-6",
+          "haircut_ppm": 0,
           "has_market": false,
           "id": 0,
           "market_id": 0,
@@ -1500,7 +1505,9 @@ const GenesisState = `{
         "skew_factor_ppm": 2000000,
         "order_size_pct_ppm": 100000,
         "order_expiration_seconds": 2,
-        "activation_threshold_quote_quantums": "1000000000"
+        "activation_threshold_quote_quantums": "1000000000",
+        "withdrawal_fee_ppm": 0,
+        "withdrawal_lockup_blocks": 0
       }
     },
     "vest": {
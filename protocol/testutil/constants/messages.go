@@ -24,6 +24,9 @@ func init() {
 	_ = TestTxBuilder.SetMsgs(Msg_BatchCancel)
 	Msg_BatchCancel_TxBtyes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
 
+	_ = TestTxBuilder.SetMsgs(Msg_BatchPlaceOrder)
+	Msg_BatchPlaceOrder_TxBtyes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
+
 	_ = TestTxBuilder.SetMsgs(Msg_Send)
 	Msg_Send_TxBytes, _ = TestEncodingCfg.TxConfig.TxEncoder()(TestTxBuilder.GetTx())
 
@@ -69,6 +72,14 @@ var (
 	}
 	Msg_BatchCancel_TxBtyes []byte
 
+	Msg_BatchPlaceOrder = &clobtypes.MsgBatchPlaceOrder{
+		SubaccountId: Alice_Num0,
+		ShortTermOrders: []clobtypes.Order{
+			Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		},
+	}
+	Msg_BatchPlaceOrder_TxBtyes []byte
+
 	Msg_PlaceOrder_LongTerm = &clobtypes.MsgPlaceOrder{
 		Order: LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
 	}
@@ -102,6 +102,32 @@ var (
 		Subticks:     10,
 		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
 	}
+	LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpCancelTaker = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+		Side:                    clobtypes.Order_SIDE_BUY,
+		Quantums:                5,
+		Subticks:                10,
+		GoodTilOneof:            &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		SelfTradePreventionMode: clobtypes.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER,
+	}
+	LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_LongTerm,
+			ClobPairId:   0,
+		},
+		Side:                    clobtypes.Order_SIDE_BUY,
+		Quantums:                5,
+		Subticks:                10,
+		GoodTilOneof:            &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		SelfTradePreventionMode: clobtypes.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+	}
 	LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15 = clobtypes.Order{
 		OrderId: clobtypes.OrderId{
 			SubaccountId: Alice_Num0,
@@ -1140,6 +1166,36 @@ var (
 		ConditionType:                   clobtypes.Order_CONDITION_TYPE_STOP_LOSS,
 		ConditionalOrderTriggerSubticks: 49_999_000_000,
 	}
+	ConditionalOrder_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10_TS_49990_Offset5 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Bob_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks: 49_990_000_000,
+		TrailingOffsetSubticks:          5_000_000,
+	}
+	ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TS_50010_Offset5 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_BUY,
+		Quantums:                        100_000_000,
+		Subticks:                        50_000_000_000,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks: 50_010_000_000,
+		TrailingOffsetSubticks:          5_000_000,
+	}
 	ConditionalOrder_Carl_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10 = clobtypes.Order{
 		OrderId: clobtypes.OrderId{
 			SubaccountId: Carl_Num0,
@@ -1343,6 +1399,56 @@ var (
 		ConditionalOrderTriggerSubticks: 50_001_000_000,
 	}
 
+	// Trailing stop orders.
+	ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     4,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_SELL,
+		Quantums:                        5,
+		Subticks:                        10,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks: 20,
+		TrailingOffsetSubticks:          5,
+	}
+	ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     5,
+			OrderFlags:   clobtypes.OrderIdFlags_Conditional,
+			ClobPairId:   0,
+		},
+		Side:                            clobtypes.Order_SIDE_BUY,
+		Quantums:                        5,
+		Subticks:                        30,
+		GoodTilOneof:                    &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+		ConditionType:                   clobtypes.Order_CONDITION_TYPE_TRAILING_STOP,
+		ConditionalOrderTriggerSubticks: 30,
+		TrailingOffsetPpm:               100_000,
+	}
+
+	// TWAP orders.
+	TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60 = clobtypes.Order{
+		OrderId: clobtypes.OrderId{
+			SubaccountId: Alice_Num0,
+			ClientId:     0,
+			OrderFlags:   clobtypes.OrderIdFlags_Twap,
+			ClobPairId:   0,
+		},
+		Side:         clobtypes.Order_SIDE_BUY,
+		Quantums:     100_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: 600},
+		TwapParameters: &clobtypes.TwapParameters{
+			Duration: 300,
+			Interval: 60,
+		},
+	}
+
 	// Long-Term post-only orders.
 	LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15_PO = clobtypes.Order{
 		OrderId: clobtypes.OrderId{
@@ -79,6 +79,12 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&clobtypes.MsgPlaceOrder{},
 		&clobtypes.MsgCancelOrder{},
 		&clobtypes.MsgBatchCancel{},
+		&clobtypes.MsgBatchPlaceOrder{},
+		&clobtypes.MsgReplaceOrder{},
+		&clobtypes.MsgCancelAllOrders{},
+		&clobtypes.MsgHeartbeat{},
+		&clobtypes.MsgSetMarketMakerProtectionConfig{},
+		&clobtypes.MsgResetMarketMakerProtection{},
 
 		// Perpetuals.
 		&perpetualtypes.MsgAddPremiumVotes{},
@@ -93,6 +99,7 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 
 		// Vault.
 		&vaulttypes.MsgDepositToVault{},
+		&vaulttypes.MsgWithdrawFromVault{},
 	}
 
 	for _, msg := range msgInterfacesToRegister {
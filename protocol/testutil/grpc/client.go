@@ -4,6 +4,7 @@ import (
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/daemons/bridge/api"
 	liquidationtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/liquidation/api"
 	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -13,6 +14,7 @@ import (
 
 // QueryClient combines all the query clients used in testing into a single mock interface for testing convenience.
 type QueryClient interface {
+	assettypes.QueryClient
 	blocktimetypes.QueryClient
 	satypes.QueryClient
 	clobtypes.QueryClient
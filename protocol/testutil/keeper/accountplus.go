@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+func AccountPlusKeepers(
+	t testing.TB,
+) (
+	ctx sdk.Context,
+	accountPlusKeeper *keeper.Keeper,
+	storeKey storetypes.StoreKey,
+) {
+	ctx = initKeepers(t, func(
+		db *dbm.MemDB,
+		registry codectypes.InterfaceRegistry,
+		cdc *codec.ProtoCodec,
+		stateStore storetypes.CommitMultiStore,
+		transientStoreKey storetypes.StoreKey,
+	) []GenesisInitializer {
+		accountPlusKeeper, storeKey = createAccountPlusKeeper(stateStore, db, cdc)
+		return []GenesisInitializer{}
+	})
+	return ctx, accountPlusKeeper, storeKey
+}
+
+func createAccountPlusKeeper(
+	stateStore storetypes.CommitMultiStore,
+	db *dbm.MemDB,
+	cdc codec.BinaryCodec,
+) (*keeper.Keeper, *storetypes.KVStoreKey) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	k := keeper.NewKeeper(cdc, storeKey)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	return k, storeKey
+}
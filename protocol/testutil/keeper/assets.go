@@ -7,6 +7,7 @@ import (
 
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 
 	storetypes "cosmossdk.io/store/types"
@@ -18,6 +19,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	delaymsgmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
 )
 
@@ -32,6 +34,7 @@ func CreateUsdcAsset(ctx sdk.Context, assetsKeeper *keeper.Keeper) error {
 		constants.Usdc.HasMarket,
 		constants.Usdc.MarketId,
 		constants.Usdc.AtomicResolution,
+		constants.Usdc.HaircutPpm,
 	)
 	return err
 }
@@ -89,6 +92,10 @@ func createAssetsKeeper(
 		storeKey,
 		pk,
 		mockIndexerEventsManager,
+		[]string{
+			delaymsgmoduletypes.ModuleAddress.String(),
+			lib.GovModuleAddress.String(),
+		},
 	)
 
 	return k, storeKey
@@ -118,3 +125,28 @@ func GetAssetCreateEventsFromIndexerBlock(
 	}
 	return assetEvents
 }
+
+// GetUpdateAssetEventsFromIndexerBlock returns the asset update events in the
+// Indexer Block event Kafka message.
+func GetUpdateAssetEventsFromIndexerBlock(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+) []*indexerevents.UpdateAssetEventV1 {
+	var updateAssetEvents []*indexerevents.UpdateAssetEventV1
+	block := keeper.GetIndexerEventManager().ProduceBlock(ctx)
+	if block == nil {
+		return updateAssetEvents
+	}
+	for _, event := range block.Events {
+		if event.Subtype != indexerevents.SubtypeUpdateAsset {
+			continue
+		}
+		var updateAssetEvent indexerevents.UpdateAssetEventV1
+		err := proto.Unmarshal(event.DataBytes, &updateAssetEvent)
+		if err != nil {
+			panic(err)
+		}
+		updateAssetEvents = append(updateAssetEvents, &updateAssetEvent)
+	}
+	return updateAssetEvents
+}
@@ -12,6 +12,7 @@ import (
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -109,6 +110,7 @@ func createSubaccountsKeeper(
 		pk,
 		btk,
 		mockIndexerEventsManager,
+		streaming.NewNoopGrpcStreamingManager(),
 	)
 
 	return k, storeKey
@@ -30,6 +30,7 @@ type FakeMemClobKeeper struct {
 	statePositionFn                      types.GetStatePositionFn
 	useCollatCheckFnForSingleMatch       bool
 	indexerEventManager                  indexer_manager.IndexerEventManager
+	priceBandSubticks                    map[types.ClobPairId][2]uint64
 }
 
 func NewFakeMemClobKeeper() *FakeMemClobKeeper {
@@ -47,6 +48,7 @@ func NewFakeMemClobKeeper() *FakeMemClobKeeper {
 		dirtyTimeToStatefulOrdersExpiring:    make(map[time.Time][]types.OrderId),
 		nextTransactionIndex:                 0,
 		subaccountsToDeleverage:              make(map[satypes.SubaccountId]bool),
+		priceBandSubticks:                    make(map[types.ClobPairId][2]uint64),
 	}
 }
 
@@ -62,6 +64,17 @@ func (f *FakeMemClobKeeper) WithIndexerEventManager(
 	return f
 }
 
+// WithPriceBandSubticks sets the inclusive lower and upper bounds in subticks of the price band of a
+// clob pair.
+func (f *FakeMemClobKeeper) WithPriceBandSubticks(
+	clobPairId types.ClobPairId,
+	lowerSubticks uint64,
+	upperSubticks uint64,
+) *FakeMemClobKeeper {
+	f.priceBandSubticks[clobPairId] = [2]uint64{lowerSubticks, upperSubticks}
+	return f
+}
+
 // Commit simulates the `checkState` being reset and uncommitted.
 func (f *FakeMemClobKeeper) ResetState() {
 	f.dirtyPositionSizes = make(map[satypes.SubaccountId]map[types.ClobPairId]*big.Int)
@@ -105,6 +118,18 @@ func (f *FakeMemClobKeeper) CancelShortTermOrder(
 	panic("CancelShortTermOrder not currently implemented on FakeMemClobKeeper")
 }
 
+func (f *FakeMemClobKeeper) GetPriceBandSubticks(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (
+	lowerSubticks uint64,
+	upperSubticks uint64,
+	enabled bool,
+) {
+	bounds, enabled := f.priceBandSubticks[clobPairId]
+	return bounds[0], bounds[1], enabled
+}
+
 func (f *FakeMemClobKeeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	msg satypes.SubaccountId,
@@ -496,6 +521,16 @@ func (f *FakeMemClobKeeper) IsLiquidatable(
 	panic("This function should not be implemented as FakeMemClobKeeper is getting deprecated (CLOB-175)")
 }
 
+// IsMarketMakerProtectionTripped always returns false since market-maker protection is not tracked by
+// FakeMemClobKeeper.
+func (f *FakeMemClobKeeper) IsMarketMakerProtectionTripped(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+) bool {
+	return false
+}
+
 func (f *FakeMemClobKeeper) ValidateSubaccountEquityTierLimitForShortTermOrder(
 	ctx sdk.Context,
 	order types.Order) error {
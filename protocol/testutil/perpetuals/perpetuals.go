@@ -56,6 +56,12 @@ func WithMarketType(marketType perptypes.PerpetualMarketType) PerpetualModifierO
 	}
 }
 
+func WithFundingConfig(fundingConfig *perptypes.PerpetualFundingConfig) PerpetualModifierOption {
+	return func(cp *perptypes.Perpetual) {
+		cp.Params.FundingConfig = fundingConfig
+	}
+}
+
 // GeneratePerpetual returns a `Perpetual` object set to default values.
 // Passing in `PerpetualModifierOption` methods alters the value of the `Perpetual` returned.
 // It will start with the default, valid `Perpetual` value defined within the method
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group x/accountplus queries under a subcommand.
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryAuthenticator())
+	cmd.AddCommand(CmdQueryListAuthenticator())
+
+	return cmd
+}
+
+func CmdQueryAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-authenticator [owner] [id]",
+		Short: "get an authenticator of an account by its id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse authenticator id.
+			id, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authenticator(
+				context.Background(),
+				&types.QueryGetAuthenticatorRequest{
+					Owner: args[0],
+					Id:    id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryListAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-authenticator [owner]",
+		Short: "list all authenticators of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuthenticatorAll(
+				context.Background(),
+				&types.QueryAllAuthenticatorRequest{
+					Owner: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
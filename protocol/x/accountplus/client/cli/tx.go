@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+const (
+	FlagClobPairIds       = "clob-pair-ids"
+	FlagSubaccountNumbers = "subaccount-numbers"
+	FlagGoodTilBlockTime  = "good-til-block-time"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdAddAuthenticator())
+	cmd.AddCommand(CmdRemoveAuthenticator())
+
+	return cmd
+}
+
+func CmdAddAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-authenticator [owner_key_or_address] [public_key_hex] [msg_type_urls]",
+		Short: "Broadcast message AddAuthenticator",
+		Long: `Register a key that may sign the given comma separated msg types on behalf of the owner.
+Note, the '--from' flag is ignored as it is implied from [owner_key_or_address].
+The key can optionally be restricted to clob pair ids and subaccount numbers, and given an expiry.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse public key.
+			publicKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			clobPairIds, err := cmd.Flags().GetUintSlice(FlagClobPairIds)
+			if err != nil {
+				return err
+			}
+			subaccountNumbers, err := cmd.Flags().GetUintSlice(FlagSubaccountNumbers)
+			if err != nil {
+				return err
+			}
+			goodTilBlockTime, err := cmd.Flags().GetUint32(FlagGoodTilBlockTime)
+			if err != nil {
+				return err
+			}
+
+			err = cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddAuthenticator{
+				Owner:             clientCtx.GetFromAddress().String(),
+				PublicKey:         publicKey,
+				MsgTypeUrls:       strings.Split(args[2], ","),
+				ClobPairIds:       toUint32Slice(clobPairIds),
+				SubaccountNumbers: toUint32Slice(subaccountNumbers),
+				GoodTilBlockTime:  goodTilBlockTime,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().UintSlice(FlagClobPairIds, nil, "Clob pair ids the key may trade (default all)")
+	cmd.Flags().UintSlice(FlagSubaccountNumbers, nil, "Subaccount numbers the key may trade (default all)")
+	cmd.Flags().Uint32(FlagGoodTilBlockTime, 0, "Unix timestamp after which the key expires (default never)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveAuthenticator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-authenticator [owner_key_or_address] [id]",
+		Short: "Broadcast message RemoveAuthenticator",
+		Long: `Revoke a key registered by the owner.
+Note, the '--from' flag is ignored as it is implied from [owner_key_or_address].
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse authenticator id.
+			id, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			err = cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveAuthenticator{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func toUint32Slice(values []uint) []uint32 {
+	result := make([]uint32, 0, len(values))
+	for _, value := range values {
+		result = append(result, uint32(value))
+	}
+	return result
+}
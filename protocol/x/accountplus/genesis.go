@@ -0,0 +1,33 @@
+package accountplus
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, account := range genState.Accounts {
+		if err := k.SetAccountAuthenticators(ctx, account); err != nil {
+			panic(err)
+		}
+	}
+	k.SetNextAuthenticatorId(ctx, genState.NextAuthenticatorId)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	accounts := k.GetAllAccountAuthenticators(ctx)
+	if accounts == nil {
+		accounts = []types.AccountAuthenticators{}
+	}
+	return &types.GenesisState{
+		Accounts:            accounts,
+		NextAuthenticatorId: k.GetNextAuthenticatorId(ctx),
+	}
+}
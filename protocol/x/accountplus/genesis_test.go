@@ -0,0 +1,60 @@
+package accountplus_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Accounts: []types.AccountAuthenticators{
+			{
+				Owner: constants.AliceAccAddress.String(),
+				Authenticators: []types.Authenticator{
+					{
+						Id:                3,
+						PublicKey:         secp256k1.GenPrivKey().PubKey().Bytes(),
+						MsgTypeUrls:       []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+						ClobPairIds:       []uint32{0},
+						SubaccountNumbers: []uint32{0, 1},
+						GoodTilBlockTime:  100,
+					},
+				},
+			},
+		},
+		NextAuthenticatorId: 4,
+	}
+
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.AccountPlusKeeper
+
+	accountplus.InitGenesis(ctx, k, genesisState)
+	got := accountplus.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
+
+func TestInvalidGenesis_Panics(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.AccountPlusKeeper
+
+	genesisState := types.GenesisState{
+		Accounts: []types.AccountAuthenticators{
+			{Owner: "invalid"},
+		},
+	}
+
+	require.Panics(t, func() {
+		accountplus.InitGenesis(ctx, k, genesisState)
+	})
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+// GetNextAuthenticatorId returns the id assigned to the next registered authenticator.
+func (k Keeper) GetNextAuthenticatorId(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get([]byte(types.NextAuthenticatorIdKey))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// SetNextAuthenticatorId sets the id assigned to the next registered authenticator.
+func (k Keeper) SetNextAuthenticatorId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.NextAuthenticatorIdKey), binary.BigEndian.AppendUint64(nil, id))
+}
+
+// GetAccountAuthenticators returns the authenticators registered by an account, ordered by id.
+func (k Keeper) GetAccountAuthenticators(ctx sdk.Context, owner string) []types.Authenticator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AccountAuthenticatorsKeyPrefix))
+	b := store.Get([]byte(owner))
+	if b == nil {
+		return []types.Authenticator{}
+	}
+
+	var val types.AccountAuthenticators
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Authenticators
+}
+
+// GetAllAccountAuthenticators returns the authenticators of all accounts that registered at least one
+// authenticator.
+func (k Keeper) GetAllAccountAuthenticators(ctx sdk.Context) (list []types.AccountAuthenticators) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AccountAuthenticatorsKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountAuthenticators
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// SetAccountAuthenticators sets the authenticators of an account. The account's entry is deleted if it
+// has no authenticators.
+func (k Keeper) SetAccountAuthenticators(ctx sdk.Context, account types.AccountAuthenticators) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AccountAuthenticatorsKeyPrefix))
+	if len(account.Authenticators) == 0 {
+		store.Delete([]byte(account.Owner))
+		return nil
+	}
+	store.Set([]byte(account.Owner), k.cdc.MustMarshal(&account))
+	return nil
+}
+
+// GetAuthenticator returns the authenticator with the given id registered by an account.
+func (k Keeper) GetAuthenticator(
+	ctx sdk.Context,
+	owner string,
+	id uint64,
+) (
+	val types.Authenticator,
+	found bool,
+) {
+	for _, authenticator := range k.GetAccountAuthenticators(ctx, owner) {
+		if authenticator.Id == id {
+			return authenticator, true
+		}
+	}
+	return types.Authenticator{}, false
+}
+
+// AddAuthenticator registers an authenticator for an account and returns the id assigned to it.
+// The id of the given authenticator is ignored.
+func (k Keeper) AddAuthenticator(
+	ctx sdk.Context,
+	owner string,
+	authenticator types.Authenticator,
+) (
+	id uint64,
+	err error,
+) {
+	authenticators := k.GetAccountAuthenticators(ctx, owner)
+	if len(authenticators) >= types.MaxAuthenticatorsPerAccount {
+		return 0, errorsmod.Wrapf(
+			types.ErrTooManyAuthenticators,
+			"owner %s already has %d authenticators",
+			owner,
+			len(authenticators),
+		)
+	}
+
+	id = k.GetNextAuthenticatorId(ctx)
+	authenticator.Id = id
+	if err := k.SetAccountAuthenticators(ctx, types.AccountAuthenticators{
+		Owner:          owner,
+		Authenticators: append(authenticators, authenticator),
+	}); err != nil {
+		return 0, err
+	}
+	k.SetNextAuthenticatorId(ctx, id+1)
+	return id, nil
+}
+
+// RemoveAuthenticator revokes the authenticator with the given id registered by an account.
+func (k Keeper) RemoveAuthenticator(ctx sdk.Context, owner string, id uint64) error {
+	authenticators := k.GetAccountAuthenticators(ctx, owner)
+	for i, authenticator := range authenticators {
+		if authenticator.Id == id {
+			return k.SetAccountAuthenticators(ctx, types.AccountAuthenticators{
+				Owner:          owner,
+				Authenticators: append(authenticators[:i], authenticators[i+1:]...),
+			})
+		}
+	}
+	return errorsmod.Wrapf(types.ErrAuthenticatorNotFound, "owner: %s, id: %d", owner, id)
+}
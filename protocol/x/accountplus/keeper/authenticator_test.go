@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuthenticator() types.Authenticator {
+	return types.Authenticator{
+		PublicKey:   secp256k1.GenPrivKey().PubKey().Bytes(),
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+	}
+}
+
+func TestAddAndRemoveAuthenticator(t *testing.T) {
+	ctx, k, _ := keepertest.AccountPlusKeepers(t)
+	alice := constants.AliceAccAddress.String()
+	bob := constants.BobAccAddress.String()
+
+	// Ids are assigned sequentially across accounts.
+	aliceAuthenticator := newAuthenticator()
+	id, err := k.AddAuthenticator(ctx, alice, aliceAuthenticator)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), id)
+	id, err = k.AddAuthenticator(ctx, bob, newAuthenticator())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	id, err = k.AddAuthenticator(ctx, alice, newAuthenticator())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+	require.Equal(t, uint64(3), k.GetNextAuthenticatorId(ctx))
+
+	got, found := k.GetAuthenticator(ctx, alice, 0)
+	require.True(t, found)
+	aliceAuthenticator.Id = 0
+	require.Equal(t, aliceAuthenticator, got)
+
+	// Authenticators are scoped to the account that registered them.
+	_, found = k.GetAuthenticator(ctx, bob, 0)
+	require.False(t, found)
+	require.Len(t, k.GetAccountAuthenticators(ctx, alice), 2)
+	require.Len(t, k.GetAllAccountAuthenticators(ctx), 2)
+
+	require.NoError(t, k.RemoveAuthenticator(ctx, alice, 0))
+	_, found = k.GetAuthenticator(ctx, alice, 0)
+	require.False(t, found)
+	require.ErrorIs(t, k.RemoveAuthenticator(ctx, alice, 0), types.ErrAuthenticatorNotFound)
+	require.ErrorIs(t, k.RemoveAuthenticator(ctx, bob, 2), types.ErrAuthenticatorNotFound)
+
+	// Accounts without authenticators are removed from state.
+	require.NoError(t, k.RemoveAuthenticator(ctx, bob, 1))
+	require.Empty(t, k.GetAccountAuthenticators(ctx, bob))
+	require.Len(t, k.GetAllAccountAuthenticators(ctx), 1)
+}
+
+func TestAddAuthenticator_TooManyAuthenticators(t *testing.T) {
+	ctx, k, _ := keepertest.AccountPlusKeepers(t)
+	alice := constants.AliceAccAddress.String()
+
+	for i := 0; i < types.MaxAuthenticatorsPerAccount; i++ {
+		_, err := k.AddAuthenticator(ctx, alice, newAuthenticator())
+		require.NoError(t, err)
+	}
+	_, err := k.AddAuthenticator(ctx, alice, newAuthenticator())
+	require.ErrorIs(t, err, types.ErrTooManyAuthenticators)
+}
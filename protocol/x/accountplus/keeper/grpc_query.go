@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Authenticator(
+	goCtx context.Context,
+	req *types.QueryGetAuthenticatorRequest,
+) (*types.QueryAuthenticatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	authenticator, found := k.GetAuthenticator(ctx, req.Owner, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAuthenticatorResponse{Authenticator: authenticator}, nil
+}
+
+func (k Keeper) AuthenticatorAll(
+	goCtx context.Context,
+	req *types.QueryAllAuthenticatorRequest,
+) (*types.QueryAuthenticatorAllResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	return &types.QueryAuthenticatorAllResponse{
+		Authenticators: k.GetAccountAuthenticators(ctx, req.Owner),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticatorQueries(t *testing.T) {
+	ctx, k, _ := keepertest.AccountPlusKeepers(t)
+	alice := constants.AliceAccAddress.String()
+	authenticator := newAuthenticator()
+	id, err := k.AddAuthenticator(ctx, alice, authenticator)
+	require.NoError(t, err)
+	authenticator.Id = id
+
+	resp, err := k.Authenticator(ctx, &types.QueryGetAuthenticatorRequest{Owner: alice, Id: id})
+	require.NoError(t, err)
+	require.Equal(t, authenticator, resp.Authenticator)
+
+	_, err = k.Authenticator(ctx, &types.QueryGetAuthenticatorRequest{Owner: alice, Id: id + 1})
+	require.Equal(t, status.Error(codes.NotFound, "not found"), err)
+
+	allResp, err := k.AuthenticatorAll(ctx, &types.QueryAllAuthenticatorRequest{Owner: alice})
+	require.NoError(t, err)
+	require.Equal(t, []types.Authenticator{authenticator}, allResp.Authenticators)
+
+	allResp, err = k.AuthenticatorAll(ctx, &types.QueryAllAuthenticatorRequest{Owner: constants.BobAccAddress.String()})
+	require.NoError(t, err)
+	require.Empty(t, allResp.Authenticators)
+
+	_, err = k.Authenticator(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) AddAuthenticator(
+	goCtx context.Context,
+	msg *types.MsgAddAuthenticator,
+) (*types.MsgAddAuthenticatorResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	authenticator := msg.ToAuthenticator(0)
+	if authenticator.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrAuthenticatorExpired,
+			"good til block time %d is before block time %d",
+			msg.GoodTilBlockTime,
+			ctx.BlockTime().Unix(),
+		)
+	}
+
+	id, err := k.Keeper.AddAuthenticator(ctx, msg.Owner, authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (k msgServer) RemoveAuthenticator(
+	goCtx context.Context,
+	msg *types.MsgRemoveAuthenticator,
+) (*types.MsgRemoveAuthenticatorResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	if err := k.Keeper.RemoveAuthenticator(ctx, msg.Owner, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
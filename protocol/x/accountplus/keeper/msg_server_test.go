@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAddAuthenticator(t *testing.T) {
+	tests := map[string]struct {
+		goodTilBlockTime uint32
+		expectedErr      error
+	}{
+		"no expiry": {
+			goodTilBlockTime: 0,
+		},
+		"expires in the future": {
+			goodTilBlockTime: 101,
+		},
+		"already expired": {
+			goodTilBlockTime: 99,
+			expectedErr:      types.ErrAuthenticatorExpired,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, _ := keepertest.AccountPlusKeepers(t)
+			ctx = ctx.WithBlockTime(time.Unix(100, 0))
+			ms := keeper.NewMsgServerImpl(*k)
+
+			authenticator := newAuthenticator()
+			msg := &types.MsgAddAuthenticator{
+				Owner:            constants.AliceAccAddress.String(),
+				PublicKey:        authenticator.PublicKey,
+				MsgTypeUrls:      authenticator.MsgTypeUrls,
+				GoodTilBlockTime: tc.goodTilBlockTime,
+			}
+			resp, err := ms.AddAuthenticator(ctx, msg)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, k.GetAccountAuthenticators(ctx, msg.Owner))
+				return
+			}
+			require.NoError(t, err)
+			got, found := k.GetAuthenticator(ctx, msg.Owner, resp.Id)
+			require.True(t, found)
+			require.Equal(t, msg.ToAuthenticator(resp.Id), got)
+		})
+	}
+}
+
+func TestMsgRemoveAuthenticator(t *testing.T) {
+	ctx, k, _ := keepertest.AccountPlusKeepers(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	alice := constants.AliceAccAddress.String()
+	id, err := k.AddAuthenticator(ctx, alice, newAuthenticator())
+	require.NoError(t, err)
+
+	// Only the account that registered the authenticator can remove it.
+	_, err = ms.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{
+		Owner: constants.BobAccAddress.String(),
+		Id:    id,
+	})
+	require.ErrorIs(t, err, types.ErrAuthenticatorNotFound)
+
+	_, err = ms.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{
+		Owner: alice,
+		Id:    id,
+	})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAccountAuthenticators(ctx, alice))
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// AllowedMsgTypeUrls are the type urls of the messages an authenticator may be permitted to sign.
+var AllowedMsgTypeUrls = map[string]struct{}{
+	sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{}):                     {},
+	sdk.MsgTypeURL(&clobtypes.MsgCancelOrder{}):                    {},
+	sdk.MsgTypeURL(&clobtypes.MsgReplaceOrder{}):                   {},
+	sdk.MsgTypeURL(&clobtypes.MsgBatchCancel{}):                    {},
+	sdk.MsgTypeURL(&clobtypes.MsgBatchPlaceOrder{}):                {},
+	sdk.MsgTypeURL(&clobtypes.MsgCancelAllOrders{}):                {},
+	sdk.MsgTypeURL(&clobtypes.MsgHeartbeat{}):                      {},
+	sdk.MsgTypeURL(&clobtypes.MsgSetMarketMakerProtectionConfig{}): {},
+	sdk.MsgTypeURL(&clobtypes.MsgResetMarketMakerProtection{}):     {},
+}
+
+// Validate performs stateless validation of the authenticator's public key and permissions.
+func (a Authenticator) Validate() error {
+	if len(a.PublicKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(
+			ErrInvalidPublicKey,
+			"public key must be a compressed secp256k1 key of %d bytes, got %d bytes",
+			secp256k1.PubKeySize,
+			len(a.PublicKey),
+		)
+	}
+
+	return ValidatePermissions(a.MsgTypeUrls, a.ClobPairIds, a.SubaccountNumbers)
+}
+
+// ValidatePermissions validates the message types, clob pair ids and subaccount numbers an authenticator
+// is permitted to use.
+func ValidatePermissions(msgTypeUrls []string, clobPairIds []uint32, subaccountNumbers []uint32) error {
+	if len(msgTypeUrls) == 0 {
+		return errorsmod.Wrap(ErrInvalidPermissions, "at least one msg type url must be permitted")
+	}
+	for _, msgTypeUrl := range msgTypeUrls {
+		if _, ok := AllowedMsgTypeUrls[msgTypeUrl]; !ok {
+			return errorsmod.Wrapf(ErrInvalidPermissions, "msg type url %s cannot be permitted", msgTypeUrl)
+		}
+	}
+	if lib.ContainsDuplicates(msgTypeUrls) {
+		return errorsmod.Wrap(ErrInvalidPermissions, "msg type urls contain duplicates")
+	}
+	if lib.ContainsDuplicates(clobPairIds) {
+		return errorsmod.Wrap(ErrInvalidPermissions, "clob pair ids contain duplicates")
+	}
+	if lib.ContainsDuplicates(subaccountNumbers) {
+		return errorsmod.Wrap(ErrInvalidPermissions, "subaccount numbers contain duplicates")
+	}
+	return nil
+}
+
+// GetPubKey returns the authenticator's public key.
+func (a Authenticator) GetPubKey() cryptotypes.PubKey {
+	return &secp256k1.PubKey{Key: a.PublicKey}
+}
+
+// IsExpired returns true if the authenticator can no longer be used at the given block time.
+func (a Authenticator) IsExpired(blockTime time.Time) bool {
+	return a.GoodTilBlockTime != 0 && blockTime.Unix() > int64(a.GoodTilBlockTime)
+}
+
+// AuthorizeMsgs returns an error if the authenticator is expired at the given block time or if any of
+// the messages is not permitted by the authenticator.
+func (a Authenticator) AuthorizeMsgs(msgs []sdk.Msg, blockTime time.Time) error {
+	if a.IsExpired(blockTime) {
+		return errorsmod.Wrapf(
+			ErrAuthenticatorExpired,
+			"authenticator %d expired at %d, block time is %d",
+			a.Id,
+			a.GoodTilBlockTime,
+			blockTime.Unix(),
+		)
+	}
+
+	for _, msg := range msgs {
+		if err := a.authorizeMsg(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a Authenticator) authorizeMsg(msg sdk.Msg) error {
+	msgTypeUrl := sdk.MsgTypeURL(msg)
+	if !slices.Contains(a.MsgTypeUrls, msgTypeUrl) {
+		return errorsmod.Wrapf(ErrMsgNotAuthorized, "msg type %s is not permitted", msgTypeUrl)
+	}
+
+	var subaccountNumber uint32
+	var clobPairIds []uint32
+	switch typedMsg := msg.(type) {
+	case *clobtypes.MsgPlaceOrder:
+		subaccountNumber = typedMsg.Order.OrderId.SubaccountId.Number
+		clobPairIds = []uint32{typedMsg.Order.OrderId.ClobPairId}
+	case *clobtypes.MsgCancelOrder:
+		subaccountNumber = typedMsg.OrderId.SubaccountId.Number
+		clobPairIds = []uint32{typedMsg.OrderId.ClobPairId}
+	case *clobtypes.MsgReplaceOrder:
+		subaccountNumber = typedMsg.Order.OrderId.SubaccountId.Number
+		clobPairIds = []uint32{typedMsg.Order.OrderId.ClobPairId}
+	case *clobtypes.MsgBatchCancel:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		for _, batch := range typedMsg.ShortTermCancels {
+			clobPairIds = append(clobPairIds, batch.ClobPairId)
+		}
+	case *clobtypes.MsgBatchPlaceOrder:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		for _, order := range typedMsg.ShortTermOrders {
+			clobPairIds = append(clobPairIds, order.OrderId.ClobPairId)
+		}
+	case *clobtypes.MsgCancelAllOrders:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		// Canceling the orders of all clob pairs requires the authenticator to be permitted to use all clob pairs.
+		if len(typedMsg.ClobPairIds) == 0 && len(a.ClobPairIds) > 0 {
+			return errorsmod.Wrap(ErrMsgNotAuthorized, "canceling the orders of all clob pairs is not permitted")
+		}
+		clobPairIds = typedMsg.ClobPairIds
+	case *clobtypes.MsgHeartbeat:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		// An expired heartbeat cancels the orders of all clob pairs, which requires the authenticator to be
+		// permitted to use all clob pairs.
+		if len(a.ClobPairIds) > 0 {
+			return errorsmod.Wrap(ErrMsgNotAuthorized, "heartbeats require permission to use all clob pairs")
+		}
+	case *clobtypes.MsgSetMarketMakerProtectionConfig:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		clobPairIds = []uint32{typedMsg.ClobPairId}
+	case *clobtypes.MsgResetMarketMakerProtection:
+		subaccountNumber = typedMsg.SubaccountId.Number
+		clobPairIds = []uint32{typedMsg.ClobPairId}
+	default:
+		return errorsmod.Wrapf(ErrMsgNotAuthorized, "msg type %s is not supported", msgTypeUrl)
+	}
+
+	if len(a.SubaccountNumbers) > 0 && !slices.Contains(a.SubaccountNumbers, subaccountNumber) {
+		return errorsmod.Wrapf(ErrMsgNotAuthorized, "subaccount number %d is not permitted", subaccountNumber)
+	}
+	if len(a.ClobPairIds) > 0 {
+		for _, clobPairId := range clobPairIds {
+			if !slices.Contains(a.ClobPairIds, clobPairId) {
+				return errorsmod.Wrapf(ErrMsgNotAuthorized, "clob pair id %d is not permitted", clobPairId)
+			}
+		}
+	}
+	return nil
+}
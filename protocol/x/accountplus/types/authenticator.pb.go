@@ -0,0 +1,1078 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/accountplus/authenticator.proto
+
+package types
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Authenticator is an additional key registered by an account. The key may
+// sign transactions on behalf of the account, restricted to order placement
+// and cancellation messages that satisfy the authenticator's permissions.
+type Authenticator struct {
+	// Id of the authenticator. Ids are unique across all accounts.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Compressed secp256k1 public key of the authenticator.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Type urls of the messages the authenticator may sign. Must be a subset of
+	// the clob order placement and cancellation messages.
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// Ids of the clob pairs the authenticator may trade. If empty, all clob
+	// pairs may be traded.
+	ClobPairIds []uint32 `protobuf:"varint,4,rep,packed,name=clob_pair_ids,json=clobPairIds,proto3" json:"clob_pair_ids,omitempty"`
+	// Numbers of the account's subaccounts the authenticator may trade. If
+	// empty, all subaccounts of the account may be traded.
+	SubaccountNumbers []uint32 `protobuf:"varint,5,rep,packed,name=subaccount_numbers,json=subaccountNumbers,proto3" json:"subaccount_numbers,omitempty"`
+	// The authenticator can no longer be used once the block time is greater
+	// than this unix timestamp (in seconds). If zero, the authenticator does
+	// not expire.
+	GoodTilBlockTime uint32 `protobuf:"fixed32,6,opt,name=good_til_block_time,json=goodTilBlockTime,proto3" json:"good_til_block_time,omitempty"`
+}
+
+func (m *Authenticator) Reset()         { *m = Authenticator{} }
+func (m *Authenticator) String() string { return proto.CompactTextString(m) }
+func (*Authenticator) ProtoMessage()    {}
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed4cc332474e6bf, []int{0}
+}
+func (m *Authenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Authenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authenticator.Merge(m, src)
+}
+func (m *Authenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authenticator proto.InternalMessageInfo
+
+func (m *Authenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Authenticator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Authenticator) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Authenticator) GetClobPairIds() []uint32 {
+	if m != nil {
+		return m.ClobPairIds
+	}
+	return nil
+}
+
+func (m *Authenticator) GetSubaccountNumbers() []uint32 {
+	if m != nil {
+		return m.SubaccountNumbers
+	}
+	return nil
+}
+
+func (m *Authenticator) GetGoodTilBlockTime() uint32 {
+	if m != nil {
+		return m.GoodTilBlockTime
+	}
+	return 0
+}
+
+// AccountAuthenticators contains all authenticators registered by an account.
+type AccountAuthenticators struct {
+	// Address of the account.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Authenticators registered by the account, ordered by id.
+	Authenticators []Authenticator `protobuf:"bytes,2,rep,name=authenticators,proto3" json:"authenticators"`
+}
+
+func (m *AccountAuthenticators) Reset()         { *m = AccountAuthenticators{} }
+func (m *AccountAuthenticators) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticators) ProtoMessage()    {}
+func (*AccountAuthenticators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed4cc332474e6bf, []int{1}
+}
+func (m *AccountAuthenticators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticators.Merge(m, src)
+}
+func (m *AccountAuthenticators) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticators) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticators proto.InternalMessageInfo
+
+func (m *AccountAuthenticators) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AccountAuthenticators) GetAuthenticators() []Authenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+// TxExtension is a non-critical tx extension option that selects the
+// authenticator used to sign a transaction instead of the signer's account
+// key.
+type TxExtension struct {
+	// Id of the signer's authenticator that signed the transaction.
+	AuthenticatorId uint64 `protobuf:"varint,1,opt,name=authenticator_id,json=authenticatorId,proto3" json:"authenticator_id,omitempty"`
+}
+
+func (m *TxExtension) Reset()         { *m = TxExtension{} }
+func (m *TxExtension) String() string { return proto.CompactTextString(m) }
+func (*TxExtension) ProtoMessage()    {}
+func (*TxExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fed4cc332474e6bf, []int{2}
+}
+func (m *TxExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxExtension.Merge(m, src)
+}
+func (m *TxExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxExtension proto.InternalMessageInfo
+
+func (m *TxExtension) GetAuthenticatorId() uint64 {
+	if m != nil {
+		return m.AuthenticatorId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Authenticator)(nil), "dydxprotocol.accountplus.Authenticator")
+	proto.RegisterType((*AccountAuthenticators)(nil), "dydxprotocol.accountplus.AccountAuthenticators")
+	proto.RegisterType((*TxExtension)(nil), "dydxprotocol.accountplus.TxExtension")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/accountplus/authenticator.proto", fileDescriptor_fed4cc332474e6bf)
+}
+
+var fileDescriptor_fed4cc332474e6bf = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8b, 0xd4, 0x30,
+	0x18, 0xc6, 0x27, 0x33, 0xb3, 0x2b, 0x93, 0x71, 0xd6, 0x35, 0xae, 0x10, 0x04, 0x6b, 0xe9, 0xc5,
+	0x0a, 0x4e, 0x0b, 0xea, 0xc1, 0x8b, 0x87, 0x1d, 0xf0, 0xb0, 0x08, 0x22, 0xa5, 0x8b, 0xe0, 0x25,
+	0xb4, 0x49, 0xe8, 0x84, 0x4d, 0x9b, 0x92, 0x3f, 0xda, 0xde, 0xfd, 0x00, 0x7e, 0xac, 0x3d, 0xee,
+	0xd1, 0x93, 0xc8, 0x8c, 0x1f, 0x44, 0xda, 0xca, 0x3a, 0x15, 0xbc, 0x25, 0xcf, 0xf3, 0xe3, 0x21,
+	0xef, 0x93, 0x17, 0x3e, 0x67, 0x2d, 0x6b, 0x6a, 0xad, 0xac, 0xa2, 0x4a, 0xc6, 0x19, 0xa5, 0xca,
+	0x55, 0xb6, 0x96, 0xce, 0xc4, 0x99, 0xb3, 0x5b, 0x5e, 0x59, 0x41, 0x33, 0xab, 0x74, 0xd4, 0x23,
+	0x08, 0x1f, 0xd2, 0xd1, 0x01, 0xfd, 0xe8, 0xac, 0x50, 0x85, 0xea, 0x9d, 0xb8, 0x3b, 0x0d, 0x7c,
+	0xf0, 0x0b, 0xc0, 0xd5, 0xf9, 0x61, 0x0e, 0x3a, 0x81, 0x53, 0xc1, 0x30, 0xf0, 0x41, 0x38, 0x4f,
+	0xa6, 0x82, 0xa1, 0xc7, 0x10, 0xd6, 0x2e, 0x97, 0x82, 0x92, 0x2b, 0xde, 0xe2, 0xa9, 0x0f, 0xc2,
+	0xbb, 0xc9, 0x62, 0x50, 0xde, 0xf1, 0x16, 0x05, 0x70, 0x55, 0x9a, 0x82, 0xd8, 0xb6, 0xe6, 0xc4,
+	0x69, 0x69, 0xf0, 0xcc, 0x9f, 0x85, 0x8b, 0x64, 0x59, 0x9a, 0x22, 0x6d, 0x6b, 0x7e, 0xa9, 0xa5,
+	0xe9, 0x18, 0x2a, 0x55, 0x4e, 0xea, 0x4c, 0x68, 0x22, 0x98, 0xc1, 0x73, 0x7f, 0x16, 0xae, 0x92,
+	0x65, 0x27, 0x7e, 0xc8, 0x84, 0xbe, 0x60, 0x06, 0xad, 0x21, 0x32, 0x2e, 0xff, 0xf3, 0x60, 0x52,
+	0xb9, 0x32, 0xe7, 0xda, 0xe0, 0xa3, 0x1e, 0xbc, 0xff, 0xd7, 0x79, 0x3f, 0x18, 0x68, 0x0d, 0x1f,
+	0x14, 0x4a, 0x31, 0x62, 0x85, 0x24, 0xb9, 0x54, 0xf4, 0x8a, 0x58, 0x51, 0x72, 0x7c, 0xec, 0x83,
+	0xf0, 0x4e, 0x72, 0xda, 0x59, 0xa9, 0x90, 0x9b, 0xce, 0x48, 0x45, 0xc9, 0x83, 0xaf, 0x00, 0x3e,
+	0x3c, 0x1f, 0x12, 0x46, 0xd3, 0x1a, 0x74, 0x06, 0x8f, 0xd4, 0x97, 0x8a, 0xeb, 0x7e, 0xe2, 0x45,
+	0x32, 0x5c, 0xd0, 0x25, 0x3c, 0x19, 0xb5, 0x6b, 0xf0, 0xd4, 0x9f, 0x85, 0xcb, 0x17, 0x4f, 0xa3,
+	0xff, 0xf5, 0x1b, 0x8d, 0x72, 0x37, 0xf3, 0xeb, 0x1f, 0x4f, 0x26, 0xc9, 0x3f, 0x21, 0xc1, 0x6b,
+	0xb8, 0x4c, 0x9b, 0xb7, 0x8d, 0xe5, 0x95, 0x11, 0xaa, 0x42, 0xcf, 0xe0, 0xe9, 0x08, 0x20, 0xb7,
+	0xc5, 0xdf, 0x1b, 0xe9, 0x17, 0x6c, 0xf3, 0xf1, 0x7a, 0xe7, 0x81, 0x9b, 0x9d, 0x07, 0x7e, 0xee,
+	0x3c, 0xf0, 0x6d, 0xef, 0x4d, 0x6e, 0xf6, 0xde, 0xe4, 0xfb, 0xde, 0x9b, 0x7c, 0x7a, 0x53, 0x08,
+	0xbb, 0x75, 0x79, 0x44, 0x55, 0x19, 0x8f, 0x56, 0xe5, 0xf3, 0xab, 0x35, 0xdd, 0x66, 0xa2, 0x8a,
+	0x6f, 0x95, 0x66, 0xb4, 0x3e, 0xdd, 0x97, 0x99, 0xfc, 0xb8, 0x77, 0x5f, 0xfe, 0x1e, 0x00, 0x7d,
+	0x7b, 0x60, 0x76, 0x67, 0x02, 0x00, 0x00,
+}
+
+func (m *Authenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilBlockTime != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
+		i--
+		dAtA[i] = 0x35
+	}
+	if len(m.SubaccountNumbers) > 0 {
+		dAtA2 := make([]byte, len(m.SubaccountNumbers)*10)
+		var j1 int
+		for _, num := range m.SubaccountNumbers {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthenticator(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClobPairIds) > 0 {
+		dAtA4 := make([]byte, len(m.ClobPairIds)*10)
+		var j3 int
+		for _, num := range m.ClobPairIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthenticator(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuthenticator(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthenticators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthenticator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAuthenticator(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthenticatorId != 0 {
+		i = encodeVarintAuthenticator(dAtA, i, uint64(m.AuthenticatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthenticator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthenticator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Authenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuthenticator(uint64(m.Id))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	if len(m.ClobPairIds) > 0 {
+		l = 0
+		for _, e := range m.ClobPairIds {
+			l += sovAuthenticator(uint64(e))
+		}
+		n += 1 + sovAuthenticator(uint64(l)) + l
+	}
+	if len(m.SubaccountNumbers) > 0 {
+		l = 0
+		for _, e := range m.SubaccountNumbers {
+			l += sovAuthenticator(uint64(e))
+		}
+		n += 1 + sovAuthenticator(uint64(l)) + l
+	}
+	if m.GoodTilBlockTime != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *AccountAuthenticators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAuthenticator(uint64(l))
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovAuthenticator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthenticatorId != 0 {
+		n += 1 + sovAuthenticator(uint64(m.AuthenticatorId))
+	}
+	return n
+}
+
+func sovAuthenticator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthenticator(x uint64) (n int) {
+	return sovAuthenticator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Authenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthenticator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClobPairIds = append(m.ClobPairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthenticator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthenticator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthenticator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClobPairIds) == 0 {
+					m.ClobPairIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthenticator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClobPairIds = append(m.ClobPairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairIds", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthenticator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SubaccountNumbers = append(m.SubaccountNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthenticator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthenticator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthenticator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SubaccountNumbers) == 0 {
+					m.SubaccountNumbers = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthenticator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SubaccountNumbers = append(m.SubaccountNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountNumbers", wireType)
+			}
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			m.GoodTilBlockTime = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoodTilBlockTime = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAuthenticators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, Authenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorId", wireType)
+			}
+			m.AuthenticatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthenticator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthenticator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthenticator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthenticator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthenticator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthenticator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthenticator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthenticator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthenticator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthenticator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthenticator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,200 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_Validate(t *testing.T) {
+	tests := map[string]struct {
+		authenticator types.Authenticator
+		expectedErr   error
+	}{
+		"valid": {
+			authenticator: validAuthenticator(0),
+		},
+		"invalid public key": {
+			authenticator: types.Authenticator{
+				PublicKey:   []byte{1},
+				MsgTypeUrls: []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+			},
+			expectedErr: types.ErrInvalidPublicKey,
+		},
+		"no msg type urls": {
+			authenticator: types.Authenticator{
+				PublicKey: validAuthenticator(0).PublicKey,
+			},
+			expectedErr: types.ErrInvalidPermissions,
+		},
+		"msg type url not allowed": {
+			authenticator: types.Authenticator{
+				PublicKey:   validAuthenticator(0).PublicKey,
+				MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+			expectedErr: types.ErrInvalidPermissions,
+		},
+		"duplicate clob pair ids": {
+			authenticator: types.Authenticator{
+				PublicKey:   validAuthenticator(0).PublicKey,
+				MsgTypeUrls: []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+				ClobPairIds: []uint32{1, 1},
+			},
+			expectedErr: types.ErrInvalidPermissions,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.authenticator.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_AuthorizeMsgs(t *testing.T) {
+	authenticator := types.Authenticator{
+		PublicKey: validAuthenticator(0).PublicKey,
+		MsgTypeUrls: []string{
+			sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{}),
+			sdk.MsgTypeURL(&clobtypes.MsgBatchCancel{}),
+			sdk.MsgTypeURL(&clobtypes.MsgCancelAllOrders{}),
+			sdk.MsgTypeURL(&clobtypes.MsgHeartbeat{}),
+			sdk.MsgTypeURL(&clobtypes.MsgResetMarketMakerProtection{}),
+		},
+		ClobPairIds:       []uint32{0, 1},
+		SubaccountNumbers: []uint32{0},
+		GoodTilBlockTime:  100,
+	}
+	orderId := func(subaccountNumber uint32, clobPairId uint32) clobtypes.OrderId {
+		return clobtypes.OrderId{
+			SubaccountId: satypes.SubaccountId{
+				Owner:  constants.AliceAccAddress.String(),
+				Number: subaccountNumber,
+			},
+			ClobPairId: clobPairId,
+		}
+	}
+
+	tests := map[string]struct {
+		msgs        []sdk.Msg
+		blockTime   time.Time
+		expectedErr error
+	}{
+		"place order": {
+			msgs:      []sdk.Msg{&clobtypes.MsgPlaceOrder{Order: clobtypes.Order{OrderId: orderId(0, 1)}}},
+			blockTime: time.Unix(100, 0),
+		},
+		"batch cancel": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgBatchCancel{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					ShortTermCancels: []clobtypes.OrderBatch{
+						{ClobPairId: 0, ClientIds: []uint32{1}},
+						{ClobPairId: 1, ClientIds: []uint32{2}},
+					},
+				},
+			},
+			blockTime: time.Unix(100, 0),
+		},
+		"expired": {
+			msgs:        []sdk.Msg{&clobtypes.MsgPlaceOrder{Order: clobtypes.Order{OrderId: orderId(0, 1)}}},
+			blockTime:   time.Unix(101, 0),
+			expectedErr: types.ErrAuthenticatorExpired,
+		},
+		"msg type not permitted": {
+			msgs:        []sdk.Msg{&clobtypes.MsgCancelOrder{OrderId: orderId(0, 1)}},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"clob pair not permitted": {
+			msgs:        []sdk.Msg{&clobtypes.MsgPlaceOrder{Order: clobtypes.Order{OrderId: orderId(0, 2)}}},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"batch cancel with clob pair not permitted": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgBatchCancel{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					ShortTermCancels: []clobtypes.OrderBatch{
+						{ClobPairId: 0, ClientIds: []uint32{1}},
+						{ClobPairId: 2, ClientIds: []uint32{2}},
+					},
+				},
+			},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"cancel all orders": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgCancelAllOrders{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					ClobPairIds:  []uint32{0, 1},
+				},
+			},
+			blockTime: time.Unix(100, 0),
+		},
+		"cancel all orders of all clob pairs not permitted": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgCancelAllOrders{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+				},
+			},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"heartbeat not permitted with clob pair restrictions": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgHeartbeat{
+					SubaccountId:   satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					TimeoutSeconds: 10,
+				},
+			},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"reset market-maker protection on permitted clob pair": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgResetMarketMakerProtection{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					ClobPairId:   1,
+				},
+			},
+			blockTime: time.Unix(100, 0),
+		},
+		"reset market-maker protection on clob pair not permitted": {
+			msgs: []sdk.Msg{
+				&clobtypes.MsgResetMarketMakerProtection{
+					SubaccountId: satypes.SubaccountId{Owner: constants.AliceAccAddress.String()},
+					ClobPairId:   2,
+				},
+			},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+		"subaccount not permitted": {
+			msgs:        []sdk.Msg{&clobtypes.MsgPlaceOrder{Order: clobtypes.Order{OrderId: orderId(1, 1)}}},
+			blockTime:   time.Unix(100, 0),
+			expectedErr: types.ErrMsgNotAuthorized,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := authenticator.AuthorizeMsgs(tc.msgs, tc.blockTime)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/app/module"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	// Register the tx extension so that it is unpacked when decoding a tx's extension options.
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil), &TxExtension{})
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(module.InterfaceRegistry)
+)
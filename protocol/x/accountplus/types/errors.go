@@ -0,0 +1,20 @@
+package types
+
+// DONTCOVER
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/accountplus module sentinel errors
+var (
+	ErrInvalidOwner                   = errorsmod.Register(ModuleName, 1000, "invalid owner address")
+	ErrInvalidPublicKey               = errorsmod.Register(ModuleName, 1001, "invalid authenticator public key")
+	ErrInvalidPermissions             = errorsmod.Register(ModuleName, 1002, "invalid authenticator permissions")
+	ErrDuplicateAuthenticatorId       = errorsmod.Register(ModuleName, 1003, "duplicate authenticator id")
+	ErrAuthenticatorNotFound          = errorsmod.Register(ModuleName, 1004, "authenticator not found")
+	ErrTooManyAuthenticators          = errorsmod.Register(ModuleName, 1005, "account has too many authenticators")
+	ErrAuthenticatorExpired           = errorsmod.Register(ModuleName, 1006, "authenticator has expired")
+	ErrMsgNotAuthorized               = errorsmod.Register(ModuleName, 1007, "msg is not authorized by authenticator")
+	ErrMultipleSignersNotSupported    = errorsmod.Register(ModuleName, 1008, "authenticators only support txs with a single signer")
+	ErrInvalidNextAuthenticatorId     = errorsmod.Register(ModuleName, 1009, "invalid next authenticator id")
+	ErrDuplicateAccountAuthenticators = errorsmod.Register(ModuleName, 1010, "duplicate account in genesis")
+)
@@ -0,0 +1,70 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Accounts:            []AccountAuthenticators{},
+		NextAuthenticatorId: 0,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	owners := make(map[string]struct{}, len(gs.Accounts))
+	ids := make(map[uint64]struct{})
+	for _, account := range gs.Accounts {
+		if _, exists := owners[account.Owner]; exists {
+			return errorsmod.Wrapf(ErrDuplicateAccountAuthenticators, "owner: %s", account.Owner)
+		}
+		owners[account.Owner] = struct{}{}
+
+		if err := account.Validate(); err != nil {
+			return err
+		}
+
+		for _, authenticator := range account.Authenticators {
+			if _, exists := ids[authenticator.Id]; exists {
+				return errorsmod.Wrapf(ErrDuplicateAuthenticatorId, "id: %d", authenticator.Id)
+			}
+			ids[authenticator.Id] = struct{}{}
+
+			if authenticator.Id >= gs.NextAuthenticatorId {
+				return errorsmod.Wrapf(
+					ErrInvalidNextAuthenticatorId,
+					"next authenticator id %d must be greater than authenticator id %d",
+					gs.NextAuthenticatorId,
+					authenticator.Id,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate validates the owner and authenticators of an AccountAuthenticators.
+func (a AccountAuthenticators) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOwner, "owner %s: %v", a.Owner, err)
+	}
+	if len(a.Authenticators) > MaxAuthenticatorsPerAccount {
+		return errorsmod.Wrapf(
+			ErrTooManyAuthenticators,
+			"owner %s has %d authenticators, max is %d",
+			a.Owner,
+			len(a.Authenticators),
+			MaxAuthenticatorsPerAccount,
+		)
+	}
+	for _, authenticator := range a.Authenticators {
+		if err := authenticator.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
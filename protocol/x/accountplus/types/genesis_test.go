@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/accountplus/types"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func validAuthenticator(id uint64) types.Authenticator {
+	return types.Authenticator{
+		Id:          id,
+		PublicKey:   secp256k1.GenPrivKey().PubKey().Bytes(),
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := map[string]struct {
+		genState    *types.GenesisState
+		expectedErr error
+	}{
+		"default is valid": {
+			genState: types.DefaultGenesis(),
+		},
+		"valid": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner:          constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(0), validAuthenticator(2)},
+					},
+					{
+						Owner:          constants.BobAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(1)},
+					},
+				},
+				NextAuthenticatorId: 3,
+			},
+		},
+		"invalid owner": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner:          "invalid",
+						Authenticators: []types.Authenticator{validAuthenticator(0)},
+					},
+				},
+				NextAuthenticatorId: 1,
+			},
+			expectedErr: types.ErrInvalidOwner,
+		},
+		"duplicate owner": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner:          constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(0)},
+					},
+					{
+						Owner:          constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(1)},
+					},
+				},
+				NextAuthenticatorId: 2,
+			},
+			expectedErr: types.ErrDuplicateAccountAuthenticators,
+		},
+		"duplicate authenticator id": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner:          constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(0)},
+					},
+					{
+						Owner:          constants.BobAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(0)},
+					},
+				},
+				NextAuthenticatorId: 1,
+			},
+			expectedErr: types.ErrDuplicateAuthenticatorId,
+		},
+		"next authenticator id too low": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner:          constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{validAuthenticator(1)},
+					},
+				},
+				NextAuthenticatorId: 1,
+			},
+			expectedErr: types.ErrInvalidNextAuthenticatorId,
+		},
+		"invalid authenticator": {
+			genState: &types.GenesisState{
+				Accounts: []types.AccountAuthenticators{
+					{
+						Owner: constants.AliceAccAddress.String(),
+						Authenticators: []types.Authenticator{
+							{
+								Id:          0,
+								PublicKey:   []byte{1, 2, 3},
+								MsgTypeUrls: []string{sdk.MsgTypeURL(&clobtypes.MsgPlaceOrder{})},
+							},
+						},
+					},
+				},
+				NextAuthenticatorId: 1,
+			},
+			expectedErr: types.ErrInvalidPublicKey,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+// Module name and store keys
+const (
+	// ModuleName defines the module name
+	ModuleName = "accountplus"
+
+	// StoreKey defines the primary module store key. Note that the store key must not share a prefix
+	// with the x/auth store key "acc".
+	StoreKey = "dydx" + ModuleName
+)
+
+// State
+const (
+	// AccountAuthenticatorsKeyPrefix is the prefix used when storing the AccountAuthenticators of an
+	// account in the state.
+	AccountAuthenticatorsKeyPrefix = "Account:"
+
+	// NextAuthenticatorIdKey is the key used to store the id assigned to the next registered authenticator.
+	NextAuthenticatorIdKey = "NextAuthenticatorId"
+)
+
+// MaxAuthenticatorsPerAccount is the maximum number of authenticators an account can register.
+const MaxAuthenticatorsPerAccount = 10
@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddAuthenticator{}
+	_ sdk.Msg = &MsgRemoveAuthenticator{}
+)
+
+// ValidateBasic performs stateless validation on a MsgAddAuthenticator.
+func (msg *MsgAddAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOwner, "owner %s: %v", msg.Owner, err)
+	}
+
+	return msg.ToAuthenticator(0).Validate()
+}
+
+// ToAuthenticator returns the authenticator registered by the msg with the given id.
+func (msg *MsgAddAuthenticator) ToAuthenticator(id uint64) Authenticator {
+	return Authenticator{
+		Id:                id,
+		PublicKey:         msg.PublicKey,
+		MsgTypeUrls:       msg.MsgTypeUrls,
+		ClobPairIds:       msg.ClobPairIds,
+		SubaccountNumbers: msg.SubaccountNumbers,
+		GoodTilBlockTime:  msg.GoodTilBlockTime,
+	}
+}
+
+// ValidateBasic performs stateless validation on a MsgRemoveAuthenticator.
+func (msg *MsgRemoveAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOwner, "owner %s: %v", msg.Owner, err)
+	}
+	return nil
+}
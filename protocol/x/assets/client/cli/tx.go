@@ -11,6 +11,11 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 )
 
+const (
+	// FlagAuthority is the flag for the address that controls the module. Defaults to the gov module address.
+	FlagAuthority = "authority"
+)
+
 var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 )
@@ -25,7 +30,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// this line is used by starport scaffolding # 1
+	cmd.AddCommand(CmdCreateAsset())
+	cmd.AddCommand(CmdUpdateAsset())
 
 	return cmd
 }
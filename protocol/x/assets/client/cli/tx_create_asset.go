@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdCreateAsset creates a new asset. Since only the module authority can create assets,
+// the message is typically generated with '--generate-only' and submitted in a governance proposal.
+func CmdCreateAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-asset [id] [symbol] [denom] [denom_exponent] [has_market] [market_id] " +
+			"[atomic_resolution] [haircut_ppm]",
+		Short: "Broadcast message CreateAsset",
+		Long: `Create a new asset.
+Only the module authority, given by the '--authority' flag, can create assets. Use '--generate-only'
+to generate the message for a governance proposal.
+[market_id] must be 0 if [has_market] is false.
+Use '--' before the arguments if [denom_exponent] or [atomic_resolution] is negative.
+`,
+		Args: cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argDenomExponent, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argHasMarket, err := cast.ToBoolE(args[4])
+			if err != nil {
+				return err
+			}
+
+			argMarketId, err := cast.ToUint32E(args[5])
+			if err != nil {
+				return err
+			}
+
+			argAtomicResolution, err := cast.ToInt32E(args[6])
+			if err != nil {
+				return err
+			}
+
+			argHaircutPpm, err := cast.ToUint32E(args[7])
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(FlagAuthority)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateAsset{
+				Authority: authority,
+				Asset: types.Asset{
+					Id:               argId,
+					Symbol:           args[1],
+					Denom:            args[2],
+					DenomExponent:    argDenomExponent,
+					HasMarket:        argHasMarket,
+					MarketId:         argMarketId,
+					AtomicResolution: argAtomicResolution,
+					HaircutPpm:       argHaircutPpm,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAuthority, lib.GovModuleAddress.String(), "Address that controls the module")
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+//go:build all || integration_test
+
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/network"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/client/cli"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/spf13/cobra"
+)
+
+func TestCmdCreateAndUpdateAsset(t *testing.T) {
+	net := network.New(t, network.DefaultConfig(nil))
+	val := net.Validators[0]
+	ctx := val.ClientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	tests := map[string]struct {
+		cmd  *cobra.Command
+		args []string
+
+		expectedMsg sdk.Msg
+		expectedErr error
+	}{
+		"Create asset": {
+			cmd:  cli.CmdCreateAsset(),
+			args: []string{"1", "BTC", "btc-denom", "-8", "true", "0", "-8", "100000"},
+			expectedMsg: &types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset: types.Asset{
+					Id:               1,
+					Symbol:           "BTC",
+					Denom:            "btc-denom",
+					DenomExponent:    -8,
+					HasMarket:        true,
+					MarketId:         0,
+					AtomicResolution: -8,
+					HaircutPpm:       100_000,
+				},
+			},
+		},
+		"Create asset fails with a zero haircut for a collateral asset": {
+			cmd:         cli.CmdCreateAsset(),
+			args:        []string{"1", "BTC", "btc-denom", "-8", "true", "0", "-8", "0"},
+			expectedErr: types.ErrInvalidHaircutPpm,
+		},
+		"Update asset": {
+			cmd:  cli.CmdUpdateAsset(),
+			args: []string{"1", "true", "2", "200000"},
+			expectedMsg: &types.MsgUpdateAsset{
+				Authority:  lib.GovModuleAddress.String(),
+				Id:         1,
+				HasMarket:  true,
+				MarketId:   2,
+				HaircutPpm: 200_000,
+			},
+		},
+		"Update asset fails with a market id and no market": {
+			cmd:         cli.CmdUpdateAsset(),
+			args:        []string{"1", "false", "2", "0"},
+			expectedErr: types.ErrInvalidMarketId,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arguments follow '--' so that negative exponents are not parsed as flags.
+			args := append(append(commonFlags, "--"), tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, tc.cmd, args)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			generatedTx, err := ctx.TxConfig.TxJSONDecoder()(out.Bytes())
+			require.NoError(t, err)
+			require.Len(t, generatedTx.GetMsgs(), 1)
+			require.Equal(t, tc.expectedMsg, generatedTx.GetMsgs()[0])
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdUpdateAsset updates the market and haircut of an existing asset. Since only the module authority
+// can update assets, the message is typically generated with '--generate-only' and submitted in a
+// governance proposal.
+func CmdUpdateAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-asset [id] [has_market] [market_id] [haircut_ppm]",
+		Short: "Broadcast message UpdateAsset",
+		Long: `Update the market and haircut of an existing asset.
+Only the module authority, given by the '--authority' flag, can update assets. Use '--generate-only'
+to generate the message for a governance proposal.
+[market_id] must be 0 if [has_market] is false.
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argHasMarket, err := cast.ToBoolE(args[1])
+			if err != nil {
+				return err
+			}
+
+			argMarketId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argHaircutPpm, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(FlagAuthority)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateAsset{
+				Authority:  authority,
+				Id:         argId,
+				HasMarket:  argHasMarket,
+				MarketId:   argMarketId,
+				HaircutPpm: argHaircutPpm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAuthority, lib.GovModuleAddress.String(), "Address that controls the module")
+
+	return cmd
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 func (k Keeper) CreateAsset(
@@ -24,6 +25,7 @@ func (k Keeper) CreateAsset(
 	hasMarket bool,
 	marketId uint32,
 	atomicResolution int32,
+	haircutPpm uint32,
 ) (types.Asset, error) {
 	if prevAsset, exists := k.GetAsset(ctx, assetId); exists {
 		return types.Asset{}, errorsmod.Wrapf(
@@ -57,6 +59,10 @@ func (k Keeper) CreateAsset(
 		return types.Asset{}, types.ErrUsdcMustBeAssetZero
 	}
 
+	if err := validateHaircutPpm(assetId, hasMarket, haircutPpm); err != nil {
+		return types.Asset{}, err
+	}
+
 	// Ensure the denom is unique versus existing assets.
 	allAssets := k.GetAllAssets(ctx)
 	for _, asset := range allAssets {
@@ -74,19 +80,12 @@ func (k Keeper) CreateAsset(
 		HasMarket:        hasMarket,
 		MarketId:         marketId,
 		AtomicResolution: atomicResolution,
+		HaircutPpm:       haircutPpm,
 	}
 
 	// Validate market
-	if hasMarket {
-		if _, err := k.pricesKeeper.GetMarketPrice(ctx, marketId); err != nil {
-			return asset, err
-		}
-	} else if marketId > 0 {
-		return asset, errorsmod.Wrapf(
-			types.ErrInvalidMarketId,
-			"Market ID: %v",
-			marketId,
-		)
+	if err := k.validateMarket(ctx, hasMarket, marketId); err != nil {
+		return asset, err
 	}
 
 	// Store the new asset
@@ -115,28 +114,99 @@ func (k Keeper) ModifyAsset(
 	id uint32,
 	hasMarket bool,
 	marketId uint32,
+	haircutPpm uint32,
 ) (types.Asset, error) {
+	// USDC is the quote asset of the protocol and cannot be modified. This is a protocol-wide invariant.
+	if id == types.AssetUsdc.Id {
+		return types.Asset{}, types.ErrUsdcCannotBeModified
+	}
+
 	// Get asset
 	asset, exists := k.GetAsset(ctx, id)
 	if !exists {
 		return asset, errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
 	}
 
+	if err := validateHaircutPpm(id, hasMarket, haircutPpm); err != nil {
+		return asset, err
+	}
+
 	// Validate market
-	if _, err := k.pricesKeeper.GetMarketPrice(ctx, marketId); err != nil {
+	if err := k.validateMarket(ctx, hasMarket, marketId); err != nil {
 		return asset, err
 	}
 
 	// Modify asset
 	asset.HasMarket = hasMarket
 	asset.MarketId = marketId
+	asset.HaircutPpm = haircutPpm
 
 	// Store the modified asset
 	k.setAsset(ctx, asset)
 
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeUpdateAsset,
+		indexerevents.UpdateAssetEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewUpdateAssetEvent(
+				asset.Id,
+				asset.HasMarket,
+				asset.MarketId,
+				asset.HaircutPpm,
+			),
+		),
+	)
+
 	return asset, nil
 }
 
+// validateMarket returns an error if the market of an asset with a market does not exist, or if a
+// market id is set on an asset without a market.
+func (k Keeper) validateMarket(ctx sdk.Context, hasMarket bool, marketId uint32) error {
+	if !hasMarket {
+		if marketId > 0 {
+			return errorsmod.Wrapf(
+				types.ErrInvalidMarketId,
+				"Market ID: %v",
+				marketId,
+			)
+		}
+		return nil
+	}
+
+	_, err := k.pricesKeeper.GetMarketPrice(ctx, marketId)
+	return err
+}
+
+// validateHaircutPpm returns an error if the haircut is greater than 100%, if a non-zero
+// haircut is set on USDC, or if a non-USDC asset with a market (and therefore enabled as
+// collateral) has no haircut.
+func validateHaircutPpm(assetId uint32, hasMarket bool, haircutPpm uint32) error {
+	if haircutPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHaircutPpm,
+			"haircut ppm %d is greater than %d",
+			haircutPpm,
+			lib.OneMillion,
+		)
+	}
+	if assetId == types.AssetUsdc.Id {
+		if haircutPpm != 0 {
+			return errorsmod.Wrap(types.ErrInvalidHaircutPpm, "USDC must have a zero haircut")
+		}
+		return nil
+	}
+	if hasMarket && haircutPpm == 0 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHaircutPpm,
+			"haircut ppm must be non-zero for collateral asset %d",
+			assetId,
+		)
+	}
+	return nil
+}
+
 func (k Keeper) setAsset(
 	ctx sdk.Context,
 	asset types.Asset,
@@ -197,7 +267,7 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Get asset
-	_, exists := k.GetAsset(ctx, id)
+	asset, exists := k.GetAsset(ctx, id)
 	if !exists {
 		return big.NewInt(0), errorsmod.Wrap(types.ErrAssetDoesNotExist, lib.UintToString(id))
 	}
@@ -208,9 +278,18 @@ func (k Keeper) GetNetCollateral(
 	}
 
 	// Balance is positive.
-	// TODO(DEC-581): add multi-collateral support.
 	if bigQuantums.Sign() == 1 {
-		return big.NewInt(0), types.ErrNotImplementedMulticollateral
+		// Assets without an oracle price cannot be valued and do not count towards collateral.
+		if !asset.HasMarket {
+			return big.NewInt(0), nil
+		}
+
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, asset.MarketId)
+		if err != nil {
+			return big.NewInt(0), err
+		}
+
+		return GetNetCollateralInQuoteQuantums(asset, marketPrice, bigQuantums), nil
 	}
 
 	// Balance is negative.
@@ -218,6 +297,31 @@ func (k Keeper) GetNetCollateral(
 	return big.NewInt(0), types.ErrNotImplementedMargin
 }
 
+// GetNetCollateralInQuoteQuantums returns the net collateral in quote quantums that a
+// positive balance of an asset contributes to an account, which can be represented by
+// the following equation:
+//
+// `quantums / 10^atomicResolution * marketPrice * 10^marketExponent * 10^quoteAtomicResolution
+// * (1 - haircutPpm / 1_000_000)`.
+//
+// The result is rounded down. Note that this is a stateless function.
+func GetNetCollateralInQuoteQuantums(
+	asset types.Asset,
+	marketPrice pricestypes.MarketPrice,
+	bigQuantums *big.Int,
+) (
+	bigNetCollateralQuoteQuantums *big.Int,
+) {
+	bigQuoteQuantums := lib.BaseToQuoteQuantums(
+		bigQuantums,
+		asset.AtomicResolution,
+		marketPrice.Price,
+		marketPrice.Exponent,
+	)
+
+	return lib.BigIntMulPpm(bigQuoteQuantums, lib.OneMillion-asset.HaircutPpm)
+}
+
 // GetMarginRequirements returns the initial and maintenance margin-
 // requirements for a given position size for a given assetId.
 func (k Keeper) GetMarginRequirements(
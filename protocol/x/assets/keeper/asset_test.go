@@ -39,8 +39,10 @@ func createNAssets(
 	for i := range items {
 		hasMarket := i%2 == 0
 		var marketId uint32
+		var haircutPpm uint32
 		if hasMarket {
 			marketId = uint32(i)
+			haircutPpm = 100_000
 		}
 		asset, err := keeper.CreateAsset(
 			ctx,
@@ -51,6 +53,7 @@ func createNAssets(
 			hasMarket,                   // HasMarket
 			marketId,                    // MarketId
 			int32(i),                    // AtomicResolution
+			haircutPpm,                  // HaircutPpm
 		)
 		if err != nil {
 			return items, err
@@ -75,6 +78,7 @@ func TestCreateAsset_MarketNotFound(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		uint32(100_000),
 	)
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 
@@ -95,6 +99,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		uint32(0),
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -111,6 +116,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		uint32(0),
 	)
 	require.ErrorIs(t, err, types.ErrUsdcMustBeAssetZero)
 
@@ -127,6 +133,7 @@ func TestCreateAsset_InvalidUsdcAsset(t *testing.T) {
 		true,
 		uint32(999),
 		int32(-1),
+		uint32(0),
 	)
 	require.ErrorIs(t, err, types.ErrUnexpectedUsdcDenomExponent)
 
@@ -147,6 +154,7 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 		false,
 		uint32(1),
 		int32(-1),
+		uint32(0),
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrInvalidMarketId, "Market ID: 1").Error())
 
@@ -154,6 +162,58 @@ func TestCreateAsset_MarketIdInvalid(t *testing.T) {
 	require.Len(t, keeper.GetAllAssets(ctx), 0)
 }
 
+func TestCreateAsset_InvalidHaircutPpm(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+
+	// Haircut cannot be greater than 100%.
+	_, err := keeper.CreateAsset(
+		ctx,
+		constants.BtcUsd.Id,
+		constants.BtcUsd.Symbol,
+		constants.BtcUsd.Denom,
+		constants.BtcUsd.DenomExponent,
+		constants.BtcUsd.HasMarket,
+		constants.BtcUsd.MarketId,
+		constants.BtcUsd.AtomicResolution,
+		1_000_001,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidHaircutPpm)
+
+	// Assets with a market are enabled as collateral and must have a haircut.
+	_, err = keeper.CreateAsset(
+		ctx,
+		constants.BtcUsd.Id,
+		constants.BtcUsd.Symbol,
+		constants.BtcUsd.Denom,
+		constants.BtcUsd.DenomExponent,
+		constants.BtcUsd.HasMarket,
+		constants.BtcUsd.MarketId,
+		constants.BtcUsd.AtomicResolution,
+		0,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidHaircutPpm)
+
+	// USDC cannot have a haircut.
+	_, err = keeper.CreateAsset(
+		ctx,
+		constants.Usdc.Id,
+		constants.Usdc.Symbol,
+		constants.Usdc.Denom,
+		constants.Usdc.DenomExponent,
+		constants.Usdc.HasMarket,
+		constants.Usdc.MarketId,
+		constants.Usdc.AtomicResolution,
+		1,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidHaircutPpm)
+
+	_, exists := keeper.GetAsset(ctx, constants.BtcUsd.Id)
+	require.False(t, exists)
+	_, exists = keeper.GetAsset(ctx, constants.Usdc.Id)
+	require.False(t, exists)
+}
+
 func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
 
@@ -168,6 +228,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // haircutPpm
 	)
 	require.NoError(t, err)
 
@@ -181,6 +242,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,       // hasMarket
 		0,           // marketId
 		10,          // atomicResolution
+		0,           // haircutPpm
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrAssetDenomAlreadyExists, "btc-denom").Error())
 
@@ -194,6 +256,7 @@ func TestCreateAsset_AssetAlreadyExists(t *testing.T) {
 		false,            // hasMarket
 		0,                // marketId
 		10,               // atomicResolution
+		0,                // haircutPpm
 	)
 	require.ErrorIs(t, err, types.ErrAssetIdAlreadyExists)
 }
@@ -208,12 +271,17 @@ func TestModifyAsset_Success(t *testing.T) {
 		// Modify each field arbitrarily and
 		// verify the fields were modified in state
 		hasMarket := (i%2 == 0)
-		marketId := uint32(i*2) % numMarkets
+		var marketId uint32
+		if hasMarket {
+			marketId = uint32(i*2) % numMarkets
+		}
+		haircutPpm := uint32((i + 1) * 10_000)
 		retItem, err := keeper.ModifyAsset(
 			ctx,
 			item.Id,
 			hasMarket,
 			marketId,
+			haircutPpm,
 		)
 		require.NoError(t, err)
 		newItem, exists := keeper.GetAsset(ctx, item.Id)
@@ -234,11 +302,33 @@ func TestModifyAsset_Success(t *testing.T) {
 			marketId,
 			newItem.MarketId,
 		)
+		require.Equal(t,
+			haircutPpm,
+			newItem.HaircutPpm,
+		)
 		require.Equal(t,
 			int32(i),
 			newItem.AtomicResolution,
 		)
 	}
+
+	// An asset update event is emitted for each modified asset.
+	updateAssetEvents := keepertest.GetUpdateAssetEventsFromIndexerBlock(ctx, keeper)
+	require.Len(t, updateAssetEvents, len(items))
+	for _, item := range items {
+		asset, exists := keeper.GetAsset(ctx, item.Id)
+		require.True(t, exists)
+		require.Contains(
+			t,
+			updateAssetEvents,
+			indexerevents.NewUpdateAssetEvent(
+				asset.Id,
+				asset.HasMarket,
+				asset.MarketId,
+				asset.HaircutPpm,
+			),
+		)
+	}
 }
 
 func TestModifyAsset_NotFound(t *testing.T) {
@@ -250,6 +340,7 @@ func TestModifyAsset_NotFound(t *testing.T) {
 		firstValidAssetId,
 		true,
 		uint32(1),
+		uint32(0), // haircutPpm
 	)
 	require.EqualError(t, err, errorsmod.Wrap(types.ErrAssetDoesNotExist, "1").Error())
 	require.ErrorIs(t, err, types.ErrAssetDoesNotExist)
@@ -264,8 +355,86 @@ func TestModifyAsset_NotFound(t *testing.T) {
 		firstValidAssetId,
 		true,
 		uint32(0),
+		uint32(50_000), // haircutPpm
+	)
+	require.NoError(t, err)
+}
+
+func TestModifyAsset_InvalidHaircutPpm(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+	require.NoError(t, err)
+
+	_, err = keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		true,
+		uint32(0),
+		1_000_001,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidHaircutPpm)
+
+	// Assets with a market are enabled as collateral and must have a haircut.
+	_, err = keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		true,
+		uint32(0),
+		0,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidHaircutPpm)
+
+	asset, exists := keeper.GetAsset(ctx, firstValidAssetId)
+	require.True(t, exists)
+	require.Equal(t, uint32(100_000), asset.HaircutPpm)
+}
+
+func TestModifyAsset_Usdc(t *testing.T) {
+	ctx, keeper, _, _, _, _ := keepertest.AssetsKeepers(t, true)
+	require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+
+	// USDC cannot be modified.
+	_, err := keeper.ModifyAsset(
+		ctx,
+		types.AssetUsdc.Id,
+		false,
+		uint32(0),
+		uint32(0), // haircutPpm
+	)
+	require.ErrorIs(t, err, types.ErrUsdcCannotBeModified)
+
+	asset, exists := keeper.GetAsset(ctx, types.AssetUsdc.Id)
+	require.True(t, exists)
+	require.Equal(t, *constants.Usdc, asset)
+	require.Empty(t, keepertest.GetUpdateAssetEventsFromIndexerBlock(ctx, keeper))
+}
+
+func TestModifyAsset_NoMarket(t *testing.T) {
+	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 1)
+	require.NoError(t, err)
+
+	// A market id cannot be set on an asset without a market.
+	_, err = keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		false,
+		uint32(1),
+		uint32(0), // haircutPpm
+	)
+	require.EqualError(t, err, errorsmod.Wrap(types.ErrInvalidMarketId, "Market ID: 1").Error())
+
+	// Removing the market of an asset does not require a market price.
+	asset, err := keeper.ModifyAsset(
+		ctx,
+		firstValidAssetId,
+		false,
+		uint32(0),
+		uint32(0), // haircutPpm
 	)
 	require.NoError(t, err)
+	require.False(t, asset.HasMarket)
+	require.Zero(t, asset.MarketId)
 }
 
 func TestModifyAsset_MarketNotFound(t *testing.T) {
@@ -278,6 +447,7 @@ func TestModifyAsset_MarketNotFound(t *testing.T) {
 		firstValidAssetId,
 		true,
 		uint32(999),
+		uint32(100_000), // haircutPpm
 	)
 	require.EqualError(t, err, errorsmod.Wrap(pricestypes.ErrMarketPriceDoesNotExist, "999").Error())
 }
@@ -319,31 +489,126 @@ func TestGetAllAssets_Success(t *testing.T) {
 }
 
 func TestGetNetCollateral(t *testing.T) {
-	ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
-	_, err := createNAssets(t, ctx, keeper, pricesKeeper, 2)
-	require.NoError(t, err)
+	tests := map[string]struct {
+		// Parameters.
+		assetId     uint32
+		haircutPpm  uint32
+		bigQuantums *big.Int
+
+		// Expectations.
+		expectedNetCollateral *big.Int
+		expectedErr           error
+	}{
+		"USDC balance counts in full": {
+			assetId:               types.AssetUsdc.Id,
+			bigQuantums:           big.NewInt(100),
+			expectedNetCollateral: big.NewInt(100),
+		},
+		"Negative USDC balance counts in full": {
+			assetId:               types.AssetUsdc.Id,
+			bigQuantums:           big.NewInt(-100),
+			expectedNetCollateral: big.NewInt(-100),
+		},
+		"Asset balance is valued at the oracle price less the haircut": {
+			assetId:               constants.BtcUsd.Id,
+			haircutPpm:            100_000,                 // 10%
+			bigQuantums:           big.NewInt(100_000_000), // 1 BTC
+			expectedNetCollateral: big.NewInt(45_000_000_000),
+		},
+		"Asset balance with a haircut is rounded down": {
+			assetId:               constants.BtcUsd.Id,
+			haircutPpm:            333_333,
+			bigQuantums:           big.NewInt(3), // $0.0015
+			expectedNetCollateral: big.NewInt(1_000),
+		},
+		"Asset balance with a full haircut does not count towards collateral": {
+			assetId:               constants.BtcUsd.Id,
+			haircutPpm:            1_000_000,
+			bigQuantums:           big.NewInt(100_000_000), // 1 BTC
+			expectedNetCollateral: big.NewInt(0),
+		},
+		"Zero asset balance": {
+			assetId:               constants.BtcUsd.Id,
+			bigQuantums:           big.NewInt(0),
+			expectedNetCollateral: big.NewInt(0),
+		},
+		"Asset balance without a market does not count towards collateral": {
+			assetId:               2,
+			bigQuantums:           big.NewInt(100_000_000),
+			expectedNetCollateral: big.NewInt(0),
+		},
+		"Negative asset balance is not supported": {
+			assetId:     constants.BtcUsd.Id,
+			bigQuantums: big.NewInt(-100),
+			expectedErr: types.ErrNotImplementedMargin,
+		},
+		"Asset does not exist": {
+			assetId:     3,
+			bigQuantums: big.NewInt(100),
+			expectedErr: types.ErrAssetDoesNotExist,
+		},
+	}
 
-	netCollateral, err := keeper.GetNetCollateral(
-		ctx,
-		types.AssetUsdc.Id,
-		new(big.Int).SetInt64(100),
-	)
-	require.NoError(t, err)
-	require.Equal(t, new(big.Int).SetInt64(100), netCollateral)
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, keeper))
+			haircutPpm := constants.BtcUsd.HaircutPpm
+			if tc.haircutPpm != 0 {
+				haircutPpm = tc.haircutPpm
+			}
+			_, err := keeper.CreateAsset(
+				ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+				haircutPpm,
+			)
+			require.NoError(t, err)
+			_, err = keeper.CreateAsset(
+				ctx,
+				2,
+				"NOMARKET",
+				"no-market-denom",
+				-8,
+				false,
+				0,
+				-8,
+				0,
+			)
+			require.NoError(t, err)
 
-	_, err = keeper.GetNetCollateral(
-		ctx,
-		uint32(1),
-		new(big.Int).SetInt64(100),
-	)
-	require.EqualError(t, types.ErrNotImplementedMulticollateral, err.Error())
+			netCollateral, err := keeper.GetNetCollateral(ctx, tc.assetId, tc.bigQuantums)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNetCollateral, netCollateral)
+		})
+	}
+}
 
-	_, err = keeper.GetNetCollateral(
-		ctx,
-		uint32(1),
-		new(big.Int).SetInt64(-100),
+func TestGetNetCollateralInQuoteQuantums(t *testing.T) {
+	asset := *constants.BtcUsd
+	asset.HaircutPpm = 250_000 // 25%
+	marketPrice := pricestypes.MarketPrice{
+		Id:       constants.BtcUsd.MarketId,
+		Exponent: constants.BtcUsdExponent,
+		Price:    constants.FiveBillion, // $50,000 == 1 BTC
+	}
+
+	// 2 BTC at $50,000 with a 25% haircut.
+	require.Equal(
+		t,
+		big.NewInt(75_000_000_000),
+		keeper.GetNetCollateralInQuoteQuantums(asset, marketPrice, big.NewInt(200_000_000)),
 	)
-	require.EqualError(t, types.ErrNotImplementedMargin, err.Error())
 }
 
 func TestGetMarginRequirements(t *testing.T) {
@@ -467,6 +732,7 @@ func TestConvertAssetToCoin_Success(t *testing.T) {
 				false,
 				0,
 				tc.atomicResolution,
+				uint32(0),
 			)
 			require.NoError(t, err)
 
@@ -522,6 +788,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-6,
+		uint32(0),
 	)
 	require.NoError(t, err)
 
@@ -542,6 +809,7 @@ func TestConvertAssetToCoin_Failure(t *testing.T) {
 		false,
 		0,
 		-50, /* invalid asset atomic resolution */
+		uint32(0),
 	)
 	require.NoError(t, err)
 	_, _, err = keeper.ConvertAssetToCoin(ctx, 2, big.NewInt(100))
@@ -5,7 +5,7 @@ import (
 )
 
 type msgServer struct {
-	Keeper
+	Keeper Keeper
 }
 
 // NewMsgServerImpl returns an implementation of the MsgServer interface
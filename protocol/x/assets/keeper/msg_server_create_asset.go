@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+func (k msgServer) CreateAsset(
+	goCtx context.Context,
+	msg *types.MsgCreateAsset,
+) (*types.MsgCreateAssetResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if _, err := k.Keeper.CreateAsset(
+		ctx,
+		msg.Asset.Id,
+		msg.Asset.Symbol,
+		msg.Asset.Denom,
+		msg.Asset.DenomExponent,
+		msg.Asset.HasMarket,
+		msg.Asset.MarketId,
+		msg.Asset.AtomicResolution,
+		msg.Asset.HaircutPpm,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAssetResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAsset_MsgServer(t *testing.T) {
+	btcWithHaircut := *constants.BtcUsd
+	btcWithHaircut.HaircutPpm = 100_000 // 10%
+
+	testCases := map[string]struct {
+		setup          func(*testing.T, sdk.Context, *keeper.Keeper)
+		msg            *types.MsgCreateAsset
+		expectedAssets []types.Asset
+		expectedErr    string
+	}{
+		"Succeeds: create new asset (id = 1)": {
+			setup: func(t *testing.T, ctx sdk.Context, assetsKeeper *keeper.Keeper) {},
+			msg: &types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     btcWithHaircut,
+			},
+			expectedAssets: []types.Asset{*constants.Usdc, btcWithHaircut},
+		},
+		"Failure: asset id already exists": {
+			setup: func(t *testing.T, ctx sdk.Context, assetsKeeper *keeper.Keeper) {
+				_, err := assetsKeeper.CreateAsset(
+					ctx,
+					btcWithHaircut.Id,
+					btcWithHaircut.Symbol,
+					btcWithHaircut.Denom,
+					btcWithHaircut.DenomExponent,
+					btcWithHaircut.HasMarket,
+					btcWithHaircut.MarketId,
+					btcWithHaircut.AtomicResolution,
+					btcWithHaircut.HaircutPpm,
+				)
+				require.NoError(t, err)
+			},
+			msg: &types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     btcWithHaircut,
+			},
+			expectedAssets: []types.Asset{*constants.Usdc, btcWithHaircut},
+			expectedErr:    "Existing asset found with the same asset id",
+		},
+		"Failure: USDC denom with a non-zero asset id": {
+			setup: func(t *testing.T, ctx sdk.Context, assetsKeeper *keeper.Keeper) {},
+			msg: &types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset: types.Asset{
+					Id:               btcWithHaircut.Id,
+					Symbol:           btcWithHaircut.Symbol,
+					Denom:            constants.Usdc.Denom,
+					DenomExponent:    btcWithHaircut.DenomExponent,
+					HasMarket:        btcWithHaircut.HasMarket,
+					MarketId:         btcWithHaircut.MarketId,
+					AtomicResolution: btcWithHaircut.AtomicResolution,
+				},
+			},
+			expectedAssets: []types.Asset{*constants.Usdc},
+			expectedErr:    "USDC must be asset 0",
+		},
+		"Failure: market does not exist": {
+			setup: func(t *testing.T, ctx sdk.Context, assetsKeeper *keeper.Keeper) {},
+			msg: &types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset: types.Asset{
+					Id:               btcWithHaircut.Id,
+					Symbol:           btcWithHaircut.Symbol,
+					Denom:            btcWithHaircut.Denom,
+					DenomExponent:    btcWithHaircut.DenomExponent,
+					HasMarket:        true,
+					MarketId:         999,
+					AtomicResolution: btcWithHaircut.AtomicResolution,
+					HaircutPpm:       btcWithHaircut.HaircutPpm,
+				},
+			},
+			expectedAssets: []types.Asset{*constants.Usdc},
+			expectedErr:    "Market price does not exist",
+		},
+		"Failure: invalid authority": {
+			setup: func(t *testing.T, ctx sdk.Context, assetsKeeper *keeper.Keeper) {},
+			msg: &types.MsgCreateAsset{
+				Authority: "invalid",
+				Asset:     btcWithHaircut,
+			},
+			expectedAssets: []types.Asset{*constants.Usdc},
+			expectedErr:    "invalid authority",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, assetsKeeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			tc.setup(t, ctx, assetsKeeper)
+			msgServer := keeper.NewMsgServerImpl(*assetsKeeper)
+
+			_, err := msgServer.CreateAsset(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assetEvents := keepertest.GetAssetCreateEventsFromIndexerBlock(ctx, assetsKeeper)
+				require.Contains(
+					t,
+					assetEvents,
+					indexerevents.NewAssetCreateEvent(
+						tc.msg.Asset.Id,
+						tc.msg.Asset.Symbol,
+						tc.msg.Asset.HasMarket,
+						tc.msg.Asset.MarketId,
+						tc.msg.Asset.AtomicResolution,
+					),
+				)
+			}
+			require.Equal(t, tc.expectedAssets, assetsKeeper.GetAllAssets(ctx))
+		})
+	}
+}
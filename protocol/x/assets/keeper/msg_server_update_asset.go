@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+)
+
+func (k msgServer) UpdateAsset(
+	goCtx context.Context,
+	msg *types.MsgUpdateAsset,
+) (*types.MsgUpdateAssetResponse, error) {
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if _, err := k.Keeper.ModifyAsset(
+		ctx,
+		msg.Id,
+		msg.HasMarket,
+		msg.MarketId,
+		msg.HaircutPpm,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAssetResponse{}, nil
+}
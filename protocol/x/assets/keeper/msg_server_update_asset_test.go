@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAsset(t *testing.T) {
+	tests := map[string]struct {
+		msg           *types.MsgUpdateAsset
+		expectedAsset types.Asset
+		expectedErr   string
+	}{
+		"Succeeds: update market and haircut": {
+			msg: &types.MsgUpdateAsset{
+				Authority:  lib.GovModuleAddress.String(),
+				Id:         constants.BtcUsd.Id,
+				HasMarket:  true,
+				MarketId:   1, // ETH-USD
+				HaircutPpm: 200_000,
+			},
+			expectedAsset: types.Asset{
+				Id:               constants.BtcUsd.Id,
+				Symbol:           constants.BtcUsd.Symbol,
+				Denom:            constants.BtcUsd.Denom,
+				DenomExponent:    constants.BtcUsd.DenomExponent,
+				HasMarket:        true,
+				MarketId:         1, // ETH-USD
+				AtomicResolution: constants.BtcUsd.AtomicResolution,
+				HaircutPpm:       200_000,
+			},
+		},
+		"Failure: asset does not exist": {
+			msg: &types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        999,
+				HasMarket: true,
+			},
+			expectedAsset: *constants.BtcUsd,
+			expectedErr:   "Asset does not exist",
+		},
+		"Succeeds: remove market": {
+			msg: &types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        constants.BtcUsd.Id,
+				HasMarket: false,
+			},
+			expectedAsset: types.Asset{
+				Id:               constants.BtcUsd.Id,
+				Symbol:           constants.BtcUsd.Symbol,
+				Denom:            constants.BtcUsd.Denom,
+				DenomExponent:    constants.BtcUsd.DenomExponent,
+				HasMarket:        false,
+				MarketId:         0,
+				AtomicResolution: constants.BtcUsd.AtomicResolution,
+				HaircutPpm:       0,
+			},
+		},
+		"Failure: market does not exist": {
+			msg: &types.MsgUpdateAsset{
+				Authority:  lib.GovModuleAddress.String(),
+				Id:         constants.BtcUsd.Id,
+				HasMarket:  true,
+				MarketId:   999,
+				HaircutPpm: 200_000,
+			},
+			expectedAsset: *constants.BtcUsd,
+			expectedErr:   "Market price does not exist",
+		},
+		"Failure: collateral asset without a haircut": {
+			msg: &types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        constants.BtcUsd.Id,
+				HasMarket: true,
+				MarketId:  1, // ETH-USD
+			},
+			expectedAsset: *constants.BtcUsd,
+			expectedErr:   "haircut ppm must be non-zero for collateral asset",
+		},
+		"Failure: USDC cannot be modified": {
+			msg: &types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        constants.Usdc.Id,
+			},
+			expectedAsset: *constants.BtcUsd,
+			expectedErr:   "USDC cannot be modified",
+		},
+		"Failure: invalid authority": {
+			msg: &types.MsgUpdateAsset{
+				Authority:  "invalid",
+				Id:         constants.BtcUsd.Id,
+				HasMarket:  true,
+				HaircutPpm: 200_000,
+			},
+			expectedAsset: *constants.BtcUsd,
+			expectedErr:   "invalid authority",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, assetsKeeper, pricesKeeper, _, _, _ := keepertest.AssetsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+			_, err := assetsKeeper.CreateAsset(
+				ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+				constants.BtcUsd.HaircutPpm,
+			)
+			require.NoError(t, err)
+			msgServer := keeper.NewMsgServerImpl(*assetsKeeper)
+
+			_, err = msgServer.UpdateAsset(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			asset, exists := assetsKeeper.GetAsset(ctx, constants.BtcUsd.Id)
+			require.True(t, exists)
+			require.Equal(t, tc.expectedAsset, asset)
+
+			usdc, exists := assetsKeeper.GetAsset(ctx, constants.Usdc.Id)
+			require.True(t, exists)
+			require.Equal(t, *constants.Usdc, usdc)
+		})
+	}
+}
@@ -78,7 +78,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 0)
+	require.Len(t, fv.MapKeys(), 4)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -93,7 +93,7 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"haircut_ppm":0}]}`
 	require.Equal(t, expected, string(json))
 }
 
@@ -153,7 +153,9 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "assets", cmd.Use)
-	require.Equal(t, 0, len(cmd.Commands()))
+	require.Equal(t, 2, len(cmd.Commands()))
+	require.Equal(t, "create-asset", cmd.Commands()[0].Name())
+	require.Equal(t, "update-asset", cmd.Commands()[1].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -213,7 +215,7 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected := `{"assets":[{"id":0,"symbol":"USDC","denom":`
 	expected += `"ibc/8E27BA2D5493AF5636760E354E46004562C46AB7EC0CC4C1CA14E9E20E2545B5",`
 	expected += `"denom_exponent":-6,"has_market":false,`
-	expected += `"market_id":0,"atomic_resolution":-6}]}`
+	expected += `"market_id":0,"atomic_resolution":-6,"haircut_ppm":0}]}`
 	require.Equal(t, expected, string(genesisJson))
 }
 
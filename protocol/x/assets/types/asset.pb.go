@@ -53,6 +53,11 @@ type Asset struct {
 	// then an `asset_position` with `base_quantums = 1e8` is equivalent to
 	// a position size of one full coin.
 	AtomicResolution int32 `protobuf:"zigzag32,7,opt,name=atomic_resolution,json=atomicResolution,proto3" json:"atomic_resolution,omitempty"`
+	// The haircut applied to the oracle value of a positive balance of this
+	// `Asset` when calculating net collateral, in parts-per-million. For example,
+	// `haircut_ppm = 100_000` means that only 90% of the value of the balance
+	// counts towards collateral. Must be zero for USDC.
+	HaircutPpm uint32 `protobuf:"varint,8,opt,name=haircut_ppm,json=haircutPpm,proto3" json:"haircut_ppm,omitempty"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -137,6 +142,13 @@ func (m *Asset) GetAtomicResolution() int32 {
 	return 0
 }
 
+func (m *Asset) GetHaircutPpm() uint32 {
+	if m != nil {
+		return m.HaircutPpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Asset)(nil), "dydxprotocol.assets.Asset")
 }
@@ -144,25 +156,26 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/assets/asset.proto", fileDescriptor_d0b73b5c910a62b5) }
 
 var fileDescriptor_d0b73b5c910a62b5 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0x31, 0x4b, 0xc4, 0x30,
-	0x14, 0x07, 0xf0, 0xcb, 0x69, 0xeb, 0x35, 0x70, 0x87, 0x17, 0x45, 0x02, 0x62, 0x2c, 0x82, 0x50,
-	0x10, 0xdb, 0x41, 0x07, 0x57, 0x05, 0x07, 0x07, 0x97, 0x8c, 0x2e, 0x25, 0x6d, 0x82, 0x0d, 0xb6,
-	0x4d, 0x69, 0x72, 0xd2, 0x7e, 0x0b, 0x3f, 0x96, 0xe3, 0x2d, 0x82, 0xa3, 0xb4, 0x5f, 0x44, 0x4c,
-	0xce, 0x43, 0xa7, 0xbc, 0xf7, 0xfb, 0x3f, 0xc8, 0xe3, 0xc1, 0x53, 0xde, 0xf3, 0xae, 0x69, 0x95,
-	0x51, 0xb9, 0x2a, 0x13, 0xa6, 0xb5, 0x30, 0xda, 0x3d, 0xb1, 0x55, 0x74, 0xf0, 0x77, 0x20, 0x76,
-	0x03, 0x67, 0x1f, 0x00, 0x7a, 0xb7, 0x3f, 0x25, 0x5a, 0xc0, 0xa9, 0xe4, 0x18, 0x84, 0x20, 0x9a,
-	0xd3, 0xa9, 0xe4, 0xe8, 0x08, 0xfa, 0xba, 0xaf, 0x32, 0x55, 0xe2, 0x69, 0x08, 0xa2, 0x80, 0x6e,
-	0x3a, 0x74, 0x08, 0x3d, 0x2e, 0x6a, 0x55, 0xe1, 0x1d, 0xcb, 0xae, 0x41, 0xe7, 0x70, 0x61, 0x8b,
-	0x54, 0x74, 0x8d, 0xaa, 0x45, 0x6d, 0xf0, 0x6e, 0x08, 0xa2, 0x25, 0x9d, 0x5b, 0xbd, 0xdf, 0x20,
-	0x3a, 0x81, 0xb0, 0x60, 0x3a, 0xad, 0x58, 0xfb, 0x22, 0x0c, 0xf6, 0x42, 0x10, 0xcd, 0x68, 0x50,
-	0x30, 0xfd, 0x68, 0x01, 0x1d, 0xc3, 0xc0, 0x45, 0xa9, 0xe4, 0xd8, 0xb7, 0xab, 0xcc, 0x1c, 0x3c,
-	0x70, 0x74, 0x01, 0x97, 0xcc, 0xa8, 0x4a, 0xe6, 0x69, 0x2b, 0xb4, 0x2a, 0x57, 0x46, 0xaa, 0x1a,
-	0xef, 0xd9, 0x5f, 0xf6, 0x5d, 0x40, 0xb7, 0x7e, 0x47, 0xdf, 0x07, 0x02, 0xd6, 0x03, 0x01, 0x5f,
-	0x03, 0x01, 0x6f, 0x23, 0x99, 0xac, 0x47, 0x32, 0xf9, 0x1c, 0xc9, 0xe4, 0xe9, 0xe6, 0x59, 0x9a,
-	0x62, 0x95, 0xc5, 0xb9, 0xaa, 0x92, 0x7f, 0x27, 0x7b, 0xbd, 0xbe, 0xcc, 0x0b, 0x26, 0xeb, 0x64,
-	0x2b, 0xdd, 0xef, 0x19, 0x4d, 0xdf, 0x08, 0x9d, 0xf9, 0x36, 0xb8, 0xfa, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0x1b, 0xd1, 0xd6, 0x6a, 0x01, 0x00, 0x00,
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0x07, 0xf0, 0xa6, 0xdf, 0xd7, 0xda, 0x8e, 0xb4, 0xd8, 0x28, 0x12, 0x10, 0xd3, 0x45, 0x10,
+	0x16, 0xc4, 0xf6, 0xa0, 0x07, 0xaf, 0x0a, 0x1e, 0x3c, 0x08, 0x92, 0xa3, 0x97, 0x25, 0xdd, 0x04,
+	0x37, 0xd8, 0x6c, 0xc2, 0x26, 0x95, 0xf6, 0x2d, 0x7c, 0x2c, 0x8f, 0x3d, 0x7a, 0x94, 0xee, 0x3b,
+	0x78, 0x16, 0x93, 0xb5, 0xe8, 0x29, 0x33, 0xbf, 0xff, 0x90, 0x84, 0x81, 0xb1, 0x58, 0x89, 0xa5,
+	0xad, 0x8c, 0x37, 0xb9, 0x99, 0x4f, 0xb9, 0x73, 0xd2, 0xbb, 0x78, 0x4c, 0x82, 0xe2, 0xfd, 0xdf,
+	0x03, 0x93, 0x38, 0x70, 0xf2, 0x89, 0xa0, 0x73, 0xfd, 0x5d, 0xe2, 0x21, 0xb4, 0x95, 0x20, 0x28,
+	0x41, 0xe9, 0x80, 0xb5, 0x95, 0xc0, 0x87, 0xd0, 0x75, 0x2b, 0x3d, 0x33, 0x73, 0xd2, 0x4e, 0x50,
+	0xda, 0x67, 0x4d, 0x87, 0x0f, 0xa0, 0x23, 0x64, 0x69, 0x34, 0xf9, 0x17, 0x38, 0x36, 0xf8, 0x14,
+	0x86, 0xa1, 0xc8, 0xe4, 0xd2, 0x9a, 0x52, 0x96, 0x9e, 0xfc, 0x4f, 0x50, 0x3a, 0x62, 0x83, 0xa0,
+	0xb7, 0x0d, 0xe2, 0x63, 0x80, 0x82, 0xbb, 0x4c, 0xf3, 0xea, 0x59, 0x7a, 0xd2, 0x49, 0x50, 0xda,
+	0x63, 0xfd, 0x82, 0xbb, 0xfb, 0x00, 0xf8, 0x08, 0xfa, 0x31, 0xca, 0x94, 0x20, 0xdd, 0xf0, 0x95,
+	0x5e, 0x84, 0x3b, 0x81, 0xcf, 0x60, 0xc4, 0xbd, 0xd1, 0x2a, 0xcf, 0x2a, 0xe9, 0xcc, 0x7c, 0xe1,
+	0x95, 0x29, 0xc9, 0x4e, 0x78, 0x65, 0x2f, 0x06, 0x6c, 0xeb, 0x78, 0x0c, 0xbb, 0x05, 0x57, 0x55,
+	0xbe, 0xf0, 0x99, 0xb5, 0x9a, 0xf4, 0xc2, 0x5d, 0xd0, 0xd0, 0x83, 0xd5, 0x37, 0xec, 0x6d, 0x43,
+	0xd1, 0x7a, 0x43, 0xd1, 0xc7, 0x86, 0xa2, 0xd7, 0x9a, 0xb6, 0xd6, 0x35, 0x6d, 0xbd, 0xd7, 0xb4,
+	0xf5, 0x78, 0xf5, 0xa4, 0x7c, 0xb1, 0x98, 0x4d, 0x72, 0xa3, 0xa7, 0x7f, 0x76, 0xfa, 0x72, 0x79,
+	0x9e, 0x17, 0x5c, 0x95, 0xd3, 0xad, 0x2c, 0x7f, 0xf6, 0xec, 0x57, 0x56, 0xba, 0x59, 0x37, 0x04,
+	0x17, 0x5f, 0x03, 0x00, 0xd4, 0x32, 0xe8, 0x88, 0x8b, 0x01, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -185,6 +198,11 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaircutPpm != 0 {
+		i = encodeVarintAsset(dAtA, i, uint64(m.HaircutPpm))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.AtomicResolution != 0 {
 		i = encodeVarintAsset(dAtA, i, uint64((uint32(m.AtomicResolution)<<1)^uint32((m.AtomicResolution>>31))))
 		i--
@@ -272,6 +290,9 @@ func (m *Asset) Size() (n int) {
 	if m.AtomicResolution != 0 {
 		n += 1 + sozAsset(uint64(m.AtomicResolution))
 	}
+	if m.HaircutPpm != 0 {
+		n += 1 + sovAsset(uint64(m.HaircutPpm))
+	}
 	return n
 }
 
@@ -474,6 +495,25 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
 			m.AtomicResolution = v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaircutPpm", wireType)
+			}
+			m.HaircutPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaircutPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -18,6 +18,11 @@ var (
 	ErrInvalidDenomExponent         = errorsmod.Register(ModuleName, 11, "Invalid denom exponent")
 	ErrAssetAlreadyExists           = errorsmod.Register(ModuleName, 12, "Asset already exists")
 	ErrUnexpectedUsdcDenomExponent  = errorsmod.Register(ModuleName, 13, "USDC denom exponent is unexpected")
+	ErrInvalidHaircutPpm            = errorsmod.Register(ModuleName, 14, "Invalid haircut ppm")
+	ErrInvalidAuthority             = errorsmod.Register(ModuleName, 15, "Authority is invalid")
+	ErrInvalidAssetSymbol           = errorsmod.Register(ModuleName, 16, "Invalid asset symbol")
+	ErrInvalidAssetDenom            = errorsmod.Register(ModuleName, 17, "Invalid asset denom")
+	ErrUsdcCannotBeModified         = errorsmod.Register(ModuleName, 18, "USDC cannot be modified")
 
 	// Errors for Not Implemented
 	ErrNotImplementedMulticollateral = errorsmod.Register(ModuleName, 401, "Not Implemented: Multi-Collateral")
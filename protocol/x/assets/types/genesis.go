@@ -44,6 +44,7 @@ func (gs GenesisState) Validate() error {
 	// Provided assets should not contain duplicated asset ids, and denoms.
 	// Asset ids should be sequential.
 	// MarketId should be 0 if HasMarket is false.
+	// HaircutPpm should not be greater than 1_000_000, and should be non-zero for assets with a market.
 	assetIdSet := make(map[uint32]struct{})
 	denomSet := make(map[string]struct{})
 	expectedId := uint32(0)
@@ -61,6 +62,12 @@ func (gs GenesisState) Validate() error {
 		if !asset.HasMarket && asset.MarketId > 0 {
 			return ErrInvalidMarketId
 		}
+		if asset.HaircutPpm > lib.OneMillion {
+			return ErrInvalidHaircutPpm
+		}
+		if asset.HasMarket && asset.Id != AssetUsdc.Id && asset.HaircutPpm == 0 {
+			return ErrInvalidHaircutPpm
+		}
 		assetIdSet[asset.Id] = struct{}{}
 		denomSet[asset.Denom] = struct{}{}
 		expectedId = expectedId + 1
@@ -34,6 +34,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						HasMarket:        true,
 						MarketId:         0,
 						AtomicResolution: int32(-6),
+						HaircutPpm:       100_000,
 					},
 				},
 			},
@@ -165,6 +166,53 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidMarketId,
 		},
+		"HaircutPpm greater than 1_000_000": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					types.AssetUsdc,
+					{
+						Id:               1,
+						Denom:            "BTC",
+						HasMarket:        true,
+						MarketId:         0,
+						AtomicResolution: int32(-8),
+						HaircutPpm:       1_000_001,
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidHaircutPpm,
+		},
+		"Asset with a market and a zero haircut": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					types.AssetUsdc,
+					{
+						Id:               1,
+						Denom:            "BTC",
+						HasMarket:        true,
+						MarketId:         0,
+						AtomicResolution: int32(-8),
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidHaircutPpm,
+		},
+		"USDC with a non-zero haircut": {
+			genState: &types.GenesisState{
+				Assets: []types.Asset{
+					{
+						Id:               0,
+						Symbol:           types.AssetUsdc.Symbol,
+						Denom:            types.AssetUsdc.Denom,
+						DenomExponent:    types.AssetUsdc.DenomExponent,
+						HasMarket:        false,
+						AtomicResolution: lib.QuoteCurrencyAtomicResolution,
+						HaircutPpm:       1,
+					},
+				},
+			},
+			expectedErr: types.ErrUsdcMustBeAssetZero,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+var _ sdk.Msg = &MsgCreateAsset{}
+
+func (msg *MsgCreateAsset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(
+			ErrInvalidAuthority,
+			fmt.Sprintf(
+				"authority '%s' must be a valid bech32 address, but got error '%v'",
+				msg.Authority,
+				err.Error(),
+			),
+		)
+	}
+
+	if msg.Asset.Symbol == "" {
+		return errorsmod.Wrap(ErrInvalidAssetSymbol, "symbol cannot be empty")
+	}
+	if err := sdk.ValidateDenom(msg.Asset.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidAssetDenom, err.Error())
+	}
+	if lib.AbsInt32(msg.Asset.DenomExponent) > MaxAssetUnitExponentAbs {
+		return errorsmod.Wrapf(
+			ErrInvalidDenomExponent,
+			"denom exponent %d must be within [-%d, %d]",
+			msg.Asset.DenomExponent,
+			MaxAssetUnitExponentAbs,
+			MaxAssetUnitExponentAbs,
+		)
+	}
+	if lib.AbsInt32(msg.Asset.AtomicResolution) > MaxAssetUnitExponentAbs {
+		return errorsmod.Wrapf(
+			ErrInvalidAssetAtomicResolution,
+			"atomic resolution %d must be within [-%d, %d]",
+			msg.Asset.AtomicResolution,
+			MaxAssetUnitExponentAbs,
+			MaxAssetUnitExponentAbs,
+		)
+	}
+	return validateMarketAndHaircut(msg.Asset.MarketId, msg.Asset.HasMarket, msg.Asset.HaircutPpm)
+}
+
+// validateMarketAndHaircut returns an error if a market id is set on an asset without a market,
+// or if the haircut is greater than 100%. Haircut rules that depend on the asset are validated
+// by the keeper.
+func validateMarketAndHaircut(marketId uint32, hasMarket bool, haircutPpm uint32) error {
+	if !hasMarket && marketId > 0 {
+		return errorsmod.Wrapf(ErrInvalidMarketId, "Market ID: %v", marketId)
+	}
+	if haircutPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidHaircutPpm,
+			"haircut ppm %d is greater than %d",
+			haircutPpm,
+			lib.OneMillion,
+		)
+	}
+	return nil
+}
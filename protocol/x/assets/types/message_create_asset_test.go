@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateAsset_ValidateBasic(t *testing.T) {
+	validAsset := types.Asset{
+		Id:               1,
+		Symbol:           "BTC",
+		Denom:            "btc-denom",
+		DenomExponent:    -8,
+		HasMarket:        true,
+		MarketId:         0,
+		AtomicResolution: -8,
+		HaircutPpm:       100_000,
+	}
+	withAsset := func(modify func(asset *types.Asset)) types.Asset {
+		asset := validAsset
+		modify(&asset)
+		return asset
+	}
+
+	tests := map[string]struct {
+		msg         types.MsgCreateAsset
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     validAsset,
+			},
+		},
+		"Failure: invalid authority": {
+			msg: types.MsgCreateAsset{
+				Authority: "",
+				Asset:     validAsset,
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: empty symbol": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     withAsset(func(asset *types.Asset) { asset.Symbol = "" }),
+			},
+			expectedErr: types.ErrInvalidAssetSymbol,
+		},
+		"Failure: invalid denom": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     withAsset(func(asset *types.Asset) { asset.Denom = "1" }),
+			},
+			expectedErr: types.ErrInvalidAssetDenom,
+		},
+		"Failure: denom exponent out of bounds": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     withAsset(func(asset *types.Asset) { asset.DenomExponent = -33 }),
+			},
+			expectedErr: types.ErrInvalidDenomExponent,
+		},
+		"Failure: atomic resolution out of bounds": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     withAsset(func(asset *types.Asset) { asset.AtomicResolution = 33 }),
+			},
+			expectedErr: types.ErrInvalidAssetAtomicResolution,
+		},
+		"Failure: market id set without a market": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset: withAsset(func(asset *types.Asset) {
+					asset.HasMarket = false
+					asset.MarketId = 1
+				}),
+			},
+			expectedErr: types.ErrInvalidMarketId,
+		},
+		"Failure: haircut greater than 100%": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset:     withAsset(func(asset *types.Asset) { asset.HaircutPpm = 1_000_001 }),
+			},
+			expectedErr: types.ErrInvalidHaircutPpm,
+		},
+		"Success: zero haircut for an asset without a market": {
+			msg: types.MsgCreateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Asset: withAsset(func(asset *types.Asset) {
+					asset.HasMarket = false
+					asset.HaircutPpm = 0
+				}),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
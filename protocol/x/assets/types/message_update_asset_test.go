@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateAsset_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg         types.MsgUpdateAsset
+		expectedErr error
+	}{
+		"Success": {
+			msg: types.MsgUpdateAsset{
+				Authority:  lib.GovModuleAddress.String(),
+				Id:         1,
+				HasMarket:  true,
+				MarketId:   1,
+				HaircutPpm: 100_000,
+			},
+		},
+		"Success: remove market": {
+			msg: types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        1,
+			},
+		},
+		"Failure: invalid authority": {
+			msg: types.MsgUpdateAsset{
+				Authority: "invalid",
+				Id:        1,
+			},
+			expectedErr: types.ErrInvalidAuthority,
+		},
+		"Failure: market id set without a market": {
+			msg: types.MsgUpdateAsset{
+				Authority: lib.GovModuleAddress.String(),
+				Id:        1,
+				HasMarket: false,
+				MarketId:  1,
+			},
+			expectedErr: types.ErrInvalidMarketId,
+		},
+		"Failure: haircut greater than 100%": {
+			msg: types.MsgUpdateAsset{
+				Authority:  lib.GovModuleAddress.String(),
+				Id:         1,
+				HasMarket:  true,
+				HaircutPpm: 1_000_001,
+			},
+			expectedErr: types.ErrInvalidHaircutPpm,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -88,25 +88,82 @@ func EndBlocker(
 		)
 	}
 
+	// Cancel the stateful orders of subaccounts whose dead man's switch was not refreshed in time.
+	// These removed stateful order ids will be purged from the memclob in `Commit`, and the Short-Term
+	// orders of these subaccounts will be removed from the memclob in `PrepareCheckState`.
+	heartbeatRemovedOrderIds, expiredHeartbeatSubaccountIds := keeper.CancelStatefulOrdersOfExpiredHeartbeats(ctx)
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		heartbeatRemovedOrderIds...,
+	)
+	processProposerMatchesEvents.ExpiredHeartbeatSubaccountIds = expiredHeartbeatSubaccountIds
+
+	// Remove the stateful orders of subaccounts on clob pairs where their market-maker protection was
+	// tripped in this block. These removed stateful order ids will be purged from the memclob in `Commit`.
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		keeper.RemoveStatefulOrdersOfTrippedMarketMakers(
+			ctx,
+			processProposerMatchesEvents.MarketMakerProtectionTrips,
+		)...,
+	)
+
 	// Prune expired untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
 	keeper.PruneUntriggeredConditionalOrders(
 		expiredStatefulOrderIds,
 		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
 	)
 
+	// Prune removed untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
+	// This includes conditional orders canceled since they were part of the same order group as a filled
+	// or removed order.
+	keeper.PruneUntriggeredConditionalOrders(
+		lib.DedupeSlice(processProposerMatchesEvents.RemovedStatefulOrderIds),
+		[]types.OrderId{},
+	)
+
+	// Prune replaced untriggered conditional orders from the in-memory UntriggeredConditionalOrders struct.
+	// The replacement orders are re-added below along with the newly-placed conditional orders.
+	replacedUntriggeredConditionalOrderIds := make([]types.OrderId, 0)
+	for _, orderId := range lib.DedupeSlice(processProposerMatchesEvents.ReplacedStatefulOrderIds) {
+		if orderId.IsConditionalOrder() && !keeper.IsConditionalOrderTriggered(ctx, orderId) {
+			replacedUntriggeredConditionalOrderIds = append(replacedUntriggeredConditionalOrderIds, orderId)
+		}
+	}
+	keeper.PruneUntriggeredConditionalOrders(
+		replacedUntriggeredConditionalOrderIds,
+		[]types.OrderId{},
+	)
+
 	// Update the memstore with expired order ids.
 	// These expired stateful order ids will be purged from the memclob in `Commit`.
 	processProposerMatchesEvents.ExpiredStatefulOrderIds = expiredStatefulOrderIds
 
+	// Generate the suborders of TWAP orders that are due. Note that this happens after pruning expired
+	// orders since the previous suborder of a TWAP order expires when its next suborder is due.
+	// These suborders will be placed in `PrepareCheckState`.
+	processProposerMatchesEvents.PlacedTwapSuborderIds = keeper.GenerateTwapSuborders(ctx)
+
 	// Before triggering conditional orders, add newly-placed conditional orders to the clob keeper's
 	// in-memory UntriggeredConditionalOrders data structure to allow conditional orders to
-	// trigger in the same block they are placed. Skip triggering orders which have been cancelled
-	// or expired.
-	// TODO(CLOB-773) Support conditional order replacements. Ensure replacements are de-duplicated.
+	// trigger in the same block they are placed. Replaced untriggered conditional orders are added back
+	// with their replacement orders. Skip triggering orders which have been cancelled, removed or expired.
 	keeper.AddUntriggeredConditionalOrders(
 		ctx,
-		processProposerMatchesEvents.PlacedConditionalOrderIds,
-		lib.UniqueSliceToSet(processProposerMatchesEvents.GetPlacedStatefulCancellationOrderIds()),
+		lib.DedupeSlice(
+			append(
+				processProposerMatchesEvents.PlacedConditionalOrderIds,
+				replacedUntriggeredConditionalOrderIds...,
+			),
+		),
+		lib.UniqueSliceToSet(
+			lib.DedupeSlice(
+				append(
+					processProposerMatchesEvents.GetPlacedStatefulCancellationOrderIds(),
+					processProposerMatchesEvents.GetRemovedStatefulOrderIds()...,
+				),
+			),
+		),
 		lib.UniqueSliceToSet(expiredStatefulOrderIds),
 	)
 
@@ -122,6 +179,9 @@ func EndBlocker(
 		processProposerMatchesEvents,
 	)
 
+	// Trip or restore the circuit breakers of clob pairs based on the oracle prices updated in this block.
+	keeper.UpdateCircuitBreakers(ctx)
+
 	// Prune any rate limiting information that is no longer relevant.
 	keeper.PruneRateLimits(ctx)
 
@@ -156,7 +216,8 @@ func PrepareCheckState(
 	}
 
 	// 1. Remove all operations in the local validators operations queue from the memclob.
-	localValidatorOperationsQueue, shortTermOrderTxBytes := keeper.MemClob.GetOperationsToReplay(ctx)
+	localValidatorOperationsQueue, shortTermOrderTxBytes, shortTermOrderBatchIndexes :=
+		keeper.MemClob.GetOperationsToReplay(ctx)
 
 	log.DebugLog(ctx, "Clearing local operations queue",
 		log.LocalValidatorOperationsQueue, types.GetInternalOperationsQueueTextString(localValidatorOperationsQueue),
@@ -197,6 +258,13 @@ func PrepareCheckState(
 		metrics.Count,
 	)
 
+	// Apply all stateful order replacements included in the last block to the memclob.
+	offchainUpdates = keeper.ReplaceStatefulOrdersFromLastBlock(
+		ctx,
+		processProposerMatchesEvents.ReplacedStatefulOrderIds,
+		offchainUpdates,
+	)
+
 	// 4. Place all conditional orders triggered in EndBlocker of last block on the memclob.
 	offchainUpdates = keeper.PlaceConditionalOrdersTriggeredInLastBlock(
 		ctx,
@@ -204,11 +272,27 @@ func PrepareCheckState(
 		offchainUpdates,
 	)
 
+	// Place all TWAP suborders generated in EndBlocker of last block on the memclob.
+	offchainUpdates = keeper.PlaceStatefulOrdersFromLastBlock(
+		ctx,
+		processProposerMatchesEvents.PlacedTwapSuborderIds,
+		offchainUpdates,
+	)
+
+	// Remove the Short-Term orders of subaccounts on clob pairs where their market-maker protection was
+	// tripped in the last block. Note that replaying these orders below fails since the market-maker
+	// protection is still tripped.
+	keeper.CancelShortTermOrdersOfTrippedMarketMakers(
+		ctx,
+		processProposerMatchesEvents.MarketMakerProtectionTrips,
+	)
+
 	// 5. Replay the local validator’s operations onto the book.
 	replayUpdates := keeper.MemClob.ReplayOperations(
 		ctx,
 		localValidatorOperationsQueue,
 		shortTermOrderTxBytes,
+		shortTermOrderBatchIndexes,
 		offchainUpdates,
 	)
 
@@ -217,6 +301,14 @@ func PrepareCheckState(
 		offchainUpdates = replayUpdates
 	}
 
+	// Remove the Short-Term orders of subaccounts whose heartbeat expired in the last block. Note that this
+	// is done after replaying the local validator's operations, since the replayed orders were placed
+	// before the heartbeat expired.
+	keeper.CancelShortTermOrdersOfExpiredHeartbeats(
+		ctx,
+		processProposerMatchesEvents.ExpiredHeartbeatSubaccountIds,
+	)
+
 	// 6. Get all potentially liquidatable subaccount IDs and attempt to liquidate them.
 	liquidatableSubaccountIds := keeper.DaemonLiquidationInfo.GetLiquidatableSubaccountIds()
 	subaccountsToDeleverage, err := keeper.LiquidateSubaccountsAgainstOrderbook(ctx, liquidatableSubaccountIds)
@@ -247,7 +339,7 @@ func PrepareCheckState(
 	// Send all off-chain Indexer events
 	keeper.SendOffchainMessages(offchainUpdates, nil, metrics.SendPrepareCheckStateOffchainUpdates)
 
-	newLocalValidatorOperationsQueue, _ := keeper.MemClob.GetOperationsToReplay(ctx)
+	newLocalValidatorOperationsQueue, _, _ := keeper.MemClob.GetOperationsToReplay(ctx)
 
 	log.DebugLog(ctx, "Local operations queue after PrepareCheckState",
 		log.NewLocalValidatorOperationsQueue,
@@ -257,6 +349,11 @@ func PrepareCheckState(
 	// Initialize new GRPC streams with orderbook snapshots, if any.
 	keeper.InitializeNewGrpcStreams(ctx)
 
+	// Capture the orderbook snapshots served by the orderbook and simulate order queries, if enabled.
+	if keeper.Flags.OrderbookQueriesEnabled {
+		keeper.UpdateOrderbookSnapshots(ctx)
+	}
+
 	// Set per-orderbook gauges.
 	keeper.MemClob.SetMemclobGauges(ctx)
 }
@@ -1459,7 +1459,7 @@ func TestPrepareCheckState(t *testing.T) {
 
 			// Verify test expectations.
 			require.NoError(t, err)
-			operationsQueue, _ := memClob.GetOperationsToReplay(ctx)
+			operationsQueue, _, _ := memClob.GetOperationsToReplay(ctx)
 
 			require.Equal(t, tc.expectedOperationsQueue, operationsQueue)
 
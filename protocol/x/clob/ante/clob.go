@@ -14,7 +14,9 @@ import (
 
 // ClobDecorator is an AnteDecorator which is responsible for:
 //   - adding short term order placements and cancelations to the in-memory orderbook (`CheckTx` only).
+//   - removing all short term orders of a subaccount from the in-memory orderbook (`CheckTx` only).
 //   - adding stateful order placements and cancelations to state (`CheckTx` and `RecheckTx` only).
+//   - validating stateful order replacements against state (`CheckTx` and `RecheckTx` only).
 //
 // This AnteDecorator also enforces that any Transaction which contains a `MsgPlaceOrder`,
 // or a `MsgCancelOrder`, must consist only of a single message.
@@ -123,6 +125,14 @@ func (cd ClobDecorator) AnteHandle(
 				log.Error, err,
 			)
 		}
+	case *types.MsgReplaceOrder:
+		err = cd.clobKeeper.ReplaceStatefulOrder(ctx, msg)
+
+		log.DebugLog(ctx, "Received new stateful order replacement",
+			log.Tx, cometbftlog.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			log.OrderHash, cometbftlog.NewLazySprintf("%X", msg.Order.GetOrderHash()),
+			log.Error, err,
+		)
 	case *types.MsgBatchCancel:
 		// MsgBatchCancel currently only processes short-term cancels right now.
 		// No need to process short term orders on `ReCheckTx`.
@@ -150,6 +160,54 @@ func (cd ClobDecorator) AnteHandle(
 			log.Tx, cometbftlog.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
 			log.Error, err,
 		)
+	case *types.MsgCancelAllOrders:
+		// Only Short-Term orders are removed from the memclob here, stateful orders are removed from state
+		// when the msg is delivered. No need to process short term order cancelations on `ReCheckTx`.
+		if ctx.IsReCheckTx() {
+			return next(ctx, tx, simulate)
+		}
+
+		_, err = cd.clobKeeper.CancelAllShortTermOrders(ctx, msg)
+
+		log.DebugLog(
+			ctx,
+			"Received new cancel all orders",
+			log.Tx, cometbftlog.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			log.Error, err,
+		)
+	case *types.MsgBatchPlaceOrder:
+		// MsgBatchPlaceOrder currently only processes short-term orders right now.
+		// Unlike other Short-Term clob messages, MsgBatchPlaceOrder is not excluded from the mempool,
+		// so fail `ReCheckTx` to evict it. Its orders were already placed on the memclob in `CheckTx`.
+		if ctx.IsReCheckTx() {
+			return ctx, errorsmod.Wrap(
+				types.ErrBatchPlaceOrderFailed,
+				"MsgBatchPlaceOrder is not rechecked",
+			)
+		}
+
+		var success []types.OrderId
+		var failures []types.OrderPlacementFailure
+		success, failures, err = cd.clobKeeper.BatchPlaceShortTermOrder(
+			ctx,
+			msg,
+		)
+		// If there are no successful placements and no validation errors,
+		// return an error indicating no orders have been placed.
+		if len(success) == 0 && err == nil {
+			err = errorsmod.Wrapf(
+				types.ErrBatchPlaceOrderFailed,
+				"No successful order placements. Failures: %+v",
+				failures,
+			)
+		}
+
+		log.DebugLog(
+			ctx,
+			"Received new batch order placement",
+			log.Tx, cometbftlog.NewLazySprintf("%X", tmhash.Sum(ctx.TxBytes())),
+			log.Error, err,
+		)
 	}
 	if err != nil {
 		return ctx, err
@@ -159,15 +217,21 @@ func (cd ClobDecorator) AnteHandle(
 }
 
 // IsSingleClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder` or `MsgBatchCancel`). If `msgs` consist of multiple
-// clob messages, or a mix of on-chain and clob messages, an error is returned.
+// (`MsgPlaceOrder` or `MsgCancelOrder` or `MsgBatchCancel` or `MsgBatchPlaceOrder` or `MsgReplaceOrder`
+// or `MsgCancelAllOrders`).
+// If `msgs` consist of multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsSingleClobMsgTx(tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
 	var hasMessage = false
 
 	for _, msg := range msgs {
 		switch msg.(type) {
-		case *types.MsgCancelOrder, *types.MsgPlaceOrder, *types.MsgBatchCancel:
+		case *types.MsgCancelOrder,
+			*types.MsgPlaceOrder,
+			*types.MsgBatchCancel,
+			*types.MsgBatchPlaceOrder,
+			*types.MsgReplaceOrder,
+			*types.MsgCancelAllOrders:
 			hasMessage = true
 		}
 
@@ -184,7 +248,8 @@ func IsSingleClobMsgTx(tx sdk.Tx) (bool, error) {
 	if numMsgs > 1 {
 		return false, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"a transaction containing MsgCancelOrder or MsgPlaceOrder or MsgBatchCancel may not contain more than one message",
+			"a transaction containing MsgCancelOrder or MsgPlaceOrder or MsgBatchCancel or MsgBatchPlaceOrder "+
+				"or MsgReplaceOrder or MsgCancelAllOrders may not contain more than one message",
 		)
 	}
 
@@ -192,7 +257,8 @@ func IsSingleClobMsgTx(tx sdk.Tx) (bool, error) {
 }
 
 // IsShortTermClobMsgTx returns `true` if the supplied `tx` consist of a single clob message
-// (`MsgPlaceOrder` or `MsgCancelOrder` or `MsgBatchCancel`) which references a Short-Term Order.
+// (`MsgPlaceOrder` or `MsgCancelOrder` or `MsgBatchCancel` or `MsgBatchPlaceOrder`) which references
+// a Short-Term Order.
 // If `msgs` consist of multiple clob messages, or a mix of on-chain and clob messages, an error is returned.
 func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	msgs := tx.GetMsgs()
@@ -218,6 +284,11 @@ func IsShortTermClobMsgTx(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 				// MsgBatchCancel processes only short term orders for now.
 				isShortTermOrder = true
 			}
+		case *types.MsgBatchPlaceOrder:
+			{
+				// MsgBatchPlaceOrder processes only short term orders for now.
+				isShortTermOrder = true
+			}
 		}
 
 		if isShortTermOrder {
@@ -8,15 +8,19 @@ import (
 var _ sdktypes.AnteDecorator = (*ClobRateLimitDecorator)(nil)
 
 // ClobRateLimitDecorator is an AnteDecorator which is responsible for rate limiting MsgCancelOrder and MsgPlaceOrder
-// and MsgBatchCancel requests.
+// and MsgBatchCancel and MsgBatchPlaceOrder and MsgReplaceOrder and MsgCancelAllOrders requests.
 //
 // This AnteDecorator is a no-op if:
-//   - No messages in the transaction are `MsgCancelOrder` or `MsgPlaceOrder` or `MsgBatchCancel`
+//   - No messages in the transaction are `MsgCancelOrder` or `MsgPlaceOrder` or `MsgBatchCancel` or
+//     `MsgBatchPlaceOrder` or `MsgReplaceOrder` or `MsgCancelAllOrders`
 //
 // This AnteDecorator returns an error if:
 //   - The rate limit is exceeded for any `MsgCancelOrder` messages.
 //   - The rate limit is exceeded for any `MsgPlaceOrder` messages.
 //   - The rate limit is exceeded for any `MsgBatchCancel` messages.
+//   - The rate limit is exceeded for any `MsgBatchPlaceOrder` messages.
+//   - The rate limit is exceeded for any `MsgReplaceOrder` messages.
+//   - The rate limit is exceeded for any `MsgCancelAllOrders` messages.
 //
 // TODO(CLOB-721): Rate limit short term order cancellations.
 type ClobRateLimitDecorator struct {
@@ -48,6 +52,18 @@ func (r ClobRateLimitDecorator) AnteHandle(
 			if err = r.clobKeeper.RateLimitBatchCancel(ctx, msg); err != nil {
 				return ctx, err
 			}
+		case *types.MsgBatchPlaceOrder:
+			if err = r.clobKeeper.RateLimitBatchPlaceOrder(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgReplaceOrder:
+			if err = r.clobKeeper.RateLimitReplaceOrder(ctx, msg); err != nil {
+				return ctx, err
+			}
+		case *types.MsgCancelAllOrders:
+			if err = r.clobKeeper.RateLimitCancelAllOrders(ctx, msg); err != nil {
+				return ctx, err
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
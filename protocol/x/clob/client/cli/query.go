@@ -27,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdGetEquityTierLimitConfig())
 	cmd.AddCommand(CmdGetLiquidationsConfiguration())
 	cmd.AddCommand(CmdQueryStatefulOrder())
+	cmd.AddCommand(CmdQueryOrderbook())
+	cmd.AddCommand(CmdQuerySubaccountRisk())
+	cmd.AddCommand(CmdQuerySimulateOrder())
 
 	return cmd
 }
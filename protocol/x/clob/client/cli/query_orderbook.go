@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDepth         = "depth"
+	flagGroupSubticks = "group-subticks"
+)
+
+func CmdQueryOrderbook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orderbook clob_pair_id",
+		Short: "queries the price levels of the orderbook of a clob pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			clobPairId, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+
+			depth, err := cmd.Flags().GetUint32(flagDepth)
+			if err != nil {
+				return err
+			}
+
+			groupSubticks, err := cmd.Flags().GetUint64(flagGroupSubticks)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOrderbookRequest{
+				ClobPairId:    clobPairId,
+				Depth:         depth,
+				GroupSubticks: groupSubticks,
+			}
+
+			res, err := queryClient.Orderbook(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flagDepth, 0, "Maximum number of price levels per side of the orderbook")
+	cmd.Flags().Uint64(flagGroupSubticks, 0, "Price range in subticks that price levels are grouped by")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
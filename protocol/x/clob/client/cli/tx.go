@@ -27,9 +27,20 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdPlaceOrder())
 	cmd.AddCommand(CmdCancelOrder())
+	cmd.AddCommand(CmdReplaceOrder())
 	batchCancelCmd := CmdBatchCancel()
 	batchCancelCmd.PersistentFlags().String("clientIds", "", "A list of client ids to to batch cancel")
 	cmd.AddCommand(batchCancelCmd)
+	cancelAllOrdersCmd := CmdCancelAllOrders()
+	cancelAllOrdersCmd.PersistentFlags().String(
+		"clobPairIds",
+		"",
+		"A list of clob pair ids to cancel all orders for. If empty, orders of all clob pairs are cancelled",
+	)
+	cmd.AddCommand(cancelAllOrdersCmd)
+	cmd.AddCommand(CmdHeartbeat())
+	cmd.AddCommand(CmdSetMarketMakerProtectionConfig())
+	cmd.AddCommand(CmdResetMarketMakerProtection())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
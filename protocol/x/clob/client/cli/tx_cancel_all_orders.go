@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancelAllOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-orders owner subaccount_number --clobPairIds=\"<list of ids>\"",
+		Short: "Broadcast message cancel all orders for a subaccount, optionally filtered by clob pair ids",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+			clobPairIds, err := cmd.Flags().GetString("clobPairIds")
+			if err != nil {
+				return err
+			}
+			argClobPairIds := []uint32{}
+			for _, idString := range strings.Fields(clobPairIds) {
+				idUint64, err := strconv.ParseUint(idString, 10, 32)
+				if err != nil {
+					return err
+				}
+				argClobPairIds = append(argClobPairIds, uint32(idUint64))
+			}
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAllOrders(
+				satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				argClobPairIds,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetMarketMakerProtectionConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "set-market-maker-protection-config owner subaccount_number clob_pair_id window_blocks " +
+			"max_filled_quantums max_filled_quote_quantums freeze_blocks",
+		Short: "Broadcast message set-market-maker-protection-config to set (or clear, with all settings 0) " +
+			"the market-maker protection settings of a subaccount on a clob pair",
+		Args: cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			argWindowBlocks, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			argMaxFilledQuantums, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			argMaxFilledQuoteQuantums, err := cast.ToUint64E(args[5])
+			if err != nil {
+				return err
+			}
+
+			argFreezeBlocks, err := cast.ToUint32E(args[6])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMarketMakerProtectionConfig(
+				satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				argClobPairId,
+				types.MarketMakerProtectionConfig{
+					WindowBlocks:           argWindowBlocks,
+					MaxFilledQuantums:      argMaxFilledQuantums,
+					MaxFilledQuoteQuantums: argMaxFilledQuoteQuantums,
+					FreezeBlocks:           argFreezeBlocks,
+				},
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResetMarketMakerProtection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-market-maker-protection owner subaccount_number clob_pair_id",
+		Short: "Broadcast message reset-market-maker-protection to reset tripped market-maker protection of a subaccount",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argOwner := args[0]
+
+			argNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argClobPairId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetMarketMakerProtection(
+				satypes.SubaccountId{
+					Owner:  argOwner,
+					Number: argNumber,
+				},
+				argClobPairId,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,174 @@
+package clob_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var (
+	BatchPlaceOrder_Alice_Num0_Clob0_Clob1_Buy5_Price10_GTB20 = *clobtypes.NewMsgBatchPlaceOrder(
+		constants.Alice_Num0,
+		[]clobtypes.Order{
+			PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order,
+			PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order,
+		},
+	)
+)
+
+func TestBatchPlaceOrder(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	// Place a resting sell order which will be matched by the first order of the batch.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20,
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		BatchPlaceOrder_Alice_Num0_Clob0_Clob1_Buy5_Price10_GTB20,
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+
+	// Both orders of the batch are placed on the memclob.
+	clob0OrderId := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order.OrderId
+	clob1OrderId := PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order.OrderId
+	_, exists := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob1OrderId)
+	require.True(t, exists)
+
+	// The matched order of the batch is proposed by referencing its index in the batch transaction.
+	proposedOperationsTx, err := tApp.App.TxConfig().TxDecoder()(tApp.GetProposedOperationsTx())
+	require.NoError(t, err)
+	proposedOperations := proposedOperationsTx.GetMsgs()[0].(*clobtypes.MsgProposedOperations)
+	require.Len(t, proposedOperations.OperationsQueue, 3)
+	batchPlacement := proposedOperations.OperationsQueue[1].GetShortTermOrderBatchPlacement()
+	require.NotNil(t, batchPlacement)
+	require.Equal(t, []uint32{0}, batchPlacement.OrderIndices)
+
+	// The proposed operations are accepted and the batch order is filled in state.
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+	_, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, clob0OrderId)
+	require.Equal(
+		t,
+		PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order.Quantums,
+		fillAmount.ToUint64(),
+	)
+
+	// The unmatched order of the batch is still on the memclob after replaying the operations queue.
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob0OrderId)
+	require.False(t, exists)
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob1OrderId)
+	require.True(t, exists)
+
+	// Placing the batch again only succeeds for the order which is no longer on the memclob.
+	newOrder := PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order
+	newOrder.OrderId.ClientId = 1
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		*clobtypes.NewMsgBatchPlaceOrder(
+			constants.Alice_Num0,
+			[]clobtypes.Order{
+				PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order,
+				newOrder,
+			},
+		),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, newOrder.OrderId)
+	require.True(t, exists)
+
+	// Placing a batch where every order fails is rejected.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		*clobtypes.NewMsgBatchPlaceOrder(
+			constants.Alice_Num0,
+			[]clobtypes.Order{
+				PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order,
+				newOrder,
+			},
+		),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsErr, "Expected CheckTx to error. Response: %+v", resp)
+		require.Equal(t, clobtypes.ErrBatchPlaceOrderFailed.ABCICode(), resp.Code)
+	}
+}
+
+func TestBatchPlaceOrder_RateLimitsAreEnforced(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).
+		WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+			genesis = testapp.DefaultGenesis()
+			testapp.UpdateGenesisDocWithAppStateForModule(
+				&genesis,
+				func(genesisState *clobtypes.GenesisState) {
+					genesisState.BlockRateLimitConfig = clobtypes.BlockRateLimitConfiguration{
+						MaxShortTermOrdersAndCancelsPerNBlocks: []clobtypes.MaxPerNBlocksRateLimit{
+							{
+								NumBlocks: 1,
+								Limit:     3,
+							},
+						},
+					}
+				},
+			)
+			testapp.UpdateGenesisDocWithAppStateForModule(
+				&genesis,
+				func(genesisState *satypes.GenesisState) {
+					genesisState.Subaccounts = []satypes.Subaccount{
+						constants.Alice_Num0_10_000USD,
+					}
+				})
+			return genesis
+		}).Build()
+	ctx := tApp.InitChain()
+
+	// Each order in the batch counts towards the rate limit, so the two order batch is allowed.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		BatchPlaceOrder_Alice_Num0_Clob0_Clob1_Buy5_Price10_GTB20,
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+
+	// A second two order batch exceeds the limit of three orders per block.
+	secondBatch := BatchPlaceOrder_Alice_Num0_Clob0_Clob1_Buy5_Price10_GTB20
+	secondBatch.ShortTermOrders = make([]clobtypes.Order, 0, 2)
+	for _, order := range BatchPlaceOrder_Alice_Num0_Clob0_Clob1_Buy5_Price10_GTB20.ShortTermOrders {
+		order.OrderId.ClientId = 1
+		secondBatch.ShortTermOrders = append(secondBatch.ShortTermOrders, order)
+	}
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, secondBatch) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsErr, "Expected CheckTx to error. Response: %+v", resp)
+		require.Equal(t, clobtypes.ErrBlockRateLimitExceeded.ABCICode(), resp.Code)
+		require.Contains(t, resp.Log, "exceeds configured block rate limit")
+	}
+
+	// The rate limit resets in the next block.
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, secondBatch) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+}
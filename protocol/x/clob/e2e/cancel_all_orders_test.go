@@ -0,0 +1,109 @@
+package clob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestCancelAllOrders(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	// Place a Long-Term order and a conditional order in separate blocks.
+	for _, statefulOrder := range []clobtypes.MsgPlaceOrder{
+		LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+		ConditionalPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+	} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, statefulOrder) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+		ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	}
+
+	// Place Short-Term orders on both clob pairs, along with an order of another subaccount.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+		PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20,
+		PlaceOrder_Alice_Num1_Id0_Clob0_Buy5_Price10_GTB20,
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+
+	longTermOrderId := LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.Order.OrderId
+	conditionalOrderId := ConditionalPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.Order.OrderId
+	clob0OrderId := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20.Order.OrderId
+	clob1OrderId := PlaceOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTB20.Order.OrderId
+	otherSubaccountOrderId := PlaceOrder_Alice_Num1_Id0_Clob0_Buy5_Price10_GTB20.Order.OrderId
+	for _, orderId := range []clobtypes.OrderId{longTermOrderId, clob0OrderId, clob1OrderId, otherSubaccountOrderId} {
+		_, exists := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, orderId)
+		require.True(t, exists)
+	}
+
+	// Canceling all orders on clob pair 1 only removes the Short-Term order on clob pair 1.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		*clobtypes.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{1}),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	_, exists := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob1OrderId)
+	require.False(t, exists)
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob0OrderId)
+	require.True(t, exists)
+
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	for _, orderId := range []clobtypes.OrderId{longTermOrderId, conditionalOrderId} {
+		_, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+		require.True(t, found)
+	}
+
+	// Canceling all orders on all clob pairs removes the remaining orders of the subaccount.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		*clobtypes.NewMsgCancelAllOrders(constants.Alice_Num0, nil),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, clob0OrderId)
+	require.False(t, exists)
+
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	for _, orderId := range []clobtypes.OrderId{longTermOrderId, conditionalOrderId} {
+		_, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+		require.False(t, found)
+	}
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, longTermOrderId)
+	require.False(t, exists)
+
+	// The order of the other subaccount is not canceled.
+	_, exists = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, otherSubaccountOrderId)
+	require.True(t, exists)
+}
+
+func TestCancelAllOrders_NonExistentClobPair(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+		ctx,
+		tApp.App,
+		*clobtypes.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{99}),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsErr, "Expected CheckTx to error. Response: %+v", resp)
+		require.Equal(t, clobtypes.ErrInvalidClobPairParameter.ABCICode(), resp.Code)
+	}
+}
@@ -0,0 +1,103 @@
+package clob_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestHeartbeat(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	goodTilBlockTime := lib.MustConvertIntegerToUint32(ctx.BlockTime().Add(time.Hour).Unix())
+	alice0Order := LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5
+	alice0Order.Order.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: goodTilBlockTime}
+	bob0Order := alice0Order
+	bob0Order.Order.OrderId.SubaccountId = constants.Bob_Num0
+
+	// Place a Long-Term order for two subaccounts.
+	for _, order := range []clobtypes.MsgPlaceOrder{alice0Order, bob0Order} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, order) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	heartbeat := func(blockTime time.Time) {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithSdkMsg(
+			ctx,
+			tApp.App,
+			testapp.MustMakeCheckTxOptions{
+				AccAddressForSigning: constants.Alice_Num0.Owner,
+				Gas:                  1_000_000,
+				FeeAmt:               constants.TestFeeCoins_5Cents,
+			},
+			clobtypes.NewMsgHeartbeat(constants.Alice_Num0, 60),
+		) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+		ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{
+			BlockTime: blockTime,
+		})
+	}
+
+	// Arm the dead man's switch of the first subaccount.
+	startTime := ctx.BlockTime().Add(time.Second)
+	heartbeat(startTime)
+	subaccountHeartbeat, found := tApp.App.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(
+		t,
+		clobtypes.SubaccountHeartbeat{
+			TimeoutSeconds: 60,
+			Deadline:       lib.MustConvertIntegerToUint32(startTime.Add(60 * time.Second).Unix()),
+		},
+		subaccountHeartbeat,
+	)
+
+	// Refreshing the heartbeat moves the deadline, so the orders are not canceled at the original deadline.
+	heartbeat(startTime.Add(30 * time.Second))
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{
+		BlockTime: startTime.Add(60 * time.Second),
+	})
+	_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, alice0Order.Order.OrderId)
+	require.True(t, found)
+
+	// Place a Short-Term order for the first subaccount.
+	shortTermOrder := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, shortTermOrder) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, shortTermOrder.Order.OrderId)
+	require.True(t, found)
+
+	// Once the refreshed deadline passes, the orders of the first subaccount are canceled, including
+	// its Short-Term orders.
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{
+		BlockTime: startTime.Add(90 * time.Second),
+	})
+	_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, alice0Order.Order.OrderId)
+	require.False(t, found)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, alice0Order.Order.OrderId)
+	require.False(t, found)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, shortTermOrder.Order.OrderId)
+	require.False(t, found)
+	_, found = tApp.App.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.False(t, found)
+
+	// The orders of the other subaccount are not canceled.
+	_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, bob0Order.Order.OrderId)
+	require.True(t, found)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, bob0Order.Order.OrderId)
+	require.True(t, found)
+}
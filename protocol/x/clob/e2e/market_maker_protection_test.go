@@ -0,0 +1,175 @@
+package clob_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+func TestMarketMakerProtection(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	deliverAliceSdkMsg := func(msg sdk.Msg) {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithSdkMsg(
+			ctx,
+			tApp.App,
+			testapp.MustMakeCheckTxOptions{
+				AccAddressForSigning: constants.Alice_Num0.Owner,
+				Gas:                  1_000_000,
+				FeeAmt:               constants.TestFeeCoins_5Cents,
+			},
+			msg,
+		) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+		ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	}
+	placeOrders := func(orders ...clobtypes.MsgPlaceOrder) {
+		for _, order := range orders {
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, order) {
+				resp := tApp.CheckTx(checkTx)
+				require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+		}
+		ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	}
+
+	goodTilBlockTime := lib.MustConvertIntegerToUint32(ctx.BlockTime().Add(time.Hour).Unix())
+	filledOrder := LongTermPlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5
+	filledOrder.Order.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{GoodTilBlockTime: goodTilBlockTime}
+	shortTermOrder := *clobtypes.NewMsgPlaceOrder(testapp.MustScaleOrder(
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price5_GTB20,
+		testapp.DefaultGenesis(),
+	))
+	shortTermOrder.Order.OrderId.ClientId = 1
+	restingOrder := filledOrder
+	restingOrder.Order.OrderId.ClientId = 2
+	restingOrder.Order.Subticks = shortTermOrder.Order.Subticks
+
+	// Enable market-maker protection of Alice on clob pair 0 such that a single fill trips it.
+	config := clobtypes.MarketMakerProtectionConfig{
+		WindowBlocks:      10,
+		MaxFilledQuantums: filledOrder.Order.Quantums,
+		FreezeBlocks:      5,
+	}
+	deliverAliceSdkMsg(clobtypes.NewMsgSetMarketMakerProtectionConfig(constants.Alice_Num0, 0, config))
+	gotConfig, found := tApp.App.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0)
+	require.True(t, found)
+	require.Equal(t, config, gotConfig)
+
+	// Alice quotes with two Long-Term orders and a Short-Term order.
+	placeOrders(filledOrder, shortTermOrder)
+	placeOrders(restingOrder)
+
+	// Bob fills the first order of Alice, which trips market-maker protection.
+	placeOrders(PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20)
+	trippedBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	require.Equal(
+		t,
+		clobtypes.MarketMakerProtectionState{
+			WindowStartBlock: trippedBlockHeight,
+			FrozenUntilBlock: trippedBlockHeight + config.FreezeBlocks,
+		},
+		tApp.App.ClobKeeper.GetMarketMakerProtectionState(ctx, constants.Alice_Num0, 0),
+	)
+
+	// All remaining orders of Alice on the clob pair are removed.
+	for _, orderId := range []clobtypes.OrderId{filledOrder.Order.OrderId, restingOrder.Order.OrderId} {
+		_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+		require.False(t, found)
+	}
+	for _, order := range []clobtypes.MsgPlaceOrder{shortTermOrder, restingOrder} {
+		_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, order.Order.OrderId)
+		require.False(t, found)
+	}
+
+	// New orders of Alice on the clob pair are rejected.
+	newOrder := shortTermOrder
+	newOrder.Order.OrderId.ClientId = 3
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, newOrder) {
+		resp := tApp.CheckTx(checkTx)
+		require.True(t, resp.IsErr())
+		require.Contains(t, resp.Log, clobtypes.ErrMarketMakerProtectionTripped.Error())
+	}
+
+	// Once Alice resets market-maker protection, new orders are accepted again.
+	deliverAliceSdkMsg(clobtypes.NewMsgResetMarketMakerProtection(constants.Alice_Num0, 0))
+	placeOrders(newOrder)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, newOrder.Order.OrderId)
+	require.True(t, found)
+}
+
+func TestMarketMakerProtection_TrippedMakerIsNotFilled(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	filledOrder := PlaceOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	restingOrder := filledOrder
+	restingOrder.Order.OrderId.ClientId = 1
+	takerOrder := PlaceOrder_Bob_Num0_Id0_Clob0_Sell5_Price10_GTB20
+	takerOrder.Order.Quantums = filledOrder.Order.Quantums + restingOrder.Order.Quantums
+
+	// Enable market-maker protection of Alice on clob pair 0 such that a single fill trips it.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithSdkMsg(
+		ctx,
+		tApp.App,
+		testapp.MustMakeCheckTxOptions{
+			AccAddressForSigning: constants.Alice_Num0.Owner,
+			Gas:                  1_000_000,
+			FeeAmt:               constants.TestFeeCoins_5Cents,
+		},
+		clobtypes.NewMsgSetMarketMakerProtectionConfig(
+			constants.Alice_Num0,
+			0,
+			clobtypes.MarketMakerProtectionConfig{
+				WindowBlocks:      10,
+				MaxFilledQuantums: filledOrder.Order.Quantums,
+				FreezeBlocks:      5,
+			},
+		),
+	) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	// Alice quotes with two Short-Term orders at the same price.
+	for _, order := range []clobtypes.MsgPlaceOrder{filledOrder, restingOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, order) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+
+	// Bob crosses both orders of Alice. The first fill trips market-maker protection, so the second
+	// order of Alice is not filled and is removed from the memclob immediately.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, takerOrder) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	_, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, restingOrder.Order.OrderId)
+	require.False(t, found)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, takerOrder.Order.OrderId)
+	require.True(t, found)
+
+	// The proposed block only fills the first order of Alice.
+	ctx = tApp.AdvanceToBlock(uint32(ctx.BlockHeight())+1, testapp.AdvanceToBlockOptions{})
+	exists, fillAmount, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, filledOrder.Order.OrderId)
+	require.True(t, exists)
+	require.Equal(t, filledOrder.Order.GetBaseQuantums(), fillAmount)
+	exists, _, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, restingOrder.Order.OrderId)
+	require.False(t, exists)
+	exists, fillAmount, _ = tApp.App.ClobKeeper.GetOrderFillAmount(ctx, takerOrder.Order.OrderId)
+	require.True(t, exists)
+	require.Equal(t, filledOrder.Order.GetBaseQuantums(), fillAmount)
+}
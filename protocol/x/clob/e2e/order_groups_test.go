@@ -0,0 +1,147 @@
+package clob_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetiertypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderGroupCancellation(t *testing.T) {
+	takeProfit := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_TP_49700
+	takeProfit.OrderGroupId = 1
+	takeProfit.OrderGroupType = clobtypes.Order_ORDER_GROUP_TYPE_OCO
+
+	stopLoss := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10_SL_50300
+	stopLoss.OrderId.ClientId = 1
+	stopLoss.OrderGroupId = 1
+	stopLoss.OrderGroupType = clobtypes.Order_ORDER_GROUP_TYPE_OCO
+
+	tests := map[string]struct {
+		// Whether the cancellation is included in the same block as the order placements.
+		cancelInPlacementBlock bool
+	}{
+		"Cancelling an order cancels the other order of its OCO order group": {
+			cancelInPlacementBlock: false,
+		},
+		"Cancelling an order in the block it is placed cancels the other order of its OCO order group": {
+			cancelInPlacementBlock: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							constants.Alice_Num0_100_000USD,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *prices.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{
+							constants.ClobPair_Btc,
+						}
+						genesisState.LiquidationsConfig = clobtypes.LiquidationsConfig_Default
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *feetiertypes.GenesisState) {
+						genesisState.Params = constants.PerpetualFeeParamsNoFee
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+
+			// Place both orders of the order group.
+			for _, order := range []clobtypes.Order{takeProfit, stopLoss} {
+				for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+					ctx,
+					tApp.App,
+					*clobtypes.NewMsgPlaceOrder(order),
+				) {
+					resp := tApp.CheckTx(checkTx)
+					require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+				}
+			}
+
+			if !tc.cancelInPlacementBlock {
+				ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+				// Verify both orders are in the order group.
+				resp, err := tApp.App.ClobKeeper.StatefulOrder(
+					ctx,
+					&clobtypes.QueryStatefulOrderRequest{OrderId: stopLoss.OrderId},
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&clobtypes.OrderGroup{
+						Type:     clobtypes.Order_ORDER_GROUP_TYPE_OCO,
+						OrderIds: []clobtypes.OrderId{takeProfit.OrderId, stopLoss.OrderId},
+					},
+					resp.OrderGroup,
+				)
+			}
+
+			// Cancel the take profit order.
+			for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+				ctx,
+				tApp.App,
+				*clobtypes.NewMsgCancelOrderStateful(
+					takeProfit.OrderId,
+					lib.MustConvertIntegerToUint32(
+						time.Unix(ctx.BlockTime().Unix(), 0).Add(clobtypes.StatefulOrderTimeWindow).Unix(),
+					),
+				),
+			) {
+				resp := tApp.CheckTx(checkTx)
+				require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+			}
+
+			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+			// Verify both orders were removed from state and neither can be triggered.
+			for _, order := range []clobtypes.Order{takeProfit, stopLoss} {
+				_, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+				require.False(t, found)
+			}
+			_, found := tApp.App.ClobKeeper.GetOrderGroup(ctx, constants.Alice_Num0, 1)
+			require.False(t, found)
+			require.NotContains(t, tApp.App.ClobKeeper.UntriggeredConditionalOrders, clobtypes.ClobPairId(0))
+		})
+	}
+}
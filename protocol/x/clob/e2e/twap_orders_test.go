@@ -0,0 +1,174 @@
+package clob_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	feetiertypes "github.com/dydxprotocol/v4-chain/protocol/x/feetiers/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	prices "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newTwapOrdersTestApp returns a test app with a BTC clob pair and two subaccounts with 100,000 USD each.
+func newTwapOrdersTestApp(t *testing.T) *testapp.TestApp {
+	return testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					constants.Alice_Num0_100_000USD,
+					constants.Bob_Num0_100_000USD,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *prices.GenesisState) {
+				*genesisState = constants.TestPricesGenesisState
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *perptypes.GenesisState) {
+				genesisState.Params = constants.PerpetualsGenesisParams
+				genesisState.LiquidityTiers = constants.LiquidityTiers
+				genesisState.Perpetuals = []perptypes.Perpetual{
+					constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+				}
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *clobtypes.GenesisState) {
+				genesisState.ClobPairs = []clobtypes.ClobPair{
+					constants.ClobPair_Btc,
+				}
+				genesisState.LiquidationsConfig = clobtypes.LiquidationsConfig_Default
+			},
+		)
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *feetiertypes.GenesisState) {
+				genesisState.Params = constants.PerpetualFeeParamsNoFee
+			},
+		)
+		return genesis
+	}).Build()
+}
+
+func TestTwapOrderExecution(t *testing.T) {
+	tApp := newTwapOrdersTestApp(t)
+	ctx := tApp.InitChain()
+
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
+	twapOrder.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{
+		GoodTilBlockTime: lib.MustConvertIntegerToUint32(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+	}
+
+	// Place a resting order for the TWAP order to match against, followed by the TWAP order.
+	for _, order := range []clobtypes.Order{constants.Order_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10, twapOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+			ctx,
+			tApp.App,
+			*clobtypes.NewMsgPlaceOrder(order),
+		) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+
+	// The TWAP order is placed in block 2, which generates its first suborder. The suborder is matched
+	// in block 3.
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+	firstSuborderTime := lib.MustConvertIntegerToUint32(ctx.BlockTime().Unix())
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+	resp, err := tApp.App.ClobKeeper.StatefulOrder(
+		ctx,
+		&clobtypes.QueryStatefulOrderRequest{OrderId: twapOrder.OrderId},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20_000_000), resp.FillAmount)
+	require.Equal(
+		t,
+		&clobtypes.TwapOrderState{RemainingLegs: 4, NextSuborderTime: firstSuborderTime + 60},
+		resp.TwapOrderState,
+	)
+
+	// The TWAP order is never placed on the memclob.
+	_, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, twapOrder.OrderId)
+	require.False(t, found)
+
+	// The next suborder is generated once it is due.
+	ctx = tApp.AdvanceToBlock(4, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(int64(firstSuborderTime+60), 0),
+	})
+	ctx = tApp.AdvanceToBlock(5, testapp.AdvanceToBlockOptions{})
+
+	resp, err = tApp.App.ClobKeeper.StatefulOrder(
+		ctx,
+		&clobtypes.QueryStatefulOrderRequest{OrderId: twapOrder.OrderId},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40_000_000), resp.FillAmount)
+	require.Equal(
+		t,
+		&clobtypes.TwapOrderState{RemainingLegs: 3, NextSuborderTime: firstSuborderTime + 120},
+		resp.TwapOrderState,
+	)
+}
+
+func TestTwapSuborderUnfilledSizeIsRemoved(t *testing.T) {
+	tApp := newTwapOrdersTestApp(t)
+	ctx := tApp.InitChain()
+
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
+	twapOrder.GoodTilOneof = &clobtypes.Order_GoodTilBlockTime{
+		GoodTilBlockTime: lib.MustConvertIntegerToUint32(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+	}
+	// The resting order only fills half of the first suborder.
+	restingOrder := constants.Order_Bob_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10
+	restingOrder.Quantums = 10_000_000
+
+	for _, order := range []clobtypes.Order{restingOrder, twapOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(
+			ctx,
+			tApp.App,
+			*clobtypes.NewMsgPlaceOrder(order),
+		) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+
+	// The first suborder is generated in block 2 and partially matched in block 3. Its unfilled size
+	// would rest on the book, so the suborder is removed.
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+	suborderId := twapOrder.OrderId.GetTwapSuborderId()
+	_, found := tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.True(t, found)
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+	_, found = tApp.App.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.False(t, found)
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, suborderId)
+	require.False(t, found)
+
+	// The TWAP order keeps the fill of its suborder and its remaining legs.
+	resp, err := tApp.App.ClobKeeper.StatefulOrder(
+		ctx,
+		&clobtypes.QueryStatefulOrderRequest{OrderId: twapOrder.OrderId},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000_000), resp.FillAmount)
+	require.Equal(t, uint32(4), resp.TwapOrderState.RemainingLegs)
+}
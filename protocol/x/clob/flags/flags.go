@@ -18,6 +18,8 @@ type ClobFlags struct {
 	MevTelemetryEnabled    bool
 	MevTelemetryHosts      []string
 	MevTelemetryIdentifier string
+
+	OrderbookQueriesEnabled bool
 }
 
 // List of CLI flags.
@@ -31,6 +33,9 @@ const (
 	MevTelemetryEnabled    = "mev-telemetry-enabled"
 	MevTelemetryHosts      = "mev-telemetry-hosts"
 	MevTelemetryIdentifier = "mev-telemetry-identifier"
+
+	// Orderbook queries.
+	OrderbookQueriesEnabled = "orderbook-queries-enabled"
 )
 
 // Default values.
@@ -43,6 +48,8 @@ const (
 	DefaultMevTelemetryEnabled    = false
 	DefaultMevTelemetryHostsFlag  = ""
 	DefaultMevTelemetryIdentifier = ""
+
+	DefaultOrderbookQueriesEnabled = false
 )
 
 var DefaultMevTelemetryHosts = []string{}
@@ -90,6 +97,11 @@ func AddClobFlagsToCmd(cmd *cobra.Command) {
 		DefaultMevTelemetryIdentifier,
 		"Sets the identifier to use for MEV Telemetry collection agents.",
 	)
+	cmd.Flags().Bool(
+		OrderbookQueriesEnabled,
+		DefaultOrderbookQueriesEnabled,
+		"Captures orderbook snapshots after every block to serve the orderbook and simulate order queries if true.",
+	)
 }
 
 func GetDefaultClobFlags() ClobFlags {
@@ -100,6 +112,7 @@ func GetDefaultClobFlags() ClobFlags {
 		MevTelemetryEnabled:                 DefaultMevTelemetryEnabled,
 		MevTelemetryHosts:                   DefaultMevTelemetryHosts,
 		MevTelemetryIdentifier:              DefaultMevTelemetryIdentifier,
+		OrderbookQueriesEnabled:             DefaultOrderbookQueriesEnabled,
 	}
 }
 
@@ -130,6 +143,12 @@ func GetClobFlagValuesFromOptions(
 		}
 	}
 
+	if option := appOpts.Get(OrderbookQueriesEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.OrderbookQueriesEnabled = v
+		}
+	}
+
 	if option := appOpts.Get(MaxLiquidationAttemptsPerBlock); option != nil {
 		if v, err := cast.ToUint32E(option); err == nil {
 			result.MaxLiquidationAttemptsPerBlock = v
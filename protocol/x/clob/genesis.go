@@ -15,22 +15,49 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 
 	// Create all `ClobPair` structs.
 	for _, elem := range genState.ClobPairs {
-		perpetualId, err := elem.GetPerpetualId()
-		if err != nil {
-			panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, err.Error()))
+		var err error
+		switch elem.Metadata.(type) {
+		case *types.ClobPair_SpotClobMetadata:
+			baseAssetId, quoteAssetId := elem.MustGetSpotAssetIds()
+			_, err = k.CreateSpotClobPair(
+				ctx,
+				elem.Id,
+				baseAssetId,
+				quoteAssetId,
+				satypes.BaseQuantums(elem.StepBaseQuantums),
+				elem.QuantumConversionExponent,
+				elem.SubticksPerTick,
+				elem.Status,
+			)
+		default:
+			perpetualId, perpErr := elem.GetPerpetualId()
+			if perpErr != nil {
+				panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, perpErr.Error()))
+			}
+			_, err = k.CreatePerpetualClobPair(
+				ctx,
+				elem.Id,
+				perpetualId,
+				satypes.BaseQuantums(elem.StepBaseQuantums),
+				elem.QuantumConversionExponent,
+				elem.SubticksPerTick,
+				elem.Status,
+			)
 		}
-		_, err = k.CreatePerpetualClobPair(
-			ctx,
-			elem.Id,
-			perpetualId,
-			satypes.BaseQuantums(elem.StepBaseQuantums),
-			elem.QuantumConversionExponent,
-			elem.SubticksPerTick,
-			elem.Status,
-		)
 		if err != nil {
 			panic(err)
 		}
+
+		if elem.PriceBandConfig != nil || elem.CircuitBreakerConfig != nil {
+			if err := k.SetClobPairPriceProtectionConfigs(
+				ctx,
+				elem.GetClobPairId(),
+				elem.PriceBandConfig,
+				elem.CircuitBreakerConfig,
+			); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// Create the `LiquidationsConfig` in state, and panic if the genesis state is invalid.
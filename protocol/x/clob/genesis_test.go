@@ -1,6 +1,7 @@
 package clob_test
 
 import (
+	"fmt"
 	"testing"
 
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +25,19 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
+	spotClobPairWithNonUsdcQuote := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  0,
+				QuoteAssetId: 1,
+			},
+		},
+		Id:               uint32(0),
+		StepBaseQuantums: 5,
+		SubticksPerTick:  5,
+		Status:           types.ClobPair_STATUS_ACTIVE,
+	}
+
 	tests := map[string]struct {
 		// Genesis state.
 		genesis types.GenesisState
@@ -202,21 +216,10 @@ func TestGenesis(t *testing.T) {
 			expectedErr:     "Asset orders are not implemented",
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
-		"Genesis state is invalid when there is a spot metadata on a CLOB pair": {
+		"Genesis state is invalid when a spot CLOB pair has a non-USDC quote asset": {
 			genesis: types.GenesisState{
 				ClobPairs: []types.ClobPair{
-					{
-						Metadata: &types.ClobPair_SpotClobMetadata{
-							SpotClobMetadata: &types.SpotClobMetadata{
-								BaseAssetId:  0,
-								QuoteAssetId: 1,
-							},
-						},
-						Id:               uint32(0),
-						StepBaseQuantums: 5,
-						SubticksPerTick:  5,
-						Status:           types.ClobPair_STATUS_ACTIVE,
-					},
+					spotClobPairWithNonUsdcQuote,
 					{
 						Metadata: &types.ClobPair_PerpetualClobMetadata{
 							PerpetualClobMetadata: &types.PerpetualClobMetadata{
@@ -239,7 +242,10 @@ func TestGenesis(t *testing.T) {
 					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
 				},
 			},
-			expectedErr:     "Asset orders are not implemented",
+			expectedErr: fmt.Sprintf(
+				"CLOB pair (%+v) has unsupported quote asset 1, only USDC is supported.",
+				&spotClobPairWithNonUsdcQuote,
+			),
 			expectedErrType: types.ErrInvalidClobPairParameter,
 		},
 		"Genesis state is invalid when spread to maintenance margin ratio ppm is 0": {
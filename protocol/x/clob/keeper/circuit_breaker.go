@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getCircuitBreakerStateStore fetches a state store used for creating, reading, updating, and deleting
+// the circuit breaker state of clob pairs from state.
+func (k Keeper) getCircuitBreakerStateStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.CircuitBreakerStateKeyPrefix),
+	)
+}
+
+// GetCircuitBreakerState gets the circuit breaker state of a clob pair from state. Returns an empty
+// state if none exists.
+func (k Keeper) GetCircuitBreakerState(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (val types.CircuitBreakerState) {
+	store := k.getCircuitBreakerStateStore(ctx)
+
+	b := store.Get(clobPairKey(clobPairId))
+	if b == nil {
+		return val
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// setCircuitBreakerState sets the circuit breaker state of a clob pair in state.
+func (k Keeper) setCircuitBreakerState(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	state types.CircuitBreakerState,
+) {
+	store := k.getCircuitBreakerStateStore(ctx)
+	store.Set(clobPairKey(clobPairId), k.cdc.MustMarshal(&state))
+}
+
+// deleteCircuitBreakerState deletes the circuit breaker state of a clob pair from state.
+func (k Keeper) deleteCircuitBreakerState(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) {
+	store := k.getCircuitBreakerStateStore(ctx)
+	store.Delete(clobPairKey(clobPairId))
+}
+
+// isTrippedByCircuitBreaker returns true if the clob pair has the tripped status of its circuit breaker
+// because its circuit breaker is tripped. Liquidations remain enabled for such clob pairs, since the
+// circuit breaker protects against erratic trading rather than halting the market.
+func (k Keeper) isTrippedByCircuitBreaker(ctx sdk.Context, clobPair types.ClobPair) bool {
+	if clobPair.Status != types.ClobPair_STATUS_CANCEL_ONLY && clobPair.Status != types.ClobPair_STATUS_POST_ONLY {
+		return false
+	}
+	return k.GetCircuitBreakerState(ctx, clobPair.GetClobPairId()).IsTripped()
+}
+
+// UpdateCircuitBreakers updates the circuit breakers of all clob pairs based on their current oracle
+// price. This is called in the `EndBlocker`, after the oracle prices of the block were updated.
+//
+// For every clob pair with an enabled circuit breaker:
+//   - If the circuit breaker is tripped and its cooldown has elapsed, the status of the clob pair before
+//     the trip is restored.
+//   - If the clob pair is active, its oracle price is added to the trailing window of the last
+//     `WindowBlocks` blocks. If the oracle price changed by more than the configured maximum from the
+//     minimum or the maximum oracle price within the trailing window, the circuit breaker is tripped and
+//     the clob pair transitions to the configured tripped status.
+func (k Keeper) UpdateCircuitBreakers(ctx sdk.Context) {
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		config := clobPair.CircuitBreakerConfig
+		if !config.IsEnabled() {
+			continue
+		}
+
+		clobPairId := clobPair.GetClobPairId()
+		state := k.GetCircuitBreakerState(ctx, clobPairId)
+		if state.IsTripped() {
+			if blockHeight <= state.TrippedUntilBlock {
+				continue
+			}
+
+			k.setClobPairStatusFromCircuitBreaker(ctx, clobPair, state.RestoreStatus)
+			k.deleteCircuitBreakerState(ctx, clobPairId)
+			log.InfoLog(
+				ctx,
+				"Circuit breaker restored clob pair status",
+				log.ClobPairId, clobPairId,
+				"clobPairStatus", state.RestoreStatus,
+			)
+			metrics.IncrCounterWithLabels(
+				metrics.ClobCircuitBreakerRestored,
+				1,
+				metrics.GetLabelForIntValue(metrics.ClobPairId, int(clobPairId)),
+			)
+			continue
+		}
+
+		// Only active clob pairs are tracked, such that the window is empty once a clob pair becomes active.
+		if clobPair.Status != types.ClobPair_STATUS_ACTIVE {
+			k.deleteCircuitBreakerState(ctx, clobPairId)
+			continue
+		}
+
+		oraclePriceSubticks := lib.BigRatRound(k.GetOraclePriceSubticksRat(ctx, clobPair), false).Uint64()
+		state.AddOraclePrice(config.WindowBlocks, blockHeight, oraclePriceSubticks)
+		if config.ExceedsMaxWindowOraclePriceChange(state, oraclePriceSubticks) {
+			k.setClobPairStatusFromCircuitBreaker(ctx, clobPair, config.TrippedStatus)
+			k.setCircuitBreakerState(
+				ctx,
+				clobPairId,
+				types.CircuitBreakerState{
+					TrippedUntilBlock: blockHeight + config.CooldownBlocks,
+					RestoreStatus:     clobPair.Status,
+				},
+			)
+			log.InfoLog(
+				ctx,
+				"Circuit breaker tripped",
+				log.ClobPairId, clobPairId,
+				"oraclePriceSubticks", oraclePriceSubticks,
+				"windowMinOraclePriceSubticks", state.WindowMinOraclePrices[0].OraclePriceSubticks,
+				"windowMaxOraclePriceSubticks", state.WindowMaxOraclePrices[0].OraclePriceSubticks,
+			)
+			metrics.IncrCounterWithLabels(
+				metrics.ClobCircuitBreakerTripped,
+				1,
+				metrics.GetLabelForIntValue(metrics.ClobPairId, int(clobPairId)),
+			)
+			continue
+		}
+
+		k.setCircuitBreakerState(ctx, clobPairId, state)
+	}
+}
+
+// setClobPairStatusFromCircuitBreaker sets the status of a clob pair in state and sends an update to the
+// indexer. Unlike `UpdateClobPair`, the status transition is not validated since circuit breakers only
+// transition between the active status and the configured tripped status.
+func (k Keeper) setClobPairStatusFromCircuitBreaker(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	status types.ClobPair_Status,
+) {
+	clobPair.Status = status
+	k.setClobPair(ctx, clobPair)
+
+	k.GetIndexerEventManager().AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeUpdateClobPair,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.UpdateClobPairEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewUpdateClobPairEvent(
+				clobPair.GetClobPairId(),
+				clobPair.Status,
+				clobPair.QuantumConversionExponent,
+				types.SubticksPerTick(clobPair.GetSubticksPerTick()),
+				satypes.BaseQuantums(clobPair.GetStepBaseQuantums()),
+			),
+		),
+	)
+}
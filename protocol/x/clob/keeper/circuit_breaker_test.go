@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
+	"github.com/dydxprotocol/v4-chain/protocol/x/prices"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCircuitBreakers(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockIndexerEventManager.On(
+		"AddBlockEvent",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+	keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+
+	config := &types.CircuitBreakerConfig{
+		MaxOraclePriceChangePpm: 100_000,
+		WindowBlocks:            5,
+		CooldownBlocks:          3,
+		TrippedStatus:           types.ClobPair_STATUS_CANCEL_ONLY,
+	}
+	require.NoError(t, ks.ClobKeeper.SetClobPairPriceProtectionConfigs(ks.Ctx, 0, nil, config))
+
+	marketPrice, err := ks.PricesKeeper.GetMarketPrice(ks.Ctx, 0)
+	require.NoError(t, err)
+	updateBtcPrice := func(price uint64) {
+		require.NoError(
+			t,
+			ks.PricesKeeper.UpdateMarketPrices(
+				ks.Ctx,
+				[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: price}},
+			),
+		)
+	}
+	updateCircuitBreakersAtBlock := func(blockHeight int64) types.ClobPair {
+		ks.Ctx = ks.Ctx.WithBlockHeight(blockHeight)
+		ks.ClobKeeper.UpdateCircuitBreakers(ks.Ctx)
+		clobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, 0)
+		require.True(t, found)
+		return clobPair
+	}
+
+	// The first update adds the current oracle price to the trailing window.
+	clobPair := updateCircuitBreakersAtBlock(10)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+	state := ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0)
+	require.Len(t, state.WindowMinOraclePrices, 1)
+	require.Equal(t, uint32(10), state.WindowMinOraclePrices[0].Block)
+	require.NotZero(t, state.WindowMinOraclePrices[0].OraclePriceSubticks)
+	require.Equal(t, state.WindowMinOraclePrices, state.WindowMaxOraclePrices)
+
+	// A price change within the max does not trip the circuit breaker.
+	updateBtcPrice(marketPrice.Price + marketPrice.Price/20)
+	clobPair = updateCircuitBreakersAtBlock(11)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+
+	// Once the initial oracle price leaves the trailing window, price changes are measured from the
+	// oracle prices within the trailing window only.
+	for blockHeight := int64(12); blockHeight <= 16; blockHeight++ {
+		clobPair = updateCircuitBreakersAtBlock(blockHeight)
+		require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+	}
+	updateBtcPrice(marketPrice.Price + marketPrice.Price*3/20)
+	clobPair = updateCircuitBreakersAtBlock(17)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+
+	// A price change above the max from the minimum oracle price within the trailing window trips the
+	// circuit breaker.
+	updateBtcPrice(marketPrice.Price + marketPrice.Price/5)
+	clobPair = updateCircuitBreakersAtBlock(18)
+	require.Equal(t, types.ClobPair_STATUS_CANCEL_ONLY, clobPair.Status)
+	require.Equal(
+		t,
+		types.CircuitBreakerState{
+			TrippedUntilBlock: 21,
+			RestoreStatus:     types.ClobPair_STATUS_ACTIVE,
+		},
+		ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0),
+	)
+
+	// The clob pair stays in the tripped status until the cooldown elapsed.
+	clobPair = updateCircuitBreakersAtBlock(21)
+	require.Equal(t, types.ClobPair_STATUS_CANCEL_ONLY, clobPair.Status)
+
+	// The status is restored once the cooldown elapsed.
+	clobPair = updateCircuitBreakersAtBlock(22)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+	require.Equal(t, types.CircuitBreakerState{}, ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0))
+
+	// The trailing window restarts at the current oracle price.
+	clobPair = updateCircuitBreakersAtBlock(23)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+	state = ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0)
+	require.Len(t, state.WindowMinOraclePrices, 1)
+	require.Equal(t, uint32(23), state.WindowMinOraclePrices[0].Block)
+
+	// Disabling the circuit breaker stops tracking the clob pair.
+	require.NoError(t, ks.ClobKeeper.SetClobPairPriceProtectionConfigs(ks.Ctx, 0, nil, nil))
+	require.Equal(t, types.CircuitBreakerState{}, ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0))
+	updateBtcPrice(marketPrice.Price * 2)
+	clobPair = updateCircuitBreakersAtBlock(24)
+	require.Equal(t, types.ClobPair_STATUS_ACTIVE, clobPair.Status)
+	require.Equal(t, types.CircuitBreakerState{}, ks.ClobKeeper.GetCircuitBreakerState(ks.Ctx, 0))
+}
+
+func TestSetClobPairPriceProtectionConfigs_Invalid(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	err := ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+		ks.Ctx,
+		0,
+		&types.PriceBandConfig{BandPpm: 50_000},
+		nil,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidClobPairParameter)
+
+	err = ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+		ks.Ctx,
+		0,
+		nil,
+		&types.CircuitBreakerConfig{MaxOraclePriceChangePpm: 100_000},
+	)
+	require.ErrorIs(t, err, types.ErrInvalidClobPairParameter)
+}
@@ -113,10 +113,95 @@ func (k Keeper) CreatePerpetualClobPair(
 	return clobPair, nil
 }
 
+// CreateSpotClobPair creates a new spot CLOB pair in the store.
+// Additionally, it creates an order book matching the ID of the newly created CLOB pair.
+//
+// An error will occur if any of the fields fail validation (see validateClobPair for details),
+// or if the base and quote assets are already traded by an existing spot CLOB pair.
+// In the event of an error, the store will not be updated nor will a matching order book be created.
+//
+// Returns the newly created CLOB pair and an error if one occurs.
+func (k Keeper) CreateSpotClobPair(
+	ctx sdk.Context,
+	clobPairId uint32,
+	baseAssetId uint32,
+	quoteAssetId uint32,
+	stepSizeBaseQuantums satypes.BaseQuantums,
+	quantumConversionExponent int32,
+	subticksPerTick uint32,
+	status types.ClobPair_Status,
+) (types.ClobPair, error) {
+	// If the desired CLOB pair ID is already in use, return an error.
+	if clobPair, exists := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); exists {
+		return types.ClobPair{}, errorsmod.Wrapf(
+			types.ErrClobPairAlreadyExists,
+			"id=%v, existing clob pair=%v",
+			clobPairId,
+			clobPair,
+		)
+	}
+
+	// Verify the base and quote assets are not already traded by an existing CLOB pair.
+	for _, existingClobPair := range k.GetAllClobPairs(ctx) {
+		existingBaseAssetId, existingQuoteAssetId, err := existingClobPair.GetSpotAssetIds()
+		if err != nil {
+			continue
+		}
+		if existingBaseAssetId == baseAssetId && existingQuoteAssetId == quoteAssetId {
+			return types.ClobPair{}, errorsmod.Wrapf(
+				types.ErrSpotAssetsAssociatedWithExistingClobPair,
+				"base asset id=%v, quote asset id=%v, existing clob pair id=%v",
+				baseAssetId,
+				quoteAssetId,
+				existingClobPair.Id,
+			)
+		}
+	}
+
+	clobPair := types.ClobPair{
+		Metadata: &types.ClobPair_SpotClobMetadata{
+			SpotClobMetadata: &types.SpotClobMetadata{
+				BaseAssetId:  baseAssetId,
+				QuoteAssetId: quoteAssetId,
+			},
+		},
+		Id:                        clobPairId,
+		StepBaseQuantums:          stepSizeBaseQuantums.ToUint64(),
+		QuantumConversionExponent: quantumConversionExponent,
+		SubticksPerTick:           subticksPerTick,
+		Status:                    status,
+	}
+	if err := k.validateClobPair(ctx, &clobPair); err != nil {
+		return clobPair, err
+	}
+
+	k.createClobPair(ctx, clobPair)
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeSpotMarket,
+		indexerevents.SpotMarketEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewSpotMarketCreateEvent(
+				clobPairId,
+				baseAssetId,
+				quoteAssetId,
+				status,
+				quantumConversionExponent,
+				subticksPerTick,
+				stepSizeBaseQuantums.ToUint64(),
+			),
+		),
+	)
+
+	return clobPair, nil
+}
+
 // validateClobPair validates a CLOB pair's fields are suitable for CLOB pair creation.
 //
 // Stateful Validation:
-//   - Must be a perpetual CLOB pair with a perpetualId matching a perpetual in the store.
+//   - A perpetual CLOB pair must have a perpetualId matching a perpetual in the store.
+//   - A spot CLOB pair must have base and quote assets matching assets in the store, and the base
+//     asset must have a market so that an oracle price is available.
 //
 // Stateless Validation
 //   - `clobPair.Validate()` returns no error.
@@ -125,7 +210,6 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 		return err
 	}
 
-	// TODO(DEC-1535): update this validation when we implement "spot"/"asset" clob pairs.
 	switch clobPair.Metadata.(type) {
 	case *types.ClobPair_PerpetualClobMetadata:
 		perpetualId, err := clobPair.GetPerpetualId()
@@ -144,11 +228,47 @@ func (k Keeper) validateClobPair(ctx sdk.Context, clobPair *types.ClobPair) erro
 				clobPair,
 			)
 		}
+	case *types.ClobPair_SpotClobMetadata:
+		baseAssetId, quoteAssetId := clobPair.MustGetSpotAssetIds()
+		// Validate the assets referenced by the CLOB pair exist.
+		baseAsset, exists := k.assetsKeeper.GetAsset(ctx, baseAssetId)
+		if !exists {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has non-existent base asset %d.",
+				clobPair,
+				baseAssetId,
+			)
+		}
+		if _, exists := k.assetsKeeper.GetAsset(ctx, quoteAssetId); !exists {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has non-existent quote asset %d.",
+				clobPair,
+				quoteAssetId,
+			)
+		}
+		// The oracle price of the base asset is required for CLOB pairs in the initializing state.
+		if !baseAsset.HasMarket {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has base asset %d without a market.",
+				clobPair,
+				baseAssetId,
+			)
+		}
+		// Spot CLOB pairs have no positions to settle, so final settlement is not supported.
+		if clobPair.Status == types.ClobPair_STATUS_FINAL_SETTLEMENT {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) is a spot CLOB and cannot be in final settlement.",
+				clobPair,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidClobPairParameter,
-			// TODO(DEC-1535): update this error message when we implement "spot"/"asset" clob pairs.
-			"CLOB pair (%+v) is not a perpetual CLOB.",
+			"CLOB pair (%+v) is neither a perpetual nor a spot CLOB.",
 			clobPair,
 		)
 	}
@@ -197,6 +317,31 @@ func (k Keeper) setClobPair(ctx sdk.Context, clobPair types.ClobPair) {
 	store.Set(clobPairKey(clobPair.GetClobPairId()), b)
 }
 
+// SetClobPairPriceProtectionConfigs sets the price band and circuit breaker configs of an existing
+// `ClobPair` in state. Since `ClobPair`s are created without these configs, this is used to apply the
+// configs of newly created `ClobPair`s. Any circuit breaker state of the `ClobPair` is reset. Returns an
+// error if a config is invalid.
+func (k Keeper) SetClobPairPriceProtectionConfigs(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	priceBandConfig *types.PriceBandConfig,
+	circuitBreakerConfig *types.CircuitBreakerConfig,
+) error {
+	if err := priceBandConfig.Validate(); err != nil {
+		return err
+	}
+	if err := circuitBreakerConfig.Validate(); err != nil {
+		return err
+	}
+
+	clobPair := k.mustGetClobPair(ctx, clobPairId)
+	clobPair.PriceBandConfig = priceBandConfig
+	clobPair.CircuitBreakerConfig = circuitBreakerConfig
+	k.setClobPair(ctx, clobPair)
+	k.deleteCircuitBreakerState(ctx, clobPairId)
+	return nil
+}
+
 // InitMemClobOrderbooks initializes the memclob with `ClobPair`s from state.
 func (k Keeper) InitMemClobOrderbooks(ctx sdk.Context) {
 	clobPairs := k.GetAllClobPairs(ctx)
@@ -321,7 +466,8 @@ func (k Keeper) GetAllClobPairs(ctx sdk.Context) (list []types.ClobPair) {
 }
 
 // validateLiquidationAgainstClobPairStatus returns an error if placing the provided
-// liquidation order would conflict with the clob pair's current status.
+// liquidation order would conflict with the clob pair's current status. Liquidations are
+// only allowed for active clob pairs and clob pairs whose circuit breaker is tripped.
 func (k Keeper) validateLiquidationAgainstClobPairStatus(
 	ctx sdk.Context,
 	liquidationOrder types.LiquidationOrder,
@@ -344,7 +490,7 @@ func (k Keeper) validateLiquidationAgainstClobPairStatus(
 		)
 	}
 
-	if clobPair.Status != types.ClobPair_STATUS_ACTIVE {
+	if clobPair.Status != types.ClobPair_STATUS_ACTIVE && !k.isTrippedByCircuitBreaker(ctx, clobPair) {
 		return errorsmod.Wrapf(
 			types.ErrLiquidationConflictsWithClobPairStatus,
 			"Liquidation order %+v cannot be placed for clob pair with status %+v",
@@ -425,6 +571,24 @@ func (k Keeper) validateOrderAgainstClobPairStatus(
 				clobPair.Status,
 			)
 		}
+	case types.ClobPair_STATUS_CANCEL_ONLY:
+		// Reject all orders. Existing orders may only be canceled.
+		return errorsmod.Wrapf(
+			types.ErrOrderConflictsWithClobPairStatus,
+			"Order %+v disallowed, only cancellations are allowed for clob pair with status %+v",
+			order,
+			clobPair.Status,
+		)
+	case types.ClobPair_STATUS_POST_ONLY:
+		// Reject non-post-only orders, such that no matches can occur.
+		if order.TimeInForce != types.Order_TIME_IN_FORCE_POST_ONLY {
+			return errorsmod.Wrapf(
+				types.ErrOrderConflictsWithClobPairStatus,
+				"Order %+v must be post-only for clob pair with status %+v",
+				order,
+				clobPair.Status,
+			)
+		}
 	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
 		return errorsmod.Wrapf(
 			types.ErrOrderConflictsWithClobPairStatus,
@@ -484,27 +648,36 @@ func (k Keeper) UpdateClobPair(
 		)
 	}
 
-	// Note, only perpetual clob pairs are currently supported. Neither the old nor the
-	// new clob pair should be spot.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	oldPerpetualId, err := oldClobPair.GetPerpetualId()
-	if err != nil {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			err.Error(),
-		)
-	}
-	if perpetualId != oldPerpetualId {
-		return errorsmod.Wrap(
-			types.ErrInvalidClobPairUpdate,
-			"UpdateClobPair: cannot update ClobPair perpetual id",
-		)
+	if oldClobPair.IsSpotClobPair() {
+		// The traded assets of a spot clob pair cannot be updated, and it cannot become a perpetual clob pair.
+		if clobPair.GetSpotClobMetadata() == nil ||
+			*clobPair.GetSpotClobMetadata() != *oldClobPair.GetSpotClobMetadata() {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair spot metadata",
+			)
+		}
+	} else {
+		perpetualId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		oldPerpetualId, err := oldClobPair.GetPerpetualId()
+		if err != nil {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				err.Error(),
+			)
+		}
+		if perpetualId != oldPerpetualId {
+			return errorsmod.Wrap(
+				types.ErrInvalidClobPairUpdate,
+				"UpdateClobPair: cannot update ClobPair perpetual id",
+			)
+		}
 	}
 	if clobPair.StepBaseQuantums != oldClobPair.StepBaseQuantums {
 		return errorsmod.Wrapf(
@@ -542,6 +715,12 @@ func (k Keeper) UpdateClobPair(
 
 	k.setClobPair(ctx, clobPair)
 
+	// The circuit breaker stops tracking the clob pair if it is disabled or if the status of the clob pair is
+	// changed, such that the status is not restored by a circuit breaker tripped before the update.
+	if !clobPair.CircuitBreakerConfig.IsEnabled() || newStatus != oldStatus {
+		k.deleteCircuitBreakerState(ctx, clobPair.GetClobPairId())
+	}
+
 	// Send UpdateClobPair to indexer.
 	k.GetIndexerEventManager().AddTxnEvent(
 		ctx,
@@ -653,6 +832,21 @@ func (k Keeper) validateInternalOperationAgainstClobPairStatus(
 			clobPairId,
 			types.ClobPair_STATUS_INITIALIZING,
 		)
+	case types.ClobPair_STATUS_CANCEL_ONLY, types.ClobPair_STATUS_POST_ONLY:
+		// Matches other than deleveraging events are invalid since no trading happens. Liquidation
+		// matches are valid if the clob pair has the status because its circuit breaker is tripped.
+		// Order placements and removals are validated against the status like any other order.
+		match := internalOperation.GetMatch()
+		if match != nil && match.GetMatchPerpetualDeleveraging() == nil &&
+			(match.GetMatchPerpetualLiquidation() == nil || !k.isTrippedByCircuitBreaker(ctx, clobPair)) {
+			return errorsmod.Wrapf(
+				types.ErrOperationConflictsWithClobPairStatus,
+				"Operation %s invalid for ClobPair with id %d with status %s",
+				internalOperation.GetInternalOperationTextString(),
+				clobPairId,
+				clobPair.Status,
+			)
+		}
 	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
 		// Only allow deleveraging events. This allows the protocol to close out open
 		// positions in the market. All other operations are not allowed.
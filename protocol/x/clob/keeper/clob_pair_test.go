@@ -21,6 +21,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
 	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
 	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	asstypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
@@ -323,6 +324,166 @@ func TestCreatePerpetualClobPair(t *testing.T) {
 	}
 }
 
+func TestCreateSpotClobPair(t *testing.T) {
+	tests := map[string]struct {
+		// State.
+		assets            []*asstypes.Asset
+		existingClobPairs []types.ClobPair
+
+		// CLOB pair.
+		clobPair types.ClobPair
+
+		// Expectations.
+		expectedErr error
+	}{
+		"CLOB pair is valid": {
+			assets:   []*asstypes.Asset{constants.Usdc, constants.BtcUsd},
+			clobPair: constants.ClobPair_Spot_Btc,
+		},
+		"CLOB pair is invalid when the base asset does not exist": {
+			assets:      []*asstypes.Asset{constants.Usdc},
+			clobPair:    constants.ClobPair_Spot_Btc,
+			expectedErr: types.ErrInvalidClobPairParameter,
+		},
+		"CLOB pair is invalid when the quote asset is not USDC": {
+			assets: []*asstypes.Asset{constants.Usdc, constants.BtcUsd},
+			clobPair: *clobtest.GenerateClobPair(
+				clobtest.WithId(constants.ClobPair_Spot_Btc.Id),
+				clobtest.WithSpotMetadata(
+					&types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  0,
+							QuoteAssetId: 1,
+						},
+					},
+				),
+			),
+			expectedErr: types.ErrInvalidClobPairParameter,
+		},
+		"CLOB pair is invalid when in final settlement": {
+			assets: []*asstypes.Asset{constants.Usdc, constants.BtcUsd},
+			clobPair: *clobtest.GenerateClobPair(
+				clobtest.WithId(constants.ClobPair_Spot_Btc.Id),
+				clobtest.WithSpotMetadata(
+					constants.ClobPair_Spot_Btc.Metadata.(*types.ClobPair_SpotClobMetadata),
+				),
+				clobtest.WithStatus(types.ClobPair_STATUS_FINAL_SETTLEMENT),
+			),
+			expectedErr: types.ErrInvalidClobPairParameter,
+		},
+		"CLOB pair is invalid when the assets are traded by an existing CLOB pair": {
+			assets:            []*asstypes.Asset{constants.Usdc, constants.BtcUsd},
+			existingClobPairs: []types.ClobPair{constants.ClobPair_Spot_Btc},
+			clobPair: *clobtest.GenerateClobPair(
+				clobtest.WithId(constants.ClobPair_Spot_Btc.Id+1),
+				clobtest.WithSpotMetadata(
+					constants.ClobPair_Spot_Btc.Metadata.(*types.ClobPair_SpotClobMetadata),
+				),
+			),
+			expectedErr: types.ErrSpotAssetsAssociatedWithExistingClobPair,
+		},
+		"CLOB pair is invalid when the CLOB pair ID is already in use": {
+			assets:            []*asstypes.Asset{constants.Usdc, constants.BtcUsd},
+			existingClobPairs: []types.ClobPair{constants.ClobPair_Spot_Btc},
+			clobPair:          constants.ClobPair_Spot_Btc,
+			expectedErr:       types.ErrClobPairAlreadyExists,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Boilerplate setup.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			for _, a := range tc.assets {
+				_, err := ks.AssetsKeeper.CreateAsset(
+					ks.Ctx,
+					a.Id,
+					a.Symbol,
+					a.Denom,
+					a.DenomExponent,
+					a.HasMarket,
+					a.MarketId,
+					a.AtomicResolution,
+					a.HaircutPpm,
+				)
+				require.NoError(t, err)
+			}
+
+			mockIndexerEventManager.On("AddTxnEvent",
+				ks.Ctx,
+				indexerevents.SubtypeSpotMarket,
+				indexerevents.SpotMarketEventVersion,
+				mock.Anything,
+			).Return()
+
+			for _, clobPair := range tc.existingClobPairs {
+				baseAssetId, quoteAssetId := clobPair.MustGetSpotAssetIds()
+				_, err := ks.ClobKeeper.CreateSpotClobPair(
+					ks.Ctx,
+					clobPair.Id,
+					baseAssetId,
+					quoteAssetId,
+					satypes.BaseQuantums(clobPair.StepBaseQuantums),
+					clobPair.QuantumConversionExponent,
+					clobPair.SubticksPerTick,
+					clobPair.Status,
+				)
+				require.NoError(t, err)
+			}
+
+			// Perform the method under test.
+			baseAssetId, quoteAssetId := tc.clobPair.MustGetSpotAssetIds()
+			createdClobPair, err := ks.ClobKeeper.CreateSpotClobPair(
+				ks.Ctx,
+				tc.clobPair.Id,
+				baseAssetId,
+				quoteAssetId,
+				satypes.BaseQuantums(tc.clobPair.StepBaseQuantums),
+				tc.clobPair.QuantumConversionExponent,
+				tc.clobPair.SubticksPerTick,
+				tc.clobPair.Status,
+			)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.clobPair, createdClobPair)
+
+			storedClobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(tc.clobPair.Id))
+			require.True(t, found)
+			require.Equal(t, tc.clobPair, storedClobPair)
+
+			// Spot CLOB pairs are not associated with a perpetual.
+			require.Empty(t, ks.ClobKeeper.PerpetualIdToClobPairId)
+
+			mockIndexerEventManager.AssertCalled(
+				t,
+				"AddTxnEvent",
+				ks.Ctx,
+				indexerevents.SubtypeSpotMarket,
+				indexerevents.SpotMarketEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewSpotMarketCreateEvent(
+						tc.clobPair.Id,
+						baseAssetId,
+						quoteAssetId,
+						tc.clobPair.Status,
+						tc.clobPair.QuantumConversionExponent,
+						tc.clobPair.SubticksPerTick,
+						tc.clobPair.StepBaseQuantums,
+					),
+				),
+			)
+		})
+	}
+}
+
 func TestCreateMultipleClobPairs(t *testing.T) {
 	type CreationExpectation struct {
 		// CLOB pair.
@@ -1077,14 +1238,29 @@ func TestClobPairValidate(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Invalid Metadata (empty SpotClobMetadata)",
 			clobPair: types.ClobPair{
 				Metadata:         &types.ClobPair_SpotClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
 				Status:           types.ClobPair_STATUS_ACTIVE,
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "has empty spot metadata",
+		},
+		{
+			desc: "Invalid Metadata (SpotClobMetadata with non-USDC quote asset)",
+			clobPair: types.ClobPair{
+				Metadata: &types.ClobPair_SpotClobMetadata{
+					SpotClobMetadata: &types.SpotClobMetadata{
+						BaseAssetId:  0,
+						QuoteAssetId: 1,
+					},
+				},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_ACTIVE,
+			},
+			expectedErr: "has unsupported quote asset 1",
 		},
 		{
 			desc: "Unsupported Status",
@@ -1126,6 +1302,11 @@ func TestClobPairValidate(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			desc:        "Valid spot ClobPair",
+			clobPair:    constants.ClobPair_Spot_Btc,
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range tests {
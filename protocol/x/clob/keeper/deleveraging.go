@@ -173,6 +173,8 @@ func (k Keeper) GetCrossInsuranceFundBalance(ctx sdk.Context) (balance *big.Int)
 // - shouldDeleverageAtOraclePrice is true if the subaccount has non-negative TNC and the market is in final settlement.
 // This function returns an error if `GetNetCollateralAndMarginRequirements` returns an error or if there is
 // an error when fetching the clob pair for the provided perpetual.
+// Negative TNC is determined at oracle prices rather than risk prices, because deleveraging
+// transfers positions at oracle-based prices and a subaccount is only insolvent at those prices.
 func (k Keeper) CanDeleverageSubaccount(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -289,8 +291,11 @@ func (k Keeper) IsValidInsuranceFundDelta(ctx sdk.Context, insuranceFundDelta *b
 	return new(big.Int).Add(currentInsuranceFundBalance, insuranceFundDelta).Sign() >= 0
 }
 
-// OffsetSubaccountPerpetualPosition iterates over all subaccounts and use those with positions
-// on the opposite side to offset the liquidated subaccount's position by `deltaQuantumsTotal`.
+// OffsetSubaccountPerpetualPosition iterates over subaccounts with positions on the opposite side
+// in auto-deleveraging rank order, and uses them to offset the liquidated subaccount's position by
+// `deltaQuantumsTotal`. Positions are ranked by their unrealized PnL multiplied by the effective
+// leverage of their subaccount at the current oracle prices, such that the most profitable and
+// leveraged positions are offset first.
 //
 // This function returns the fills that were processed and the remaining amount to offset.
 // Note that each deleveraging fill is being processed _optimistically_, and the state transitions are
@@ -317,11 +322,14 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 	deltaQuantumsRemaining = new(big.Int).Set(deltaQuantumsTotal)
 	fills = make([]types.MatchPerpetualDeleveraging_Fill, 0)
 
-	// Find subaccounts with open positions on the opposite side of the liquidated subaccount.
+	// Find the highest ranked subaccounts with open positions on the opposite side of the liquidated
+	// subaccount. Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
 	isDeleveragingLong := deltaQuantumsTotal.Sign() == -1
-	subaccountsWithOpenPositions := k.DaemonLiquidationInfo.GetSubaccountsWithOpenPositionsOnSide(
+	subaccountsWithOpenPositions := k.subaccountsKeeper.GetAdlRankedSubaccountIds(
+		ctx,
 		perpetualId,
 		!isDeleveragingLong,
+		k.Flags.MaxDeleveragingSubaccountsToIterate,
 	)
 
 	numSubaccounts := len(subaccountsWithOpenPositions)
@@ -336,16 +344,8 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 		return fills, deltaQuantumsRemaining
 	}
 
-	// Start from a random subaccount.
-	pseudoRand := k.GetPseudoRand(ctx)
-	indexOffset := pseudoRand.Intn(numSubaccounts)
-
-	// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
-	numSubaccountsToIterate := lib.Min(numSubaccounts, int(k.Flags.MaxDeleveragingSubaccountsToIterate))
-
-	for i := 0; i < numSubaccountsToIterate && deltaQuantumsRemaining.Sign() != 0; i++ {
-		index := (i + indexOffset) % numSubaccounts
-		subaccountId := subaccountsWithOpenPositions[index]
+	for i := 0; i < numSubaccounts && deltaQuantumsRemaining.Sign() != 0; i++ {
+		subaccountId := subaccountsWithOpenPositions[i]
 
 		numSubaccountsIterated++
 		offsettingSubaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Orderbook(
+	c context.Context,
+	req *types.QueryOrderbookRequest,
+) (*types.QueryOrderbookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !k.Flags.OrderbookQueriesEnabled {
+		return nil, status.Error(codes.Unavailable, "orderbook queries are not enabled on this node")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	depth := req.Depth
+	if depth == 0 {
+		depth = types.DefaultOrderbookQueryDepth
+	}
+	if depth > types.MaxOrderbookQueryDepth {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"depth must be less than or equal to %d",
+			types.MaxOrderbookQueryDepth,
+		)
+	}
+
+	clobPair, found := k.GetClobPair(ctx, types.ClobPairId(req.ClobPairId))
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if req.GroupSubticks%uint64(clobPair.SubticksPerTick) != 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"group subticks must be a multiple of the subticks per tick %d of the clob pair",
+			clobPair.SubticksPerTick,
+		)
+	}
+
+	orderbookSnapshots := k.GetOrderbookSnapshots()
+	if orderbookSnapshots == nil {
+		return nil, status.Error(codes.Unavailable, "orderbook is not available yet")
+	}
+
+	// The snapshot is empty if the clob pair was created after the snapshots were captured.
+	snapshot := orderbookSnapshots.Snapshots[clobPair.GetClobPairId()]
+	res := &types.QueryOrderbookResponse{
+		ClobPairId:  req.ClobPairId,
+		BlockHeight: orderbookSnapshots.BlockHeight,
+		Bids:        types.GroupOrderbookLevels(snapshot.Bids, true, req.GroupSubticks, depth),
+		Asks:        types.GroupOrderbookLevels(snapshot.Asks, false, req.GroupSubticks, depth),
+	}
+	if len(snapshot.Bids) > 0 {
+		res.BestBidSubticks = snapshot.Bids[0].Subticks
+	}
+	if len(snapshot.Asks) > 0 {
+		res.BestAskSubticks = snapshot.Asks[0].Subticks
+	}
+	if snapshot.MidPriceExists {
+		res.MidPriceSubticks = snapshot.MidPrice.ToUint64()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	clobflags "github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOrderbook(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(
+		map[string]interface{}{clobflags.OrderbookQueriesEnabled: true},
+	).Build()
+	ctx := tApp.InitChain()
+
+	buyOrder := testapp.MustScaleOrder(
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20,
+		testapp.DefaultGenesis(),
+	)
+	sellOrder := testapp.MustScaleOrder(
+		constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price15_GTB20,
+		testapp.DefaultGenesis(),
+	)
+	for _, order := range []types.Order{buyOrder, sellOrder} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *types.NewMsgPlaceOrder(order)) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	clobPair, found := tApp.App.ClobKeeper.GetClobPair(ctx, 0)
+	require.True(t, found)
+
+	// Price levels are returned with the best bid, best ask, and mid price of the orderbook.
+	res, err := tApp.App.ClobKeeper.Orderbook(ctx, &types.QueryOrderbookRequest{ClobPairId: 0})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QueryOrderbookResponse{
+			ClobPairId:  0,
+			BlockHeight: 2,
+			Bids: []types.OrderbookLevel{
+				{Subticks: buyOrder.Subticks, Quantums: buyOrder.Quantums, NumOrders: 1},
+			},
+			Asks: []types.OrderbookLevel{
+				{Subticks: sellOrder.Subticks, Quantums: sellOrder.Quantums, NumOrders: 1},
+			},
+			BestBidSubticks:  buyOrder.Subticks,
+			BestAskSubticks:  sellOrder.Subticks,
+			MidPriceSubticks: buyOrder.Subticks + (sellOrder.Subticks-buyOrder.Subticks)/2,
+		},
+		res,
+	)
+
+	// Price levels are grouped by the requested price range.
+	groupSubticks := uint64(clobPair.SubticksPerTick) * 1_000
+	res, err = tApp.App.ClobKeeper.Orderbook(
+		ctx,
+		&types.QueryOrderbookRequest{ClobPairId: 0, Depth: 1, GroupSubticks: groupSubticks},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.OrderbookLevel{
+			{Subticks: buyOrder.Subticks - buyOrder.Subticks%groupSubticks, Quantums: buyOrder.Quantums, NumOrders: 1},
+		},
+		res.Bids,
+	)
+	require.Len(t, res.Asks, 1)
+	require.Zero(t, res.Asks[0].Subticks%groupSubticks)
+	require.GreaterOrEqual(t, res.Asks[0].Subticks, sellOrder.Subticks)
+
+	// Clob pairs without orders have an empty orderbook.
+	res, err = tApp.App.ClobKeeper.Orderbook(ctx, &types.QueryOrderbookRequest{ClobPairId: 1})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QueryOrderbookResponse{
+			ClobPairId:  1,
+			BlockHeight: 2,
+			Bids:        []types.OrderbookLevel{},
+			Asks:        []types.OrderbookLevel{},
+		},
+		res,
+	)
+}
+
+func TestOrderbook_Errors(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(
+		map[string]interface{}{clobflags.OrderbookQueriesEnabled: true},
+	).Build()
+	ctx := tApp.InitChain()
+
+	clobPair, found := tApp.App.ClobKeeper.GetClobPair(ctx, 0)
+	require.True(t, found)
+
+	tests := map[string]struct {
+		req          *types.QueryOrderbookRequest
+		expectedCode codes.Code
+	}{
+		"nil request": {
+			req:          nil,
+			expectedCode: codes.InvalidArgument,
+		},
+		"depth above max": {
+			req:          &types.QueryOrderbookRequest{ClobPairId: 0, Depth: types.MaxOrderbookQueryDepth + 1},
+			expectedCode: codes.InvalidArgument,
+		},
+		"clob pair does not exist": {
+			req:          &types.QueryOrderbookRequest{ClobPairId: 1_000},
+			expectedCode: codes.NotFound,
+		},
+		"group subticks is not a multiple of subticks per tick": {
+			req: &types.QueryOrderbookRequest{
+				ClobPairId:    0,
+				GroupSubticks: uint64(clobPair.SubticksPerTick) + 1,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tApp.App.ClobKeeper.Orderbook(ctx, tc.req)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestOrderbook_Unavailable(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	keepertest.CreateNClobPair(t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		1,
+		mockIndexerEventManager,
+	)
+
+	// The orderbook is unavailable until the first snapshots are captured.
+	ks.ClobKeeper.Flags.OrderbookQueriesEnabled = true
+	_, err := ks.ClobKeeper.Orderbook(ks.Ctx, &types.QueryOrderbookRequest{ClobPairId: 0})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// The orderbook is unavailable if orderbook queries are not enabled.
+	ks.ClobKeeper.UpdateOrderbookSnapshots(ks.Ctx)
+	ks.ClobKeeper.Flags.OrderbookQueriesEnabled = false
+	_, err = ks.ClobKeeper.Orderbook(ks.Ctx, &types.QueryOrderbookRequest{ClobPairId: 0})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	ks.ClobKeeper.Flags.OrderbookQueriesEnabled = true
+	res, err := ks.ClobKeeper.Orderbook(ks.Ctx, &types.QueryOrderbookRequest{ClobPairId: 0})
+	require.NoError(t, err)
+	require.Empty(t, res.Bids)
+	require.Empty(t, res.Asks)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SimulateOrder(
+	c context.Context,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !k.Flags.OrderbookQueriesEnabled {
+		return nil, status.Error(codes.Unavailable, "orderbook queries are not enabled on this node")
+	}
+	if err := types.NewMsgPlaceOrder(req.Order).ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Order.IsConditionalOrder() || req.Order.IsTwapOrder() {
+		return nil, status.Error(codes.InvalidArgument, "conditional and TWAP orders can not be simulated")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	clobPair, found := k.GetClobPair(ctx, req.Order.GetClobPairId())
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if clobPair.IsSpotClobPair() {
+		return nil, status.Error(codes.InvalidArgument, "orders on spot clob pairs can not be simulated")
+	}
+
+	// Validate the order as if it was placed in the next block.
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+	if err := k.PerformStatefulOrderValidation(ctx, &req.Order, nextBlockHeight, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := k.ValidateMarketMakerProtectionNotTripped(ctx, req.Order); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	orderbookSnapshots := k.GetOrderbookSnapshots()
+	if orderbookSnapshots == nil {
+		return nil, status.Error(codes.Unavailable, "orderbook is not available yet")
+	}
+
+	res, err := k.simulateOrder(
+		ctx,
+		req.Order,
+		clobPair,
+		orderbookSnapshots.Snapshots[clobPair.GetClobPairId()],
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.BlockHeight = orderbookSnapshots.BlockHeight
+
+	return res, nil
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobflags "github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulateOrder(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(
+		map[string]interface{}{clobflags.OrderbookQueriesEnabled: true},
+	).Build()
+	ctx := tApp.InitChain()
+
+	sellOrder0 := testapp.MustScaleOrder(
+		constants.Order_Bob_Num0_Id12_Clob0_Sell20_Price15_GTB20,
+		testapp.DefaultGenesis(),
+	)
+	sellOrder1 := constants.Order_Bob_Num0_Id13_Clob0_Sell35_Price35_GTB30
+	sellOrder1.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 20}
+	sellOrder1 = testapp.MustScaleOrder(sellOrder1, testapp.DefaultGenesis())
+	for _, order := range []types.Order{sellOrder0, sellOrder1} {
+		for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *types.NewMsgPlaceOrder(order)) {
+			resp := tApp.CheckTx(checkTx)
+			require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+		}
+	}
+	ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+
+	clobPair, found := tApp.App.ClobKeeper.GetClobPair(ctx, 0)
+	require.True(t, found)
+
+	// Buy order crossing both sell orders, with remaining size resting on the orderbook.
+	buyOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	buyOrder.Quantums = 60
+	buyOrder.Subticks = 35
+	buyOrder = testapp.MustScaleOrder(buyOrder, testapp.DefaultGenesis())
+
+	takerFeePpm := tApp.App.FeeTiersKeeper.GetPerpetualFeePpm(ctx, constants.Alice_Num0.Owner, true)
+	expectedFills := []types.SimulatedFill{
+		{
+			MakerOrderId: sellOrder0.OrderId,
+			Subticks:     sellOrder0.Subticks,
+			FillQuantums: sellOrder0.Quantums,
+		},
+		{
+			MakerOrderId: sellOrder1.OrderId,
+			Subticks:     sellOrder1.Subticks,
+			FillQuantums: sellOrder1.Quantums,
+		},
+	}
+	bigTotalFee := new(big.Int)
+	bigTotalNotional := new(big.Int)
+	for i, fill := range expectedFills {
+		bigFillQuoteQuantums := types.FillAmountToQuoteQuantums(
+			types.Subticks(fill.Subticks),
+			satypes.BaseQuantums(fill.FillQuantums),
+			clobPair.QuantumConversionExponent,
+		)
+		// Fees are rounded towards positive infinity.
+		bigFee := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, takerFeePpm, true)
+		expectedFills[i].FeeQuoteQuantums = dtypes.NewIntFromBigInt(bigFee)
+		bigTotalFee.Add(bigTotalFee, bigFee)
+		bigTotalNotional.Add(
+			bigTotalNotional,
+			new(big.Int).SetUint64(fill.Subticks*fill.FillQuantums),
+		)
+	}
+
+	res, err := tApp.App.ClobKeeper.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{Order: buyOrder})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), res.BlockHeight)
+	require.Equal(t, expectedFills, res.Fills)
+	require.Equal(t, sellOrder0.Quantums+sellOrder1.Quantums, res.TotalFilledQuantums)
+	// The average price of a buy order is rounded up.
+	require.Equal(
+		t,
+		lib.BigRatRound(
+			new(big.Rat).SetFrac(bigTotalNotional, new(big.Int).SetUint64(res.TotalFilledQuantums)),
+			true,
+		).Uint64(),
+		res.AverageFillSubticks,
+	)
+	require.Equal(t, takerFeePpm, res.TakerFeePpm)
+	require.Equal(t, dtypes.NewIntFromBigInt(bigTotalFee), res.TotalFeeQuoteQuantums)
+	require.Equal(t, buyOrder.Quantums-res.TotalFilledQuantums, res.RestingQuantums)
+	require.Equal(t, types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED, res.RemovalReason)
+
+	// Post-only orders crossing the orderbook are removed without being filled.
+	postOnlyOrder := buyOrder
+	postOnlyOrder.TimeInForce = types.Order_TIME_IN_FORCE_POST_ONLY
+	postOnlyRes, err := tApp.App.ClobKeeper.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{Order: postOnlyOrder})
+	require.NoError(t, err)
+	require.Empty(t, postOnlyRes.Fills)
+	require.Zero(t, postOnlyRes.RestingQuantums)
+	require.Equal(t, types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER, postOnlyRes.RemovalReason)
+
+	// Fill-or-kill orders that can not be fully filled are removed without being filled.
+	fokOrder := buyOrder
+	fokOrder.TimeInForce = types.Order_TIME_IN_FORCE_FILL_OR_KILL
+	fokRes, err := tApp.App.ClobKeeper.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{Order: fokOrder})
+	require.NoError(t, err)
+	require.Empty(t, fokRes.Fills)
+	require.Zero(t, fokRes.RestingQuantums)
+	require.Equal(
+		t,
+		types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED,
+		fokRes.RemovalReason,
+	)
+
+	// Immediate-or-cancel orders do not rest on the orderbook.
+	iocOrder := buyOrder
+	iocOrder.TimeInForce = types.Order_TIME_IN_FORCE_IOC
+	iocRes, err := tApp.App.ClobKeeper.SimulateOrder(ctx, &types.QuerySimulateOrderRequest{Order: iocOrder})
+	require.NoError(t, err)
+	require.Equal(t, expectedFills, iocRes.Fills)
+	require.Zero(t, iocRes.RestingQuantums)
+	require.Equal(t, types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED, iocRes.RemovalReason)
+
+	// Simulating orders neither fills the maker orders nor modifies the orderbook.
+	for _, sellOrder := range []types.Order{sellOrder0, sellOrder1} {
+		exists, _, _ := tApp.App.ClobKeeper.GetOrderFillAmount(ctx, sellOrder.OrderId)
+		require.False(t, exists)
+		_, found := tApp.App.ClobKeeper.MemClob.GetOrder(ctx, sellOrder.OrderId)
+		require.True(t, found)
+	}
+	_, found = tApp.App.ClobKeeper.MemClob.GetOrder(ctx, buyOrder.OrderId)
+	require.False(t, found)
+
+	// The simulated collateral of the subaccount matches the collateral after placing the order.
+	for _, checkTx := range testapp.MustMakeCheckTxsWithClobMsg(ctx, tApp.App, *types.NewMsgPlaceOrder(buyOrder)) {
+		resp := tApp.CheckTx(checkTx)
+		require.Conditionf(t, resp.IsOK, "Expected CheckTx to succeed. Response: %+v", resp)
+	}
+	ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
+
+	bigNetCollateral, bigInitialMargin, bigMaintenanceMargin, err :=
+		tApp.App.SubaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			ctx,
+			satypes.Update{SubaccountId: constants.Alice_Num0},
+		)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewIntFromBigInt(bigNetCollateral), res.NetCollateral)
+	require.Equal(t, dtypes.NewIntFromBigInt(bigInitialMargin), res.InitialMargin)
+	require.Equal(t, dtypes.NewIntFromBigInt(bigMaintenanceMargin), res.MaintenanceMargin)
+	require.Equal(
+		t,
+		dtypes.NewIntFromBigInt(new(big.Int).Sub(bigNetCollateral, bigInitialMargin)),
+		res.FreeCollateral,
+	)
+}
+
+func TestSimulateOrder_Errors(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithAppOptions(
+		map[string]interface{}{clobflags.OrderbookQueriesEnabled: true},
+	).Build()
+	ctx := tApp.InitChain()
+
+	clobPairDoesNotExist := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	clobPairDoesNotExist.OrderId.ClobPairId = 1_000
+	goodTilBlockExceedsShortBlockWindow := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	goodTilBlockExceedsShortBlockWindow.GoodTilOneof = &types.Order_GoodTilBlock{GoodTilBlock: 100}
+
+	tests := map[string]struct {
+		req          *types.QuerySimulateOrderRequest
+		expectedCode codes.Code
+	}{
+		"nil request": {
+			req:          nil,
+			expectedCode: codes.InvalidArgument,
+		},
+		"invalid order": {
+			req:          &types.QuerySimulateOrderRequest{Order: types.Order{}},
+			expectedCode: codes.InvalidArgument,
+		},
+		"conditional order": {
+			req: &types.QuerySimulateOrderRequest{
+				Order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		"clob pair does not exist": {
+			req:          &types.QuerySimulateOrderRequest{Order: clobPairDoesNotExist},
+			expectedCode: codes.NotFound,
+		},
+		"order fails stateful validation": {
+			req:          &types.QuerySimulateOrderRequest{Order: goodTilBlockExceedsShortBlockWindow},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := tApp.App.ClobKeeper.SimulateOrder(ctx, tc.req)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+
+	// Orders can not be simulated if orderbook queries are not enabled on the node.
+	tApp.App.ClobKeeper.Flags.OrderbookQueriesEnabled = false
+	_, err := tApp.App.ClobKeeper.SimulateOrder(
+		ctx,
+		&types.QuerySimulateOrderRequest{Order: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20},
+	)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
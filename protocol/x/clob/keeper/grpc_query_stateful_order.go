@@ -39,5 +39,19 @@ func (k Keeper) StatefulOrder(
 		res.Triggered = k.IsConditionalOrderTriggered(ctx, req.OrderId)
 	}
 
+	// Get the order group for orders that are part of an order group
+	if order := val.Order; order.IsInOrderGroup() {
+		if orderGroup, found := k.GetOrderGroup(ctx, req.OrderId.SubaccountId, order.OrderGroupId); found {
+			res.OrderGroup = &orderGroup
+		}
+	}
+
+	// Get the execution progress of TWAP orders
+	if req.OrderId.IsTwapOrder() {
+		if twapOrderState, found := k.GetTwapOrderState(ctx, req.OrderId); found {
+			res.TwapOrderState = &twapOrderState
+		}
+	}
+
 	return res, nil
 }
@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubaccountRisk(t *testing.T) {
+	tests := map[string]struct {
+		// State.
+		subaccounts []satypes.Subaccount
+		// Premium of the mark price of BTC over its oracle price. The mark price is disabled if zero.
+		btcMarkPricePremiumPpm int32
+
+		// Parameters.
+		subaccountId satypes.SubaccountId
+
+		// Expectations.
+		expectedRisk clobtypes.SubaccountRisk
+	}{
+		"Well collateralized short": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_100000USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			subaccountId: constants.Carl_Num0,
+			expectedRisk: clobtypes.SubaccountRisk{
+				SubaccountId:      constants.Carl_Num0,
+				NetCollateral:     dtypes.NewInt(50_000_000_000),
+				InitialMargin:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:    dtypes.NewInt(40_000_000_000),
+				IsLiquidatable:    false,
+				PerpetualPositions: []clobtypes.PerpetualPositionRisk{
+					{
+						PerpetualId:         0,
+						ClobPairId:          0,
+						Quantums:            dtypes.NewInt(-100_000_000),
+						OraclePriceSubticks: 50_000_000_000,
+						RiskPriceSubticks:   50_000_000_000,
+						// $100,000, at which the short can be closed with the $100,000 of collateral.
+						BankruptcyPriceSubticks: 100_000_000_000,
+						// $90,909.09, at which the net collateral of $100,000 - $90,909.09 equals the
+						// maintenance margin requirement of 10% of $90,909.09. Rounded down to a tick.
+						EstimatedLiquidationPriceSubticks: 90_909_090_905,
+					},
+				},
+			},
+		},
+		"Well collateralized long can not be liquidated by a price drop": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_100000USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			subaccountId: constants.Dave_Num0,
+			expectedRisk: clobtypes.SubaccountRisk{
+				SubaccountId:      constants.Dave_Num0,
+				NetCollateral:     dtypes.NewInt(100_000_000_000),
+				InitialMargin:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:    dtypes.NewInt(90_000_000_000),
+				IsLiquidatable:    false,
+				PerpetualPositions: []clobtypes.PerpetualPositionRisk{
+					{
+						PerpetualId:                       0,
+						ClobPairId:                        0,
+						Quantums:                          dtypes.NewInt(100_000_000),
+						OraclePriceSubticks:               50_000_000_000,
+						RiskPriceSubticks:                 50_000_000_000,
+						BankruptcyPriceSubticks:           0,
+						EstimatedLiquidationPriceSubticks: 0,
+					},
+				},
+			},
+		},
+		"Liquidatable short": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			subaccountId: constants.Carl_Num0,
+			expectedRisk: clobtypes.SubaccountRisk{
+				SubaccountId:      constants.Carl_Num0,
+				NetCollateral:     dtypes.NewInt(499_000_000),
+				InitialMargin:     dtypes.NewInt(10_000_000_000),
+				MaintenanceMargin: dtypes.NewInt(5_000_000_000),
+				FreeCollateral:    dtypes.NewInt(-9_501_000_000),
+				IsLiquidatable:    true,
+				PerpetualPositions: []clobtypes.PerpetualPositionRisk{
+					{
+						PerpetualId:         0,
+						ClobPairId:          0,
+						Quantums:            dtypes.NewInt(-100_000_000),
+						OraclePriceSubticks: 50_000_000_000,
+						RiskPriceSubticks:   50_000_000_000,
+						// $50,499.
+						BankruptcyPriceSubticks: 50_499_000_000,
+						// $45,908.18.
+						EstimatedLiquidationPriceSubticks: 45_908_181_815,
+					},
+				},
+			},
+		},
+		"Liquidation prices are estimated at the risk prices": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Carl_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  0,
+							Quantums: dtypes.NewInt(54_000_000_000), // $54,000
+						},
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						{
+							PerpetualId:  0,
+							Quantums:     dtypes.NewInt(-100_000_000), // -1 BTC
+							FundingIndex: dtypes.NewInt(0),
+						},
+						{
+							PerpetualId:  1,
+							Quantums:     dtypes.NewInt(1_000_000_000), // 1 ETH
+							FundingIndex: dtypes.NewInt(0),
+						},
+					},
+				},
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			btcMarkPricePremiumPpm: 10_000,
+			subaccountId:           constants.Carl_Num0,
+			expectedRisk: clobtypes.SubaccountRisk{
+				SubaccountId:      constants.Carl_Num0,
+				NetCollateral:     dtypes.NewInt(7_000_000_000),
+				InitialMargin:     dtypes.NewInt(10_600_000_000),
+				MaintenanceMargin: dtypes.NewInt(5_300_000_000),
+				FreeCollateral:    dtypes.NewInt(-3_600_000_000),
+				IsLiquidatable:    false,
+				PerpetualPositions: []clobtypes.PerpetualPositionRisk{
+					{
+						PerpetualId:         0,
+						ClobPairId:          0,
+						Quantums:            dtypes.NewInt(-100_000_000),
+						OraclePriceSubticks: 50_000_000_000,
+						// $50,500, the mark price of BTC.
+						RiskPriceSubticks: 50_500_000_000,
+						// $56,603.77.
+						BankruptcyPriceSubticks: 56_603_773_580,
+						// $51,545.45.
+						EstimatedLiquidationPriceSubticks: 51_545_454_545,
+					},
+					{
+						PerpetualId:         1,
+						ClobPairId:          1,
+						Quantums:            dtypes.NewInt(1_000_000_000),
+						OraclePriceSubticks: 3_000_000_000,
+						RiskPriceSubticks:   3_000_000_000,
+						// $2,603.77.
+						BankruptcyPriceSubticks: 2_603_774_000,
+						// $1,722.22, at which the net collateral of $6,500 - $3,000 + $1,722.22 at the mark
+						// price of BTC equals the maintenance margin requirement of $5,050 + $172.22.
+						// Rounded up to a tick.
+						EstimatedLiquidationPriceSubticks: 1_722_223_000,
+					},
+				},
+			},
+		},
+		"Subaccount without positions": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_100000USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			subaccountId: constants.Alice_Num0,
+			expectedRisk: clobtypes.SubaccountRisk{
+				SubaccountId:      constants.Alice_Num0,
+				NetCollateral:     dtypes.NewInt(0),
+				InitialMargin:     dtypes.NewInt(0),
+				MaintenanceMargin: dtypes.NewInt(0),
+				FreeCollateral:    dtypes.NewInt(0),
+				IsLiquidatable:    false,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						*genesisState = constants.TestPricesGenesisState
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.Params = constants.PerpetualsGenesisParams
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance_OpenInterest1,
+							constants.EthUsd_20PercentInitial_10PercentMaintenance,
+						}
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = tc.subaccounts
+					},
+				)
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+
+			if tc.btcMarkPricePremiumPpm != 0 {
+				require.NoError(t, tApp.App.PerpetualsKeeper.AddPremiumVotes(
+					ctx,
+					[]perptypes.FundingPremium{{PerpetualId: 0, PremiumPpm: tc.btcMarkPricePremiumPpm}},
+				))
+				_, err := tApp.App.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+					ctx,
+					0,
+					&perptypes.MarkPriceConfig{Enabled: true, EmaSmoothingPpm: 1_000_000},
+				)
+				require.NoError(t, err)
+				tApp.App.PerpetualsKeeper.UpdateMarkPrices(ctx)
+			}
+
+			res, err := tApp.App.ClobKeeper.SubaccountRisk(
+				ctx,
+				&clobtypes.QuerySubaccountRiskRequest{
+					Owner:  tc.subaccountId.Owner,
+					Number: tc.subaccountId.Number,
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRisk, res.SubaccountRisk)
+		})
+	}
+}
+
+func TestSubaccountRisk_Errors(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+
+	_, err := tApp.App.ClobKeeper.SubaccountRisk(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tApp.App.ClobKeeper.SubaccountRisk(
+		ctx,
+		&clobtypes.QuerySubaccountRiskRequest{Owner: "invalid", Number: 0},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
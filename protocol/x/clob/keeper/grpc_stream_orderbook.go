@@ -8,13 +8,7 @@ func (k Keeper) StreamOrderbookUpdates(
 	req *types.StreamOrderbookUpdatesRequest,
 	stream types.Query_StreamOrderbookUpdatesServer,
 ) error {
-	err := k.GetGrpcStreamingManager().Subscribe(*req, stream)
-	if err != nil {
-		return err
-	}
-
-	// Keep this scope alive because once this scope exits - the stream is closed
-	ctx := stream.Context()
-	<-ctx.Done()
-	return nil
+	// Subscribe blocks and serves the stream until it is closed. Once this scope exits,
+	// the stream is closed.
+	return k.GetGrpcStreamingManager().Subscribe(*req, stream)
 }
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getSubaccountHeartbeatStore fetches a state store used for creating, reading, updating, and deleting
+// the dead man's switch of subaccounts from state.
+func (k Keeper) getSubaccountHeartbeatStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.SubaccountHeartbeatKeyPrefix),
+	)
+}
+
+// getHeartbeatsTimeSliceStore fetches a state store used for creating, reading, updating, and deleting
+// a heartbeat time slice from state.
+func (k Keeper) getHeartbeatsTimeSliceStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.HeartbeatsTimeSlicePrefix),
+	)
+}
+
+// GetSubaccountHeartbeat gets the dead man's switch of a subaccount from state.
+// Returns false if the dead man's switch of the subaccount is not armed.
+func (k Keeper) GetSubaccountHeartbeat(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (val types.SubaccountHeartbeat, found bool) {
+	store := k.getSubaccountHeartbeatStore(ctx)
+
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetSubaccountHeartbeat arms or refreshes the dead man's switch of a subaccount, such that the stateful
+// orders of the subaccount are canceled `timeoutSeconds` after the current block time unless the
+// heartbeat is refreshed again. The previous deadline of the subaccount, if any, is discarded.
+func (k Keeper) SetSubaccountHeartbeat(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	timeoutSeconds uint32,
+) {
+	k.DeleteSubaccountHeartbeat(ctx, subaccountId)
+
+	heartbeat := types.SubaccountHeartbeat{
+		TimeoutSeconds: timeoutSeconds,
+		Deadline: lib.MustConvertIntegerToUint32(
+			ctx.BlockTime().Add(time.Duration(timeoutSeconds) * time.Second).Unix(),
+		),
+	}
+	store := k.getSubaccountHeartbeatStore(ctx)
+	store.Set(subaccountId.ToStateKey(), k.cdc.MustMarshal(&heartbeat))
+
+	deadline := time.Unix(int64(heartbeat.Deadline), 0)
+	subaccountIds := k.GetHeartbeatsTimeSlice(ctx, deadline)
+	k.setHeartbeatsTimeSlice(ctx, deadline, append(subaccountIds, subaccountId))
+}
+
+// DeleteSubaccountHeartbeat disarms the dead man's switch of a subaccount by deleting it from state
+// and removing the subaccount from the time slice of its deadline. This function is a no-op if the
+// dead man's switch of the subaccount is not armed.
+func (k Keeper) DeleteSubaccountHeartbeat(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) {
+	heartbeat, found := k.GetSubaccountHeartbeat(ctx, subaccountId)
+	if !found {
+		return
+	}
+
+	store := k.getSubaccountHeartbeatStore(ctx)
+	store.Delete(subaccountId.ToStateKey())
+
+	// Note that the time slice may have already been removed from state if the heartbeat is being
+	// deleted while canceling the orders of expired heartbeats.
+	deadline := time.Unix(int64(heartbeat.Deadline), 0)
+	subaccountIds := k.GetHeartbeatsTimeSlice(ctx, deadline)
+	updatedSubaccountIds := make([]satypes.SubaccountId, 0, len(subaccountIds))
+	for _, heartbeatSubaccountId := range subaccountIds {
+		if heartbeatSubaccountId != subaccountId {
+			updatedSubaccountIds = append(updatedSubaccountIds, heartbeatSubaccountId)
+		}
+	}
+	k.setHeartbeatsTimeSlice(ctx, deadline, updatedSubaccountIds)
+}
+
+// GetHeartbeatsTimeSlice gets a slice of the subaccount IDs whose heartbeat expires at `deadline`,
+// sorted by subaccount ID.
+func (k Keeper) GetHeartbeatsTimeSlice(ctx sdk.Context, deadline time.Time) (
+	subaccountIds []satypes.SubaccountId,
+) {
+	store := k.getHeartbeatsTimeSliceStore(ctx)
+	b := store.Get(sdk.FormatTimeBytes(deadline))
+	if b == nil {
+		return []satypes.SubaccountId{}
+	}
+
+	var heartbeatsTimeSlice types.HeartbeatTimeSliceValue
+	k.cdc.MustUnmarshal(b, &heartbeatsTimeSlice)
+	return heartbeatsTimeSlice.SubaccountIds
+}
+
+// setHeartbeatsTimeSlice sets a sorted list of subaccount IDs in state at `deadline`. The time
+// slice is deleted from state if `subaccountIds` is empty.
+func (k Keeper) setHeartbeatsTimeSlice(
+	ctx sdk.Context,
+	deadline time.Time,
+	subaccountIds []satypes.SubaccountId,
+) {
+	store := k.getHeartbeatsTimeSliceStore(ctx)
+	key := sdk.FormatTimeBytes(deadline)
+
+	if len(subaccountIds) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	sort.Sort(satypes.SortedSubaccountIds(subaccountIds))
+	store.Set(key, k.cdc.MustMarshal(&types.HeartbeatTimeSliceValue{SubaccountIds: subaccountIds}))
+}
+
+// removeExpiredHeartbeatsTimeSlices iterates all heartbeat time slices from time 0 until `blockTime`
+// (inclusive) and removes the time slices from state. It returns all subaccount IDs that were removed.
+func (k Keeper) removeExpiredHeartbeatsTimeSlices(ctx sdk.Context, blockTime time.Time) (
+	expiredSubaccountIds []satypes.SubaccountId,
+) {
+	store := k.getHeartbeatsTimeSliceStore(ctx)
+	iterator := store.Iterator(
+		nil,
+		storetypes.InclusiveEndBytes(sdk.FormatTimeBytes(blockTime)),
+	)
+	defer iterator.Close()
+
+	expiredSubaccountIds = make([]satypes.SubaccountId, 0)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var heartbeatsTimeSlice types.HeartbeatTimeSliceValue
+		k.cdc.MustUnmarshal(iterator.Value(), &heartbeatsTimeSlice)
+		expiredSubaccountIds = append(expiredSubaccountIds, heartbeatsTimeSlice.SubaccountIds...)
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return expiredSubaccountIds
+}
+
+// CancelStatefulOrdersOfExpiredHeartbeats disarms the dead man's switch of every subaccount whose
+// heartbeat expired at or before the current block time, and removes all stateful orders of these
+// subaccounts from state. An on-chain indexer event is emitted for each removed order.
+// Returns the ids of the removed orders, which should be removed from the memclob in `PrepareCheckState`,
+// and the ids of the subaccounts whose heartbeat expired, whose Short-Term orders should be removed from
+// the memclob in `PrepareCheckState`, see `CancelShortTermOrdersOfExpiredHeartbeats`.
+func (k Keeper) CancelStatefulOrdersOfExpiredHeartbeats(ctx sdk.Context) (
+	removedOrderIds []types.OrderId,
+	expiredSubaccountIds []satypes.SubaccountId,
+) {
+	removedOrderIds = make([]types.OrderId, 0)
+	expiredSubaccountIds = k.removeExpiredHeartbeatsTimeSlices(ctx, ctx.BlockTime())
+	for _, subaccountId := range expiredSubaccountIds {
+		k.DeleteSubaccountHeartbeat(ctx, subaccountId)
+
+		for _, order := range k.GetAllStatefulOrdersForSubaccount(ctx, subaccountId) {
+			k.MustRemoveStatefulOrder(ctx, order.OrderId)
+			removedOrderIds = append(removedOrderIds, order.OrderId)
+
+			k.GetIndexerEventManager().AddBlockEvent(
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewStatefulOrderRemovalEvent(
+						order.OrderId,
+						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+					),
+				),
+			)
+		}
+
+		metrics.IncrCounter(metrics.ClobHeartbeatExpired, 1)
+	}
+
+	return removedOrderIds, expiredSubaccountIds
+}
+
+// CancelShortTermOrdersOfExpiredHeartbeats removes all Short-Term orders of every subaccount whose
+// heartbeat expired in the last block from the memclob.
+func (k Keeper) CancelShortTermOrdersOfExpiredHeartbeats(
+	ctx sdk.Context,
+	expiredSubaccountIds []satypes.SubaccountId,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	for _, subaccountId := range expiredSubaccountIds {
+		if _, err := k.CancelAllShortTermOrders(
+			ctx,
+			types.NewMsgCancelAllOrders(subaccountId, nil),
+		); err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"Failed to cancel Short-Term orders of expired heartbeat",
+				err,
+				log.Subaccount, subaccountId,
+			)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetDeleteSubaccountHeartbeat(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	ctx := ks.Ctx.WithBlockTime(time.Unix(100, 0))
+
+	_, found := ks.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.False(t, found)
+
+	// Arm the dead man's switch of two subaccounts with the same deadline.
+	ks.ClobKeeper.SetSubaccountHeartbeat(ctx, constants.Alice_Num0, 60)
+	ks.ClobKeeper.SetSubaccountHeartbeat(ctx, constants.Bob_Num0, 60)
+	heartbeat, found := ks.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, types.SubaccountHeartbeat{TimeoutSeconds: 60, Deadline: 160}, heartbeat)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Bob_Num0, constants.Alice_Num0},
+		ks.ClobKeeper.GetHeartbeatsTimeSlice(ctx, time.Unix(160, 0)),
+	)
+
+	// Refreshing the heartbeat moves the subaccount to the time slice of its new deadline.
+	ctx = ctx.WithBlockTime(time.Unix(130, 0))
+	ks.ClobKeeper.SetSubaccountHeartbeat(ctx, constants.Alice_Num0, 10)
+	heartbeat, found = ks.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, types.SubaccountHeartbeat{TimeoutSeconds: 10, Deadline: 140}, heartbeat)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Bob_Num0},
+		ks.ClobKeeper.GetHeartbeatsTimeSlice(ctx, time.Unix(160, 0)),
+	)
+	require.Equal(
+		t,
+		[]satypes.SubaccountId{constants.Alice_Num0},
+		ks.ClobKeeper.GetHeartbeatsTimeSlice(ctx, time.Unix(140, 0)),
+	)
+
+	// Disarming the dead man's switch removes it from state.
+	ks.ClobKeeper.DeleteSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	_, found = ks.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.False(t, found)
+	require.Empty(t, ks.ClobKeeper.GetHeartbeatsTimeSlice(ctx, time.Unix(140, 0)))
+
+	// Disarming a dead man's switch that is not armed is a no-op.
+	ks.ClobKeeper.DeleteSubaccountHeartbeat(ctx, constants.Alice_Num0)
+}
+
+func TestCancelStatefulOrdersOfExpiredHeartbeats(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	aliceOrders := []types.Order{
+		constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+	}
+	bobOrder := constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell5_Price10_GTBT10
+	ctx := ks.Ctx.WithBlockTime(time.Unix(5, 0))
+	for _, order := range append(aliceOrders, bobOrder) {
+		ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+		ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ctx, order.MustGetUnixGoodTilBlockTime(), order.OrderId)
+	}
+
+	ks.ClobKeeper.SetSubaccountHeartbeat(ctx, constants.Alice_Num0, 2)
+	ks.ClobKeeper.SetSubaccountHeartbeat(ctx, constants.Bob_Num0, 4)
+
+	// Nothing is canceled before the deadline of a heartbeat.
+	ctx = ctx.WithBlockTime(time.Unix(6, 0))
+	removedOrderIds, expiredSubaccountIds := ks.ClobKeeper.CancelStatefulOrdersOfExpiredHeartbeats(ctx)
+	require.Empty(t, removedOrderIds)
+	require.Empty(t, expiredSubaccountIds)
+
+	// The stateful orders of the subaccount are canceled once its deadline passes.
+	ctx = ctx.WithBlockTime(time.Unix(8, 0))
+	for _, order := range aliceOrders {
+		indexerEventManager.On(
+			"AddBlockEvent",
+			mock.Anything,
+			indexerevents.SubtypeStatefulOrder,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					order.OrderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+				),
+			),
+		).Once().Return()
+	}
+	removedOrderIds, expiredSubaccountIds = ks.ClobKeeper.CancelStatefulOrdersOfExpiredHeartbeats(ctx)
+	require.ElementsMatch(
+		t,
+		[]types.OrderId{aliceOrders[0].OrderId, aliceOrders[1].OrderId},
+		removedOrderIds,
+	)
+	require.Equal(t, []satypes.SubaccountId{constants.Alice_Num0}, expiredSubaccountIds)
+	indexerEventManager.AssertExpectations(t)
+
+	for _, order := range aliceOrders {
+		_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+		require.False(t, found)
+	}
+	_, found := ks.ClobKeeper.GetSubaccountHeartbeat(ctx, constants.Alice_Num0)
+	require.False(t, found)
+
+	// The heartbeat of the other subaccount is disarmed before its deadline.
+	ks.ClobKeeper.DeleteSubaccountHeartbeat(ctx, constants.Bob_Num0)
+	ctx = ctx.WithBlockTime(time.Unix(10, 0))
+	removedOrderIds, expiredSubaccountIds = ks.ClobKeeper.CancelStatefulOrdersOfExpiredHeartbeats(ctx)
+	require.Empty(t, removedOrderIds)
+	require.Empty(t, expiredSubaccountIds)
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, bobOrder.OrderId)
+	require.True(t, found)
+}
@@ -18,6 +18,7 @@ import (
 	flags "github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/rate_limit"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 type (
@@ -60,6 +61,10 @@ type (
 		placeCancelOrderRateLimiter rate_limit.RateLimiter[sdk.Msg]
 
 		DaemonLiquidationInfo *liquidationtypes.DaemonLiquidationInfo
+
+		// Snapshots of the orderbooks in the memclob served by the orderbook and simulate order queries.
+		// Updated in `PrepareCheckState` since the memclob must not be read concurrently from gRPC queries.
+		orderbookSnapshots *atomic.Pointer[OrderbookSnapshots]
 	}
 )
 
@@ -122,6 +127,7 @@ func NewKeeper(
 		Flags:                       clobFlags,
 		placeCancelOrderRateLimiter: placeCancelOrderRateLimiter,
 		DaemonLiquidationInfo:       daemonLiquidationInfo,
+		orderbookSnapshots:          &atomic.Pointer[OrderbookSnapshots]{},
 	}
 
 	// Provide the keeper to the MemClob.
@@ -257,7 +263,7 @@ func (k *Keeper) SetAnteHandler(anteHandler sdk.AnteHandler) {
 }
 
 // InitializeNewGrpcStreams initializes new gRPC streams for all uninitialized clob pairs
-// by sending the corresponding orderbook snapshots.
+// and subaccounts by sending the corresponding orderbook and subaccount snapshots.
 func (k Keeper) InitializeNewGrpcStreams(ctx sdk.Context) {
 	streamingManager := k.GetGrpcStreamingManager()
 	allUpdates := types.NewOffchainUpdates()
@@ -273,6 +279,17 @@ func (k Keeper) InitializeNewGrpcStreams(ctx sdk.Context) {
 	}
 
 	k.SendOrderbookUpdates(ctx, allUpdates, true)
+
+	uninitializedSubaccountIds := streamingManager.GetUninitializedSubaccountIds()
+	subaccountSnapshots := make([]satypes.StreamSubaccountUpdate, 0, len(uninitializedSubaccountIds))
+	for _, subaccountId := range uninitializedSubaccountIds {
+		subaccountSnapshots = append(
+			subaccountSnapshots,
+			k.subaccountsKeeper.GetStreamSubaccountUpdate(ctx, subaccountId),
+		)
+	}
+
+	k.subaccountsKeeper.SendSubaccountUpdates(ctx, subaccountSnapshots)
 }
 
 // SendOrderbookUpdates sends the offchain updates to the gRPC streaming manager.
@@ -293,7 +310,9 @@ func (k Keeper) SendOrderbookUpdates(
 	)
 }
 
-// SendOrderbookFillUpdates sends the orderbook fills to the gRPC streaming manager.
+// SendOrderbookFillUpdates sends the orderbook fills to the gRPC streaming manager. Fills of
+// DeliverTx are staged until the block is finalized, and are then sent along with the subaccount
+// updates of the block in the order they happened.
 func (k Keeper) SendOrderbookFillUpdates(
 	ctx sdk.Context,
 	orderbookFills []types.StreamOrderbookFill,
@@ -301,6 +320,12 @@ func (k Keeper) SendOrderbookFillUpdates(
 	if len(orderbookFills) == 0 {
 		return
 	}
+	if lib.IsDeliverTxMode(ctx) {
+		for _, orderbookFill := range orderbookFills {
+			k.GetGrpcStreamingManager().StageFinalizeBlockFill(ctx, orderbookFill)
+		}
+		return
+	}
 	k.GetGrpcStreamingManager().SendOrderbookFillUpdates(
 		ctx,
 		orderbookFills,
@@ -343,9 +343,10 @@ func (k Keeper) PlacePerpetualLiquidation(
 
 // IsLiquidatable returns true if the subaccount is able to be liquidated; that is,
 // if-and-only-if the maintenance margin requirement is non-zero and greater than the net collateral
-// of the subaccount.
-// If `GetNetCollateralAndMarginRequirements` returns an error, this function will return that
-// error to the caller.
+// of the subaccount. Perpetual positions are valued at the risk price of their perpetual, which is
+// the mark price for perpetuals that enable it and the oracle price otherwise.
+// If `GetNetCollateralAndMarginRequirementsAtRiskPrices` returns an error, this function will return
+// that error to the caller.
 func (k Keeper) IsLiquidatable(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -356,7 +357,7 @@ func (k Keeper) IsLiquidatable(
 	bigNetCollateral,
 		_,
 		bigMaintenanceMargin,
-		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirementsAtRiskPrices(
 		ctx,
 		satypes.Update{SubaccountId: subaccountId},
 	)
@@ -410,6 +411,9 @@ func (k Keeper) EnsureIsLiquidatable(
 //
 // Also note that this function does not check whether the given subaccount is liquidatable,
 // but validates that the provided deltaQuantums is valid with respect to the current position size.
+//
+// Unlike `IsLiquidatable`, the bankruptcy price is computed at oracle prices since liquidation fills
+// and insurance fund payments are settled and collateral-checked at oracle prices.
 func (k Keeper) GetBankruptcyPriceInQuoteQuantums(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -526,7 +530,8 @@ func (k Keeper) GetBankruptcyPriceInQuoteQuantums(
 }
 
 // GetFillablePrice returns the fillable-price of a subaccount’s position. It returns a rational
-// number to avoid rounding errors.
+// number to avoid rounding errors. The fillable price is derived from the oracle price and the
+// subaccount's oracle-price collateralization, which is what the liquidation fill is settled against.
 func (k Keeper) GetFillablePrice(
 	ctx sdk.Context,
 	subaccountId satypes.SubaccountId,
@@ -385,7 +385,8 @@ func TestPlacePerpetualLiquidation(t *testing.T) {
 
 func TestPlacePerpetualLiquidation_validateLiquidationAgainstClobPairStatus(t *testing.T) {
 	tests := map[string]struct {
-		status types.ClobPair_Status
+		status                types.ClobPair_Status
+		circuitBreakerTripped bool
 
 		expectedError error
 	}{
@@ -402,6 +403,19 @@ func TestPlacePerpetualLiquidation_validateLiquidationAgainstClobPairStatus(t *t
 
 			expectedError: types.ErrLiquidationConflictsWithClobPairStatus,
 		},
+		"Cannot liquidate in cancel-only state": {
+			status: types.ClobPair_STATUS_CANCEL_ONLY,
+
+			expectedError: types.ErrLiquidationConflictsWithClobPairStatus,
+		},
+		"Can liquidate in cancel-only state of a tripped circuit breaker": {
+			status:                types.ClobPair_STATUS_CANCEL_ONLY,
+			circuitBreakerTripped: true,
+		},
+		"Can liquidate in post-only state of a tripped circuit breaker": {
+			status:                types.ClobPair_STATUS_POST_ONLY,
+			circuitBreakerTripped: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -438,6 +452,10 @@ func TestPlacePerpetualLiquidation_validateLiquidationAgainstClobPairStatus(t *t
 			}
 
 			clobPair := constants.ClobPair_Btc
+			status := tc.status
+			if tc.circuitBreakerTripped {
+				status = types.ClobPair_STATUS_ACTIVE
+			}
 			_, err = ks.ClobKeeper.CreatePerpetualClobPair(
 				ctx,
 				clobPair.Id,
@@ -445,16 +463,51 @@ func TestPlacePerpetualLiquidation_validateLiquidationAgainstClobPairStatus(t *t
 				satypes.BaseQuantums(clobPair.StepBaseQuantums),
 				clobPair.QuantumConversionExponent,
 				clobPair.SubticksPerTick,
-				tc.status,
+				status,
 			)
 			require.NoError(t, err)
 
+			// Trip the circuit breaker, which transitions the clob pair to the tripped status.
+			if tc.circuitBreakerTripped {
+				deliverCtx := ks.Ctx.WithBlockHeight(1)
+				require.NoError(
+					t,
+					ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+						deliverCtx,
+						clobPair.GetClobPairId(),
+						nil,
+						&types.CircuitBreakerConfig{
+							MaxOraclePriceChangePpm: 100_000,
+							WindowBlocks:            5,
+							CooldownBlocks:          5,
+							TrippedStatus:           tc.status,
+						},
+					),
+				)
+				ks.ClobKeeper.UpdateCircuitBreakers(deliverCtx)
+				marketPrice, err := ks.PricesKeeper.GetMarketPrice(deliverCtx, 0)
+				require.NoError(t, err)
+				require.NoError(
+					t,
+					ks.PricesKeeper.UpdateMarketPrices(
+						deliverCtx,
+						[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: marketPrice.Price * 2}},
+					),
+				)
+				ks.ClobKeeper.UpdateCircuitBreakers(deliverCtx.WithBlockHeight(2))
+				gotClobPair, found := ks.ClobKeeper.GetClobPair(ctx, clobPair.GetClobPairId())
+				require.True(t, found)
+				require.Equal(t, tc.status, gotClobPair.Status)
+			}
+
 			_, _, err = ks.ClobKeeper.PlacePerpetualLiquidation(
 				ctx,
 				constants.LiquidationOrder_Dave_Num0_Clob0_Sell1BTC_Price50000,
 			)
 			if tc.expectedError != nil {
 				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NotErrorIs(t, err, types.ErrLiquidationConflictsWithClobPairStatus)
 			}
 		})
 	}
@@ -2104,6 +2157,7 @@ func TestPlacePerpetualLiquidation_Deleveraging(t *testing.T) {
 			for _, s := range tc.subaccounts {
 				ks.SubaccountsKeeper.SetSubaccount(ctx, s)
 			}
+			ks.SubaccountsKeeper.InitializeAdlPositions(ctx)
 
 			ks.ClobKeeper.DaemonLiquidationInfo.UpdateSubaccountsWithPositions(
 				clobtest.GetOpenPositionsFromSubaccounts(tc.subaccounts),
@@ -2413,6 +2467,71 @@ func TestIsLiquidatable(t *testing.T) {
 	}
 }
 
+func TestIsLiquidatable_MarkPrice(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On(
+		"AddBlockEvent",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+	// Find the maintenance margin requirement of a long BTC position at the oracle price.
+	subaccount := satypes.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		PerpetualPositions: []*satypes.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	}
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+	bigNotional, _, bigMaintenanceMargin, err := ks.SubaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ks.Ctx,
+		satypes.Update{SubaccountId: *subaccount.Id},
+	)
+	require.NoError(t, err)
+
+	// The net collateral of the subaccount equals its maintenance margin requirement at the oracle price.
+	subaccount.AssetPositions = keepertest.CreateUsdcAssetPosition(
+		new(big.Int).Sub(bigMaintenanceMargin, bigNotional),
+	)
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+	isLiquidatable, err := ks.ClobKeeper.IsLiquidatable(ks.Ctx, *subaccount.Id)
+	require.NoError(t, err)
+	require.False(t, isLiquidatable)
+
+	// The mark price of BTC is 1% below its oracle price.
+	require.NoError(t, ks.PerpetualsKeeper.AddPremiumVotes(
+		ks.Ctx,
+		[]perptypes.FundingPremium{{PerpetualId: 0, PremiumPpm: -10_000}},
+	))
+	_, err = ks.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ks.Ctx,
+		0,
+		&perptypes.MarkPriceConfig{EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	ks.PerpetualsKeeper.UpdateMarkPrices(ks.Ctx)
+
+	// The oracle price is used while the mark price is disabled.
+	isLiquidatable, err = ks.ClobKeeper.IsLiquidatable(ks.Ctx, *subaccount.Id)
+	require.NoError(t, err)
+	require.False(t, isLiquidatable)
+
+	_, err = ks.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ks.Ctx,
+		0,
+		&perptypes.MarkPriceConfig{Enabled: true, EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	isLiquidatable, err = ks.ClobKeeper.IsLiquidatable(ks.Ctx, *subaccount.Id)
+	require.NoError(t, err)
+	require.True(t, isLiquidatable)
+}
+
 func TestGetBankruptcyPriceInQuoteQuantums(t *testing.T) {
 	tests := map[string]struct {
 		// Parameters.
@@ -436,9 +436,14 @@ func (k Keeper) InitializeCumulativePnLs(
 	for clobPairId, metadata := range clobMetadata {
 		clobPair := metadata.ClobPair
 		// Get a mapping from perpetual Id to current perpetual funding index.
-		perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
-		if err != nil {
-			panic(perptypes.ErrPerpetualDoesNotExist)
+		// Spot CLOB pairs do not accrue funding.
+		fundingIndex := big.NewInt(0)
+		if !clobPair.IsSpotClobPair() {
+			perpetual, err := perpetualKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
+			if err != nil {
+				panic(perptypes.ErrPerpetualDoesNotExist)
+			}
+			fundingIndex = perpetual.FundingIndex.BigInt()
 		}
 
 		for _, cumulativePnL := range []map[types.ClobPairId]*CumulativePnL{
@@ -451,7 +456,7 @@ func (k Keeper) InitializeCumulativePnLs(
 				NumFills:                    0,
 				VolumeQuoteQuantums:         big.NewInt(0),
 				Metadata:                    metadata,
-				PerpetualFundingIndex:       perpetual.FundingIndex.BigInt(),
+				PerpetualFundingIndex:       new(big.Int).Set(fundingIndex),
 			}
 		}
 	}
@@ -488,6 +493,18 @@ func (k Keeper) GetMEVDataFromOperations(
 			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Decode the short-term orders placed as part of a batch for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return nil, err
+			}
+			msgBatchPlaceOrder := tx.GetMsgs()[0].(*types.MsgBatchPlaceOrder)
+			for _, orderIndex := range batchPlacement.OrderIndices {
+				order := msgBatchPlaceOrder.ShortTermOrders[orderIndex]
+				placedShortTermOrders[order.GetOrderId()] = order
+			}
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -694,6 +711,18 @@ func (k Keeper) CalculateSubaccountPnLForMatches(
 			msgPlaceOrder := tx.GetMsgs()[0].(*types.MsgPlaceOrder)
 			order := msgPlaceOrder.Order
 			placedShortTermOrders[order.GetOrderId()] = order
+		case *types.OperationRaw_ShortTermOrderBatchPlacement:
+			// Collect all the short-term orders placed as part of a batch for subsequent lookups.
+			batchPlacement := typedOperation.ShortTermOrderBatchPlacement
+			tx, err := k.txDecoder(batchPlacement.TxBytes)
+			if err != nil {
+				return err
+			}
+			msgBatchPlaceOrder := tx.GetMsgs()[0].(*types.MsgBatchPlaceOrder)
+			for _, orderIndex := range batchPlacement.OrderIndices {
+				order := msgBatchPlaceOrder.ShortTermOrders[orderIndex]
+				placedShortTermOrders[order.GetOrderId()] = order
+			}
 		case *types.OperationRaw_Match:
 			switch match := typedOperation.Match.Match.(type) {
 			case *types.ClobMatch_MatchOrders:
@@ -773,6 +802,10 @@ func (k Keeper) AddSettlementForPositionDelta(
 	clobPairToPnLs map[types.ClobPairId]*CumulativePnL,
 ) (err error) {
 	for _, cumulativePnL := range clobPairToPnLs {
+		// Spot CLOB pairs have no funding settlement.
+		if cumulativePnL.Metadata.ClobPair.IsSpotClobPair() {
+			continue
+		}
 		perpetualId := cumulativePnL.Metadata.ClobPair.MustGetPerpetualId()
 		for subaccountId, deltaQuantums := range cumulativePnL.SubaccountPositionSizeDelta {
 			// Get the subaccount and its perpetual positions.
@@ -847,7 +880,6 @@ func (c *CumulativePnL) AddPnLForTradeWithFilledSubticks(
 //
 // where n is the size of the trade, p is the price of the trade, p_mid is the mid price of validator's ordrbook,
 // and f is the fee subaccount pays for the matched trade.
-// This function returns an error if the clob pair associated with the order is a spot clob pair.
 func (c *CumulativePnL) AddPnLForTradeWithFilledQuoteQuantums(
 	subaccountId satypes.SubaccountId,
 	isBuy bool,
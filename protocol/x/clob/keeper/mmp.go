@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// marketMakerProtectionKey returns the state key of the market-maker protection of a subaccount on
+// a clob pair.
+func marketMakerProtectionKey(subaccountId satypes.SubaccountId, clobPairId types.ClobPairId) []byte {
+	return append(subaccountId.ToStateKey(), lib.Uint32ToKey(clobPairId.ToUint32())...)
+}
+
+// getMarketMakerProtectionConfigStore fetches a state store used for creating, reading, updating, and
+// deleting the market-maker protection settings of subaccounts from state.
+func (k Keeper) getMarketMakerProtectionConfigStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.MarketMakerProtectionConfigKeyPrefix),
+	)
+}
+
+// getMarketMakerProtectionStateStore fetches a state store used for creating, reading, updating, and
+// deleting the maker fills of subaccounts within the current market-maker protection window from state.
+func (k Keeper) getMarketMakerProtectionStateStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.MarketMakerProtectionStateKeyPrefix),
+	)
+}
+
+// GetMarketMakerProtectionConfig gets the market-maker protection settings of a subaccount on a clob
+// pair from state. Returns false if market-maker protection is not enabled.
+func (k Keeper) GetMarketMakerProtectionConfig(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+) (val types.MarketMakerProtectionConfig, found bool) {
+	store := k.getMarketMakerProtectionConfigStore(ctx)
+
+	b := store.Get(marketMakerProtectionKey(subaccountId, clobPairId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetMarketMakerProtectionConfig sets the market-maker protection settings of a subaccount on a clob
+// pair in state, or deletes them if `config` is empty. The maker fills accumulated so far and any
+// tripped market-maker protection are reset.
+func (k Keeper) SetMarketMakerProtectionConfig(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+	config types.MarketMakerProtectionConfig,
+) {
+	k.ResetMarketMakerProtection(ctx, subaccountId, clobPairId)
+
+	store := k.getMarketMakerProtectionConfigStore(ctx)
+	key := marketMakerProtectionKey(subaccountId, clobPairId)
+	if config.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&config))
+}
+
+// GetMarketMakerProtectionState gets the maker fills of a subaccount on a clob pair within the current
+// market-maker protection window from state. Returns an empty state if none exists.
+func (k Keeper) GetMarketMakerProtectionState(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+) (val types.MarketMakerProtectionState) {
+	store := k.getMarketMakerProtectionStateStore(ctx)
+
+	b := store.Get(marketMakerProtectionKey(subaccountId, clobPairId))
+	if b == nil {
+		return val
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// setMarketMakerProtectionState sets the maker fills of a subaccount on a clob pair within the current
+// market-maker protection window in state.
+func (k Keeper) setMarketMakerProtectionState(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+	state types.MarketMakerProtectionState,
+) {
+	store := k.getMarketMakerProtectionStateStore(ctx)
+	store.Set(marketMakerProtectionKey(subaccountId, clobPairId), k.cdc.MustMarshal(&state))
+}
+
+// ResetMarketMakerProtection resets the maker fills accumulated by a subaccount on a clob pair and any
+// tripped market-maker protection, allowing new orders to be placed immediately.
+func (k Keeper) ResetMarketMakerProtection(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+) {
+	store := k.getMarketMakerProtectionStateStore(ctx)
+	store.Delete(marketMakerProtectionKey(subaccountId, clobPairId))
+}
+
+// getMarketMakerProtectionBlockHeight returns the height of the block that orders placed and matched
+// with `ctx` are included in. Note that during `CheckTx` this is the next block height since
+// `ctx.BlockHeight()` is the height of the previously committed block.
+func getMarketMakerProtectionBlockHeight(ctx sdk.Context) uint32 {
+	if ctx.IsCheckTx() {
+		return lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+	}
+	return lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+}
+
+// IsMarketMakerProtectionTripped returns true if market-maker protection of the subaccount is tripped on
+// the clob pair, meaning that orders of the subaccount on the clob pair can neither be placed nor filled.
+func (k Keeper) IsMarketMakerProtectionTripped(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+) bool {
+	state := k.GetMarketMakerProtectionState(ctx, subaccountId, clobPairId)
+	return state.IsFrozen(getMarketMakerProtectionBlockHeight(ctx))
+}
+
+// ValidateMarketMakerProtectionNotTripped returns an error if market-maker protection of the subaccount
+// of `order` is tripped on the clob pair of the order, meaning that the order must be rejected.
+func (k Keeper) ValidateMarketMakerProtectionNotTripped(
+	ctx sdk.Context,
+	order types.Order,
+) error {
+	subaccountId := order.GetSubaccountId()
+	clobPairId := order.GetClobPairId()
+	state := k.GetMarketMakerProtectionState(ctx, subaccountId, clobPairId)
+	if state.IsFrozen(getMarketMakerProtectionBlockHeight(ctx)) {
+		return errorsmod.Wrapf(
+			types.ErrMarketMakerProtectionTripped,
+			"Subaccount %+v cannot place orders on clob pair %d until block %d",
+			subaccountId,
+			clobPairId,
+			state.FrozenUntilBlock+1,
+		)
+	}
+	return nil
+}
+
+// recordMakerFillForMarketMakerProtection adds a maker fill of a subaccount on a clob pair to the fills
+// accumulated within the current market-maker protection window. Fills are not tracked if market-maker
+// protection is not enabled or is already tripped. Once tripped, further maker fills of the subaccount on
+// the clob pair are rejected and the memclob removes the resting orders of the subaccount on the clob pair.
+// If market-maker protection is tripped during `DeliverTx`, the trip is added to
+// `ProcessProposerMatchesEvents` so that the orders of the subaccount on the clob pair are removed in the
+// `EndBlocker` and in `PrepareCheckState`.
+func (k Keeper) recordMakerFillForMarketMakerProtection(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	clobPairId types.ClobPairId,
+	fillAmount satypes.BaseQuantums,
+	bigFillQuoteQuantums *big.Int,
+) {
+	config, found := k.GetMarketMakerProtectionConfig(ctx, subaccountId, clobPairId)
+	if !found {
+		return
+	}
+
+	blockHeight := getMarketMakerProtectionBlockHeight(ctx)
+	state := k.GetMarketMakerProtectionState(ctx, subaccountId, clobPairId)
+	if state.IsFrozen(blockHeight) {
+		return
+	}
+
+	fillQuoteQuantums := uint64(math.MaxUint64)
+	if bigFillQuoteQuantums.IsUint64() {
+		fillQuoteQuantums = bigFillQuoteQuantums.Uint64()
+	}
+
+	tripped := state.AddMakerFill(config, blockHeight, fillAmount.ToUint64(), fillQuoteQuantums)
+	k.setMarketMakerProtectionState(ctx, subaccountId, clobPairId, state)
+	if !tripped {
+		return
+	}
+
+	log.InfoLog(
+		ctx,
+		"Market-maker protection tripped",
+		log.Subaccount, subaccountId,
+		log.ClobPairId, clobPairId,
+	)
+	metrics.IncrCounterWithLabels(
+		metrics.ClobMarketMakerProtectionTripped,
+		1,
+		metrics.GetLabelForIntValue(metrics.ClobPairId, int(clobPairId)),
+		metrics.GetLabelForBoolValue(metrics.CheckTx, ctx.IsCheckTx()),
+	)
+
+	if lib.IsDeliverTxMode(ctx) {
+		processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+		processProposerMatchesEvents.MarketMakerProtectionTrips = append(
+			processProposerMatchesEvents.MarketMakerProtectionTrips,
+			types.MarketMakerProtectionTrip{
+				SubaccountId: subaccountId,
+				ClobPairId:   clobPairId.ToUint32(),
+			},
+		)
+		k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+	}
+}
+
+// RemoveStatefulOrdersOfTrippedMarketMakers removes all stateful orders of the subaccount on the clob
+// pair of every market-maker protection trip from state, and emits an on-chain indexer event for each
+// removed order. Returns the ids of the removed orders, which should be removed from the memclob in
+// `PrepareCheckState`. Short-Term orders are removed from the memclob in `PrepareCheckState`, see
+// `CancelShortTermOrdersOfTrippedMarketMakers`.
+func (k Keeper) RemoveStatefulOrdersOfTrippedMarketMakers(
+	ctx sdk.Context,
+	trips []types.MarketMakerProtectionTrip,
+) (removedOrderIds []types.OrderId) {
+	removedOrderIds = make([]types.OrderId, 0)
+	for _, trip := range trips {
+		for _, order := range k.GetAllStatefulOrdersForSubaccount(ctx, trip.SubaccountId) {
+			if order.GetClobPairId().ToUint32() != trip.ClobPairId {
+				continue
+			}
+
+			k.MustRemoveStatefulOrder(ctx, order.OrderId)
+			removedOrderIds = append(removedOrderIds, order.OrderId)
+
+			k.GetIndexerEventManager().AddBlockEvent(
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewStatefulOrderRemovalEvent(
+						order.OrderId,
+						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION,
+					),
+				),
+			)
+		}
+	}
+	return removedOrderIds
+}
+
+// CancelShortTermOrdersOfTrippedMarketMakers removes all Short-Term orders of the subaccount on the
+// clob pair of every market-maker protection trip from the memclob.
+func (k Keeper) CancelShortTermOrdersOfTrippedMarketMakers(
+	ctx sdk.Context,
+	trips []types.MarketMakerProtectionTrip,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	for _, trip := range trips {
+		if _, err := k.CancelAllShortTermOrders(
+			ctx,
+			types.NewMsgCancelAllOrders(trip.SubaccountId, []uint32{trip.ClobPairId}),
+		); err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"Failed to cancel Short-Term orders of tripped market maker",
+				err,
+				log.Subaccount, trip.SubaccountId,
+				log.ClobPairId, trip.ClobPairId,
+			)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSetMarketMakerProtectionConfig(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	ctx := ks.Ctx
+
+	_, found := ks.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0)
+	require.False(t, found)
+
+	config := types.MarketMakerProtectionConfig{
+		WindowBlocks:      10,
+		MaxFilledQuantums: 1_000,
+		FreezeBlocks:      20,
+	}
+	ks.ClobKeeper.SetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0, config)
+	gotConfig, found := ks.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0)
+	require.True(t, found)
+	require.Equal(t, config, gotConfig)
+
+	// The config is scoped to the subaccount and clob pair.
+	_, found = ks.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 1)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Bob_Num0, 0)
+	require.False(t, found)
+
+	// Setting an empty config disables market-maker protection.
+	ks.ClobKeeper.SetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0, types.MarketMakerProtectionConfig{})
+	_, found = ks.ClobKeeper.GetMarketMakerProtectionConfig(ctx, constants.Alice_Num0, 0)
+	require.False(t, found)
+	require.Equal(
+		t,
+		types.MarketMakerProtectionState{},
+		ks.ClobKeeper.GetMarketMakerProtectionState(ctx, constants.Alice_Num0, 0),
+	)
+}
+
+func TestRemoveStatefulOrdersOfTrippedMarketMakers(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	ctx := ks.Ctx
+
+	aliceClob0Orders := []types.Order{
+		constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+	}
+	aliceClob1Order := constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25
+	bobOrder := constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell5_Price10_GTBT10
+	for _, order := range append(aliceClob0Orders, aliceClob1Order, bobOrder) {
+		ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+		ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ctx, order.MustGetUnixGoodTilBlockTime(), order.OrderId)
+	}
+
+	for _, order := range aliceClob0Orders {
+		indexerEventManager.On(
+			"AddBlockEvent",
+			mock.Anything,
+			indexerevents.SubtypeStatefulOrder,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					order.OrderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION,
+				),
+			),
+		).Once().Return()
+	}
+
+	// Only the orders of the tripped subaccount on the tripped clob pair are removed.
+	require.ElementsMatch(
+		t,
+		[]types.OrderId{aliceClob0Orders[0].OrderId, aliceClob0Orders[1].OrderId},
+		ks.ClobKeeper.RemoveStatefulOrdersOfTrippedMarketMakers(
+			ctx,
+			[]types.MarketMakerProtectionTrip{{SubaccountId: constants.Alice_Num0, ClobPairId: 0}},
+		),
+	)
+	indexerEventManager.AssertExpectations(t)
+
+	for _, order := range aliceClob0Orders {
+		_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+		require.False(t, found)
+	}
+	for _, order := range []types.Order{aliceClob1Order, bobOrder} {
+		_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+		require.True(t, found)
+	}
+}
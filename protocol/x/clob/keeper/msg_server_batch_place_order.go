@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// BatchPlaceOrder is a no-op. MsgBatchPlaceOrder only handles short term orders, which are placed on
+// the memclob in `CheckTx` and fail `ReCheckTx` so that the message is evicted from the mempool.
+// The proposer decodes the batch into per-order `ShortTermOrderBatchPlacement` operations, which are
+// executed in `ProcessProposerOperations`. The message itself is removed from the other txs of a
+// proposed block and such blocks are rejected in `ProcessProposal`, so this code path is not reached
+// in `DeliverTx`.
+func (k msgServer) BatchPlaceOrder(
+	goCtx context.Context,
+	msg *types.MsgBatchPlaceOrder,
+) (resp *types.MsgBatchPlaceOrderResponse, err error) {
+	return &types.MsgBatchPlaceOrderResponse{}, nil
+}
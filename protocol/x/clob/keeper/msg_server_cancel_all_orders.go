@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// CancelAllOrders performs cancellation of all stateful orders of a subaccount. Short-Term orders
+// are removed from the memclob in `CheckTx`, see `CancelAllShortTermOrders`.
+func (k msgServer) CancelAllOrders(
+	goCtx context.Context,
+	msg *types.MsgCancelAllOrders,
+) (resp *types.MsgCancelAllOrdersResponse, err error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.HandleMsgCancelAllOrders(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAllOrdersResponse{}, nil
+}
+
+// HandleMsgCancelAllOrders handles a MsgCancelAllOrders by
+// 1. validating that the clob pairs of the msg exist.
+// 2. removing all Long-Term and conditional orders of the subaccount on the clob pairs of the msg from state,
+// along with the other orders in their order groups or their suborders.
+// 3. updating ProcessProposerMatchesEvents with the new stateful order cancellations.
+// 4. adding an order cancellation on-chain indexer event for every canceled order.
+func (k Keeper) HandleMsgCancelAllOrders(
+	ctx sdk.Context,
+	msg *types.MsgCancelAllOrders,
+) (err error) {
+	lib.AssertDeliverTxMode(ctx)
+
+	// Attach various logging tags relative to this request. These should be static with no changes.
+	ctx = log.AddPersistentTagsToLogger(ctx,
+		log.Module, log.Clob,
+		log.ProposerConsAddress, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		log.Callback, lib.TxMode(ctx),
+		log.BlockHeight, ctx.BlockHeight(),
+		log.Handler, log.CancelAllOrders,
+		log.Msg, msg,
+	)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.CancelAllOrders,
+			metrics.DeliverTx,
+		)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Error cancelling all orders", err)
+		}
+	}()
+
+	// 1. Validate that the clob pairs exist.
+	for _, clobPairId := range msg.GetClobPairIds() {
+		if _, found := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); !found {
+			return errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"Invalid clob pair id %+v",
+				clobPairId,
+			)
+		}
+	}
+
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	for _, order := range k.GetAllStatefulOrdersForSubaccount(ctx, msg.SubaccountId) {
+		if !msg.ShouldCancelOrder(order.OrderId) {
+			continue
+		}
+
+		// Skip orders which were already removed from state along with a previously canceled order,
+		// since they were part of the same order group or were the suborder of a canceled TWAP order.
+		if _, found := k.GetLongTermOrderPlacement(ctx, order.OrderId); !found {
+			continue
+		}
+
+		// 2. Remove the order from state, and cancel the other orders in its order group or its suborder.
+		// Note that the fill amount is read beforehand since it is removed from state.
+		_, fillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId)
+		k.MustRemoveStatefulOrder(ctx, order.OrderId)
+		canceledOrderIds := k.CancelOrderGroupSiblings(ctx, order, fillAmount > 0)
+		canceledOrderIds = append(canceledOrderIds, k.CancelTwapSuborder(ctx, order.OrderId)...)
+
+		// 3. Update `ProcessProposerMatchesEvents` with the new stateful order cancellation and the
+		// canceled orders of its order group or its canceled suborder.
+		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds = append(
+			processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
+			order.OrderId,
+		)
+		processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+			processProposerMatchesEvents.RemovedStatefulOrderIds,
+			canceledOrderIds...,
+		)
+
+		// 4. Add the relevant on-chain Indexer event for the cancellation.
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					order.OrderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+				),
+			),
+		)
+	}
+	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+
+	return nil
+}
@@ -32,8 +32,9 @@ func (k msgServer) CancelOrder(
 
 // HandleMsgCancelOrder handles a MsgCancelOrder by
 // 1. persisting the cancellation on chain.
-// 2. updating ProcessProposerMatchesEvents with the new stateful order cancellation.
-// 3. adding order cancellation on-chain indexer event.
+// 2. canceling the other orders in the order group or the suborder of the canceled order.
+// 3. updating ProcessProposerMatchesEvents with the new stateful order cancellation.
+// 4. adding order cancellation on-chain indexer event.
 func (k Keeper) HandleMsgCancelOrder(
 	ctx sdk.Context,
 	msg *types.MsgCancelOrder,
@@ -96,21 +97,34 @@ func (k Keeper) HandleMsgCancelOrder(
 	// 2. Cancel the order on the ClobKeeper which is responsible for:
 	//   - stateful cancellation validation.
 	//   - removing the order from state and the memstore.
+	// Note that the order and its fill amount are read beforehand since they are removed from state.
+	orderPlacement, _ := k.GetLongTermOrderPlacement(ctx, msg.OrderId)
+	_, fillAmount, _ := k.GetOrderFillAmount(ctx, msg.OrderId)
 	if err := k.CancelStatefulOrder(ctx, msg); err != nil {
 		return err
 	}
 
-	// 3. Update `ProcessProposerMatchesEvents` with the new stateful order cancellation.
+	// 3. Cancel the other orders in the order group of the canceled order, or the suborder of a
+	// canceled TWAP order.
+	canceledOrderIds := k.CancelOrderGroupSiblings(ctx, orderPlacement.Order, fillAmount > 0)
+	canceledOrderIds = append(canceledOrderIds, k.CancelTwapSuborder(ctx, msg.OrderId)...)
+
+	// 4. Update `ProcessProposerMatchesEvents` with the new stateful order cancellation and the
+	// canceled orders of its order group or its canceled suborder.
 	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
 
 	processProposerMatchesEvents.PlacedStatefulCancellationOrderIds = append(
 		processProposerMatchesEvents.PlacedStatefulCancellationOrderIds,
 		msg.OrderId,
 	)
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		canceledOrderIds...,
+	)
 
 	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
 
-	// 4. Add the relevant on-chain Indexer event for the cancellation.
+	// 5. Add the relevant on-chain Indexer event for the cancellation.
 	k.GetIndexerEventManager().AddTxnEvent(
 		ctx,
 		indexerevents.SubtypeStatefulOrder,
@@ -26,23 +26,51 @@ func (k msgServer) CreateClobPair(
 		)
 	}
 
-	perpetualId, err := msg.ClobPair.GetPerpetualId()
-	if err != nil {
-		return nil, err
+	switch msg.ClobPair.Metadata.(type) {
+	case *types.ClobPair_SpotClobMetadata:
+		// `MsgCreateClobPair.ValidateBasic` ensures that `msg.ClobPair.Metadata` is a valid `SpotClobMetadata`.
+		baseAssetId, quoteAssetId := msg.ClobPair.MustGetSpotAssetIds()
+		if _, err := k.Keeper.CreateSpotClobPair(
+			ctx,
+			msg.ClobPair.Id,
+			baseAssetId,
+			quoteAssetId,
+			satypes.BaseQuantums(msg.ClobPair.StepBaseQuantums),
+			msg.ClobPair.QuantumConversionExponent,
+			msg.ClobPair.SubticksPerTick,
+			msg.ClobPair.Status,
+		); err != nil {
+			return nil, err
+		}
+	default:
+		perpetualId, err := msg.ClobPair.GetPerpetualId()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := k.Keeper.CreatePerpetualClobPair(
+			ctx,
+			msg.ClobPair.Id,
+			perpetualId,
+			satypes.BaseQuantums(msg.ClobPair.StepBaseQuantums),
+			msg.ClobPair.QuantumConversionExponent,
+			msg.ClobPair.SubticksPerTick,
+			msg.ClobPair.Status,
+		); err != nil {
+			return nil, err
+		}
 	}
 
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
-	if _, err := k.Keeper.CreatePerpetualClobPair(
-		ctx,
-		msg.ClobPair.Id,
-		// `MsgCreateClobPair.ValidateBasic` ensures that `msg.ClobPair.Metadata` is `PerpetualClobMetadata`.
-		perpetualId,
-		satypes.BaseQuantums(msg.ClobPair.StepBaseQuantums),
-		msg.ClobPair.QuantumConversionExponent,
-		msg.ClobPair.SubticksPerTick,
-		msg.ClobPair.Status,
-	); err != nil {
-		return nil, err
+	if msg.ClobPair.PriceBandConfig != nil || msg.ClobPair.CircuitBreakerConfig != nil {
+		if err := k.Keeper.SetClobPairPriceProtectionConfigs(
+			ctx,
+			msg.ClobPair.GetClobPairId(),
+			msg.ClobPair.PriceBandConfig,
+			msg.ClobPair.CircuitBreakerConfig,
+		); err != nil {
+			return nil, err
+		}
 	}
+
 	return &types.MsgCreateClobPairResponse{}, nil
 }
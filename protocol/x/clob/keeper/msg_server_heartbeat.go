@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// Heartbeat arms, refreshes or disarms the dead man's switch of a subaccount.
+func (k msgServer) Heartbeat(
+	goCtx context.Context,
+	msg *types.MsgHeartbeat,
+) (*types.MsgHeartbeatResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	k.Keeper.HandleMsgHeartbeat(ctx, msg)
+
+	return &types.MsgHeartbeatResponse{}, nil
+}
+
+// HandleMsgHeartbeat handles a MsgHeartbeat by disarming the dead man's switch of the subaccount if the
+// timeout of the msg is zero, and otherwise arming it such that all stateful orders of the subaccount
+// are canceled in the `EndBlocker` once the timeout elapses without another heartbeat.
+func (k Keeper) HandleMsgHeartbeat(
+	ctx sdk.Context,
+	msg *types.MsgHeartbeat,
+) {
+	lib.AssertDeliverTxMode(ctx)
+
+	// Attach various logging tags relative to this request. These should be static with no changes.
+	ctx = log.AddPersistentTagsToLogger(ctx,
+		log.Module, log.Clob,
+		log.Callback, lib.TxMode(ctx),
+		log.BlockHeight, ctx.BlockHeight(),
+		log.Handler, log.Heartbeat,
+		log.Msg, msg,
+	)
+
+	if msg.TimeoutSeconds == 0 {
+		k.DeleteSubaccountHeartbeat(ctx, msg.SubaccountId)
+		log.DebugLog(ctx, "Disarmed dead man's switch")
+	} else {
+		k.SetSubaccountHeartbeat(ctx, msg.SubaccountId, msg.TimeoutSeconds)
+		log.DebugLog(ctx, "Armed dead man's switch")
+	}
+
+	metrics.IncrCountMetricWithLabels(types.ModuleName, metrics.Heartbeat)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// SetMarketMakerProtectionConfig sets or clears the market-maker protection settings of a subaccount
+// on a clob pair.
+func (k msgServer) SetMarketMakerProtectionConfig(
+	goCtx context.Context,
+	msg *types.MsgSetMarketMakerProtectionConfig,
+) (*types.MsgSetMarketMakerProtectionConfigResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.HandleMsgSetMarketMakerProtectionConfig(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMarketMakerProtectionConfigResponse{}, nil
+}
+
+// ResetMarketMakerProtection resets tripped market-maker protection of a subaccount on a clob pair.
+func (k msgServer) ResetMarketMakerProtection(
+	goCtx context.Context,
+	msg *types.MsgResetMarketMakerProtection,
+) (*types.MsgResetMarketMakerProtectionResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.HandleMsgResetMarketMakerProtection(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetMarketMakerProtectionResponse{}, nil
+}
+
+// HandleMsgSetMarketMakerProtectionConfig handles a MsgSetMarketMakerProtectionConfig by validating that
+// the clob pair of the msg exists, and setting the market-maker protection settings of the subaccount on
+// the clob pair. The maker fills accumulated so far and any tripped market-maker protection are reset.
+func (k Keeper) HandleMsgSetMarketMakerProtectionConfig(
+	ctx sdk.Context,
+	msg *types.MsgSetMarketMakerProtectionConfig,
+) error {
+	lib.AssertDeliverTxMode(ctx)
+	ctx = addMarketMakerProtectionLogTags(ctx, msg)
+
+	clobPairId := types.ClobPairId(msg.ClobPairId)
+	if err := k.validateMarketMakerProtectionClobPair(ctx, clobPairId); err != nil {
+		return err
+	}
+
+	k.SetMarketMakerProtectionConfig(ctx, msg.SubaccountId, clobPairId, msg.Config)
+	log.DebugLog(ctx, "Set market-maker protection config")
+	return nil
+}
+
+// HandleMsgResetMarketMakerProtection handles a MsgResetMarketMakerProtection by validating that the
+// clob pair of the msg exists, and resetting market-maker protection of the subaccount on the clob pair
+// such that new orders can be placed immediately.
+func (k Keeper) HandleMsgResetMarketMakerProtection(
+	ctx sdk.Context,
+	msg *types.MsgResetMarketMakerProtection,
+) error {
+	lib.AssertDeliverTxMode(ctx)
+	ctx = addMarketMakerProtectionLogTags(ctx, msg)
+
+	clobPairId := types.ClobPairId(msg.ClobPairId)
+	if err := k.validateMarketMakerProtectionClobPair(ctx, clobPairId); err != nil {
+		return err
+	}
+
+	k.ResetMarketMakerProtection(ctx, msg.SubaccountId, clobPairId)
+	log.DebugLog(ctx, "Reset market-maker protection")
+	return nil
+}
+
+// addMarketMakerProtectionLogTags attaches various logging tags relative to a market-maker protection
+// request. These should be static with no changes.
+func addMarketMakerProtectionLogTags(ctx sdk.Context, msg sdk.Msg) sdk.Context {
+	return log.AddPersistentTagsToLogger(ctx,
+		log.Module, log.Clob,
+		log.Callback, lib.TxMode(ctx),
+		log.BlockHeight, ctx.BlockHeight(),
+		log.Handler, log.MarketMakerProtection,
+		log.Msg, msg,
+	)
+}
+
+// validateMarketMakerProtectionClobPair returns an error if the clob pair does not exist.
+func (k Keeper) validateMarketMakerProtectionClobPair(ctx sdk.Context, clobPairId types.ClobPairId) error {
+	if _, found := k.GetClobPair(ctx, clobPairId); !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClobPairParameter,
+			"Invalid clob pair id %+v",
+			clobPairId,
+		)
+	}
+	return nil
+}
@@ -113,8 +113,20 @@ func (k Keeper) HandleMsgPlaceOrder(
 		return err
 	}
 
-	// 4. Emit the new order placement indexer event.
-	if order.IsConditionalOrder() {
+	// 4. Emit the new order placement indexer event. TWAP orders are not placed on the memclob, their
+	// suborders are generated in the `EndBlocker` instead.
+	if order.IsTwapOrder() {
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewTwapOrderPlacementEvent(
+					order,
+				),
+			),
+		)
+	} else if order.IsConditionalOrder() {
 		k.GetIndexerEventManager().AddTxnEvent(
 			ctx,
 			indexerevents.SubtypeStatefulOrder,
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ReplaceOrder is the entry point for `MsgReplaceOrder` messages executed in `runMsgs` during `DeliverTx`.
+func (k msgServer) ReplaceOrder(goCtx context.Context, msg *types.MsgReplaceOrder) (
+	resp *types.MsgReplaceOrderResponse,
+	err error,
+) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.HandleMsgReplaceOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReplaceOrderResponse{}, nil
+}
+
+// HandleMsgReplaceOrder handles a MsgReplaceOrder by
+// 1. atomically replacing the existing stateful order on chain.
+// 2. updating ProcessProposerMatchesEvents with the stateful order replacement.
+// 3. adding a single order replacement on-chain indexer event.
+func (k Keeper) HandleMsgReplaceOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (err error) {
+	lib.AssertDeliverTxMode(ctx)
+
+	// Attach various logging tags relative to this request. These should be static with no changes.
+	ctx = log.AddPersistentTagsToLogger(ctx,
+		log.Module, log.Clob,
+		log.ProposerConsAddress, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		log.Callback, lib.TxMode(ctx),
+		log.BlockHeight, ctx.BlockHeight(),
+		log.Handler, log.ReplaceOrder,
+		log.Msg, msg,
+	)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceOrder,
+			metrics.DeliverTx,
+			msg.Order.GetOrderLabels()...,
+		)
+		if err != nil {
+			log.InfoLog(ctx, "Error replacing order", log.Error, err)
+		}
+	}()
+
+	// 1. Ensure the order is not a Short-Term order.
+	order := msg.GetOrder()
+	order.MustBeStatefulOrder()
+
+	// 2. Return an error if an associated cancellation or removal already exists in the current block.
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	cancelledOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.PlacedStatefulCancellationOrderIds)
+	if _, found := cancelledOrderIds[order.GetOrderId()]; found {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderPreviouslyCancelled,
+			"ReplaceOrder: order (%+v)",
+			order,
+		)
+	}
+	removedOrderIds := lib.UniqueSliceToSet(processProposerMatchesEvents.RemovedStatefulOrderIds)
+	if _, found := removedOrderIds[order.GetOrderId()]; found {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderPreviouslyRemoved,
+			"ReplaceOrder: order (%+v)",
+			order,
+		)
+	}
+
+	// 3. Replace the order on the ClobKeeper which is responsible for:
+	//   - stateful order replacement validation.
+	//   - collateralization check.
+	//   - replacing the order in state and the memstore.
+	if err := k.ReplaceStatefulOrder(ctx, msg); err != nil {
+		return err
+	}
+
+	// 4. Emit a single order replacement indexer event.
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderReplacementEvent(
+				order,
+			),
+		),
+	)
+
+	// 5. Add the replaced stateful order to `ProcessProposerMatchesEvents` for use in `PrepareCheckState`.
+	processProposerMatchesEvents.ReplacedStatefulOrderIds = append(
+		processProposerMatchesEvents.ReplacedStatefulOrderIds,
+		order.OrderId,
+	)
+	k.MustSetProcessProposerMatchesEvents(
+		ctx,
+		processProposerMatchesEvents,
+	)
+
+	return nil
+}
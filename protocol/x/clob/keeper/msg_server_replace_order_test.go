@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrder_PanicIfShortTermOrder(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	require.Panicsf(
+		t,
+		func() {
+			//nolint: errcheck
+			msgServer.ReplaceOrder(ks.Ctx.WithIsCheckTx(false), types.NewMsgReplaceOrder(order))
+		},
+		"MustBeStatefulOrder: called with non-stateful order ID (%+v)",
+		order.OrderId,
+	)
+}
+
+func TestReplaceOrder_Error(t *testing.T) {
+	sellOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	sellOrder.Side = types.Order_SIDE_SELL
+
+	tests := map[string]struct {
+		// State.
+		existingOrder                *types.Order
+		existingFillAmount           satypes.BaseQuantums
+		processProposerMatchesEvents types.ProcessProposerMatchesEvents
+
+		// Parameters.
+		replacementOrder types.Order
+
+		// Expectations.
+		expectedError error
+	}{
+		"Returns an error when the order does not exist": {
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			expectedError:    types.ErrStatefulOrderDoesNotExist,
+		},
+		"Returns an error when the order was cancelled in the current block": {
+			existingOrder: &constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			processProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				PlacedStatefulCancellationOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				},
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			expectedError:    types.ErrStatefulOrderPreviouslyCancelled,
+		},
+		"Returns an error when the order was removed in the current block": {
+			existingOrder: &constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			processProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				},
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			expectedError:    types.ErrStatefulOrderPreviouslyRemoved,
+		},
+		"Returns an error when the replacement changes the order side": {
+			existingOrder:    &constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			replacementOrder: sellOrder,
+			expectedError:    types.ErrInvalidStatefulOrderReplacement,
+		},
+		"Returns an error when the replacement size does not exceed the fill amount": {
+			existingOrder:      &constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			existingFillAmount: 5,
+			replacementOrder:   constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			expectedError:      types.ErrInvalidStatefulOrderReplacement,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+			ctx := ks.Ctx.WithBlockHeight(2).WithIsCheckTx(false).WithIsReCheckTx(false)
+			tc.processProposerMatchesEvents.BlockHeight = 2
+			ks.ClobKeeper.MustSetProcessProposerMatchesEvents(ctx, tc.processProposerMatchesEvents)
+
+			if tc.existingOrder != nil {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, *tc.existingOrder, 1)
+			}
+			if tc.existingFillAmount != 0 {
+				ks.ClobKeeper.SetOrderFillAmount(ctx, tc.replacementOrder.OrderId, tc.existingFillAmount, 20)
+			}
+
+			_, err := msgServer.ReplaceOrder(ctx, types.NewMsgReplaceOrder(tc.replacementOrder))
+			require.ErrorIs(t, err, tc.expectedError)
+
+			// Verify the existing order is unchanged and no replacement was recorded.
+			if tc.existingOrder != nil {
+				placement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, tc.existingOrder.OrderId)
+				require.True(t, found)
+				require.Equal(t, *tc.existingOrder, placement.Order)
+			}
+			require.Empty(t, ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx).ReplacedStatefulOrderIds)
+		})
+	}
+}
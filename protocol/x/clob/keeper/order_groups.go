@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getOrderGroupStore fetches a state store used for creating, reading, updating, and deleting
+// order groups from state.
+func (k Keeper) getOrderGroupStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.OrderGroupKeyPrefix),
+	)
+}
+
+// orderGroupKey returns the state key of the order group with id `orderGroupId` of a subaccount.
+func orderGroupKey(subaccountId satypes.SubaccountId, orderGroupId uint32) []byte {
+	return append(subaccountId.ToStateKey(), lib.Uint32ToKey(orderGroupId)...)
+}
+
+// GetOrderGroup gets the order group with id `orderGroupId` of a subaccount from state.
+// Returns false if the order group has no orders in state.
+func (k Keeper) GetOrderGroup(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+) (val types.OrderGroup, found bool) {
+	store := k.getOrderGroupStore(ctx)
+
+	b := store.Get(orderGroupKey(subaccountId, orderGroupId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// setOrderGroup writes an order group to state. The order group is deleted from state if it
+// contains no orders.
+func (k Keeper) setOrderGroup(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	orderGroupId uint32,
+	orderGroup types.OrderGroup,
+) {
+	store := k.getOrderGroupStore(ctx)
+	key := orderGroupKey(subaccountId, orderGroupId)
+
+	if len(orderGroup.OrderIds) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&orderGroup))
+}
+
+// addOrderToOrderGroup adds the order id of a newly-placed stateful order to its order group in state.
+// This is a no-op if the order is not part of an order group.
+func (k Keeper) addOrderToOrderGroup(ctx sdk.Context, order types.Order) {
+	if !order.IsInOrderGroup() {
+		return
+	}
+
+	subaccountId := order.OrderId.SubaccountId
+	orderGroup, found := k.GetOrderGroup(ctx, subaccountId, order.OrderGroupId)
+	if !found {
+		orderGroup = types.OrderGroup{
+			Type: order.OrderGroupType,
+		}
+	}
+
+	orderGroup.OrderIds = append(orderGroup.OrderIds, order.OrderId)
+	k.setOrderGroup(ctx, subaccountId, order.OrderGroupId, orderGroup)
+}
+
+// removeOrderFromOrderGroup removes the order id of a stateful order from its order group in state.
+// This is a no-op if the order is not part of an order group.
+func (k Keeper) removeOrderFromOrderGroup(ctx sdk.Context, order types.Order) {
+	if !order.IsInOrderGroup() {
+		return
+	}
+
+	subaccountId := order.OrderId.SubaccountId
+	orderGroup, found := k.GetOrderGroup(ctx, subaccountId, order.OrderGroupId)
+	if !found {
+		return
+	}
+
+	orderIds := make([]types.OrderId, 0, len(orderGroup.OrderIds))
+	for _, orderId := range orderGroup.OrderIds {
+		if orderId != order.OrderId {
+			orderIds = append(orderIds, orderId)
+		}
+	}
+	orderGroup.OrderIds = orderIds
+	k.setOrderGroup(ctx, subaccountId, order.OrderGroupId, orderGroup)
+}
+
+// ValidateOrderGroup performs stateful validation of the order group of a newly-placed stateful order.
+// An error is returned if any of the following conditions are true:
+//   - The order group in state has a different order group type or `ClobPairId` than the order.
+//   - The order group in state already contains `MaxOrderGroupSize` orders.
+//   - The order is the entry order of a bracket order group that already has an entry order.
+func (k Keeper) ValidateOrderGroup(ctx sdk.Context, order types.Order) error {
+	if !order.IsInOrderGroup() {
+		return nil
+	}
+
+	orderGroup, found := k.GetOrderGroup(ctx, order.OrderId.SubaccountId, order.OrderGroupId)
+	if !found {
+		return nil
+	}
+
+	if orderGroup.Type != order.OrderGroupType {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderGroup,
+			"order group type %s does not match order group type %s of order group %d",
+			order.OrderGroupType,
+			orderGroup.Type,
+			order.OrderGroupId,
+		)
+	}
+
+	if len(orderGroup.OrderIds) >= int(types.MaxOrderGroupSize) {
+		return errorsmod.Wrapf(
+			types.ErrOrderGroupFull,
+			"order group %d already contains %d orders",
+			order.OrderGroupId,
+			len(orderGroup.OrderIds),
+		)
+	}
+
+	for _, orderId := range orderGroup.OrderIds {
+		if orderId.ClobPairId != order.OrderId.ClobPairId {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"order group %d contains orders for ClobPair %d, got order for ClobPair %d",
+				order.OrderGroupId,
+				orderId.ClobPairId,
+				order.OrderId.ClobPairId,
+			)
+		}
+
+		if order.IsBracketEntryOrder() && !orderId.IsConditionalOrder() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderGroup,
+				"bracket order group %d already contains entry order %+v",
+				order.OrderGroupId,
+				orderId,
+			)
+		}
+	}
+
+	return nil
+}
+
+// CancelOrderGroupSiblings removes all other orders in the order group of `order` from state and emits
+// an on-chain indexer event for each removed order. It should be called when `order` is filled or
+// removed from state. Removing the entry order of a bracket order group only cancels the other orders
+// in the group if the entry order was never filled, and filling the entry order does not cancel them.
+// Returns the ids of the removed orders, which should be removed from the memclob in `PrepareCheckState`.
+func (k Keeper) CancelOrderGroupSiblings(
+	ctx sdk.Context,
+	order types.Order,
+	orderFilled bool,
+) (canceledOrderIds []types.OrderId) {
+	canceledOrderIds = make([]types.OrderId, 0)
+	if !order.IsInOrderGroup() || (order.IsBracketEntryOrder() && orderFilled) {
+		return canceledOrderIds
+	}
+
+	orderGroup, found := k.GetOrderGroup(ctx, order.OrderId.SubaccountId, order.OrderGroupId)
+	if !found {
+		return canceledOrderIds
+	}
+
+	for _, orderId := range orderGroup.OrderIds {
+		if orderId == order.OrderId {
+			continue
+		}
+
+		k.MustRemoveStatefulOrder(ctx, orderId)
+		canceledOrderIds = append(canceledOrderIds, orderId)
+
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED,
+				),
+			),
+		)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, metrics.OrderGroupCanceled, metrics.StatefulOrderRemoved, metrics.Count},
+			1,
+			orderId.GetOrderIdLabels(),
+		)
+	}
+
+	return canceledOrderIds
+}
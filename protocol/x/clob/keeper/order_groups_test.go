@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+// withOrderGroup returns a copy of `order` that is part of the order group with id `orderGroupId`.
+func withOrderGroup(order types.Order, orderGroupId uint32, orderGroupType types.Order_OrderGroupType) types.Order {
+	order.OrderGroupId = orderGroupId
+	order.OrderGroupType = orderGroupType
+	return order
+}
+
+var (
+	bracketEntry = withOrderGroup(
+		constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+		1,
+		types.Order_ORDER_GROUP_TYPE_BRACKET,
+	)
+	bracketTakeProfit = withOrderGroup(
+		constants.ConditionalOrder_Alice_Num0_Id2_Clob0_Sell20_Price20_GTBT15_TakeProfit20,
+		1,
+		types.Order_ORDER_GROUP_TYPE_BRACKET,
+	)
+	bracketStopLoss = withOrderGroup(
+		constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell25_Price10_GTBT15_StopLoss10,
+		1,
+		types.Order_ORDER_GROUP_TYPE_BRACKET,
+	)
+)
+
+func TestOrderGroup_SetAndDeleteLongTermOrderPlacement(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+	subaccountId := constants.Alice_Num0
+
+	// Orders that are not part of an order group are not added to an order group.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10, 0)
+	_, found := ks.ClobKeeper.GetOrderGroup(ks.Ctx, subaccountId, 0)
+	require.False(t, found)
+
+	// Orders are added to their order group when placed.
+	for _, order := range []types.Order{bracketEntry, bracketTakeProfit, bracketStopLoss} {
+		ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, 0)
+	}
+	orderGroup, found := ks.ClobKeeper.GetOrderGroup(ks.Ctx, subaccountId, 1)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.OrderGroup{
+			Type: types.Order_ORDER_GROUP_TYPE_BRACKET,
+			OrderIds: []types.OrderId{
+				bracketEntry.OrderId,
+				bracketTakeProfit.OrderId,
+				bracketStopLoss.OrderId,
+			},
+		},
+		orderGroup,
+	)
+
+	// Overwriting an existing order does not add it to its order group again.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, bracketEntry, 1)
+	orderGroup, found = ks.ClobKeeper.GetOrderGroup(ks.Ctx, subaccountId, 1)
+	require.True(t, found)
+	require.Len(t, orderGroup.OrderIds, 3)
+
+	// Orders are removed from their order group when deleted.
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, bracketEntry.OrderId)
+	orderGroup, found = ks.ClobKeeper.GetOrderGroup(ks.Ctx, subaccountId, 1)
+	require.True(t, found)
+	require.Equal(
+		t,
+		[]types.OrderId{bracketTakeProfit.OrderId, bracketStopLoss.OrderId},
+		orderGroup.OrderIds,
+	)
+
+	// The order group is removed from state once it has no orders.
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, bracketTakeProfit.OrderId)
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, bracketStopLoss.OrderId)
+	_, found = ks.ClobKeeper.GetOrderGroup(ks.Ctx, subaccountId, 1)
+	require.False(t, found)
+}
+
+func TestValidateOrderGroup(t *testing.T) {
+	tests := map[string]struct {
+		existingOrders []types.Order
+		order          types.Order
+		expectedErr    error
+	}{
+		"Succeeds for order that is not part of an order group": {
+			existingOrders: []types.Order{bracketEntry},
+			order:          constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+		},
+		"Succeeds for first order of an order group": {
+			order: bracketEntry,
+		},
+		"Succeeds for conditional orders of a bracket order group": {
+			existingOrders: []types.Order{bracketEntry, bracketTakeProfit},
+			order:          bracketStopLoss,
+		},
+		"Fails for order group type mismatch": {
+			existingOrders: []types.Order{bracketEntry},
+			order:          withOrderGroup(bracketTakeProfit, 1, types.Order_ORDER_GROUP_TYPE_OCO),
+			expectedErr:    types.ErrInvalidOrderGroup,
+		},
+		"Fails for ClobPair mismatch": {
+			existingOrders: []types.Order{bracketEntry},
+			order: withOrderGroup(
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTBT15_StopLoss20,
+				1,
+				types.Order_ORDER_GROUP_TYPE_BRACKET,
+			),
+			expectedErr: types.ErrInvalidOrderGroup,
+		},
+		"Fails for second entry order of a bracket order group": {
+			existingOrders: []types.Order{bracketEntry},
+			order: withOrderGroup(
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+				1,
+				types.Order_ORDER_GROUP_TYPE_BRACKET,
+			),
+			expectedErr: types.ErrInvalidOrderGroup,
+		},
+		"Fails for full order group": {
+			existingOrders: []types.Order{
+				withOrderGroup(bracketEntry, 2, types.Order_ORDER_GROUP_TYPE_OCO),
+				withOrderGroup(bracketTakeProfit, 2, types.Order_ORDER_GROUP_TYPE_OCO),
+				withOrderGroup(bracketStopLoss, 2, types.Order_ORDER_GROUP_TYPE_OCO),
+			},
+			order: withOrderGroup(
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+				2,
+				types.Order_ORDER_GROUP_TYPE_OCO,
+			),
+			expectedErr: types.ErrOrderGroupFull,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			for _, order := range tc.existingOrders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, 0)
+			}
+
+			err := ks.ClobKeeper.ValidateOrderGroup(ks.Ctx, tc.order)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCancelOrderGroupSiblings(t *testing.T) {
+	ocoTakeProfit := withOrderGroup(bracketTakeProfit, 2, types.Order_ORDER_GROUP_TYPE_OCO)
+	ocoStopLoss := withOrderGroup(bracketStopLoss, 2, types.Order_ORDER_GROUP_TYPE_OCO)
+
+	tests := map[string]struct {
+		existingOrders []types.Order
+		order          types.Order
+		orderFilled    bool
+
+		expectedCanceledOrderIds []types.OrderId
+	}{
+		"Does not cancel orders for order that is not part of an order group": {
+			existingOrders:           []types.Order{bracketEntry, bracketTakeProfit, bracketStopLoss},
+			order:                    constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+			expectedCanceledOrderIds: []types.OrderId{},
+		},
+		"Cancels other order of OCO order group when order is filled": {
+			existingOrders:           []types.Order{ocoTakeProfit, ocoStopLoss},
+			order:                    ocoTakeProfit,
+			orderFilled:              true,
+			expectedCanceledOrderIds: []types.OrderId{ocoStopLoss.OrderId},
+		},
+		"Cancels other order of OCO order group when order is removed": {
+			existingOrders:           []types.Order{ocoTakeProfit},
+			order:                    ocoStopLoss,
+			expectedCanceledOrderIds: []types.OrderId{ocoTakeProfit.OrderId},
+		},
+		"Does not cancel conditional orders of bracket order group when entry order is filled": {
+			existingOrders:           []types.Order{bracketEntry, bracketTakeProfit, bracketStopLoss},
+			order:                    bracketEntry,
+			orderFilled:              true,
+			expectedCanceledOrderIds: []types.OrderId{},
+		},
+		"Cancels conditional orders of bracket order group when unfilled entry order is removed": {
+			existingOrders: []types.Order{bracketTakeProfit, bracketStopLoss},
+			order:          bracketEntry,
+			expectedCanceledOrderIds: []types.OrderId{
+				bracketTakeProfit.OrderId,
+				bracketStopLoss.OrderId,
+			},
+		},
+		"Cancels other orders of bracket order group when conditional order is filled": {
+			existingOrders:           []types.Order{bracketEntry, bracketTakeProfit, bracketStopLoss},
+			order:                    bracketStopLoss,
+			orderFilled:              true,
+			expectedCanceledOrderIds: []types.OrderId{bracketEntry.OrderId, bracketTakeProfit.OrderId},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+			for _, order := range tc.existingOrders {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, order, 0)
+				ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+					ks.Ctx,
+					order.MustGetUnixGoodTilBlockTime(),
+					order.OrderId,
+				)
+			}
+
+			for _, orderId := range tc.expectedCanceledOrderIds {
+				indexerEventManager.On(
+					"AddTxnEvent",
+					ks.Ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							orderId,
+							indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDER_GROUP_CANCELED,
+						),
+					),
+				).Once().Return()
+			}
+
+			canceledOrderIds := ks.ClobKeeper.CancelOrderGroupSiblings(ks.Ctx, tc.order, tc.orderFilled)
+			require.Equal(t, tc.expectedCanceledOrderIds, canceledOrderIds)
+
+			// Verify the canceled orders were removed from state and the other orders remain.
+			canceledOrderIdsSet := make(map[types.OrderId]struct{})
+			for _, orderId := range canceledOrderIds {
+				canceledOrderIdsSet[orderId] = struct{}{}
+			}
+			for _, order := range tc.existingOrders {
+				_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, order.OrderId)
+				_, canceled := canceledOrderIdsSet[order.OrderId]
+				require.Equal(t, !canceled, found)
+			}
+			require.Equal(
+				t,
+				uint32(len(tc.existingOrders)-len(canceledOrderIds)),
+				ks.ClobKeeper.GetStatefulOrderCount(ks.Ctx, constants.Alice_Num0),
+			)
+			indexerEventManager.AssertExpectations(t)
+		})
+	}
+}
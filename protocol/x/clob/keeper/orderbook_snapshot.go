@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// OrderbookSnapshots contains snapshots of the orderbooks of all clob pairs in the memclob, captured
+// after a block was committed.
+type OrderbookSnapshots struct {
+	// Height of the committed block.
+	BlockHeight uint32
+	// Snapshots of the orderbooks, by clob pair id.
+	Snapshots map[types.ClobPairId]types.OrderbookSnapshot
+}
+
+// UpdateOrderbookSnapshots captures snapshots of the orderbooks of all clob pairs in the memclob, to be
+// served by the orderbook and simulate order queries. This is called in `PrepareCheckState`, once the
+// memclob reflects the committed block.
+func (k Keeper) UpdateOrderbookSnapshots(ctx sdk.Context) {
+	clobPairs := k.GetAllClobPairs(ctx)
+	snapshots := &OrderbookSnapshots{
+		BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		Snapshots:   make(map[types.ClobPairId]types.OrderbookSnapshot, len(clobPairs)),
+	}
+	for _, clobPair := range clobPairs {
+		clobPairId := clobPair.GetClobPairId()
+		snapshots.Snapshots[clobPairId] = k.MemClob.GetOrderbookSnapshot(ctx, clobPairId)
+	}
+	k.orderbookSnapshots.Store(snapshots)
+}
+
+// GetOrderbookSnapshots returns the latest snapshots of the orderbooks of all clob pairs in the memclob.
+// Returns nil if no snapshots were captured yet. Safe to call concurrently.
+func (k Keeper) GetOrderbookSnapshots() *OrderbookSnapshots {
+	return k.orderbookSnapshots.Load()
+}
@@ -30,7 +30,7 @@ func (k Keeper) GetOperations(ctx sdk.Context) *types.MsgProposedOperations {
 	}
 
 	if err := msgProposedOperations.ValidateBasic(); err != nil {
-		operations, _ := k.MemClob.GetOperationsToReplay(ctx)
+		operations, _, _ := k.MemClob.GetOperationsToReplay(ctx)
 		panic(fmt.Sprintf("MsgProposedOperations failed validation: %s. Operations to replay: %+v", err, operations))
 	}
 
@@ -40,7 +40,7 @@ func (k Keeper) GetOperations(ctx sdk.Context) *types.MsgProposedOperations {
 		k.txDecoder,
 		k.antehandler,
 	); err != nil {
-		operations, _ := k.MemClob.GetOperationsToReplay(ctx)
+		operations, _, _ := k.MemClob.GetOperationsToReplay(ctx)
 		panic(fmt.Sprintf("MsgProposedOperations failed stateful validation: %s. Operations to replay: %+v", err, operations))
 	}
 
@@ -116,6 +116,123 @@ func (k Keeper) BatchCancelShortTermOrder(
 	return success, failure, nil
 }
 
+// BatchPlaceShortTermOrder places a specified batch of short term orders on their corresponding orderbooks.
+// This message is not atomic. It will optimistically call `PlaceShortTermOrder` for every order in the batch,
+// in the order the orders are specified. If any of the orders error, the error will be silently logged.
+// This msg will only error if:
+// - Stateful validation of the clob pair ids fails
+// This function will return two lists, one for the order ids of successes and one for failures.
+// This method assumes the provided MsgBatchPlaceOrder has already passed ValidateBasic in CheckTx.
+func (k Keeper) BatchPlaceShortTermOrder(
+	ctx sdk.Context,
+	msg *types.MsgBatchPlaceOrder,
+) (success []types.OrderId, failure []types.OrderPlacementFailure, err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	// Statefully validate the clob pair ids.
+	for _, order := range msg.GetShortTermOrders() {
+		clobPairId := order.GetClobPairId()
+		if _, found := k.GetClobPair(ctx, clobPairId); !found {
+			return success, failure, errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"Invalid clob pair id %+v",
+				clobPairId,
+			)
+		}
+	}
+
+	for i, order := range msg.GetShortTermOrders() {
+		// Run the short term order with its index in the batch, so that the operations to propose
+		// can reference the order within the batch transaction. If it errors, just log silently.
+		_, _, err := k.PlaceShortTermOrder(
+			types.WithBatchOrderIndex(ctx, uint32(i)),
+			types.NewMsgPlaceOrder(order),
+		)
+
+		if err != nil {
+			failure = append(failure, types.OrderPlacementFailure{
+				OrderId: order.OrderId,
+				Error:   err.Error(),
+			})
+			log.InfoLog(
+				ctx,
+				"Batch Place Order: Failed to place a short term order.",
+				log.OrderId, order.OrderId,
+				log.Error, err,
+			)
+		} else {
+			success = append(success, order.OrderId)
+		}
+	}
+	return success, failure, nil
+}
+
+// CancelAllShortTermOrders removes all Short-Term orders of the subaccount of `msg` from the memclob. If
+// `msg` specifies clob pair ids, only the orders on these clob pairs are removed. Every order is removed by
+// calling `CancelShortTermOrder` with a cancel that expires at the `goodTilBlock` of the order. If any of the
+// cancels error, the error will be silently logged. This msg will only error if:
+// - Stateful validation of the clob pair ids fails
+// This function will return the ids of the removed orders. Note that stateful orders are not removed by this
+// function, they are removed from state in `HandleMsgCancelAllOrders` during `DeliverTx`.
+// This method assumes the provided MsgCancelAllOrders has already passed ValidateBasic in CheckTx.
+func (k Keeper) CancelAllShortTermOrders(
+	ctx sdk.Context,
+	msg *types.MsgCancelAllOrders,
+) (canceledOrderIds []types.OrderId, err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	// Statefully validate the clob pair ids.
+	clobPairIds := make([]types.ClobPairId, 0, len(msg.GetClobPairIds()))
+	for _, clobPairId := range msg.GetClobPairIds() {
+		if _, found := k.GetClobPair(ctx, types.ClobPairId(clobPairId)); !found {
+			return canceledOrderIds, errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"Invalid clob pair id %+v",
+				clobPairId,
+			)
+		}
+		clobPairIds = append(clobPairIds, types.ClobPairId(clobPairId))
+	}
+	if len(clobPairIds) == 0 {
+		for _, clobPair := range k.GetAllClobPairs(ctx) {
+			clobPairIds = append(clobPairIds, clobPair.GetClobPairId())
+		}
+	}
+
+	subaccountId := msg.GetSubaccountId()
+	for _, clobPairId := range clobPairIds {
+		for _, side := range []types.Order_Side{types.Order_SIDE_BUY, types.Order_SIDE_SELL} {
+			openOrders, err := k.MemClob.GetSubaccountOrders(ctx, clobPairId, subaccountId, side)
+			if err != nil {
+				return canceledOrderIds, err
+			}
+
+			for _, order := range openOrders {
+				if !order.IsShortTermOrder() {
+					continue
+				}
+
+				// Cancel the short term order. If it errors, just log silently.
+				err := k.CancelShortTermOrder(
+					ctx,
+					types.NewMsgCancelOrderShortTerm(order.OrderId, order.GetGoodTilBlock()),
+				)
+				if err != nil {
+					log.InfoLog(
+						ctx,
+						"Cancel All Orders: Failed to cancel a short term order.",
+						log.OrderId, order.OrderId,
+						log.Error, err,
+					)
+				} else {
+					canceledOrderIds = append(canceledOrderIds, order.OrderId)
+				}
+			}
+		}
+	}
+	return canceledOrderIds, nil
+}
+
 // CancelShortTermOrder removes a Short-Term order by `OrderId` (if it exists) from all order-related data structures
 // in the memclob. As well, CancelShortTermOrder adds (or updates) a cancel to the desired `goodTilBlock` in the
 // memclob.
@@ -168,6 +285,7 @@ func (k Keeper) CancelShortTermOrder(
 // An error will be returned if any of the following conditions are true:
 //   - Standard stateful validation fails.
 //   - Placing the short term order on the memclob returns an error.
+//   - Market-maker protection of the subaccount is tripped on the clob pair of the order.
 //
 // This method will panic if the provided order is not a Short-Term order.
 func (k Keeper) PlaceShortTermOrder(
@@ -207,6 +325,11 @@ func (k Keeper) PlaceShortTermOrder(
 		return 0, 0, err
 	}
 
+	// Reject the order if market-maker protection of the subaccount is tripped on the clob pair.
+	if err = k.ValidateMarketMakerProtectionNotTripped(ctx, order); err != nil {
+		return 0, 0, err
+	}
+
 	// Place the order on the memclob and return the result.
 	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err := k.MemClob.PlaceOrder(
 		ctx,
@@ -308,6 +431,8 @@ func (k Keeper) CancelStatefulOrder(
 //
 // An error will be returned if any of the following conditions are true:
 //   - Standard stateful validation fails.
+//   - Order group validation fails.
+//   - Market-maker protection of the subaccount is tripped on the clob pair of the order.
 //   - Equity tier limit exceeded.
 //   - Collateralization check fails.
 //
@@ -347,7 +472,8 @@ func (k Keeper) PlaceStatefulOrder(
 	order := msg.Order
 	order.OrderId.MustBeStatefulOrder()
 
-	// 2. Perform stateful validation on the order.
+	// 2. Perform stateful validation on the order, and check that market-maker protection of the
+	// subaccount is not tripped on the clob pair of the order.
 	if err := k.PerformStatefulOrderValidation(
 		ctx,
 		&order,
@@ -357,46 +483,30 @@ func (k Keeper) PlaceStatefulOrder(
 	); err != nil {
 		return err
 	}
+	if err := k.ValidateMarketMakerProtectionNotTripped(ctx, order); err != nil {
+		return err
+	}
+
+	// 3. Check that the order can be added to its order group.
+	if err := k.ValidateOrderGroup(ctx, order); err != nil {
+		return err
+	}
 
 	if !isInternalOrder {
-		// 3. Check that adding the order would not exceed the equity tier for the account.
+		// 4. Check that adding the order would not exceed the equity tier for the account.
 		if err := k.ValidateSubaccountEquityTierLimitForStatefulOrder(ctx, order); err != nil {
 			return err
 		}
 
-		// 4. Perform a check on the subaccount updates for the full size of the order to mitigate spam.
+		// 5. Perform a check on the subaccount updates for the full size of the order to mitigate spam.
 		if !order.IsConditionalOrder() {
-			_, successPerSubaccountUpdate := k.AddOrderToOrderbookSubaccountUpdatesCheck(
-				ctx,
-				order.GetClobPairId(),
-				map[satypes.SubaccountId][]types.PendingOpenOrder{
-					order.OrderId.SubaccountId: {
-						{
-							RemainingQuantums: order.GetBaseQuantums(),
-							IsBuy:             order.IsBuy(),
-							Subticks:          order.GetOrderSubticks(),
-							ClobPairId:        order.GetClobPairId(),
-						},
-					},
-				},
-			)
-
-			if updateResult := successPerSubaccountUpdate[order.OrderId.SubaccountId]; !updateResult.IsSuccess() {
-				err := types.ErrStatefulOrderCollateralizationCheckFailed
-				if updateResult.IsIsolatedSubaccountError() {
-					err = types.ErrWouldViolateIsolatedSubaccountConstraints
-				}
-				return errorsmod.Wrapf(
-					err,
-					"PlaceStatefulOrder: order (%+v), result (%s)",
-					order,
-					successPerSubaccountUpdate[order.OrderId.SubaccountId].String(),
-				)
+			if err := k.performStatefulOrderCollateralizationCheck(ctx, order, order.GetBaseQuantums()); err != nil {
+				return errorsmod.Wrap(err, "PlaceStatefulOrder")
 			}
 		}
 	}
 
-	// 5. If we are in `deliverTx` then we write the order to committed state otherwise add the order to uncommitted
+	// 6. If we are in `deliverTx` then we write the order to committed state otherwise add the order to uncommitted
 	// state.
 	if lib.IsDeliverTxMode(ctx) {
 		// Write the stateful order to state and the memstore.
@@ -406,6 +516,9 @@ func (k Keeper) PlaceStatefulOrder(
 			order.MustGetUnixGoodTilBlockTime(),
 			order.GetOrderId(),
 		)
+		if order.IsTwapOrder() {
+			k.InitializeTwapOrderState(ctx, order)
+		}
 	} else {
 		// Write the stateful order to a transient store. PerformStatefulOrderValidation will ensure that the order does
 		// not exist which will prevent MustAddUncommittedStatefulOrderPlacement from panicking.
@@ -418,6 +531,153 @@ func (k Keeper) PlaceStatefulOrder(
 	return nil
 }
 
+// ReplaceStatefulOrder performs stateful validation on a stateful order replacement and, during `DeliverTx`,
+// atomically replaces the existing order in state with the new order. The order being replaced must exist in
+// committed state and any fill amount of the existing order carries over to the new order.
+//
+// An error will be returned if any of the following conditions are true:
+//   - The order being replaced does not exist in committed state.
+//   - The replacement changes the side, the condition type or the order group of the order.
+//   - The size of the replacement order does not exceed the current fill amount of the order.
+//   - Standard stateful validation fails.
+//   - Market-maker protection of the subaccount is tripped on the clob pair of the order.
+//   - Collateralization check fails.
+//
+// Note that during `CheckTx` this method only performs validation, since replacing an order does not change
+// the number of open stateful orders for the subaccount.
+//
+// This method will panic if the provided order is not a Stateful order.
+func (k Keeper) ReplaceStatefulOrder(
+	ctx sdk.Context,
+	msg *types.MsgReplaceOrder,
+) (err error) {
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReplaceStatefulOrder,
+			metrics.GetCallbackMetricFromCtx(ctx),
+		)
+	}()
+
+	// 1. Ensure the order is not a Short-Term order.
+	order := msg.Order
+	order.OrderId.MustBeStatefulOrder()
+
+	// 2. Ensure the order being replaced exists in state and is compatible with the replacement order.
+	existingOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, order.OrderId)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderDoesNotExist,
+			"ReplaceStatefulOrder: order (%+v)",
+			order,
+		)
+	}
+
+	existingOrder := existingOrderPlacement.GetOrder()
+	if existingOrder.Side != order.Side {
+		return errorsmod.Wrapf(
+			types.ErrInvalidStatefulOrderReplacement,
+			"Replacement order cannot change the order side. Existing order: (%+v). New order: (%+v).",
+			existingOrder,
+			order,
+		)
+	}
+
+	if existingOrder.ConditionType != order.ConditionType {
+		return errorsmod.Wrapf(
+			types.ErrInvalidStatefulOrderReplacement,
+			"Replacement order cannot change the condition type. Existing order: (%+v). New order: (%+v).",
+			existingOrder,
+			order,
+		)
+	}
+
+	if existingOrder.OrderGroupId != order.OrderGroupId || existingOrder.OrderGroupType != order.OrderGroupType {
+		return errorsmod.Wrapf(
+			types.ErrInvalidStatefulOrderReplacement,
+			"Replacement order cannot change the order group. Existing order: (%+v). New order: (%+v).",
+			existingOrder,
+			order,
+		)
+	}
+
+	_, fillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId)
+	if order.GetBaseQuantums() <= fillAmount {
+		return errorsmod.Wrapf(
+			types.ErrInvalidStatefulOrderReplacement,
+			"Replacement order size %d must be greater than the fill amount %d of the existing order. "+
+				"Existing order: (%+v). New order: (%+v).",
+			order.GetBaseQuantums(),
+			fillAmount,
+			existingOrder,
+			order,
+		)
+	}
+
+	// 3. Perform stateful validation on the order, and check that market-maker protection of the
+	// subaccount is not tripped on the clob pair of the order. Note that the blockHeight is not used
+	// during stateful order validation.
+	if err := k.PerformStatefulOrderValidation(ctx, &order, 0, true); err != nil {
+		return err
+	}
+	if err := k.ValidateMarketMakerProtectionNotTripped(ctx, order); err != nil {
+		return err
+	}
+
+	// 4. Perform a check on the subaccount updates for the remaining size of the order to mitigate spam.
+	if !order.IsConditionalOrder() {
+		if err := k.performStatefulOrderCollateralizationCheck(ctx, order, order.GetBaseQuantums()-fillAmount); err != nil {
+			return errorsmod.Wrap(err, "ReplaceStatefulOrder")
+		}
+	}
+
+	// 5. If we are in `deliverTx` then replace the order in committed state.
+	if lib.IsDeliverTxMode(ctx) {
+		k.MustReplaceStatefulOrder(ctx, order, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+	}
+
+	return nil
+}
+
+// performStatefulOrderCollateralizationCheck performs a check on the subaccount updates for `remainingQuantums`
+// of a stateful order. Returns an error if the subaccount updates would not be valid.
+func (k Keeper) performStatefulOrderCollateralizationCheck(
+	ctx sdk.Context,
+	order types.Order,
+	remainingQuantums satypes.BaseQuantums,
+) error {
+	_, successPerSubaccountUpdate := k.AddOrderToOrderbookSubaccountUpdatesCheck(
+		ctx,
+		order.GetClobPairId(),
+		map[satypes.SubaccountId][]types.PendingOpenOrder{
+			order.OrderId.SubaccountId: {
+				{
+					RemainingQuantums: remainingQuantums,
+					IsBuy:             order.IsBuy(),
+					Subticks:          order.GetOrderSubticks(),
+					ClobPairId:        order.GetClobPairId(),
+				},
+			},
+		},
+	)
+
+	if updateResult := successPerSubaccountUpdate[order.OrderId.SubaccountId]; !updateResult.IsSuccess() {
+		err := types.ErrStatefulOrderCollateralizationCheckFailed
+		if updateResult.IsIsolatedSubaccountError() {
+			err = types.ErrWouldViolateIsolatedSubaccountConstraints
+		}
+		return errorsmod.Wrapf(
+			err,
+			"order (%+v), result (%s)",
+			order,
+			updateResult.String(),
+		)
+	}
+
+	return nil
+}
+
 // ReplayPlaceOrder returns the result of calling `PlaceOrder` on the memclob.
 // This method does not forward events directly to indexer, but instead returns
 // them in the form of `OffchainUpdates`. This method is meant to be used in the
@@ -426,6 +686,7 @@ func (k Keeper) PlaceStatefulOrder(
 //
 // An error will be returned if any of the following conditions are true:
 // - Standard stateful validation fails.
+// - Market-maker protection of the subaccount is tripped on the clob pair of the order.
 // - The memclob itself returns an error.
 func (k Keeper) ReplayPlaceOrder(
 	ctx sdk.Context,
@@ -448,6 +709,11 @@ func (k Keeper) ReplayPlaceOrder(
 		return 0, 0, nil, err
 	}
 
+	// Reject the order if market-maker protection of the subaccount is tripped on the clob pair.
+	if err = k.ValidateMarketMakerProtectionNotTripped(ctx, order); err != nil {
+		return 0, 0, nil, err
+	}
+
 	// Place the order on the memclob and return the result.
 	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err = k.MemClob.PlaceOrder(
 		ctx,
@@ -575,6 +841,106 @@ func (k Keeper) PlaceStatefulOrdersFromLastBlock(
 	return existingOffchainUpdates
 }
 
+// ReplaceStatefulOrdersFromLastBlock validates and applies the stateful order replacements from the last block
+// to the memclob. Untriggered conditional orders are skipped since they are not on the memclob.
+// Replacements that only decrease the size of an order keep the queue priority of the order on the book,
+// otherwise the existing order is removed and the replacement order is placed on the book.
+// This is called in `PrepareCheckState` after placing the long term orders from the last block.
+func (k Keeper) ReplaceStatefulOrdersFromLastBlock(
+	ctx sdk.Context,
+	replacedStatefulOrderIds []types.OrderId,
+	existingOffchainUpdates *types.OffchainUpdates,
+) (
+	offchainUpdates *types.OffchainUpdates,
+) {
+	lib.AssertCheckTxMode(ctx)
+
+	defer telemetry.MeasureSince(
+		time.Now(),
+		types.ModuleName,
+		metrics.ReplaceStatefulOrdersFromLastBlock,
+		metrics.Latency,
+	)
+
+	replacedStatefulOrderIds = lib.DedupeSlice(replacedStatefulOrderIds)
+	telemetry.SetGauge(
+		float32(len(replacedStatefulOrderIds)),
+		types.ModuleName,
+		metrics.ReplaceStatefulOrdersFromLastBlock,
+		metrics.Count,
+	)
+
+	for _, orderId := range replacedStatefulOrderIds {
+		orderId.MustBeStatefulOrder()
+
+		// Untriggered conditional orders are not on the memclob and will be placed once triggered.
+		if orderId.IsConditionalOrder() && !k.IsConditionalOrderTriggered(ctx, orderId) {
+			continue
+		}
+
+		orderPlacement, exists := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !exists {
+			// Order does not exist in state and therefore should not be placed. This likely
+			// indicates that the order was cancelled, removed or fully filled after being replaced.
+			continue
+		}
+
+		order := orderPlacement.GetOrder()
+		_, orderStatus, replaceOrderOffchainUpdates, err := k.replacePreexistingStatefulOrder(ctx, &order)
+		if err != nil {
+			log.DebugLog(ctx,
+				fmt.Sprintf(
+					"ReplaceStatefulOrdersFromLastBlock: ReplaceStatefulOrder() returned an error %+v for order %+v",
+					err,
+					order,
+				),
+			)
+
+			if k.indexerEventManager.Enabled() && off_chain_updates.ShouldSendOrderRemovalOnReplay(err) {
+				if message, success := off_chain_updates.CreateOrderRemoveMessageWithDefaultReason(
+					ctx,
+					order.OrderId,
+					orderStatus,
+					err,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_INTERNAL_ERROR,
+				); success {
+					existingOffchainUpdates.AddRemoveMessage(order.OrderId, message)
+				}
+			}
+		} else if k.indexerEventManager.Enabled() {
+			existingOffchainUpdates.Append(replaceOrderOffchainUpdates)
+		}
+	}
+
+	// Clear place messages as BEST_EFFORT_OPEN messages should not be
+	// sent for stateful order placements.
+	existingOffchainUpdates.CondenseMessagesForReplay()
+
+	return existingOffchainUpdates
+}
+
+// replacePreexistingStatefulOrder performs stateful validation on a replaced stateful order and replaces the
+// order on the memclob. This function does not modify state, since the replacement is assumed to already be
+// written to state.
+func (k Keeper) replacePreexistingStatefulOrder(
+	ctx sdk.Context,
+	order *types.Order,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	order.MustBeStatefulOrder()
+	// Block height is not used when validating stateful orders, so always pass in zero.
+	if err = k.PerformStatefulOrderValidation(ctx, order, 0, true); err != nil {
+		return 0, 0, nil, err
+	}
+
+	return k.MemClob.ReplaceStatefulOrder(ctx, *order)
+}
+
 // PlaceConditionalOrdersTriggeredInLastBlock takes in a list of conditional order ids that were triggered
 // in the last block, verifies they are conditional orders, verifies they are in triggered state, and places
 // the orders on the memclob.
@@ -767,7 +1133,8 @@ func (k Keeper) validateGoodTilBlock(goodTilBlock uint32, blockHeight uint32) er
 //   - The `Subticks` of the order is a multiple of the ClobPair's `SubticksPerTick`.
 //   - The `Quantums` of the order is a multiple of the ClobPair's `StepBaseQuantums`.
 //
-// This validation also ensures that the order is valid for the ClobPair's status.
+// This validation also ensures that the order is valid for the ClobPair's status and, unless
+// `isPreexistingStatefulOrder` is true, for the ClobPair's price band.
 //
 // For short term orders it also ensures:
 //   - The `GoodTilBlock` of the order is greater than the provided `blockHeight`.
@@ -820,6 +1187,17 @@ func (k Keeper) PerformStatefulOrderValidation(
 		)
 	}
 
+	// Spot CLOB pairs hold no positions to reduce and have no oracle-backed perpetual to trigger
+	// conditional orders against, so reject these order types.
+	if clobPair.IsSpotClobPair() && (order.IsReduceOnly() || order.IsConditionalOrder()) {
+		return errorsmod.Wrapf(
+			types.ErrOrderTypeNotSupportedForSpotClobPair,
+			"Order %+v must not be reduce-only or conditional for spot clob pair %v",
+			order.GetOrderTextString(),
+			clobPair.Id,
+		)
+	}
+
 	// Validates the order against the ClobPair's status.
 	if err := k.validateOrderAgainstClobPairStatus(ctx, order.MustGetOrder(), clobPair); err != nil {
 		telemetry.IncrCounterWithLabels(
@@ -834,6 +1212,13 @@ func (k Keeper) PerformStatefulOrderValidation(
 		return err
 	}
 
+	// Validates the order against the ClobPair's price band.
+	if !isPreexistingStatefulOrder {
+		if err := k.validateOrderAgainstPriceBand(ctx, order.MustGetOrder(), clobPair); err != nil {
+			return err
+		}
+	}
+
 	if order.OrderId.IsShortTermOrder() {
 		if err := k.validateGoodTilBlock(order.GetGoodTilBlock(), blockHeight); err != nil {
 			return err
@@ -1033,8 +1418,14 @@ func (k Keeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 
 	pendingUpdates := types.NewPendingUpdates()
 
-	// Retrieve the associated `PerpetualId` for the `ClobPair`.
-	perpetualId := clobPair.MustGetPerpetualId()
+	// Retrieve the associated `PerpetualId` or spot base asset for the `ClobPair`.
+	var perpetualId, baseAssetId uint32
+	isSpotClobPair := clobPair.IsSpotClobPair()
+	if isSpotClobPair {
+		baseAssetId, _ = clobPair.MustGetSpotAssetIds()
+	} else {
+		perpetualId = clobPair.MustGetPerpetualId()
+	}
 
 	iterateOverOpenOrdersStart := time.Now()
 	for subaccountId, openOrders := range subaccountOpenOrders {
@@ -1066,6 +1457,18 @@ func (k Keeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 			}
 
 			bigFillAmount := openOrder.RemainingQuantums.ToBigInt()
+			if isSpotClobPair {
+				pendingUpdates.AddSpotFill(
+					subaccountId,
+					baseAssetId,
+					openOrder.IsBuy,
+					makerFeePpm,
+					bigFillAmount,
+					bigFillQuoteQuantums,
+				)
+				continue
+			}
+
 			addPerpetualFillAmountStart := time.Now()
 			pendingUpdates.AddPerpetualFill(
 				subaccountId,
@@ -1120,6 +1523,10 @@ func (k Keeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 
 // GetOraclePriceSubticksRat returns the oracle price in subticks for the given `ClobPair`.
 func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	if clobPair.IsSpotClobPair() {
+		return k.getSpotOraclePriceSubticksRat(ctx, clobPair)
+	}
+
 	// Retrieve the associated `PerpetualId` for the `ClobPair`.
 	perpetualId := clobPair.MustGetPerpetualId()
 
@@ -1151,6 +1558,68 @@ func (k Keeper) GetOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPa
 	return oraclePriceSubticksRat
 }
 
+// GetTriggerPriceSubticksRat returns the price in subticks used to trigger conditional orders of the
+// given perpetual `ClobPair`. This is the mark price of the perpetual if it enables the mark price,
+// and the oracle price otherwise.
+func (k Keeper) GetTriggerPriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	perpetual, riskPrice, err := k.perpetualsKeeper.GetPerpetualAndRiskPrice(ctx, clobPair.MustGetPerpetualId())
+	if err != nil || riskPrice.Price == 0 {
+		// Fall back to the oracle price, which panics on the same conditions.
+		return k.GetOraclePriceSubticksRat(ctx, clobPair)
+	}
+
+	return types.PriceToSubticks(
+		riskPrice,
+		clobPair,
+		perpetual.Params.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	)
+}
+
+// getSpotOraclePriceSubticksRat returns the oracle price in subticks for the given spot `ClobPair`,
+// using the market of its base asset.
+func (k Keeper) getSpotOraclePriceSubticksRat(ctx sdk.Context, clobPair types.ClobPair) *big.Rat {
+	baseAssetId, _ := clobPair.MustGetSpotAssetIds()
+
+	// `validateClobPair` ensures the base asset of a spot `ClobPair` exists and has a market.
+	baseAsset, exists := k.assetsKeeper.GetAsset(ctx, baseAssetId)
+	if !exists || !baseAsset.HasMarket {
+		panic(
+			errorsmod.Wrapf(
+				types.ErrInvalidClobPairParameter,
+				"clob pair ID = (%d), base asset ID = (%d) has no market",
+				clobPair.Id,
+				baseAssetId,
+			),
+		)
+	}
+
+	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, baseAsset.MarketId)
+	if err != nil {
+		panic(errorsmod.Wrapf(err, "base asset ID = (%d)", baseAssetId))
+	}
+
+	// Get the oracle price for the market.
+	oraclePriceSubticksRat := types.PriceToSubticks(
+		marketPrice,
+		clobPair,
+		baseAsset.AtomicResolution,
+		lib.QuoteCurrencyAtomicResolution,
+	)
+	if oraclePriceSubticksRat.Cmp(big.NewRat(0, 1)) == 0 {
+		panic(
+			errorsmod.Wrapf(
+				types.ErrZeroPriceForOracle,
+				"clob pair ID = (%d), base asset ID = (%d), market ID = (%d)",
+				clobPair.Id,
+				baseAssetId,
+				marketPrice.Id,
+			),
+		)
+	}
+	return oraclePriceSubticksRat
+}
+
 // GetStatePosition returns the current size of a subaccount's position for the specified `clobPairId`.
 func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.SubaccountId, clobPairId types.ClobPairId,
 ) (
@@ -1162,13 +1631,22 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 		panic(fmt.Sprintf("GetStatePosition: CLOB pair %d not found", clobPairId))
 	}
 
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+
+	// For spot CLOB pairs, the position is the balance of the base asset held by this subaccount.
+	if clobPair.IsSpotClobPair() {
+		baseAssetId, _ := clobPair.MustGetSpotAssetIds()
+		position, _ := subaccount.GetAssetPositionForId(baseAssetId)
+		return position.GetBigQuantums()
+	}
+
 	// Get the perpetual ID for this CLOB pair, and panic if it is not a perpetual CLOB.
 	perpetualId, err := clobPair.GetPerpetualId()
 	if err != nil {
 		panic(
 			errorsmod.Wrap(
 				err,
-				"GetStatePosition: Reduce-only orders for assets not implemented",
+				"GetStatePosition: CLOB pair is neither a perpetual nor a spot CLOB",
 			),
 		)
 	}
@@ -1176,7 +1654,6 @@ func (k Keeper) GetStatePosition(ctx sdk.Context, subaccountId satypes.Subaccoun
 	// Get the position size corresponding to `perpetualId` held by this subaccount, negative
 	// if short and positive if long. If the subaccount does not have an open position
 	// corresponding to `perpetualId`, a position size of zero is returned.
-	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
 	position, _ := subaccount.GetPerpetualPositionForId(perpetualId)
 	return position.GetBigQuantums()
 }
@@ -1320,7 +1797,6 @@ func (k Keeper) SendOffchainMessages(
 // This value is always positive.
 //
 // Returns an error if:
-// - The Order is not for a `PerpetualClob`.
 // - The underlying `Price` does not exist.
 func getFillQuoteQuantums(
 	clobPair types.ClobPair,
@@ -1334,10 +1810,6 @@ func getFillQuoteQuantums(
 		metrics.Latency,
 	)
 
-	if perpetualClobMetadata := clobPair.GetPerpetualClobMetadata(); perpetualClobMetadata == nil {
-		return nil, types.ErrAssetOrdersNotImplemented
-	}
-
 	quantumConversionExponent := clobPair.QuantumConversionExponent
 
 	quoteQuantums := types.FillAmountToQuoteQuantums(
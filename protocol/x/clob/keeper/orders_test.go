@@ -2662,3 +2662,52 @@ func TestPlaceConditionalOrdersTriggeredInLastBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTriggerPriceSubticksRat(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockIndexerEventManager.On(
+		"AddBlockEvent",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+	keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+
+	oraclePrice := ks.ClobKeeper.GetOraclePriceSubticksRat(ks.Ctx, constants.ClobPair_Btc)
+	require.Equal(t, oraclePrice, ks.ClobKeeper.GetTriggerPriceSubticksRat(ks.Ctx, constants.ClobPair_Btc))
+
+	// The mark price of BTC is 1% above its oracle price.
+	require.NoError(t, ks.PerpetualsKeeper.AddPremiumVotes(
+		ks.Ctx,
+		[]perptypes.FundingPremium{{PerpetualId: 0, PremiumPpm: 10_000}},
+	))
+	_, err := ks.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ks.Ctx,
+		0,
+		&perptypes.MarkPriceConfig{EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	ks.PerpetualsKeeper.UpdateMarkPrices(ks.Ctx)
+
+	// The oracle price is used while the mark price is disabled.
+	require.Equal(t, oraclePrice, ks.ClobKeeper.GetTriggerPriceSubticksRat(ks.Ctx, constants.ClobPair_Btc))
+
+	_, err = ks.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ks.Ctx,
+		0,
+		&perptypes.MarkPriceConfig{Enabled: true, EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		new(big.Rat).Mul(oraclePrice, big.NewRat(101, 100)),
+		ks.ClobKeeper.GetTriggerPriceSubticksRat(ks.Ctx, constants.ClobPair_Btc),
+	)
+}
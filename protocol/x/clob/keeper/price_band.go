@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// GetPriceBandSubticks returns the inclusive lower and upper bounds in subticks of the execution price
+// of non-liquidation matches on the clob pair, based on the current oracle price. Returns false if the
+// clob pair does not exist or has no price band enabled.
+func (k Keeper) GetPriceBandSubticks(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (
+	lowerSubticks uint64,
+	upperSubticks uint64,
+	enabled bool,
+) {
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found || !clobPair.PriceBandConfig.IsEnabled() {
+		return 0, 0, false
+	}
+
+	lowerSubticks, upperSubticks = clobPair.PriceBandConfig.GetPriceBandSubticks(
+		k.GetOraclePriceSubticksRat(ctx, clobPair),
+	)
+	return lowerSubticks, upperSubticks, true
+}
+
+// validateOrderAgainstPriceBand returns an error if the clob pair has a price band in `MODE_REJECT` and
+// the provided order is priced beyond the price band on the aggressive side, i.e. a buy order priced
+// above the upper bound or a sell order priced below the lower bound. Post-only orders never take
+// liquidity and conditional orders are not priced against the oracle price until triggered, so both
+// are exempt.
+func (k Keeper) validateOrderAgainstPriceBand(
+	ctx sdk.Context,
+	order types.Order,
+	clobPair types.ClobPair,
+) error {
+	if !clobPair.PriceBandConfig.IsEnabled() ||
+		clobPair.PriceBandConfig.Mode != types.PriceBandConfig_MODE_REJECT ||
+		order.TimeInForce == types.Order_TIME_IN_FORCE_POST_ONLY ||
+		order.IsConditionalOrder() {
+		return nil
+	}
+
+	lowerSubticks, upperSubticks := clobPair.PriceBandConfig.GetPriceBandSubticks(
+		k.GetOraclePriceSubticksRat(ctx, clobPair),
+	)
+	if order.IsBuy() && order.Subticks > upperSubticks {
+		return errorsmod.Wrapf(
+			types.ErrOrderOutsidePriceBand,
+			"Buy order subticks %d must be less than or equal to the upper bound %d of the price band",
+			order.Subticks,
+			upperSubticks,
+		)
+	}
+	if !order.IsBuy() && order.Subticks < lowerSubticks {
+		return errorsmod.Wrapf(
+			types.ErrOrderOutsidePriceBand,
+			"Sell order subticks %d must be greater than or equal to the lower bound %d of the price band",
+			order.Subticks,
+			lowerSubticks,
+		)
+	}
+	return nil
+}
+
+// isOrderPricedBeyondPriceBand returns true if the clob pair has a price band and the provided order is
+// priced beyond the price band on the aggressive side, i.e. a buy order priced above the upper bound or a
+// sell order priced below the lower bound. Such orders cross all maker orders priced beyond the price band
+// on the other side of the orderbook.
+func (k Keeper) isOrderPricedBeyondPriceBand(
+	ctx sdk.Context,
+	order types.Order,
+	clobPair types.ClobPair,
+) bool {
+	if !clobPair.PriceBandConfig.IsEnabled() {
+		return false
+	}
+
+	lowerSubticks, upperSubticks := clobPair.PriceBandConfig.GetPriceBandSubticks(
+		k.GetOraclePriceSubticksRat(ctx, clobPair),
+	)
+	if order.IsBuy() {
+		return order.Subticks > upperSubticks
+	}
+	return order.Subticks < lowerSubticks
+}
+
+// validateFillAgainstPriceBand returns an error if the clob pair has a price band and the provided fill
+// price in subticks is outside of it.
+func (k Keeper) validateFillAgainstPriceBand(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	fillSubticks types.Subticks,
+) error {
+	if !clobPair.PriceBandConfig.IsEnabled() {
+		return nil
+	}
+
+	lowerSubticks, upperSubticks := clobPair.PriceBandConfig.GetPriceBandSubticks(
+		k.GetOraclePriceSubticksRat(ctx, clobPair),
+	)
+	if fillSubticks.ToUint64() < lowerSubticks || fillSubticks.ToUint64() > upperSubticks {
+		return errorsmod.Wrapf(
+			types.ErrFillOutsidePriceBand,
+			"Fill subticks %d must be between %d and %d",
+			fillSubticks,
+			lowerSubticks,
+			upperSubticks,
+		)
+	}
+	return nil
+}
@@ -60,7 +60,7 @@ func (k Keeper) ProcessProposerOperations(
 	// If grpc streams are on, send absolute fill amounts from local + proposed opqueue to the grpc stream.
 	// This must be sent out to account for checkState being discarded and deliverState being used.
 	if streamingManager := k.GetGrpcStreamingManager(); streamingManager.Enabled() {
-		localValidatorOperationsQueue, _ := k.MemClob.GetOperationsToReplay(ctx)
+		localValidatorOperationsQueue, _, _ := k.MemClob.GetOperationsToReplay(ctx)
 		orderIdsFromProposed := fetchOrdersInvolvedInOpQueue(
 			operations,
 		)
@@ -86,9 +86,20 @@ func (k Keeper) ProcessProposerOperations(
 	}
 
 	// Collect the list of order ids filled and set the field in the `ProcessProposerMatchesEvents` object.
+	// Orders canceled while processing order removals since they were part of the same order group as
+	// a removed order are also added to the removed stateful order ids, and market-maker protections
+	// tripped while processing matches and stateful orders replaced earlier in the block or decremented due to
+	// self trades are carried over.
+	existingProcessProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
 	processProposerMatchesEvents := k.GenerateProcessProposerMatchesEvents(ctx, operations)
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		existingProcessProposerMatchesEvents.RemovedStatefulOrderIds...,
+	)
+	processProposerMatchesEvents.MarketMakerProtectionTrips = existingProcessProposerMatchesEvents.MarketMakerProtectionTrips
+	processProposerMatchesEvents.ReplacedStatefulOrderIds = existingProcessProposerMatchesEvents.ReplacedStatefulOrderIds
 
-	// Remove fully filled orders from state.
+	// Cancel the other orders in the order groups of filled orders and remove fully filled orders from state.
 	for _, orderId := range processProposerMatchesEvents.OrderIdsFilledInLastBlock {
 		if orderId.IsShortTermOrder() {
 			continue
@@ -96,6 +107,11 @@ func (k Keeper) ProcessProposerOperations(
 
 		orderPlacement, placementExists := k.GetLongTermOrderPlacement(ctx, orderId)
 		if placementExists {
+			processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+				processProposerMatchesEvents.RemovedStatefulOrderIds,
+				k.CancelOrderGroupSiblings(ctx, orderPlacement.Order, true)...,
+			)
+
 			fillAmountExists, orderStateFillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
 			if !fillAmountExists {
 				panic("ProcessProposerOperations: Order fill amount does not exist in state")
@@ -156,6 +172,10 @@ func (k Keeper) ProcessInternalOperations(
 	// All short term orders in this map have passed validation.
 	placedShortTermOrders := make(map[types.OrderId]types.Order, 0)
 
+	// Collect the total size each taker order was decremented by due to self trades with smaller maker orders,
+	// for validating decrements of larger maker orders by the remaining size of the taker order.
+	selfTradeTakerDecrements := make(map[types.OrderId]uint64, 0)
+
 	// Write the matches to state if all stateful validation passes.
 	for _, operation := range operations {
 		if err := k.validateInternalOperationAgainstClobPairStatus(ctx, operation); err != nil {
@@ -186,7 +206,12 @@ func (k Keeper) ProcessInternalOperations(
 		case *types.InternalOperation_OrderRemoval:
 			orderRemoval := castedOperation.OrderRemoval
 
-			if err := k.PersistOrderRemovalToState(ctx, *orderRemoval); err != nil {
+			if err := k.PersistOrderRemovalToState(
+				ctx,
+				*orderRemoval,
+				placedShortTermOrders,
+				selfTradeTakerDecrements,
+			); err != nil {
 				return errorsmod.Wrapf(
 					types.ErrInvalidOrderRemoval,
 					"Order Removal (%+v) invalid. Error: %+v",
@@ -271,10 +296,15 @@ func (k Keeper) statUnverifiedOrderRemoval(
 }
 
 // PersistOrderRemovalToState takes in an OrderRemoval, statefully validates it according to
-// RemovalReason, and writes the removal to state.
+// RemovalReason, and writes the removal to state. Short-Term taker orders referenced by order removals
+// due to self trades are fetched from `placedShortTermOrders`, and the sizes taker orders were decremented
+// by due to self trades earlier in the block are tracked in `selfTradeTakerDecrements`. Maker orders of self
+// trades which are decremented instead of removed are replaced in state with the decremented order.
 func (k Keeper) PersistOrderRemovalToState(
 	ctx sdk.Context,
 	orderRemoval types.OrderRemoval,
+	placedShortTermOrders map[types.OrderId]types.Order,
+	selfTradeTakerDecrements map[types.OrderId]uint64,
 ) error {
 	lib.AssertDeliverTxMode(ctx)
 	orderIdToRemove := orderRemoval.GetOrderId()
@@ -337,9 +367,25 @@ func (k Keeper) PersistOrderRemovalToState(
 				"Order is not post-only.",
 			)
 		}
-	case types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE:
-		// TODO(CLOB-877)
-		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
+	case types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+		types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+		types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+		types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL:
+		if err := k.validateSelfTradeOrderRemoval(
+			ctx,
+			orderRemoval,
+			orderToRemove,
+			placedShortTermOrders,
+			selfTradeTakerDecrements,
+		); err != nil {
+			return err
+		}
+
+		// The maker order is decremented instead of removed, so it remains in state.
+		if orderRemoval.IsSelfTradeDecrement() {
+			k.mustDecrementSelfTradeMakerOrder(ctx, orderToRemove, orderRemoval.DecrementQuantums)
+			return nil
+		}
 	case types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED:
 		// TODO(CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
@@ -360,10 +406,22 @@ func (k Keeper) PersistOrderRemovalToState(
 				"Fill-or-kill order is fully filled.",
 			)
 		}
-	case types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK:
+	case types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK,
+		types.OrderRemoval_REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK:
 		// TODO(CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
 
+		// The order should be a conditional order or a TWAP suborder, matching the removal reason.
+		isTwapSuborderRemoval := removalReason == types.OrderRemoval_REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK
+		if isTwapSuborderRemoval != orderIdToRemove.IsTwapSuborder() ||
+			!isTwapSuborderRemoval && !orderIdToRemove.IsConditionalOrder() {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Removal reason does not match the order flags.",
+				orderRemoval,
+			)
+		}
+
 		// The order should be IOC.
 		if orderToRemove.TimeInForce != types.Order_TIME_IN_FORCE_IOC {
 			return errorsmod.Wrap(
@@ -420,6 +478,28 @@ func (k Keeper) PersistOrderRemovalToState(
 	case types.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
 		// TODO(CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
+	case types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND:
+		// The order must be priced beyond the price band of the clob pair on its aggressive side, which
+		// is the case for taker orders crossing maker orders priced beyond the price band on the far side
+		// of the orderbook and for maker orders priced beyond the price band on the near side.
+		clobPair := k.mustGetClobPair(ctx, orderToRemove.GetClobPairId())
+		if !k.isOrderPricedBeyondPriceBand(ctx, orderToRemove, clobPair) {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Order is not priced beyond the price band.",
+				orderRemoval,
+			)
+		}
+	case types.OrderRemoval_REMOVAL_REASON_MARKET_MAKER_PROTECTION:
+		// The market-maker protection of the subaccount of the order must be tripped on the clob pair
+		// of the order.
+		if !k.IsMarketMakerProtectionTripped(ctx, orderIdToRemove.SubaccountId, orderToRemove.GetClobPairId()) {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Market-maker protection is not tripped.",
+				orderRemoval,
+			)
+		}
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidOrderRemovalReason,
@@ -427,7 +507,9 @@ func (k Keeper) PersistOrderRemovalToState(
 		)
 	}
 
-	// Remove the stateful order from state.
+	// Remove the stateful order from state. Note that the fill amount is read beforehand since it is
+	// removed from state along with the order.
+	_, fillAmount, _ := k.GetOrderFillAmount(ctx, orderIdToRemove)
 	k.MustRemoveStatefulOrder(ctx, orderIdToRemove)
 
 	// Emit an on-chain indexer event for Stateful Order Removal.
@@ -445,6 +527,17 @@ func (k Keeper) PersistOrderRemovalToState(
 		),
 	)
 
+	// Cancel the other orders in the order group of the removed order. The canceled orders are added to
+	// `ProcessProposerMatchesEvents` so they are removed from the memclob in `PrepareCheckState`.
+	if canceledOrderIds := k.CancelOrderGroupSiblings(ctx, orderToRemove, fillAmount > 0); len(canceledOrderIds) > 0 {
+		processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+		processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+			processProposerMatchesEvents.RemovedStatefulOrderIds,
+			canceledOrderIds...,
+		)
+		k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+	}
+
 	telemetry.IncrCounterWithLabels(
 		[]string{types.ModuleName, metrics.ProcessOperations, metrics.StatefulOrderRemoved, metrics.Count},
 		1,
@@ -456,6 +549,38 @@ func (k Keeper) PersistOrderRemovalToState(
 	return nil
 }
 
+// mustDecrementSelfTradeMakerOrder decrements the size of a stateful maker order of a self trade by
+// `decrementQuantums` in state while keeping its priority, and emits an on-chain indexer event for the
+// replacement. The order is added to `ProcessProposerMatchesEvents` so it's replaced on the memclob in
+// `PrepareCheckState`.
+func (k Keeper) mustDecrementSelfTradeMakerOrder(
+	ctx sdk.Context,
+	makerOrder types.Order,
+	decrementQuantums uint64,
+) {
+	decrementedOrder := makerOrder
+	decrementedOrder.Quantums -= decrementQuantums
+	k.MustReplaceStatefulOrder(ctx, decrementedOrder, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderReplacementEvent(
+				decrementedOrder,
+			),
+		),
+	)
+
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	processProposerMatchesEvents.ReplacedStatefulOrderIds = append(
+		processProposerMatchesEvents.ReplacedStatefulOrderIds,
+		decrementedOrder.OrderId,
+	)
+	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+}
+
 // PersistMatchOrdersToState writes a MatchOrders object to state and emits an onchain
 // indexer event for the match.
 func (k Keeper) PersistMatchOrdersToState(
@@ -873,8 +998,10 @@ func (k Keeper) GenerateProcessProposerMatchesEvents(
 					seenOrderIdsFilledInLastBlock[makerOrderId] = struct{}{}
 				}
 			}
-		} else if operationRemoval := operation.GetOrderRemoval(); operationRemoval != nil {
-			// For order removal, add order id to `seenOrderIdsRemovedInLastBlock`
+		} else if operationRemoval := operation.GetOrderRemoval(); operationRemoval != nil &&
+			!operationRemoval.IsSelfTradeDecrement() {
+			// For order removal, add order id to `seenOrderIdsRemovedInLastBlock`. Maker orders decremented
+			// due to a self trade are not removed.
 			orderId := operationRemoval.GetOrderId()
 			seenOrderIdsRemovedInLastBlock[orderId] = struct{}{}
 		}
@@ -34,8 +34,9 @@ func (k Keeper) FetchOrderFromOrderId(
 		return order, nil
 	}
 
-	// For stateful orders, fetch from state. Do not fetch from untriggered conditional orders.
-	if orderId.IsLongTermOrder() {
+	// For stateful orders, fetch from state. Do not fetch from untriggered conditional orders or TWAP
+	// orders, since only their suborders are placed on the memclob.
+	if orderId.IsLongTermOrder() || orderId.IsTwapSuborder() {
 		statefulOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, orderId)
 		if !found {
 			return order, errorsmod.Wrapf(
@@ -55,6 +56,12 @@ func (k Keeper) FetchOrderFromOrderId(
 			)
 		}
 		return conditionalOrderPlacement.Order, nil
+	} else if orderId.IsTwapOrder() {
+		return order, errorsmod.Wrapf(
+			types.ErrInvalidMatchOrder,
+			"TWAP order id %+v is never placed on the memclob, only its suborders are.",
+			orderId,
+		)
 	}
 
 	panic(
@@ -129,3 +136,139 @@ func (k Keeper) ValidateLiquidationOrderAgainstProposedLiquidation(
 	}
 	return nil
 }
+
+// validateSelfTradeOrderRemoval statefully validates an order removal due to a self trade against the taker
+// order of the self trade. An error is returned when
+//   - The removal does not reference a taker order and the subaccount of the removed order is not
+//     liquidatable, since only self trades with liquidation orders do not reference a taker order.
+//   - The removed order is the taker order, and the removal reason does not match its self-trade
+//     prevention mode or the mode does not cancel the taker order.
+//   - The removed order is the maker order, and the orders do not cross or the removal reason does not
+//     match the self-trade prevention mode of the taker order or the mode does not cancel the maker order.
+//   - The removal reason is `REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL` and the maker order is larger
+//     than the remaining size of the taker order, or the decrement is not equal to the remaining size of the
+//     taker order and smaller than the maker order.
+//
+// The remaining size of the taker order is its remaining size in state less the sizes it was decremented by due
+// to self trades with smaller maker orders earlier in the block, which are tracked in `selfTradeTakerDecrements`.
+func (k Keeper) validateSelfTradeOrderRemoval(
+	ctx sdk.Context,
+	orderRemoval types.OrderRemoval,
+	orderToRemove types.Order,
+	placedShortTermOrders map[types.OrderId]types.Order,
+	selfTradeTakerDecrements map[types.OrderId]uint64,
+) error {
+	if orderRemoval.SelfTradeTakerOrderId == nil {
+		isLiquidatable, err := k.IsLiquidatable(ctx, orderToRemove.OrderId.SubaccountId)
+		if err != nil {
+			return err
+		}
+		if !isLiquidatable {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. No taker order and subaccount is not liquidatable.",
+				orderRemoval,
+			)
+		}
+		return nil
+	}
+
+	takerOrder, err := k.FetchOrderFromOrderId(ctx, *orderRemoval.SelfTradeTakerOrderId, placedShortTermOrders)
+	if err != nil {
+		return err
+	}
+	takerRemovalReason := takerOrder.GetSelfTradePreventionRemovalReason()
+
+	// The taker order is removed if its self-trade prevention mode cancels the taker order.
+	if takerOrder.OrderId == orderToRemove.OrderId {
+		if orderRemoval.RemovalReason != takerRemovalReason ||
+			takerRemovalReason == types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Taker order self-trade prevention mode %v does not cancel the taker order.",
+				orderRemoval,
+				takerOrder.SelfTradePreventionMode,
+			)
+		}
+		return nil
+	}
+
+	// The maker order must be a resting order on the opposite side of the same orderbook that the taker
+	// order crosses.
+	takerCrossesMaker := takerOrder.GetSubticks() <= orderToRemove.GetSubticks()
+	if takerOrder.IsBuy() {
+		takerCrossesMaker = takerOrder.GetSubticks() >= orderToRemove.GetSubticks()
+	}
+	if takerOrder.GetClobPairId() != orderToRemove.GetClobPairId() ||
+		takerOrder.IsBuy() == orderToRemove.IsBuy() ||
+		!takerCrossesMaker {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Taker order %s does not cross the maker order.",
+			orderRemoval,
+			takerOrder.GetOrderTextString(),
+		)
+	}
+
+	if orderRemoval.RemovalReason != takerRemovalReason ||
+		takerRemovalReason == types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Taker order self-trade prevention mode %v does not cancel the maker order.",
+			orderRemoval,
+			takerOrder.SelfTradePreventionMode,
+		)
+	}
+
+	if orderRemoval.RemovalReason != types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL {
+		return nil
+	}
+
+	// The smaller of the two orders is canceled, and the larger order is decremented by the remaining size
+	// of the smaller order. Removals of smaller maker orders come before the matches of the taker order made
+	// in the same matching cycle in the operations queue, so the remaining size of the taker order is an upper
+	// bound for them. Decrements of larger maker orders come after the matches of the taker order, which stops
+	// matching once it decrements a maker order, so the remaining size of the taker order is exact for them.
+	_, makerFillAmount, _ := k.GetOrderFillAmount(ctx, orderToRemove.OrderId)
+	_, takerFillAmount, _ := k.GetOrderFillAmount(ctx, takerOrder.OrderId)
+	takerDecrementedQuantums := selfTradeTakerDecrements[takerOrder.OrderId]
+	if makerFillAmount >= orderToRemove.GetBaseQuantums() ||
+		takerFillAmount.ToUint64()+takerDecrementedQuantums >= takerOrder.GetBaseQuantums().ToUint64() {
+		return errorsmod.Wrapf(
+			types.ErrOrderFullyFilled,
+			"Order Removal (%+v) invalid. Maker or taker order has no remaining size.",
+			orderRemoval,
+		)
+	}
+	makerRemainingQuantums := orderToRemove.GetBaseQuantums().ToUint64() - makerFillAmount.ToUint64()
+	takerRemainingQuantums := takerOrder.GetBaseQuantums().ToUint64() - takerFillAmount.ToUint64() -
+		takerDecrementedQuantums
+
+	if !orderRemoval.IsSelfTradeDecrement() {
+		if makerRemainingQuantums > takerRemainingQuantums {
+			return errorsmod.Wrapf(
+				types.ErrInvalidOrderRemoval,
+				"Order Removal (%+v) invalid. Maker order with remaining size %d is larger than the taker order "+
+					"with remaining size %d and must be decremented.",
+				orderRemoval,
+				makerRemainingQuantums,
+				takerRemainingQuantums,
+			)
+		}
+		selfTradeTakerDecrements[takerOrder.OrderId] += makerRemainingQuantums
+		return nil
+	}
+
+	if orderRemoval.DecrementQuantums != takerRemainingQuantums ||
+		orderRemoval.DecrementQuantums >= makerRemainingQuantums {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderRemoval,
+			"Order Removal (%+v) invalid. Decrement must be equal to the taker order remaining size %d and "+
+				"smaller than the maker order remaining size %d.",
+			orderRemoval,
+			takerRemainingQuantums,
+			makerRemainingQuantums,
+		)
+	}
+	return nil
+}
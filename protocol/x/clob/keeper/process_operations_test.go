@@ -74,6 +74,63 @@ type processProposerOperationsTestCase struct {
 
 func TestProcessProposerOperations(t *testing.T) {
 	blockHeight := uint32(5)
+
+	// Alice's Long-term buy order of size 40 with decrement-and-cancel self-trade prevention.
+	takerBuy40StpDecrementAndCancel :=
+		constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel
+	takerBuy40StpDecrementAndCancel.Quantums = 40
+
+	// Dave's Long-term sell order of 1 BTC at $49,999.
+	longTermSell1BTCPrice49999 := constants.LongTermOrder_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTBT10
+	longTermSell1BTCPrice49999.Subticks = constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price49999_GTB10.Subticks
+
+	// tripCircuitBreaker trips the circuit breaker of the BTC clob pair, which transitions the clob pair to
+	// `trippedStatus`.
+	tripCircuitBreaker := func(
+		ctx sdk.Context,
+		ks keepertest.ClobKeepersTestContext,
+		trippedStatus types.ClobPair_Status,
+	) {
+		ks.ClobKeeper.GetIndexerEventManager().(*mocks.IndexerEventManager).On(
+			"AddBlockEvent",
+			mock.Anything,
+			indexerevents.SubtypeUpdateClobPair,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.UpdateClobPairEventVersion,
+			mock.Anything,
+		).Return()
+		require.NoError(
+			t,
+			ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+				ctx,
+				0,
+				nil,
+				&types.CircuitBreakerConfig{
+					MaxOraclePriceChangePpm: 100_000,
+					WindowBlocks:            5,
+					CooldownBlocks:          5,
+					TrippedStatus:           trippedStatus,
+				},
+			),
+		)
+		marketPrice, err := ks.PricesKeeper.GetMarketPrice(ctx, 0)
+		require.NoError(t, err)
+		ks.ClobKeeper.UpdateCircuitBreakers(ctx)
+		for _, price := range []uint64{marketPrice.Price * 2, marketPrice.Price} {
+			require.NoError(
+				t,
+				ks.PricesKeeper.UpdateMarketPrices(
+					ctx,
+					[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{{MarketId: 0, Price: price}},
+				),
+			)
+			ks.ClobKeeper.UpdateCircuitBreakers(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+		}
+		clobPair, found := ks.ClobKeeper.GetClobPair(ctx, 0)
+		require.True(t, found)
+		require.Equal(t, trippedStatus, clobPair.Status)
+	}
+
 	tests := map[string]processProposerOperationsTestCase{
 		"Succeeds no operations": {
 			perpetuals:                []perptypes.Perpetual{},
@@ -792,6 +849,109 @@ func TestProcessProposerOperations(t *testing.T) {
 				constants.Dave_Num0: {},
 			},
 		},
+		// Liquidations are allowed for markets whose circuit breaker is tripped, such that the positions of
+		// undercollateralized subaccounts are still closed while trading is halted.
+		"Succeeds with liquidation order for market in cancel-only mode due to a tripped circuit breaker": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// liquidatable: MMR = $5000, TNC = $0
+				constants.Carl_Num0_1BTC_Short_50000USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			preExistingStatefulOrders: []types.Order{
+				longTermSell1BTCPrice49999,
+			},
+			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
+				tripCircuitBreaker(ctx, ks, types.ClobPair_STATUS_CANCEL_ONLY)
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualLiquidation(
+					types.MatchPerpetualLiquidation{
+						Liquidated:  constants.Carl_Num0,
+						ClobPairId:  0,
+						PerpetualId: 0,
+						TotalSize:   100_000_000,
+						IsBuy:       true,
+						Fills: []types.MakerFill{
+							{
+								FillAmount:   100_000_000,
+								MakerOrderId: longTermSell1BTCPrice49999.GetOrderId(),
+							},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				OrderIdsFilledInLastBlock: []types.OrderId{
+					longTermSell1BTCPrice49999.GetOrderId(),
+				},
+				RemovedStatefulOrderIds: []types.OrderId{
+					longTermSell1BTCPrice49999.GetOrderId(),
+				},
+				BlockHeight: blockHeight,
+			},
+			expectedMatches: []*MatchWithOrdersForTesting{
+				{
+					MatchWithOrders: types.MatchWithOrders{
+						TakerOrder: &constants.LiquidationOrder_Carl_Num0_Clob0_Buy1BTC_Price50500,
+						MakerOrder: &longTermSell1BTCPrice49999,
+						FillAmount: 100_000_000,
+						MakerFee:   9_999_800,
+						TakerFee:   1_000_000,
+					},
+					TotalFilledMaker: 100_000_000,
+					TotalFilledTaker: 100_000_000,
+				},
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Carl_Num0: 0,
+				constants.Dave_Num0: constants.Usdc_Asset_99_999.GetBigQuantums().Int64() - int64(9_999_800),
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Carl_Num0: {},
+				constants.Dave_Num0: {},
+			},
+		},
+		"Fails with liquidation order for market in cancel-only mode": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_CancelOnly,
+			},
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_50000USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			preExistingStatefulOrders: []types.Order{
+				longTermSell1BTCPrice49999,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualLiquidation(
+					types.MatchPerpetualLiquidation{
+						Liquidated:  constants.Carl_Num0,
+						ClobPairId:  0,
+						PerpetualId: 0,
+						TotalSize:   100_000_000,
+						IsBuy:       true,
+						Fills: []types.MakerFill{
+							{
+								FillAmount:   100_000_000,
+								MakerOrderId: longTermSell1BTCPrice49999.GetOrderId(),
+							},
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
 		// This test proposes a set of operations where no liquidation match occurs before the
 		// deleveraging match. This happens in the case where the liquidation taker order did
 		// not match with any orders on the other side of the book, the subaccount total net collateral
@@ -1270,11 +1430,14 @@ func TestProcessProposerOperations(t *testing.T) {
 				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
 				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
 				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO,
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
 			},
 			rawOperations: []types.OperationRaw{
-				clobtest.NewOrderRemovalOperationRaw(
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
 					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
 					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+					0,
 				),
 				clobtest.NewOrderRemovalOperationRaw(
 					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell10_Price10_GTBT10_PO.OrderId,
@@ -1290,6 +1453,147 @@ func TestProcessProposerOperations(t *testing.T) {
 				},
 			},
 		},
+		"Succeeds order removal operation for order priced beyond the price band": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+			},
+			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
+				require.NoError(
+					t,
+					ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+						ctx,
+						0,
+						&types.PriceBandConfig{BandPpm: 100_000, Mode: types.PriceBandConfig_MODE_CLAMP},
+						nil,
+					),
+				)
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+				),
+			},
+
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+				},
+			},
+		},
+		"Succeeds order removal operation for self-trade cancel-taker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpCancelTaker,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpCancelTaker.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpCancelTaker.OrderId,
+					0,
+				),
+			},
+
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpCancelTaker.OrderId,
+				},
+			},
+		},
+		"Succeeds self-trade order decrement of a larger maker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					5,
+				),
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					0,
+				),
+			},
+
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+				},
+				ReplacedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+				},
+			},
+		},
+		"Succeeds self-trade order decrement after an earlier decrement of the taker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{},
+			preExistingStatefulOrders: []types.Order{
+				takerBuy40StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					takerBuy40StpDecrementAndCancel.OrderId,
+					0,
+				),
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					takerBuy40StpDecrementAndCancel.OrderId,
+					20,
+				),
+			},
+
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+				},
+				ReplacedStatefulOrderIds: []types.OrderId{
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+				},
+			},
+		},
 		"Fails when attempting to match order with invalid order side": {
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_100PercentMarginRequirement,
@@ -1745,6 +2049,48 @@ func TestProcessProposerOperations(t *testing.T) {
 			},
 			expectedError: types.ErrOperationConflictsWithClobPairStatus,
 		},
+		"Fails with clob match for market in cancel-only mode": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_CancelOnly,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRaw(
+					&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15,
+					[]types.MakerFill{
+						{
+							FillAmount:   5,
+							MakerOrderId: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
+		"Fails with clob match for market in post-only mode": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc_PostOnly,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRaw(
+					&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15,
+					[]types.MakerFill{
+						{
+							FillAmount:   5,
+							MakerOrderId: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOperationConflictsWithClobPairStatus,
+		},
 		"Fails with short term order placement for market in initializing mode": {
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_100PercentMarginRequirement,
@@ -1798,6 +2144,232 @@ func TestProcessProposerOperations(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidOrderRemoval,
 		},
+		"Fails with order removal reason outside price band for market without price band": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with order removal reason outside price band for order inside of the price band": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				// A buy order priced below the price band is not priced beyond it on the aggressive side.
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10,
+			},
+			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
+				require.NoError(
+					t,
+					ks.ClobKeeper.SetClobPairPriceProtectionConfigs(
+						ctx,
+						0,
+						&types.PriceBandConfig{BandPpm: 100_000, Mode: types.PriceBandConfig_MODE_CLAMP},
+						nil,
+					),
+				)
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with order removal reason market-maker protection for subaccount that is not tripped": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_MARKET_MAKER_PROTECTION,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with order removal reason self-trade cancel-taker for order without cancel-taker mode": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+					constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId,
+					0,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order removal without a taker order for a subaccount that is not liquidatable": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order removal of a maker order the taker order does not cross": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price5_GTBT5,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price5_GTBT5.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					0,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order removal of a larger maker order that is not decremented": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					0,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order decrement smaller than the taker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					4,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order decrement ignoring an earlier decrement of the taker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				takerBuy40StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					takerBuy40StpDecrementAndCancel.OrderId,
+					0,
+				),
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					takerBuy40StpDecrementAndCancel.OrderId,
+					40,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
+		"Fails with self-trade order decrement larger than the taker order": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			preExistingStatefulOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel,
+				constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewSelfTradeOrderRemovalOperationRaw(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					10,
+				),
+			},
+			expectedError: types.ErrInvalidOrderRemoval,
+		},
 		"Fails with order removal for market in final settlement": {
 			perpetuals: []perptypes.Perpetual{
 				constants.BtcUsd_100PercentMarginRequirement,
@@ -2498,6 +3070,14 @@ func setupProcessProposerOperationsTestCase(
 		Timestamp: time.Unix(int64(5), 0),
 	})
 
+	// Initialize the process proposer matches events for the block, as is done in `BeginBlocker`.
+	ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+		ctx,
+		types.ProcessProposerMatchesEvents{
+			BlockHeight: blockHeight,
+		},
+	)
+
 	return ctx, ks, mockIndexerEventManager
 }
 
@@ -25,8 +25,10 @@ import (
 // If additional validation of the provided orders or match fails, an error is returned.
 // The following validation occurs in this method:
 //   - Order is for a valid ClobPair.
-//   - Order is for a valid Perpetual.
+//   - Order is for a valid Perpetual, if the ClobPair is a perpetual ClobPair.
 //   - Validate the `fillAmount` of a match is divisible by the `ClobPair`'s `StepBaseQuantums`.
+//   - Validate non-liquidation matches are executed within the `ClobPair`'s price band.
+//   - Validate the market-maker protection of the maker subaccount is not tripped on the `ClobPair`.
 //   - Validate the new total fill amount of an order does not exceed the total quantums of the order given
 //     the fill amounts present in the provided `matchOrders` and in state.
 //   - Validate the subaccount updates resulting from the match are valid (before persisting the updates to state)
@@ -105,6 +107,28 @@ func (k Keeper) ProcessSingleMatch(
 	// Define local variable relevant to retrieving QuoteQuantums based on the fill amount.
 	makerSubticks := makerMatchableOrder.GetOrderSubticks()
 
+	// Verify that non-liquidation matches are executed within the price band of the `clobPair`.
+	// Liquidations are validated against the oracle price through the fillable price instead.
+	if !takerMatchableOrder.IsLiquidation() {
+		if err := k.validateFillAgainstPriceBand(ctx, clobPair, makerSubticks); err != nil {
+			return false, takerUpdateResult, makerUpdateResult, nil, err
+		}
+	}
+
+	// Verify that the maker subaccount has not tripped its market-maker protection on the `clobPair`.
+	if makerSubaccountId := makerMatchableOrder.GetSubaccountId(); k.IsMarketMakerProtectionTripped(
+		ctx,
+		makerSubaccountId,
+		clobPairId,
+	) {
+		return false, takerUpdateResult, makerUpdateResult, nil, errorsmod.Wrapf(
+			types.ErrMarketMakerProtectionTripped,
+			"Maker subaccount %+v cannot be filled on clob pair %d",
+			makerSubaccountId,
+			clobPairId,
+		)
+	}
+
 	// Calculate the number of quote quantums for the match based on the maker order subticks.
 	bigFillQuoteQuantums, err := getFillQuoteQuantums(clobPair, makerSubticks, fillAmount)
 	if err != nil {
@@ -128,10 +152,18 @@ func (k Keeper) ProcessSingleMatch(
 		)
 	}
 
-	// Retrieve the associated perpetual id for the `ClobPair`.
-	perpetualId, err := clobPair.GetPerpetualId()
-	if err != nil {
-		return false, takerUpdateResult, makerUpdateResult, nil, err
+	// Retrieve the associated perpetual id for the `ClobPair`. Spot `ClobPair`s have no perpetual
+	// and cannot be liquidated.
+	var perpetualId uint32
+	if clobPair.IsSpotClobPair() {
+		if takerMatchableOrder.IsLiquidation() {
+			return false, takerUpdateResult, makerUpdateResult, nil, types.ErrOrderTypeNotSupportedForSpotClobPair
+		}
+	} else {
+		perpetualId, err = clobPair.GetPerpetualId()
+		if err != nil {
+			return false, takerUpdateResult, makerUpdateResult, nil, err
+		}
 	}
 
 	// Calculate taker and maker fee ppms.
@@ -216,15 +248,26 @@ func (k Keeper) ProcessSingleMatch(
 	}
 
 	// Update both subaccounts in the matched order atomically.
-	takerUpdateResult, makerUpdateResult, err = k.persistMatchedOrders(
-		ctx,
-		matchWithOrders,
-		perpetualId,
-		takerFeePpm,
-		makerFeePpm,
-		bigFillQuoteQuantums,
-		takerInsuranceFundDelta,
-	)
+	if clobPair.IsSpotClobPair() {
+		takerUpdateResult, makerUpdateResult, err = k.persistMatchedSpotOrders(
+			ctx,
+			matchWithOrders,
+			clobPair,
+			takerFeePpm,
+			makerFeePpm,
+			bigFillQuoteQuantums,
+		)
+	} else {
+		takerUpdateResult, makerUpdateResult, err = k.persistMatchedOrders(
+			ctx,
+			matchWithOrders,
+			perpetualId,
+			takerFeePpm,
+			makerFeePpm,
+			bigFillQuoteQuantums,
+			takerInsuranceFundDelta,
+		)
+	}
 
 	if err != nil {
 		return false, takerUpdateResult, makerUpdateResult, nil, err
@@ -292,6 +335,15 @@ func (k Keeper) ProcessSingleMatch(
 	)
 	offchainUpdates.Append(makerOffchainUpdates)
 
+	// Track the maker fill for the market-maker protection of the maker subaccount.
+	k.recordMakerFillForMarketMakerProtection(
+		ctx,
+		matchWithOrders.MakerOrder.GetSubaccountId(),
+		clobPairId,
+		fillAmount,
+		bigFillQuoteQuantums,
+	)
+
 	return true, takerUpdateResult, makerUpdateResult, offchainUpdates, nil
 }
 
@@ -496,6 +548,156 @@ func (k Keeper) persistMatchedOrders(
 	return takerUpdateResult, makerUpdateResult, nil
 }
 
+// persistMatchedSpotOrders persists a matched spot order to the subaccount state,
+// by exchanging the base asset for the quote asset between the affected subaccounts.
+// This method also transfers fees to the fee collector module.
+// This method mutates matchWithOrders by setting the fee fields.
+func (k Keeper) persistMatchedSpotOrders(
+	ctx sdk.Context,
+	matchWithOrders *types.MatchWithOrders,
+	clobPair types.ClobPair,
+	takerFeePpm int32,
+	makerFeePpm int32,
+	bigFillQuoteQuantums *big.Int,
+) (
+	takerUpdateResult satypes.UpdateResult,
+	makerUpdateResult satypes.UpdateResult,
+	err error,
+) {
+	baseAssetId, quoteAssetId := clobPair.MustGetSpotAssetIds()
+
+	// Taker fees and maker fees/rebates are rounded towards positive infinity.
+	bigTakerFeeQuoteQuantums := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, takerFeePpm, true)
+	bigMakerFeeQuoteQuantums := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, makerFeePpm, true)
+
+	matchWithOrders.MakerFee = bigMakerFeeQuoteQuantums.Int64()
+	matchWithOrders.TakerFee = bigTakerFeeQuoteQuantums.Int64()
+
+	bigTakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
+	bigMakerQuoteBalanceDelta := new(big.Int).Set(bigFillQuoteQuantums)
+
+	bigTakerBaseBalanceDelta := matchWithOrders.FillAmount.ToBigInt()
+	bigMakerBaseBalanceDelta := matchWithOrders.FillAmount.ToBigInt()
+
+	if matchWithOrders.TakerOrder.IsBuy() {
+		bigTakerQuoteBalanceDelta.Neg(bigTakerQuoteBalanceDelta)
+		bigMakerBaseBalanceDelta.Neg(bigMakerBaseBalanceDelta)
+	} else {
+		bigMakerQuoteBalanceDelta.Neg(bigMakerQuoteBalanceDelta)
+		bigTakerBaseBalanceDelta.Neg(bigTakerBaseBalanceDelta)
+	}
+
+	// Subtract quote balance delta with fees paid.
+	bigTakerQuoteBalanceDelta.Sub(bigTakerQuoteBalanceDelta, bigTakerFeeQuoteQuantums)
+	bigMakerQuoteBalanceDelta.Sub(bigMakerQuoteBalanceDelta, bigMakerFeeQuoteQuantums)
+
+	// Create the subaccount update.
+	updates := []satypes.Update{
+		// Taker update
+		{
+			AssetUpdates: []satypes.AssetUpdate{
+				{
+					AssetId:          quoteAssetId,
+					BigQuantumsDelta: bigTakerQuoteBalanceDelta,
+				},
+				{
+					AssetId:          baseAssetId,
+					BigQuantumsDelta: bigTakerBaseBalanceDelta,
+				},
+			},
+			SubaccountId: matchWithOrders.TakerOrder.GetSubaccountId(),
+		},
+		// Maker update
+		{
+			AssetUpdates: []satypes.AssetUpdate{
+				{
+					AssetId:          quoteAssetId,
+					BigQuantumsDelta: bigMakerQuoteBalanceDelta,
+				},
+				{
+					AssetId:          baseAssetId,
+					BigQuantumsDelta: bigMakerBaseBalanceDelta,
+				},
+			},
+			SubaccountId: matchWithOrders.MakerOrder.GetSubaccountId(),
+		},
+	}
+
+	// Apply the update.
+	success, successPerUpdate, err := k.subaccountsKeeper.UpdateSubaccounts(
+		ctx,
+		updates,
+		satypes.SpotMatch,
+	)
+	if err != nil {
+		return satypes.UpdateCausedError, satypes.UpdateCausedError, err
+	}
+
+	takerUpdateResult = successPerUpdate[0]
+	makerUpdateResult = successPerUpdate[1]
+
+	// If not successful, return error indicating why.
+	if updateResultErr := satypes.GetErrorFromUpdateResults(
+		success,
+		successPerUpdate,
+		updates,
+	); updateResultErr != nil {
+		return takerUpdateResult, makerUpdateResult, updateResultErr
+	}
+
+	// Transfer the fee amount from subacounts module to fee collector module account.
+	bigTotalFeeQuoteQuantums := new(big.Int).Add(bigTakerFeeQuoteQuantums, bigMakerFeeQuoteQuantums)
+	if err := k.subaccountsKeeper.TransferSpotFeesToFeeCollectorModule(
+		ctx,
+		quoteAssetId,
+		bigTotalFeeQuoteQuantums,
+	); err != nil {
+		return takerUpdateResult, makerUpdateResult, errorsmod.Wrapf(
+			types.ErrSubaccountFeeTransferFailed,
+			"persistMatchedSpotOrders: subaccounts (%v, %v) updated, but fee transfer (bigFeeQuoteQuantums: %v)"+
+				" to fee-collector failed. Err: %v",
+			matchWithOrders.MakerOrder.GetSubaccountId(),
+			matchWithOrders.TakerOrder.GetSubaccountId(),
+			bigTotalFeeQuoteQuantums,
+			err,
+		)
+	}
+
+	// Process fill in x/stats and x/rewards.
+	k.rewardsKeeper.AddRewardSharesForFill(
+		ctx,
+		matchWithOrders.TakerOrder.GetSubaccountId().Owner,
+		matchWithOrders.MakerOrder.GetSubaccountId().Owner,
+		bigFillQuoteQuantums,
+		bigTakerFeeQuoteQuantums,
+		bigMakerFeeQuoteQuantums,
+	)
+
+	k.statsKeeper.RecordFill(
+		ctx,
+		matchWithOrders.TakerOrder.GetSubaccountId().Owner,
+		matchWithOrders.MakerOrder.GetSubaccountId().Owner,
+		bigFillQuoteQuantums,
+	)
+
+	// Emit an event indicating a match occurred.
+	ctx.EventManager().EmitEvent(
+		types.NewCreateSpotMatchEvent(
+			matchWithOrders.TakerOrder.GetSubaccountId(),
+			matchWithOrders.MakerOrder.GetSubaccountId(),
+			bigTakerFeeQuoteQuantums,
+			bigMakerFeeQuoteQuantums,
+			bigTakerQuoteBalanceDelta,
+			bigMakerQuoteBalanceDelta,
+			bigTakerBaseBalanceDelta,
+			bigMakerBaseBalanceDelta,
+			baseAssetId,
+		),
+	)
+
+	return takerUpdateResult, makerUpdateResult, nil
+}
+
 func (k Keeper) setOrderFillAmountsAndPruning(
 	ctx sdk.Context,
 	order types.Order,
@@ -532,6 +734,12 @@ func (k Keeper) setOrderFillAmountsAndPruning(
 		k.AddOrdersForPruning(ctx, []types.OrderId{order.OrderId}, pruneableBlockHeight)
 	}
 
+	// Add the new fill of a TWAP suborder to the aggregate fill amount of its TWAP order.
+	if order.IsTwapSuborder() {
+		_, curFillAmount, _ := k.GetOrderFillAmount(ctx, order.OrderId)
+		k.addTwapSuborderFillAmount(ctx, order.OrderId, newTotalFillAmount-curFillAmount)
+	}
+
 	// Update the state with the new `fillAmount` for this `orderId`.
 	// TODO(DEC-1219): Determine whether we should use `OrderFillState` proto for stateful order fill amounts.
 	k.SetOrderFillAmount(
@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSingleMatch_Spot(t *testing.T) {
+	clobPair := constants.ClobPair_Spot_Btc
+
+	// Alice buys 1 BTC from Bob at $50,000.
+	takerOrder := types.Order{
+		OrderId: types.OrderId{
+			SubaccountId: constants.Alice_Num0,
+			ClientId:     0,
+			ClobPairId:   clobPair.Id,
+		},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     100_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+	makerOrder := types.Order{
+		OrderId: types.OrderId{
+			SubaccountId: constants.Bob_Num0,
+			ClientId:     0,
+			ClobPairId:   clobPair.Id,
+		},
+		Side:         types.Order_SIDE_SELL,
+		Quantums:     100_000_000,
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+
+	tests := map[string]struct {
+		// State.
+		subaccounts []satypes.Subaccount
+
+		// Expectations.
+		expectedErr               error
+		expectedTakerUpdateResult satypes.UpdateResult
+		expectedMakerUpdateResult satypes.UpdateResult
+		expectedSubaccounts       []satypes.Subaccount
+	}{
+		"Match settles as asset transfers between the taker and maker": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.Usdc.Id,
+							Quantums: dtypes.NewInt(100_000_000_000), // $100,000
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedTakerUpdateResult: satypes.Success,
+			expectedMakerUpdateResult: satypes.Success,
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: constants.Usdc.Id,
+							// $100,000 - $50,000 - $25 taker fee
+							Quantums: dtypes.NewInt(49_975_000_000),
+						},
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId: constants.Usdc.Id,
+							// $50,000 - $10 maker fee
+							Quantums: dtypes.NewInt(49_990_000_000),
+						},
+					},
+				},
+			},
+		},
+		"Match fails when the maker does not hold enough of the base asset": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.Usdc.Id,
+							Quantums: dtypes.NewInt(100_000_000_000), // $100,000
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(50_000_000), // 0.5 BTC
+						},
+					},
+				},
+			},
+			expectedErr:               satypes.ErrFailedToUpdateSubaccounts,
+			expectedTakerUpdateResult: satypes.Success,
+			expectedMakerUpdateResult: satypes.InsufficientAssetBalance,
+		},
+		"Match fails when the taker does not hold enough USDC to pay for the fill": {
+			// The purchased BTC would keep the taker collateralized, but spot matches cannot borrow USDC.
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.Usdc.Id,
+							Quantums: dtypes.NewInt(40_000_000_000), // $40,000
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedErr:               satypes.ErrFailedToUpdateSubaccounts,
+			expectedTakerUpdateResult: satypes.InsufficientAssetBalance,
+			expectedMakerUpdateResult: satypes.Success,
+		},
+		"Match fails when the taker does not hold enough USDC to pay the fee": {
+			subaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.Usdc.Id,
+							Quantums: dtypes.NewInt(50_010_000_000), // $50,010
+						},
+					},
+				},
+				{
+					Id: &constants.Bob_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						{
+							AssetId:  constants.BtcUsd.Id,
+							Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			expectedErr:               satypes.ErrFailedToUpdateSubaccounts,
+			expectedTakerUpdateResult: satypes.InsufficientAssetBalance,
+			expectedMakerUpdateResult: satypes.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockBankKeeper := &mocks.BankKeeper{}
+			mockBankKeeper.On(
+				"SendCoins",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return(nil)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			mockIndexerEventManager.On("Enabled").Return(false).Maybe()
+			mockIndexerEventManager.On("AddTxnEvent",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return().Maybe()
+
+			ks := keepertest.NewClobKeepersTestContext(
+				t,
+				memclob.NewMemClobPriceTimePriority(false),
+				mockBankKeeper,
+				mockIndexerEventManager,
+			)
+			ctx := ks.Ctx.WithIsCheckTx(false)
+
+			keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+			require.NoError(t, ks.FeeTiersKeeper.SetPerpetualFeeParams(ctx, constants.PerpetualFeeParams))
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, ks.AssetsKeeper))
+			_, err := ks.AssetsKeeper.CreateAsset(
+				ctx,
+				constants.BtcUsd.Id,
+				constants.BtcUsd.Symbol,
+				constants.BtcUsd.Denom,
+				constants.BtcUsd.DenomExponent,
+				constants.BtcUsd.HasMarket,
+				constants.BtcUsd.MarketId,
+				constants.BtcUsd.AtomicResolution,
+				constants.BtcUsd.HaircutPpm,
+			)
+			require.NoError(t, err)
+
+			baseAssetId, quoteAssetId := clobPair.MustGetSpotAssetIds()
+			_, err = ks.ClobKeeper.CreateSpotClobPair(
+				ctx,
+				clobPair.Id,
+				baseAssetId,
+				quoteAssetId,
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				clobPair.Status,
+			)
+			require.NoError(t, err)
+
+			for _, subaccount := range tc.subaccounts {
+				ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+			}
+
+			matchWithOrders := &types.MatchWithOrders{
+				TakerOrder: &takerOrder,
+				MakerOrder: &makerOrder,
+				FillAmount: satypes.BaseQuantums(100_000_000),
+			}
+			success, takerUpdateResult, makerUpdateResult, _, err := ks.ClobKeeper.ProcessSingleMatch(
+				ctx,
+				matchWithOrders,
+			)
+			require.Equal(t, tc.expectedTakerUpdateResult, takerUpdateResult)
+			require.Equal(t, tc.expectedMakerUpdateResult, makerUpdateResult)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.False(t, success)
+				for _, subaccount := range tc.subaccounts {
+					require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ctx, *subaccount.Id))
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, success)
+			require.Equal(t, int64(25_000_000), matchWithOrders.TakerFee)
+			require.Equal(t, int64(10_000_000), matchWithOrders.MakerFee)
+			for _, subaccount := range tc.expectedSubaccounts {
+				require.Equal(t, subaccount, ks.SubaccountsKeeper.GetSubaccount(ctx, *subaccount.Id))
+			}
+
+			// Spot CLOB pairs have no perpetual positions, so the base asset
+			// balance is used as the state position.
+			require.Zero(t, ks.ClobKeeper.GetStatePosition(ctx, constants.Bob_Num0, clobPair.GetClobPairId()).Sign())
+			require.Equal(
+				t,
+				big.NewInt(100_000_000),
+				ks.ClobKeeper.GetStatePosition(ctx, constants.Alice_Num0, clobPair.GetClobPairId()),
+			)
+		})
+	}
+}
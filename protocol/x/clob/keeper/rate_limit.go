@@ -90,6 +90,73 @@ func (k *Keeper) RateLimitBatchCancel(ctx sdk.Context, msg *types.MsgBatchCancel
 	return k.placeCancelOrderRateLimiter.RateLimit(ctx, msg)
 }
 
+// RateLimitBatchPlaceOrder passes orders with valid clob pairs to `placeOrderRateLimiter`.
+// The rate limiting is only performed during `CheckTx`.
+func (k *Keeper) RateLimitBatchPlaceOrder(ctx sdk.Context, msg *types.MsgBatchPlaceOrder) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`. Note that `MsgBatchPlaceOrder` is evicted
+	// from the mempool during `ReCheckTx` so it does not count towards the rate limit again.
+	if lib.IsDeliverTxMode(ctx) || ctx.IsReCheckTx() {
+		return nil
+	}
+
+	for _, order := range msg.ShortTermOrders {
+		_, found := k.GetClobPair(ctx, order.GetClobPairId())
+		// If the clob pair isn't found then we expect order validation to fail the order as being invalid.
+		if !found {
+			return nil
+		}
+	}
+
+	// Ensure that the GTB of every order is valid before we attempt to rate limit. This is to prevent a replay
+	// attack where short-term order placements with GTBs in the past or the far future could be replayed by an
+	// adversary. Normally transaction replay attacks rely on sequence numbers being part of the signature and being
+	// incremented for each transaction but sequence number verification is skipped for short-term orders.
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
+	for _, order := range msg.ShortTermOrders {
+		if err := k.validateGoodTilBlock(order.GetGoodTilBlock(), nextBlockHeight); err != nil {
+			return err
+		}
+	}
+
+	return k.placeCancelOrderRateLimiter.RateLimit(ctx, msg)
+}
+
+// RateLimitCancelAllOrders passes cancel all orders msgs with valid clob pairs to `placeOrderRateLimiter`.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitCancelAllOrders(ctx sdk.Context, msg *types.MsgCancelAllOrders) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`.
+	if lib.IsDeliverTxMode(ctx) {
+		return nil
+	}
+
+	for _, clobPairId := range msg.ClobPairIds {
+		_, found := k.GetClobPair(ctx, types.ClobPairId(clobPairId))
+		// If the clob pair isn't found then we expect cancel all orders validation to fail the msg as being invalid.
+		if !found {
+			return nil
+		}
+	}
+
+	return k.placeCancelOrderRateLimiter.RateLimit(ctx, msg)
+}
+
+// RateLimitReplaceOrder passes order replacements with valid clob pairs to `placeOrderRateLimiter`.
+// The rate limiting is only performed during `CheckTx` and `ReCheckTx`.
+func (k *Keeper) RateLimitReplaceOrder(ctx sdk.Context, msg *types.MsgReplaceOrder) error {
+	// Only rate limit during `CheckTx` and `ReCheckTx`.
+	if lib.IsDeliverTxMode(ctx) {
+		return nil
+	}
+
+	_, found := k.GetClobPair(ctx, msg.Order.GetClobPairId())
+	// If the clob pair isn't found then we expect order validation to fail the order as being invalid.
+	if !found {
+		return nil
+	}
+
+	return k.placeCancelOrderRateLimiter.RateLimit(ctx, msg)
+}
+
 func (k *Keeper) PruneRateLimits(ctx sdk.Context) {
 	k.placeCancelOrderRateLimiter.PruneRateLimits(ctx)
 }
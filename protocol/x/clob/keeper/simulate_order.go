@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	streaming "github.com/dydxprotocol/v4-chain/protocol/streaming/grpc"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// simulateOrder places the provided order in a memclob cloned from an orderbook snapshot, using a branched
+// `CheckTx` context whose state updates are discarded. The order is therefore matched and checked exactly
+// like an order placed in `CheckTx`, including the collateral checks of `ProcessSingleMatch` and of adding
+// the remaining size of the order to the orderbook. It returns the fills of the order, the remaining size
+// that would rest on the orderbook, the reason the order would be removed, and the fees and collateral of
+// the taker subaccount after the fills.
+//
+// Note that the orderbook snapshot is captured after each block, while the collateral checks use the
+// state of the provided context.
+func (k Keeper) simulateOrder(
+	ctx sdk.Context,
+	order types.Order,
+	clobPair types.ClobPair,
+	snapshot types.OrderbookSnapshot,
+) (
+	res *types.QuerySimulateOrderResponse,
+	err error,
+) {
+	takerSubaccountId := order.GetSubaccountId()
+	takerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(ctx, takerSubaccountId.Owner, true)
+
+	// Branch the state, so that the fills of the order are not written to state. The encoded order
+	// placement stands in for the TX bytes of a Short-Term order.
+	simulateCtx, _ := ctx.CacheContext()
+	simulateCtx = simulateCtx.
+		WithIsCheckTx(true).
+		WithTxBytes(k.cdc.MustMarshal(types.NewMsgPlaceOrder(order)))
+
+	// The fill amounts in state may lag behind the orderbook snapshot, which includes the fills of
+	// operations replayed in `PrepareCheckState`. Set them such that the remaining size of each order
+	// matches the orderbook snapshot.
+	makerOrders := make(map[types.OrderId]types.Order, len(snapshot.BidOrders)+len(snapshot.AskOrders))
+	for _, snapshotOrders := range [][]types.OrderbookSnapshotOrder{snapshot.BidOrders, snapshot.AskOrders} {
+		for _, snapshotOrder := range snapshotOrders {
+			makerOrder := snapshotOrder.Order
+			makerOrders[makerOrder.OrderId] = makerOrder
+
+			fillAmount := makerOrder.GetBaseQuantums() - snapshotOrder.RemainingQuantums
+			_, stateFillAmount, prunableBlockHeight := k.GetOrderFillAmount(simulateCtx, makerOrder.OrderId)
+			if stateFillAmount < fillAmount {
+				k.SetOrderFillAmount(simulateCtx, makerOrder.OrderId, fillAmount, prunableBlockHeight)
+			}
+		}
+	}
+
+	// Place the order in a memclob cloned from the orderbook snapshot, using a copy of the keeper that
+	// references the cloned memclob so that the memclob of the keeper is not read concurrently. The copy
+	// does not send the simulated matches to gRPC streams.
+	memClob := k.MemClob.CloneFromOrderbookSnapshot(simulateCtx, clobPair, snapshot)
+	k.MemClob = memClob
+	k.streamingManager = streaming.NewNoopGrpcStreamingManager()
+	memClob.SetClobKeeper(&k)
+
+	res = &types.QuerySimulateOrderResponse{
+		Fills:       make([]types.SimulatedFill, 0),
+		TakerFeePpm: takerFeePpm,
+	}
+	_, orderStatus, _, err := memClob.PlaceOrder(simulateCtx, order)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrFokOrderCouldNotBeFullyFilled):
+			res.RemovalReason = types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED
+		case errors.Is(err, types.ErrPostOnlyWouldCrossMakerOrder):
+			res.RemovalReason = types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER
+		case errors.Is(err, types.ErrWouldViolateIsolatedSubaccountConstraints):
+			res.RemovalReason = types.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS
+		default:
+			return nil, err
+		}
+	} else {
+		res.RemovalReason = getSimulatedOrderRemovalReason(order, orderStatus)
+	}
+
+	// Collect the fills of the order from the matches in the operations queue of the cloned memclob.
+	bigTotalFeeQuoteQuantums := new(big.Int)
+	bigTotalSubticksMulQuantums := new(big.Int)
+	operations, _, _ := memClob.GetOperationsToReplay(simulateCtx)
+	for _, operation := range operations {
+		matchOrders := operation.GetMatch().GetMatchOrders()
+		if matchOrders == nil || matchOrders.TakerOrderId != order.OrderId {
+			continue
+		}
+		for _, fill := range matchOrders.Fills {
+			makerOrder := makerOrders[fill.MakerOrderId]
+			makerSubticks := makerOrder.GetOrderSubticks()
+			fillAmount := satypes.BaseQuantums(fill.FillAmount)
+			bigFillQuoteQuantums := types.FillAmountToQuoteQuantums(
+				makerSubticks,
+				fillAmount,
+				clobPair.QuantumConversionExponent,
+			)
+			bigFeeQuoteQuantums := lib.BigIntMulSignedPpm(bigFillQuoteQuantums, takerFeePpm, true)
+
+			bigTotalFeeQuoteQuantums.Add(bigTotalFeeQuoteQuantums, bigFeeQuoteQuantums)
+			bigTotalSubticksMulQuantums.Add(
+				bigTotalSubticksMulQuantums,
+				new(big.Int).Mul(makerSubticks.ToBigInt(), fillAmount.ToBigInt()),
+			)
+			res.Fills = append(res.Fills, types.SimulatedFill{
+				MakerOrderId:     fill.MakerOrderId,
+				Subticks:         makerSubticks.ToUint64(),
+				FillQuantums:     fill.FillAmount,
+				FeeQuoteQuantums: dtypes.NewIntFromBigInt(bigFeeQuoteQuantums),
+			})
+			res.TotalFilledQuantums += fill.FillAmount
+		}
+	}
+
+	if restingOrder, found := memClob.GetOrder(simulateCtx, order.OrderId); found {
+		remainingQuantums, _ := memClob.GetOrderRemainingAmount(simulateCtx, restingOrder)
+		res.RestingQuantums = remainingQuantums.ToUint64()
+	}
+	if res.TotalFilledQuantums > 0 {
+		res.AverageFillSubticks = lib.BigRatRound(
+			new(big.Rat).SetFrac(bigTotalSubticksMulQuantums, new(big.Int).SetUint64(res.TotalFilledQuantums)),
+			order.IsBuy(),
+		).Uint64()
+	}
+	res.TotalFeeQuoteQuantums = dtypes.NewIntFromBigInt(bigTotalFeeQuoteQuantums)
+
+	// The collateral of the taker subaccount includes the fills of the order written to the branched state.
+	bigNetCollateral, bigInitialMargin, bigMaintenanceMargin, err :=
+		k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+			simulateCtx,
+			satypes.Update{SubaccountId: takerSubaccountId},
+		)
+	if err != nil {
+		return nil, err
+	}
+	res.NetCollateral = dtypes.NewIntFromBigInt(bigNetCollateral)
+	res.InitialMargin = dtypes.NewIntFromBigInt(bigInitialMargin)
+	res.MaintenanceMargin = dtypes.NewIntFromBigInt(bigMaintenanceMargin)
+	res.FreeCollateral = dtypes.NewIntFromBigInt(new(big.Int).Sub(bigNetCollateral, bigInitialMargin))
+
+	return res, nil
+}
+
+// getSimulatedOrderRemovalReason returns the reason the remaining size of a simulated order is removed
+// given the status of the order after it was placed in the memclob, or `REMOVAL_REASON_UNSPECIFIED` if
+// the order was not removed. The remaining size of an immediate-or-cancel order that would rest on the
+// orderbook is canceled without a removal reason.
+func getSimulatedOrderRemovalReason(
+	order types.Order,
+	orderStatus types.OrderStatus,
+) types.OrderRemoval_RemovalReason {
+	switch {
+	case orderStatus == types.Undercollateralized || orderStatus == types.InternalError:
+		return types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED
+	case orderStatus == types.ReduceOnlyResized:
+		return types.OrderRemoval_REMOVAL_REASON_INVALID_REDUCE_ONLY
+	case orderStatus == types.ViolatesIsolatedSubaccountConstraints:
+		return types.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS
+	case orderStatus == types.OutsidePriceBand:
+		return types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND
+	case orderStatus.IsSelfTradePrevention():
+		return order.GetSelfTradePreventionRemovalReason()
+	default:
+		return types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED
+	}
+}
@@ -26,7 +26,8 @@ import (
 // it was placed. The placed order can either be a conditional order or a long term order.
 // If the order is conditional, it will be placed into the Untriggered Conditional Orders state store.
 // If it is a long term order, it will be placed in the Long Term Order state store.
-// If the `OrderId` doesn't exist then the stateful order count is incremented.
+// If the `OrderId` doesn't exist then the stateful order count is incremented and the order is added to its
+// order group, if any.
 // Note the following:
 // - If a stateful order placement already exists in state with `order.OrderId`, this function will overwrite it.
 // - The `TransactionIndex` field will be set to the next unused transaction index for this block.
@@ -72,6 +73,9 @@ func (k Keeper) SetLongTermOrderPlacement(
 			k.GetStatefulOrderCount(ctx, order.OrderId.SubaccountId)+1,
 		)
 
+		// Add the order to its order group.
+		k.addOrderToOrderGroup(ctx, order)
+
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, metrics.StatefulOrder, metrics.Count},
 			1,
@@ -138,8 +142,9 @@ func (k Keeper) GetLongTermOrderPlacement(
 	return val, true
 }
 
-// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state
-// and decrements the stateful order count if the `orderId` exists.
+// DeleteLongTermOrderPlacement deletes a long term order and the placement information from state,
+// decrements the stateful order count and removes the order from its order group if the `orderId` exists.
+// The execution progress of TWAP orders is deleted along with the order.
 // This function is a no-op if no stateful order exists in state with `orderId`.
 func (k Keeper) DeleteLongTermOrderPlacement(
 	ctx sdk.Context,
@@ -154,7 +159,8 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 	// same regardless of whether the memstore has the order or not.
 	count := k.GetStatefulOrderCount(ctx, orderId.SubaccountId)
 	orderKey := orderId.ToStateKey()
-	if memStore.Has(orderKey) {
+	var longTermOrderPlacement types.LongTermOrderPlacement
+	if b := memStore.Get(orderKey); b != nil {
 		if count == 0 {
 			log.ErrorLog(ctx, "Stateful order count is zero but order is in the memstore. Underflow",
 				"orderId", cometbftlog.NewLazySprintf("%+v", orderId),
@@ -162,14 +168,25 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 		} else {
 			count--
 		}
+
+		k.cdc.MustUnmarshal(b, &longTermOrderPlacement)
 	}
 
+	// Remove the order from its order group. This is a no-op if the order was not found, since the
+	// zero-valued order is not in an order group.
+	k.removeOrderFromOrderGroup(ctx, longTermOrderPlacement.Order)
+
 	// Delete the `StatefulOrderPlacement` from state.
 	store.Delete(orderKey)
 
 	// Delete the `StatefulOrderPlacement` from memstore.
 	memStore.Delete(orderKey)
 
+	// Delete the execution progress of the TWAP order from state.
+	if orderId.IsTwapOrder() {
+		k.deleteTwapOrderState(ctx, orderId)
+	}
+
 	// Set the count.
 	k.SetStatefulOrderCount(ctx, orderId.SubaccountId, count)
 
@@ -275,6 +292,46 @@ func (k Keeper) MustTriggerConditionalOrder(
 	untriggeredConditionalOrderMemStore.Delete(orderKey)
 }
 
+// MustSetTrailingStopTriggerSubticks sets the current trigger subticks of an untriggered trailing stop
+// order. The trailing stop order must already exist in untriggered state, or else this function will panic.
+func (k Keeper) MustSetTrailingStopTriggerSubticks(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	triggerSubticks types.Subticks,
+) {
+	// If this is not a conditional order, panic.
+	orderId.MustBeConditionalOrder()
+
+	untriggeredConditionalOrderMemStore := k.GetUntriggeredConditionalOrderPlacementMemStore(ctx)
+	untriggeredConditionalOrderStore := k.GetUntriggeredConditionalOrderPlacementStore(ctx)
+
+	orderKey := orderId.ToStateKey()
+	bytes := untriggeredConditionalOrderMemStore.Get(orderKey)
+	if bytes == nil {
+		panic(
+			fmt.Sprintf(
+				"MustSetTrailingStopTriggerSubticks: conditional order Id does not exist in Untriggered state: %+v",
+				orderId,
+			),
+		)
+	}
+	var longTermOrderPlacement types.LongTermOrderPlacement
+	k.cdc.MustUnmarshal(bytes, &longTermOrderPlacement)
+	if !longTermOrderPlacement.Order.IsTrailingStopOrder() {
+		panic(
+			fmt.Sprintf(
+				"MustSetTrailingStopTriggerSubticks: conditional order is not a trailing stop order: %+v",
+				orderId,
+			),
+		)
+	}
+
+	longTermOrderPlacement.TrailingStopTriggerSubticks = triggerSubticks.ToUint64()
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(&longTermOrderPlacement)
+	untriggeredConditionalOrderStore.Set(orderKey, longTermOrderPlacementBytes)
+	untriggeredConditionalOrderMemStore.Set(orderKey, longTermOrderPlacementBytes)
+}
+
 // MustAddOrderToStatefulOrdersTimeSlice adds a new `OrderId` to an existing time slice, or creates a new time slice
 // containing the `OrderId` and writes it to state. It first sorts all order IDs before writing them
 // to state to avoid non-determinism issues.
@@ -321,7 +378,77 @@ func (k Keeper) MustRemoveStatefulOrder(
 		panic(fmt.Sprintf("MustRemoveStatefulOrder: order %v does not exist", orderId))
 	}
 
-	goodTilBlockTime := longTermOrderPlacement.Order.MustGetUnixGoodTilBlockTime()
+	k.mustRemoveOrderFromStatefulOrdersTimeSlice(
+		ctx,
+		longTermOrderPlacement.Order.MustGetUnixGoodTilBlockTime(),
+		orderId,
+	)
+
+	// Remove the order fill amount from state.
+	k.RemoveOrderFillAmount(ctx, orderId)
+
+	// Delete the Stateful order placement from state.
+	k.DeleteLongTermOrderPlacement(ctx, orderId)
+}
+
+// MustReplaceStatefulOrder replaces the stateful order in state that has the same `OrderId` as `order`.
+// If `order` only decreases the size of the existing order, the existing placement index is kept so the
+// order retains its priority. Otherwise the placement index is set to the next unused transaction index
+// for this block. If the `GoodTilBlockTime` of the order changed, the order is moved to the new time slice.
+// Note that the stateful order count and the order fill amount are not modified.
+// This function will panic if no stateful order with `order.OrderId` exists in state.
+func (k Keeper) MustReplaceStatefulOrder(
+	ctx sdk.Context,
+	order types.Order,
+	blockHeight uint32,
+) {
+	// If this is a Short-Term order, panic.
+	order.MustBeStatefulOrder()
+
+	existingPlacement, exists := k.GetLongTermOrderPlacement(ctx, order.OrderId)
+	if !exists {
+		panic(fmt.Sprintf("MustReplaceStatefulOrder: order %v does not exist", order.OrderId))
+	}
+
+	placementIndex := existingPlacement.PlacementIndex
+	if !existingPlacement.Order.IsSizeDecreaseReplacement(&order) {
+		placementIndex = types.TransactionOrdering{
+			BlockHeight:      blockHeight,
+			TransactionIndex: k.GetNextStatefulOrderTransactionIndex(ctx),
+		}
+	}
+
+	longTermOrderPlacementBytes := k.cdc.MustMarshal(
+		&types.LongTermOrderPlacement{
+			Order:          order,
+			PlacementIndex: placementIndex,
+		},
+	)
+
+	store, memStore := k.fetchStateStoresForOrder(ctx, order.OrderId)
+	orderKey := order.OrderId.ToStateKey()
+
+	// Overwrite the `LongTermOrderPlacement` in state and memstore.
+	store.Set(orderKey, longTermOrderPlacementBytes)
+	memStore.Set(orderKey, longTermOrderPlacementBytes)
+
+	// Move the order to the time slice of the new `GoodTilBlockTime` if it changed.
+	existingGoodTilBlockTime := existingPlacement.Order.MustGetUnixGoodTilBlockTime()
+	goodTilBlockTime := order.MustGetUnixGoodTilBlockTime()
+	if !existingGoodTilBlockTime.Equal(goodTilBlockTime) {
+		k.mustRemoveOrderFromStatefulOrdersTimeSlice(ctx, existingGoodTilBlockTime, order.OrderId)
+		k.MustAddOrderToStatefulOrdersTimeSlice(ctx, goodTilBlockTime, order.OrderId)
+	}
+}
+
+// mustRemoveOrderFromStatefulOrdersTimeSlice removes an order by `OrderId` from the time slice at
+// `goodTilBlockTime`. If the time slice is empty after removing the `OrderId`, then the time slice is
+// pruned from state. This function will panic if the `OrderId` is not in the time slice.
+func (k Keeper) mustRemoveOrderFromStatefulOrdersTimeSlice(
+	ctx sdk.Context,
+	goodTilBlockTime time.Time,
+	orderId types.OrderId,
+) {
 	longTermOrdersExpiringAtTime := k.GetStatefulOrdersTimeSlice(ctx, goodTilBlockTime)
 	updatedStatefulOrdersExpiringAtTime := make([]types.OrderId, 0, len(longTermOrdersExpiringAtTime))
 
@@ -336,7 +463,7 @@ func (k Keeper) MustRemoveStatefulOrder(
 	if len(longTermOrdersExpiringAtTime) != len(updatedStatefulOrdersExpiringAtTime)+1 {
 		panic(
 			fmt.Sprintf(
-				"MustRemoveStatefulOrder: order ID %v is not in state for time %v",
+				"mustRemoveOrderFromStatefulOrdersTimeSlice: order ID %v is not in state for time %v",
 				orderId,
 				goodTilBlockTime,
 			),
@@ -351,12 +478,6 @@ func (k Keeper) MustRemoveStatefulOrder(
 	} else {
 		k.setStatefulOrdersTimeSliceInState(ctx, goodTilBlockTime, updatedStatefulOrdersExpiringAtTime)
 	}
-
-	// Remove the order fill amount from state.
-	k.RemoveOrderFillAmount(ctx, orderId)
-
-	// Delete the Stateful order placement from state.
-	k.DeleteLongTermOrderPlacement(ctx, orderId)
 }
 
 // IsConditionalOrderTriggered checks if a given order ID is triggered or untriggered in state.
@@ -416,20 +537,41 @@ func (k Keeper) GetAllUntriggeredConditionalOrders(ctx sdk.Context) []types.Orde
 	return k.getStatefulOrders(k.getUntriggeredConditionalOrdersIterator(ctx))
 }
 
-// getStatefulOrders takes an iterator and iterates over all stateful order placements in state.
-// It returns a list of stateful order placements ordered by ascending time priority. Note this
-// function handles closing the iterator.
-func (k Keeper) getStatefulOrders(statefulOrderIterator dbm.Iterator) []types.Order {
-	defer statefulOrderIterator.Close()
+// GetAllStatefulOrdersForSubaccount iterates over the stateful order placements of `subaccountId` and
+// returns a list of orders, ordered by ascending time priority. This includes all Long-Term orders,
+// triggered and untriggered conditional orders, TWAP orders and TWAP suborders of the subaccount.
+func (k Keeper) GetAllStatefulOrdersForSubaccount(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) []types.Order {
+	// The state key of an order id starts with the encoded subaccount id, so the orders of the
+	// subaccount are the orders whose state key starts with the state key of an order id that
+	// only sets the subaccount id.
+	subaccountKeyPrefix := (&types.OrderId{SubaccountId: subaccountId}).ToStateKey()
+	return k.getStatefulOrders(
+		storetypes.KVStorePrefixIterator(k.GetLongTermOrderPlacementStore(ctx), subaccountKeyPrefix),
+		storetypes.KVStorePrefixIterator(k.GetTriggeredConditionalOrderPlacementStore(ctx), subaccountKeyPrefix),
+		storetypes.KVStorePrefixIterator(k.GetUntriggeredConditionalOrderPlacementStore(ctx), subaccountKeyPrefix),
+		storetypes.KVStorePrefixIterator(k.GetTwapOrderPlacementStore(ctx), subaccountKeyPrefix),
+		storetypes.KVStorePrefixIterator(k.GetTwapSuborderPlacementStore(ctx), subaccountKeyPrefix),
+	)
+}
 
+// getStatefulOrders takes iterators and iterates over all stateful order placements in state.
+// It returns a list of stateful order placements ordered by ascending time priority. Note this
+// function handles closing the iterators.
+func (k Keeper) getStatefulOrders(statefulOrderIterators ...dbm.Iterator) []types.Order {
 	statefulOrderPlacements := make([]types.LongTermOrderPlacement, 0)
 
 	// Get all stateful order placements from state in any order.
-	for ; statefulOrderIterator.Valid(); statefulOrderIterator.Next() {
-		statefulOrderPlacement := types.LongTermOrderPlacement{}
-		value := statefulOrderIterator.Value()
-		k.cdc.MustUnmarshal(value, &statefulOrderPlacement)
-		statefulOrderPlacements = append(statefulOrderPlacements, statefulOrderPlacement)
+	for _, statefulOrderIterator := range statefulOrderIterators {
+		for ; statefulOrderIterator.Valid(); statefulOrderIterator.Next() {
+			statefulOrderPlacement := types.LongTermOrderPlacement{}
+			value := statefulOrderIterator.Value()
+			k.cdc.MustUnmarshal(value, &statefulOrderPlacement)
+			statefulOrderPlacements = append(statefulOrderPlacements, statefulOrderPlacement)
+		}
+		statefulOrderIterator.Close()
 	}
 
 	// Sort all stateful order placements in ascending time priority and return the orders.
@@ -250,6 +250,74 @@ func TestMustTriggerConditionalOrder(t *testing.T) {
 	)
 }
 
+func TestMustSetTrailingStopTriggerSubticks(t *testing.T) {
+	// Setup keeper state and test parameters.
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	trailingStopOrder := constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, trailingStopOrder, 0)
+
+	// Verify the order placement is updated in both the untriggered state store and memstore.
+	ks.ClobKeeper.MustSetTrailingStopTriggerSubticks(ks.Ctx, trailingStopOrder.OrderId, 25)
+	for _, store := range []prefix.Store{
+		ks.ClobKeeper.GetUntriggeredConditionalOrderPlacementStore(ks.Ctx),
+		ks.ClobKeeper.GetUntriggeredConditionalOrderPlacementMemStore(ks.Ctx),
+	} {
+		var longTermOrderPlacement types.LongTermOrderPlacement
+		ks.Cdc.MustUnmarshal(store.Get(trailingStopOrder.OrderId.ToStateKey()), &longTermOrderPlacement)
+		require.Equal(t, trailingStopOrder, longTermOrderPlacement.Order)
+		require.Equal(t, uint64(25), longTermOrderPlacement.TrailingStopTriggerSubticks)
+	}
+	require.Equal(
+		t,
+		uint32(1),
+		ks.ClobKeeper.GetStatefulOrderCount(ks.Ctx, trailingStopOrder.OrderId.SubaccountId),
+	)
+
+	// Verify the untriggered conditional orders are initialized with the updated trigger on restart.
+	ks.ClobKeeper.AddUntriggeredConditionalOrders(
+		ks.Ctx,
+		[]types.OrderId{trailingStopOrder.OrderId},
+		map[types.OrderId]struct{}{},
+		map[types.OrderId]struct{}{},
+	)
+	expectedOrder := trailingStopOrder
+	expectedOrder.ConditionalOrderTriggerSubticks = 25
+	require.Equal(
+		t,
+		[]types.Order{expectedOrder},
+		ks.ClobKeeper.UntriggeredConditionalOrders[0].OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+	)
+
+	// Verify setting the trigger of an order that is not a trailing stop order panics.
+	stopLossOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, stopLossOrder, 0)
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustSetTrailingStopTriggerSubticks: conditional order is not a trailing stop order: %+v",
+			stopLossOrder.OrderId,
+		),
+		func() {
+			ks.ClobKeeper.MustSetTrailingStopTriggerSubticks(ks.Ctx, stopLossOrder.OrderId, 25)
+		},
+	)
+
+	// Verify setting the trigger of an order that is not in untriggered state panics.
+	ks.ClobKeeper.MustTriggerConditionalOrder(ks.Ctx, trailingStopOrder.OrderId)
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustSetTrailingStopTriggerSubticks: conditional order Id does not exist in Untriggered state: %+v",
+			trailingStopOrder.OrderId,
+		),
+		func() {
+			ks.ClobKeeper.MustSetTrailingStopTriggerSubticks(ks.Ctx, trailingStopOrder.OrderId, 30)
+		},
+	)
+}
+
 func TestGetSetDeleteLongTermOrderState(t *testing.T) {
 	// Setup keeper state and test parameters.
 	memClob := memclob.NewMemClobPriceTimePriority(false)
@@ -1698,3 +1766,178 @@ func TestGetAllStatefulOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAllStatefulOrdersForSubaccount(t *testing.T) {
+	// Setup keeper state and test parameters.
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	statefulOrderPlacements := []types.LongTermOrderPlacement{
+		{
+			Order: constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 4,
+			},
+		},
+		{
+			Order: constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 8,
+			},
+		},
+		{
+			Order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 2,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTB15,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 8,
+			},
+		},
+		{
+			Order: constants.ConditionalOrder_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT10,
+			PlacementIndex: types.TransactionOrdering{
+				BlockHeight: 8,
+			},
+		},
+	}
+	for _, statefulOrderPlacement := range statefulOrderPlacements {
+		ks.ClobKeeper.SetLongTermOrderPlacement(
+			ks.Ctx,
+			statefulOrderPlacement.Order,
+			statefulOrderPlacement.PlacementIndex.BlockHeight,
+		)
+	}
+	ks.ClobKeeper.MustTriggerConditionalOrder(
+		ks.Ctx.WithBlockHeight(6),
+		constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20.OrderId,
+	)
+
+	// Verify the orders of each subaccount are returned in ascending time priority.
+	require.Equal(
+		t,
+		[]types.Order{
+			constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+			constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25,
+		},
+		ks.ClobKeeper.GetAllStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.Order{
+			constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTB15,
+		},
+		ks.ClobKeeper.GetAllStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num1),
+	)
+	require.Empty(t, ks.ClobKeeper.GetAllStatefulOrdersForSubaccount(ks.Ctx, constants.Bob_Num0))
+}
+
+func TestMustReplaceStatefulOrder(t *testing.T) {
+	tests := map[string]struct {
+		existingOrder    types.Order
+		replacementOrder types.Order
+
+		expectedPlacementIndex types.TransactionOrdering
+		expectedTimeSlices     map[uint32][]types.OrderId
+	}{
+		"Size decrease retains placement index": {
+			existingOrder:    constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedPlacementIndex: types.TransactionOrdering{
+				BlockHeight:      7,
+				TransactionIndex: 0,
+			},
+			expectedTimeSlices: map[uint32][]types.OrderId{
+				15: {constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId},
+			},
+		},
+		"Size increase receives new placement index": {
+			existingOrder:    constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+
+			expectedPlacementIndex: types.TransactionOrdering{
+				BlockHeight:      9,
+				TransactionIndex: 1,
+			},
+			expectedTimeSlices: map[uint32][]types.OrderId{
+				15: {constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId},
+			},
+		},
+		"Good til block time change moves the order between time slices": {
+			existingOrder:    constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+
+			expectedPlacementIndex: types.TransactionOrdering{
+				BlockHeight:      9,
+				TransactionIndex: 1,
+			},
+			expectedTimeSlices: map[uint32][]types.OrderId{
+				15: {},
+				20: {constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, tc.existingOrder, 7)
+			ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(
+				ks.Ctx,
+				tc.existingOrder.MustGetUnixGoodTilBlockTime(),
+				tc.existingOrder.OrderId,
+			)
+
+			ks.ClobKeeper.MustReplaceStatefulOrder(ks.Ctx, tc.replacementOrder, 9)
+
+			placement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, tc.replacementOrder.OrderId)
+			require.True(t, found)
+			require.Equal(
+				t,
+				types.LongTermOrderPlacement{
+					Order:          tc.replacementOrder,
+					PlacementIndex: tc.expectedPlacementIndex,
+				},
+				placement,
+			)
+
+			// The stateful order count is unchanged by a replacement.
+			require.Equal(t, uint32(1), ks.ClobKeeper.GetStatefulOrderCount(ks.Ctx, constants.Alice_Num0))
+
+			for goodTilBlockTime, expectedOrderIds := range tc.expectedTimeSlices {
+				require.ElementsMatch(
+					t,
+					expectedOrderIds,
+					ks.ClobKeeper.GetStatefulOrdersTimeSlice(ks.Ctx, time.Unix(int64(goodTilBlockTime), 0).UTC()),
+				)
+			}
+		})
+	}
+}
+
+func TestMustReplaceStatefulOrder_PanicsIfNotFound(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustReplaceStatefulOrder: order %v does not exist",
+			constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+		),
+		func() {
+			ks.ClobKeeper.MustReplaceStatefulOrder(
+				ks.Ctx,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				9,
+			)
+		},
+	)
+}
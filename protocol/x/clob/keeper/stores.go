@@ -48,6 +48,42 @@ func (k Keeper) GetUntriggeredConditionalOrderPlacementMemStore(ctx sdk.Context)
 	)
 }
 
+// GetTwapOrderPlacementStore fetches a state store used for creating,
+// reading, updating, and deleting a TWAP order placement from state.
+func (k Keeper) GetTwapOrderPlacementStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.TwapOrderPlacementKeyPrefix),
+	)
+}
+
+// GetTwapOrderPlacementMemStore fetches a state store used for creating,
+// reading, updating, and deleting a TWAP order placement from state.
+func (k Keeper) GetTwapOrderPlacementMemStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.memKey),
+		[]byte(types.TwapOrderPlacementKeyPrefix),
+	)
+}
+
+// GetTwapSuborderPlacementStore fetches a state store used for creating,
+// reading, updating, and deleting a TWAP suborder placement from state.
+func (k Keeper) GetTwapSuborderPlacementStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.TwapSuborderPlacementKeyPrefix),
+	)
+}
+
+// GetTwapSuborderPlacementMemStore fetches a state store used for creating,
+// reading, updating, and deleting a TWAP suborder placement from state.
+func (k Keeper) GetTwapSuborderPlacementMemStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.memKey),
+		[]byte(types.TwapSuborderPlacementKeyPrefix),
+	)
+}
+
 // GetUncommittedStatefulOrderPlacementTransientStore fetches a state store used for creating,
 // reading, updating, and deleting a stateful order placement from transient state.
 func (k Keeper) GetUncommittedStatefulOrderPlacementTransientStore(ctx sdk.Context) prefix.Store {
@@ -124,7 +160,7 @@ func (k Keeper) getTransientStore(ctx sdk.Context) storetypes.KVStore {
 // for a long term order, the long term order placement store will be returned. If it is conditional, the
 // IsConditionalOrderTriggered function will be used to determine which conditional order placement
 // state store is returned.
-// Currently, this function supports conditional orders and long term orders.
+// Currently, this function supports conditional orders, long term orders, TWAP orders and TWAP suborders.
 // If the given order id is conditional, it will return the Untriggered conditional order state store.
 func (k Keeper) fetchStateStoresForOrder(
 	ctx sdk.Context,
@@ -144,6 +180,10 @@ func (k Keeper) fetchStateStoresForOrder(
 		return store, memstore
 	} else if orderId.IsLongTermOrder() {
 		return k.GetLongTermOrderPlacementStore(ctx), k.GetLongTermOrderPlacementMemStore(ctx)
+	} else if orderId.IsTwapOrder() {
+		return k.GetTwapOrderPlacementStore(ctx), k.GetTwapOrderPlacementMemStore(ctx)
+	} else if orderId.IsTwapSuborder() {
+		return k.GetTwapSuborderPlacementStore(ctx), k.GetTwapSuborderPlacementMemStore(ctx)
 	}
 	panic(
 		fmt.Sprintf(
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetSubaccountRisk returns the collateral and margin requirements of a subaccount at the current
+// oracle prices, and its liquidation risk at the current risk prices. It uses the same functions
+// that are used to determine whether a subaccount is liquidatable and at which price its positions
+// are liquidated.
+func (k Keeper) GetSubaccountRisk(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	risk types.SubaccountRisk,
+	err error,
+) {
+	bigNetCollateral,
+		bigInitialMargin,
+		bigMaintenanceMargin,
+		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return risk, err
+	}
+
+	// Liquidation is determined at the risk prices, which may be mark prices instead of oracle prices.
+	// See `IsLiquidatable`.
+	bigRiskNetCollateral,
+		_,
+		bigRiskMaintenanceMargin,
+		err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirementsAtRiskPrices(
+		ctx,
+		satypes.Update{SubaccountId: subaccountId},
+	)
+	if err != nil {
+		return risk, err
+	}
+
+	risk = types.SubaccountRisk{
+		SubaccountId:      subaccountId,
+		NetCollateral:     dtypes.NewIntFromBigInt(bigNetCollateral),
+		InitialMargin:     dtypes.NewIntFromBigInt(bigInitialMargin),
+		MaintenanceMargin: dtypes.NewIntFromBigInt(bigMaintenanceMargin),
+		FreeCollateral:    dtypes.NewIntFromBigInt(new(big.Int).Sub(bigNetCollateral, bigInitialMargin)),
+		IsLiquidatable:    CanLiquidateSubaccount(bigRiskNetCollateral, bigRiskMaintenanceMargin),
+	}
+
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	for _, position := range subaccount.PerpetualPositions {
+		positionRisk, err := k.getPerpetualPositionRisk(
+			ctx,
+			subaccountId,
+			position.PerpetualId,
+			position.GetBigQuantums(),
+			bigMaintenanceMargin,
+			bigRiskNetCollateral,
+			bigRiskMaintenanceMargin,
+		)
+		if err != nil {
+			return risk, err
+		}
+		risk.PerpetualPositions = append(risk.PerpetualPositions, positionRisk)
+	}
+
+	return risk, nil
+}
+
+// getPerpetualPositionRisk returns the oracle price, risk price, bankruptcy price and estimated
+// liquidation price of a perpetual position of a subaccount with the given total maintenance margin
+// requirement, and total net collateral and maintenance margin requirement at the risk prices.
+func (k Keeper) getPerpetualPositionRisk(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	bigQuantums *big.Int,
+	bigMaintenanceMargin *big.Int,
+	bigRiskNetCollateral *big.Int,
+	bigRiskMaintenanceMargin *big.Int,
+) (
+	positionRisk types.PerpetualPositionRisk,
+	err error,
+) {
+	clobPairId, err := k.GetClobPairIdForPerpetual(ctx, perpetualId)
+	if err != nil {
+		return positionRisk, err
+	}
+	clobPair := k.mustGetClobPair(ctx, clobPairId)
+	isLong := bigQuantums.Sign() > 0
+
+	positionRisk = types.PerpetualPositionRisk{
+		PerpetualId: perpetualId,
+		ClobPairId:  clobPairId.ToUint32(),
+		Quantums:    dtypes.NewIntFromBigInt(bigQuantums),
+		OraclePriceSubticks: lib.BigRatRound(
+			k.GetOraclePriceSubticksRat(ctx, clobPair),
+			false,
+		).Uint64(),
+		// The risk price of a perpetual is the price which triggers its conditional orders.
+		RiskPriceSubticks: lib.BigRatRound(
+			k.GetTriggerPriceSubticksRat(ctx, clobPair),
+			false,
+		).Uint64(),
+	}
+
+	// Prices are computed in quote quantums per base quantum and converted to subticks the same way
+	// fillable prices of liquidation orders are.
+	toSubticks := func(price *big.Rat) uint64 {
+		if price.Sign() <= 0 {
+			return 0
+		}
+		return k.ConvertFillablePriceToSubticks(ctx, price, isLong, clobPair).ToUint64()
+	}
+
+	// The bankruptcy price is only defined if the subaccount has a maintenance margin requirement.
+	if bigMaintenanceMargin.Sign() > 0 {
+		bankruptcyQuoteQuantums, err := k.GetBankruptcyPriceInQuoteQuantums(
+			ctx,
+			subaccountId,
+			perpetualId,
+			new(big.Int).Neg(bigQuantums),
+		)
+		if err != nil {
+			return positionRisk, err
+		}
+		positionRisk.BankruptcyPriceSubticks = toSubticks(
+			new(big.Rat).SetFrac(bankruptcyQuoteQuantums, bigQuantums),
+		)
+	}
+
+	// The subaccount becomes liquidatable when `TNC = TMMR` at the risk prices. Assuming only the risk
+	// price of this perpetual changes by a factor of `x`, and that the maintenance margin requirement of
+	// the position scales linearly with its notional, this happens when
+	// `TNC - PNNV + x * PNNV = TMMR - PMMR + x * PMMR`, or `x = (TMMR - TNC + PNNV - PMMR) / (PNNV - PMMR)`.
+	bigPositionNetNotional, err := k.perpetualsKeeper.GetNetCollateralAtRiskPrice(ctx, perpetualId, bigQuantums)
+	if err != nil {
+		return positionRisk, err
+	}
+	_, bigPositionMaintenanceMargin, err := k.perpetualsKeeper.GetMarginRequirementsAtRiskPrice(
+		ctx,
+		perpetualId,
+		bigQuantums,
+	)
+	if err != nil {
+		return positionRisk, err
+	}
+	denominator := new(big.Int).Sub(bigPositionNetNotional, bigPositionMaintenanceMargin)
+	if denominator.Sign() != 0 {
+		numerator := new(big.Int).Sub(bigRiskMaintenanceMargin, bigRiskNetCollateral)
+		numerator.Add(numerator, denominator)
+		priceFactor := new(big.Rat).SetFrac(numerator, denominator)
+		riskPrice := new(big.Rat).SetFrac(bigPositionNetNotional, bigQuantums)
+		positionRisk.EstimatedLiquidationPriceSubticks = toSubticks(
+			new(big.Rat).Mul(priceFactor, riskPrice),
+		)
+	}
+
+	return positionRisk, nil
+}
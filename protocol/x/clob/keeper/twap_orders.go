@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// getTwapOrderStateStore fetches a state store used for creating, reading, updating, and deleting
+// the execution progress of TWAP orders from state.
+func (k Keeper) getTwapOrderStateStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.TwapOrderStateKeyPrefix),
+	)
+}
+
+// getTwapOrdersTimeSliceStore fetches a state store used for creating, reading, updating, and deleting
+// a TWAP order time slice from state.
+func (k Keeper) getTwapOrdersTimeSliceStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.TwapOrdersTimeSlicePrefix),
+	)
+}
+
+// GetTwapOrderState gets the execution progress of a TWAP order from state.
+// Returns false if no TWAP order exists in state with `orderId`.
+func (k Keeper) GetTwapOrderState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (val types.TwapOrderState, found bool) {
+	store := k.getTwapOrderStateStore(ctx)
+
+	b := store.Get(orderId.ToStateKey())
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// setTwapOrderState writes the execution progress of a TWAP order to state and adds the TWAP order
+// to the time slice of its next suborder.
+func (k Keeper) setTwapOrderState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	twapOrderState types.TwapOrderState,
+) {
+	store := k.getTwapOrderStateStore(ctx)
+	store.Set(orderId.ToStateKey(), k.cdc.MustMarshal(&twapOrderState))
+
+	nextSuborderTime := time.Unix(int64(twapOrderState.NextSuborderTime), 0)
+	orderIds := k.GetTwapOrdersTimeSlice(ctx, nextSuborderTime)
+	k.setTwapOrdersTimeSlice(ctx, nextSuborderTime, append(orderIds, orderId))
+}
+
+// deleteTwapOrderState deletes the execution progress of a TWAP order from state and removes the
+// TWAP order from the time slice of its next suborder. This function is a no-op if no TWAP order
+// exists in state with `orderId`.
+func (k Keeper) deleteTwapOrderState(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) {
+	twapOrderState, found := k.GetTwapOrderState(ctx, orderId)
+	if !found {
+		return
+	}
+
+	store := k.getTwapOrderStateStore(ctx)
+	store.Delete(orderId.ToStateKey())
+
+	// Note that the time slice may have already been removed from state if the TWAP order is being
+	// completed while generating suborders.
+	nextSuborderTime := time.Unix(int64(twapOrderState.NextSuborderTime), 0)
+	orderIds := k.GetTwapOrdersTimeSlice(ctx, nextSuborderTime)
+	updatedOrderIds := make([]types.OrderId, 0, len(orderIds))
+	for _, twapOrderId := range orderIds {
+		if twapOrderId != orderId {
+			updatedOrderIds = append(updatedOrderIds, twapOrderId)
+		}
+	}
+	k.setTwapOrdersTimeSlice(ctx, nextSuborderTime, updatedOrderIds)
+}
+
+// GetTwapOrdersTimeSlice gets a slice of TWAP order IDs that generate their next suborder at
+// `suborderTime`, sorted by order ID.
+func (k Keeper) GetTwapOrdersTimeSlice(ctx sdk.Context, suborderTime time.Time) (
+	orderIds []types.OrderId,
+) {
+	store := k.getTwapOrdersTimeSliceStore(ctx)
+	b := store.Get(sdk.FormatTimeBytes(suborderTime))
+	if b == nil {
+		return []types.OrderId{}
+	}
+
+	var twapOrdersTimeSlice types.StatefulOrderTimeSliceValue
+	k.cdc.MustUnmarshal(b, &twapOrdersTimeSlice)
+	return twapOrdersTimeSlice.OrderIds
+}
+
+// setTwapOrdersTimeSlice sets a sorted list of TWAP order IDs in state at `suborderTime`. The time
+// slice is deleted from state if `orderIds` is empty.
+func (k Keeper) setTwapOrdersTimeSlice(
+	ctx sdk.Context,
+	suborderTime time.Time,
+	orderIds []types.OrderId,
+) {
+	store := k.getTwapOrdersTimeSliceStore(ctx)
+	key := sdk.FormatTimeBytes(suborderTime)
+
+	if len(orderIds) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	types.MustSortAndHaveNoDuplicates(orderIds)
+	store.Set(key, k.cdc.MustMarshal(&types.StatefulOrderTimeSliceValue{OrderIds: orderIds}))
+}
+
+// removeDueTwapOrdersTimeSlices iterates all TWAP order time slices from time 0 until `blockTime`
+// (inclusive) and removes the time slices from state. It returns all TWAP order IDs that were removed.
+func (k Keeper) removeDueTwapOrdersTimeSlices(ctx sdk.Context, blockTime time.Time) (
+	dueOrderIds []types.OrderId,
+) {
+	store := k.getTwapOrdersTimeSliceStore(ctx)
+	iterator := store.Iterator(
+		nil,
+		storetypes.InclusiveEndBytes(sdk.FormatTimeBytes(blockTime)),
+	)
+	defer iterator.Close()
+
+	dueOrderIds = make([]types.OrderId, 0)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var twapOrdersTimeSlice types.StatefulOrderTimeSliceValue
+		k.cdc.MustUnmarshal(iterator.Value(), &twapOrdersTimeSlice)
+		dueOrderIds = append(dueOrderIds, twapOrdersTimeSlice.OrderIds...)
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return dueOrderIds
+}
+
+// InitializeTwapOrderState writes the initial execution progress of a newly-placed TWAP order to state.
+// The first suborder of the TWAP order is generated in the `EndBlocker` of the current block.
+func (k Keeper) InitializeTwapOrderState(ctx sdk.Context, order types.Order) {
+	if !order.IsTwapOrder() {
+		panic(fmt.Sprintf("InitializeTwapOrderState: called with non-TWAP order (%+v)", order))
+	}
+
+	k.setTwapOrderState(
+		ctx,
+		order.OrderId,
+		types.TwapOrderState{
+			RemainingLegs:    order.TwapParameters.GetNumLegs(),
+			NextSuborderTime: lib.MustConvertIntegerToUint32(ctx.BlockTime().Unix()),
+		},
+	)
+}
+
+// GenerateTwapSuborders generates the next suborder of every TWAP order whose next suborder is due at
+// the current block time. Each suborder is an immediate-or-cancel order for an equal share of the
+// remaining size of the TWAP order, rounded down to the step size of the `ClobPair`, at the subticks
+// of the TWAP order. The last suborder is for the entire remaining size. Suborders are written to state
+// and expire once the next suborder is due, so at most one suborder of a TWAP order exists at a time.
+// TWAP orders that are fully filled or have no remaining suborders are removed from state.
+// Returns the ids of the generated suborders, which should be placed on the memclob in `PrepareCheckState`.
+func (k Keeper) GenerateTwapSuborders(ctx sdk.Context) (placedSuborderIds []types.OrderId) {
+	blockTime := ctx.BlockTime()
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+
+	placedSuborderIds = make([]types.OrderId, 0)
+	for _, orderId := range k.removeDueTwapOrdersTimeSlices(ctx, blockTime) {
+		orderPlacement, found := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !found {
+			log.ErrorLog(ctx, fmt.Sprintf("GenerateTwapSuborders: TWAP order %+v does not exist in state", orderId))
+			continue
+		}
+		twapOrderState, found := k.GetTwapOrderState(ctx, orderId)
+		if !found {
+			log.ErrorLog(ctx, fmt.Sprintf("GenerateTwapSuborders: TWAP order %+v has no state", orderId))
+			continue
+		}
+
+		order := orderPlacement.Order
+		_, fillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
+		remainingQuantums := order.GetBaseQuantums() - fillAmount
+		if remainingQuantums == 0 || twapOrderState.RemainingLegs == 0 {
+			k.completeTwapOrder(ctx, order, remainingQuantums == 0)
+			continue
+		}
+
+		clobPair, found := k.GetClobPair(ctx, order.GetClobPairId())
+		if !found {
+			panic(fmt.Sprintf("GenerateTwapSuborders: ClobPair %d does not exist", order.GetClobPairId()))
+		}
+
+		suborderQuantums := remainingQuantums
+		if twapOrderState.RemainingLegs > 1 {
+			stepBaseQuantums := satypes.BaseQuantums(clobPair.StepBaseQuantums)
+			suborderQuantums = remainingQuantums / satypes.BaseQuantums(twapOrderState.RemainingLegs)
+			suborderQuantums = lib.Max(suborderQuantums-suborderQuantums%stepBaseQuantums, stepBaseQuantums)
+		}
+
+		nextSuborderTime := lib.MustConvertIntegerToUint32(
+			blockTime.Add(time.Duration(order.TwapParameters.Interval) * time.Second).Unix(),
+		)
+		suborder := types.Order{
+			OrderId:                 order.OrderId.GetTwapSuborderId(),
+			Side:                    order.Side,
+			Quantums:                suborderQuantums.ToUint64(),
+			Subticks:                order.Subticks,
+			GoodTilOneof:            &types.Order_GoodTilBlockTime{GoodTilBlockTime: nextSuborderTime},
+			TimeInForce:             types.Order_TIME_IN_FORCE_IOC,
+			ClientMetadata:          order.ClientMetadata,
+			SelfTradePreventionMode: order.SelfTradePreventionMode,
+		}
+		k.SetLongTermOrderPlacement(ctx, suborder, blockHeight)
+		k.MustAddOrderToStatefulOrdersTimeSlice(ctx, suborder.MustGetUnixGoodTilBlockTime(), suborder.OrderId)
+		placedSuborderIds = append(placedSuborderIds, suborder.OrderId)
+
+		twapOrderState.RemainingLegs--
+		twapOrderState.NextSuborderTime = nextSuborderTime
+		k.setTwapOrderState(ctx, orderId, twapOrderState)
+
+		metrics.IncrCountMetricWithLabels(
+			types.ModuleName,
+			metrics.ClobTwapSuborderPlaced,
+			orderId.GetOrderIdLabels()...,
+		)
+	}
+
+	return placedSuborderIds
+}
+
+// completeTwapOrder removes a TWAP order that has no remaining suborders or is fully filled from state
+// and emits an on-chain indexer event for the removal.
+func (k Keeper) completeTwapOrder(ctx sdk.Context, order types.Order, fullyFilled bool) {
+	k.MustRemoveStatefulOrder(ctx, order.OrderId)
+
+	removalReason := indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED
+	if fullyFilled {
+		removalReason = indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_FULLY_FILLED
+	}
+	k.GetIndexerEventManager().AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				order.OrderId,
+				removalReason,
+			),
+		),
+	)
+
+	metrics.IncrCountMetricWithLabels(
+		types.ModuleName,
+		metrics.ClobTwapOrderCompleted,
+		append(
+			order.OrderId.GetOrderIdLabels(),
+			metrics.GetLabelForBoolValue(metrics.FullyFilled, fullyFilled),
+		)...,
+	)
+}
+
+// addTwapSuborderFillAmount adds the fill amount of a match of a TWAP suborder to the aggregate fill
+// amount of the TWAP order that generated it. This is a no-op if the TWAP order is no longer in state.
+func (k Keeper) addTwapSuborderFillAmount(
+	ctx sdk.Context,
+	suborderId types.OrderId,
+	fillAmount satypes.BaseQuantums,
+) {
+	orderId := suborderId.GetTwapParentOrderId()
+	if _, found := k.GetLongTermOrderPlacement(ctx, orderId); !found {
+		return
+	}
+
+	_, curFillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
+	// Note that stateful orders are never pruned by `BlockHeight`, so we set the value to `math.MaxUint32` here.
+	k.SetOrderFillAmount(ctx, orderId, curFillAmount+fillAmount, math.MaxUint32)
+}
+
+// CancelTwapSuborder removes the suborder of a canceled TWAP order from state, if it exists, and emits
+// an on-chain indexer event for the removal. Returns the id of the removed suborder, which should be
+// removed from the memclob in `PrepareCheckState`.
+func (k Keeper) CancelTwapSuborder(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) (canceledOrderIds []types.OrderId) {
+	canceledOrderIds = make([]types.OrderId, 0)
+	if !orderId.IsTwapOrder() {
+		return canceledOrderIds
+	}
+
+	suborderId := orderId.GetTwapSuborderId()
+	if _, found := k.GetLongTermOrderPlacement(ctx, suborderId); !found {
+		return canceledOrderIds
+	}
+
+	k.MustRemoveStatefulOrder(ctx, suborderId)
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				suborderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+			),
+		),
+	)
+
+	return append(canceledOrderIds, suborderId)
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTwapSuborders(t *testing.T) {
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
+	suborderId := twapOrder.OrderId.GetTwapSuborderId()
+
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	keepertest.CreateNClobPair(
+		t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		1,
+		indexerEventManager,
+	)
+
+	// Place the TWAP order at time 100.
+	ctx := ks.Ctx.WithBlockTime(time.Unix(100, 0))
+	ks.ClobKeeper.SetLongTermOrderPlacement(ctx, twapOrder, 0)
+	ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ctx, twapOrder.MustGetUnixGoodTilBlockTime(), twapOrder.OrderId)
+	ks.ClobKeeper.InitializeTwapOrderState(ctx, twapOrder)
+
+	twapOrderState, found := ks.ClobKeeper.GetTwapOrderState(ctx, twapOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, types.TwapOrderState{RemainingLegs: 5, NextSuborderTime: 100}, twapOrderState)
+	require.Equal(t, []types.OrderId{twapOrder.OrderId}, ks.ClobKeeper.GetTwapOrdersTimeSlice(ctx, time.Unix(100, 0)))
+
+	// The first suborder is for an equal share of the TWAP order and expires when the next suborder is due.
+	placedSuborderIds := ks.ClobKeeper.GenerateTwapSuborders(ctx)
+	require.Equal(t, []types.OrderId{suborderId}, placedSuborderIds)
+
+	suborderPlacement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.Order{
+			OrderId:      suborderId,
+			Side:         types.Order_SIDE_BUY,
+			Quantums:     20_000_000,
+			Subticks:     50_000_000_000,
+			GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 160},
+			TimeInForce:  types.Order_TIME_IN_FORCE_IOC,
+		},
+		suborderPlacement.Order,
+	)
+	require.Equal(
+		t,
+		[]types.OrderId{suborderId},
+		ks.ClobKeeper.GetStatefulOrdersTimeSlice(ctx, time.Unix(160, 0)),
+	)
+
+	twapOrderState, found = ks.ClobKeeper.GetTwapOrderState(ctx, twapOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, types.TwapOrderState{RemainingLegs: 4, NextSuborderTime: 160}, twapOrderState)
+	require.Empty(t, ks.ClobKeeper.GetTwapOrdersTimeSlice(ctx, time.Unix(100, 0)))
+	require.Equal(t, []types.OrderId{twapOrder.OrderId}, ks.ClobKeeper.GetTwapOrdersTimeSlice(ctx, time.Unix(160, 0)))
+
+	// No suborders are generated before the next suborder is due.
+	require.Empty(t, ks.ClobKeeper.GenerateTwapSuborders(ctx.WithBlockTime(time.Unix(159, 0))))
+
+	// The first suborder is partially filled and expires, and the next suborder is for an equal share of the
+	// remaining size of the TWAP order.
+	ctx = ctx.WithBlockTime(time.Unix(160, 0))
+	ks.ClobKeeper.SetOrderFillAmount(ctx, twapOrder.OrderId, 30_000_000, math.MaxUint32)
+	ks.ClobKeeper.MustRemoveStatefulOrder(ctx, suborderId)
+
+	placedSuborderIds = ks.ClobKeeper.GenerateTwapSuborders(ctx)
+	require.Equal(t, []types.OrderId{suborderId}, placedSuborderIds)
+
+	suborderPlacement, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.True(t, found)
+	require.Equal(t, uint64(17_500_000), suborderPlacement.Order.Quantums)
+	require.Equal(t, uint32(220), suborderPlacement.Order.GetGoodTilBlockTime())
+
+	twapOrderState, found = ks.ClobKeeper.GetTwapOrderState(ctx, twapOrder.OrderId)
+	require.True(t, found)
+	require.Equal(t, types.TwapOrderState{RemainingLegs: 3, NextSuborderTime: 220}, twapOrderState)
+
+	// The TWAP order is fully filled and is removed from state once its next suborder is due.
+	ctx = ctx.WithBlockTime(time.Unix(220, 0))
+	ks.ClobKeeper.SetOrderFillAmount(ctx, twapOrder.OrderId, 100_000_000, math.MaxUint32)
+	ks.ClobKeeper.MustRemoveStatefulOrder(ctx, suborderId)
+
+	indexerEventManager.On(
+		"AddBlockEvent",
+		mock.Anything,
+		indexerevents.SubtypeStatefulOrder,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				twapOrder.OrderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_FULLY_FILLED,
+			),
+		),
+	).Once().Return()
+
+	require.Empty(t, ks.ClobKeeper.GenerateTwapSuborders(ctx))
+
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, twapOrder.OrderId)
+	require.False(t, found)
+	_, found = ks.ClobKeeper.GetTwapOrderState(ctx, twapOrder.OrderId)
+	require.False(t, found)
+	require.Empty(t, ks.ClobKeeper.GetTwapOrdersTimeSlice(ctx, time.Unix(220, 0)))
+	require.Equal(t, uint32(0), ks.ClobKeeper.GetStatefulOrderCount(ctx, constants.Alice_Num0))
+	indexerEventManager.AssertExpectations(t)
+}
+
+func TestGenerateTwapSuborders_LastSuborder(t *testing.T) {
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
+	twapOrder.TwapParameters = &types.TwapParameters{Duration: 120, Interval: 60}
+	suborderId := twapOrder.OrderId.GetTwapSuborderId()
+
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	keepertest.CreateNClobPair(
+		t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		1,
+		indexerEventManager,
+	)
+
+	ctx := ks.Ctx.WithBlockTime(time.Unix(100, 0))
+	ks.ClobKeeper.SetLongTermOrderPlacement(ctx, twapOrder, 0)
+	ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ctx, twapOrder.MustGetUnixGoodTilBlockTime(), twapOrder.OrderId)
+	ks.ClobKeeper.InitializeTwapOrderState(ctx, twapOrder)
+
+	// The first suborder is rounded down to the step size of the ClobPair.
+	ks.ClobKeeper.SetOrderFillAmount(ctx, twapOrder.OrderId, 3, math.MaxUint32)
+	require.Equal(t, []types.OrderId{suborderId}, ks.ClobKeeper.GenerateTwapSuborders(ctx))
+	suborderPlacement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.True(t, found)
+	require.Equal(t, uint64(49_999_995), suborderPlacement.Order.Quantums)
+
+	// The last suborder is for the entire remaining size of the TWAP order.
+	ctx = ctx.WithBlockTime(time.Unix(160, 0))
+	ks.ClobKeeper.MustRemoveStatefulOrder(ctx, suborderId)
+	require.Equal(t, []types.OrderId{suborderId}, ks.ClobKeeper.GenerateTwapSuborders(ctx))
+	suborderPlacement, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.True(t, found)
+	require.Equal(t, uint64(99_999_997), suborderPlacement.Order.Quantums)
+
+	// The TWAP order expires once it has no remaining suborders.
+	ctx = ctx.WithBlockTime(time.Unix(220, 0))
+	ks.ClobKeeper.MustRemoveStatefulOrder(ctx, suborderId)
+
+	indexerEventManager.On(
+		"AddBlockEvent",
+		mock.Anything,
+		indexerevents.SubtypeStatefulOrder,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				twapOrder.OrderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_EXPIRED,
+			),
+		),
+	).Once().Return()
+
+	require.Empty(t, ks.ClobKeeper.GenerateTwapSuborders(ctx))
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, twapOrder.OrderId)
+	require.False(t, found)
+	indexerEventManager.AssertExpectations(t)
+}
+
+func TestCancelTwapSuborder(t *testing.T) {
+	twapOrder := constants.TwapOrder_Alice_Num0_Id0_Clob0_Buy1BTC_Price50000_GTBT600_Duration300_Interval60
+	suborderId := twapOrder.OrderId.GetTwapSuborderId()
+
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	keepertest.CreateNClobPair(
+		t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		1,
+		indexerEventManager,
+	)
+
+	ctx := ks.Ctx.WithBlockTime(time.Unix(100, 0))
+	ks.ClobKeeper.SetLongTermOrderPlacement(ctx, twapOrder, 0)
+	ks.ClobKeeper.MustAddOrderToStatefulOrdersTimeSlice(ctx, twapOrder.MustGetUnixGoodTilBlockTime(), twapOrder.OrderId)
+	ks.ClobKeeper.InitializeTwapOrderState(ctx, twapOrder)
+
+	// Nothing is canceled for orders that are not TWAP orders or TWAP orders without a suborder.
+	require.Empty(
+		t,
+		ks.ClobKeeper.CancelTwapSuborder(ctx, constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId),
+	)
+	require.Empty(t, ks.ClobKeeper.CancelTwapSuborder(ctx, twapOrder.OrderId))
+
+	require.Equal(t, []types.OrderId{suborderId}, ks.ClobKeeper.GenerateTwapSuborders(ctx))
+
+	indexerEventManager.On(
+		"AddTxnEvent",
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				suborderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_USER_CANCELED,
+			),
+		),
+	).Once().Return()
+
+	require.Equal(t, []types.OrderId{suborderId}, ks.ClobKeeper.CancelTwapSuborder(ctx, twapOrder.OrderId))
+	_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, suborderId)
+	require.False(t, found)
+	require.Empty(t, ks.ClobKeeper.GetStatefulOrdersTimeSlice(ctx, time.Unix(160, 0)))
+
+	// Removing the TWAP order removes its execution progress from state.
+	ks.ClobKeeper.MustRemoveStatefulOrder(ctx, twapOrder.OrderId)
+	_, found = ks.ClobKeeper.GetTwapOrderState(ctx, twapOrder.OrderId)
+	require.False(t, found)
+	require.Empty(t, ks.ClobKeeper.GetTwapOrdersTimeSlice(ctx, time.Unix(160, 0)))
+	indexerEventManager.AssertExpectations(t)
+}
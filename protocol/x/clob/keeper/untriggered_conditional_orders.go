@@ -85,7 +85,7 @@ func (k Keeper) AddUntriggeredConditionalOrders(
 			untriggeredConditionalOrders = k.NewUntriggeredConditionalOrders()
 			k.UntriggeredConditionalOrders[clobPairId] = untriggeredConditionalOrders
 		}
-		untriggeredConditionalOrders.AddUntriggeredConditionalOrder(orderPlacement.GetOrder())
+		untriggeredConditionalOrders.AddUntriggeredConditionalOrder(orderPlacement.GetUntriggeredOrder())
 	}
 }
 
@@ -108,7 +108,7 @@ func (untriggeredOrders *UntriggeredConditionalOrders) AddUntriggeredConditional
 		}
 	}
 
-	if order.IsStopLossOrder() {
+	if order.IsStopLossOrder() || order.IsTrailingStopOrder() {
 		if order.IsBuy() {
 			untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = append(
 				untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
@@ -219,6 +219,33 @@ func (untriggeredOrders *UntriggeredConditionalOrders) RemoveUntriggeredConditio
 	untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice = newOrdersToTriggerWhenOraclePriceGTETriggerPrice
 }
 
+// UpdateTrailingStopTriggers moves the trigger price of all untriggered trailing stop orders to trail
+// the given oracle price. The trigger price of a trailing stop order only moves in the direction
+// that makes the order harder to trigger. It returns the trailing stop orders whose trigger price moved,
+// with their new trigger price set as `ConditionalOrderTriggerSubticks`. This is only called in EndBlocker.
+func (untriggeredOrders *UntriggeredConditionalOrders) UpdateTrailingStopTriggers(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick types.SubticksPerTick,
+) (updatedOrders []types.Order) {
+	updatedOrders = make([]types.Order, 0)
+	for _, orders := range [][]types.Order{
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+		untriggeredOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	} {
+		for i := range orders {
+			if !orders[i].IsTrailingStopOrder() {
+				continue
+			}
+			triggerSubticks, moved := orders[i].GetTrailingStopTriggerSubticks(oraclePriceSubticksRat, subticksPerTick)
+			if moved {
+				orders[i].ConditionalOrderTriggerSubticks = triggerSubticks.ToUint64()
+				updatedOrders = append(updatedOrders, orders[i])
+			}
+		}
+	}
+	return updatedOrders
+}
+
 // PollTriggeredConditionalOrders removes all triggered conditional orders from the
 // `UntriggeredConditionalOrders` struct given a new oracle price for a clobPairId. It returns
 // a list of order ids that were triggered. This is only called in EndBlocker. We round up to the nearest
@@ -300,9 +327,19 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (allTriggeredOrde
 			continue
 		}
 
-		// Trigger conditional orders using the oracle price.
+		// Conditional orders are not supported on spot CLOB pairs.
+		if clobPair.IsSpotClobPair() {
+			continue
+		}
+
 		perpetualId := clobPair.MustGetPerpetualId()
-		oraclePrice := k.GetOraclePriceSubticksRat(ctx, clobPair)
+		// The oracle price is replaced by the mark price for perpetuals which enable it.
+		oraclePrice := k.GetTriggerPriceSubticksRat(ctx, clobPair)
+
+		// Move the trigger prices of trailing stop orders with the oracle price before triggering.
+		k.UpdateTrailingStopTriggers(ctx, untriggered, oraclePrice, clobPair.SubticksPerTick)
+
+		// Trigger conditional orders using the oracle price.
 		triggered := k.TriggerOrdersWithPrice(ctx, untriggered, oraclePrice, perpetualId, metrics.OraclePrice)
 		allTriggeredOrderIds = append(allTriggeredOrderIds, triggered...)
 
@@ -340,6 +377,33 @@ func (k Keeper) MaybeTriggerConditionalOrders(ctx sdk.Context) (allTriggeredOrde
 	return allTriggeredOrderIds
 }
 
+// UpdateTrailingStopTriggers moves the trigger prices of all untriggered trailing stop orders in
+// `untriggered` to trail the given oracle price. For each trailing stop order whose trigger price moved,
+// the new trigger price is written to state and an event is emitted. This function is called in EndBlocker.
+func (k Keeper) UpdateTrailingStopTriggers(
+	ctx sdk.Context,
+	untriggered *UntriggeredConditionalOrders,
+	oraclePrice *big.Rat,
+	subticksPerTick uint32,
+) {
+	updatedOrders := untriggered.UpdateTrailingStopTriggers(oraclePrice, types.SubticksPerTick(subticksPerTick))
+	for _, order := range updatedOrders {
+		triggerSubticks := types.Subticks(order.ConditionalOrderTriggerSubticks)
+		k.MustSetTrailingStopTriggerSubticks(ctx, order.OrderId, triggerSubticks)
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewConditionalOrderTriggerUpdatedEvent(
+					order.OrderId,
+					triggerSubticks,
+				),
+			),
+		)
+	}
+}
+
 // TriggerOrdersWithPrice triggers all untriggered conditional orders using the given price. It returns
 // a list of order ids that were triggered. This function is called in EndBlocker.
 // It removes all triggered conditional orders from the `UntriggeredConditionalOrders ` struct.
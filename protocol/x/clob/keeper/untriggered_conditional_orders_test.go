@@ -437,3 +437,96 @@ func TestPollTriggeredConditionalOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTrailingStopTriggers(t *testing.T) {
+	withTrigger := func(order types.Order, triggerSubticks uint64) types.Order {
+		order.ConditionalOrderTriggerSubticks = triggerSubticks
+		return order
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		conditionalOrdersToAdd []types.Order
+		currentSubticks        *big.Rat
+
+		// Expectations.
+		expectedUpdatedOrders                                 []types.Order
+		expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice []types.Order
+		expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice []types.Order
+	}{
+		"Oracle price moves up, sell trigger moves up": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price20_GTBT15_StopLoss20,
+				constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell25_Price10_GTBT15_StopLoss10,
+				constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5,
+				constants.ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent,
+			},
+			currentSubticks: big.NewRat(30, 1),
+			expectedUpdatedOrders: []types.Order{
+				withTrigger(constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id3_Clob0_Sell25_Price10_GTBT15_StopLoss10,
+				withTrigger(constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5, 25),
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price20_GTBT15_StopLoss20,
+				constants.ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent,
+			},
+		},
+		"Oracle price moves down, buy trigger moves down": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5,
+				constants.ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent,
+			},
+			currentSubticks: big.NewRat(20, 1),
+			expectedUpdatedOrders: []types.Order{
+				withTrigger(constants.ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent, 22),
+			},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				withTrigger(constants.ConditionalOrder_Alice_Num0_Id5_Clob0_Buy5_Price30_GTBT15_TrailingStop30_Offset10Percent, 22),
+			},
+		},
+		"Oracle price unchanged relative to triggers, no triggers move": {
+			conditionalOrdersToAdd: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price20_GTBT15_StopLoss20,
+				constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5,
+			},
+			currentSubticks:       big.NewRat(25, 1),
+			expectedUpdatedOrders: []types.Order{},
+			expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id4_Clob0_Sell5_Price10_GTBT15_TrailingStop20_Offset5,
+			},
+			expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price20_GTBT15_StopLoss20,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			untriggeredConditionalOrders := keeper.NewUntriggeredConditionalOrders()
+
+			for _, order := range tc.conditionalOrdersToAdd {
+				untriggeredConditionalOrders.AddUntriggeredConditionalOrder(order)
+			}
+
+			updatedOrders := untriggeredConditionalOrders.UpdateTrailingStopTriggers(tc.currentSubticks, 1)
+
+			require.Equal(t, tc.expectedUpdatedOrders, updatedOrders)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceLTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceLTETriggerPrice,
+			)
+			require.Equal(
+				t,
+				tc.expectedOrdersToTriggerWhenOraclePriceGTETriggerPrice,
+				untriggeredConditionalOrders.OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+			)
+		})
+	}
+}
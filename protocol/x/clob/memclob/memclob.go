@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"runtime/debug"
+	"slices"
 	"time"
 
 	cmtlog "github.com/cometbft/cometbft/libs/log"
@@ -52,9 +53,14 @@ type MemClobPriceTimePriority struct {
 	generateOrderbookUpdates bool
 }
 
+// OrderWithRemovalReason is a maker order that should be removed from the orderbook after matching, along with
+// the reason for its removal. Maker orders of a self trade also reference the taker order of the self trade, and
+// are only decremented instead of removed if `DecrementQuantums` is non-zero.
 type OrderWithRemovalReason struct {
-	Order         types.Order
-	RemovalReason types.OrderRemoval_RemovalReason
+	Order                 types.Order
+	RemovalReason         types.OrderRemoval_RemovalReason
+	SelfTradeTakerOrderId *types.OrderId
+	DecrementQuantums     satypes.BaseQuantums
 }
 
 func NewMemClobPriceTimePriority(
@@ -365,8 +371,10 @@ func (m *MemClobPriceTimePriority) mustUpdateMemclobStateWithMatches(
 		}
 	}
 
-	// If the taker order is not a liquidation, add the taker order placement to the operations queue.
-	if !takerOrder.IsLiquidation() {
+	// If the taker order is not a liquidation, add the taker order placement to the operations queue. The
+	// placement of a Short-Term taker order may already have been added for a self trade during matching.
+	if !takerOrder.IsLiquidation() &&
+		!m.operationsToPropose.IsOrderPlacementInOperationsQueue(takerOrder.MustGetOrder()) {
 		taker := takerOrder.MustGetOrder()
 
 		// Add the taker order placement to the operations queue.
@@ -375,10 +383,7 @@ func (m *MemClobPriceTimePriority) mustUpdateMemclobStateWithMatches(
 				taker,
 			)
 		} else {
-			m.operationsToPropose.MustAddShortTermOrderTxBytes(
-				taker,
-				ctx.TxBytes(),
-			)
+			m.mustAddShortTermOrderTxBytes(ctx, taker)
 			m.operationsToPropose.MustAddShortTermOrderPlacementToOperationsQueue(
 				taker,
 			)
@@ -433,6 +438,7 @@ func (m *MemClobPriceTimePriority) GetOperationsRaw(ctx sdk.Context) (
 func (m *MemClobPriceTimePriority) GetOperationsToReplay(ctx sdk.Context) (
 	[]types.InternalOperation,
 	map[types.OrderHash][]byte,
+	map[types.OrderHash]uint32,
 ) {
 	return m.operationsToPropose.GetOperationsToReplay()
 }
@@ -457,9 +463,37 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	orderStatus types.OrderStatus,
 	offchainUpdates *types.OffchainUpdates,
 	err error,
+) {
+	return m.placeOrder(ctx, order, false)
+}
+
+// placeOrder places the order as described in `PlaceOrder`. If `isReplacement` is true, the order replaces a
+// stateful order which was removed from the orderbook by `ReplaceStatefulOrder`. In that case a replace message
+// is sent to the Indexer and a single replacement update is sent to grpc streams instead of a placement, and a
+// removal is sent to grpc streams if the replacement order does not rest on the orderbook.
+func (m *MemClobPriceTimePriority) placeOrder(
+	ctx sdk.Context,
+	order types.Order,
+	isReplacement bool,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
 ) {
 	lib.AssertCheckTxMode(ctx)
 
+	// The replaced order was removed from the orderbook without an update to grpc streams. Send a removal
+	// if the replacement order was not added to the orderbook.
+	if isReplacement && m.generateOrderbookUpdates {
+		defer func() {
+			if _, found := m.openOrders.getOrder(ctx, order.OrderId); !found {
+				orderbookUpdate := m.GetOrderbookUpdatesForOrderRemoval(ctx, order.OrderId)
+				m.clobKeeper.SendOrderbookUpdates(ctx, orderbookUpdate, false)
+			}
+		}()
+	}
+
 	// Perform invariant checks that the orderbook is not crossed after `PlaceOrder` finishes execution.
 	defer func() {
 		orderbook := m.openOrders.mustGetOrderbook(ctx, order.GetClobPairId())
@@ -494,7 +528,7 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	if m.generateOffchainUpdates {
 		// If this is a replacement order, then ensure we send the appropriate replacement message.
 		orderId := order.OrderId
-		if _, found := m.openOrders.getOrder(ctx, orderId); found {
+		if _, found := m.openOrders.getOrder(ctx, orderId); found || isReplacement {
 			if message, success := off_chain_updates.CreateOrderReplaceMessage(
 				ctx,
 				order,
@@ -511,6 +545,12 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 		}
 	}
 
+	if isReplacement && m.generateOrderbookUpdates {
+		// Send a single orderbook update for the replacement to grpc streams before any fills of the order.
+		orderbookUpdate := m.GetOrderbookUpdatesForOrderReplacement(ctx, order)
+		m.clobKeeper.SendOrderbookUpdates(ctx, orderbookUpdate, false)
+	}
+
 	// Attempt to match the order against the orderbook.
 	takerOrderStatus, takerOffchainUpdates, _, err := m.matchOrder(ctx, &order)
 	offchainUpdates.Append(takerOffchainUpdates)
@@ -588,6 +628,27 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 				)
 			}
 		}
+		// If stateful taker order was canceled since it crosses maker orders priced outside of the price band,
+		// add Order Removal to operations queue to forcefully remove the order from state.
+		if takerOrderStatus.OrderStatus == types.OutsidePriceBand && order.IsStatefulOrder() {
+			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+					order.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+				)
+			}
+		}
+		// If stateful taker order was canceled due to self-trade prevention while matching, add Order Removal
+		// to operations queue to forcefully remove the order from state.
+		if takerOrderStatus.OrderStatus.IsSelfTradePrevention() && order.IsStatefulOrder() {
+			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+				m.operationsToPropose.MustAddSelfTradeOrderRemovalToOperationsQueue(
+					order.OrderId,
+					order.GetSelfTradePreventionRemovalReason(),
+					order.OrderId,
+				)
+			}
+		}
 		return orderSizeOptimisticallyFilledFromMatchingQuantums, takerOrderStatus.OrderStatus, offchainUpdates, nil
 	}
 
@@ -626,13 +687,23 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 			}
 		}
 
-		// long-term orders cannot use IOC, so we know this stateful order
-		// is conditional. Remove the conditional order.
+		// Long-term orders cannot use IOC, so a stateful IOC order is either a conditional order or
+		// a TWAP suborder. Remove the stateful order with the removal reason matching its order flags.
 		if order.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
-			m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
-				order.OrderId,
-				types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK,
-			)
+			var removalReason types.OrderRemoval_RemovalReason
+			switch {
+			case order.OrderId.IsConditionalOrder():
+				// Conditional IOC orders have their remaining size removed in the block after they are
+				// triggered.
+				removalReason = types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK
+			case order.OrderId.IsTwapSuborder():
+				// TWAP suborders are IOC orders. The unfilled size of the suborder is placed in the next
+				// suborders of its TWAP order.
+				removalReason = types.OrderRemoval_REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK
+			default:
+				panic(fmt.Sprintf("placeOrder: stateful IOC order %+v is neither conditional nor a TWAP suborder", order))
+			}
+			m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(order.OrderId, removalReason)
 		}
 		return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, nil
 	}
@@ -675,14 +746,16 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	// operations to propose.
 	if order.IsShortTermOrder() &&
 		!m.operationsToPropose.IsOrderPlacementInOperationsQueue(order) {
-		m.operationsToPropose.MustAddShortTermOrderTxBytes(
-			order,
-			ctx.TxBytes(),
-		)
+		m.mustAddShortTermOrderTxBytes(ctx, order)
 	}
 
-	// Add the order to the orderbook and all other bookkeeping data structures.
-	m.mustAddOrderToOrderbook(ctx, order, false)
+	// Add the order to the orderbook and all other bookkeeping data structures. Replacement orders were already
+	// sent to grpc streams before matching.
+	if isReplacement {
+		m.openOrders.mustAddOrderToOrderbook(ctx, order, false)
+	} else {
+		m.mustAddOrderToOrderbook(ctx, order, false)
+	}
 
 	// If the taker order is added to the orderbook successfully, send an off-chain message with
 	// the total filled size of the order (size of order - remaining size).
@@ -706,6 +779,73 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, types.Success, offchainUpdates, nil
 }
 
+// ReplaceStatefulOrder replaces a stateful order on the orderbook with `order`, which has the same `OrderId`
+// as the existing order. If the replacement order only decreases the size of the resting order and has at least
+// `MinOrderBaseQuantums` of remaining size, the resting order is amended in place and keeps its queue priority.
+// Otherwise the resting order (if any) is removed from the orderbook and `order` is placed as in `PlaceOrder`,
+// which may match the order against the orderbook.
+// In both cases a single replacement update is sent to the Indexer and grpc streams instead of a removal and
+// a placement.
+// This function assumes the replacement has already been validated and written to state.
+func (m *MemClobPriceTimePriority) ReplaceStatefulOrder(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	orderSizeOptimisticallyFilledFromMatchingQuantums satypes.BaseQuantums,
+	orderStatus types.OrderStatus,
+	offchainUpdates *types.OffchainUpdates,
+	err error,
+) {
+	lib.AssertCheckTxMode(ctx)
+	order.MustBeStatefulOrder()
+
+	levelOrder, exists := m.openOrders.orderIdToLevelOrder[order.OrderId]
+	if exists {
+		existingOrder := levelOrder.Value.Order
+
+		// The order on the book is already up to date, which happens when the order was placed and replaced
+		// in the same block.
+		if existingOrder.GetOrderHash() == order.GetOrderHash() {
+			return 0, types.Success, types.NewOffchainUpdates(), nil
+		}
+
+		orderbook := m.openOrders.mustGetOrderbook(ctx, order.GetClobPairId())
+		remainingAmount, hasRemainingAmount := m.GetOrderRemainingAmount(ctx, order)
+		if existingOrder.IsSizeDecreaseReplacement(&order) &&
+			hasRemainingAmount &&
+			remainingAmount >= orderbook.MinOrderBaseQuantums {
+			// Amend the resting order in place to keep its position in the level.
+			levelOrder.Value.Order = order
+
+			offchainUpdates = types.NewOffchainUpdates()
+			if m.generateOffchainUpdates {
+				offchainUpdates = m.GetOrderbookUpdatesForOrderReplacement(ctx, order)
+			}
+
+			if m.generateOrderbookUpdates {
+				// Send a single orderbook update for the replacement to grpc streams.
+				orderbookUpdate := m.GetOrderbookUpdatesForOrderReplacement(ctx, order)
+				m.clobKeeper.SendOrderbookUpdates(ctx, orderbookUpdate, false)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, metrics.ReplaceOrder, metrics.Memclob, metrics.Success},
+				1,
+				order.GetOrderLabels(),
+			)
+
+			return 0, types.Success, offchainUpdates, nil
+		}
+
+		// The replacement loses the queue priority of the resting order. Remove the resting order from the
+		// orderbook without sending a removal to grpc streams, since placing the replacement order sends a
+		// single replacement update for the order.
+		m.openOrders.mustRemoveOrder(ctx, levelOrder)
+	}
+
+	return m.placeOrder(ctx, order, exists)
+}
+
 // PlacePerpetualLiquidation matches an IOC liquidation order against the orderbook. Specifically,
 // it will perform the following operations:
 //   - If the liquidation order overlaps the orderbook, it will match orders within that orderbook
@@ -809,20 +949,42 @@ func (m *MemClobPriceTimePriority) matchOrder(
 		}
 	}
 
+	// Maker orders of a self trade which are larger than the taker order are decremented after matching.
+	var makerOrdersToDecrement []OrderWithRemovalReason
+
 	// For each maker order that should be removed, remove it from the orderbook and emit off-chain
 	// updates for the indexer.
 	for _, makerOrderWithRemovalReason := range makerOrdersToRemove {
 		// TODO(DEC-847): Update logic to properly remove long-term orders.
 		makerOrderId := makerOrderWithRemovalReason.Order.OrderId
+
+		// Order removals of stateful maker orders due to a self trade reference the taker order, which
+		// must be placed earlier in the operations queue so the removal can be validated.
+		if takerOrderId := makerOrderWithRemovalReason.SelfTradeTakerOrderId; takerOrderId != nil &&
+			makerOrderId.IsStatefulOrder() {
+			m.mustAddShortTermTakerOrderPlacementToOperationsQueue(branchedContext, order.MustGetOrder())
+		}
+
+		// Maker orders of a self trade which are larger than the taker order are decremented instead of removed.
+		if makerOrderWithRemovalReason.DecrementQuantums > 0 {
+			makerOrdersToDecrement = append(makerOrdersToDecrement, makerOrderWithRemovalReason)
+			continue
+		}
+
+		// Orders removed due to being replaced by the taker order do not have a removal reason.
+		reason := indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED
+		if makerOrderWithRemovalReason.RemovalReason != types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED {
+			reason = indexershared.ConvertOrderRemovalReasonToIndexerOrderRemovalReason(
+				makerOrderWithRemovalReason.RemovalReason,
+			)
+		}
+
 		// TODO(CLOB-669): Move logic outside of `memclob.go` by returning a slice of removed orders.
 		// If the order is a replacement order, a message was already added above the place message.
 		if m.generateOffchainUpdates && (order.IsLiquidation() || makerOrderId != order.MustGetOrder().OrderId) {
-			// If the taker order and the removed maker order are from the same subaccount, set
-			// the reason to SELF_TRADE error, otherwise set the reason to be UNDERCOLLATERALIZED.
+			// The removal reason of the maker order depends on why it was removed during matching, i.e.
+			// UNDERCOLLATERALIZED or one of the self-trade prevention reasons.
 			// TODO(DEC-1409): Update this to support order replacements on indexer.
-			reason := indexershared.ConvertOrderRemovalReasonToIndexerOrderRemovalReason(
-				makerOrderWithRemovalReason.RemovalReason,
-			)
 			if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 				branchedContext,
 				makerOrderId,
@@ -833,12 +995,20 @@ func (m *MemClobPriceTimePriority) matchOrder(
 			}
 		}
 
-		m.mustRemoveOrder(branchedContext, makerOrderId)
+		m.mustRemoveOrderWithReason(branchedContext, makerOrderId, reason)
 		if makerOrderId.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(makerOrderId) {
-			m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
-				makerOrderId,
-				makerOrderWithRemovalReason.RemovalReason,
-			)
+			if takerOrderId := makerOrderWithRemovalReason.SelfTradeTakerOrderId; takerOrderId != nil {
+				m.operationsToPropose.MustAddSelfTradeOrderRemovalToOperationsQueue(
+					makerOrderId,
+					makerOrderWithRemovalReason.RemovalReason,
+					*takerOrderId,
+				)
+			} else {
+				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+					makerOrderId,
+					makerOrderWithRemovalReason.RemovalReason,
+				)
+			}
 		}
 	}
 
@@ -888,6 +1058,13 @@ func (m *MemClobPriceTimePriority) matchOrder(
 		)
 		offchainUpdates.Append(matchOffchainUpdates)
 		writeCache()
+
+		// Remove the resting orders of maker subaccounts whose market-maker protection was tripped by the
+		// matches. Note that the order removals are added to the operations queue after the matches, so the
+		// trips can be validated when the removals are persisted to state.
+		offchainUpdates.Append(
+			m.mustRemoveOrdersOfTrippedMarketMakers(ctx, order.GetClobPairId(), newMakerFills),
+		)
 	} else {
 		// If state was not written to, re-send grpc stream updates for all orders
 		// involved in the match to "reset" fill amounts.
@@ -904,6 +1081,22 @@ func (m *MemClobPriceTimePriority) matchOrder(
 		m.clobKeeper.SendOrderbookUpdates(ctx, allUpdates, false)
 	}
 
+	// Decrement the maker orders of self trades once the matches of the taker order are in the operations queue,
+	// so the decrements can be validated against the remaining size of the taker order after its matches. If
+	// matching failed, the matches of the taker order are discarded and the maker orders are not decremented.
+	if matchingErr == nil {
+		for _, makerOrderToDecrement := range makerOrdersToDecrement {
+			offchainUpdates.Append(
+				m.mustDecrementSelfTradeMakerOrder(
+					ctx,
+					makerOrderToDecrement.Order,
+					*makerOrderToDecrement.SelfTradeTakerOrderId,
+					makerOrderToDecrement.DecrementQuantums,
+				),
+			)
+		}
+	}
+
 	return takerOrderStatus, offchainUpdates, makerOrdersToRemove, matchingErr
 }
 
@@ -919,6 +1112,7 @@ func (m *MemClobPriceTimePriority) ReplayOperations(
 	ctx sdk.Context,
 	localOperations []types.InternalOperation,
 	shortTermOrderTxBytes map[types.OrderHash][]byte,
+	shortTermOrderBatchIndexes map[types.OrderHash]uint32,
 	existingOffchainUpdates *types.OffchainUpdates,
 ) *types.OffchainUpdates {
 	lib.AssertCheckTxMode(ctx)
@@ -976,6 +1170,13 @@ func (m *MemClobPriceTimePriority) ReplayOperations(
 				)
 			}
 			ctx = ctx.WithTxBytes(shortTermOrderTxBytes)
+			// Set the index of the order within its `MsgBatchPlaceOrder`, if any, so the replayed order
+			// is proposed as part of the same batch transaction.
+			if orderIndex, isBatchOrder := shortTermOrderBatchIndexes[order.GetOrderHash()]; isBatchOrder {
+				ctx = types.WithBatchOrderIndex(ctx, orderIndex)
+			} else {
+				ctx = types.WithoutBatchOrderIndex(ctx)
+			}
 
 			// Note we use `clobKeeper.PlaceOrder` here to ensure the proper stateful validation is performed and
 			// newly-placed stateful orders are written to state. In the future this will be important for sequence number
@@ -1075,6 +1276,31 @@ func (m *MemClobPriceTimePriority) ReplayOperations(
 		case *types.InternalOperation_OrderRemoval:
 			orderId := operation.GetOrderRemoval().OrderId
 
+			// Maker orders decremented due to a self trade are not removed from the orderbook. Restore the order in
+			// state on the orderbook, which is the decremented order if the previous block proposer included the
+			// decrement and the original order otherwise.
+			if operation.GetOrderRemoval().IsSelfTradeDecrement() {
+				statefulOrderPlacement, found := m.clobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+				if _, onOrderbook := m.openOrders.orderIdToLevelOrder[orderId]; !found || !onOrderbook {
+					continue
+				}
+
+				_, orderStatus, replaceOrderOffchainUpdates, err := m.ReplaceStatefulOrder(
+					ctx,
+					statefulOrderPlacement.Order,
+				)
+				existingOffchainUpdates = m.GenerateOffchainUpdatesForReplayPlaceOrder(
+					ctx,
+					err,
+					operation,
+					statefulOrderPlacement.Order,
+					orderStatus,
+					replaceOrderOffchainUpdates,
+					existingOffchainUpdates,
+				)
+				continue
+			}
+
 			// Prevent double placement caused by PreexistingStatefulOrder and Order Removal
 			// both existing in local operations.
 			if _, found := placedPreexistingStatefulOrderIds[orderId]; found {
@@ -1552,6 +1778,59 @@ func (m *MemClobPriceTimePriority) mustAddOrderToOrderbook(
 	}
 }
 
+// mustRemoveOrdersOfTrippedMarketMakers removes all resting orders on the clob pair of the maker subaccounts
+// of `makerFills` whose market-maker protection is tripped on the clob pair. An order removal is added to the
+// operations queue for every removed stateful order.
+func (m *MemClobPriceTimePriority) mustRemoveOrdersOfTrippedMarketMakers(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	makerFills []types.MakerFill,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+	checkedSubaccountIds := make(map[satypes.SubaccountId]bool)
+	for _, makerFill := range makerFills {
+		subaccountId := makerFill.MakerOrderId.SubaccountId
+		if checkedSubaccountIds[subaccountId] {
+			continue
+		}
+		checkedSubaccountIds[subaccountId] = true
+
+		if !m.clobKeeper.IsMarketMakerProtectionTripped(ctx, subaccountId, clobPairId) {
+			continue
+		}
+
+		for _, side := range []types.Order_Side{types.Order_SIDE_BUY, types.Order_SIDE_SELL} {
+			orders, err := m.GetSubaccountOrders(ctx, clobPairId, subaccountId, side)
+			if err != nil {
+				panic(err)
+			}
+
+			for _, order := range orders {
+				reason := indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_MAKER_PROTECTION
+				if m.generateOffchainUpdates {
+					if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+						ctx,
+						order.OrderId,
+						reason,
+						ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+					); success {
+						offchainUpdates.AddRemoveMessage(order.OrderId, message)
+					}
+				}
+
+				m.mustRemoveOrderWithReason(ctx, order.OrderId, reason)
+				if order.IsStatefulOrder() && !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
+					m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
+						order.OrderId,
+						types.OrderRemoval_REMOVAL_REASON_MARKET_MAKER_PROTECTION,
+					)
+				}
+			}
+		}
+	}
+	return offchainUpdates
+}
+
 // mustPerformTakerOrderMatching performs matching using the provided taker order while the order
 // overlaps the other side of the orderbook. It returns multiple variables used for representing the result
 // of matching with the taker order, which are documented further below. Note that this function does not modify
@@ -1612,6 +1891,24 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 	}
 	takerRemainingSizeBeforeMatching := takerRemainingSize
 
+	// Self trades are handled according to the self-trade prevention mode of the taker order. Liquidation
+	// orders always use the default mode, which cancels the maker order.
+	takerSelfTradePreventionMode := types.Order_SELF_TRADE_PREVENTION_MODE_UNSPECIFIED
+	if !takerIsLiquidation {
+		takerSelfTradePreventionMode = newTakerOrder.MustGetOrder().SelfTradePreventionMode
+	}
+	// The total amount the taker order was decremented by due to self-trade prevention.
+	var takerSelfTradeDecrementedSize satypes.BaseQuantums
+	// Whether the taker order was decremented due to a self trade with a Short-Term maker order.
+	var takerDecrementedByShortTermMaker bool
+
+	// Non-liquidation taker orders only match maker orders priced within the price band of the clob pair.
+	// The price band is fetched once the first crossing maker order is found.
+	var priceBandFetched, priceBandEnabled bool
+	var priceBandLowerSubticks, priceBandUpperSubticks uint64
+	// Whether the taker order crosses a maker order priced beyond the price band on the far side of the orderbook.
+	var takerCrossesMakerOutsidePriceBand bool
+
 	// Initialize variables used for tracking matches made during this matching cycle.
 	var makerLevelOrder *types.LevelOrder
 	var takerOrderHash types.OrderHash
@@ -1666,17 +1963,86 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 			continue
 		}
 
+		// If the maker order is priced outside of the price band, it cannot be matched by a non-liquidation
+		// taker order. Maker orders priced beyond the price band on the near side of the orderbook are priced
+		// beyond the price band on their own aggressive side, so they are removed. Maker orders priced beyond
+		// the price band on the far side of the orderbook stop matching since all following maker orders are
+		// priced even further away, and the taker order crossing them is priced beyond the price band on its
+		// aggressive side.
+		if !takerIsLiquidation {
+			if !priceBandFetched {
+				priceBandLowerSubticks, priceBandUpperSubticks, priceBandEnabled = m.clobKeeper.GetPriceBandSubticks(
+					ctx,
+					clobPairId,
+				)
+				priceBandFetched = true
+			}
+			makerSubticks := makerOrder.Order.GetOrderSubticks().ToUint64()
+			if priceBandEnabled && (makerSubticks < priceBandLowerSubticks || makerSubticks > priceBandUpperSubticks) {
+				if takerIsBuy == (makerSubticks < priceBandLowerSubticks) {
+					makerOrdersToRemove = append(
+						makerOrdersToRemove,
+						OrderWithRemovalReason{
+							Order:         makerOrder.Order,
+							RemovalReason: types.OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND,
+						},
+					)
+					continue
+				}
+				takerCrossesMakerOutsidePriceBand = true
+				break
+			}
+		}
+
+		// If the maker subaccount tripped its market-maker protection on the clob pair, possibly by an earlier
+		// match of this taker order, the maker order cannot be filled. Skip it and continue matching. Note that
+		// the resting orders of the maker subaccount are removed once the matches of the taker order are
+		// committed.
+		if m.clobKeeper.IsMarketMakerProtectionTripped(ctx, makerSubaccountId, clobPairId) {
+			continue
+		}
+
 		// If the matched maker order does not have same order ID and is from the same subaccount
-		// as the taker order, then we cannot match the orders. Cancel the maker order and continue matching.
-		// TODO(DEC-1562): determine if we should handle self-trades differently.
+		// as the taker order, then we cannot match the orders. Handle the self trade according to the
+		// self-trade prevention mode of the taker order, which determines whether the maker order is
+		// canceled and whether the taker order is decremented or stops matching.
 		if makerSubaccountId == takerSubaccountId {
-			makerOrdersToRemove = append(
-				makerOrdersToRemove,
-				OrderWithRemovalReason{
-					Order:         makerOrder.Order,
-					RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
-				},
+			makerRemovalReason, makerDecrementSize, takerDecrementSize, selfTradeTakerOrderStatus := m.resolveSelfTrade(
+				ctx,
+				takerSelfTradePreventionMode,
+				takerRemainingSize,
+				takerDecrementedByShortTermMaker,
+				makerOrder.Order,
 			)
+			if makerRemovalReason != types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED {
+				// Liquidation orders have no order id, so the removal does not reference the taker order.
+				var selfTradeTakerOrderId *types.OrderId
+				if !takerIsLiquidation {
+					takerOrderId := newTakerOrder.MustGetOrder().OrderId
+					selfTradeTakerOrderId = &takerOrderId
+				}
+				makerOrdersToRemove = append(
+					makerOrdersToRemove,
+					OrderWithRemovalReason{
+						Order:                 makerOrder.Order,
+						RemovalReason:         makerRemovalReason,
+						SelfTradeTakerOrderId: selfTradeTakerOrderId,
+						DecrementQuantums:     makerDecrementSize,
+					},
+				)
+			}
+
+			takerRemainingSize -= takerDecrementSize
+			takerSelfTradeDecrementedSize += takerDecrementSize
+			if takerDecrementSize > 0 && makerOrder.Order.IsShortTermOrder() {
+				takerDecrementedByShortTermMaker = true
+			}
+
+			// If the remaining size of the taker order should be canceled, stop matching.
+			if !selfTradeTakerOrderStatus.IsSuccess() {
+				takerOrderStatus.OrderStatus = selfTradeTakerOrderStatus
+				break
+			}
 			continue
 		}
 
@@ -1876,9 +2242,24 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		}
 	}
 
-	// Update the remaining size of the taker order now that matching has ended.
+	// A taker order that was decremented due to self-trade prevention cannot be added to the orderbook with
+	// its decremented size, therefore any size remaining after matching is canceled.
+	if takerSelfTradeDecrementedSize > 0 && takerRemainingSize > 0 && takerOrderStatus.OrderStatus.IsSuccess() {
+		takerOrderStatus.OrderStatus = types.SelfTradeDecrementAndCancel
+	}
+
+	// A taker order that crosses maker orders priced outside of the price band cannot be added to the
+	// orderbook without crossing it, therefore any size remaining after matching is canceled.
+	if takerCrossesMakerOutsidePriceBand && takerRemainingSize > 0 && takerOrderStatus.OrderStatus.IsSuccess() {
+		takerOrderStatus.OrderStatus = types.OutsidePriceBand
+	}
+
+	// Update the remaining size of the taker order now that matching has ended. Note that size decremented
+	// due to self-trade prevention was not filled.
 	takerOrderStatus.RemainingQuantums = takerRemainingSize
-	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching - takerRemainingSize
+	takerOrderStatus.OrderOptimisticallyFilledQuantums = takerRemainingSizeBeforeMatching -
+		takerRemainingSize -
+		takerSelfTradeDecrementedSize
 
 	return newMakerFills,
 		matchedOrderHashToOrder,
@@ -1887,6 +2268,141 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		takerOrderStatus
 }
 
+// resolveSelfTrade determines how a self trade between the taker order and a resting maker order from the
+// same subaccount is handled, according to the self-trade prevention mode of the taker order. It returns:
+//   - The removal reason of the maker order if it should be removed or decremented, or
+//     `REMOVAL_REASON_UNSPECIFIED` if it should remain on the orderbook.
+//   - The size the maker order should be decremented by, or zero if it should be removed entirely.
+//   - The size the remaining size of the taker order should be decremented by.
+//   - The status of the taker order, which is not successful if the taker order should stop matching and
+//     have its remaining size canceled.
+//
+// `takerDecrementedByShortTermMaker` is true if the taker order was decremented due to a self trade with a
+// Short-Term maker order earlier during matching. Removals of Short-Term orders are not included in the
+// operations queue, so the decrement of a larger stateful maker order by the remaining size of the taker order
+// could not be validated. In that case the stateful maker order remains on the orderbook unchanged.
+func (m *MemClobPriceTimePriority) resolveSelfTrade(
+	ctx sdk.Context,
+	takerSelfTradePreventionMode types.Order_SelfTradePreventionMode,
+	takerRemainingSize satypes.BaseQuantums,
+	takerDecrementedByShortTermMaker bool,
+	makerOrder types.Order,
+) (
+	makerRemovalReason types.OrderRemoval_RemovalReason,
+	makerDecrementSize satypes.BaseQuantums,
+	takerDecrementSize satypes.BaseQuantums,
+	takerOrderStatus types.OrderStatus,
+) {
+	switch takerSelfTradePreventionMode {
+	case types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER:
+		return types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED, 0, 0, types.SelfTradeCancelTaker
+	case types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH:
+		return types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH, 0, 0, types.SelfTradeCancelBoth
+	case types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL:
+		makerRemainingSize, makerHasRemainingSize := m.GetOrderRemainingAmount(ctx, makerOrder)
+		if !makerHasRemainingSize {
+			panic(fmt.Sprintf("resolveSelfTrade: maker order has no remaining amount %v", makerOrder))
+		}
+
+		// The maker order is larger than the taker order. The maker order is decremented by the remaining size
+		// of the taker order, and the remaining size of the taker order is canceled.
+		if makerRemainingSize > takerRemainingSize {
+			if makerOrder.IsStatefulOrder() && takerDecrementedByShortTermMaker {
+				return types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED, 0, takerRemainingSize, types.SelfTradeDecrementAndCancel
+			}
+			// Short-Term orders are signed by the placer and cannot be resized, and removals of Short-Term orders
+			// are not included in the operations queue, so a decrement could not be proposed to other validators.
+			// The larger Short-Term maker order is canceled instead.
+			if makerOrder.IsShortTermOrder() {
+				return types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					0,
+					takerRemainingSize,
+					types.SelfTradeDecrementAndCancel
+			}
+			return types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+				takerRemainingSize,
+				takerRemainingSize,
+				types.SelfTradeDecrementAndCancel
+		}
+
+		// The maker order is canceled and the taker order is decremented by the remaining size of the maker
+		// order. If this leaves the taker order with no remaining size, it is canceled as well.
+		takerOrderStatus = types.Success
+		if makerRemainingSize == takerRemainingSize {
+			takerOrderStatus = types.SelfTradeDecrementAndCancel
+		}
+		return types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL, 0, makerRemainingSize, takerOrderStatus
+	default:
+		return types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE, 0, 0, types.Success
+	}
+}
+
+// mustAddShortTermTakerOrderPlacementToOperationsQueue adds the placement of a Short-Term taker order to the
+// operations queue if it's not already in the operations queue. Stateful taker orders are already in state, so
+// nothing is added for them.
+func (m *MemClobPriceTimePriority) mustAddShortTermTakerOrderPlacementToOperationsQueue(
+	ctx sdk.Context,
+	takerOrder types.Order,
+) {
+	if takerOrder.IsStatefulOrder() || m.operationsToPropose.IsOrderPlacementInOperationsQueue(takerOrder) {
+		return
+	}
+
+	m.mustAddShortTermOrderTxBytes(ctx, takerOrder)
+	m.operationsToPropose.MustAddShortTermOrderPlacementToOperationsQueue(takerOrder)
+}
+
+// mustDecrementSelfTradeMakerOrder decrements the size of a resting maker order of a self trade by
+// `decrementQuantums` while keeping its priority on the orderbook, and returns the off-chain updates for the
+// resized order. The order is resized and the decrement is added to the operations queue. Only stateful orders
+// can be decremented, since Short-Term maker orders are canceled instead.
+func (m *MemClobPriceTimePriority) mustDecrementSelfTradeMakerOrder(
+	ctx sdk.Context,
+	makerOrder types.Order,
+	takerOrderId types.OrderId,
+	decrementQuantums satypes.BaseQuantums,
+) (offchainUpdates *types.OffchainUpdates) {
+	levelOrder, exists := m.openOrders.orderIdToLevelOrder[makerOrder.OrderId]
+	if !exists {
+		panic(
+			fmt.Sprintf(
+				"mustDecrementSelfTradeMakerOrder: maker order %s does not exist on the orderbook",
+				makerOrder.GetOrderTextString(),
+			),
+		)
+	}
+
+	if !makerOrder.IsStatefulOrder() {
+		panic(
+			fmt.Sprintf(
+				"mustDecrementSelfTradeMakerOrder: maker order %s is not a stateful order",
+				makerOrder.GetOrderTextString(),
+			),
+		)
+	}
+
+	decrementedOrder := levelOrder.Value.Order
+	decrementedOrder.Quantums -= decrementQuantums.ToUint64()
+	levelOrder.Value.Order = decrementedOrder
+
+	m.operationsToPropose.MustAddSelfTradeOrderDecrementToOperationsQueue(
+		decrementedOrder.OrderId,
+		takerOrderId,
+		decrementQuantums.ToUint64(),
+	)
+
+	offchainUpdates = types.NewOffchainUpdates()
+	if m.generateOffchainUpdates {
+		offchainUpdates = m.GetOrderbookUpdatesForOrderReplacement(ctx, decrementedOrder)
+	}
+
+	if m.generateOrderbookUpdates {
+		orderbookUpdate := m.GetOrderbookUpdatesForOrderReplacement(ctx, decrementedOrder)
+		m.clobKeeper.SendOrderbookUpdates(ctx, orderbookUpdate, false)
+	}
+	return offchainUpdates
+}
+
 // SetMemclobGauges sets gauges for each orderbook and the operations queue based on current memclob state.
 // This is used only for observability purposes.
 func (m *MemClobPriceTimePriority) SetMemclobGauges(
@@ -1959,6 +2475,21 @@ func (m *MemClobPriceTimePriority) SetMemclobGauges(
 func (m *MemClobPriceTimePriority) mustRemoveOrder(
 	ctx sdk.Context,
 	orderId types.OrderId,
+) {
+	m.mustRemoveOrderWithReason(
+		ctx,
+		orderId,
+		indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED,
+	)
+}
+
+// mustRemoveOrderWithReason completely removes an order from all data structures for tracking
+// open orders in the memclob, and sends the provided removal reason to grpc streams.
+// If the order does not exist, this method will panic.
+func (m *MemClobPriceTimePriority) mustRemoveOrderWithReason(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	reason indexersharedtypes.OrderRemovalReason,
 ) {
 	defer telemetry.ModuleMeasureSince(
 		types.ModuleName,
@@ -1986,7 +2517,7 @@ func (m *MemClobPriceTimePriority) mustRemoveOrder(
 
 	if m.generateOrderbookUpdates {
 		// Send an orderbook update to grpc streams.
-		orderbookUpdate := m.GetOrderbookUpdatesForOrderRemoval(ctx, order.OrderId)
+		orderbookUpdate := m.getOrderbookUpdatesForOrderRemovalWithReason(ctx, order.OrderId, reason)
 		m.clobKeeper.SendOrderbookUpdates(ctx, orderbookUpdate, false)
 	}
 }
@@ -2198,6 +2729,97 @@ func (m *MemClobPriceTimePriority) GetMidPrice(
 	return midPrice, bestBid, bestAsk, exists
 }
 
+// GetOrderbookSnapshot returns the price levels of the orderbook for the given clob pair, aggregating the
+// remaining size of all orders at each price, and the mid price of the orderbook if it exists.
+func (m *MemClobPriceTimePriority) GetOrderbookSnapshot(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (snapshot types.OrderbookSnapshot) {
+	orderbook, exists := m.openOrders.orderbooksMap[clobPairId]
+	if !exists {
+		return snapshot
+	}
+
+	snapshot.Bids, snapshot.BidOrders = m.getOrderbookLevels(ctx, orderbook.Bids, true)
+	snapshot.Asks, snapshot.AskOrders = m.getOrderbookLevels(ctx, orderbook.Asks, false)
+	snapshot.MidPrice, snapshot.MidPriceExists = orderbook.GetMidPrice()
+	return snapshot
+}
+
+// CloneFromOrderbookSnapshot returns a new memclob containing only the orderbook of the given clob pair,
+// with the orders of the orderbook snapshot in the same matching priority. This allows matching orders
+// against the orderbook without modifying this memclob, and without reading it concurrently. The clob
+// keeper of the new memclob must be set with `SetClobKeeper`, and the new memclob does not generate
+// off-chain or orderbook updates. Note that the remaining size of the orders is read from state when
+// matching, and that the operations queue of the new memclob must not be proposed since Short-Term orders
+// of the orderbook snapshot do not have their TX bytes.
+func (m *MemClobPriceTimePriority) CloneFromOrderbookSnapshot(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	snapshot types.OrderbookSnapshot,
+) types.MemClob {
+	clone := NewMemClobPriceTimePriority(false)
+	clone.CreateOrderbook(ctx, clobPair)
+	for _, snapshotOrders := range [][]types.OrderbookSnapshotOrder{snapshot.BidOrders, snapshot.AskOrders} {
+		for _, snapshotOrder := range snapshotOrders {
+			order := snapshotOrder.Order
+			clone.openOrders.mustAddOrderToOrderbook(ctx, order, false)
+
+			// Matched Short-Term maker orders are added to the operations queue, which requires TX bytes.
+			// The encoded order placement stands in for the TX bytes.
+			if order.IsShortTermOrder() {
+				txBytes, err := types.NewMsgPlaceOrder(order).Marshal()
+				if err != nil {
+					panic(fmt.Sprintf("CloneFromOrderbookSnapshot: failed to encode order %+v: %v", order, err))
+				}
+				clone.operationsToPropose.MustAddShortTermOrderTxBytes(order, txBytes)
+			}
+		}
+	}
+	return clone
+}
+
+// getOrderbookLevels returns the price levels of one side of an orderbook, sorted from the best to the
+// worst price, aggregating the remaining size of all orders at each price. It also returns the orders of
+// the side with their remaining size, sorted by matching priority.
+func (m *MemClobPriceTimePriority) getOrderbookLevels(
+	ctx sdk.Context,
+	side map[types.Subticks]*types.Level,
+	isBuy bool,
+) (
+	levels []types.OrderbookLevel,
+	orders []types.OrderbookSnapshotOrder,
+) {
+	prices := lib.GetSortedKeys[lib.Sortable[types.Subticks]](side)
+	if isBuy {
+		slices.Reverse(prices)
+	}
+
+	levels = make([]types.OrderbookLevel, 0, len(prices))
+	orders = make([]types.OrderbookSnapshotOrder, 0)
+	for _, subticks := range prices {
+		level := types.OrderbookLevel{Subticks: subticks.ToUint64()}
+		side[subticks].LevelOrders.Front.Each(
+			func(order types.ClobOrder) {
+				remainingAmount, hasRemainingAmount := m.GetOrderRemainingAmount(ctx, order.Order)
+				if !hasRemainingAmount {
+					return
+				}
+				level.Quantums += remainingAmount.ToUint64()
+				level.NumOrders++
+				orders = append(
+					orders,
+					types.OrderbookSnapshotOrder{Order: order.Order, RemainingQuantums: remainingAmount},
+				)
+			},
+		)
+		if level.NumOrders > 0 {
+			levels = append(levels, level)
+		}
+	}
+	return levels, orders
+}
+
 // getImpactPriceSubticks returns the impact ask or bid price (in subticks), given the clob pair
 // and orderbook. The bid (or ask) impact price is the average price a trader
 // would receive if they sold (or bought) from the order book using `impactNotionalAmount`.
@@ -2507,3 +3129,19 @@ func (m *MemClobPriceTimePriority) resizeReduceOnlyMatchIfNecessary(
 	maxMatchSize := lib.BigMin(absPositionSize, absNewMatchSize)
 	return satypes.BaseQuantums(maxMatchSize.Uint64())
 }
+
+// mustAddShortTermOrderTxBytes adds the TX bytes of the provided Short-Term order to the operations
+// to propose. If the order is being placed as part of a `MsgBatchPlaceOrder`, the index of the order
+// in the batch is recorded as well.
+func (m *MemClobPriceTimePriority) mustAddShortTermOrderTxBytes(
+	ctx sdk.Context,
+	order types.Order,
+) {
+	m.operationsToPropose.MustAddShortTermOrderTxBytes(
+		order,
+		ctx.TxBytes(),
+	)
+	if orderIndex, isBatchOrder := types.GetBatchOrderIndex(ctx); isBatchOrder {
+		m.operationsToPropose.MustAddShortTermOrderBatchIndex(order, orderIndex)
+	}
+}
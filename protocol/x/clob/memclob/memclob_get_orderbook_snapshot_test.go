@@ -0,0 +1,84 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderbookSnapshot(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+
+	clobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+	memclob := NewMemClobPriceTimePriority(false)
+	memclob.SetClobKeeper(clobKeeper)
+
+	memclob.CreateOrderbook(ctx, constants.ClobPair_Btc)
+
+	// An orderbook without orders has no price levels and no mid price.
+	require.Equal(
+		t,
+		types.OrderbookSnapshot{
+			Bids:      []types.OrderbookLevel{},
+			Asks:      []types.OrderbookLevel{},
+			BidOrders: []types.OrderbookSnapshotOrder{},
+			AskOrders: []types.OrderbookSnapshotOrder{},
+		},
+		memclob.GetOrderbookSnapshot(ctx, constants.ClobPair_Btc.GetClobPairId()),
+	)
+
+	carlSell5Price50 := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+	carlSell5Price50.OrderId.SubaccountId = constants.Carl_Num0
+	carlSell5Price50.Subticks = 50
+	carlSell10Price60 := carlSell5Price50
+	carlSell10Price60.OrderId.ClientId = 2
+	carlSell10Price60.Quantums = 10
+	carlSell10Price60.Subticks = 60
+
+	orders := []types.Order{
+		constants.Order_Alice_Num0_Id1_Clob0_Buy15_Price10_GTB18_PO,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16,
+		constants.Order_Bob_Num0_Id12_Clob0_Buy5_Price40_GTB20,
+		carlSell10Price60,
+		carlSell5Price50,
+	}
+	for _, order := range orders {
+		memclob.mustAddOrderToOrderbook(ctx, order, false)
+	}
+
+	// Price levels and orders contain the remaining size of partially filled orders.
+	clobKeeper.SetOrderFillAmount(ctx, constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16.OrderId, 4)
+
+	require.Equal(
+		t,
+		types.OrderbookSnapshot{
+			Bids: []types.OrderbookLevel{
+				{Subticks: 40, Quantums: 5, NumOrders: 1},
+				{Subticks: 10, Quantums: 21, NumOrders: 2},
+			},
+			Asks: []types.OrderbookLevel{
+				{Subticks: 50, Quantums: 5, NumOrders: 1},
+				{Subticks: 60, Quantums: 10, NumOrders: 1},
+			},
+			BidOrders: []types.OrderbookSnapshotOrder{
+				{Order: constants.Order_Bob_Num0_Id12_Clob0_Buy5_Price40_GTB20, RemainingQuantums: 5},
+				{Order: constants.Order_Alice_Num0_Id1_Clob0_Buy15_Price10_GTB18_PO, RemainingQuantums: 15},
+				{Order: constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16, RemainingQuantums: 6},
+			},
+			AskOrders: []types.OrderbookSnapshotOrder{
+				{Order: carlSell5Price50, RemainingQuantums: 5},
+				{Order: carlSell10Price60, RemainingQuantums: 10},
+			},
+			MidPrice:       45,
+			MidPriceExists: true,
+		},
+		memclob.GetOrderbookSnapshot(ctx, constants.ClobPair_Btc.GetClobPairId()),
+	)
+
+	// Orderbooks that do not exist are empty.
+	require.Equal(t, types.OrderbookSnapshot{}, memclob.GetOrderbookSnapshot(ctx, 1))
+}
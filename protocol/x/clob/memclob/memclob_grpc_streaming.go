@@ -118,17 +118,64 @@ func (m *MemClobPriceTimePriority) GetOrderbookUpdatesForOrderPlacement(
 	return offchainUpdates
 }
 
+// GetOrderbookUpdatesForOrderReplacement returns a replace order offchain message and
+// a update order offchain message used to replace an order in place for
+// the orderbook grpc stream.
+func (m *MemClobPriceTimePriority) GetOrderbookUpdatesForOrderReplacement(
+	ctx sdk.Context,
+	order types.Order,
+) (offchainUpdates *types.OffchainUpdates) {
+	offchainUpdates = types.NewOffchainUpdates()
+	orderId := order.OrderId
+
+	// Generate a order replace message.
+	if message, success := off_chain_updates.CreateOrderReplaceMessage(
+		ctx,
+		order,
+	); success {
+		offchainUpdates.AddReplaceMessage(orderId, message)
+	}
+
+	// Get the current fill amount of the order.
+	fillAmount := m.GetOrderFilledAmount(ctx, orderId)
+
+	// Generate an update message updating the total filled amount of order.
+	if message, success := off_chain_updates.CreateOrderUpdateMessage(
+		ctx,
+		orderId,
+		fillAmount,
+	); success {
+		offchainUpdates.AddUpdateMessage(orderId, message)
+	}
+
+	return offchainUpdates
+}
+
 // GetOrderbookUpdatesForOrderRemoval returns a remove order offchain message
 // used to remove an order for the orderbook grpc stream.
 func (m *MemClobPriceTimePriority) GetOrderbookUpdatesForOrderRemoval(
 	ctx sdk.Context,
 	orderId types.OrderId,
+) (offchainUpdates *types.OffchainUpdates) {
+	return m.getOrderbookUpdatesForOrderRemovalWithReason(
+		ctx,
+		orderId,
+		indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED,
+	)
+}
+
+// getOrderbookUpdatesForOrderRemovalWithReason returns a remove order offchain message with the provided
+// removal reason used to remove an order for the orderbook grpc stream.
+func (m *MemClobPriceTimePriority) getOrderbookUpdatesForOrderRemovalWithReason(
+	ctx sdk.Context,
+	orderId types.OrderId,
+	reason indexersharedtypes.OrderRemovalReason,
 ) (offchainUpdates *types.OffchainUpdates) {
 	offchainUpdates = types.NewOffchainUpdates()
 	if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
 		ctx,
 		orderId,
-		indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_UNSPECIFIED,
+		reason,
 		ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
 	); success {
 		offchainUpdates.AddRemoveMessage(orderId, message)
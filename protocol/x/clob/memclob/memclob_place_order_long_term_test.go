@@ -14,6 +14,11 @@ import (
 func TestPlaceOrder_LongTerm(t *testing.T) {
 	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
 	ctx = ctx.WithIsCheckTx(true)
+
+	// Alice's resting Long-term sell order of size 65 decremented by a self trade with a taker order of size 5.
+	decrementedSell60 := constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25
+	decrementedSell60.Quantums = 60
+
 	tests := map[string]struct {
 		// State.
 		placedMatchableOrders  []types.MatchableOrder
@@ -650,9 +655,11 @@ func TestPlaceOrder_LongTerm(t *testing.T) {
 			expectedOrderStatus: types.Success,
 			expectedOperations:  []types.Operation{},
 			expectedInternalOperations: []types.InternalOperation{
-				types.NewOrderRemovalInternalOperation(
+				types.NewSelfTradeOrderRemovalInternalOperation(
 					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
 					types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					0,
 				),
 			},
 			expectedRemainingBids: []OrderWithRemainingSize{},
@@ -663,6 +670,40 @@ func TestPlaceOrder_LongTerm(t *testing.T) {
 				},
 			},
 		},
+		`A Long-term buy order with decrement-and-cancel self-trade prevention decrements a larger Long-term sell
+			order from the same subaccount and is canceled`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+			},
+			collateralizationCheck: map[int]testutil_memclob.CollateralizationCheck{},
+
+			order: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel,
+
+			expectedFilledSize:  0,
+			expectedOrderStatus: types.SelfTradeDecrementAndCancel,
+			expectedOperations:  []types.Operation{},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewSelfTradeOrderRemovalInternalOperation(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					5,
+				),
+				types.NewSelfTradeOrderRemovalInternalOperation(
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StpDecrementAndCancel.OrderId,
+					0,
+				),
+			},
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         decrementedSell60,
+					RemainingSize: 60,
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
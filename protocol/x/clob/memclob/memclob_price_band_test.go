@@ -0,0 +1,166 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceOrder_PriceBand(t *testing.T) {
+	newOrder := func(
+		subaccountId satypes.SubaccountId,
+		clientId uint32,
+		side types.Order_Side,
+		quantums uint64,
+		subticks uint64,
+	) types.Order {
+		order := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+		order.OrderId.SubaccountId = subaccountId
+		order.OrderId.ClientId = clientId
+		order.Side = side
+		order.Quantums = quantums
+		order.Subticks = subticks
+		return order
+	}
+
+	// The price band of clob pair 0 is [10, 20] subticks.
+	bobSell5Price5 := newOrder(constants.Bob_Num0, 0, types.Order_SIDE_SELL, 5, 5)
+	carlSell5Price15 := newOrder(constants.Carl_Num0, 0, types.Order_SIDE_SELL, 5, 15)
+	carlSell5Price25 := newOrder(constants.Carl_Num0, 1, types.Order_SIDE_SELL, 5, 25)
+	bobBuy5Price25 := newOrder(constants.Bob_Num0, 2, types.Order_SIDE_BUY, 5, 25)
+	carlBuy5Price15 := newOrder(constants.Carl_Num0, 2, types.Order_SIDE_BUY, 5, 15)
+	carlBuy5Price5 := newOrder(constants.Carl_Num0, 3, types.Order_SIDE_BUY, 5, 5)
+
+	tests := map[string]struct {
+		// State.
+		existingOrders    []types.Order
+		priceBandDisabled bool
+
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedOrderStatus     types.OrderStatus
+		expectedFilledSize      satypes.BaseQuantums
+		expectedOrderRests      bool
+		expectedRemainingOrders []types.Order
+		expectedRemovedOrders   []types.Order
+	}{
+		"Buy order matches maker orders inside of the price band": {
+			existingOrders:        []types.Order{carlSell5Price15},
+			order:                 newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 15),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    5,
+			expectedRemovedOrders: []types.Order{carlSell5Price15},
+		},
+		"Buy order removes maker orders below the price band": {
+			existingOrders:        []types.Order{bobSell5Price5, carlSell5Price15},
+			order:                 newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 15),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    5,
+			expectedRemovedOrders: []types.Order{bobSell5Price5, carlSell5Price15},
+		},
+		"Buy order inside of the price band rests after removing maker orders below the price band": {
+			existingOrders:        []types.Order{bobSell5Price5},
+			order:                 newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 15),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    0,
+			expectedOrderRests:    true,
+			expectedRemovedOrders: []types.Order{bobSell5Price5},
+		},
+		"Buy order stops matching at maker orders above the price band and is canceled": {
+			existingOrders:          []types.Order{carlSell5Price15, carlSell5Price25},
+			order:                   newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 10, 25),
+			expectedOrderStatus:     types.OutsidePriceBand,
+			expectedFilledSize:      5,
+			expectedRemainingOrders: []types.Order{carlSell5Price25},
+			expectedRemovedOrders:   []types.Order{carlSell5Price15},
+		},
+		"Buy order only crossing maker orders above the price band is canceled": {
+			existingOrders:          []types.Order{carlSell5Price25},
+			order:                   newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 25),
+			expectedOrderStatus:     types.OutsidePriceBand,
+			expectedFilledSize:      0,
+			expectedRemainingOrders: []types.Order{carlSell5Price25},
+		},
+		"Buy order fully filled inside of the price band is not canceled": {
+			existingOrders:          []types.Order{carlSell5Price15, carlSell5Price25},
+			order:                   newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 25),
+			expectedOrderStatus:     types.Success,
+			expectedFilledSize:      5,
+			expectedRemainingOrders: []types.Order{carlSell5Price25},
+			expectedRemovedOrders:   []types.Order{carlSell5Price15},
+		},
+		"Sell order removes maker orders above the price band": {
+			existingOrders:        []types.Order{bobBuy5Price25, carlBuy5Price15},
+			order:                 newOrder(constants.Alice_Num0, 0, types.Order_SIDE_SELL, 5, 15),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    5,
+			expectedRemovedOrders: []types.Order{bobBuy5Price25, carlBuy5Price15},
+		},
+		"Sell order stops matching at maker orders below the price band and is canceled": {
+			existingOrders:          []types.Order{carlBuy5Price15, carlBuy5Price5},
+			order:                   newOrder(constants.Alice_Num0, 0, types.Order_SIDE_SELL, 10, 5),
+			expectedOrderStatus:     types.OutsidePriceBand,
+			expectedFilledSize:      5,
+			expectedRemainingOrders: []types.Order{carlBuy5Price5},
+			expectedRemovedOrders:   []types.Order{carlBuy5Price15},
+		},
+		"Order rests on the orderbook without crossing maker orders": {
+			existingOrders:          []types.Order{carlSell5Price25},
+			order:                   newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 15),
+			expectedOrderStatus:     types.Success,
+			expectedFilledSize:      0,
+			expectedOrderRests:      true,
+			expectedRemainingOrders: []types.Order{carlSell5Price25},
+		},
+		"Order matches maker orders outside of the price band if the price band is disabled": {
+			existingOrders:        []types.Order{carlSell5Price25},
+			priceBandDisabled:     true,
+			order:                 newOrder(constants.Alice_Num0, 0, types.Order_SIDE_BUY, 5, 25),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    5,
+			expectedRemovedOrders: []types.Order{carlSell5Price25},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state.
+			ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+			ctx = ctx.WithIsCheckTx(true)
+			memClobKeeper := testutil_memclob.NewFakeMemClobKeeper()
+			if !tc.priceBandDisabled {
+				memClobKeeper = memClobKeeper.WithPriceBandSubticks(0, 10, 20)
+			}
+			memclob := NewMemClobPriceTimePriority(false)
+			memclob.SetClobKeeper(memClobKeeper)
+			createOrderbooks(t, ctx, memclob, 1)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+
+			// Run the test case.
+			filledSize, orderStatus, _, err := memclob.PlaceOrder(ctx, tc.order)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOrderStatus, orderStatus)
+			require.Equal(t, tc.expectedFilledSize, filledSize)
+
+			// Verify the memclob state.
+			if tc.expectedOrderRests {
+				requireOrderExistsInMemclob(t, ctx, tc.order, memclob)
+			} else {
+				requireOrderDoesNotExistInMemclob(t, ctx, tc.order, memclob)
+			}
+			for _, order := range tc.expectedRemainingOrders {
+				requireOrderExistsInMemclob(t, ctx, order, memclob)
+			}
+			for _, order := range tc.expectedRemovedOrders {
+				requireOrderDoesNotExistInMemclob(t, ctx, order, memclob)
+			}
+		})
+	}
+}
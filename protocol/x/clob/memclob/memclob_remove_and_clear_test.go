@@ -162,7 +162,7 @@ func TestRemoveAndClearOperationsQueue(t *testing.T) {
 				tc.preexistingStatefulOrders,
 			)
 
-			operations, _ := memclob.operationsToPropose.GetOperationsToReplay()
+			operations, _, _ := memclob.operationsToPropose.GetOperationsToReplay()
 			memclob.RemoveAndClearOperationsQueue(
 				ctx,
 				operations,
@@ -176,9 +176,10 @@ func TestRemoveAndClearOperationsQueue(t *testing.T) {
 				tc.expectedRemainingAsks,
 			)
 
-			operations, shortTermTxBytes := memclob.operationsToPropose.GetOperationsToReplay()
+			operations, shortTermTxBytes, shortTermBatchIndexes := memclob.operationsToPropose.GetOperationsToReplay()
 			require.Empty(t, operations)
 			require.Empty(t, shortTermTxBytes)
+			require.Empty(t, shortTermBatchIndexes)
 		})
 	}
 }
@@ -0,0 +1,186 @@
+package memclob
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceStatefulOrder(t *testing.T) {
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+		fillAmounts    map[types.OrderId]satypes.BaseQuantums
+
+		// Parameters.
+		replacementOrder types.Order
+
+		// Expectations.
+		expectedErr         error
+		expectedLevelOrders []types.Order
+		// The types of the placement, removal and replacement messages sent to grpc streams when replacing
+		// the order.
+		expectedStreamMessageTypes []types.OffchainUpdateMessageType
+	}{
+		"Size decrease retains queue priority": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			expectedStreamMessageTypes: []types.OffchainUpdateMessageType{
+				types.ReplaceMessageType,
+			},
+		},
+		"Size decrease below the minimum order size removes the order": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			fillAmounts: map[types.OrderId]satypes.BaseQuantums{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15.OrderId: 3,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedErr: types.ErrOrderFullyFilled,
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			expectedStreamMessageTypes: []types.OffchainUpdateMessageType{
+				types.RemoveMessageType,
+			},
+		},
+		"Size increase loses queue priority": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15,
+			},
+			expectedStreamMessageTypes: []types.OffchainUpdateMessageType{
+				types.ReplaceMessageType,
+			},
+		},
+		"Good til block time change loses queue priority": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+			},
+			expectedStreamMessageTypes: []types.OffchainUpdateMessageType{
+				types.ReplaceMessageType,
+			},
+		},
+		"Identical order is a no-op": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+		},
+		"Order not on the book is placed": {
+			existingOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			replacementOrder: constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+
+			expectedLevelOrders: []types.Order{
+				constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+				constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15,
+			},
+			expectedStreamMessageTypes: []types.OffchainUpdateMessageType{
+				types.PlaceMessageType,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state.
+			ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+			ctx = ctx.WithIsCheckTx(true)
+			memclob := NewMemClobPriceTimePriority(false)
+			memClobKeeper := &streamRecordingMemClobKeeper{FakeMemClobKeeper: testutil_memclob.NewFakeMemClobKeeper()}
+			memclob.SetClobKeeper(memClobKeeper)
+			memclob.SetGenerateOrderbookUpdates(true)
+			createOrderbooks(t, ctx, memclob, 1)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+			for orderId, fillAmount := range tc.fillAmounts {
+				memClobKeeper.SetOrderFillAmount(ctx, orderId, fillAmount)
+			}
+			memClobKeeper.messageTypes = nil
+
+			// Run the test case.
+			_, orderStatus, _, err := memclob.ReplaceStatefulOrder(ctx, tc.replacementOrder)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.Success, orderStatus)
+			}
+
+			// Verify the level orders are in the expected time priority.
+			orderbook := memclob.openOrders.mustGetOrderbook(ctx, tc.replacementOrder.GetClobPairId())
+			level, exists := orderbook.Bids[tc.replacementOrder.GetOrderSubticks()]
+			require.True(t, exists)
+
+			levelOrders := make([]types.Order, 0, len(tc.expectedLevelOrders))
+			for levelOrder := level.LevelOrders.Front; levelOrder != nil; levelOrder = levelOrder.Next {
+				levelOrders = append(levelOrders, levelOrder.Value.Order)
+			}
+			require.Equal(t, tc.expectedLevelOrders, levelOrders)
+			if tc.expectedErr != nil {
+				requireOrderDoesNotExistInMemclob(t, ctx, tc.replacementOrder, memclob)
+			} else {
+				requireOrderExistsInMemclob(t, ctx, tc.replacementOrder, memclob)
+			}
+
+			// Verify a single update was sent to grpc streams for the replacement.
+			require.Equal(t, tc.expectedStreamMessageTypes, memClobKeeper.messageTypes)
+		})
+	}
+}
+
+// streamRecordingMemClobKeeper records the types of the placement, removal and replacement messages sent to
+// grpc streams. Fill amount updates are not recorded.
+type streamRecordingMemClobKeeper struct {
+	*testutil_memclob.FakeMemClobKeeper
+	messageTypes []types.OffchainUpdateMessageType
+}
+
+func (k *streamRecordingMemClobKeeper) SendOrderbookUpdates(
+	ctx sdk.Context,
+	offchainUpdates *types.OffchainUpdates,
+	snapshot bool,
+) {
+	for _, message := range offchainUpdates.Messages {
+		if message.Type != types.UpdateMessageType {
+			k.messageTypes = append(k.messageTypes, message.Type)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil_memclob "github.com/dydxprotocol/v4-chain/protocol/testutil/memclob"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceOrder_SelfTradePrevention(t *testing.T) {
+	// Alice's resting sell orders of size 5 and 10 at price 15.
+	makerSell5 := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+	makerSell10 := constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15
+
+	// Bob's resting sell order of size 10 at price 15.
+	bobSell10 := makerSell10
+	bobSell10.OrderId.SubaccountId = constants.Bob_Num0
+	bobSell10.OrderId.ClientId = 2
+
+	// Alice's crossing buy order of size 10 at price 15.
+	takerBuy10 := constants.Order_Alice_Num0_Id0_Clob0_Buy35_Price10_GTB20
+	takerBuy10.Quantums = 10
+	takerBuy10.Subticks = 15
+
+	// Alice's crossing buy order of size 5 at price 15.
+	takerBuy5 := takerBuy10
+	takerBuy5.Quantums = 5
+
+	withMode := func(
+		order types.Order,
+		mode types.Order_SelfTradePreventionMode,
+	) types.Order {
+		order.SelfTradePreventionMode = mode
+		return order
+	}
+
+	tests := map[string]struct {
+		// State.
+		existingOrders []types.Order
+
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedOrderStatus   types.OrderStatus
+		expectedFilledSize    satypes.BaseQuantums
+		expectedRemainingBids []types.Order
+		expectedRemainingAsks []types.Order
+		expectedRemovedOrders []types.Order
+	}{
+		"Unspecified mode cancels the maker and rests the taker": {
+			existingOrders:        []types.Order{makerSell10},
+			order:                 takerBuy10,
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    0,
+			expectedRemainingBids: []types.Order{takerBuy10},
+			expectedRemovedOrders: []types.Order{makerSell10},
+		},
+		"Cancel maker mode cancels the maker and continues matching": {
+			existingOrders: []types.Order{makerSell10, bobSell10},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER,
+			),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    10,
+			expectedRemovedOrders: []types.Order{makerSell10, bobSell10},
+		},
+		"Cancel taker mode cancels the taker and leaves the maker": {
+			existingOrders: []types.Order{makerSell10},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER,
+			),
+			expectedOrderStatus:   types.SelfTradeCancelTaker,
+			expectedFilledSize:    0,
+			expectedRemainingAsks: []types.Order{makerSell10},
+		},
+		"Cancel both mode cancels the maker and the taker": {
+			existingOrders: []types.Order{makerSell10, bobSell10},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH,
+			),
+			expectedOrderStatus:   types.SelfTradeCancelBoth,
+			expectedFilledSize:    0,
+			expectedRemainingAsks: []types.Order{bobSell10},
+			expectedRemovedOrders: []types.Order{makerSell10},
+		},
+		"Decrement and cancel mode with a smaller maker decrements the taker and continues matching": {
+			existingOrders: []types.Order{makerSell5, bobSell10},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+			),
+			expectedOrderStatus:   types.Success,
+			expectedFilledSize:    5,
+			expectedRemainingAsks: []types.Order{bobSell10},
+			expectedRemovedOrders: []types.Order{makerSell5},
+		},
+		"Decrement and cancel mode with a smaller maker cancels the decremented taker remainder": {
+			existingOrders: []types.Order{makerSell5},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+			),
+			expectedOrderStatus:   types.SelfTradeDecrementAndCancel,
+			expectedFilledSize:    0,
+			expectedRemovedOrders: []types.Order{makerSell5},
+		},
+		"Decrement and cancel mode with an equal maker cancels the maker and the taker": {
+			existingOrders: []types.Order{makerSell10},
+			order: withMode(
+				takerBuy10,
+				types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+			),
+			expectedOrderStatus:   types.SelfTradeDecrementAndCancel,
+			expectedFilledSize:    0,
+			expectedRemovedOrders: []types.Order{makerSell10},
+		},
+		"Decrement and cancel mode with a larger Short-Term maker cancels the maker and the taker": {
+			existingOrders: []types.Order{makerSell10},
+			order: withMode(
+				takerBuy5,
+				types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+			),
+			expectedOrderStatus:   types.SelfTradeDecrementAndCancel,
+			expectedFilledSize:    0,
+			expectedRemovedOrders: []types.Order{makerSell10},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state.
+			ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+			ctx = ctx.WithIsCheckTx(true)
+			memclob := NewMemClobPriceTimePriority(false)
+			memclob.SetClobKeeper(testutil_memclob.NewFakeMemClobKeeper())
+			createOrderbooks(t, ctx, memclob, 1)
+			createAllOrders(t, ctx, memclob, tc.existingOrders)
+
+			// Run the test case.
+			filledSize, orderStatus, _, err := memclob.PlaceOrder(ctx, tc.order)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOrderStatus, orderStatus)
+			require.Equal(t, tc.expectedFilledSize, filledSize)
+
+			// Verify the memclob state.
+			if len(tc.expectedRemainingBids) == 0 {
+				requireOrderDoesNotExistInMemclob(t, ctx, tc.order, memclob)
+			}
+			for _, order := range tc.expectedRemainingBids {
+				requireOrderExistsInMemclob(t, ctx, order, memclob)
+			}
+			for _, order := range tc.expectedRemainingAsks {
+				requireOrderExistsInMemclob(t, ctx, order, memclob)
+			}
+			for _, order := range tc.expectedRemovedOrders {
+				requireOrderDoesNotExistInMemclob(t, ctx, order, memclob)
+			}
+		})
+	}
+}
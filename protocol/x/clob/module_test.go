@@ -148,7 +148,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 18)
+	require.Len(t, fv.MapKeys(), 30)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -240,10 +240,15 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 3, len(cmd.Commands()))
+	require.Equal(t, 8, len(cmd.Commands()))
 	require.Equal(t, "batch-cancel", cmd.Commands()[0].Name())
-	require.Equal(t, "cancel-order", cmd.Commands()[1].Name())
-	require.Equal(t, "place-order", cmd.Commands()[2].Name())
+	require.Equal(t, "cancel-all-orders", cmd.Commands()[1].Name())
+	require.Equal(t, "cancel-order", cmd.Commands()[2].Name())
+	require.Equal(t, "heartbeat", cmd.Commands()[3].Name())
+	require.Equal(t, "place-order", cmd.Commands()[4].Name())
+	require.Equal(t, "replace-order", cmd.Commands()[5].Name())
+	require.Equal(t, "reset-market-maker-protection", cmd.Commands()[6].Name())
+	require.Equal(t, "set-market-maker-protection-config", cmd.Commands()[7].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -251,13 +256,16 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 9, len(cmd.Commands()))
 	require.Equal(t, "get-block-rate-limit-config", cmd.Commands()[0].Name())
 	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[1].Name())
 	require.Equal(t, "get-liquidations-config", cmd.Commands()[2].Name())
 	require.Equal(t, "list-clob-pair", cmd.Commands()[3].Name())
-	require.Equal(t, "show-clob-pair", cmd.Commands()[4].Name())
-	require.Equal(t, "stateful-order", cmd.Commands()[5].Name())
+	require.Equal(t, "orderbook", cmd.Commands()[4].Name())
+	require.Equal(t, "show-clob-pair", cmd.Commands()[5].Name())
+	require.Equal(t, "simulate-order", cmd.Commands()[6].Name())
+	require.Equal(t, "stateful-order", cmd.Commands()[7].Name())
+	require.Equal(t, "subaccount-risk", cmd.Commands()[8].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -422,7 +430,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	genesisJson := am.ExportGenesis(ctx, cdc)
 	expected := `{"clob_pairs":[{"id":0,"perpetual_clob_metadata":{"perpetual_id":0},`
 	expected += `"step_base_quantums":"5","subticks_per_tick":100,`
-	expected += `"quantum_conversion_exponent":0,"status":"STATUS_ACTIVE"}],`
+	expected += `"quantum_conversion_exponent":0,"status":"STATUS_ACTIVE",`
+	expected += `"price_band_config":null,"circuit_breaker_config":null}],`
 	expected += `"liquidations_config":{`
 	expected += `"max_liquidation_fee_ppm":5000,"position_block_limits":{"min_position_notional_liquidated":"1000",`
 	expected += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
@@ -12,8 +12,8 @@ var (
 	BATCH_CANCEL_RATE_LIMIT_WEIGHT = uint32(2)
 )
 
-// A RateLimiter which rate limits types.MsgPlaceOrder, types.MsgCancelOrder, and
-// types.MsgBatchCancel.
+// A RateLimiter which rate limits types.MsgPlaceOrder, types.MsgCancelOrder,
+// types.MsgBatchCancel, types.MsgBatchPlaceOrder, types.MsgReplaceOrder, and types.MsgCancelAllOrders.
 //
 // The rate limiting keeps track of short term and stateful orders placed during
 // CheckTx.
@@ -27,7 +27,8 @@ type placeAndCancelOrderRateLimiter struct {
 var _ RateLimiter[sdk.Msg] = (*placeAndCancelOrderRateLimiter)(nil)
 
 // NewPlaceCancelOrderRateLimiter returns a RateLimiter which rate limits types.MsgPlaceOrder, types.MsgCancelOrder,
-// types.MsgBatchCancel based upon the provided types.BlockRateLimitConfiguration. The rate limiter currently
+// types.MsgBatchCancel, types.MsgBatchPlaceOrder, types.MsgReplaceOrder, types.MsgCancelAllOrders based upon the
+// provided types.BlockRateLimitConfiguration. The rate limiter currently
 // supports limiting based upon:
 //   - how many short term place/cancel orders per account (by using string).
 //   - how many stateful order per account (by using string).
@@ -94,6 +95,12 @@ func (r *placeAndCancelOrderRateLimiter) RateLimit(ctx sdk.Context, msg sdk.Msg)
 		err = r.RateLimitPlaceOrder(ctx, *castedMsg)
 	case *types.MsgBatchCancel:
 		err = r.RateLimitBatchCancelOrder(ctx, *castedMsg)
+	case *types.MsgBatchPlaceOrder:
+		err = r.RateLimitBatchPlaceOrder(ctx, *castedMsg)
+	case *types.MsgReplaceOrder:
+		err = r.RateLimitReplaceOrder(ctx, *castedMsg)
+	case *types.MsgCancelAllOrders:
+		err = r.RateLimitCancelAllOrders(ctx, *castedMsg)
 	}
 	return err
 }
@@ -171,6 +178,69 @@ func (r *placeAndCancelOrderRateLimiter) RateLimitBatchCancelOrder(
 	return err
 }
 
+// RateLimitBatchPlaceOrder rate limits batch order placements using the short term order rate limiter.
+// Each order in the batch counts as a single short term order placement.
+func (r *placeAndCancelOrderRateLimiter) RateLimitBatchPlaceOrder(
+	ctx sdk.Context,
+	msg types.MsgBatchPlaceOrder,
+) (err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	err = r.checkStateShortTermOrderPlaceCancelRateLimiter.RateLimitIncrBy(
+		ctx,
+		msg.SubaccountId.Owner,
+		lib.MustConvertIntegerToUint32(len(msg.ShortTermOrders)),
+	)
+	if err != nil {
+		metrics.IncrCounterWithLabels(
+			metrics.ClobRateLimitBatchPlaceOrderCount,
+			1,
+		)
+		r.rateLimitedAccounts[msg.SubaccountId.Owner] = true
+	}
+	return err
+}
+
+// RateLimitReplaceOrder rate limits stateful order replacements using the stateful order rate limiter,
+// since a replacement is equivalent to placing a new stateful order.
+func (r *placeAndCancelOrderRateLimiter) RateLimitReplaceOrder(
+	ctx sdk.Context,
+	msg types.MsgReplaceOrder,
+) (err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	msg.Order.MustBeStatefulOrder()
+	err = r.checkStateStatefulOrderRateLimiter.RateLimit(ctx, msg.Order.OrderId.SubaccountId.Owner)
+	if err != nil {
+		metrics.IncrCounterWithLabels(
+			metrics.ClobRateLimitReplaceOrderCount,
+			1,
+			msg.Order.GetOrderLabels()...,
+		)
+		r.rateLimitedAccounts[msg.Order.OrderId.SubaccountId.Owner] = true
+	}
+	return err
+}
+
+// RateLimitCancelAllOrders rate limits cancel all orders msgs using the stateful order rate limiter,
+// since the msg is included in a block and removes stateful orders from state.
+func (r *placeAndCancelOrderRateLimiter) RateLimitCancelAllOrders(
+	ctx sdk.Context,
+	msg types.MsgCancelAllOrders,
+) (err error) {
+	lib.AssertCheckTxMode(ctx)
+
+	err = r.checkStateStatefulOrderRateLimiter.RateLimit(ctx, msg.SubaccountId.Owner)
+	if err != nil {
+		metrics.IncrCounterWithLabels(
+			metrics.ClobRateLimitCancelAllOrdersCount,
+			1,
+		)
+		r.rateLimitedAccounts[msg.SubaccountId.Owner] = true
+	}
+	return err
+}
+
 func (r *placeAndCancelOrderRateLimiter) PruneRateLimits(ctx sdk.Context) {
 	telemetry.IncrCounter(
 		float32(len(r.rateLimitedAccounts)),
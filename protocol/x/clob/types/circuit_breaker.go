@@ -0,0 +1,145 @@
+package types
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// IsEnabled returns true if the circuit breaker is set and has a non-zero max oracle price change.
+func (c *CircuitBreakerConfig) IsEnabled() bool {
+	return c != nil && c.MaxOraclePriceChangePpm > 0
+}
+
+// Validate validates the circuit breaker config. It returns an error if the circuit breaker is enabled
+// and any of the following conditions are true:
+//   - `WindowBlocks` or `CooldownBlocks` is zero or exceeds `MaxCircuitBreakerBlocks`.
+//   - `TrippedStatus` is neither `STATUS_CANCEL_ONLY` nor `STATUS_POST_ONLY`.
+func (c *CircuitBreakerConfig) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+
+	if c.WindowBlocks == 0 || c.WindowBlocks > MaxCircuitBreakerBlocks {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"invalid CircuitBreakerConfig parameter: WindowBlocks must be between 1 and %d. Got %d",
+			MaxCircuitBreakerBlocks,
+			c.WindowBlocks,
+		)
+	}
+
+	if c.CooldownBlocks == 0 || c.CooldownBlocks > MaxCircuitBreakerBlocks {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"invalid CircuitBreakerConfig parameter: CooldownBlocks must be between 1 and %d. Got %d",
+			MaxCircuitBreakerBlocks,
+			c.CooldownBlocks,
+		)
+	}
+
+	if c.TrippedStatus != ClobPair_STATUS_CANCEL_ONLY && c.TrippedStatus != ClobPair_STATUS_POST_ONLY {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"invalid CircuitBreakerConfig parameter: TrippedStatus must be %+v or %+v. Got %+v",
+			ClobPair_STATUS_CANCEL_ONLY,
+			ClobPair_STATUS_POST_ONLY,
+			c.TrippedStatus,
+		)
+	}
+
+	return nil
+}
+
+// ExceedsMaxOraclePriceChange returns true if the change from `startOraclePriceSubticks` to
+// `oraclePriceSubticks` exceeds `MaxOraclePriceChangePpm` of `startOraclePriceSubticks`.
+func (c *CircuitBreakerConfig) ExceedsMaxOraclePriceChange(
+	startOraclePriceSubticks uint64,
+	oraclePriceSubticks uint64,
+) bool {
+	if startOraclePriceSubticks == 0 {
+		return false
+	}
+
+	bigChange := new(big.Int).Sub(
+		new(big.Int).SetUint64(oraclePriceSubticks),
+		new(big.Int).SetUint64(startOraclePriceSubticks),
+	)
+	bigChange.Abs(bigChange).Mul(bigChange, lib.BigIntOneMillion())
+
+	bigMaxChange := new(big.Int).Mul(
+		new(big.Int).SetUint64(startOraclePriceSubticks),
+		new(big.Int).SetUint64(uint64(c.MaxOraclePriceChangePpm)),
+	)
+	return bigChange.Cmp(bigMaxChange) > 0
+}
+
+// ExceedsMaxWindowOraclePriceChange returns true if the change from the minimum or the maximum oracle
+// price within the trailing window of `state` to `oraclePriceSubticks` exceeds `MaxOraclePriceChangePpm`.
+func (c *CircuitBreakerConfig) ExceedsMaxWindowOraclePriceChange(
+	state CircuitBreakerState,
+	oraclePriceSubticks uint64,
+) bool {
+	if len(state.WindowMinOraclePrices) == 0 || len(state.WindowMaxOraclePrices) == 0 {
+		return false
+	}
+
+	return c.ExceedsMaxOraclePriceChange(state.WindowMinOraclePrices[0].OraclePriceSubticks, oraclePriceSubticks) ||
+		c.ExceedsMaxOraclePriceChange(state.WindowMaxOraclePrices[0].OraclePriceSubticks, oraclePriceSubticks)
+}
+
+// IsTripped returns true if the circuit breaker is tripped.
+func (s CircuitBreakerState) IsTripped() bool {
+	return s.TrippedUntilBlock != 0
+}
+
+// AddOraclePrice adds the oracle price at `blockHeight` to the trailing window of the last `windowBlocks`
+// blocks and evicts the oracle prices of blocks before the window. Oracle prices which can no longer be
+// the minimum or the maximum of the window are discarded, such that the first entries of
+// `WindowMinOraclePrices` and `WindowMaxOraclePrices` are the minimum and maximum of the window.
+func (s *CircuitBreakerState) AddOraclePrice(
+	windowBlocks uint32,
+	blockHeight uint32,
+	oraclePriceSubticks uint64,
+) {
+	oraclePrice := CircuitBreakerOraclePrice{
+		Block:               blockHeight,
+		OraclePriceSubticks: oraclePriceSubticks,
+	}
+	s.WindowMinOraclePrices = addWindowOraclePrice(
+		s.WindowMinOraclePrices,
+		windowBlocks,
+		oraclePrice,
+		func(a, b uint64) bool { return a < b },
+	)
+	s.WindowMaxOraclePrices = addWindowOraclePrice(
+		s.WindowMaxOraclePrices,
+		windowBlocks,
+		oraclePrice,
+		func(a, b uint64) bool { return a > b },
+	)
+}
+
+// addWindowOraclePrice evicts the oracle prices of blocks before the trailing window of `windowBlocks`
+// blocks ending at `oraclePrice` from `oraclePrices`, discards the oracle prices which do not precede
+// `oraclePrice` according to `precedes`, and appends `oraclePrice`.
+func addWindowOraclePrice(
+	oraclePrices []CircuitBreakerOraclePrice,
+	windowBlocks uint32,
+	oraclePrice CircuitBreakerOraclePrice,
+	precedes func(a, b uint64) bool,
+) []CircuitBreakerOraclePrice {
+	start := 0
+	for start < len(oraclePrices) &&
+		uint64(oraclePrices[start].Block)+uint64(windowBlocks) < uint64(oraclePrice.Block) {
+		start++
+	}
+	oraclePrices = oraclePrices[start:]
+
+	end := len(oraclePrices)
+	for end > 0 && !precedes(oraclePrices[end-1].OraclePriceSubticks, oraclePrice.OraclePriceSubticks) {
+		end--
+	}
+	return append(oraclePrices[:end:end], oraclePrice)
+}
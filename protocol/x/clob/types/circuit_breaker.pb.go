@@ -0,0 +1,679 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/clob/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreakerState represents the oracle prices of a `ClobPair` within the
+// trailing window of blocks, and whether its circuit breaker is tripped.
+type CircuitBreakerState struct {
+	// The last block height of the cooldown of a tripped circuit breaker. A
+	// value of zero means that the circuit breaker is not tripped.
+	TrippedUntilBlock uint32 `protobuf:"varint,3,opt,name=tripped_until_block,json=trippedUntilBlock,proto3" json:"tripped_until_block,omitempty"`
+	// The status of the `ClobPair` before the circuit breaker was tripped, which
+	// is restored once the cooldown has elapsed.
+	RestoreStatus ClobPair_Status `protobuf:"varint,4,opt,name=restore_status,json=restoreStatus,proto3,enum=dydxprotocol.clob.ClobPair_Status" json:"restore_status,omitempty"`
+	// The candidates for the minimum oracle price within the trailing window,
+	// ordered by block height with strictly increasing prices. The first entry is
+	// the minimum oracle price within the trailing window.
+	WindowMinOraclePrices []CircuitBreakerOraclePrice `protobuf:"bytes,5,rep,name=window_min_oracle_prices,json=windowMinOraclePrices,proto3" json:"window_min_oracle_prices"`
+	// The candidates for the maximum oracle price within the trailing window,
+	// ordered by block height with strictly decreasing prices. The first entry is
+	// the maximum oracle price within the trailing window.
+	WindowMaxOraclePrices []CircuitBreakerOraclePrice `protobuf:"bytes,6,rep,name=window_max_oracle_prices,json=windowMaxOraclePrices,proto3" json:"window_max_oracle_prices"`
+}
+
+func (m *CircuitBreakerState) Reset()         { *m = CircuitBreakerState{} }
+func (m *CircuitBreakerState) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerState) ProtoMessage()    {}
+func (*CircuitBreakerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d37789660fa929, []int{0}
+}
+func (m *CircuitBreakerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerState.Merge(m, src)
+}
+func (m *CircuitBreakerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerState proto.InternalMessageInfo
+
+func (m *CircuitBreakerState) GetTrippedUntilBlock() uint32 {
+	if m != nil {
+		return m.TrippedUntilBlock
+	}
+	return 0
+}
+
+func (m *CircuitBreakerState) GetRestoreStatus() ClobPair_Status {
+	if m != nil {
+		return m.RestoreStatus
+	}
+	return ClobPair_STATUS_UNSPECIFIED
+}
+
+func (m *CircuitBreakerState) GetWindowMinOraclePrices() []CircuitBreakerOraclePrice {
+	if m != nil {
+		return m.WindowMinOraclePrices
+	}
+	return nil
+}
+
+func (m *CircuitBreakerState) GetWindowMaxOraclePrices() []CircuitBreakerOraclePrice {
+	if m != nil {
+		return m.WindowMaxOraclePrices
+	}
+	return nil
+}
+
+// CircuitBreakerOraclePrice represents the oracle price of a `ClobPair` at a
+// block height.
+type CircuitBreakerOraclePrice struct {
+	// The block height of the oracle price.
+	Block uint32 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	// The oracle price of the `ClobPair` in subticks.
+	OraclePriceSubticks uint64 `protobuf:"varint,2,opt,name=oracle_price_subticks,json=oraclePriceSubticks,proto3" json:"oracle_price_subticks,omitempty"`
+}
+
+func (m *CircuitBreakerOraclePrice) Reset()         { *m = CircuitBreakerOraclePrice{} }
+func (m *CircuitBreakerOraclePrice) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerOraclePrice) ProtoMessage()    {}
+func (*CircuitBreakerOraclePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70d37789660fa929, []int{1}
+}
+func (m *CircuitBreakerOraclePrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerOraclePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerOraclePrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerOraclePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerOraclePrice.Merge(m, src)
+}
+func (m *CircuitBreakerOraclePrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerOraclePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerOraclePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerOraclePrice proto.InternalMessageInfo
+
+func (m *CircuitBreakerOraclePrice) GetBlock() uint32 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *CircuitBreakerOraclePrice) GetOraclePriceSubticks() uint64 {
+	if m != nil {
+		return m.OraclePriceSubticks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CircuitBreakerState)(nil), "dydxprotocol.clob.CircuitBreakerState")
+	proto.RegisterType((*CircuitBreakerOraclePrice)(nil), "dydxprotocol.clob.CircuitBreakerOraclePrice")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/clob/circuit_breaker.proto", fileDescriptor_70d37789660fa929)
+}
+
+var fileDescriptor_70d37789660fa929 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4f, 0xce, 0xd2, 0x40,
+	0x14, 0xef, 0x7c, 0xf4, 0x23, 0x66, 0x0c, 0x44, 0x0a, 0x24, 0x95, 0x45, 0xad, 0x6c, 0xec, 0x42,
+	0xdb, 0x04, 0x8d, 0x07, 0x28, 0x2b, 0x4d, 0x8c, 0xa4, 0xc4, 0x8d, 0x9b, 0xc9, 0xcc, 0x74, 0x02,
+	0x93, 0x96, 0x4e, 0x33, 0x9d, 0x4a, 0xb9, 0x85, 0xc7, 0x62, 0xc9, 0xd2, 0x95, 0x31, 0x70, 0x01,
+	0x8f, 0x60, 0xda, 0x69, 0xb4, 0x28, 0xae, 0xbe, 0xdd, 0xbc, 0xf7, 0xfb, 0xf7, 0xe6, 0xe5, 0xc1,
+	0x17, 0xf1, 0x21, 0xae, 0x72, 0x29, 0x94, 0xa0, 0x22, 0x0d, 0x68, 0x2a, 0x48, 0x40, 0xb9, 0xa4,
+	0x25, 0x57, 0x88, 0x48, 0x86, 0x13, 0x26, 0xfd, 0x06, 0xb5, 0x46, 0x5d, 0xa2, 0x5f, 0x13, 0x67,
+	0x93, 0x8d, 0xd8, 0x88, 0xa6, 0x15, 0xd4, 0x2f, 0x4d, 0x9c, 0x3d, 0xbf, 0xe1, 0x98, 0x0a, 0x82,
+	0x72, 0xcc, 0x5b, 0xaf, 0xf9, 0xcf, 0x3b, 0x38, 0x5e, 0xea, 0x94, 0x50, 0x87, 0xac, 0x15, 0x56,
+	0xcc, 0xf2, 0xe1, 0x58, 0x49, 0x9e, 0xe7, 0x2c, 0x46, 0x65, 0xa6, 0x78, 0x8a, 0x48, 0x2a, 0x68,
+	0x62, 0xf7, 0x5c, 0xe0, 0x0d, 0xa2, 0x51, 0x0b, 0x7d, 0xaa, 0x91, 0xb0, 0x06, 0xac, 0x77, 0x70,
+	0x28, 0x59, 0xa1, 0x84, 0x64, 0xa8, 0x50, 0x58, 0x95, 0x85, 0x6d, 0xba, 0xc0, 0x1b, 0x2e, 0xe6,
+	0xfe, 0x3f, 0xc3, 0xfa, 0xcb, 0x54, 0x90, 0x55, 0x3d, 0xc2, 0xba, 0x61, 0x46, 0x83, 0x56, 0xa9,
+	0x4b, 0x2b, 0x81, 0xf6, 0x9e, 0x67, 0xb1, 0xd8, 0xa3, 0x1d, 0xcf, 0x90, 0x90, 0x98, 0xa6, 0x0c,
+	0xe5, 0x92, 0x53, 0x56, 0xd8, 0xf7, 0x6e, 0xcf, 0x7b, 0xbc, 0x78, 0x79, 0xcb, 0xf4, 0xea, 0x13,
+	0x1f, 0x1b, 0xd5, 0xaa, 0x16, 0x85, 0xe6, 0xf1, 0xfb, 0x33, 0x23, 0x9a, 0x6a, 0xcf, 0x0f, 0x3c,
+	0xeb, 0x60, 0x57, 0x61, 0xb8, 0xfa, 0x2b, 0xac, 0xff, 0xe0, 0x30, 0x5c, 0x75, 0xc3, 0xde, 0x9b,
+	0x8f, 0xc0, 0x93, 0xde, 0x9c, 0xc1, 0xa7, 0xff, 0xd5, 0x5b, 0x13, 0x78, 0xaf, 0x37, 0x0d, 0x9a,
+	0x4d, 0xeb, 0xc2, 0x5a, 0xc0, 0x69, 0x77, 0x34, 0x54, 0x94, 0x44, 0x71, 0x9a, 0x14, 0xf6, 0x9d,
+	0x0b, 0x3c, 0x33, 0x1a, 0x8b, 0x3f, 0x0e, 0xeb, 0x16, 0x0a, 0x57, 0xc7, 0xb3, 0x03, 0x4e, 0x67,
+	0x07, 0xfc, 0x38, 0x3b, 0xe0, 0xeb, 0xc5, 0x31, 0x4e, 0x17, 0xc7, 0xf8, 0x76, 0x71, 0x8c, 0xcf,
+	0x6f, 0x37, 0x5c, 0x6d, 0x4b, 0xe2, 0x53, 0xb1, 0x0b, 0xae, 0x2e, 0xe4, 0xcb, 0x9b, 0x57, 0x74,
+	0x8b, 0x79, 0x16, 0xfc, 0xee, 0x54, 0xfa, 0x6a, 0xd4, 0x21, 0x67, 0x05, 0xe9, 0x37, 0xed, 0xd7,
+	0xbf, 0x06, 0x00, 0xbf, 0x64, 0xef, 0x9c, 0xa9, 0x02, 0x00, 0x00,
+}
+
+func (m *CircuitBreakerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WindowMaxOraclePrices) > 0 {
+		for iNdEx := len(m.WindowMaxOraclePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowMaxOraclePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WindowMinOraclePrices) > 0 {
+		for iNdEx := len(m.WindowMinOraclePrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowMinOraclePrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RestoreStatus != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.RestoreStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrippedUntilBlock != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.TrippedUntilBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerOraclePrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerOraclePrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerOraclePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OraclePriceSubticks != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.OraclePriceSubticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreakerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrippedUntilBlock != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.TrippedUntilBlock))
+	}
+	if m.RestoreStatus != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.RestoreStatus))
+	}
+	if len(m.WindowMinOraclePrices) > 0 {
+		for _, e := range m.WindowMinOraclePrices {
+			l = e.Size()
+			n += 1 + l + sovCircuitBreaker(uint64(l))
+		}
+	}
+	if len(m.WindowMaxOraclePrices) > 0 {
+		for _, e := range m.WindowMaxOraclePrices {
+			l = e.Size()
+			n += 1 + l + sovCircuitBreaker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitBreakerOraclePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.Block))
+	}
+	if m.OraclePriceSubticks != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.OraclePriceSubticks))
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreakerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedUntilBlock", wireType)
+			}
+			m.TrippedUntilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedUntilBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreStatus", wireType)
+			}
+			m.RestoreStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoreStatus |= ClobPair_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMinOraclePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowMinOraclePrices = append(m.WindowMinOraclePrices, CircuitBreakerOraclePrice{})
+			if err := m.WindowMinOraclePrices[len(m.WindowMinOraclePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMaxOraclePrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowMaxOraclePrices = append(m.WindowMaxOraclePrices, CircuitBreakerOraclePrice{})
+			if err := m.WindowMaxOraclePrices[len(m.WindowMaxOraclePrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerOraclePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerOraclePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerOraclePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceSubticks", wireType)
+			}
+			m.OraclePriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OraclePriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,207 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerConfig_Validate(t *testing.T) {
+	validConfig := types.CircuitBreakerConfig{
+		MaxOraclePriceChangePpm: 100_000,
+		WindowBlocks:            10,
+		CooldownBlocks:          20,
+		TrippedStatus:           types.ClobPair_STATUS_CANCEL_ONLY,
+	}
+
+	tests := map[string]struct {
+		config        func() *types.CircuitBreakerConfig
+		expectedError string
+	}{
+		"nil config is valid": {
+			config: func() *types.CircuitBreakerConfig { return nil },
+		},
+		"disabled config is valid": {
+			config: func() *types.CircuitBreakerConfig { return &types.CircuitBreakerConfig{} },
+		},
+		"cancel-only tripped status is valid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				return &config
+			},
+		},
+		"post-only tripped status is valid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				config.TrippedStatus = types.ClobPair_STATUS_POST_ONLY
+				return &config
+			},
+		},
+		"zero window blocks is invalid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				config.WindowBlocks = 0
+				return &config
+			},
+			expectedError: "WindowBlocks must be between 1 and 100000",
+		},
+		"window blocks above max is invalid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				config.WindowBlocks = types.MaxCircuitBreakerBlocks + 1
+				return &config
+			},
+			expectedError: "WindowBlocks must be between 1 and 100000",
+		},
+		"zero cooldown blocks is invalid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				config.CooldownBlocks = 0
+				return &config
+			},
+			expectedError: "CooldownBlocks must be between 1 and 100000",
+		},
+		"active tripped status is invalid": {
+			config: func() *types.CircuitBreakerConfig {
+				config := validConfig
+				config.TrippedStatus = types.ClobPair_STATUS_ACTIVE
+				return &config
+			},
+			expectedError: "TrippedStatus must be",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config().Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidClobPairParameter)
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestCircuitBreakerConfig_ExceedsMaxOraclePriceChange(t *testing.T) {
+	config := &types.CircuitBreakerConfig{MaxOraclePriceChangePpm: 100_000}
+
+	tests := map[string]struct {
+		startOraclePriceSubticks uint64
+		oraclePriceSubticks      uint64
+		expected                 bool
+	}{
+		"no change": {
+			startOraclePriceSubticks: 1_000,
+			oraclePriceSubticks:      1_000,
+			expected:                 false,
+		},
+		"increase equal to max": {
+			startOraclePriceSubticks: 1_000,
+			oraclePriceSubticks:      1_100,
+			expected:                 false,
+		},
+		"increase above max": {
+			startOraclePriceSubticks: 1_000,
+			oraclePriceSubticks:      1_101,
+			expected:                 true,
+		},
+		"decrease equal to max": {
+			startOraclePriceSubticks: 1_000,
+			oraclePriceSubticks:      900,
+			expected:                 false,
+		},
+		"decrease above max": {
+			startOraclePriceSubticks: 1_000,
+			oraclePriceSubticks:      899,
+			expected:                 true,
+		},
+		"no window start price": {
+			startOraclePriceSubticks: 0,
+			oraclePriceSubticks:      1_000,
+			expected:                 false,
+		},
+		"large prices do not overflow": {
+			startOraclePriceSubticks: math.MaxUint64 / 2,
+			oraclePriceSubticks:      math.MaxUint64,
+			expected:                 true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				config.ExceedsMaxOraclePriceChange(tc.startOraclePriceSubticks, tc.oraclePriceSubticks),
+			)
+		})
+	}
+}
+
+func TestCircuitBreakerConfig_ExceedsMaxWindowOraclePriceChange(t *testing.T) {
+	config := &types.CircuitBreakerConfig{MaxOraclePriceChangePpm: 100_000}
+	state := types.CircuitBreakerState{}
+	require.False(t, config.ExceedsMaxWindowOraclePriceChange(state, 1_000))
+
+	state.AddOraclePrice(10, 1, 1_000)
+	state.AddOraclePrice(10, 2, 1_050)
+	require.False(t, config.ExceedsMaxWindowOraclePriceChange(state, 1_100))
+	require.True(t, config.ExceedsMaxWindowOraclePriceChange(state, 1_101))
+	require.False(t, config.ExceedsMaxWindowOraclePriceChange(state, 945))
+	require.True(t, config.ExceedsMaxWindowOraclePriceChange(state, 944))
+}
+
+func TestCircuitBreakerState_IsTripped(t *testing.T) {
+	require.False(t, types.CircuitBreakerState{}.IsTripped())
+	require.True(t, types.CircuitBreakerState{TrippedUntilBlock: 5}.IsTripped())
+}
+
+func TestCircuitBreakerState_AddOraclePrice(t *testing.T) {
+	state := types.CircuitBreakerState{}
+	for block, price := range []uint64{1_000, 1_200, 900, 1_100, 1_100} {
+		state.AddOraclePrice(3, uint32(block+1), price)
+	}
+	require.Equal(
+		t,
+		[]types.CircuitBreakerOraclePrice{
+			{Block: 3, OraclePriceSubticks: 900},
+			{Block: 5, OraclePriceSubticks: 1_100},
+		},
+		state.WindowMinOraclePrices,
+	)
+	require.Equal(
+		t,
+		[]types.CircuitBreakerOraclePrice{
+			{Block: 2, OraclePriceSubticks: 1_200},
+			{Block: 5, OraclePriceSubticks: 1_100},
+		},
+		state.WindowMaxOraclePrices,
+	)
+
+	// Oracle prices of blocks before the trailing window are evicted.
+	state.AddOraclePrice(3, 6, 1_000)
+	require.Equal(
+		t,
+		[]types.CircuitBreakerOraclePrice{
+			{Block: 3, OraclePriceSubticks: 900},
+			{Block: 6, OraclePriceSubticks: 1_000},
+		},
+		state.WindowMinOraclePrices,
+	)
+	require.Equal(
+		t,
+		[]types.CircuitBreakerOraclePrice{
+			{Block: 5, OraclePriceSubticks: 1_100},
+			{Block: 6, OraclePriceSubticks: 1_000},
+		},
+		state.WindowMaxOraclePrices,
+	)
+	state.AddOraclePrice(3, 7, 1_000)
+	require.Equal(
+		t,
+		[]types.CircuitBreakerOraclePrice{{Block: 7, OraclePriceSubticks: 1_000}},
+		state.WindowMinOraclePrices,
+	)
+}
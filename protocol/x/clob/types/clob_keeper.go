@@ -29,6 +29,14 @@ type ClobKeeper interface {
 		ctx sdk.Context,
 		msg *MsgBatchCancel,
 	) (success []uint32, failure []uint32, err error)
+	BatchPlaceShortTermOrder(
+		ctx sdk.Context,
+		msg *MsgBatchPlaceOrder,
+	) (success []OrderId, failure []OrderPlacementFailure, err error)
+	CancelAllShortTermOrders(
+		ctx sdk.Context,
+		msg *MsgCancelAllOrders,
+	) (canceledOrderIds []OrderId, err error)
 	CancelShortTermOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CancelStatefulOrder(ctx sdk.Context, msg *MsgCancelOrder) error
 	CreatePerpetualClobPair(
@@ -43,15 +51,48 @@ type ClobKeeper interface {
 		ClobPair,
 		error,
 	)
+	CreateSpotClobPair(
+		ctx sdk.Context,
+		clobPairId uint32,
+		baseAssetId uint32,
+		quoteAssetId uint32,
+		stepSizeInBaseQuantums satypes.BaseQuantums,
+		quantumConversionExponent int32,
+		subticksPerTick uint32,
+		status ClobPair_Status,
+	) (
+		ClobPair,
+		error,
+	)
+	HandleMsgCancelAllOrders(
+		ctx sdk.Context,
+		msg *MsgCancelAllOrders,
+	) (err error)
 	HandleMsgCancelOrder(
 		ctx sdk.Context,
 		msg *MsgCancelOrder,
 	) (err error)
+	HandleMsgHeartbeat(
+		ctx sdk.Context,
+		msg *MsgHeartbeat,
+	)
 	HandleMsgPlaceOrder(
 		ctx sdk.Context,
 		msg *MsgPlaceOrder,
 		isInternalOrder bool,
 	) (err error)
+	HandleMsgReplaceOrder(
+		ctx sdk.Context,
+		msg *MsgReplaceOrder,
+	) (err error)
+	HandleMsgResetMarketMakerProtection(
+		ctx sdk.Context,
+		msg *MsgResetMarketMakerProtection,
+	) (err error)
+	HandleMsgSetMarketMakerProtectionConfig(
+		ctx sdk.Context,
+		msg *MsgSetMarketMakerProtectionConfig,
+	) (err error)
 	GetAllClobPairs(ctx sdk.Context) (list []ClobPair)
 	GetClobPair(ctx sdk.Context, id ClobPairId) (val ClobPair, found bool)
 	HasAuthority(authority string) bool
@@ -65,6 +106,10 @@ type ClobKeeper interface {
 		msg *MsgPlaceOrder,
 		isInternalOrder bool,
 	) error
+	ReplaceStatefulOrder(
+		ctx sdk.Context,
+		msg *MsgReplaceOrder,
+	) error
 
 	PruneStateFillAmountsForShortTermOrders(
 		ctx sdk.Context,
@@ -101,6 +146,10 @@ type ClobKeeper interface {
 		ctx sdk.Context,
 		orderId OrderId,
 	) (val LongTermOrderPlacement, found bool)
+	GetAllStatefulOrdersForSubaccount(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+	) []Order
 	DeleteLongTermOrderPlacement(
 		ctx sdk.Context,
 		orderId OrderId,
@@ -141,6 +190,9 @@ type ClobKeeper interface {
 	RateLimitCancelOrder(ctx sdk.Context, order *MsgCancelOrder) error
 	RateLimitPlaceOrder(ctx sdk.Context, order *MsgPlaceOrder) error
 	RateLimitBatchCancel(ctx sdk.Context, order *MsgBatchCancel) error
+	RateLimitBatchPlaceOrder(ctx sdk.Context, order *MsgBatchPlaceOrder) error
+	RateLimitReplaceOrder(ctx sdk.Context, order *MsgReplaceOrder) error
+	RateLimitCancelAllOrders(ctx sdk.Context, msg *MsgCancelAllOrders) error
 	InitializeBlockRateLimit(ctx sdk.Context, config BlockRateLimitConfiguration) error
 	GetBlockRateLimitConfiguration(
 		ctx sdk.Context,
@@ -151,6 +203,12 @@ type ClobKeeper interface {
 		ctx sdk.Context,
 		clobPair ClobPair,
 	) error
+	SetClobPairPriceProtectionConfigs(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+		priceBandConfig *PriceBandConfig,
+		circuitBreakerConfig *CircuitBreakerConfig,
+	) error
 	UpdateLiquidationsConfig(ctx sdk.Context, config LiquidationsConfig) error
 	// Gprc streaming
 	InitializeNewGrpcStreams(ctx sdk.Context)
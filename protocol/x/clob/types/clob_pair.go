@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -11,6 +12,18 @@ import (
 // a subset of the types defined in the proto for ClobPair_Status.
 var SupportedClobPairStatusTransitions = map[ClobPair_Status]map[ClobPair_Status]struct{}{
 	ClobPair_STATUS_ACTIVE: {
+		ClobPair_STATUS_CANCEL_ONLY:      struct{}{},
+		ClobPair_STATUS_POST_ONLY:        struct{}{},
+		ClobPair_STATUS_FINAL_SETTLEMENT: struct{}{},
+	},
+	ClobPair_STATUS_CANCEL_ONLY: {
+		ClobPair_STATUS_ACTIVE:           struct{}{},
+		ClobPair_STATUS_POST_ONLY:        struct{}{},
+		ClobPair_STATUS_FINAL_SETTLEMENT: struct{}{},
+	},
+	ClobPair_STATUS_POST_ONLY: {
+		ClobPair_STATUS_ACTIVE:           struct{}{},
+		ClobPair_STATUS_CANCEL_ONLY:      struct{}{},
 		ClobPair_STATUS_FINAL_SETTLEMENT: struct{}{},
 	},
 	ClobPair_STATUS_INITIALIZING: {
@@ -73,6 +86,31 @@ func (c *ClobPair) MustGetPerpetualId() uint32 {
 	return id
 }
 
+// IsSpotClobPair returns true if the provided `clobPair` trades a spot asset pair.
+func (c *ClobPair) IsSpotClobPair() bool {
+	return c.GetSpotClobMetadata() != nil
+}
+
+// GetSpotAssetIds returns the base and quote asset ids for the provided spot `clobPair`.
+func (c *ClobPair) GetSpotAssetIds() (baseAssetId uint32, quoteAssetId uint32, err error) {
+	spotClobMetadata := c.GetSpotClobMetadata()
+	if spotClobMetadata == nil {
+		return 0, 0, ErrNotSpotClobPair
+	}
+
+	return spotClobMetadata.BaseAssetId, spotClobMetadata.QuoteAssetId, nil
+}
+
+// MustGetSpotAssetIds returns the base and quote asset ids for the provided spot `clobPair`.
+// Will panic if `GetSpotAssetIds` returns an error.
+func (c *ClobPair) MustGetSpotAssetIds() (baseAssetId uint32, quoteAssetId uint32) {
+	baseAssetId, quoteAssetId, err := c.GetSpotAssetIds()
+	if err != nil {
+		panic(err)
+	}
+	return baseAssetId, quoteAssetId
+}
+
 // GetId returns the `ClobPairId` for the provided `clobPair`.
 func (c *ClobPair) GetClobPairId() ClobPairId {
 	return ClobPairId(c.Id)
@@ -80,14 +118,31 @@ func (c *ClobPair) GetClobPairId() ClobPairId {
 
 // Stateless validation on ClobPair.
 func (c *ClobPair) Validate() error {
-	switch c.Metadata.(type) {
-	// TODO(DEC-1535): update this when additional clob pair types are supported.
+	switch metadata := c.Metadata.(type) {
 	case *ClobPair_SpotClobMetadata:
-		return errorsmod.Wrapf(
-			ErrInvalidClobPairParameter,
-			"CLOB pair (%+v) is not a perpetual CLOB.",
-			c,
-		)
+		if metadata.SpotClobMetadata == nil {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has empty spot metadata.",
+				c,
+			)
+		}
+		// Spot matches are settled and charged fees in the quote asset, which must be USDC.
+		if metadata.SpotClobMetadata.QuoteAssetId != assettypes.AssetUsdc.Id {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has unsupported quote asset %d, only USDC is supported.",
+				c,
+				metadata.SpotClobMetadata.QuoteAssetId,
+			)
+		}
+		if metadata.SpotClobMetadata.BaseAssetId == metadata.SpotClobMetadata.QuoteAssetId {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairParameter,
+				"CLOB pair (%+v) has identical base and quote assets.",
+				c,
+			)
+		}
 	}
 
 	if !IsSupportedClobPairStatus(c.Status) {
@@ -117,5 +172,13 @@ func (c *ClobPair) Validate() error {
 		)
 	}
 
+	if err := c.PriceBandConfig.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.CircuitBreakerConfig.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -34,11 +34,12 @@ const (
 	// STATUS_PAUSED behavior is unfinalized.
 	// TODO(DEC-600): update this documentation.
 	ClobPair_STATUS_PAUSED ClobPair_Status = 2
-	// STATUS_CANCEL_ONLY behavior is unfinalized.
-	// TODO(DEC-600): update this documentation.
+	// STATUS_CANCEL_ONLY represents a clob pair on which no new orders
+	// are accepted and no matches are executed. Orders may still be
+	// canceled.
 	ClobPair_STATUS_CANCEL_ONLY ClobPair_Status = 3
-	// STATUS_POST_ONLY behavior is unfinalized.
-	// TODO(DEC-600): update this documentation.
+	// STATUS_POST_ONLY represents a clob pair on which only post-only
+	// orders are accepted and no matches are executed.
 	ClobPair_STATUS_POST_ONLY ClobPair_Status = 4
 	// STATUS_INITIALIZING represents a newly-added clob pair.
 	// Clob pairs in this state only accept orders which are
@@ -79,6 +80,41 @@ func (ClobPair_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_178b475635886947, []int{2, 0}
 }
 
+// Mode determines how orders priced outside of the price band are handled.
+type PriceBandConfig_Mode int32
+
+const (
+	// Default value. This value is invalid and unused.
+	PriceBandConfig_MODE_UNSPECIFIED PriceBandConfig_Mode = 0
+	// MODE_REJECT rejects non-post-only orders which are priced beyond the
+	// price band on the aggressive side, i.e. buy orders priced above the
+	// upper bound and sell orders priced below the lower bound.
+	PriceBandConfig_MODE_REJECT PriceBandConfig_Mode = 1
+	// MODE_CLAMP accepts orders priced outside of the price band, but clamps
+	// their executions to maker orders priced within the price band.
+	PriceBandConfig_MODE_CLAMP PriceBandConfig_Mode = 2
+)
+
+var PriceBandConfig_Mode_name = map[int32]string{
+	0: "MODE_UNSPECIFIED",
+	1: "MODE_REJECT",
+	2: "MODE_CLAMP",
+}
+
+var PriceBandConfig_Mode_value = map[string]int32{
+	"MODE_UNSPECIFIED": 0,
+	"MODE_REJECT":      1,
+	"MODE_CLAMP":       2,
+}
+
+func (x PriceBandConfig_Mode) String() string {
+	return proto.EnumName(PriceBandConfig_Mode_name, int32(x))
+}
+
+func (PriceBandConfig_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_178b475635886947, []int{3, 0}
+}
+
 // PerpetualClobMetadata contains metadata for a `ClobPair`
 // representing a Perpetual product.
 type PerpetualClobMetadata struct {
@@ -191,7 +227,6 @@ type ClobPair struct {
 	// PerpetualClobMetadata, and Spot CLOBs will have SpotClobMetadata.
 	//
 	// Types that are valid to be assigned to Metadata:
-	//
 	//	*ClobPair_PerpetualClobMetadata
 	//	*ClobPair_SpotClobMetadata
 	Metadata isClobPair_Metadata `protobuf_oneof:"metadata"`
@@ -206,6 +241,14 @@ type ClobPair struct {
 	// per Subtick.
 	QuantumConversionExponent int32           `protobuf:"zigzag32,6,opt,name=quantum_conversion_exponent,json=quantumConversionExponent,proto3" json:"quantum_conversion_exponent,omitempty"`
 	Status                    ClobPair_Status `protobuf:"varint,7,opt,name=status,proto3,enum=dydxprotocol.clob.ClobPair_Status" json:"status,omitempty"`
+	// Price band of the CLOB, restricting how far away from the oracle price
+	// non-liquidation matches may be executed. Price bands are disabled if
+	// unset.
+	PriceBandConfig *PriceBandConfig `protobuf:"bytes,8,opt,name=price_band_config,json=priceBandConfig,proto3" json:"price_band_config,omitempty"`
+	// Circuit breaker of the CLOB, switching the CLOB to a restricted status
+	// when the oracle price moves too fast. The circuit breaker is disabled if
+	// unset.
+	CircuitBreakerConfig *CircuitBreakerConfig `protobuf:"bytes,9,opt,name=circuit_breaker_config,json=circuitBreakerConfig,proto3" json:"circuit_breaker_config,omitempty"`
 }
 
 func (m *ClobPair) Reset()         { *m = ClobPair{} }
@@ -313,6 +356,20 @@ func (m *ClobPair) GetStatus() ClobPair_Status {
 	return ClobPair_STATUS_UNSPECIFIED
 }
 
+func (m *ClobPair) GetPriceBandConfig() *PriceBandConfig {
+	if m != nil {
+		return m.PriceBandConfig
+	}
+	return nil
+}
+
+func (m *ClobPair) GetCircuitBreakerConfig() *CircuitBreakerConfig {
+	if m != nil {
+		return m.CircuitBreakerConfig
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ClobPair) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -321,55 +378,208 @@ func (*ClobPair) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// PriceBandConfig defines a band around the oracle price of a `ClobPair`
+// outside of which non-liquidation matches are not executed.
+type PriceBandConfig struct {
+	// The maximum deviation of the execution price of a match from the oracle
+	// price, in parts-per-million. A value of zero disables the price band.
+	BandPpm uint32               `protobuf:"varint,1,opt,name=band_ppm,json=bandPpm,proto3" json:"band_ppm,omitempty"`
+	Mode    PriceBandConfig_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=dydxprotocol.clob.PriceBandConfig_Mode" json:"mode,omitempty"`
+}
+
+func (m *PriceBandConfig) Reset()         { *m = PriceBandConfig{} }
+func (m *PriceBandConfig) String() string { return proto.CompactTextString(m) }
+func (*PriceBandConfig) ProtoMessage()    {}
+func (*PriceBandConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178b475635886947, []int{3}
+}
+func (m *PriceBandConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBandConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBandConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceBandConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBandConfig.Merge(m, src)
+}
+func (m *PriceBandConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBandConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBandConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceBandConfig proto.InternalMessageInfo
+
+func (m *PriceBandConfig) GetBandPpm() uint32 {
+	if m != nil {
+		return m.BandPpm
+	}
+	return 0
+}
+
+func (m *PriceBandConfig) GetMode() PriceBandConfig_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return PriceBandConfig_MODE_UNSPECIFIED
+}
+
+// CircuitBreakerConfig defines when the circuit breaker of a `ClobPair` is
+// tripped, and which status the `ClobPair` has while it is tripped.
+type CircuitBreakerConfig struct {
+	// The maximum change of the oracle price from the minimum or the maximum
+	// oracle price within the trailing window of blocks, in parts-per-million. A
+	// value of zero disables the circuit breaker.
+	MaxOraclePriceChangePpm uint32 `protobuf:"varint,1,opt,name=max_oracle_price_change_ppm,json=maxOraclePriceChangePpm,proto3" json:"max_oracle_price_change_ppm,omitempty"`
+	// The number of blocks in the trailing window over which the oracle price
+	// change is measured.
+	WindowBlocks uint32 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// The number of blocks after the circuit breaker is tripped before the
+	// status of the `ClobPair` is restored.
+	CooldownBlocks uint32 `protobuf:"varint,3,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	// The status of the `ClobPair` while the circuit breaker is tripped. Must be
+	// either `STATUS_CANCEL_ONLY` or `STATUS_POST_ONLY`.
+	TrippedStatus ClobPair_Status `protobuf:"varint,4,opt,name=tripped_status,json=trippedStatus,proto3,enum=dydxprotocol.clob.ClobPair_Status" json:"tripped_status,omitempty"`
+}
+
+func (m *CircuitBreakerConfig) Reset()         { *m = CircuitBreakerConfig{} }
+func (m *CircuitBreakerConfig) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerConfig) ProtoMessage()    {}
+func (*CircuitBreakerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178b475635886947, []int{4}
+}
+func (m *CircuitBreakerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerConfig.Merge(m, src)
+}
+func (m *CircuitBreakerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerConfig proto.InternalMessageInfo
+
+func (m *CircuitBreakerConfig) GetMaxOraclePriceChangePpm() uint32 {
+	if m != nil {
+		return m.MaxOraclePriceChangePpm
+	}
+	return 0
+}
+
+func (m *CircuitBreakerConfig) GetWindowBlocks() uint32 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *CircuitBreakerConfig) GetCooldownBlocks() uint32 {
+	if m != nil {
+		return m.CooldownBlocks
+	}
+	return 0
+}
+
+func (m *CircuitBreakerConfig) GetTrippedStatus() ClobPair_Status {
+	if m != nil {
+		return m.TrippedStatus
+	}
+	return ClobPair_STATUS_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.clob.ClobPair_Status", ClobPair_Status_name, ClobPair_Status_value)
+	proto.RegisterEnum("dydxprotocol.clob.PriceBandConfig_Mode", PriceBandConfig_Mode_name, PriceBandConfig_Mode_value)
 	proto.RegisterType((*PerpetualClobMetadata)(nil), "dydxprotocol.clob.PerpetualClobMetadata")
 	proto.RegisterType((*SpotClobMetadata)(nil), "dydxprotocol.clob.SpotClobMetadata")
 	proto.RegisterType((*ClobPair)(nil), "dydxprotocol.clob.ClobPair")
+	proto.RegisterType((*PriceBandConfig)(nil), "dydxprotocol.clob.PriceBandConfig")
+	proto.RegisterType((*CircuitBreakerConfig)(nil), "dydxprotocol.clob.CircuitBreakerConfig")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/clob_pair.proto", fileDescriptor_178b475635886947) }
 
 var fileDescriptor_178b475635886947 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x1c, 0xc6, 0x73, 0x01, 0xf2, 0x43, 0xc7, 0x9f, 0x9f, 0x73, 0x2d, 0x25, 0x2d, 0x52, 0x14, 0xdc,
-	0x4a, 0x44, 0x88, 0x3a, 0x12, 0xad, 0x3a, 0x30, 0x54, 0x72, 0x8c, 0x29, 0x96, 0x82, 0x71, 0x6d,
-	0x53, 0xa9, 0x2c, 0xd6, 0xd9, 0x3e, 0x15, 0x8b, 0xc4, 0x67, 0x7c, 0x67, 0x04, 0x52, 0xd7, 0x2e,
-	0x9d, 0x78, 0x1d, 0x9d, 0x2a, 0xc6, 0xbe, 0x82, 0x8e, 0x8c, 0x1d, 0x2b, 0x18, 0xfa, 0x36, 0x2a,
-	0x5f, 0x9c, 0x34, 0x24, 0x19, 0xda, 0xc5, 0xb2, 0x3e, 0xdf, 0xe7, 0xee, 0xfb, 0xdc, 0xf3, 0xc0,
-	0xf5, 0xf0, 0x32, 0xbc, 0x48, 0x52, 0xca, 0x69, 0x40, 0xbb, 0xad, 0xa0, 0x4b, 0x7d, 0xf1, 0xf1,
-	0x12, 0x1c, 0xa5, 0x8a, 0xe0, 0xa8, 0x3a, 0x2a, 0x51, 0xf2, 0xe9, 0x93, 0x2a, 0xee, 0x45, 0x31,
-	0x6d, 0x89, 0x6f, 0x5f, 0x25, 0x9f, 0xc0, 0x15, 0x8b, 0xa4, 0x09, 0xe1, 0x19, 0xee, 0x6a, 0x5d,
-	0xea, 0x1f, 0x10, 0x8e, 0x43, 0xcc, 0x31, 0x5a, 0x87, 0x8b, 0xc9, 0x60, 0xe0, 0x45, 0x61, 0x0d,
-	0x34, 0x40, 0x73, 0xc9, 0x5e, 0x18, 0x32, 0x23, 0xdc, 0xd9, 0xfa, 0xfc, 0xeb, 0xeb, 0xe6, 0xc6,
-	0xa4, 0x93, 0xa9, 0x17, 0xca, 0x9f, 0x00, 0x94, 0x9c, 0x84, 0xf2, 0x7b, 0x5b, 0x64, 0xb8, 0xe4,
-	0x63, 0x46, 0x3c, 0xcc, 0x18, 0xe1, 0x23, 0x6b, 0x72, 0xa8, 0xe6, 0xcc, 0x08, 0xd1, 0x33, 0xb8,
-	0x7c, 0x96, 0x51, 0x3e, 0x22, 0x2a, 0x0b, 0xd1, 0xa2, 0xa0, 0x85, 0x6a, 0x67, 0x23, 0x37, 0x23,
-	0x4f, 0x9a, 0x19, 0x5f, 0x29, 0x7f, 0x9b, 0x83, 0xf3, 0x39, 0xb0, 0x70, 0x94, 0xa2, 0x65, 0x58,
-	0x1e, 0x2e, 0x2d, 0x47, 0x21, 0xba, 0x02, 0x70, 0xf5, 0xcf, 0xb3, 0x45, 0xa4, 0xbd, 0xe2, 0xa0,
-	0xd8, 0xba, 0xb0, 0xdd, 0x54, 0x26, 0x72, 0x55, 0xa6, 0x3e, 0xb8, 0xbd, 0x75, 0xfd, 0x0f, 0xf1,
-	0xec, 0x97, 0xec, 0x95, 0x64, 0x6a, 0x11, 0x1f, 0x21, 0x62, 0x09, 0xe5, 0x63, 0x66, 0x66, 0x84,
-	0x99, 0xa7, 0x53, 0xcc, 0x8c, 0x3f, 0xb8, 0xbd, 0x71, 0xfd, 0x77, 0xc9, 0xec, 0x97, 0x6c, 0x89,
-	0x8d, 0x17, 0xb4, 0x05, 0x11, 0xe3, 0x24, 0xf1, 0x44, 0x4b, 0x67, 0x19, 0x8e, 0x79, 0xd6, 0x63,
-	0xb5, 0xd9, 0x06, 0x68, 0xce, 0xda, 0x52, 0x3e, 0x69, 0x63, 0x46, 0xde, 0x16, 0x1c, 0x6d, 0xc2,
-	0x2a, 0xcb, 0x7c, 0x1e, 0x05, 0xa7, 0xcc, 0x4b, 0x48, 0xea, 0xe5, 0x7f, 0xb5, 0x39, 0x91, 0xee,
-	0xff, 0x83, 0x81, 0x45, 0x52, 0x37, 0x0a, 0x4e, 0xd1, 0x6b, 0xb8, 0x56, 0xdc, 0xe7, 0x05, 0x34,
-	0x3e, 0x27, 0x29, 0x8b, 0x68, 0xec, 0x91, 0x8b, 0x84, 0xc6, 0x24, 0xe6, 0xb5, 0x4a, 0x03, 0x34,
-	0xab, 0xf6, 0xe3, 0x42, 0xa2, 0x0d, 0x15, 0x7a, 0x21, 0x40, 0x3b, 0xb0, 0xc2, 0x38, 0xe6, 0x19,
-	0xab, 0xfd, 0xd7, 0x00, 0xcd, 0xe5, 0x6d, 0x79, 0x4a, 0x16, 0x83, 0x9e, 0x15, 0x47, 0x28, 0xed,
-	0xe2, 0x84, 0xfc, 0x05, 0xc0, 0x4a, 0x1f, 0xa1, 0x47, 0x10, 0x39, 0xae, 0xea, 0x1e, 0x39, 0xde,
-	0x91, 0xe9, 0x58, 0xba, 0x66, 0xec, 0x19, 0xfa, 0xae, 0x54, 0x42, 0x55, 0xb8, 0x54, 0x70, 0x55,
-	0x73, 0x8d, 0x77, 0xba, 0x04, 0x46, 0x90, 0xa5, 0x1e, 0x39, 0xfa, 0xae, 0x54, 0x1e, 0x39, 0xad,
-	0xa9, 0xa6, 0xa6, 0x77, 0xbc, 0x43, 0xb3, 0xf3, 0x5e, 0x9a, 0x41, 0x0f, 0xa1, 0x34, 0x90, 0x1e,
-	0x3a, 0x6e, 0x9f, 0xce, 0xa2, 0x55, 0xf8, 0xa0, 0xa0, 0x86, 0x69, 0xb8, 0x86, 0xda, 0x31, 0x8e,
-	0x0d, 0xf3, 0x8d, 0x34, 0x87, 0xd6, 0xe0, 0x6a, 0x31, 0xd8, 0x33, 0x4c, 0xb5, 0xe3, 0x39, 0xba,
-	0xeb, 0x76, 0xf4, 0x03, 0xdd, 0x74, 0xa5, 0x4a, 0x1b, 0xc2, 0xf9, 0x41, 0xed, 0x6d, 0xeb, 0xfb,
-	0x6d, 0x1d, 0xdc, 0xdc, 0xd6, 0xc1, 0xcf, 0xdb, 0x3a, 0xb8, 0xba, 0xab, 0x97, 0x6e, 0xee, 0xea,
-	0xa5, 0x1f, 0x77, 0xf5, 0xd2, 0xf1, 0xab, 0x0f, 0x11, 0x3f, 0xc9, 0x7c, 0x25, 0xa0, 0xbd, 0xd6,
-	0xbd, 0xae, 0xcf, 0x5f, 0x3e, 0x0f, 0x4e, 0x70, 0x14, 0xb7, 0x86, 0xe4, 0xa2, 0xdf, 0x3f, 0xbf,
-	0x4c, 0x08, 0xf3, 0x2b, 0x02, 0xbf, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x54, 0xe8, 0x09,
-	0x52, 0x04, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0xc7, 0x45, 0x45, 0x51, 0xdc, 0xc7, 0x91, 0x44, 0x5d, 0x9d, 0x58, 0xa9, 0x01, 0xc1, 0x61,
+	0x0a, 0x48, 0x08, 0x5c, 0x19, 0x48, 0x8b, 0x0e, 0xee, 0x0b, 0x20, 0xd1, 0x4c, 0xc3, 0x42, 0x92,
+	0x59, 0x8a, 0x2e, 0xd0, 0x00, 0xc5, 0xe1, 0x48, 0x5e, 0x6d, 0xc2, 0x22, 0xef, 0x42, 0x9e, 0x62,
+	0x05, 0xe8, 0xda, 0xa5, 0x53, 0x3e, 0x46, 0xd1, 0xa9, 0xc8, 0xa7, 0xe8, 0x98, 0xb1, 0x63, 0x61,
+	0x0f, 0x45, 0x87, 0x7e, 0x87, 0x82, 0x47, 0xd2, 0x95, 0x65, 0x0e, 0xe9, 0x22, 0x88, 0xbf, 0xe7,
+	0xff, 0xbc, 0xfd, 0xef, 0x48, 0x78, 0xe8, 0xbf, 0xf2, 0x97, 0x3c, 0x66, 0x82, 0x79, 0x6c, 0xbe,
+	0xef, 0xcd, 0x99, 0x2b, 0x7f, 0x30, 0x27, 0x41, 0x3c, 0x90, 0x1c, 0xb5, 0x57, 0x25, 0x83, 0x34,
+	0xfa, 0x41, 0x9b, 0x84, 0x41, 0xc4, 0xf6, 0xe5, 0x6f, 0xa6, 0xd2, 0x4e, 0xe1, 0x9e, 0x45, 0x63,
+	0x4e, 0xc5, 0x82, 0xcc, 0xf5, 0x39, 0x73, 0x27, 0x54, 0x10, 0x9f, 0x08, 0x82, 0x1e, 0xc2, 0x5d,
+	0x5e, 0x04, 0x70, 0xe0, 0x77, 0x94, 0x5d, 0xa5, 0xdf, 0xb0, 0x37, 0xaf, 0x98, 0xe9, 0x1f, 0xec,
+	0xfd, 0xfc, 0xd7, 0x6f, 0x8f, 0x7b, 0x37, 0x27, 0x29, 0x2d, 0xa8, 0xfd, 0xa4, 0x80, 0x3a, 0xe3,
+	0x4c, 0x5c, 0xeb, 0xa2, 0x41, 0xc3, 0x25, 0x09, 0xc5, 0x24, 0x49, 0xa8, 0x58, 0x69, 0x93, 0xc2,
+	0x61, 0xca, 0x4c, 0x1f, 0x7d, 0x08, 0xcd, 0x17, 0x0b, 0x26, 0x56, 0x44, 0x55, 0x29, 0xba, 0x2b,
+	0x69, 0xae, 0x3a, 0xe8, 0xa5, 0xc3, 0x68, 0x37, 0x87, 0x59, 0x6f, 0xa9, 0xfd, 0x5d, 0x87, 0x8d,
+	0x14, 0x58, 0x24, 0x88, 0x51, 0x13, 0xaa, 0x57, 0x4d, 0xab, 0x81, 0x8f, 0x5e, 0x2b, 0xb0, 0xfd,
+	0xdf, 0xda, 0xd2, 0xd2, 0x30, 0x4f, 0x94, 0x5d, 0x37, 0x9f, 0xf4, 0x07, 0x37, 0x7c, 0x1d, 0x94,
+	0x2e, 0x3c, 0xda, 0x7b, 0xf3, 0x3f, 0xec, 0x79, 0x56, 0xb1, 0xef, 0xf1, 0xd2, 0x83, 0xf8, 0x11,
+	0x50, 0xc2, 0x99, 0x58, 0x1b, 0xe6, 0x96, 0x1c, 0xe6, 0x51, 0xc9, 0x30, 0xeb, 0x0b, 0x8f, 0x7a,
+	0x6f, 0xde, 0xcd, 0x99, 0x67, 0x15, 0x5b, 0x4d, 0xd6, 0x0f, 0x68, 0x0f, 0x50, 0x22, 0x28, 0xc7,
+	0xf2, 0x94, 0x5e, 0x2c, 0x48, 0x24, 0x16, 0x61, 0xd2, 0xa9, 0xed, 0x2a, 0xfd, 0x9a, 0xad, 0xa6,
+	0x91, 0x11, 0x49, 0xe8, 0x37, 0x39, 0x47, 0x8f, 0xa1, 0x9d, 0x2c, 0x5c, 0x11, 0x78, 0x67, 0x09,
+	0xe6, 0x34, 0xc6, 0xe9, 0xbf, 0xce, 0x6d, 0xe9, 0x6e, 0xab, 0x08, 0x58, 0x34, 0x76, 0x02, 0xef,
+	0x0c, 0x7d, 0x09, 0x3b, 0x79, 0x3d, 0xec, 0xb1, 0xe8, 0x25, 0x8d, 0x93, 0x80, 0x45, 0x98, 0x2e,
+	0x39, 0x8b, 0x68, 0x24, 0x3a, 0xf5, 0x5d, 0xa5, 0xdf, 0xb6, 0x1f, 0xe4, 0x12, 0xfd, 0x4a, 0x61,
+	0xe4, 0x02, 0x74, 0x00, 0xf5, 0x44, 0x10, 0xb1, 0x48, 0x3a, 0x77, 0x76, 0x95, 0x7e, 0xf3, 0x89,
+	0x56, 0xe2, 0x45, 0x71, 0xce, 0x83, 0x99, 0x54, 0xda, 0x79, 0x06, 0x9a, 0x42, 0x9b, 0xc7, 0x81,
+	0x47, 0xb1, 0x4b, 0x22, 0x3f, 0x6d, 0xff, 0x43, 0x70, 0xd2, 0xd9, 0x90, 0x96, 0x96, 0x95, 0xb1,
+	0x52, 0xed, 0x88, 0x44, 0xbe, 0x2e, 0x95, 0x76, 0x8b, 0x5f, 0x07, 0xe8, 0x7b, 0xb8, 0xef, 0x05,
+	0xb1, 0xb7, 0x08, 0x04, 0x76, 0x63, 0x4a, 0xce, 0x68, 0x5c, 0x14, 0x7d, 0x4f, 0x16, 0xed, 0x95,
+	0xcd, 0x96, 0x25, 0x8c, 0x32, 0x7d, 0x5e, 0x79, 0xcb, 0x2b, 0xa1, 0xda, 0xaf, 0x0a, 0xd4, 0xb3,
+	0x0d, 0xd0, 0x7d, 0x40, 0x33, 0x67, 0xe8, 0x1c, 0xcf, 0xf0, 0xf1, 0x74, 0x66, 0x19, 0xba, 0xf9,
+	0xd4, 0x34, 0x0e, 0xd5, 0x0a, 0x6a, 0x43, 0x23, 0xe7, 0x43, 0xdd, 0x31, 0xbf, 0x35, 0x54, 0x65,
+	0x05, 0x59, 0xc3, 0xe3, 0x99, 0x71, 0xa8, 0x56, 0x57, 0xb2, 0xf5, 0xe1, 0x54, 0x37, 0xc6, 0xf8,
+	0x68, 0x3a, 0xfe, 0x4e, 0xbd, 0x85, 0xb6, 0x40, 0x2d, 0xa4, 0x47, 0x33, 0x27, 0xa3, 0x35, 0xb4,
+	0x0d, 0xef, 0xe7, 0xd4, 0x9c, 0x9a, 0x8e, 0x39, 0x1c, 0x9b, 0xcf, 0xcd, 0xe9, 0x57, 0xea, 0x6d,
+	0xb4, 0x03, 0xdb, 0x79, 0xe0, 0xa9, 0x39, 0x1d, 0x8e, 0xf1, 0xcc, 0x70, 0x9c, 0xb1, 0x31, 0x31,
+	0xa6, 0x8e, 0x5a, 0x1f, 0x01, 0x6c, 0x14, 0xb7, 0x54, 0xfb, 0x45, 0x81, 0xd6, 0x9a, 0x79, 0xe8,
+	0x01, 0x6c, 0x48, 0xd7, 0x39, 0x0f, 0xf3, 0x17, 0xef, 0x4e, 0xfa, 0x6c, 0xf1, 0x10, 0x7d, 0x06,
+	0xb5, 0x90, 0xf9, 0x54, 0xbe, 0x69, 0xcd, 0x52, 0xd3, 0xd6, 0x8a, 0x0d, 0x26, 0xcc, 0xa7, 0xb6,
+	0x4c, 0xd2, 0xbe, 0x80, 0x5a, 0xfa, 0x94, 0xee, 0x32, 0x39, 0x3a, 0x34, 0xd6, 0xfc, 0x69, 0xc1,
+	0xa6, 0xa4, 0xb6, 0xf1, 0xb5, 0xa1, 0x3b, 0xaa, 0x82, 0x9a, 0x00, 0x12, 0xe8, 0xe3, 0xe1, 0xc4,
+	0x52, 0xab, 0xda, 0x3f, 0x0a, 0x6c, 0x95, 0x1d, 0x09, 0xfa, 0x1c, 0x76, 0x42, 0xb2, 0xc4, 0x2c,
+	0x26, 0xde, 0x9c, 0xe2, 0xec, 0xda, 0x78, 0xa7, 0x24, 0x3a, 0xa1, 0x2b, 0x2b, 0x6c, 0x87, 0x64,
+	0x79, 0x24, 0x15, 0x72, 0x42, 0x5d, 0xc6, 0xd3, 0x95, 0x1e, 0x41, 0xe3, 0x3c, 0x88, 0x7c, 0x76,
+	0x8e, 0xdd, 0x39, 0xf3, 0xce, 0x92, 0xe2, 0xdb, 0x95, 0xc1, 0x91, 0x64, 0xa8, 0x07, 0x2d, 0x8f,
+	0xb1, 0xb9, 0xcf, 0xce, 0xa3, 0x42, 0x76, 0x4b, 0xca, 0x9a, 0x05, 0xce, 0x85, 0x26, 0x34, 0x45,
+	0x1c, 0x70, 0x4e, 0x7d, 0x9c, 0xdf, 0xfd, 0xda, 0x3b, 0xdf, 0xfd, 0x46, 0x9e, 0x99, 0x3d, 0x8e,
+	0xac, 0xdf, 0x2f, 0xba, 0xca, 0xdb, 0x8b, 0xae, 0xf2, 0xe7, 0x45, 0x57, 0x79, 0x7d, 0xd9, 0xad,
+	0xbc, 0xbd, 0xec, 0x56, 0xfe, 0xb8, 0xec, 0x56, 0x9e, 0x7f, 0x7a, 0x12, 0x88, 0xd3, 0x85, 0x3b,
+	0xf0, 0x58, 0xb8, 0x7f, 0xed, 0xab, 0xf1, 0xf2, 0x93, 0x8f, 0xbc, 0x53, 0x12, 0x44, 0xfb, 0x57,
+	0x64, 0x99, 0x7d, 0x49, 0xc4, 0x2b, 0x4e, 0x13, 0xb7, 0x2e, 0xf1, 0xc7, 0xff, 0x0e, 0x00, 0x07,
+	0xcb, 0x98, 0x4b, 0x9c, 0x06, 0x00, 0x00,
 }
 
 func (m *PerpetualClobMetadata) Marshal() (dAtA []byte, err error) {
@@ -453,6 +663,30 @@ func (m *ClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CircuitBreakerConfig != nil {
+		{
+			size, err := m.CircuitBreakerConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClobPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PriceBandConfig != nil {
+		{
+			size, err := m.PriceBandConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClobPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Status != 0 {
 		i = encodeVarintClobPair(dAtA, i, uint64(m.Status))
 		i--
@@ -532,6 +766,82 @@ func (m *ClobPair_SpotClobMetadata) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	}
 	return len(dAtA) - i, nil
 }
+func (m *PriceBandConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBandConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBandConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BandPpm != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.BandPpm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrippedStatus != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.TrippedStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CooldownBlocks != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.CooldownBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxOraclePriceChangePpm != 0 {
+		i = encodeVarintClobPair(dAtA, i, uint64(m.MaxOraclePriceChangePpm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClobPair(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClobPair(v)
 	base := offset
@@ -594,6 +904,14 @@ func (m *ClobPair) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovClobPair(uint64(m.Status))
 	}
+	if m.PriceBandConfig != nil {
+		l = m.PriceBandConfig.Size()
+		n += 1 + l + sovClobPair(uint64(l))
+	}
+	if m.CircuitBreakerConfig != nil {
+		l = m.CircuitBreakerConfig.Size()
+		n += 1 + l + sovClobPair(uint64(l))
+	}
 	return n
 }
 
@@ -621,6 +939,41 @@ func (m *ClobPair_SpotClobMetadata) Size() (n int) {
 	}
 	return n
 }
+func (m *PriceBandConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BandPpm != 0 {
+		n += 1 + sovClobPair(uint64(m.BandPpm))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovClobPair(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *CircuitBreakerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxOraclePriceChangePpm != 0 {
+		n += 1 + sovClobPair(uint64(m.MaxOraclePriceChangePpm))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovClobPair(uint64(m.WindowBlocks))
+	}
+	if m.CooldownBlocks != 0 {
+		n += 1 + sovClobPair(uint64(m.CooldownBlocks))
+	}
+	if m.TrippedStatus != 0 {
+		n += 1 + sovClobPair(uint64(m.TrippedStatus))
+	}
+	return n
+}
 
 func sovClobPair(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -981,6 +1334,292 @@ func (m *ClobPair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBandConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceBandConfig == nil {
+				m.PriceBandConfig = &PriceBandConfig{}
+			}
+			if err := m.PriceBandConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreakerConfig == nil {
+				m.CircuitBreakerConfig = &CircuitBreakerConfig{}
+			}
+			if err := m.CircuitBreakerConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClobPair(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceBandConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClobPair
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceBandConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceBandConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandPpm", wireType)
+			}
+			m.BandPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BandPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= PriceBandConfig_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClobPair(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClobPair
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClobPair
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOraclePriceChangePpm", wireType)
+			}
+			m.MaxOraclePriceChangePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOraclePriceChangePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownBlocks", wireType)
+			}
+			m.CooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedStatus", wireType)
+			}
+			m.TrippedStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedStatus |= ClobPair_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClobPair(dAtA[iNdEx:])
@@ -38,11 +38,27 @@ func TestGetPerpetualId(t *testing.T) {
 	require.ErrorIs(t, types.ErrAssetOrdersNotImplemented, err)
 }
 
+func TestGetSpotAssetIds(t *testing.T) {
+	baseAssetId, quoteAssetId, err := constants.ClobPair_Spot_Btc.GetSpotAssetIds()
+	require.Equal(t, uint32(1), baseAssetId)
+	require.Equal(t, uint32(0), quoteAssetId)
+	require.NoError(t, err)
+	require.True(t, constants.ClobPair_Spot_Btc.IsSpotClobPair())
+
+	baseAssetId, quoteAssetId, err = constants.ClobPair_Eth.GetSpotAssetIds()
+	require.Equal(t, uint32(0), baseAssetId)
+	require.Equal(t, uint32(0), quoteAssetId)
+	require.ErrorIs(t, err, types.ErrNotSpotClobPair)
+	require.False(t, constants.ClobPair_Eth.IsSpotClobPair())
+}
+
 func TestIsSupportedClobPairStatus_Supported(t *testing.T) {
-	// these are the only two supported statuses
+	// these are the only supported statuses
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_ACTIVE))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_INITIALIZING))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_FINAL_SETTLEMENT))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_CANCEL_ONLY))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_POST_ONLY))
 }
 
 func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
@@ -52,8 +68,6 @@ func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
 	// these are part of the ClobPair_Status enum but are not supported
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_UNSPECIFIED))
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_PAUSED))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_CANCEL_ONLY))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_POST_ONLY))
 }
 
 func TestIsSupportedClobPairStatusTransition_Supported(t *testing.T) {
@@ -69,6 +83,18 @@ func TestIsSupportedClobPairStatusTransition_Supported(t *testing.T) {
 	require.True(t, types.IsSupportedClobPairStatusTransition(
 		types.ClobPair_STATUS_FINAL_SETTLEMENT, types.ClobPair_STATUS_INITIALIZING,
 	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_ACTIVE, types.ClobPair_STATUS_CANCEL_ONLY,
+	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_ACTIVE, types.ClobPair_STATUS_POST_ONLY,
+	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_CANCEL_ONLY, types.ClobPair_STATUS_ACTIVE,
+	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_POST_ONLY, types.ClobPair_STATUS_ACTIVE,
+	))
 }
 
 func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
@@ -108,7 +134,9 @@ func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
 			case int32(types.ClobPair_STATUS_ACTIVE):
 				{
 					switch toClobPairStatus {
-					case int32(types.ClobPair_STATUS_FINAL_SETTLEMENT):
+					case int32(types.ClobPair_STATUS_CANCEL_ONLY),
+						int32(types.ClobPair_STATUS_POST_ONLY),
+						int32(types.ClobPair_STATUS_FINAL_SETTLEMENT):
 						continue
 					default:
 						require.Equal(
@@ -137,6 +165,30 @@ func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
 						)
 					}
 				}
+			case int32(types.ClobPair_STATUS_CANCEL_ONLY), int32(types.ClobPair_STATUS_POST_ONLY):
+				{
+					switch toClobPairStatus {
+					case int32(types.ClobPair_STATUS_ACTIVE),
+						int32(types.ClobPair_STATUS_CANCEL_ONLY),
+						int32(types.ClobPair_STATUS_POST_ONLY),
+						int32(types.ClobPair_STATUS_FINAL_SETTLEMENT):
+						require.True(
+							t,
+							types.IsSupportedClobPairStatusTransition(
+								types.ClobPair_Status(fromClobPairStatus),
+								types.ClobPair_Status(toClobPairStatus),
+							),
+						)
+					default:
+						require.False(
+							t,
+							types.IsSupportedClobPairStatusTransition(
+								types.ClobPair_Status(fromClobPairStatus),
+								types.ClobPair_Status(toClobPairStatus),
+							),
+						)
+					}
+				}
 			default:
 				require.False(
 					t,
@@ -12,6 +12,35 @@ const ShortBlockWindow uint32 = 20
 // can have in one Msg.
 const MaxMsgBatchCancelBatchSize uint32 = 100
 
+// MaxMsgBatchPlaceOrderBatchSize represents the maximum number of orders that a MsgBatchPlaceOrder
+// can have in one Msg.
+const MaxMsgBatchPlaceOrderBatchSize uint32 = 50
+
+// MaxOrderGroupSize represents the maximum number of stateful orders that can be part of an order group
+// at the same time.
+const MaxOrderGroupSize uint32 = 3
+
+// MinTwapOrderInterval and MaxTwapOrderInterval represent the bounds of the interval in seconds between
+// the suborders of a TWAP order.
+const (
+	MinTwapOrderInterval uint32 = 30
+	MaxTwapOrderInterval uint32 = 60 * 60 // 1 hour.
+)
+
+// MaxTwapOrderDuration represents the maximum duration in seconds of a TWAP order.
+const MaxTwapOrderDuration uint32 = 24 * 60 * 60 // 24 hours.
+
+// MaxHeartbeatTimeoutSeconds represents the maximum timeout in seconds of the dead man's switch of a subaccount.
+const MaxHeartbeatTimeoutSeconds uint32 = 24 * 60 * 60 // 24 hours.
+
+// MaxMarketMakerProtectionBlocks represents the maximum number of blocks of the window and the freeze
+// interval of market-maker protection.
+const MaxMarketMakerProtectionBlocks uint32 = 100_000
+
+// MaxCircuitBreakerBlocks represents the maximum number of blocks of the window and the cooldown of the
+// circuit breaker of a clob pair.
+const MaxCircuitBreakerBlocks uint32 = 100_000
+
 // StatefulOrderTimeWindow represents the maximum amount of time in seconds past the current block time that a
 // long-term/conditional `MsgPlaceOrder` message will be considered valid by the validator.
 const StatefulOrderTimeWindow time.Duration = 95 * 24 * time.Hour // 95 days.
@@ -26,3 +55,10 @@ const StatefulOrderTimeWindow time.Duration = 95 * 24 * time.Hour // 95 days.
 //
 //	lower_bound = (1 - min_price_change_ppm / 1_000_000 * conditional_order_trigger_multiplier) * oracle_price
 const ConditionalOrderTriggerMultiplier uint64 = 25
+
+// DefaultOrderbookQueryDepth and MaxOrderbookQueryDepth represent the default and maximum number of price
+// levels per side of the orderbook returned by the orderbook query.
+const (
+	DefaultOrderbookQueryDepth uint32 = 20
+	MaxOrderbookQueryDepth     uint32 = 1_000
+)
@@ -221,6 +221,66 @@ var (
 		47,
 		"CLOB has not been initialized",
 	)
+	ErrInvalidSelfTradePreventionMode = errorsmod.Register(
+		ModuleName,
+		48,
+		"invalid self-trade prevention mode",
+	)
+	ErrNotSpotClobPair = errorsmod.Register(
+		ModuleName,
+		49,
+		"CLOB pair is not a spot CLOB pair",
+	)
+	ErrSpotAssetsAssociatedWithExistingClobPair = errorsmod.Register(
+		ModuleName,
+		50,
+		"spot base and quote assets are already associated with an existing CLOB pair",
+	)
+	ErrOrderTypeNotSupportedForSpotClobPair = errorsmod.Register(
+		ModuleName,
+		51,
+		"order type is not supported for spot CLOB pairs",
+	)
+	ErrInvalidBatchPlaceOrder = errorsmod.Register(
+		ModuleName,
+		52,
+		"Invalid batch place order message",
+	)
+	ErrBatchPlaceOrderFailed = errorsmod.Register(
+		ModuleName,
+		53,
+		"Batch place order has failed",
+	)
+	ErrInvalidCancelAllOrders = errorsmod.Register(
+		ModuleName,
+		54,
+		"Invalid cancel all orders message",
+	)
+	ErrInvalidHeartbeat = errorsmod.Register(
+		ModuleName,
+		55,
+		"Invalid heartbeat message",
+	)
+	ErrInvalidMarketMakerProtectionConfig = errorsmod.Register(
+		ModuleName,
+		56,
+		"Invalid market-maker protection config",
+	)
+	ErrMarketMakerProtectionTripped = errorsmod.Register(
+		ModuleName,
+		57,
+		"Market-maker protection of the subaccount is tripped on the clob pair",
+	)
+	ErrOrderOutsidePriceBand = errorsmod.Register(
+		ModuleName,
+		58,
+		"Order is priced outside of the price band of the clob pair",
+	)
+	ErrFillOutsidePriceBand = errorsmod.Register(
+		ModuleName,
+		59,
+		"Match is executed outside of the price band of the clob pair",
+	)
 
 	// Liquidations errors.
 	ErrInvalidLiquidationsConfig = errorsmod.Register(
@@ -423,6 +483,11 @@ var (
 		3010,
 		"Stateful order cancellation failed because the order was already removed from state",
 	)
+	ErrInvalidStatefulOrderReplacement = errorsmod.Register(
+		ModuleName,
+		3011,
+		"Stateful order replacement is invalid",
+	)
 
 	// Operations Queue validation errors
 	ErrInvalidMsgProposedOperations = errorsmod.Register(
@@ -499,6 +564,30 @@ var (
 		6002,
 		"Conditional order is untriggered",
 	)
+	ErrInvalidTrailingOffset = errorsmod.Register(
+		ModuleName,
+		6003,
+		"Trailing stop order trailing offset is invalid",
+	)
+
+	// Order group errors.
+	ErrInvalidOrderGroup = errorsmod.Register(
+		ModuleName,
+		7000,
+		"Order group is invalid",
+	)
+	ErrOrderGroupFull = errorsmod.Register(
+		ModuleName,
+		7001,
+		"Order group contains the maximum number of orders",
+	)
+
+	// TWAP order errors.
+	ErrInvalidTwapOrder = errorsmod.Register(
+		ModuleName,
+		8000,
+		"TWAP order is invalid",
+	)
 
 	// Errors for unimplemented and disabled functionality.
 	ErrAssetOrdersNotImplemented = errorsmod.Register(
@@ -545,4 +634,9 @@ var (
 		11001,
 		"Invalid gRPC streaming request",
 	)
+	ErrGrpcStreamingSubscriptionBufferFull = errorsmod.Register(
+		ModuleName,
+		11002,
+		"gRPC streaming subscription buffer is full",
+	)
 )
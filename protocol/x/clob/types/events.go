@@ -10,7 +10,8 @@ import (
 
 // CLOB module event types.
 const (
-	EventTypeMatch = "match"
+	EventTypeMatch     = "match"
+	EventTypeSpotMatch = "spot_match"
 
 	AttributeKeyTakerSubaccount                         = "taker_subaccount"
 	AttributeKeyTakerSubaccountNumber                   = "taker_subaccount_number"
@@ -26,6 +27,9 @@ const (
 	AttributeKeyIsLiquidation                           = "is_liquidation"
 	AttributeKeyIsDeleverage                            = "is_deleverage"
 	AttributeKeyPerpetualId                             = "perpetual_id"
+	AttributeKeyMakerBaseBalanceDeltaBaseQuantums       = "maker_base_balance_delta_base_quantums"
+	AttributeKeyTakerBaseBalanceDeltaBaseQuantums       = "taker_base_balance_delta_base_quantums"
+	AttributeKeyBaseAssetId                             = "base_asset_id"
 )
 
 // NewCreateMatchEvent constructs a new match sdk.Event.
@@ -61,3 +65,31 @@ func NewCreateMatchEvent(
 		sdk.NewAttribute(AttributeKeyPerpetualId, fmt.Sprint(perpetualId)),
 	)
 }
+
+// NewCreateSpotMatchEvent constructs a new spot match sdk.Event.
+func NewCreateSpotMatchEvent(
+	taker satypes.SubaccountId,
+	maker satypes.SubaccountId,
+	takerOrderFee *big.Int,
+	makerOrderFee *big.Int,
+	takerQuoteBalanceDelta *big.Int,
+	makerQuoteBalanceDelta *big.Int,
+	takerBaseBalanceDelta *big.Int,
+	makerBaseBalanceDelta *big.Int,
+	baseAssetId uint32,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeSpotMatch,
+		sdk.NewAttribute(AttributeKeyTakerSubaccount, taker.Owner),
+		sdk.NewAttribute(AttributeKeyTakerSubaccountNumber, fmt.Sprint(taker.Number)),
+		sdk.NewAttribute(AttributeKeyMakerSubaccount, maker.Owner),
+		sdk.NewAttribute(AttributeKeyMakerSubaccountNumber, fmt.Sprint(maker.Number)),
+		sdk.NewAttribute(AttributeKeyTakerOrderFeeQuoteQuantums, fmt.Sprint(takerOrderFee)),
+		sdk.NewAttribute(AttributeKeyMakerOrderFeeQuoteQuantums, fmt.Sprint(makerOrderFee)),
+		sdk.NewAttribute(AttributeKeyTakerQuoteBalanceDeltaQuoteQuantums, takerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerQuoteBalanceDeltaQuoteQuantums, makerQuoteBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyTakerBaseBalanceDeltaBaseQuantums, takerBaseBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyMakerBaseBalanceDeltaBaseQuantums, makerBaseBalanceDelta.String()),
+		sdk.NewAttribute(AttributeKeyBaseAssetId, fmt.Sprint(baseAssetId)),
+	)
+}
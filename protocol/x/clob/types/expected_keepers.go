@@ -32,6 +32,15 @@ type SubaccountsKeeper interface {
 		bigMaintenanceMargin *big.Int,
 		err error,
 	)
+	GetNetCollateralAndMarginRequirementsAtRiskPrices(
+		ctx sdk.Context,
+		update satypes.Update,
+	) (
+		bigNetCollateral *big.Int,
+		bigInitialMargin *big.Int,
+		bigMaintenanceMargin *big.Int,
+		err error,
+	)
 	GetSubaccount(
 		ctx sdk.Context,
 		id satypes.SubaccountId,
@@ -43,6 +52,20 @@ type SubaccountsKeeper interface {
 	) (
 		list []satypes.Subaccount,
 	)
+	GetAdlRankedSubaccountIds(
+		ctx sdk.Context,
+		perpetualId uint32,
+		isLong bool,
+		limit uint32,
+	) []satypes.SubaccountId
+	GetStreamSubaccountUpdate(
+		ctx sdk.Context,
+		id satypes.SubaccountId,
+	) satypes.StreamSubaccountUpdate
+	SendSubaccountUpdates(
+		ctx sdk.Context,
+		subaccountUpdates []satypes.StreamSubaccountUpdate,
+	)
 	GetRandomSubaccount(
 		ctx sdk.Context,
 		rand *rand.Rand,
@@ -65,6 +88,7 @@ type SubaccountsKeeper interface {
 		blockHeight uint32,
 	) error
 	TransferFeesToFeeCollectorModule(ctx sdk.Context, assetId uint32, amount *big.Int, perpetualId uint32) error
+	TransferSpotFeesToFeeCollectorModule(ctx sdk.Context, assetId uint32, amount *big.Int) error
 	TransferInsuranceFundPayments(
 		ctx sdk.Context,
 		amount *big.Int,
@@ -122,6 +146,23 @@ type PerpetualsKeeper interface {
 		bigMaintenanceMarginQuoteQuantums *big.Int,
 		err error,
 	)
+	GetNetCollateralAtRiskPrice(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigNetCollateralQuoteQuantums *big.Int,
+		err error,
+	)
+	GetMarginRequirementsAtRiskPrice(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigInitialMarginQuoteQuantums *big.Int,
+		bigMaintenanceMarginQuoteQuantums *big.Int,
+		err error,
+	)
 	GetPerpetual(
 		ctx sdk.Context,
 		id uint32,
@@ -130,6 +171,10 @@ type PerpetualsKeeper interface {
 		ctx sdk.Context,
 		perpetualId uint32,
 	) (perpetualsmoduletypes.Perpetual, pricestypes.MarketPrice, error)
+	GetPerpetualAndRiskPrice(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (perpetualsmoduletypes.Perpetual, pricestypes.MarketPrice, error)
 	GetSettlementPpm(
 		ctx sdk.Context,
 		perpetualId uint32,
@@ -146,6 +191,7 @@ type PerpetualsKeeper interface {
 
 type PricesKeeper interface {
 	GetMarketParam(ctx sdk.Context, id uint32) (param pricestypes.MarketParam, exists bool)
+	GetMarketPrice(ctx sdk.Context, id uint32) (pricestypes.MarketPrice, error)
 }
 
 type StatsKeeper interface {
@@ -152,3 +152,38 @@ func NewOrderRemovalInternalOperation(
 		},
 	}
 }
+
+// NewSelfTradeOrderRemovalInternalOperation returns a new operation for removing an order due to self-trade
+// prevention, where `takerOrderId` is the id of the taker order of the self trade. If `decrementQuantums` is
+// non-zero, the maker order is decremented by `decrementQuantums` instead of being removed.
+// This function panics if it's called with an OrderId for a non stateful order or the removal reason is not
+// a self-trade removal reason.
+func NewSelfTradeOrderRemovalInternalOperation(
+	orderId OrderId,
+	removalReason OrderRemoval_RemovalReason,
+	takerOrderId OrderId,
+	decrementQuantums uint64,
+) InternalOperation {
+	orderId.MustBeStatefulOrder()
+
+	if !removalReason.IsSelfTrade() {
+		panic(
+			fmt.Sprintf(
+				"NewSelfTradeOrderRemovalInternalOperation: removal reason %v is not a self-trade removal reason",
+				removalReason,
+			),
+		)
+	}
+
+	orderRemoval := OrderRemoval{
+		OrderId:               orderId,
+		RemovalReason:         removalReason,
+		SelfTradeTakerOrderId: &takerOrderId,
+		DecrementQuantums:     decrementQuantums,
+	}
+	return InternalOperation{
+		Operation: &InternalOperation_OrderRemoval{
+			OrderRemoval: &orderRemoval,
+		},
+	}
+}
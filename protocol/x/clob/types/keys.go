@@ -18,13 +18,13 @@ const (
 // Below key prefixes are not explicitly used to read/write to state, but rather used to iterate over
 // certain groups of items stored in state.
 const (
-	// StatefulOrderKeyPrefix is the prefix key for all long term orders and all conditional orders,
-	// both triggered and untriggered.
+	// StatefulOrderKeyPrefix is the prefix key for all long term orders, all conditional orders,
+	// both triggered and untriggered, and all TWAP orders and TWAP suborders.
 	StatefulOrderKeyPrefix = "SO/"
 
-	// PlacedStatefulOrderKeyPrefix is the prefix key for placed long term orders and triggered
-	// conditional orders. It represents all stateful orders that should be placed upon the memclob
-	// during app start up.
+	// PlacedStatefulOrderKeyPrefix is the prefix key for placed long term orders, triggered
+	// conditional orders and TWAP suborders. It represents all stateful orders that should be placed
+	// upon the memclob during app start up.
 	PlacedStatefulOrderKeyPrefix = StatefulOrderKeyPrefix + "P/"
 
 	// PrunableOrdersKeyPrefix is the prefix key for orders prunable at a certain height.
@@ -56,6 +56,35 @@ const (
 	// StatefulOrdersTimeSlicePrefix is the key to retrieve a unique list of the stateful orders that
 	// expire at a given timestamp, sorted by order ID.
 	StatefulOrdersTimeSlicePrefix = "ExpTm:"
+
+	// OrderGroupKeyPrefix is the prefix to retrieve the order ids of an order group of a subaccount.
+	OrderGroupKeyPrefix = "OrdGrp:"
+
+	// TwapOrderStateKeyPrefix is the prefix to retrieve the execution progress of a TWAP order.
+	TwapOrderStateKeyPrefix = "TwapSt:"
+
+	// TwapOrdersTimeSlicePrefix is the key to retrieve a unique list of the TWAP orders that generate
+	// their next suborder at a given timestamp, sorted by order ID.
+	TwapOrdersTimeSlicePrefix = "TwapTm:"
+
+	// SubaccountHeartbeatKeyPrefix is the prefix to retrieve the dead man's switch of a subaccount.
+	SubaccountHeartbeatKeyPrefix = "Hb:"
+
+	// HeartbeatsTimeSlicePrefix is the key to retrieve a unique list of the subaccounts whose heartbeat
+	// expires at a given timestamp, sorted by subaccount ID.
+	HeartbeatsTimeSlicePrefix = "HbTm:"
+
+	// MarketMakerProtectionConfigKeyPrefix is the prefix to retrieve the market-maker protection settings
+	// of a subaccount on a clob pair.
+	MarketMakerProtectionConfigKeyPrefix = "MmpCfg:"
+
+	// MarketMakerProtectionStateKeyPrefix is the prefix to retrieve the maker fills of a subaccount on a
+	// clob pair within the current market-maker protection window.
+	MarketMakerProtectionStateKeyPrefix = "MmpSt:"
+
+	// CircuitBreakerStateKeyPrefix is the prefix to retrieve the oracle price change of a clob pair
+	// within the current circuit breaker window.
+	CircuitBreakerStateKeyPrefix = "CbSt:"
 )
 
 // Store / Memstore
@@ -71,6 +100,14 @@ const (
 	// UntriggeredConditionalOrderKeyPrefix is the key to retrieve an untriggered conditional order and
 	// information about when it was placed.
 	UntriggeredConditionalOrderKeyPrefix = StatefulOrderKeyPrefix + "U:"
+
+	// TwapOrderPlacementKeyPrefix is the key to retrieve a TWAP order and information about when it
+	// was placed. TWAP orders are never placed on the memclob.
+	TwapOrderPlacementKeyPrefix = StatefulOrderKeyPrefix + "W:"
+
+	// TwapSuborderPlacementKeyPrefix is the key to retrieve a suborder generated by a TWAP order and
+	// information about when it was generated.
+	TwapSuborderPlacementKeyPrefix = PlacedStatefulOrderKeyPrefix + "W:"
 )
 
 // Memstore
@@ -26,12 +26,17 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Fill:", types.OrderAmountFilledKeyPrefix)
 	require.Equal(t, "ExpHt:", types.LegacyBlockHeightToPotentiallyPrunableOrdersPrefix)
 	require.Equal(t, "ExpTm:", types.StatefulOrdersTimeSlicePrefix)
+	require.Equal(t, "OrdGrp:", types.OrderGroupKeyPrefix)
+	require.Equal(t, "TwapSt:", types.TwapOrderStateKeyPrefix)
+	require.Equal(t, "TwapTm:", types.TwapOrdersTimeSlicePrefix)
 }
 
 func TestStoreAndMemstoreKeys(t *testing.T) {
 	require.Equal(t, "SO/P/T:", types.TriggeredConditionalOrderKeyPrefix)
 	require.Equal(t, "SO/P/L:", types.LongTermOrderPlacementKeyPrefix)
 	require.Equal(t, "SO/U:", types.UntriggeredConditionalOrderKeyPrefix)
+	require.Equal(t, "SO/W:", types.TwapOrderPlacementKeyPrefix)
+	require.Equal(t, "SO/P/W:", types.TwapSuborderPlacementKeyPrefix)
 
 	require.Equal(t, "NumSO:", types.StatefulOrderCountPrefix)
 	require.Equal(t, "ProposerEvents", types.ProcessProposerMatchesEventsKey)
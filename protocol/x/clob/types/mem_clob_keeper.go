@@ -97,6 +97,14 @@ type MemClobKeeper interface {
 		deltaQuantumsRemaining *big.Int,
 	)
 	GetIndexerEventManager() indexer_manager.IndexerEventManager
+	GetPriceBandSubticks(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+	) (
+		lowerSubticks uint64,
+		upperSubticks uint64,
+		enabled bool,
+	)
 	IsLiquidatable(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
@@ -104,6 +112,11 @@ type MemClobKeeper interface {
 		bool,
 		error,
 	)
+	IsMarketMakerProtectionTripped(
+		ctx sdk.Context,
+		subaccountId satypes.SubaccountId,
+		clobPairId ClobPairId,
+	) bool
 	ValidateSubaccountEquityTierLimitForShortTermOrder(
 		ctx sdk.Context,
 		order Order,
@@ -35,6 +35,7 @@ type MemClob interface {
 	) (
 		[]InternalOperation,
 		map[OrderHash][]byte,
+		map[OrderHash]uint32,
 	)
 
 	GetOperationsRaw(
@@ -71,6 +72,10 @@ type MemClob interface {
 		ctx sdk.Context,
 		order Order,
 	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
+	ReplaceStatefulOrder(
+		ctx sdk.Context,
+		order Order,
+	) (satypes.BaseQuantums, OrderStatus, *OffchainUpdates, error)
 	PlacePerpetualLiquidation(
 		ctx sdk.Context,
 		liquidationOrder LiquidationOrder,
@@ -118,6 +123,7 @@ type MemClob interface {
 		ctx sdk.Context,
 		localOperations []InternalOperation,
 		shortTermOrderTxBytes map[OrderHash][]byte,
+		shortTermOrderBatchIndexes map[OrderHash]uint32,
 		existingOffchainUpdates *OffchainUpdates,
 	) (offchainUpdates *OffchainUpdates)
 	SetMemclobGauges(
@@ -141,6 +147,15 @@ type MemClob interface {
 		ctx sdk.Context,
 		clobPairId ClobPairId,
 	) (offchainUpdates *OffchainUpdates)
+	GetOrderbookSnapshot(
+		ctx sdk.Context,
+		clobPairId ClobPairId,
+	) (snapshot OrderbookSnapshot)
+	CloneFromOrderbookSnapshot(
+		ctx sdk.Context,
+		clobPair ClobPair,
+		snapshot OrderbookSnapshot,
+	) MemClob
 	GetOrderbookUpdatesForOrderPlacement(
 		ctx sdk.Context,
 		order Order,
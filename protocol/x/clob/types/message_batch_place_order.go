@@ -0,0 +1,104 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgBatchPlaceOrder{}
+
+// batchOrderIndexKey is the context key for the index of the Short-Term order being placed in the
+// `MsgBatchPlaceOrder` of the transaction.
+type batchOrderIndexKey struct{}
+
+// NewMsgBatchPlaceOrder constructs a MsgBatchPlaceOrder.
+func NewMsgBatchPlaceOrder(
+	subaccountId satypes.SubaccountId,
+	orders []Order,
+) *MsgBatchPlaceOrder {
+	return &MsgBatchPlaceOrder{
+		SubaccountId:    subaccountId,
+		ShortTermOrders: orders,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgBatchPlaceOrder` msg.
+func (msg *MsgBatchPlaceOrder) ValidateBasic() (err error) {
+	subaccountId := msg.GetSubaccountId()
+	if err := subaccountId.Validate(); err != nil {
+		return err
+	}
+
+	orders := msg.GetShortTermOrders()
+	if len(orders) == 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchPlaceOrder,
+			"Batch place order cannot have zero orders specified.",
+		)
+	}
+	if uint32(len(orders)) > MaxMsgBatchPlaceOrderBatchSize {
+		return errorsmod.Wrapf(
+			ErrInvalidBatchPlaceOrder,
+			"Batch place order cannot have over %+v orders. Order count: %+v",
+			MaxMsgBatchPlaceOrderBatchSize,
+			len(orders),
+		)
+	}
+
+	seenOrderIds := make(map[OrderId]struct{}, len(orders))
+	for i, order := range orders {
+		if order.OrderId.SubaccountId != subaccountId {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrder,
+				"Order %+v at index %d does not belong to subaccount %+v",
+				order.OrderId,
+				i,
+				subaccountId,
+			)
+		}
+		if !order.OrderId.IsShortTermOrder() {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrder,
+				"Batch place order can only place short term orders. Order %+v at index %d is not short term.",
+				order.OrderId,
+				i,
+			)
+		}
+
+		// Check for duplicate order ids across the batch.
+		if _, seen := seenOrderIds[order.OrderId]; seen {
+			return errorsmod.Wrapf(
+				ErrInvalidBatchPlaceOrder,
+				"Batch place order has duplicate order ids: %+v",
+				order.OrderId,
+			)
+		}
+		seenOrderIds[order.OrderId] = struct{}{}
+
+		if err := NewMsgPlaceOrder(order).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "Invalid order at index %d", i)
+		}
+	}
+	return nil
+}
+
+// WithBatchOrderIndex returns a copy of `ctx` indicating that the Short-Term order being placed is the
+// order at `orderIndex` of the `MsgBatchPlaceOrder` in `ctx.TxBytes()`.
+func WithBatchOrderIndex(ctx sdk.Context, orderIndex uint32) sdk.Context {
+	return ctx.WithValue(batchOrderIndexKey{}, orderIndex)
+}
+
+// WithoutBatchOrderIndex returns a copy of `ctx` indicating that the Short-Term order being placed is
+// the only order of the `MsgPlaceOrder` in `ctx.TxBytes()`.
+func WithoutBatchOrderIndex(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(batchOrderIndexKey{}, nil)
+}
+
+// GetBatchOrderIndex returns the index of the Short-Term order being placed in the `MsgBatchPlaceOrder`
+// in `ctx.TxBytes()`. Returns false if the order is not being placed as part of a `MsgBatchPlaceOrder`.
+func GetBatchOrderIndex(ctx sdk.Context) (orderIndex uint32, isBatchOrder bool) {
+	orderIndex, isBatchOrder = ctx.Value(batchOrderIndexKey{}).(uint32)
+	return orderIndex, isBatchOrder
+}
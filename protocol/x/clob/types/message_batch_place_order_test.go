@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchPlaceOrder_ValidateBasic(t *testing.T) {
+	oneOverMax := []types.Order{}
+	for i := uint32(0); i < types.MaxMsgBatchPlaceOrderBatchSize+1; i++ {
+		order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+		order.OrderId.ClientId = i
+		oneOverMax = append(oneOverMax, order)
+	}
+
+	invalidOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	invalidOrder.Quantums = 0
+
+	tests := map[string]struct {
+		msg types.MsgBatchPlaceOrder
+		err error
+	}{
+		"invalid subaccount": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.InvalidSubaccountIdNumber,
+				[]types.Order{
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				},
+			),
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"zero orders in batch": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{},
+			),
+			err: types.ErrInvalidBatchPlaceOrder,
+		},
+		"over 50 orders in batch": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				oneOverMax,
+			),
+			err: types.ErrInvalidBatchPlaceOrder,
+		},
+		"order belongs to a different subaccount": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+					constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price10_GTB20,
+				},
+			),
+			err: types.ErrInvalidBatchPlaceOrder,
+		},
+		"stateful order in batch": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{
+					constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20,
+				},
+			),
+			err: types.ErrInvalidBatchPlaceOrder,
+		},
+		"duplicate order ids": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				},
+			),
+			err: types.ErrInvalidBatchPlaceOrder,
+		},
+		"invalid order in batch": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{
+					constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15,
+					invalidOrder,
+				},
+			),
+			err: types.ErrInvalidOrderQuantums,
+		},
+		"success: one order": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				[]types.Order{
+					constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+				},
+			),
+			err: nil,
+		},
+		"success: 50 orders": {
+			msg: *types.NewMsgBatchPlaceOrder(
+				constants.Alice_Num0,
+				oneOverMax[:types.MaxMsgBatchPlaceOrderBatchSize],
+			),
+			err: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetBatchOrderIndex(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+
+	_, isBatchOrder := types.GetBatchOrderIndex(ctx)
+	require.False(t, isBatchOrder)
+
+	ctx = types.WithBatchOrderIndex(ctx, 7)
+	orderIndex, isBatchOrder := types.GetBatchOrderIndex(ctx)
+	require.True(t, isBatchOrder)
+	require.Equal(t, uint32(7), orderIndex)
+
+	ctx = types.WithoutBatchOrderIndex(ctx)
+	_, isBatchOrder = types.GetBatchOrderIndex(ctx)
+	require.False(t, isBatchOrder)
+}
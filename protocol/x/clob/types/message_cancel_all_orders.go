@@ -0,0 +1,51 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgCancelAllOrders{}
+
+// NewMsgCancelAllOrders constructs a MsgCancelAllOrders.
+func NewMsgCancelAllOrders(
+	subaccountId satypes.SubaccountId,
+	clobPairIds []uint32,
+) *MsgCancelAllOrders {
+	return &MsgCancelAllOrders{
+		SubaccountId: subaccountId,
+		ClobPairIds:  clobPairIds,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgCancelAllOrders` msg.
+func (msg *MsgCancelAllOrders) ValidateBasic() (err error) {
+	subaccountId := msg.GetSubaccountId()
+	if err := subaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if lib.ContainsDuplicates(msg.GetClobPairIds()) {
+		return errorsmod.Wrapf(
+			ErrInvalidCancelAllOrders,
+			"Cancel all orders cannot have duplicate clob pair ids: %+v",
+			msg.GetClobPairIds(),
+		)
+	}
+	return nil
+}
+
+// ShouldCancelOrder returns true if the order with `orderId` is cancelled by the msg, meaning that
+// the order belongs to the subaccount of the msg and to one of the clob pairs of the msg, if any
+// are specified.
+func (msg *MsgCancelAllOrders) ShouldCancelOrder(orderId OrderId) bool {
+	if orderId.SubaccountId != msg.SubaccountId {
+		return false
+	}
+	return len(msg.ClobPairIds) == 0 || slices.Contains(msg.ClobPairIds, orderId.ClobPairId)
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelAllOrders_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgCancelAllOrders
+		err error
+	}{
+		"invalid subaccount": {
+			msg: *types.NewMsgCancelAllOrders(constants.InvalidSubaccountIdNumber, nil),
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"duplicate clob pair ids": {
+			msg: *types.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{0, 1, 0}),
+			err: types.ErrInvalidCancelAllOrders,
+		},
+		"success: no clob pair ids": {
+			msg: *types.NewMsgCancelAllOrders(constants.Alice_Num0, nil),
+			err: nil,
+		},
+		"success: clob pair ids": {
+			msg: *types.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{0, 1}),
+			err: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelAllOrders_ShouldCancelOrder(t *testing.T) {
+	tests := map[string]struct {
+		msg     types.MsgCancelAllOrders
+		orderId types.OrderId
+
+		expectedShouldCancel bool
+	}{
+		"order of a different subaccount": {
+			msg:                  *types.NewMsgCancelAllOrders(constants.Alice_Num0, nil),
+			orderId:              constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price10_GTB20.OrderId,
+			expectedShouldCancel: false,
+		},
+		"order of the subaccount on any clob pair": {
+			msg:                  *types.NewMsgCancelAllOrders(constants.Alice_Num0, nil),
+			orderId:              constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25.OrderId,
+			expectedShouldCancel: true,
+		},
+		"order of the subaccount on one of the clob pairs": {
+			msg:                  *types.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{1}),
+			orderId:              constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25.OrderId,
+			expectedShouldCancel: true,
+		},
+		"order of the subaccount on a different clob pair": {
+			msg:                  *types.NewMsgCancelAllOrders(constants.Alice_Num0, []uint32{1}),
+			orderId:              constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+			expectedShouldCancel: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedShouldCancel, tc.msg.ShouldCancelOrder(tc.orderId))
+		})
+	}
+}
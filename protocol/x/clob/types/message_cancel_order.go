@@ -51,6 +51,14 @@ func (msg *MsgCancelOrder) ValidateBasic() (err error) {
 		return err
 	}
 
+	if orderId.IsTwapSuborder() {
+		return errorsmod.Wrapf(
+			ErrInvalidOrderFlag,
+			"TWAP suborders cannot be cancelled, cancel the TWAP order instead, orderId %+v",
+			orderId,
+		)
+	}
+
 	if orderId.IsStatefulOrder() {
 		if msg.GetGoodTilBlockTime() == 0 {
 			return errorsmod.Wrapf(
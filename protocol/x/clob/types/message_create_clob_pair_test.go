@@ -15,7 +15,7 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 		expectedErr string
 	}{
 		{
-			desc: "Invalid Metadata (SpotClobMetadata)",
+			desc: "Invalid Metadata (empty SpotClobMetadata)",
 			msg: types.MsgCreateClobPair{
 				Authority: lib.GovModuleAddress.String(),
 				ClobPair: types.ClobPair{
@@ -25,7 +25,43 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 					Status:           types.ClobPair_STATUS_ACTIVE,
 				},
 			},
-			expectedErr: "is not a perpetual CLOB",
+			expectedErr: "has empty spot metadata",
+		},
+		{
+			desc: "Invalid Metadata (SpotClobMetadata with non-USDC quote asset)",
+			msg: types.MsgCreateClobPair{
+				Authority: lib.GovModuleAddress.String(),
+				ClobPair: types.ClobPair{
+					Metadata: &types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  0,
+							QuoteAssetId: 1,
+						},
+					},
+					StepBaseQuantums: 1,
+					SubticksPerTick:  1,
+					Status:           types.ClobPair_STATUS_ACTIVE,
+				},
+			},
+			expectedErr: "has unsupported quote asset 1",
+		},
+		{
+			desc: "Invalid Metadata (SpotClobMetadata with identical base and quote assets)",
+			msg: types.MsgCreateClobPair{
+				Authority: lib.GovModuleAddress.String(),
+				ClobPair: types.ClobPair{
+					Metadata: &types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  0,
+							QuoteAssetId: 0,
+						},
+					},
+					StepBaseQuantums: 1,
+					SubticksPerTick:  1,
+					Status:           types.ClobPair_STATUS_ACTIVE,
+				},
+			},
+			expectedErr: "has identical base and quote assets",
 		},
 		{
 			desc: "Empty authority",
@@ -92,6 +128,24 @@ func TestMsgCreateClobPair_ValidateBasic(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			desc: "Valid spot ClobPair",
+			msg: types.MsgCreateClobPair{
+				Authority: lib.GovModuleAddress.String(),
+				ClobPair: types.ClobPair{
+					Metadata: &types.ClobPair_SpotClobMetadata{
+						SpotClobMetadata: &types.SpotClobMetadata{
+							BaseAssetId:  1,
+							QuoteAssetId: 0,
+						},
+					},
+					StepBaseQuantums: 1,
+					SubticksPerTick:  1,
+					Status:           types.ClobPair_STATUS_ACTIVE,
+				},
+			},
+			expectedErr: "",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgHeartbeat{}
+
+// NewMsgHeartbeat constructs a MsgHeartbeat.
+func NewMsgHeartbeat(
+	subaccountId satypes.SubaccountId,
+	timeoutSeconds uint32,
+) *MsgHeartbeat {
+	return &MsgHeartbeat{
+		SubaccountId:   subaccountId,
+		TimeoutSeconds: timeoutSeconds,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgHeartbeat` msg.
+func (msg *MsgHeartbeat) ValidateBasic() (err error) {
+	subaccountId := msg.GetSubaccountId()
+	if err := subaccountId.Validate(); err != nil {
+		return err
+	}
+
+	if msg.TimeoutSeconds > MaxHeartbeatTimeoutSeconds {
+		return errorsmod.Wrapf(
+			ErrInvalidHeartbeat,
+			"Heartbeat timeout %d seconds exceeds the maximum of %d seconds",
+			msg.TimeoutSeconds,
+			MaxHeartbeatTimeoutSeconds,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgHeartbeat_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgHeartbeat
+		err error
+	}{
+		"invalid subaccount": {
+			msg: *types.NewMsgHeartbeat(constants.InvalidSubaccountIdNumber, 60),
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"timeout exceeds the maximum": {
+			msg: *types.NewMsgHeartbeat(constants.Alice_Num0, types.MaxHeartbeatTimeoutSeconds+1),
+			err: types.ErrInvalidHeartbeat,
+		},
+		"success: disarm": {
+			msg: *types.NewMsgHeartbeat(constants.Alice_Num0, 0),
+			err: nil,
+		},
+		"success: maximum timeout": {
+			msg: *types.NewMsgHeartbeat(constants.Alice_Num0, types.MaxHeartbeatTimeoutSeconds),
+			err: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
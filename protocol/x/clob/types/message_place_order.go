@@ -4,6 +4,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
@@ -46,6 +47,18 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		return errorsmod.Wrapf(ErrInvalidConditionType, "invalid condition type (%s)", msg.Order.ConditionType)
 	}
 
+	if _, exists := Order_OrderGroupType_name[int32(msg.Order.OrderGroupType)]; !exists {
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "invalid order group type (%s)", msg.Order.OrderGroupType)
+	}
+
+	if _, exists := Order_SelfTradePreventionMode_name[int32(msg.Order.SelfTradePreventionMode)]; !exists {
+		return errorsmod.Wrapf(
+			ErrInvalidSelfTradePreventionMode,
+			"invalid self-trade prevention mode (%s)",
+			msg.Order.SelfTradePreventionMode,
+		)
+	}
+
 	if msg.Order.Side == Order_SIDE_UNSPECIFIED {
 		return errorsmod.Wrapf(ErrInvalidOrderSide, "UNSPECIFIED is not a valid order side")
 	}
@@ -60,6 +73,8 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		if msg.Order.GetGoodTilBlock() == uint32(0) {
 			return errorsmod.Wrapf(ErrInvalidOrderGoodTilBlock, "order goodTilBlock cannot be 0")
 		}
+	} else if orderId.IsTwapSuborder() {
+		return errorsmod.Wrapf(ErrInvalidOrderFlag, "TWAP suborders cannot be placed directly")
 	} else if orderId.IsStatefulOrder() {
 		if msg.Order.GetGoodTilBlockTime() == uint32(0) {
 			return errorsmod.Wrapf(
@@ -104,5 +119,95 @@ func (msg *MsgPlaceOrder) ValidateBasic() (err error) {
 		}
 	}
 
+	if msg.Order.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP {
+		if (msg.Order.TrailingOffsetSubticks == 0) == (msg.Order.TrailingOffsetPpm == 0) {
+			return errorsmod.Wrapf(
+				ErrInvalidTrailingOffset,
+				"exactly one of trailing offset subticks (%d) and trailing offset ppm (%d) must be nonzero",
+				msg.Order.TrailingOffsetSubticks,
+				msg.Order.TrailingOffsetPpm,
+			)
+		}
+
+		if msg.Order.TrailingOffsetPpm >= lib.OneMillion {
+			return errorsmod.Wrapf(
+				ErrInvalidTrailingOffset,
+				"trailing offset ppm (%d) must be less than %d",
+				msg.Order.TrailingOffsetPpm,
+				lib.OneMillion,
+			)
+		}
+	} else if msg.Order.TrailingOffsetSubticks != 0 || msg.Order.TrailingOffsetPpm != 0 {
+		return errorsmod.Wrapf(ErrInvalidTrailingOffset, "trailing offset specified for non-trailing stop order")
+	}
+
+	if (msg.Order.OrderGroupId == 0) != (msg.Order.OrderGroupType == Order_ORDER_GROUP_TYPE_UNSPECIFIED) {
+		return errorsmod.Wrapf(
+			ErrInvalidOrderGroup,
+			"order group id (%d) and order group type (%s) must either both be set or both be unset",
+			msg.Order.OrderGroupId,
+			msg.Order.OrderGroupType,
+		)
+	}
+
+	if msg.Order.IsInOrderGroup() && orderId.IsShortTermOrder() {
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "short-term orders cannot be part of an order group")
+	}
+
+	if msg.Order.IsInOrderGroup() && orderId.IsTwapOrder() {
+		return errorsmod.Wrapf(ErrInvalidOrderGroup, "TWAP orders cannot be part of an order group")
+	}
+
+	if orderId.IsTwapOrder() {
+		if err := msg.validateTwapOrder(); err != nil {
+			return err
+		}
+	} else if msg.Order.TwapParameters != nil {
+		return errorsmod.Wrapf(ErrInvalidTwapOrder, "TWAP parameters specified for non-TWAP order")
+	}
+
+	return nil
+}
+
+// validateTwapOrder performs stateless validation of the TWAP parameters of a TWAP order. The interval
+// must be within [MinTwapOrderInterval, MaxTwapOrderInterval] and the duration must be a multiple of
+// the interval no greater than MaxTwapOrderDuration. Since the suborders of a TWAP order are always
+// immediate-or-cancel, the TWAP order itself cannot specify a time in force.
+func (msg *MsgPlaceOrder) validateTwapOrder() error {
+	twapParameters := msg.Order.TwapParameters
+	if twapParameters == nil {
+		return errorsmod.Wrapf(ErrInvalidTwapOrder, "TWAP parameters must be specified for TWAP orders")
+	}
+
+	if twapParameters.Interval < MinTwapOrderInterval || twapParameters.Interval > MaxTwapOrderInterval {
+		return errorsmod.Wrapf(
+			ErrInvalidTwapOrder,
+			"TWAP order interval (%d) must be between %d and %d seconds",
+			twapParameters.Interval,
+			MinTwapOrderInterval,
+			MaxTwapOrderInterval,
+		)
+	}
+
+	if twapParameters.Duration < twapParameters.Interval ||
+		twapParameters.Duration > MaxTwapOrderDuration ||
+		twapParameters.Duration%twapParameters.Interval != 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidTwapOrder,
+			"TWAP order duration (%d) must be a multiple of the interval (%d) no greater than %d seconds",
+			twapParameters.Duration,
+			twapParameters.Interval,
+			MaxTwapOrderDuration,
+		)
+	}
+
+	if msg.Order.TimeInForce != Order_TIME_IN_FORCE_UNSPECIFIED {
+		return errorsmod.Wrapf(
+			ErrInvalidTwapOrder,
+			"TWAP orders cannot specify a time in force (%s)",
+			msg.Order.TimeInForce,
+		)
+	}
+
 	return nil
 }
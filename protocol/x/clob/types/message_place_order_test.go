@@ -83,6 +83,21 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidConditionType,
 		},
+		"invalid self-trade prevention mode": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+					},
+					Side:                    Order_SIDE_BUY,
+					SelfTradePreventionMode: Order_SelfTradePreventionMode(uint32(999)),
+				},
+			},
+			err: ErrInvalidSelfTradePreventionMode,
+		},
 		"unspecified side": {
 			msg: MsgPlaceOrder{
 				Order: Order{
@@ -447,6 +462,527 @@ func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidConditionalOrderTriggerSubticks,
 		},
+		"trailing stop: valid offset subticks": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+				},
+			},
+		},
+		"trailing stop: valid offset ppm": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetPpm:               uint32(10_000),
+				},
+			},
+		},
+		"trailing stop: no trailing offset": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"trailing stop: both trailing offsets": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+					TrailingOffsetPpm:               uint32(10_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"trailing stop: trailing offset ppm too large": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_TRAILING_STOP,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetPpm:               uint32(1_000_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"trailing stop: trailing offset on stop loss order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					TrailingOffsetSubticks:          uint64(5),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"trailing offset on long term order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TrailingOffsetPpm: uint32(10_000),
+				},
+			},
+			err: ErrInvalidTrailingOffset,
+		},
+		"order group: valid OCO conditional order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Conditional,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					ConditionType:                   Order_CONDITION_TYPE_STOP_LOSS,
+					ConditionalOrderTriggerSubticks: uint64(10),
+					OrderGroupId:                    uint32(1),
+					OrderGroupType:                  Order_ORDER_GROUP_TYPE_OCO,
+				},
+			},
+		},
+		"order group: valid bracket long term order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					OrderGroupId:   uint32(1),
+					OrderGroupType: Order_ORDER_GROUP_TYPE_BRACKET,
+				},
+			},
+		},
+		"order group: id without type": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					OrderGroupId: uint32(1),
+				},
+			},
+			err: ErrInvalidOrderGroup,
+		},
+		"order group: type without id": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					OrderGroupType: Order_ORDER_GROUP_TYPE_OCO,
+				},
+			},
+			err: ErrInvalidOrderGroup,
+		},
+		"order group: invalid type": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					OrderGroupId:   uint32(1),
+					OrderGroupType: Order_OrderGroupType(3),
+				},
+			},
+			err: ErrInvalidOrderGroup,
+		},
+		"order group: short term order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_ShortTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlock{
+						GoodTilBlock: uint32(100),
+					},
+					OrderGroupId:   uint32(1),
+					OrderGroupType: Order_ORDER_GROUP_TYPE_OCO,
+				},
+			},
+			err: ErrInvalidOrderGroup,
+		},
+		"twap: valid": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(300),
+						Interval: uint32(30),
+					},
+				},
+			},
+		},
+		"twap: missing parameters": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: interval too small": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(290),
+						Interval: uint32(29),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: interval too large": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(7202),
+						Interval: uint32(3601),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: duration not a multiple of interval": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(310),
+						Interval: uint32(60),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: duration too large": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(86460),
+						Interval: uint32(60),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: time in force": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(300),
+						Interval: uint32(30),
+					},
+					TimeInForce: Order_TIME_IN_FORCE_POST_ONLY,
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: order group": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_Twap,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(300),
+						Interval: uint32(30),
+					},
+					OrderGroupId:   uint32(1),
+					OrderGroupType: Order_ORDER_GROUP_TYPE_OCO,
+				},
+			},
+			err: ErrInvalidOrderGroup,
+		},
+		"twap: parameters for non-TWAP order": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_LongTerm,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+					TwapParameters: &TwapParameters{
+						Duration: uint32(300),
+						Interval: uint32(30),
+					},
+				},
+			},
+			err: ErrInvalidTwapOrder,
+		},
+		"twap: suborder": {
+			msg: MsgPlaceOrder{
+				Order: Order{
+					OrderId: OrderId{
+						SubaccountId: satypes.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(0),
+						},
+						OrderFlags: OrderIdFlags_TwapSuborder,
+					},
+					Side:     Order_SIDE_SELL,
+					Subticks: uint64(10),
+					Quantums: uint64(42),
+					GoodTilOneof: &Order_GoodTilBlockTime{
+						GoodTilBlockTime: uint32(100),
+					},
+				},
+			},
+			err: ErrInvalidOrderFlag,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
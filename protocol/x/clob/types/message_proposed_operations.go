@@ -20,7 +20,8 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 		switch operation := rawOperation.Operation.(type) {
 		case
 			*OperationRaw_Match,
-			*OperationRaw_ShortTermOrderPlacement:
+			*OperationRaw_ShortTermOrderPlacement,
+			*OperationRaw_ShortTermOrderBatchPlacement:
 			// no-op, stateless validation is done in ValidateAndTransformRawOperations
 		case *OperationRaw_OrderRemoval:
 			orderId := operation.OrderRemoval.GetOrderId()
@@ -104,6 +105,26 @@ func ValidateAndTransformRawOperations(
 			); err != nil {
 				return nil, err
 			}
+		case *OperationRaw_ShortTermOrderBatchPlacement:
+			batchOperations, err := decodeOperationRawShortTermOrderBatchPlacement(
+				ctx,
+				rawOperation.GetShortTermOrderBatchPlacement(),
+				decoder,
+				anteHandler,
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, batchOperation := range batchOperations {
+				if err = validator.validateShortTermOrderPlacementOperation(
+					batchOperation.GetShortTermOrderPlacement(),
+				); err != nil {
+					return nil, err
+				}
+			}
+			// The batch expands into the placement of each of its orders.
+			operations = append(operations, batchOperations...)
+			continue
 		case *OperationRaw_OrderRemoval:
 			orderRemoval := rawOperation.GetOrderRemoval()
 			if err := orderRemoval.OrderId.Validate(); err != nil {
@@ -119,6 +140,9 @@ func ValidateAndTransformRawOperations(
 					orderRemoval.RemovalReason,
 				)
 			}
+			if err := orderRemoval.ValidateSelfTradeFields(); err != nil {
+				return nil, err
+			}
 			operation.Operation = &InternalOperation_OrderRemoval{
 				OrderRemoval: rawOperation.GetOrderRemoval(),
 			}
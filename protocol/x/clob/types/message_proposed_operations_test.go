@@ -513,6 +513,64 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 			},
 			expectedError: errors.New("expected MsgPlaceOrder, got *types.MsgCancelOrder"),
 		},
+		"Short term order batch placement places each of its orders": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(
+								types.NewMsgBatchPlaceOrder(
+									constants.Carl_Num0,
+									[]types.Order{
+										constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10,
+										constants.Order_Carl_Num0_Id1_Clob0_Buy01BTC_Price49500_GTB10,
+									},
+								),
+							),
+							OrderIndices: []uint32{1, 0},
+						},
+					},
+				},
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(
+					constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10,
+				),
+				clobtestutils.NewMatchOperationRaw(
+					&constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10,
+					[]types.MakerFill{
+						{
+							FillAmount:   100_000_000, // 1 BTC
+							MakerOrderId: constants.Order_Carl_Num0_Id0_Clob0_Buy1BTC_Price50000_GTB10.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: nil,
+		},
+		"Short term order batch placement has no order indices": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes: testtx.MustGetTxBytes(constants.Msg_BatchPlaceOrder),
+						},
+					},
+				},
+			},
+			expectedError: errors.New("expected at least 1 order index, got 0"),
+		},
+		"Short term order batch placement has an out of range order index": {
+			operations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes:      testtx.MustGetTxBytes(constants.Msg_BatchPlaceOrder),
+							OrderIndices: []uint32{0, 1},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("order index 1 is out of range for MsgBatchPlaceOrder with 1 orders"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
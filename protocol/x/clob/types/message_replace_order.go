@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgReplaceOrder = "replace_order"
+
+var _ sdk.Msg = &MsgReplaceOrder{}
+
+// NewMsgReplaceOrder constructs a MsgReplaceOrder.
+func NewMsgReplaceOrder(order Order) *MsgReplaceOrder {
+	return &MsgReplaceOrder{
+		Order: order,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgReplaceOrder` msg. The replacement order
+// must be a valid Long-Term or conditional order.
+func (msg *MsgReplaceOrder) ValidateBasic() (err error) {
+	orderId := msg.Order.GetOrderId()
+	if !orderId.IsLongTermOrder() && !orderId.IsConditionalOrder() {
+		return errorsmod.Wrapf(
+			ErrInvalidStatefulOrderReplacement,
+			"only long-term and conditional orders can be replaced, got order flag %v",
+			orderId.OrderFlags,
+		)
+	}
+
+	return NewMsgPlaceOrder(msg.Order).ValidateBasic()
+}
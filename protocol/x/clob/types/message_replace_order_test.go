@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg types.MsgReplaceOrder
+		err error
+	}{
+		"valid long-term order": {
+			msg: *types.NewMsgReplaceOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
+		},
+		"valid conditional order": {
+			msg: *types.NewMsgReplaceOrder(
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20,
+			),
+		},
+		"short-term order": {
+			msg: *types.NewMsgReplaceOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15),
+			err: types.ErrInvalidStatefulOrderReplacement,
+		},
+		"invalid stateful order": {
+			msg: *types.NewMsgReplaceOrder(types.Order{
+				OrderId:      constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId,
+				Side:         types.Order_SIDE_BUY,
+				Quantums:     0,
+				Subticks:     10,
+				GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 15},
+			}),
+			err: types.ErrInvalidOrderQuantums,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgResetMarketMakerProtection{}
+
+// NewMsgResetMarketMakerProtection constructs a MsgResetMarketMakerProtection.
+func NewMsgResetMarketMakerProtection(
+	subaccountId satypes.SubaccountId,
+	clobPairId uint32,
+) *MsgResetMarketMakerProtection {
+	return &MsgResetMarketMakerProtection{
+		SubaccountId: subaccountId,
+		ClobPairId:   clobPairId,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgResetMarketMakerProtection` msg.
+func (msg *MsgResetMarketMakerProtection) ValidateBasic() (err error) {
+	subaccountId := msg.GetSubaccountId()
+	return subaccountId.Validate()
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+var _ sdk.Msg = &MsgSetMarketMakerProtectionConfig{}
+
+// NewMsgSetMarketMakerProtectionConfig constructs a MsgSetMarketMakerProtectionConfig.
+func NewMsgSetMarketMakerProtectionConfig(
+	subaccountId satypes.SubaccountId,
+	clobPairId uint32,
+	config MarketMakerProtectionConfig,
+) *MsgSetMarketMakerProtectionConfig {
+	return &MsgSetMarketMakerProtectionConfig{
+		SubaccountId: subaccountId,
+		ClobPairId:   clobPairId,
+		Config:       config,
+	}
+}
+
+// ValidateBasic performs stateless validation for the `MsgSetMarketMakerProtectionConfig` msg.
+func (msg *MsgSetMarketMakerProtectionConfig) ValidateBasic() (err error) {
+	subaccountId := msg.GetSubaccountId()
+	if err := subaccountId.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Config.Validate()
+}
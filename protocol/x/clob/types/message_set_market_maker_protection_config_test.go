@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetMarketMakerProtectionConfig_ValidateBasic(t *testing.T) {
+	validConfig := types.MarketMakerProtectionConfig{
+		WindowBlocks:      10,
+		MaxFilledQuantums: 1_000,
+		FreezeBlocks:      20,
+	}
+
+	tests := map[string]struct {
+		msg types.MsgSetMarketMakerProtectionConfig
+		err error
+	}{
+		"invalid subaccount": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(constants.InvalidSubaccountIdNumber, 0, validConfig),
+			err: satypes.ErrInvalidSubaccountIdNumber,
+		},
+		"zero window blocks": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{MaxFilledQuantums: 1_000, FreezeBlocks: 20},
+			),
+			err: types.ErrInvalidMarketMakerProtectionConfig,
+		},
+		"window blocks exceed the maximum": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{
+					WindowBlocks:      types.MaxMarketMakerProtectionBlocks + 1,
+					MaxFilledQuantums: 1_000,
+					FreezeBlocks:      20,
+				},
+			),
+			err: types.ErrInvalidMarketMakerProtectionConfig,
+		},
+		"zero freeze blocks": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{WindowBlocks: 10, MaxFilledQuantums: 1_000},
+			),
+			err: types.ErrInvalidMarketMakerProtectionConfig,
+		},
+		"no fill limits": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{WindowBlocks: 10, FreezeBlocks: 20},
+			),
+			err: types.ErrInvalidMarketMakerProtectionConfig,
+		},
+		"success": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(constants.Alice_Num0, 0, validConfig),
+			err: nil,
+		},
+		"success: quote quantums limit": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{WindowBlocks: 10, MaxFilledQuoteQuantums: 1_000, FreezeBlocks: 20},
+			),
+			err: nil,
+		},
+		"success: empty config": {
+			msg: *types.NewMsgSetMarketMakerProtectionConfig(
+				constants.Alice_Num0,
+				0,
+				types.MarketMakerProtectionConfig{},
+			),
+			err: nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IsEmpty returns true if the config does not enable market-maker protection.
+func (c MarketMakerProtectionConfig) IsEmpty() bool {
+	return c == MarketMakerProtectionConfig{}
+}
+
+// Validate validates the market-maker protection config. It returns an error if the config is
+// non-empty and any of the following conditions are true:
+//   - `WindowBlocks` or `FreezeBlocks` is zero or exceeds `MaxMarketMakerProtectionBlocks`.
+//   - Neither `MaxFilledQuantums` nor `MaxFilledQuoteQuantums` is set.
+func (c MarketMakerProtectionConfig) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+
+	if c.WindowBlocks == 0 || c.WindowBlocks > MaxMarketMakerProtectionBlocks {
+		return errorsmod.Wrapf(
+			ErrInvalidMarketMakerProtectionConfig,
+			"Window blocks %d must be between 1 and %d",
+			c.WindowBlocks,
+			MaxMarketMakerProtectionBlocks,
+		)
+	}
+
+	if c.FreezeBlocks == 0 || c.FreezeBlocks > MaxMarketMakerProtectionBlocks {
+		return errorsmod.Wrapf(
+			ErrInvalidMarketMakerProtectionConfig,
+			"Freeze blocks %d must be between 1 and %d",
+			c.FreezeBlocks,
+			MaxMarketMakerProtectionBlocks,
+		)
+	}
+
+	if c.MaxFilledQuantums == 0 && c.MaxFilledQuoteQuantums == 0 {
+		return errorsmod.Wrap(
+			ErrInvalidMarketMakerProtectionConfig,
+			"At least one of max filled quantums and max filled quote quantums must be set",
+		)
+	}
+
+	return nil
+}
+
+// IsFrozen returns true if market-maker protection is tripped at `blockHeight`, meaning that new
+// orders of the subaccount on the clob pair must be rejected. Note that a tripped state always has
+// a non-zero `FrozenUntilBlock` since `FreezeBlocks` is non-zero.
+func (s MarketMakerProtectionState) IsFrozen(blockHeight uint32) bool {
+	return s.FrozenUntilBlock != 0 && blockHeight <= s.FrozenUntilBlock
+}
+
+// AddMakerFill adds a maker fill at `blockHeight` to the fills accumulated within the current window
+// of `config`, starting a new window if the current window has elapsed. If the accumulated fills
+// reach any of the limits of `config`, market-maker protection is tripped such that new orders are
+// rejected for the next `FreezeBlocks` blocks, the accumulated fills are reset and true is returned.
+func (s *MarketMakerProtectionState) AddMakerFill(
+	config MarketMakerProtectionConfig,
+	blockHeight uint32,
+	quantums uint64,
+	quoteQuantums uint64,
+) (tripped bool) {
+	if uint64(blockHeight) >= uint64(s.WindowStartBlock)+uint64(config.WindowBlocks) {
+		s.WindowStartBlock = blockHeight
+		s.FilledQuantums = 0
+		s.FilledQuoteQuantums = 0
+	}
+
+	s.FilledQuantums = addUint64Saturating(s.FilledQuantums, quantums)
+	s.FilledQuoteQuantums = addUint64Saturating(s.FilledQuoteQuantums, quoteQuantums)
+
+	if (config.MaxFilledQuantums == 0 || s.FilledQuantums < config.MaxFilledQuantums) &&
+		(config.MaxFilledQuoteQuantums == 0 || s.FilledQuoteQuantums < config.MaxFilledQuoteQuantums) {
+		return false
+	}
+
+	s.WindowStartBlock = blockHeight
+	s.FilledQuantums = 0
+	s.FilledQuoteQuantums = 0
+	s.FrozenUntilBlock = blockHeight + config.FreezeBlocks
+	return true
+}
+
+// addUint64Saturating returns the sum of `a` and `b`, capped at the maximum uint64 value.
+func addUint64Saturating(a uint64, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketMakerProtectionState_AddMakerFill(t *testing.T) {
+	config := types.MarketMakerProtectionConfig{
+		WindowBlocks:           5,
+		MaxFilledQuantums:      100,
+		MaxFilledQuoteQuantums: 1_000,
+		FreezeBlocks:           3,
+	}
+
+	tests := map[string]struct {
+		state         types.MarketMakerProtectionState
+		blockHeight   uint32
+		quantums      uint64
+		quoteQuantums uint64
+
+		expectedTripped bool
+		expectedState   types.MarketMakerProtectionState
+	}{
+		"fill is added to the current window": {
+			state: types.MarketMakerProtectionState{
+				WindowStartBlock:    10,
+				FilledQuantums:      50,
+				FilledQuoteQuantums: 500,
+			},
+			blockHeight:   14,
+			quantums:      10,
+			quoteQuantums: 100,
+
+			expectedTripped: false,
+			expectedState: types.MarketMakerProtectionState{
+				WindowStartBlock:    10,
+				FilledQuantums:      60,
+				FilledQuoteQuantums: 600,
+			},
+		},
+		"fill starts a new window once the current window elapsed": {
+			state: types.MarketMakerProtectionState{
+				WindowStartBlock:    10,
+				FilledQuantums:      90,
+				FilledQuoteQuantums: 900,
+			},
+			blockHeight:   15,
+			quantums:      10,
+			quoteQuantums: 100,
+
+			expectedTripped: false,
+			expectedState: types.MarketMakerProtectionState{
+				WindowStartBlock:    15,
+				FilledQuantums:      10,
+				FilledQuoteQuantums: 100,
+			},
+		},
+		"fill reaching max filled quantums trips": {
+			state: types.MarketMakerProtectionState{
+				WindowStartBlock: 10,
+				FilledQuantums:   90,
+			},
+			blockHeight: 12,
+			quantums:    10,
+
+			expectedTripped: true,
+			expectedState: types.MarketMakerProtectionState{
+				WindowStartBlock: 12,
+				FrozenUntilBlock: 15,
+			},
+		},
+		"fill reaching max filled quote quantums trips": {
+			state: types.MarketMakerProtectionState{
+				WindowStartBlock:    10,
+				FilledQuoteQuantums: 900,
+			},
+			blockHeight:   12,
+			quantums:      1,
+			quoteQuantums: 200,
+
+			expectedTripped: true,
+			expectedState: types.MarketMakerProtectionState{
+				WindowStartBlock: 12,
+				FrozenUntilBlock: 15,
+			},
+		},
+		"filled quote quantums saturate": {
+			state: types.MarketMakerProtectionState{
+				WindowStartBlock:    10,
+				FilledQuoteQuantums: 500,
+			},
+			blockHeight:   12,
+			quantums:      1,
+			quoteQuantums: math.MaxUint64,
+
+			expectedTripped: true,
+			expectedState: types.MarketMakerProtectionState{
+				WindowStartBlock: 12,
+				FrozenUntilBlock: 15,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := tc.state
+			tripped := state.AddMakerFill(config, tc.blockHeight, tc.quantums, tc.quoteQuantums)
+			require.Equal(t, tc.expectedTripped, tripped)
+			require.Equal(t, tc.expectedState, state)
+		})
+	}
+}
+
+func TestMarketMakerProtectionState_IsFrozen(t *testing.T) {
+	state := types.MarketMakerProtectionState{FrozenUntilBlock: 15}
+	require.True(t, state.IsFrozen(14))
+	require.True(t, state.IsFrozen(15))
+	require.False(t, state.IsFrozen(16))
+	require.False(t, types.MarketMakerProtectionState{}.IsFrozen(0))
+	require.False(t, types.MarketMakerProtectionState{}.IsFrozen(1))
+}
@@ -43,6 +43,61 @@ func decodeOperationRawShortTermOrderPlacementBytes(
 	}, nil
 }
 
+// decodeOperationRawShortTermOrderBatchPlacement performs stateless validation
+// on the placements of the short term orders of a `MsgBatchPlaceOrder` given the
+// underlying raw tx bytes of the batch. It also runs the transaction through an
+// antehandler. The antehandler is needed to do signature validation. Returns an
+// Operation placing the order for each of the specified indices of the batch if
+// successful.
+func decodeOperationRawShortTermOrderBatchPlacement(
+	ctx sdk.Context,
+	batchPlacement *ShortTermOrderBatchPlacement,
+	decoder sdk.TxDecoder,
+	anteHandler sdk.AnteHandler,
+) ([]InternalOperation, error) {
+	if len(batchPlacement.OrderIndices) == 0 {
+		return nil, fmt.Errorf("expected at least 1 order index, got 0")
+	}
+
+	tx, err := decoder(batchPlacement.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := anteHandler(ctx, tx, false); err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("expected 1 msg, got %d", len(msgs))
+	}
+
+	msg, ok := msgs[0].(*MsgBatchPlaceOrder)
+	if !ok {
+		return nil, fmt.Errorf("expected MsgBatchPlaceOrder, got %T", msgs[0])
+	}
+
+	orders := msg.GetShortTermOrders()
+	operations := make([]InternalOperation, 0, len(batchPlacement.OrderIndices))
+	for _, orderIndex := range batchPlacement.OrderIndices {
+		if orderIndex >= uint32(len(orders)) {
+			return nil, fmt.Errorf(
+				"order index %d is out of range for MsgBatchPlaceOrder with %d orders",
+				orderIndex,
+				len(orders),
+			)
+		}
+
+		operations = append(operations, InternalOperation{
+			Operation: &InternalOperation_ShortTermOrderPlacement{
+				ShortTermOrderPlacement: NewMsgPlaceOrder(orders[orderIndex]),
+			},
+		})
+	}
+	return operations, nil
+}
+
 // GetInternalOperationTextString returns the text string representation of this operation.
 // TODO(DEC-1772): Add method for encoding operation protos as JSON to make debugging easier.
 func (o *InternalOperation) GetInternalOperationTextString() string {
@@ -19,6 +19,9 @@ type OperationsToPropose struct {
 	// This is used in `GetOperationsQueueRaw` for returning a slice of `OperationRaw` for
 	// the purposes of constructing `MsgProposedOperations`.
 	ShortTermOrderHashToTxBytes map[OrderHash][]byte
+	// A map of Short-Term order hashes to the index of the order in the `MsgBatchPlaceOrder` of its
+	// transaction. Only contains orders that were placed as part of a `MsgBatchPlaceOrder`.
+	ShortTermOrderHashToBatchIndex map[OrderHash]uint32
 	// A map from order ID to the orders themselves for each order that
 	// was matched. Note: there may be multiple distinct orders with the same
 	// ID that are matched. In that case, only the "greatest" of any such orders
@@ -34,6 +37,7 @@ func NewOperationsToPropose() *OperationsToPropose {
 		OperationsQueue:                make([]InternalOperation, 0),
 		OrderHashesInOperationsQueue:   make(map[OrderHash]bool),
 		ShortTermOrderHashToTxBytes:    make(map[OrderHash][]byte),
+		ShortTermOrderHashToBatchIndex: make(map[OrderHash]uint32),
 		MatchedOrderIdToOrder:          make(map[OrderId]Order),
 		OrderRemovalsInOperationsQueue: make(map[OrderId]bool),
 	}
@@ -81,6 +85,29 @@ func (o *OperationsToPropose) MustAddShortTermOrderTxBytes(
 	o.ShortTermOrderHashToTxBytes[orderHash] = txBytes
 }
 
+// MustAddShortTermOrderBatchIndex adds the index of the provided Short-Term order in the
+// `MsgBatchPlaceOrder` of its transaction into `ShortTermOrderHashToBatchIndex`.
+// This function will panic if the provided order is not a Short-Term order or the order does not
+// exist in `ShortTermOrderHashToTxBytes`.
+func (o *OperationsToPropose) MustAddShortTermOrderBatchIndex(
+	order Order,
+	orderIndex uint32,
+) {
+	order.OrderId.MustBeShortTermOrder()
+
+	orderHash := order.GetOrderHash()
+	if _, exists := o.ShortTermOrderHashToTxBytes[orderHash]; !exists {
+		panic(
+			fmt.Sprintf(
+				"MustAddShortTermOrderBatchIndex: Order (%s) does not exist in `ShortTermOrderHashToTxBytes`.",
+				order.GetOrderTextString(),
+			),
+		)
+	}
+
+	o.ShortTermOrderHashToBatchIndex[orderHash] = orderIndex
+}
+
 // MustAddShortTermOrderPlacementToOperationsQueue adds a Short-Term order placement operation to the
 // operations queue.
 // This function will panic if the order is not a Short-Term order, the order already exists in
@@ -115,7 +142,8 @@ func (o *OperationsToPropose) MustAddShortTermOrderPlacementToOperationsQueue(
 	o.OperationsQueue = append(o.OperationsQueue, NewShortTermOrderPlacementInternalOperation(order))
 }
 
-// RemoveShortTermOrderTxBytes removes a short term order from `ShortTermOrderHashToTxBytes`.
+// RemoveShortTermOrderTxBytes removes a short term order from `ShortTermOrderHashToTxBytes` and
+// `ShortTermOrderHashToBatchIndex`.
 // This function will panic for any of the following:
 // - the order is not a short term order.
 // - the order hash is present in `OrderHashesInOperationsQueue`
@@ -147,6 +175,7 @@ func (o *OperationsToPropose) RemoveShortTermOrderTxBytes(
 	}
 
 	delete(o.ShortTermOrderHashToTxBytes, orderHash)
+	delete(o.ShortTermOrderHashToBatchIndex, orderHash)
 }
 
 // MustAddStatefulOrderPlacementToOperationsQueue adds a stateful order placement operation to the
@@ -352,6 +381,50 @@ func (o *OperationsToPropose) MustAddOrderRemovalToOperationsQueue(
 	o.OrderRemovalsInOperationsQueue[orderId] = true
 }
 
+// MustAddSelfTradeOrderRemovalToOperationsQueue adds an order removal operation for an order removed due to
+// self-trade prevention to the operations queue, where `takerOrderId` is the id of the taker order of the
+// self trade. This function will panic if the removal reason is not a self-trade removal reason or the order
+// removal already exists in the operations queue.
+func (o *OperationsToPropose) MustAddSelfTradeOrderRemovalToOperationsQueue(
+	orderId OrderId,
+	removalReason OrderRemoval_RemovalReason,
+	takerOrderId OrderId,
+) {
+	if _, exists := o.OrderRemovalsInOperationsQueue[orderId]; exists {
+		panic("MustAddSelfTradeOrderRemovalToOperationsQueue: order removal already exists in operations queue")
+	}
+
+	o.OperationsQueue = append(
+		o.OperationsQueue,
+		NewSelfTradeOrderRemovalInternalOperation(orderId, removalReason, takerOrderId, 0),
+	)
+	o.OrderRemovalsInOperationsQueue[orderId] = true
+}
+
+// MustAddSelfTradeOrderDecrementToOperationsQueue adds an operation decrementing the maker order of a self
+// trade by `decrementQuantums` to the operations queue, where `takerOrderId` is the id of the taker order of
+// the self trade. Since the maker order is not removed, it may be decremented or removed again later in the
+// operations queue. This function will panic if `decrementQuantums` is zero.
+func (o *OperationsToPropose) MustAddSelfTradeOrderDecrementToOperationsQueue(
+	orderId OrderId,
+	takerOrderId OrderId,
+	decrementQuantums uint64,
+) {
+	if decrementQuantums == 0 {
+		panic("MustAddSelfTradeOrderDecrementToOperationsQueue: decrement quantums is zero")
+	}
+
+	o.OperationsQueue = append(
+		o.OperationsQueue,
+		NewSelfTradeOrderRemovalInternalOperation(
+			orderId,
+			OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+			takerOrderId,
+			decrementQuantums,
+		),
+	)
+}
+
 // IsOrderRemovalInOperationsQueue returns true if the provided order ID is included in
 // `OrderRemovalsInOperationsQueue`, false if not.
 func (o *OperationsToPropose) IsOrderRemovalInOperationsQueue(
@@ -373,8 +446,9 @@ func (o *OperationsToPropose) IsOrderPlacementInOperationsQueue(
 	return exists
 }
 
-// GetOperationsToReplay returns all operations in the operations queue and a map of all Short-Term
-// order hashes to their TX bytes.
+// GetOperationsToReplay returns all operations in the operations queue, a map of all Short-Term
+// order hashes to their TX bytes, and a map of all Short-Term order hashes placed as part of a
+// `MsgBatchPlaceOrder` to their index in the batch.
 // Note the returned operations include pre-existing stateful order placements, since those
 // operations are only used when replaying a local validator’s operations queue.
 // This function will panic if any of the Short-Term order placement operations do not have an
@@ -383,9 +457,11 @@ func (o *OperationsToPropose) IsOrderPlacementInOperationsQueue(
 func (o *OperationsToPropose) GetOperationsToReplay() (
 	[]InternalOperation,
 	map[OrderHash][]byte,
+	map[OrderHash]uint32,
 ) {
 	operations := make([]InternalOperation, 0, len(o.OperationsQueue))
 	shortTermOrderTxBytesMap := make(map[OrderHash][]byte)
+	shortTermOrderBatchIndexMap := make(map[OrderHash]uint32)
 
 	for _, operation := range o.OperationsQueue {
 		operations = append(operations, operation)
@@ -418,10 +494,14 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 			if len(shortTermOrderTxBytesMapCopy) == 0 {
 				panic("GetOperationsToReplay: Short-Term order TX bytes are empty.")
 			}
+
+			if orderIndex, isBatchOrder := o.ShortTermOrderHashToBatchIndex[orderHash]; isBatchOrder {
+				shortTermOrderBatchIndexMap[orderHash] = orderIndex
+			}
 		}
 	}
 
-	return operations, shortTermOrderTxBytesMap
+	return operations, shortTermOrderTxBytesMap, shortTermOrderBatchIndexMap
 }
 
 // GetOperationsToPropose returns a slice of OperationRaw.
@@ -433,6 +513,10 @@ func (o *OperationsToPropose) GetOperationsToReplay() (
 // of OperationRaw.
 func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 	operationRaws := make([]OperationRaw, 0)
+	// The index in `operationRaws` of the batch placement of each `MsgBatchPlaceOrder`, keyed by
+	// its TX bytes. The placements of all orders of a batch are proposed in a single operation so
+	// that the TX bytes of the batch are only included once.
+	batchPlacementIndices := make(map[string]int)
 
 	for _, operation := range o.OperationsQueue {
 		switch operation := operation.Operation.(type) {
@@ -456,6 +540,23 @@ func (o *OperationsToPropose) GetOperationsToPropose() []OperationRaw {
 					),
 				)
 			}
+			if orderIndex, isBatchOrder := o.ShortTermOrderHashToBatchIndex[order.GetOrderHash()]; isBatchOrder {
+				if i, exists := batchPlacementIndices[string(operationBytes)]; exists {
+					batchPlacement := operationRaws[i].GetShortTermOrderBatchPlacement()
+					batchPlacement.OrderIndices = append(batchPlacement.OrderIndices, orderIndex)
+					continue
+				}
+				batchPlacementIndices[string(operationBytes)] = len(operationRaws)
+				operationRaws = append(operationRaws, OperationRaw{
+					Operation: &OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &ShortTermOrderBatchPlacement{
+							TxBytes:      operationBytes,
+							OrderIndices: []uint32{orderIndex},
+						},
+					},
+				})
+				continue
+			}
 			operationRaws = append(operationRaws, OperationRaw{
 				Operation: &OperationRaw_ShortTermOrderPlacement{
 					ShortTermOrderPlacement: operationBytes,
@@ -288,6 +288,38 @@ func TestRemoveShortTermOrderTxBytes(t *testing.T) {
 	require.Empty(t, otp.ShortTermOrderHashToTxBytes)
 }
 
+func TestMustAddShortTermOrderBatchIndex(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	otp := types.NewOperationsToPropose()
+	otp.MustAddShortTermOrderTxBytes(order, []byte{4, 0, 8})
+	otp.MustAddShortTermOrderBatchIndex(order, 3)
+	require.Equal(
+		t,
+		map[types.OrderHash]uint32{order.GetOrderHash(): 3},
+		otp.ShortTermOrderHashToBatchIndex,
+	)
+
+	// Verify removing the TX bytes also removes the batch index.
+	otp.RemoveShortTermOrderTxBytes(order)
+	require.Empty(t, otp.ShortTermOrderHashToTxBytes)
+	require.Empty(t, otp.ShortTermOrderHashToBatchIndex)
+}
+
+func TestMustAddShortTermOrderBatchIndex_PanicsOnOrderNotInShortTermOrderHashToTxBytes(t *testing.T) {
+	order := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15
+	otp := types.NewOperationsToPropose()
+	require.PanicsWithValue(
+		t,
+		fmt.Sprintf(
+			"MustAddShortTermOrderBatchIndex: Order (%s) does not exist in `ShortTermOrderHashToTxBytes`.",
+			order.GetOrderTextString(),
+		),
+		func() {
+			otp.MustAddShortTermOrderBatchIndex(order, 0)
+		},
+	)
+}
+
 func TestRemoveShortTermOrderTxBytes_PanicsOnStatefulOrder(t *testing.T) {
 	order := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20
 	otp := types.NewOperationsToPropose()
@@ -951,13 +983,58 @@ func TestGetOperationsToReplay_Success(t *testing.T) {
 			tc.setup(otp)
 
 			// Verify expectations.
-			operation, shortTermOrdersTxBytes := otp.GetOperationsToReplay()
+			operation, shortTermOrdersTxBytes, shortTermOrderBatchIndexes := otp.GetOperationsToReplay()
 			require.Equal(t, tc.expectedOperations, operation)
 			require.Equal(t, tc.expectedShortTermOrderBytes, shortTermOrdersTxBytes)
+			require.Empty(t, shortTermOrderBatchIndexes)
 		})
 	}
 }
 
+func TestGetOperationsToReplay_BatchOrders(t *testing.T) {
+	otp := types.NewOperationsToPropose()
+	batchTxBytes := []byte{4, 0, 8}
+	batchOrder0 := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
+	batchOrder1 := constants.Order_Alice_Num0_Id1_Clob0_Sell10_Price15_GTB15
+	shortTermOrder := constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20
+
+	for i, order := range []types.Order{batchOrder0, batchOrder1} {
+		otp.MustAddShortTermOrderTxBytes(order, batchTxBytes)
+		otp.MustAddShortTermOrderBatchIndex(order, uint32(i))
+		otp.MustAddShortTermOrderPlacementToOperationsQueue(order)
+	}
+	otp.MustAddShortTermOrderTxBytes(shortTermOrder, shortTermOrder.GetOrderHash().ToBytes())
+	otp.MustAddShortTermOrderPlacementToOperationsQueue(shortTermOrder)
+
+	operations, shortTermOrderTxBytes, shortTermOrderBatchIndexes := otp.GetOperationsToReplay()
+	require.Equal(
+		t,
+		[]types.InternalOperation{
+			types.NewShortTermOrderPlacementInternalOperation(batchOrder0),
+			types.NewShortTermOrderPlacementInternalOperation(batchOrder1),
+			types.NewShortTermOrderPlacementInternalOperation(shortTermOrder),
+		},
+		operations,
+	)
+	require.Equal(
+		t,
+		map[types.OrderHash][]byte{
+			batchOrder0.GetOrderHash():    batchTxBytes,
+			batchOrder1.GetOrderHash():    batchTxBytes,
+			shortTermOrder.GetOrderHash(): shortTermOrder.GetOrderHash().ToBytes(),
+		},
+		shortTermOrderTxBytes,
+	)
+	require.Equal(
+		t,
+		map[types.OrderHash]uint32{
+			batchOrder0.GetOrderHash(): 0,
+			batchOrder1.GetOrderHash(): 1,
+		},
+		shortTermOrderBatchIndexes,
+	)
+}
+
 func TestGetOperationsToPropose_PanicsOnNonexistentShortTermOrderHashToTxBytesOrder(t *testing.T) {
 	otp := types.NewOperationsToPropose()
 	order := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
@@ -1001,6 +1078,80 @@ func TestGetOperationsToPropose_Success(t *testing.T) {
 				},
 			},
 		},
+		"Short term orders placed in a batch are included in operations to propose": {
+			setup: func(otp *types.OperationsToPropose) {
+				shortTermOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
+				// Dummy bytes for testing.
+				otp.MustAddShortTermOrderTxBytes(shortTermOrder, []byte{4, 0, 8})
+				otp.MustAddShortTermOrderBatchIndex(shortTermOrder, 2)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(shortTermOrder)
+			},
+			expectedOperations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes:      []byte{4, 0, 8},
+							OrderIndices: []uint32{2},
+						},
+					},
+				},
+			},
+		},
+		"Short term orders placed in the same batch are included in a single operation to propose": {
+			setup: func(otp *types.OperationsToPropose) {
+				takerOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16
+				otherBatchOrder := constants.Order_Alice_Num0_Id1_Clob0_Sell5_Price15_GTB15
+				makerOrder := constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15
+				// This is not included in operations to propose.
+				otp.MustAddStatefulOrderPlacementToOperationsQueue(makerOrder)
+				// Dummy bytes for testing.
+				otp.MustAddShortTermOrderTxBytes(takerOrder, []byte{4, 0, 8})
+				otp.MustAddShortTermOrderBatchIndex(takerOrder, 2)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(takerOrder)
+				otp.MustAddMatchToOperationsQueue(
+					&takerOrder,
+					[]types.MakerFillWithOrder{
+						{
+							MakerFill: types.MakerFill{
+								FillAmount:   5,
+								MakerOrderId: makerOrder.OrderId,
+							},
+							Order: makerOrder,
+						},
+					},
+				)
+				otp.MustAddShortTermOrderTxBytes(otherBatchOrder, []byte{4, 0, 8})
+				otp.MustAddShortTermOrderBatchIndex(otherBatchOrder, 0)
+				otp.MustAddShortTermOrderPlacementToOperationsQueue(otherBatchOrder)
+			},
+			expectedOperations: []types.OperationRaw{
+				{
+					Operation: &types.OperationRaw_ShortTermOrderBatchPlacement{
+						ShortTermOrderBatchPlacement: &types.ShortTermOrderBatchPlacement{
+							TxBytes:      []byte{4, 0, 8},
+							OrderIndices: []uint32{2, 0},
+						},
+					},
+				},
+				{
+					Operation: &types.OperationRaw_Match{
+						Match: &types.ClobMatch{
+							Match: &types.ClobMatch_MatchOrders{
+								MatchOrders: &types.MatchOrders{
+									TakerOrderId: constants.Order_Alice_Num0_Id0_Clob0_Buy10_Price10_GTB16.OrderId,
+									Fills: []types.MakerFill{
+										{
+											FillAmount:   5,
+											MakerOrderId: constants.ConditionalOrder_Alice_Num1_Id0_Clob0_Sell5_Price10_GTBT15_StopLoss15.OrderId,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"Stateful orders do not get included in operations to propose": {
 			setup: func(otp *types.OperationsToPropose) {
 				statefulOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	gometrics "github.com/hashicorp/go-metrics"
@@ -114,6 +115,20 @@ func (x *Order) MustCmpReplacementOrder(y *Order) int {
 	return bytes.Compare(xHash[:], yHash[:])
 }
 
+// IsSizeDecreaseReplacement returns true if y is a replacement of x that only decreases the size of x.
+// That is, y has the same `OrderId` as x, y's `Quantums` is strictly less than x's `Quantums`, and every
+// other field of y is equal to x. Such replacements can be applied in place and keep the queue priority
+// of x on the orderbook.
+func (x *Order) IsSizeDecreaseReplacement(y *Order) bool {
+	if x.OrderId != y.OrderId || y.Quantums >= x.Quantums {
+		return false
+	}
+
+	resized := *x
+	resized.Quantums = y.Quantums
+	return resized.GetOrderHash() == y.GetOrderHash()
+}
+
 // GetSubaccountId returns the subaccount ID that placed this order.
 // This function is necessary for the `Order` type to implement the `MatchableOrder` interface.
 func (o *Order) GetSubaccountId() satypes.SubaccountId {
@@ -153,6 +168,36 @@ func (o *Order) IsStopLossOrder() bool {
 	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS
 }
 
+// IsTrailingStopOrder returns whether this is order is a conditional trailing stop order.
+func (o *Order) IsTrailingStopOrder() bool {
+	return o.IsConditionalOrder() && o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP
+}
+
+// IsInOrderGroup returns whether this order is part of an order group.
+func (o *Order) IsInOrderGroup() bool {
+	return o.OrderGroupType != Order_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+// IsBracketEntryOrder returns whether this order is the non-conditional entry order of a bracket order group.
+func (o *Order) IsBracketEntryOrder() bool {
+	return o.OrderGroupType == Order_ORDER_GROUP_TYPE_BRACKET && !o.IsConditionalOrder()
+}
+
+// GetSelfTradePreventionRemovalReason returns the removal reason used for orders that are removed due to
+// self-trade prevention, where this order is the taker order of the self trade.
+func (o *Order) GetSelfTradePreventionRemovalReason() OrderRemoval_RemovalReason {
+	switch o.SelfTradePreventionMode {
+	case Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER:
+		return OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER
+	case Order_SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH:
+		return OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH
+	case Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL:
+		return OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL
+	default:
+		return OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE
+	}
+}
+
 // RequiresImmediateExecution returns whether this order has to be executed immediately.
 func (o *Order) RequiresImmediateExecution() bool {
 	return o.GetTimeInForce() == Order_TIME_IN_FORCE_IOC || o.GetTimeInForce() == Order_TIME_IN_FORCE_FILL_OR_KILL
@@ -163,8 +208,8 @@ func (o *Order) IsShortTermOrder() bool {
 	return o.OrderId.IsShortTermOrder()
 }
 
-// IsStatefulOrder returns whether this order is a stateful order, which is true for Long-Term
-// and conditional orders and false for Short-Term orders.
+// IsStatefulOrder returns whether this order is a stateful order, which is true for Long-Term,
+// conditional and TWAP orders and TWAP suborders, and false for Short-Term orders.
 func (o *Order) IsStatefulOrder() bool {
 	return o.OrderId.IsStatefulOrder()
 }
@@ -174,23 +219,92 @@ func (o *Order) IsConditionalOrder() bool {
 	return o.OrderId.IsConditionalOrder()
 }
 
+// IsTwapOrder returns whether this order is a TWAP order.
+func (o *Order) IsTwapOrder() bool {
+	return o.OrderId.IsTwapOrder()
+}
+
+// IsTwapSuborder returns whether this order is a suborder generated by a TWAP order.
+func (o *Order) IsTwapSuborder() bool {
+	return o.OrderId.IsTwapSuborder()
+}
+
+// GetNumLegs returns the number of suborders generated over the duration of a TWAP order.
+func (p *TwapParameters) GetNumLegs() uint32 {
+	return p.Duration / p.Interval
+}
+
 // CanTrigger returns if a condition order is eligible to be triggered based on a given
 // subticks value. Function will panic if order is not a conditional order.
 func (o *Order) CanTrigger(subticks Subticks) bool {
 	o.MustBeConditionalOrder()
 	orderTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
 
-	// Take profit buys and stop loss sells trigger when the oracle price goes lower
-	// than or equal to the trigger price.
+	// Take profit buys, stop loss sells and trailing stop sells trigger when the oracle price
+	// goes lower than or equal to the trigger price.
 	if o.ConditionType == Order_CONDITION_TYPE_TAKE_PROFIT && o.IsBuy() ||
-		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() {
+		o.ConditionType == Order_CONDITION_TYPE_STOP_LOSS && !o.IsBuy() ||
+		o.ConditionType == Order_CONDITION_TYPE_TRAILING_STOP && !o.IsBuy() {
 		return orderTriggerSubticks >= subticks
 	}
-	// Take profit sells and stop loss buys trigger when the oracle price goes higher
-	// than or equal to the trigger price.
+	// Take profit sells, stop loss buys and trailing stop buys trigger when the oracle price
+	// goes higher than or equal to the trigger price.
 	return orderTriggerSubticks <= subticks
 }
 
+// GetTrailingStopTriggerSubticks returns the trigger subticks of a trailing stop order after
+// trailing the given oracle price, and whether the trigger moved. The trigger of a sell trails
+// below the oracle price and only moves up, and the trigger of a buy trails above the oracle
+// price and only moves down. The new trigger is rounded away from the oracle price to a multiple
+// of `subticksPerTick`. Function will panic if order is not a trailing stop order.
+func (o *Order) GetTrailingStopTriggerSubticks(
+	oraclePriceSubticksRat *big.Rat,
+	subticksPerTick SubticksPerTick,
+) (
+	triggerSubticks Subticks,
+	moved bool,
+) {
+	if !o.IsTrailingStopOrder() {
+		panic(fmt.Sprintf("GetTrailingStopTriggerSubticks: order %+v is not a trailing stop order", o))
+	}
+
+	currentTriggerSubticks := Subticks(o.ConditionalOrderTriggerSubticks)
+	offset := new(big.Rat).SetUint64(o.TrailingOffsetSubticks)
+	if o.TrailingOffsetPpm != 0 {
+		offset = lib.BigRatMulPpm(oraclePriceSubticksRat, o.TrailingOffsetPpm)
+	}
+
+	if o.IsBuy() {
+		trigger := new(big.Rat).Add(oraclePriceSubticksRat, offset)
+		newTriggerSubticks := Subticks(lib.BigRatRoundToNearestMultiple(trigger, uint32(subticksPerTick), true))
+		if newTriggerSubticks < currentTriggerSubticks {
+			return newTriggerSubticks, true
+		}
+		return currentTriggerSubticks, false
+	}
+
+	trigger := new(big.Rat).Sub(oraclePriceSubticksRat, offset)
+	if trigger.Sign() <= 0 {
+		return currentTriggerSubticks, false
+	}
+	newTriggerSubticks := Subticks(lib.BigRatRoundToNearestMultiple(trigger, uint32(subticksPerTick), false))
+	if newTriggerSubticks > currentTriggerSubticks {
+		return newTriggerSubticks, true
+	}
+	return currentTriggerSubticks, false
+}
+
+// GetUntriggeredOrder returns the order of an untriggered conditional order placement with its
+// current trigger price. The trigger price of a trailing stop order may have moved away from the
+// `ConditionalOrderTriggerSubticks` of the placed order.
+func (orderPlacement *LongTermOrderPlacement) GetUntriggeredOrder() Order {
+	order := orderPlacement.GetOrder()
+	if orderPlacement.TrailingStopTriggerSubticks != 0 {
+		order.ConditionalOrderTriggerSubticks = orderPlacement.TrailingStopTriggerSubticks
+	}
+	return order
+}
+
 // MustGetUnixGoodTilBlockTime returns an instance of `Time` that represents the order's
 // `GoodTilBlockTime`. This function panics when the order is a short-term order or
 // when its `GoodTilBlockTime` is zero.
@@ -120,18 +120,26 @@ const (
 	// order will trigger when the oracle price moves at or below the trigger
 	// price for buys and at or above the trigger price for sells.
 	Order_CONDITION_TYPE_TAKE_PROFIT Order_ConditionType = 2
+	// CONDITION_TYPE_TRAILING_STOP represents a trailing stop order. A
+	// trailing stop order triggers like a stop order, but its trigger price
+	// follows the oracle price at a fixed offset when the oracle price moves
+	// in the favorable direction. The trigger price of a buy only moves down
+	// and the trigger price of a sell only moves up.
+	Order_CONDITION_TYPE_TRAILING_STOP Order_ConditionType = 3
 )
 
 var Order_ConditionType_name = map[int32]string{
 	0: "CONDITION_TYPE_UNSPECIFIED",
 	1: "CONDITION_TYPE_STOP_LOSS",
 	2: "CONDITION_TYPE_TAKE_PROFIT",
+	3: "CONDITION_TYPE_TRAILING_STOP",
 }
 
 var Order_ConditionType_value = map[string]int32{
-	"CONDITION_TYPE_UNSPECIFIED": 0,
-	"CONDITION_TYPE_STOP_LOSS":   1,
-	"CONDITION_TYPE_TAKE_PROFIT": 2,
+	"CONDITION_TYPE_UNSPECIFIED":   0,
+	"CONDITION_TYPE_STOP_LOSS":     1,
+	"CONDITION_TYPE_TAKE_PROFIT":   2,
+	"CONDITION_TYPE_TRAILING_STOP": 3,
 }
 
 func (x Order_ConditionType) String() string {
@@ -142,6 +150,100 @@ func (Order_ConditionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_673c6f4faa93736b, []int{7, 2}
 }
 
+// SelfTradePreventionMode determines how an order is handled when, as a
+// taker, it would match against a resting maker order from the same
+// subaccount. Only the mode of the taker order is considered.
+type Order_SelfTradePreventionMode int32
+
+const (
+	// SELF_TRADE_PREVENTION_MODE_UNSPECIFIED represents the default behavior
+	// where the resting maker order is canceled and the taker order continues
+	// matching. This is equivalent to
+	// SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER.
+	Order_SELF_TRADE_PREVENTION_MODE_UNSPECIFIED Order_SelfTradePreventionMode = 0
+	// SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER cancels the resting maker order
+	// and the taker order continues matching.
+	Order_SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER Order_SelfTradePreventionMode = 1
+	// SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER cancels the remaining size of
+	// the taker order and leaves the resting maker order on the book.
+	Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER Order_SelfTradePreventionMode = 2
+	// SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH cancels both the resting maker
+	// order and the remaining size of the taker order.
+	Order_SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH Order_SelfTradePreventionMode = 3
+	// SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL cancels the smaller of
+	// the two orders and decrements the larger order by the remaining size of
+	// the smaller order. A decremented maker order keeps its priority on the
+	// book. Short-term maker orders cannot be resized, and a decrement could
+	// not be proposed to other validators, so a larger short-term maker order
+	// is canceled instead. A decremented taker order continues
+	// matching with its reduced size, and any size that remains after
+	// matching is canceled instead of being placed on the book.
+	Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL Order_SelfTradePreventionMode = 4
+)
+
+var Order_SelfTradePreventionMode_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_MODE_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER",
+	2: "SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER",
+	3: "SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL",
+}
+
+var Order_SelfTradePreventionMode_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_MODE_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER":         1,
+	"SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER":         2,
+	"SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x Order_SelfTradePreventionMode) String() string {
+	return proto.EnumName(Order_SelfTradePreventionMode_name, int32(x))
+}
+
+func (Order_SelfTradePreventionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{7, 3}
+}
+
+// OrderGroupType determines how the orders of an order group are linked.
+type Order_OrderGroupType int32
+
+const (
+	// ORDER_GROUP_TYPE_UNSPECIFIED represents an order that is not part of an
+	// order group.
+	Order_ORDER_GROUP_TYPE_UNSPECIFIED Order_OrderGroupType = 0
+	// ORDER_GROUP_TYPE_OCO represents a one-cancels-other group. A fill or
+	// removal of any order in the group cancels all other orders in the
+	// group.
+	Order_ORDER_GROUP_TYPE_OCO Order_OrderGroupType = 1
+	// ORDER_GROUP_TYPE_BRACKET represents a bracket group consisting of at
+	// most one non-conditional entry order and conditional take profit and
+	// stop loss orders. The conditional orders behave as a one-cancels-other
+	// group. Removing the entry order before it is filled cancels the
+	// conditional orders, while filling the entry order leaves them in place.
+	Order_ORDER_GROUP_TYPE_BRACKET Order_OrderGroupType = 2
+)
+
+var Order_OrderGroupType_name = map[int32]string{
+	0: "ORDER_GROUP_TYPE_UNSPECIFIED",
+	1: "ORDER_GROUP_TYPE_OCO",
+	2: "ORDER_GROUP_TYPE_BRACKET",
+}
+
+var Order_OrderGroupType_value = map[string]int32{
+	"ORDER_GROUP_TYPE_UNSPECIFIED": 0,
+	"ORDER_GROUP_TYPE_OCO":         1,
+	"ORDER_GROUP_TYPE_BRACKET":     2,
+}
+
+func (x Order_OrderGroupType) String() string {
+	return proto.EnumName(Order_OrderGroupType_name, int32(x))
+}
+
+func (Order_OrderGroupType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{7, 4}
+}
+
 // OrderId refers to a single order belonging to a Subaccount.
 type OrderId struct {
 	// The subaccount ID that opened this order.
@@ -154,19 +256,18 @@ type OrderId struct {
 	// sub account (I.E., the same subaccount can't have two orders with
 	// the same ClientId).
 	ClientId uint32 `protobuf:"fixed32,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// order_flags represent order flags for the order. This field is invalid if
-	// it's greater than 127 (larger than one byte). Each bit in the first byte
-	// represents a different flag. Currently only two flags are supported.
+	// order_flags represent order flags for the order. Each bit represents a
+	// different flag. Currently four flags are supported.
 	//
-	// Starting from the bit after the most MSB (note that the MSB is used in
-	// proto varint encoding, and therefore cannot be used): Bit 1 is set if this
-	// order is a Long-Term order (0x40, or 64 as a uint8). Bit 2 is set if this
-	// order is a Conditional order (0x20, or 32 as a uint8).
+	// Bit 6 is set if this order is a Long-Term order (0x40, or 64). Bit 5 is
+	// set if this order is a Conditional order (0x20, or 32). Bit 7 is set if
+	// this order is a TWAP order (0x80, or 128). Bit 8 is set if this order is
+	// a suborder generated by a TWAP order (0x100, or 256).
 	//
-	// If neither bit is set, the order is assumed to be a Short-Term order.
+	// If no bit is set, the order is assumed to be a Short-Term order.
 	//
-	// If both bits are set or bits other than the 2nd and 3rd are set, the order
-	// ID is invalid.
+	// If more than one bit is set or any other bit is set, the order ID is
+	// invalid.
 	OrderFlags uint32 `protobuf:"varint,3,opt,name=order_flags,json=orderFlags,proto3" json:"order_flags,omitempty"`
 	// ID of the CLOB the order is created for.
 	ClobPairId uint32 `protobuf:"varint,4,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
@@ -451,6 +552,10 @@ type LongTermOrderPlacement struct {
 	// The block height and transaction index at which the order was placed.
 	// Used for ordering by time priority when the chain is restarted.
 	PlacementIndex TransactionOrdering `protobuf:"bytes,2,opt,name=placement_index,json=placementIndex,proto3" json:"placement_index"`
+	// The current trigger price of an untriggered trailing stop order, in
+	// subticks. Set once the trigger price has moved away from the order's
+	// `conditional_order_trigger_subticks` and zero otherwise.
+	TrailingStopTriggerSubticks uint64 `protobuf:"varint,3,opt,name=trailing_stop_trigger_subticks,json=trailingStopTriggerSubticks,proto3" json:"trailing_stop_trigger_subticks,omitempty"`
 }
 
 func (m *LongTermOrderPlacement) Reset()         { *m = LongTermOrderPlacement{} }
@@ -500,6 +605,13 @@ func (m *LongTermOrderPlacement) GetPlacementIndex() TransactionOrdering {
 	return TransactionOrdering{}
 }
 
+func (m *LongTermOrderPlacement) GetTrailingStopTriggerSubticks() uint64 {
+	if m != nil {
+		return m.TrailingStopTriggerSubticks
+	}
+	return 0
+}
+
 // ConditionalOrderPlacement represents the placement of a conditional order in
 // state. It stores the stateful order itself, the `BlockHeight` and
 // `TransactionIndex` at which the order was placed and triggered.
@@ -583,7 +695,6 @@ type Order struct {
 	// Information about when the order expires.
 	//
 	// Types that are valid to be assigned to GoodTilOneof:
-	//
 	//	*Order_GoodTilBlock
 	//	*Order_GoodTilBlockTime
 	GoodTilOneof isOrder_GoodTilOneof `protobuf_oneof:"good_til_oneof"`
@@ -607,6 +718,27 @@ type Order struct {
 	// Must be a multiple of ClobPair.SubticksPerTick (where `ClobPair.Id =
 	// orderId.ClobPairId`).
 	ConditionalOrderTriggerSubticks uint64 `protobuf:"varint,11,opt,name=conditional_order_trigger_subticks,json=conditionalOrderTriggerSubticks,proto3" json:"conditional_order_trigger_subticks,omitempty"`
+	// The self-trade prevention mode of this order.
+	SelfTradePreventionMode Order_SelfTradePreventionMode `protobuf:"varint,12,opt,name=self_trade_prevention_mode,json=selfTradePreventionMode,proto3,enum=dydxprotocol.clob.Order_SelfTradePreventionMode" json:"self_trade_prevention_mode,omitempty"`
+	// trailing_offset_subticks is the distance, in subticks, that the trigger
+	// price of a CONDITION_TYPE_TRAILING_STOP order trails the oracle price.
+	// Exactly one of trailing_offset_subticks and trailing_offset_ppm must be
+	// nonzero for trailing stop orders, and both must be 0 for all other
+	// orders.
+	TrailingOffsetSubticks uint64 `protobuf:"varint,13,opt,name=trailing_offset_subticks,json=trailingOffsetSubticks,proto3" json:"trailing_offset_subticks,omitempty"`
+	// trailing_offset_ppm is the distance, in parts-per-million of the oracle
+	// price, that the trigger price of a CONDITION_TYPE_TRAILING_STOP order
+	// trails the oracle price. Must be less than 1,000,000.
+	TrailingOffsetPpm uint32 `protobuf:"varint,14,opt,name=trailing_offset_ppm,json=trailingOffsetPpm,proto3" json:"trailing_offset_ppm,omitempty"`
+	// order_group_id is the id of the order group this order belongs to,
+	// unique per subaccount. Must be nonzero if order_group_type is set, and 0
+	// otherwise. Only stateful orders can be part of an order group.
+	OrderGroupId uint32 `protobuf:"varint,15,opt,name=order_group_id,json=orderGroupId,proto3" json:"order_group_id,omitempty"`
+	// The type of the order group this order belongs to.
+	OrderGroupType Order_OrderGroupType `protobuf:"varint,16,opt,name=order_group_type,json=orderGroupType,proto3,enum=dydxprotocol.clob.Order_OrderGroupType" json:"order_group_type,omitempty"`
+	// twap_parameters are the parameters of a TWAP order. Must be set for TWAP
+	// orders and unset for all other orders.
+	TwapParameters *TwapParameters `protobuf:"bytes,17,opt,name=twap_parameters,json=twapParameters,proto3" json:"twap_parameters,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -742,6 +874,48 @@ func (m *Order) GetConditionalOrderTriggerSubticks() uint64 {
 	return 0
 }
 
+func (m *Order) GetSelfTradePreventionMode() Order_SelfTradePreventionMode {
+	if m != nil {
+		return m.SelfTradePreventionMode
+	}
+	return Order_SELF_TRADE_PREVENTION_MODE_UNSPECIFIED
+}
+
+func (m *Order) GetTrailingOffsetSubticks() uint64 {
+	if m != nil {
+		return m.TrailingOffsetSubticks
+	}
+	return 0
+}
+
+func (m *Order) GetTrailingOffsetPpm() uint32 {
+	if m != nil {
+		return m.TrailingOffsetPpm
+	}
+	return 0
+}
+
+func (m *Order) GetOrderGroupId() uint32 {
+	if m != nil {
+		return m.OrderGroupId
+	}
+	return 0
+}
+
+func (m *Order) GetOrderGroupType() Order_OrderGroupType {
+	if m != nil {
+		return m.OrderGroupType
+	}
+	return Order_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+func (m *Order) GetTwapParameters() *TwapParameters {
+	if m != nil {
+		return m.TwapParameters
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -750,6 +924,179 @@ func (*Order) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// TwapParameters represents the parameters of a TWAP order. A TWAP order is
+// executed as a sequence of immediate-or-cancel suborders, one per interval,
+// over the duration of the order. The subticks of the TWAP order are used as
+// the limit price of each suborder.
+type TwapParameters struct {
+	// Duration of the TWAP order in seconds. Must be a multiple of the interval.
+	Duration uint32 `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Interval between the suborders of the TWAP order in seconds.
+	Interval uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *TwapParameters) Reset()         { *m = TwapParameters{} }
+func (m *TwapParameters) String() string { return proto.CompactTextString(m) }
+func (*TwapParameters) ProtoMessage()    {}
+func (*TwapParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{8}
+}
+func (m *TwapParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapParameters.Merge(m, src)
+}
+func (m *TwapParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapParameters proto.InternalMessageInfo
+
+func (m *TwapParameters) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *TwapParameters) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// TwapOrderState represents the execution progress of a TWAP order in state.
+// The aggregate fill amount of the suborders of a TWAP order is stored as the
+// fill amount of the TWAP order.
+type TwapOrderState struct {
+	// The number of suborders that remain to be generated.
+	RemainingLegs uint32 `protobuf:"varint,1,opt,name=remaining_legs,json=remainingLegs,proto3" json:"remaining_legs,omitempty"`
+	// The block time, in seconds since the epoch, at or after which the next
+	// suborder is generated or, if no suborders remain, the TWAP order is
+	// completed.
+	NextSuborderTime uint32 `protobuf:"fixed32,2,opt,name=next_suborder_time,json=nextSuborderTime,proto3" json:"next_suborder_time,omitempty"`
+}
+
+func (m *TwapOrderState) Reset()         { *m = TwapOrderState{} }
+func (m *TwapOrderState) String() string { return proto.CompactTextString(m) }
+func (*TwapOrderState) ProtoMessage()    {}
+func (*TwapOrderState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{9}
+}
+func (m *TwapOrderState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapOrderState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapOrderState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapOrderState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapOrderState.Merge(m, src)
+}
+func (m *TwapOrderState) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapOrderState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapOrderState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapOrderState proto.InternalMessageInfo
+
+func (m *TwapOrderState) GetRemainingLegs() uint32 {
+	if m != nil {
+		return m.RemainingLegs
+	}
+	return 0
+}
+
+func (m *TwapOrderState) GetNextSuborderTime() uint32 {
+	if m != nil {
+		return m.NextSuborderTime
+	}
+	return 0
+}
+
+// OrderGroup represents the set of stateful orders in state that belong to
+// the same order group of a subaccount.
+type OrderGroup struct {
+	// The type of the order group.
+	Type Order_OrderGroupType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.clob.Order_OrderGroupType" json:"type,omitempty"`
+	// The ids of the orders in the order group that are in state.
+	OrderIds []OrderId `protobuf:"bytes,2,rep,name=order_ids,json=orderIds,proto3" json:"order_ids"`
+}
+
+func (m *OrderGroup) Reset()         { *m = OrderGroup{} }
+func (m *OrderGroup) String() string { return proto.CompactTextString(m) }
+func (*OrderGroup) ProtoMessage()    {}
+func (*OrderGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_673c6f4faa93736b, []int{10}
+}
+func (m *OrderGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderGroup.Merge(m, src)
+}
+func (m *OrderGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderGroup proto.InternalMessageInfo
+
+func (m *OrderGroup) GetType() Order_OrderGroupType {
+	if m != nil {
+		return m.Type
+	}
+	return Order_ORDER_GROUP_TYPE_UNSPECIFIED
+}
+
+func (m *OrderGroup) GetOrderIds() []OrderId {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
 // TransactionOrdering represents a unique location in the block where a
 // transaction was placed. This proto includes both block height and the
 // transaction index that the specific transaction was placed. This information
@@ -765,7 +1112,7 @@ func (m *TransactionOrdering) Reset()         { *m = TransactionOrdering{} }
 func (m *TransactionOrdering) String() string { return proto.CompactTextString(m) }
 func (*TransactionOrdering) ProtoMessage()    {}
 func (*TransactionOrdering) Descriptor() ([]byte, []int) {
-	return fileDescriptor_673c6f4faa93736b, []int{8}
+	return fileDescriptor_673c6f4faa93736b, []int{11}
 }
 func (m *TransactionOrdering) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,6 +1159,8 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.clob.Order_Side", Order_Side_name, Order_Side_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_TimeInForce", Order_TimeInForce_name, Order_TimeInForce_value)
 	proto.RegisterEnum("dydxprotocol.clob.Order_ConditionType", Order_ConditionType_name, Order_ConditionType_value)
+	proto.RegisterEnum("dydxprotocol.clob.Order_SelfTradePreventionMode", Order_SelfTradePreventionMode_name, Order_SelfTradePreventionMode_value)
+	proto.RegisterEnum("dydxprotocol.clob.Order_OrderGroupType", Order_OrderGroupType_name, Order_OrderGroupType_value)
 	proto.RegisterType((*OrderId)(nil), "dydxprotocol.clob.OrderId")
 	proto.RegisterType((*OrdersFilledDuringLatestBlock)(nil), "dydxprotocol.clob.OrdersFilledDuringLatestBlock")
 	proto.RegisterType((*PotentiallyPrunableOrders)(nil), "dydxprotocol.clob.PotentiallyPrunableOrders")
@@ -820,75 +1169,105 @@ func init() {
 	proto.RegisterType((*LongTermOrderPlacement)(nil), "dydxprotocol.clob.LongTermOrderPlacement")
 	proto.RegisterType((*ConditionalOrderPlacement)(nil), "dydxprotocol.clob.ConditionalOrderPlacement")
 	proto.RegisterType((*Order)(nil), "dydxprotocol.clob.Order")
+	proto.RegisterType((*TwapParameters)(nil), "dydxprotocol.clob.TwapParameters")
+	proto.RegisterType((*TwapOrderState)(nil), "dydxprotocol.clob.TwapOrderState")
+	proto.RegisterType((*OrderGroup)(nil), "dydxprotocol.clob.OrderGroup")
 	proto.RegisterType((*TransactionOrdering)(nil), "dydxprotocol.clob.TransactionOrdering")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/order.proto", fileDescriptor_673c6f4faa93736b) }
 
 var fileDescriptor_673c6f4faa93736b = []byte{
-	// 989 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xdb, 0x6c, 0x9b, 0x9e, 0xfc, 0xac, 0x3b, 0xdd, 0x05, 0xb7, 0xa5, 0x69, 0x88, 0x50,
-	0x29, 0x42, 0x24, 0xa2, 0xac, 0x90, 0x10, 0xe2, 0x62, 0xdb, 0x26, 0xaa, 0xd5, 0xb4, 0x0e, 0xb6,
-	0x8b, 0xd4, 0x15, 0x62, 0xe4, 0xd8, 0x53, 0x77, 0xb4, 0x13, 0x4f, 0xb0, 0xc7, 0xa8, 0xb9, 0xe3,
-	0x11, 0x78, 0x09, 0xde, 0x82, 0x07, 0xd8, 0xcb, 0xbd, 0xe4, 0x0a, 0xa1, 0xf6, 0x19, 0x10, 0xb7,
-	0x68, 0xc6, 0x6e, 0xea, 0x74, 0xb7, 0x42, 0x68, 0x6f, 0xb8, 0xf3, 0x7c, 0xe7, 0x3b, 0xdf, 0x9c,
-	0xdf, 0x91, 0x61, 0x2b, 0x98, 0x06, 0x57, 0x93, 0x98, 0x0b, 0xee, 0x73, 0xd6, 0xf5, 0x19, 0x1f,
-	0x75, 0x79, 0x1c, 0x90, 0xb8, 0xa3, 0x30, 0xb4, 0x5a, 0x34, 0x77, 0xa4, 0x79, 0xe3, 0x49, 0xc8,
-	0x43, 0xae, 0xa0, 0xae, 0xfc, 0xca, 0x88, 0x1b, 0x9f, 0xcc, 0xe9, 0x24, 0xe9, 0xc8, 0xf3, 0x7d,
-	0x9e, 0x46, 0x22, 0x29, 0x7c, 0x67, 0xd4, 0xf6, 0x6f, 0x1a, 0x2c, 0x5b, 0xf2, 0x0e, 0x33, 0x40,
-	0xdf, 0x42, 0xfd, 0xce, 0x8e, 0x69, 0x60, 0x68, 0x2d, 0x6d, 0xb7, 0xba, 0xb7, 0xd3, 0x99, 0xbb,
-	0xb7, 0x20, 0xd7, 0x71, 0x66, 0xdf, 0x66, 0xb0, 0x5f, 0x7e, 0xf5, 0xc7, 0x76, 0xc9, 0xae, 0x25,
-	0x05, 0x0c, 0x6d, 0xc2, 0x8a, 0xcf, 0x28, 0xc9, 0xe4, 0x16, 0x5a, 0xda, 0xee, 0xb2, 0x5d, 0xc9,
-	0x00, 0x33, 0x40, 0xdb, 0x50, 0x55, 0xe9, 0xe1, 0x0b, 0xe6, 0x85, 0x89, 0xb1, 0xd8, 0xd2, 0x76,
-	0xeb, 0x36, 0x28, 0xa8, 0x2f, 0x11, 0xd4, 0x82, 0x9a, 0xcc, 0x12, 0x4f, 0x3c, 0x1a, 0x4b, 0x81,
-	0x72, 0xc6, 0x90, 0xd8, 0xd0, 0xa3, 0xb1, 0x19, 0xb4, 0x7f, 0x80, 0x2d, 0x15, 0x7d, 0xd2, 0xa7,
-	0x8c, 0x91, 0xe0, 0x30, 0x8d, 0x69, 0x14, 0x0e, 0x3c, 0x41, 0x12, 0xb1, 0xcf, 0xb8, 0xff, 0x12,
-	0x7d, 0x03, 0x2b, 0xd9, 0x1d, 0x34, 0x48, 0x0c, 0xad, 0xb5, 0xb8, 0x5b, 0xdd, 0xdb, 0xe8, 0xbc,
-	0x51, 0xc7, 0x4e, 0x5e, 0x82, 0x3c, 0x87, 0x0a, 0xcf, 0x8e, 0x49, 0xfb, 0x05, 0xac, 0x0f, 0xb9,
-	0x20, 0x91, 0xa0, 0x1e, 0x63, 0xd3, 0x61, 0x9c, 0x46, 0xde, 0x88, 0x91, 0xec, 0xca, 0x77, 0xd5,
-	0x26, 0xd0, 0x50, 0x26, 0x19, 0xba, 0x23, 0x3c, 0x41, 0x64, 0x41, 0x2e, 0x28, 0x63, 0xd8, 0x1b,
-	0xcb, 0xf2, 0xa9, 0xf2, 0x97, 0x6d, 0x90, 0xd0, 0x73, 0x85, 0xa0, 0x3d, 0x78, 0x3a, 0xc9, 0x63,
-	0xc0, 0x23, 0x99, 0x1f, 0xbe, 0x24, 0x34, 0xbc, 0x14, 0xaa, 0xb4, 0x75, 0x7b, 0xed, 0xd6, 0xa8,
-	0x72, 0x3f, 0x52, 0xa6, 0xf6, 0xf7, 0xb0, 0xa9, 0xd4, 0x2f, 0x52, 0xa6, 0xae, 0x73, 0xe9, 0x98,
-	0x38, 0x8c, 0xfa, 0xe4, 0x3b, 0x8f, 0xa5, 0xe4, 0x5d, 0x93, 0xf8, 0x55, 0x83, 0xf7, 0x06, 0x3c,
-	0x0a, 0x5d, 0x12, 0x8f, 0x15, 0x67, 0xc8, 0x3c, 0x9f, 0x8c, 0x49, 0x24, 0xd0, 0x33, 0x78, 0xa4,
-	0x68, 0xf9, 0x18, 0x19, 0x0f, 0xa9, 0xe6, 0x9a, 0x19, 0x19, 0x9d, 0xc1, 0xe3, 0xc9, 0xad, 0x04,
-	0xa6, 0x51, 0x40, 0xae, 0x54, 0x72, 0x6f, 0x8c, 0xa1, 0xf2, 0x77, 0x63, 0x2f, 0x4a, 0x3c, 0x5f,
-	0x50, 0x1e, 0x29, 0x29, 0x1a, 0x85, 0xb9, 0x5a, 0x63, 0x26, 0x62, 0x4a, 0x8d, 0xf6, 0x5f, 0x1a,
-	0xac, 0x1f, 0xf0, 0x28, 0xa0, 0x92, 0xeb, 0xb1, 0xff, 0x71, 0xa8, 0xe8, 0x18, 0xea, 0x22, 0xa6,
-	0x61, 0x28, 0x7b, 0xa2, 0x44, 0x17, 0xff, 0x8b, 0xa8, 0x5d, 0xcb, 0x9d, 0xb3, 0xbc, 0xff, 0x5e,
-	0x82, 0x47, 0xca, 0x84, 0xbe, 0x86, 0xca, 0x6d, 0xa3, 0xf3, 0x34, 0xff, 0xbd, 0xcf, 0xcb, 0x79,
-	0x9f, 0xd1, 0xe7, 0x50, 0x4e, 0x68, 0x40, 0x54, 0x7e, 0x8d, 0xbd, 0xad, 0x87, 0x1c, 0x3b, 0x0e,
-	0x0d, 0x88, 0xad, 0xa8, 0x68, 0x03, 0x2a, 0x3f, 0xa6, 0x5e, 0x24, 0xd2, 0x71, 0xb6, 0xda, 0x65,
-	0x7b, 0x76, 0x96, 0xb6, 0x24, 0x1d, 0x09, 0xea, 0xbf, 0x4c, 0xd4, 0x52, 0x97, 0xed, 0xd9, 0x19,
-	0xed, 0x40, 0x23, 0xe4, 0x3c, 0xc0, 0x82, 0xb2, 0x6c, 0xc6, 0x8d, 0x47, 0x72, 0xb8, 0x8f, 0x4a,
-	0x76, 0x4d, 0xe2, 0x2e, 0x65, 0xd9, 0x66, 0x77, 0x61, 0x6d, 0x9e, 0x87, 0x05, 0x1d, 0x13, 0x63,
-	0x49, 0x3e, 0x32, 0x47, 0x25, 0x5b, 0x2f, 0x92, 0xe5, 0xcc, 0xa3, 0x23, 0xa8, 0x4b, 0x06, 0xa6,
-	0x11, 0xbe, 0xe0, 0xb1, 0x4f, 0x8c, 0x65, 0x95, 0xcc, 0x47, 0x0f, 0x26, 0x23, 0xbd, 0xcc, 0xa8,
-	0x2f, 0xb9, 0x76, 0x55, 0xdc, 0x1d, 0xe4, 0x9e, 0xc6, 0x24, 0x48, 0x7d, 0x82, 0x79, 0xc4, 0xa6,
-	0x46, 0xa5, 0xa5, 0xed, 0x56, 0x6c, 0xc8, 0x20, 0x2b, 0x62, 0x53, 0xf4, 0x31, 0x3c, 0xce, 0x9f,
-	0xbd, 0x31, 0x11, 0x5e, 0xe0, 0x09, 0xcf, 0x58, 0x51, 0x1b, 0xda, 0xc8, 0xe0, 0x93, 0x1c, 0x45,
-	0x27, 0xd0, 0xf0, 0x6f, 0xa7, 0x12, 0x8b, 0xe9, 0x84, 0x18, 0xa0, 0x82, 0xda, 0x79, 0x30, 0xa8,
-	0xd9, 0x10, 0xbb, 0xd3, 0x09, 0xb1, 0xeb, 0x7e, 0xf1, 0x88, 0x8e, 0xa1, 0xed, 0xdf, 0x0d, 0x39,
-	0xce, 0xfa, 0x7d, 0x3b, 0x4c, 0xb3, 0x8a, 0x57, 0x55, 0xc5, 0xb7, 0xfd, 0x7b, 0xeb, 0xe0, 0x66,
-	0x3c, 0x27, 0xa7, 0xb5, 0xbf, 0x82, 0xb2, 0x6c, 0x27, 0x7a, 0x02, 0xba, 0x63, 0x1e, 0xf6, 0xf0,
-	0xd9, 0xa9, 0x33, 0xec, 0x1d, 0x98, 0x7d, 0xb3, 0x77, 0xa8, 0x97, 0x50, 0x0d, 0x2a, 0x0a, 0xdd,
-	0x3f, 0x3b, 0xd7, 0x35, 0x54, 0x87, 0x15, 0x75, 0x72, 0x7a, 0x83, 0x81, 0xbe, 0xd0, 0xfe, 0x59,
-	0x83, 0x6a, 0xa1, 0x7a, 0x68, 0x0b, 0xd6, 0x5d, 0xf3, 0xa4, 0x87, 0xcd, 0x53, 0xdc, 0xb7, 0xec,
-	0x83, 0xfb, 0x5a, 0x4f, 0x61, 0x75, 0xde, 0x6c, 0x5a, 0x07, 0xba, 0x86, 0x36, 0xe1, 0xfd, 0x79,
-	0x78, 0x68, 0x39, 0x2e, 0xb6, 0x4e, 0x07, 0xe7, 0xfa, 0x02, 0x6a, 0xc2, 0xc6, 0xbc, 0xb1, 0x6f,
-	0x0e, 0x06, 0xd8, 0xb2, 0xf1, 0xb1, 0x39, 0x18, 0xe8, 0x8b, 0xed, 0x31, 0xd4, 0xe7, 0x4a, 0x25,
-	0x1d, 0x0e, 0xac, 0xd3, 0x43, 0xd3, 0x35, 0xad, 0x53, 0xec, 0x9e, 0x0f, 0xef, 0x07, 0xf1, 0x01,
-	0x18, 0xf7, 0xec, 0x8e, 0x6b, 0x0d, 0xf1, 0xc0, 0x72, 0x1c, 0x5d, 0x7b, 0x8b, 0xb7, 0xfb, 0xfc,
-	0xb8, 0x87, 0x87, 0xb6, 0xd5, 0x37, 0x5d, 0x7d, 0x61, 0x5f, 0x2f, 0x4c, 0x2d, 0x8f, 0x08, 0xbf,
-	0x68, 0x13, 0x58, 0x7b, 0xcb, 0x7a, 0xa2, 0x0f, 0xa1, 0x36, 0xf7, 0x72, 0x6b, 0x6a, 0x2e, 0xaa,
-	0xa3, 0xbb, 0x17, 0x1b, 0x7d, 0x0a, 0xab, 0xe2, 0xce, 0xb3, 0xf0, 0xb2, 0xd4, 0x6d, 0xbd, 0x60,
-	0x50, 0x0b, 0xbe, 0x3f, 0x7c, 0x75, 0xdd, 0xd4, 0x5e, 0x5f, 0x37, 0xb5, 0x3f, 0xaf, 0x9b, 0xda,
-	0x2f, 0x37, 0xcd, 0xd2, 0xeb, 0x9b, 0x66, 0xe9, 0xf7, 0x9b, 0x66, 0xe9, 0xc5, 0x97, 0x21, 0x15,
-	0x97, 0xe9, 0xa8, 0xe3, 0xf3, 0x71, 0x77, 0xee, 0x87, 0xe0, 0xa7, 0x67, 0x9f, 0xf9, 0x97, 0x1e,
-	0x8d, 0xba, 0x33, 0xe4, 0x2a, 0xfb, 0xd9, 0x90, 0x03, 0x98, 0x8c, 0x96, 0x14, 0xfc, 0xc5, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x0b, 0xbd, 0x8a, 0x8e, 0x08, 0x00, 0x00,
+	// 1417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x72, 0xdb, 0x46,
+	0x12, 0x16, 0x28, 0xda, 0x92, 0x5a, 0x24, 0x05, 0x8d, 0xfc, 0x03, 0x4b, 0x16, 0x4d, 0xb3, 0xbc,
+	0xb2, 0x76, 0xbd, 0x4b, 0x6d, 0x64, 0x57, 0x2a, 0x29, 0x57, 0x0e, 0x12, 0x09, 0x5a, 0x88, 0x28,
+	0x82, 0x01, 0x20, 0x57, 0xd9, 0x95, 0xca, 0x14, 0x08, 0x0c, 0xa1, 0x89, 0x41, 0x00, 0x01, 0x86,
+	0xb6, 0x74, 0xf3, 0x31, 0xb7, 0xe4, 0x2d, 0xf2, 0x12, 0x79, 0x00, 0x1f, 0x7d, 0xcc, 0x29, 0x95,
+	0xb2, 0x6f, 0xb9, 0xe7, 0x9e, 0x9a, 0x01, 0xf8, 0xa7, 0x1f, 0x3b, 0x8e, 0x2f, 0xb9, 0x71, 0xfa,
+	0xfb, 0xfa, 0x9b, 0xe9, 0x9e, 0xee, 0x41, 0x13, 0xd6, 0xdd, 0x13, 0xf7, 0x38, 0x8a, 0x43, 0x16,
+	0x3a, 0xa1, 0xbf, 0xe5, 0xf8, 0x61, 0x77, 0x2b, 0x8c, 0x5d, 0x12, 0xd7, 0x84, 0x0d, 0x2d, 0x4f,
+	0xc2, 0x35, 0x0e, 0xaf, 0x5e, 0xf1, 0x42, 0x2f, 0x14, 0xa6, 0x2d, 0xfe, 0x2b, 0x25, 0xae, 0xfe,
+	0x7b, 0x4a, 0x27, 0x19, 0x74, 0x6d, 0xc7, 0x09, 0x07, 0x01, 0x4b, 0x26, 0x7e, 0xa7, 0xd4, 0xea,
+	0xcf, 0x12, 0xcc, 0xe9, 0x7c, 0x0f, 0xcd, 0x45, 0x5f, 0x41, 0x71, 0x8c, 0x63, 0xea, 0x2a, 0x52,
+	0x45, 0xda, 0x5c, 0xdc, 0xde, 0xa8, 0x4d, 0xed, 0x3b, 0x21, 0x57, 0x33, 0x47, 0xbf, 0x35, 0x77,
+	0x37, 0xff, 0xea, 0xd7, 0x5b, 0x33, 0x46, 0x21, 0x99, 0xb0, 0xa1, 0x35, 0x58, 0x70, 0x7c, 0x4a,
+	0x52, 0xb9, 0x5c, 0x45, 0xda, 0x9c, 0x33, 0xe6, 0x53, 0x83, 0xe6, 0xa2, 0x5b, 0xb0, 0x28, 0xc2,
+	0xc3, 0x3d, 0xdf, 0xf6, 0x12, 0x65, 0xb6, 0x22, 0x6d, 0x16, 0x0d, 0x10, 0xa6, 0x26, 0xb7, 0xa0,
+	0x0a, 0x14, 0x78, 0x94, 0x38, 0xb2, 0x69, 0xcc, 0x05, 0xf2, 0x29, 0x83, 0xdb, 0x3a, 0x36, 0x8d,
+	0x35, 0xb7, 0xfa, 0x0d, 0xac, 0x8b, 0xd3, 0x27, 0x4d, 0xea, 0xfb, 0xc4, 0x6d, 0x0c, 0x62, 0x1a,
+	0x78, 0x2d, 0x9b, 0x91, 0x84, 0xed, 0xfa, 0xa1, 0xf3, 0x0c, 0x7d, 0x01, 0x0b, 0xe9, 0x1e, 0xd4,
+	0x4d, 0x14, 0xa9, 0x32, 0xbb, 0xb9, 0xb8, 0xbd, 0x5a, 0x3b, 0x93, 0xc7, 0x5a, 0x96, 0x82, 0x2c,
+	0x86, 0xf9, 0x30, 0x5d, 0x26, 0xd5, 0xa7, 0x70, 0xa3, 0x13, 0x32, 0x12, 0x30, 0x6a, 0xfb, 0xfe,
+	0x49, 0x27, 0x1e, 0x04, 0x76, 0xd7, 0x27, 0xe9, 0x96, 0x1f, 0xab, 0x4d, 0xa0, 0x24, 0x20, 0x7e,
+	0x74, 0x93, 0xd9, 0x8c, 0xf0, 0x84, 0xf4, 0xa8, 0xef, 0x63, 0xbb, 0xcf, 0xd3, 0x27, 0xd2, 0x9f,
+	0x37, 0x80, 0x9b, 0x76, 0x84, 0x05, 0x6d, 0xc3, 0xd5, 0x28, 0x3b, 0x03, 0xee, 0xf2, 0xf8, 0xf0,
+	0x11, 0xa1, 0xde, 0x11, 0x13, 0xa9, 0x2d, 0x1a, 0x2b, 0x43, 0x50, 0xc4, 0xbe, 0x27, 0xa0, 0xea,
+	0xd7, 0xb0, 0x26, 0xd4, 0x7b, 0x03, 0x5f, 0x6c, 0x67, 0xd1, 0x3e, 0x31, 0x7d, 0xea, 0x90, 0xc7,
+	0xb6, 0x3f, 0x20, 0x1f, 0x1b, 0xc4, 0xef, 0x12, 0x5c, 0x6b, 0x85, 0x81, 0x67, 0x91, 0xb8, 0x2f,
+	0x38, 0x1d, 0xdf, 0x76, 0x48, 0x9f, 0x04, 0x0c, 0x3d, 0x80, 0x4b, 0x82, 0x96, 0x95, 0x91, 0x72,
+	0x91, 0x6a, 0xa6, 0x99, 0x92, 0xd1, 0x21, 0x2c, 0x45, 0x43, 0x09, 0x4c, 0x03, 0x97, 0x1c, 0x2b,
+	0xb9, 0xf3, 0xca, 0x50, 0xf8, 0x5b, 0xb1, 0x1d, 0x24, 0xb6, 0xc3, 0x68, 0x18, 0x08, 0x29, 0x1a,
+	0x78, 0x99, 0x5a, 0x69, 0x24, 0xa2, 0x71, 0x0d, 0x54, 0x87, 0x32, 0x8b, 0x6d, 0xea, 0xd3, 0xc0,
+	0xc3, 0x09, 0x0b, 0x23, 0xcc, 0x62, 0xea, 0x79, 0x24, 0xc6, 0xc9, 0xa0, 0xcb, 0xa8, 0xf3, 0x2c,
+	0x2d, 0xbf, 0xbc, 0xb1, 0x36, 0x64, 0x99, 0x2c, 0x8c, 0xac, 0x94, 0x63, 0x66, 0x94, 0xea, 0x1f,
+	0x12, 0xdc, 0xa8, 0x87, 0x81, 0x4b, 0xf9, 0x86, 0xb6, 0xff, 0x4f, 0x8e, 0x77, 0x1f, 0x8a, 0xc3,
+	0x08, 0x53, 0xd1, 0xd9, 0x0f, 0x11, 0x35, 0x0a, 0x99, 0xb3, 0x10, 0xab, 0xfe, 0x54, 0x84, 0x4b,
+	0x02, 0x42, 0x0f, 0x61, 0x7e, 0x58, 0x2d, 0x59, 0x98, 0xef, 0x2f, 0x96, 0xb9, 0xac, 0x58, 0xd0,
+	0x27, 0x90, 0x4f, 0xa8, 0x4b, 0x44, 0x7c, 0xa5, 0xed, 0xf5, 0x8b, 0x1c, 0x6b, 0x26, 0x75, 0x89,
+	0x21, 0xa8, 0x68, 0x15, 0xe6, 0xbf, 0x1b, 0xd8, 0x01, 0x1b, 0xf4, 0x87, 0x17, 0x34, 0x5a, 0x73,
+	0x6c, 0x74, 0x79, 0xf9, 0x14, 0x1b, 0xae, 0xd1, 0x06, 0x94, 0xbc, 0x30, 0x74, 0x31, 0xa3, 0x7e,
+	0xda, 0x28, 0xca, 0x25, 0xde, 0x21, 0x7b, 0x33, 0x46, 0x81, 0xdb, 0x2d, 0xea, 0xa7, 0xcf, 0xc3,
+	0x16, 0xac, 0x4c, 0xf3, 0x30, 0xa3, 0x7d, 0xa2, 0x5c, 0xe6, 0x2f, 0xd5, 0xde, 0x8c, 0x21, 0x4f,
+	0x92, 0x79, 0xe3, 0xa0, 0x3d, 0x28, 0x72, 0x06, 0xa6, 0x01, 0xee, 0x85, 0xb1, 0x43, 0x94, 0x39,
+	0x11, 0xcc, 0x9d, 0x0b, 0x83, 0xe1, 0x5e, 0x5a, 0xd0, 0xe4, 0x5c, 0x63, 0x91, 0x8d, 0x17, 0xbc,
+	0xd9, 0x63, 0xe2, 0x0e, 0x1c, 0x82, 0xc3, 0xc0, 0x3f, 0x51, 0xe6, 0x2b, 0xd2, 0xe6, 0xbc, 0x01,
+	0xa9, 0x49, 0x0f, 0xfc, 0x13, 0x74, 0x17, 0x96, 0xb2, 0xb7, 0xb3, 0x4f, 0x98, 0xed, 0xda, 0xcc,
+	0x56, 0x16, 0x44, 0x9b, 0x97, 0x52, 0xf3, 0x41, 0x66, 0x45, 0x07, 0x50, 0x72, 0x86, 0x55, 0x89,
+	0xd9, 0x49, 0x44, 0x14, 0x10, 0x87, 0xda, 0xb8, 0xf0, 0x50, 0xa3, 0x22, 0xb6, 0x4e, 0x22, 0x62,
+	0x14, 0x9d, 0xc9, 0x25, 0xda, 0x87, 0xaa, 0x33, 0x2e, 0x72, 0x9c, 0xde, 0xf7, 0x99, 0x76, 0x59,
+	0x14, 0x19, 0xbf, 0xe5, 0x9c, 0x6a, 0x87, 0x53, 0x2d, 0x83, 0xfa, 0xb0, 0x9a, 0x10, 0xbf, 0x87,
+	0x59, 0x6c, 0xbb, 0x04, 0x47, 0x31, 0x79, 0xce, 0x1f, 0xd3, 0x30, 0xc0, 0xfd, 0xd0, 0x25, 0x4a,
+	0x41, 0x9c, 0xf3, 0xff, 0x17, 0x57, 0x02, 0xf1, 0x7b, 0x16, 0xf7, 0xec, 0x8c, 0x1c, 0x0f, 0x42,
+	0x97, 0x18, 0xd7, 0x93, 0xf3, 0x01, 0xf4, 0x19, 0x28, 0xa3, 0x36, 0x0f, 0x7b, 0xbd, 0x84, 0xb0,
+	0xf1, 0x89, 0x8b, 0xe2, 0xc4, 0xd7, 0x86, 0xb8, 0x2e, 0xe0, 0xd1, 0x41, 0x6b, 0xb0, 0x72, 0xda,
+	0x33, 0x8a, 0xfa, 0x4a, 0x49, 0x64, 0x7c, 0x79, 0xda, 0xa9, 0x13, 0xf5, 0xd1, 0x1d, 0x28, 0xa5,
+	0x99, 0xf1, 0xe2, 0x70, 0x10, 0xf1, 0x7e, 0x58, 0x12, 0xd4, 0x82, 0xb0, 0x3e, 0xe2, 0x46, 0xf1,
+	0x49, 0x95, 0x27, 0x59, 0xe2, 0x72, 0x64, 0x11, 0xf4, 0xdd, 0x0b, 0x83, 0xd6, 0x47, 0x02, 0xe2,
+	0x76, 0x4a, 0xe1, 0xd4, 0x1a, 0x7d, 0x09, 0x4b, 0xec, 0x85, 0x1d, 0xe1, 0xc8, 0x8e, 0xed, 0x3e,
+	0x61, 0x24, 0x4e, 0x94, 0x65, 0xd1, 0x89, 0xb7, 0xcf, 0xeb, 0xed, 0x17, 0x76, 0xd4, 0x19, 0x11,
+	0x8d, 0x12, 0x9b, 0x5a, 0x57, 0x3f, 0x87, 0x3c, 0x6f, 0x36, 0x74, 0x05, 0x64, 0x53, 0x6b, 0xa8,
+	0xf8, 0xb0, 0x6d, 0x76, 0xd4, 0xba, 0xd6, 0xd4, 0xd4, 0x86, 0x3c, 0x83, 0x0a, 0x30, 0x2f, 0xac,
+	0xbb, 0x87, 0x4f, 0x64, 0x09, 0x15, 0x61, 0x41, 0xac, 0x4c, 0xb5, 0xd5, 0x92, 0x73, 0xd5, 0x97,
+	0x12, 0x2c, 0x4e, 0xd4, 0x36, 0x5a, 0x87, 0x1b, 0x96, 0x76, 0xa0, 0x62, 0xad, 0x8d, 0x9b, 0xba,
+	0x51, 0x3f, 0xad, 0x75, 0x15, 0x96, 0xa7, 0x61, 0x4d, 0xaf, 0xcb, 0x12, 0x5a, 0x83, 0xeb, 0xd3,
+	0xe6, 0x8e, 0x6e, 0x5a, 0x58, 0x6f, 0xb7, 0x9e, 0xc8, 0x39, 0x54, 0x86, 0xd5, 0x69, 0xb0, 0xa9,
+	0xb5, 0x5a, 0x58, 0x37, 0xf0, 0xbe, 0xd6, 0x6a, 0xc9, 0xb3, 0xd5, 0x1f, 0x24, 0x28, 0x4e, 0x55,
+	0x32, 0xf7, 0xa8, 0xeb, 0xed, 0x86, 0x66, 0x69, 0x7a, 0x1b, 0x5b, 0x4f, 0x3a, 0xa7, 0x4f, 0x71,
+	0x13, 0x94, 0x53, 0xb8, 0x69, 0xe9, 0x1d, 0xdc, 0xd2, 0x4d, 0x53, 0x96, 0xce, 0xf1, 0xb6, 0x76,
+	0xf6, 0x55, 0xdc, 0x31, 0xf4, 0xa6, 0x66, 0xc9, 0x39, 0x54, 0x81, 0x9b, 0xa7, 0x71, 0x63, 0x47,
+	0x6b, 0x69, 0xed, 0x47, 0x42, 0x46, 0x9e, 0xad, 0xbe, 0xcc, 0xc1, 0xf5, 0x0b, 0x6a, 0x16, 0xfd,
+	0x07, 0x36, 0x4c, 0xb5, 0xd5, 0xe4, 0x3e, 0x0d, 0x2e, 0xaa, 0x3e, 0x56, 0xdb, 0x42, 0xe9, 0x40,
+	0x3f, 0x93, 0xf9, 0x7b, 0x70, 0xf7, 0x1d, 0xdc, 0xfa, 0x4e, 0xbb, 0xae, 0xb6, 0xf0, 0xc1, 0xce,
+	0xbe, 0x6a, 0xc8, 0xd2, 0x5f, 0x23, 0x5b, 0x82, 0x9c, 0x7b, 0xcf, 0x29, 0x32, 0xf2, 0xae, 0x6e,
+	0xed, 0xc9, 0xb3, 0xe8, 0x3e, 0x6c, 0xbd, 0x83, 0xdb, 0x50, 0xeb, 0x86, 0x7a, 0xa0, 0xb6, 0x2d,
+	0xbc, 0xd3, 0x6e, 0x64, 0x9e, 0x72, 0xbe, 0xfa, 0x6d, 0x36, 0xd5, 0x8c, 0x0b, 0xb6, 0x02, 0x37,
+	0x75, 0xa3, 0xa1, 0x1a, 0xf8, 0x91, 0xa1, 0x1f, 0x76, 0xce, 0xbb, 0x16, 0x05, 0xae, 0x9c, 0x61,
+	0xe8, 0x75, 0x5d, 0x96, 0xf8, 0x85, 0x9d, 0x41, 0x76, 0x8d, 0x9d, 0xfa, 0xbe, 0x6a, 0xc9, 0xb9,
+	0x5d, 0x79, 0xe2, 0x95, 0x0f, 0x03, 0x12, 0xf6, 0xaa, 0x7b, 0x50, 0x9a, 0x2e, 0x79, 0xfe, 0x95,
+	0x70, 0x07, 0xb1, 0xcd, 0xaf, 0x41, 0x7c, 0xb1, 0x8a, 0xc6, 0x68, 0xcd, 0x31, 0x1a, 0x30, 0x12,
+	0x3f, 0xb7, 0xfd, 0x6c, 0x82, 0x1a, 0xad, 0xab, 0x24, 0x55, 0x12, 0xb1, 0xa4, 0xd3, 0xd9, 0xbf,
+	0xa0, 0x14, 0x93, 0xbe, 0x4d, 0x03, 0xfe, 0x44, 0xf8, 0xc4, 0x4b, 0x32, 0xbd, 0xe2, 0xc8, 0xda,
+	0x22, 0x5e, 0x82, 0xfe, 0x0b, 0x28, 0x20, 0xc7, 0xe2, 0xdd, 0xc9, 0x9e, 0x4e, 0xfe, 0x45, 0x49,
+	0x67, 0x5f, 0x99, 0x23, 0x66, 0x06, 0xf0, 0xee, 0xa9, 0x7e, 0x2f, 0x01, 0x8c, 0xf3, 0x85, 0x1e,
+	0x42, 0x5e, 0xbc, 0x11, 0xd2, 0x87, 0xbd, 0x11, 0xc2, 0x69, 0x7a, 0x94, 0xcb, 0xfd, 0x8d, 0x79,
+	0x74, 0xe5, 0x9c, 0x51, 0x00, 0xdd, 0x86, 0xc2, 0xd4, 0xa8, 0x99, 0x06, 0xbd, 0xd8, 0x1d, 0x8f,
+	0x98, 0xe8, 0x1e, 0x2c, 0xb3, 0xb1, 0xe7, 0xc4, 0x14, 0x53, 0x34, 0xe4, 0x09, 0x40, 0x0c, 0x13,
+	0xbb, 0x9d, 0x57, 0x6f, 0xca, 0xd2, 0xeb, 0x37, 0x65, 0xe9, 0xb7, 0x37, 0x65, 0xe9, 0xc7, 0xb7,
+	0xe5, 0x99, 0xd7, 0x6f, 0xcb, 0x33, 0xbf, 0xbc, 0x2d, 0xcf, 0x3c, 0xfd, 0xd4, 0xa3, 0xec, 0x68,
+	0xd0, 0xad, 0x39, 0x61, 0x7f, 0x6b, 0xea, 0x1f, 0xcc, 0xf3, 0x07, 0xff, 0x73, 0x8e, 0x6c, 0x1a,
+	0x6c, 0x8d, 0x2c, 0xc7, 0xe9, 0xbf, 0x23, 0x1e, 0x76, 0xd2, 0xbd, 0x2c, 0xcc, 0xf7, 0xff, 0x1c,
+	0x00, 0xbb, 0x85, 0x52, 0x1d, 0x3f, 0x0d, 0x00, 0x00,
 }
 
 func (m *OrderId) Marshal() (dAtA []byte, err error) {
@@ -1104,6 +1483,11 @@ func (m *LongTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TrailingStopTriggerSubticks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingStopTriggerSubticks))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.PlacementIndex.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1202,6 +1586,47 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TwapParameters != nil {
+		{
+			size, err := m.TwapParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.OrderGroupType != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderGroupType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.OrderGroupId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderGroupId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TrailingOffsetPpm != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingOffsetPpm))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TrailingOffsetSubticks != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TrailingOffsetSubticks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SelfTradePreventionMode != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePreventionMode))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.ConditionalOrderTriggerSubticks))
 		i--
@@ -1294,7 +1719,7 @@ func (m *Order_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x35
 	return len(dAtA) - i, nil
 }
-func (m *TransactionOrdering) Marshal() (dAtA []byte, err error) {
+func (m *TwapParameters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1304,83 +1729,192 @@ func (m *TransactionOrdering) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransactionOrdering) MarshalTo(dAtA []byte) (int, error) {
+func (m *TwapParameters) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TransactionOrdering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TwapParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TransactionIndex != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.TransactionIndex))
+	if m.Interval != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Interval))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.BlockHeight))
+	if m.Duration != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Duration))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrder(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TwapOrderState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *OrderId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TwapOrderState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapOrderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SubaccountId.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.ClientId != 0 {
-		n += 5
-	}
-	if m.OrderFlags != 0 {
-		n += 1 + sovOrder(uint64(m.OrderFlags))
+	if m.NextSuborderTime != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.NextSuborderTime))
+		i--
+		dAtA[i] = 0x15
 	}
-	if m.ClobPairId != 0 {
-		n += 1 + sovOrder(uint64(m.ClobPairId))
+	if m.RemainingLegs != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.RemainingLegs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OrdersFilledDuringLatestBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OrderIds) > 0 {
-		for _, e := range m.OrderIds {
-			l = e.Size()
-			n += 1 + l + sovOrder(uint64(l))
-		}
+func (m *OrderGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PotentiallyPrunableOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OrderGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.OrderIds) > 0 {
-		for _, e := range m.OrderIds {
+		for iNdEx := len(m.OrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionOrdering) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionOrdering) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionOrdering) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransactionIndex != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TransactionIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OrderId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.ClientId != 0 {
+		n += 5
+	}
+	if m.OrderFlags != 0 {
+		n += 1 + sovOrder(uint64(m.OrderFlags))
+	}
+	if m.ClobPairId != 0 {
+		n += 1 + sovOrder(uint64(m.ClobPairId))
+	}
+	return n
+}
+
+func (m *OrdersFilledDuringLatestBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PotentiallyPrunableOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
 			l = e.Size()
 			n += 1 + l + sovOrder(uint64(l))
 		}
@@ -1428,6 +1962,9 @@ func (m *LongTermOrderPlacement) Size() (n int) {
 	n += 1 + l + sovOrder(uint64(l))
 	l = m.PlacementIndex.Size()
 	n += 1 + l + sovOrder(uint64(l))
+	if m.TrailingStopTriggerSubticks != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingStopTriggerSubticks))
+	}
 	return n
 }
 
@@ -1483,6 +2020,25 @@ func (m *Order) Size() (n int) {
 	if m.ConditionalOrderTriggerSubticks != 0 {
 		n += 1 + sovOrder(uint64(m.ConditionalOrderTriggerSubticks))
 	}
+	if m.SelfTradePreventionMode != 0 {
+		n += 1 + sovOrder(uint64(m.SelfTradePreventionMode))
+	}
+	if m.TrailingOffsetSubticks != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingOffsetSubticks))
+	}
+	if m.TrailingOffsetPpm != 0 {
+		n += 1 + sovOrder(uint64(m.TrailingOffsetPpm))
+	}
+	if m.OrderGroupId != 0 {
+		n += 1 + sovOrder(uint64(m.OrderGroupId))
+	}
+	if m.OrderGroupType != 0 {
+		n += 2 + sovOrder(uint64(m.OrderGroupType))
+	}
+	if m.TwapParameters != nil {
+		l = m.TwapParameters.Size()
+		n += 2 + l + sovOrder(uint64(l))
+	}
 	return n
 }
 
@@ -1504,6 +2060,54 @@ func (m *Order_GoodTilBlockTime) Size() (n int) {
 	n += 5
 	return n
 }
+func (m *TwapParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		n += 1 + sovOrder(uint64(m.Duration))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOrder(uint64(m.Interval))
+	}
+	return n
+}
+
+func (m *TwapOrderState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingLegs != 0 {
+		n += 1 + sovOrder(uint64(m.RemainingLegs))
+	}
+	if m.NextSuborderTime != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *OrderGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	if len(m.OrderIds) > 0 {
+		for _, e := range m.OrderIds {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TransactionOrdering) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2091,6 +2695,25 @@ func (m *LongTermOrderPlacement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingStopTriggerSubticks", wireType)
+			}
+			m.TrailingStopTriggerSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingStopTriggerSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2510,6 +3133,407 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePreventionMode", wireType)
+			}
+			m.SelfTradePreventionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePreventionMode |= Order_SelfTradePreventionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetSubticks", wireType)
+			}
+			m.TrailingOffsetSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrailingOffsetPpm", wireType)
+			}
+			m.TrailingOffsetPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrailingOffsetPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupId", wireType)
+			}
+			m.OrderGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderGroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroupType", wireType)
+			}
+			m.OrderGroupType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderGroupType |= Order_OrderGroupType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TwapParameters == nil {
+				m.TwapParameters = &TwapParameters{}
+			}
+			if err := m.TwapParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapOrderState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapOrderState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapOrderState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingLegs", wireType)
+			}
+			m.RemainingLegs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingLegs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSuborderTime", wireType)
+			}
+			m.NextSuborderTime = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextSuborderTime = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Order_OrderGroupType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderIds = append(m.OrderIds, OrderId{})
+			if err := m.OrderIds[len(m.OrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
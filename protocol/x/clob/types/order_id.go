@@ -10,9 +10,11 @@ import (
 )
 
 const (
-	OrderIdFlags_ShortTerm   = uint32(0)
-	OrderIdFlags_Conditional = uint32(32)
-	OrderIdFlags_LongTerm    = uint32(64)
+	OrderIdFlags_ShortTerm    = uint32(0)
+	OrderIdFlags_Conditional  = uint32(32)
+	OrderIdFlags_LongTerm     = uint32(64)
+	OrderIdFlags_Twap         = uint32(128)
+	OrderIdFlags_TwapSuborder = uint32(256)
 )
 
 // IsShortTermOrder returns true if this order ID is for a short-term order, false if
@@ -40,10 +42,43 @@ func (o *OrderId) IsLongTermOrder() bool {
 	return o.OrderFlags == OrderIdFlags_LongTerm
 }
 
-// IsStatefulOrder returns whether this order is a stateful order, which is true for Long-Term
-// and conditional orders and false for Short-Term orders.
+// IsTwapOrder returns true if this order ID is for a TWAP order.
+func (o *OrderId) IsTwapOrder() bool {
+	return o.OrderFlags == OrderIdFlags_Twap
+}
+
+// IsTwapSuborder returns true if this order ID is for a suborder generated by a TWAP order.
+func (o *OrderId) IsTwapSuborder() bool {
+	return o.OrderFlags == OrderIdFlags_TwapSuborder
+}
+
+// IsStatefulOrder returns whether this order is a stateful order, which is true for Long-Term,
+// conditional and TWAP orders and TWAP suborders, and false for Short-Term orders.
 func (o *OrderId) IsStatefulOrder() bool {
-	return o.IsLongTermOrder() || o.IsConditionalOrder()
+	return o.IsLongTermOrder() || o.IsConditionalOrder() || o.IsTwapOrder() || o.IsTwapSuborder()
+}
+
+// GetTwapSuborderId returns the order ID of the suborders generated by a TWAP order. The suborders of
+// a TWAP order share the subaccount, client ID and `ClobPairId` of the TWAP order.
+// This function panics if the order ID is not for a TWAP order.
+func (o *OrderId) GetTwapSuborderId() OrderId {
+	if !o.IsTwapOrder() {
+		panic(fmt.Sprintf("GetTwapSuborderId: called with non-TWAP order ID (%+v)", *o))
+	}
+	suborderId := *o
+	suborderId.OrderFlags = OrderIdFlags_TwapSuborder
+	return suborderId
+}
+
+// GetTwapParentOrderId returns the order ID of the TWAP order that generated a TWAP suborder.
+// This function panics if the order ID is not for a TWAP suborder.
+func (o *OrderId) GetTwapParentOrderId() OrderId {
+	if !o.IsTwapSuborder() {
+		panic(fmt.Sprintf("GetTwapParentOrderId: called with non-TWAP suborder ID (%+v)", *o))
+	}
+	parentOrderId := *o
+	parentOrderId.OrderFlags = OrderIdFlags_Twap
+	return parentOrderId
 }
 
 // MustBeStatefulOrder panics if the orderId is not a stateful order, else it does nothing.
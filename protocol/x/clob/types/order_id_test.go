@@ -14,7 +14,7 @@ import (
 
 // numOrderIdFlagsTestCases is set to 129 to verify that we run a test case where
 // `OrderFlags` is greater than one byte (proto varints are encoded with 7 bits per byte).
-const numOrderIdFlagsTestCases = 129
+const numOrderIdFlagsTestCases = 257
 
 func TestToStateKey(t *testing.T) {
 	// Success
@@ -66,11 +66,58 @@ func TestIsStatefulOrder(t *testing.T) {
 		orderId := types.OrderId{OrderFlags: orderFlags}
 
 		expectedIsStatefulOrder := orderFlags == types.OrderIdFlags_LongTerm ||
-			orderFlags == types.OrderIdFlags_Conditional
+			orderFlags == types.OrderIdFlags_Conditional ||
+			orderFlags == types.OrderIdFlags_Twap ||
+			orderFlags == types.OrderIdFlags_TwapSuborder
 		require.Equal(t, expectedIsStatefulOrder, orderId.IsStatefulOrder(), "OrderFlag: %d", i)
 	}
 }
 
+func TestIsTwapOrder(t *testing.T) {
+	for i := 0; i < numOrderIdFlagsTestCases; i++ {
+		orderFlags := uint32(i)
+		orderId := types.OrderId{OrderFlags: orderFlags}
+
+		require.Equal(t, orderFlags == types.OrderIdFlags_Twap, orderId.IsTwapOrder(), "OrderFlag: %d", i)
+		require.Equal(
+			t,
+			orderFlags == types.OrderIdFlags_TwapSuborder,
+			orderId.IsTwapSuborder(),
+			"OrderFlag: %d",
+			i,
+		)
+	}
+}
+
+func TestGetTwapSuborderId(t *testing.T) {
+	twapOrderId := types.OrderId{
+		SubaccountId: constants.Alice_Num0,
+		ClientId:     5,
+		OrderFlags:   types.OrderIdFlags_Twap,
+		ClobPairId:   1,
+	}
+
+	suborderId := twapOrderId.GetTwapSuborderId()
+	require.Equal(
+		t,
+		types.OrderId{
+			SubaccountId: constants.Alice_Num0,
+			ClientId:     5,
+			OrderFlags:   types.OrderIdFlags_TwapSuborder,
+			ClobPairId:   1,
+		},
+		suborderId,
+	)
+	require.Equal(t, twapOrderId, suborderId.GetTwapParentOrderId())
+
+	require.Panics(t, func() {
+		constants.LongTermOrderId_Alice_Num0_ClientId0_Clob0.GetTwapSuborderId()
+	})
+	require.Panics(t, func() {
+		twapOrderId.GetTwapParentOrderId()
+	})
+}
+
 func TestSortOrders(t *testing.T) {
 	tests := map[string]struct {
 		// Parameters.
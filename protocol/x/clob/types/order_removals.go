@@ -0,0 +1,70 @@
+package types
+
+// IsSelfTrade returns true if the removal reason is one of the removal reasons of self-trade prevention.
+// Order removals with these removal reasons must reference the taker order of the self trade.
+func (r OrderRemoval_RemovalReason) IsSelfTrade() bool {
+	switch r {
+	case OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+		OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+		OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+		OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSelfTradeDecrement returns true if the order removal decrements the maker order of a self trade
+// instead of removing it.
+func (o *OrderRemoval) IsSelfTradeDecrement() bool {
+	return o.DecrementQuantums > 0
+}
+
+// ValidateSelfTradeFields performs stateless validation of the self-trade fields of the order removal.
+// The taker order id must be set if and only if the removal reason is a self-trade removal reason, unless
+// the taker order of the self trade was a liquidation order. The decrement may only be set for a maker
+// order with removal reason `REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL`.
+func (o *OrderRemoval) ValidateSelfTradeFields() error {
+	if !o.RemovalReason.IsSelfTrade() {
+		if o.SelfTradeTakerOrderId != nil || o.DecrementQuantums != 0 {
+			return ErrInvalidOrderRemoval.Wrapf(
+				"order removal with removal reason %v cannot reference a self trade: %+v",
+				o.RemovalReason,
+				o,
+			)
+		}
+		return nil
+	}
+
+	// Only orders removed due to a self trade with a liquidation order do not reference a taker order.
+	if o.SelfTradeTakerOrderId == nil {
+		if o.RemovalReason != OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE || o.DecrementQuantums != 0 {
+			return ErrInvalidOrderRemoval.Wrapf(
+				"order removal with removal reason %v must reference the taker order of the self trade: %+v",
+				o.RemovalReason,
+				o,
+			)
+		}
+		return nil
+	}
+	if err := o.SelfTradeTakerOrderId.Validate(); err != nil {
+		return err
+	}
+	if o.SelfTradeTakerOrderId.SubaccountId != o.OrderId.SubaccountId {
+		return ErrInvalidOrderRemoval.Wrapf(
+			"taker order of the self trade must be placed by the same subaccount: %+v",
+			o,
+		)
+	}
+
+	if o.IsSelfTradeDecrement() &&
+		(o.RemovalReason != OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL ||
+			*o.SelfTradeTakerOrderId == o.OrderId) {
+		return ErrInvalidOrderRemoval.Wrapf(
+			"only maker orders removed with removal reason %v can be decremented: %+v",
+			OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+			o,
+		)
+	}
+	return nil
+}
@@ -63,18 +63,51 @@ const (
 	// REMOVAL_REASON_FULLY_FILLED represents a removal of an order that
 	//  would lead to the subaccount violating isolated subaccount constraints.
 	OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS OrderRemoval_RemovalReason = 8
+	// REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER represents a removal of a
+	// stateful taker order with self-trade prevention mode
+	// SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER that would have matched against
+	// a maker order from the same subaccount.
+	OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER OrderRemoval_RemovalReason = 9
+	// REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH represents a removal of a stateful
+	// order that was part of a self trade where the taker order had
+	// self-trade prevention mode SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH.
+	OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH OrderRemoval_RemovalReason = 10
+	// REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL represents a removal of a
+	// stateful order that was part of a self trade where the taker order had
+	// self-trade prevention mode
+	// SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL. If `decrement_quantums`
+	// is set, the maker order is decremented instead of removed.
+	OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL OrderRemoval_RemovalReason = 11
+	// REMOVAL_REASON_OUTSIDE_PRICE_BAND represents a removal of a stateful
+	// taker order whose remaining size would have crossed maker orders priced
+	// outside of the price band of its clob pair.
+	OrderRemoval_REMOVAL_REASON_OUTSIDE_PRICE_BAND OrderRemoval_RemovalReason = 12
+	// REMOVAL_REASON_MARKET_MAKER_PROTECTION represents a removal of a stateful
+	// maker order whose subaccount tripped its market-maker protection on the
+	// clob pair of the order.
+	OrderRemoval_REMOVAL_REASON_MARKET_MAKER_PROTECTION OrderRemoval_RemovalReason = 13
+	// REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK represents a removal
+	// of the unfilled size of a TWAP suborder. TWAP suborders are IOC orders,
+	// and the TWAP order places its remaining size in its next suborders.
+	OrderRemoval_REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK OrderRemoval_RemovalReason = 14
 )
 
 var OrderRemoval_RemovalReason_name = map[int32]string{
-	0: "REMOVAL_REASON_UNSPECIFIED",
-	1: "REMOVAL_REASON_UNDERCOLLATERALIZED",
-	2: "REMOVAL_REASON_INVALID_REDUCE_ONLY",
-	3: "REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER",
-	4: "REMOVAL_REASON_INVALID_SELF_TRADE",
-	5: "REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED",
-	6: "REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK",
-	7: "REMOVAL_REASON_FULLY_FILLED",
-	8: "REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS",
+	0:  "REMOVAL_REASON_UNSPECIFIED",
+	1:  "REMOVAL_REASON_UNDERCOLLATERALIZED",
+	2:  "REMOVAL_REASON_INVALID_REDUCE_ONLY",
+	3:  "REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER",
+	4:  "REMOVAL_REASON_INVALID_SELF_TRADE",
+	5:  "REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED",
+	6:  "REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK",
+	7:  "REMOVAL_REASON_FULLY_FILLED",
+	8:  "REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS",
+	9:  "REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER",
+	10: "REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH",
+	11: "REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL",
+	12: "REMOVAL_REASON_OUTSIDE_PRICE_BAND",
+	13: "REMOVAL_REASON_MARKET_MAKER_PROTECTION",
+	14: "REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK",
 }
 
 var OrderRemoval_RemovalReason_value = map[string]int32{
@@ -87,6 +120,12 @@ var OrderRemoval_RemovalReason_value = map[string]int32{
 	"REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK":        6,
 	"REMOVAL_REASON_FULLY_FILLED":                              7,
 	"REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS":  8,
+	"REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER":                   9,
+	"REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH":                    10,
+	"REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL":           11,
+	"REMOVAL_REASON_OUTSIDE_PRICE_BAND":                        12,
+	"REMOVAL_REASON_MARKET_MAKER_PROTECTION":                   13,
+	"REMOVAL_REASON_TWAP_SUBORDER_IOC_WOULD_REST_ON_BOOK":      14,
 }
 
 func (x OrderRemoval_RemovalReason) String() string {
@@ -101,6 +140,21 @@ func (OrderRemoval_RemovalReason) EnumDescriptor() ([]byte, []int) {
 type OrderRemoval struct {
 	OrderId       OrderId                    `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
 	RemovalReason OrderRemoval_RemovalReason `protobuf:"varint,2,opt,name=removal_reason,json=removalReason,proto3,enum=dydxprotocol.clob.OrderRemoval_RemovalReason" json:"removal_reason,omitempty"`
+	// The id of the taker order of the self trade which caused the removal. It
+	// is required for the self-trade removal reasons
+	// REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+	// REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH and
+	// REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL, and must not be set for
+	// non self-trade removal reasons. For REMOVAL_REASON_INVALID_SELF_TRADE it
+	// is unset only if the taker order was a liquidation order. If it is equal
+	// to `order_id`, the removed order is the taker order of the self trade, and
+	// otherwise the removed order is the maker order of the self trade.
+	SelfTradeTakerOrderId *OrderId `protobuf:"bytes,3,opt,name=self_trade_taker_order_id,json=selfTradeTakerOrderId,proto3" json:"self_trade_taker_order_id,omitempty"`
+	// The number of base quantums the maker order of a self trade is decremented
+	// by instead of being removed. It may only be set for removal reason
+	// REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL when the maker order is
+	// larger than the remaining size of the taker order.
+	DecrementQuantums uint64 `protobuf:"varint,4,opt,name=decrement_quantums,json=decrementQuantums,proto3" json:"decrement_quantums,omitempty"`
 }
 
 func (m *OrderRemoval) Reset()         { *m = OrderRemoval{} }
@@ -150,6 +204,20 @@ func (m *OrderRemoval) GetRemovalReason() OrderRemoval_RemovalReason {
 	return OrderRemoval_REMOVAL_REASON_UNSPECIFIED
 }
 
+func (m *OrderRemoval) GetSelfTradeTakerOrderId() *OrderId {
+	if m != nil {
+		return m.SelfTradeTakerOrderId
+	}
+	return nil
+}
+
+func (m *OrderRemoval) GetDecrementQuantums() uint64 {
+	if m != nil {
+		return m.DecrementQuantums
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.clob.OrderRemoval_RemovalReason", OrderRemoval_RemovalReason_name, OrderRemoval_RemovalReason_value)
 	proto.RegisterType((*OrderRemoval)(nil), "dydxprotocol.clob.OrderRemoval")
@@ -160,37 +228,47 @@ func init() {
 }
 
 var fileDescriptor_60fa12f781955c9f = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x4e, 0xdb, 0x4c,
-	0x14, 0xc5, 0x63, 0xc8, 0x07, 0x68, 0xbe, 0x82, 0xdc, 0x51, 0x17, 0x28, 0x55, 0x0d, 0x8d, 0x54,
-	0xc4, 0x06, 0xbb, 0xa5, 0xf4, 0x8f, 0x44, 0x37, 0x63, 0xcf, 0x58, 0x1a, 0x65, 0xf0, 0x44, 0x33,
-	0x76, 0x2a, 0xd8, 0x5c, 0x25, 0x71, 0x14, 0x22, 0x05, 0x06, 0x39, 0x29, 0x82, 0xb7, 0xe8, 0x9b,
-	0xf4, 0x35, 0x58, 0x22, 0x75, 0xd3, 0x55, 0x55, 0x25, 0x2f, 0x52, 0xd9, 0x8e, 0x2a, 0x12, 0x9a,
-	0xae, 0x3c, 0xf7, 0xde, 0xdf, 0x39, 0xe7, 0xca, 0xba, 0x68, 0x2f, 0xbd, 0x4d, 0x6f, 0xae, 0x32,
-	0x33, 0x36, 0x5d, 0x33, 0xf4, 0xba, 0x43, 0xd3, 0xf1, 0x4c, 0x96, 0xf6, 0x32, 0xc8, 0x7a, 0x17,
-	0xe6, 0xba, 0x3d, 0x1c, 0xb9, 0xc5, 0x10, 0x3f, 0x7d, 0xc8, 0xb9, 0x39, 0x57, 0x7b, 0xd6, 0x37,
-	0x7d, 0x53, 0xb4, 0xbc, 0xfc, 0x55, 0x82, 0xb5, 0x17, 0x4b, 0x0c, 0xcb, 0x71, 0xfd, 0x7b, 0x15,
-	0x3d, 0x91, 0x79, 0xad, 0x4a, 0x7f, 0x7c, 0x8c, 0x36, 0xca, 0xc0, 0x41, 0xba, 0x6d, 0xed, 0x5a,
-	0xfb, 0xff, 0x1f, 0xd6, 0xdc, 0x47, 0x59, 0x6e, 0x21, 0xe1, 0xa9, 0x5f, 0xbd, 0xfb, 0xb9, 0x53,
-	0x51, 0xeb, 0xa6, 0x2c, 0x71, 0x8c, 0xb6, 0x66, 0x7b, 0x42, 0xd6, 0x6b, 0x8f, 0xcc, 0xe5, 0xf6,
-	0xca, 0xae, 0xb5, 0xbf, 0x75, 0x78, 0xb0, 0xcc, 0x62, 0x96, 0xea, 0xce, 0xbe, 0xaa, 0x10, 0xa9,
-	0xcd, 0xec, 0x61, 0x59, 0xff, 0xb6, 0x8a, 0x36, 0xe7, 0x00, 0xec, 0xa0, 0x9a, 0x62, 0x27, 0xb2,
-	0x45, 0x04, 0x28, 0x46, 0xb4, 0x8c, 0x20, 0x89, 0x74, 0x93, 0x05, 0x3c, 0xe4, 0x8c, 0xda, 0x15,
-	0xbc, 0x87, 0xea, 0x8f, 0xe6, 0x94, 0xa9, 0x40, 0x0a, 0x41, 0x62, 0xa6, 0x88, 0xe0, 0x67, 0x8c,
-	0xda, 0xd6, 0x5f, 0x38, 0x1e, 0xb5, 0x88, 0xe0, 0x14, 0x14, 0xa3, 0x49, 0xc0, 0x40, 0x46, 0xe2,
-	0xd4, 0x5e, 0xc1, 0x47, 0xe8, 0xf5, 0x02, 0xd7, 0x94, 0x3a, 0x2e, 0xa6, 0xf0, 0x59, 0x26, 0x82,
-	0x42, 0xa0, 0xa4, 0xd6, 0x70, 0x42, 0x1a, 0x4c, 0x81, 0x54, 0x94, 0x29, 0x7b, 0x15, 0xbf, 0x42,
-	0x2f, 0x97, 0xb8, 0x6b, 0x26, 0x42, 0x88, 0x15, 0xa1, 0xcc, 0xae, 0xe2, 0x4f, 0xe8, 0xe3, 0x02,
-	0x16, 0xc8, 0x88, 0xf2, 0x98, 0xcb, 0x88, 0x08, 0x08, 0x65, 0x03, 0x82, 0x22, 0x22, 0x92, 0x31,
-	0xf8, 0x0c, 0xc2, 0x44, 0x88, 0x53, 0x08, 0xb9, 0x10, 0x8c, 0xda, 0xff, 0xe1, 0x77, 0xe8, 0xcd,
-	0x3f, 0xd4, 0x5c, 0x06, 0xb3, 0x05, 0x15, 0x2b, 0x16, 0x06, 0x5f, 0xca, 0x86, 0xbd, 0x86, 0x77,
-	0xd0, 0xf3, 0x05, 0xd9, 0x9c, 0xef, 0x3a, 0x3e, 0x46, 0x1f, 0x16, 0x80, 0x16, 0x97, 0xf9, 0xdf,
-	0xd3, 0xc0, 0x75, 0xf1, 0xa0, 0xa0, 0x13, 0x9f, 0x04, 0x81, 0x4c, 0xa2, 0x38, 0x0f, 0xd5, 0xb1,
-	0x22, 0x3c, 0x8a, 0xb5, 0xbd, 0xe1, 0x37, 0xef, 0x26, 0x8e, 0x75, 0x3f, 0x71, 0xac, 0x5f, 0x13,
-	0xc7, 0xfa, 0x3a, 0x75, 0x2a, 0xf7, 0x53, 0xa7, 0xf2, 0x63, 0xea, 0x54, 0xce, 0xde, 0xf7, 0x07,
-	0xe3, 0xf3, 0x2f, 0x1d, 0xb7, 0x6b, 0x2e, 0xbc, 0xb9, 0xcb, 0xbc, 0x3e, 0x3a, 0xe8, 0x9e, 0xb7,
-	0x07, 0x97, 0xde, 0x9f, 0xce, 0x4d, 0x79, 0xad, 0xe3, 0xdb, 0xab, 0xde, 0xa8, 0xb3, 0x56, 0xb4,
-	0xdf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x45, 0x84, 0x1d, 0xbf, 0x20, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5f, 0x4f, 0xdb, 0x3a,
+	0x18, 0xc6, 0x1b, 0x28, 0x7f, 0x8e, 0xf9, 0xa3, 0x60, 0x9d, 0x23, 0x71, 0x7a, 0x74, 0x0a, 0x43,
+	0x02, 0xb1, 0x49, 0xb4, 0x1b, 0xb0, 0x31, 0x89, 0xdd, 0xb8, 0xb6, 0xab, 0x59, 0x75, 0xe3, 0xce,
+	0x71, 0x40, 0x70, 0xf3, 0xaa, 0x34, 0x19, 0xa0, 0xb5, 0x0d, 0x4b, 0x03, 0x82, 0x6f, 0xb1, 0x4f,
+	0x35, 0x71, 0xc9, 0xe5, 0xae, 0xa6, 0x09, 0x3e, 0xc0, 0xbe, 0xc2, 0x94, 0x34, 0x62, 0x50, 0xe8,
+	0xb8, 0x8a, 0xed, 0xe7, 0xf7, 0xbc, 0xef, 0x93, 0xd7, 0x92, 0xd1, 0x8a, 0x7f, 0xe1, 0x9f, 0x9f,
+	0x44, 0x61, 0x1c, 0xb6, 0xc2, 0x76, 0xb9, 0xd5, 0x0e, 0x0f, 0xca, 0x61, 0xe4, 0x07, 0x11, 0x44,
+	0x41, 0x27, 0x3c, 0x6b, 0xb6, 0x7b, 0xa5, 0x54, 0xc4, 0x73, 0x77, 0xb9, 0x52, 0xc2, 0x15, 0xfe,
+	0x3e, 0x0c, 0x0f, 0xc3, 0xf4, 0xa8, 0x9c, 0xac, 0xfa, 0x60, 0xe1, 0xff, 0x21, 0x05, 0xfb, 0xf2,
+	0xd2, 0xcf, 0x09, 0x34, 0xad, 0x92, 0xbd, 0xee, 0xd7, 0xc7, 0xdb, 0x68, 0xb2, 0xdf, 0xf0, 0xd8,
+	0x9f, 0xb7, 0x16, 0xad, 0xd5, 0xa9, 0xf5, 0x42, 0xe9, 0x41, 0xaf, 0x52, 0x6a, 0x11, 0x7e, 0x25,
+	0x7f, 0xf9, 0x7d, 0x21, 0xa7, 0x27, 0xc2, 0xfe, 0x16, 0x1b, 0x34, 0x9b, 0xe5, 0x84, 0x28, 0x68,
+	0xf6, 0xc2, 0xee, 0xfc, 0xc8, 0xa2, 0xb5, 0x3a, 0xbb, 0xbe, 0x36, 0xac, 0x44, 0xd6, 0xb5, 0x94,
+	0x7d, 0x75, 0x6a, 0xd2, 0x33, 0xd1, 0xdd, 0x2d, 0x36, 0xe8, 0xdf, 0x5e, 0xd0, 0xfe, 0x08, 0x71,
+	0xd4, 0xf4, 0x03, 0x88, 0x9b, 0x9f, 0x82, 0x08, 0x6e, 0x33, 0x8e, 0x3e, 0x95, 0x51, 0xff, 0x93,
+	0x98, 0x4d, 0xe2, 0x35, 0x89, 0x35, 0x3b, 0xc6, 0x6b, 0x08, 0xfb, 0x41, 0x2b, 0x0a, 0x3a, 0x41,
+	0x37, 0x86, 0xcf, 0xa7, 0xcd, 0x6e, 0x7c, 0xda, 0xe9, 0xcd, 0xe7, 0x17, 0xad, 0xd5, 0xbc, 0x9e,
+	0xbb, 0x55, 0x3e, 0x64, 0xc2, 0xd2, 0xd7, 0x31, 0x34, 0x73, 0x2f, 0x25, 0x2e, 0xa2, 0x82, 0xe6,
+	0x75, 0xb5, 0x43, 0x24, 0x68, 0x4e, 0x5c, 0xe5, 0x80, 0xe7, 0xb8, 0x0d, 0x4e, 0x45, 0x55, 0x70,
+	0x66, 0xe7, 0xf0, 0x0a, 0x5a, 0x7a, 0xa0, 0x33, 0xae, 0xa9, 0x92, 0x92, 0x18, 0xae, 0x89, 0x14,
+	0xfb, 0x9c, 0xd9, 0xd6, 0x23, 0x9c, 0x70, 0x76, 0x88, 0x14, 0x0c, 0x34, 0x67, 0x1e, 0xe5, 0xa0,
+	0x1c, 0xb9, 0x67, 0x8f, 0xe0, 0x4d, 0xf4, 0x72, 0x80, 0x6b, 0x28, 0xd7, 0xa4, 0x2a, 0xec, 0x2a,
+	0x4f, 0x32, 0xa0, 0x5a, 0xb9, 0x2e, 0xd4, 0x49, 0x8d, 0x6b, 0x50, 0x9a, 0x71, 0x6d, 0x8f, 0xe2,
+	0x65, 0xf4, 0x6c, 0x48, 0x75, 0x97, 0xcb, 0x2a, 0x18, 0x4d, 0x18, 0xb7, 0xf3, 0xf8, 0x1d, 0x7a,
+	0x3b, 0x80, 0x51, 0xe5, 0x30, 0x61, 0x84, 0x72, 0x88, 0x84, 0xaa, 0xaa, 0x01, 0x4d, 0x5b, 0x38,
+	0xca, 0x40, 0x85, 0x43, 0xd5, 0x93, 0x72, 0x0f, 0xaa, 0x42, 0x4a, 0xce, 0xec, 0x31, 0xfc, 0x1a,
+	0xbd, 0xfa, 0x83, 0x5b, 0x28, 0x9a, 0x05, 0xd4, 0x3c, 0x0d, 0x0c, 0x15, 0xa5, 0x6a, 0xf6, 0x38,
+	0x5e, 0x40, 0xff, 0x0d, 0xd8, 0xee, 0xd5, 0x9d, 0xc0, 0xdb, 0x68, 0x6b, 0x00, 0xd8, 0x11, 0x2a,
+	0x99, 0x9e, 0x0b, 0xc2, 0x4d, 0x17, 0x0c, 0x5c, 0xaf, 0x42, 0x28, 0x55, 0x9e, 0x63, 0x92, 0xa6,
+	0xae, 0xd1, 0x44, 0x38, 0xc6, 0xb5, 0x27, 0xf1, 0x0b, 0xb4, 0x32, 0x60, 0xfe, 0xfd, 0xc7, 0x40,
+	0x89, 0x43, 0xb9, 0x04, 0x93, 0xcc, 0xca, 0xfe, 0x0b, 0x3f, 0x47, 0xcb, 0x4f, 0xb2, 0x15, 0x65,
+	0xde, 0xdb, 0x08, 0xaf, 0xa3, 0xd2, 0x70, 0x94, 0x71, 0xaa, 0x79, 0x9d, 0x3b, 0x06, 0x88, 0xc3,
+	0x32, 0xa3, 0x3d, 0xf5, 0xc8, 0x25, 0x28, 0xcf, 0xb8, 0x82, 0x71, 0x68, 0x68, 0x41, 0x39, 0x54,
+	0x88, 0xc3, 0xec, 0xe9, 0x47, 0x12, 0xd7, 0x89, 0xae, 0x71, 0x93, 0x5d, 0x69, 0x43, 0x2b, 0xc3,
+	0x69, 0x32, 0x54, 0x7b, 0x06, 0x6f, 0xa1, 0x8d, 0x01, 0xd6, 0xec, 0x92, 0x46, 0x32, 0x8d, 0xf4,
+	0xe6, 0x87, 0x0d, 0x7d, 0xb6, 0xd2, 0xd8, 0x7f, 0x73, 0x78, 0x1c, 0x1f, 0x9d, 0x1e, 0x94, 0x5a,
+	0x61, 0xa7, 0x7c, 0xef, 0x75, 0x38, 0xdb, 0x5c, 0x6b, 0x1d, 0x35, 0x8f, 0xbb, 0xe5, 0xdb, 0x93,
+	0xf3, 0xfe, 0x8b, 0x11, 0x5f, 0x9c, 0x04, 0xbd, 0xcb, 0xeb, 0xa2, 0x75, 0x75, 0x5d, 0xb4, 0x7e,
+	0x5c, 0x17, 0xad, 0x2f, 0x37, 0xc5, 0xdc, 0xd5, 0x4d, 0x31, 0xf7, 0xed, 0xa6, 0x98, 0x3b, 0x18,
+	0x4f, 0xf1, 0x8d, 0x5f, 0x03, 0x00, 0x37, 0x08, 0x89, 0x00, 0xbc, 0x04, 0x00, 0x00,
 }
 
 func (m *OrderRemoval) Marshal() (dAtA []byte, err error) {
@@ -213,6 +291,23 @@ func (m *OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecrementQuantums != 0 {
+		i = encodeVarintOrderRemovals(dAtA, i, uint64(m.DecrementQuantums))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SelfTradeTakerOrderId != nil {
+		{
+			size, err := m.SelfTradeTakerOrderId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrderRemovals(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RemovalReason != 0 {
 		i = encodeVarintOrderRemovals(dAtA, i, uint64(m.RemovalReason))
 		i--
@@ -253,6 +348,13 @@ func (m *OrderRemoval) Size() (n int) {
 	if m.RemovalReason != 0 {
 		n += 1 + sovOrderRemovals(uint64(m.RemovalReason))
 	}
+	if m.SelfTradeTakerOrderId != nil {
+		l = m.SelfTradeTakerOrderId.Size()
+		n += 1 + l + sovOrderRemovals(uint64(l))
+	}
+	if m.DecrementQuantums != 0 {
+		n += 1 + sovOrderRemovals(uint64(m.DecrementQuantums))
+	}
 	return n
 }
 
@@ -343,6 +445,61 @@ func (m *OrderRemoval) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradeTakerOrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderRemovals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderRemovals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderRemovals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelfTradeTakerOrderId == nil {
+				m.SelfTradeTakerOrderId = &OrderId{}
+			}
+			if err := m.SelfTradeTakerOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecrementQuantums", wireType)
+			}
+			m.DecrementQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderRemovals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecrementQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrderRemovals(dAtA[iNdEx:])
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRemoval_ValidateSelfTradeFields(t *testing.T) {
+	makerOrderId := constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25.OrderId
+	takerOrderId := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId
+	bobTakerOrderId := constants.LongTermOrder_Bob_Num0_Id0_Clob0_Buy25_Price30_GTBT10.OrderId
+
+	tests := map[string]struct {
+		// Parameters.
+		orderRemoval types.OrderRemoval
+
+		// Expectations.
+		expectedErr error
+	}{
+		"Non self-trade removal without self-trade fields": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:       makerOrderId,
+				RemovalReason: types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+			},
+		},
+		"Self-trade removal of the maker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               makerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+				SelfTradeTakerOrderId: &takerOrderId,
+			},
+		},
+		"Self-trade removal of the taker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               takerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+				SelfTradeTakerOrderId: &takerOrderId,
+			},
+		},
+		"Self-trade decrement of the maker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               makerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+				SelfTradeTakerOrderId: &takerOrderId,
+				DecrementQuantums:     5,
+			},
+		},
+		"Invalid self-trade removal without a taker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:       makerOrderId,
+				RemovalReason: types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+			},
+		},
+		"Non self-trade removal referencing a taker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               makerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+				SelfTradeTakerOrderId: &takerOrderId,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+		"Non self-trade removal with a decrement": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:           makerOrderId,
+				RemovalReason:     types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+				DecrementQuantums: 5,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+		"Self-trade removal without a taker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:       makerOrderId,
+				RemovalReason: types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+		"Self-trade removal with a taker order of another subaccount": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               makerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+				SelfTradeTakerOrderId: &bobTakerOrderId,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+		"Self-trade decrement with removal reason cancel both": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               makerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+				SelfTradeTakerOrderId: &takerOrderId,
+				DecrementQuantums:     5,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+		"Self-trade decrement of the taker order": {
+			orderRemoval: types.OrderRemoval{
+				OrderId:               takerOrderId,
+				RemovalReason:         types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+				SelfTradeTakerOrderId: &takerOrderId,
+				DecrementQuantums:     5,
+			},
+			expectedErr: types.ErrInvalidOrderRemoval,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.orderRemoval.ValidateSelfTradeFields()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -347,6 +347,72 @@ func TestOrder_MustCmpReplacementOrder_PanicsWithNonReplacementOrder(t *testing.
 	)
 }
 
+func TestOrder_IsSizeDecreaseReplacement(t *testing.T) {
+	existingOrder := types.Order{
+		OrderId:      types.OrderId{ClientId: 1, OrderFlags: types.OrderIdFlags_LongTerm},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     100,
+		Subticks:     10,
+		GoodTilOneof: &types.Order_GoodTilBlockTime{GoodTilBlockTime: 10},
+	}
+
+	tests := map[string]struct {
+		updateOrder    func(order types.Order) types.Order
+		expectedResult bool
+	}{
+		"Size decrease": {
+			updateOrder: func(order types.Order) types.Order {
+				order.Quantums = 50
+				return order
+			},
+			expectedResult: true,
+		},
+		"Same order": {
+			updateOrder: func(order types.Order) types.Order {
+				return order
+			},
+			expectedResult: false,
+		},
+		"Size increase": {
+			updateOrder: func(order types.Order) types.Order {
+				order.Quantums = 150
+				return order
+			},
+			expectedResult: false,
+		},
+		"Size decrease with price change": {
+			updateOrder: func(order types.Order) types.Order {
+				order.Quantums = 50
+				order.Subticks = 20
+				return order
+			},
+			expectedResult: false,
+		},
+		"Size decrease with expiration change": {
+			updateOrder: func(order types.Order) types.Order {
+				order.Quantums = 50
+				order.GoodTilOneof = &types.Order_GoodTilBlockTime{GoodTilBlockTime: 20}
+				return order
+			},
+			expectedResult: false,
+		},
+		"Size decrease with different order ID": {
+			updateOrder: func(order types.Order) types.Order {
+				order.Quantums = 50
+				order.OrderId.ClientId = 2
+				return order
+			},
+			expectedResult: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			newOrder := tc.updateOrder(existingOrder)
+			require.Equal(t, tc.expectedResult, existingOrder.IsSizeDecreaseReplacement(&newOrder))
+		})
+	}
+}
+
 func TestOrder_GetSubaccountId(t *testing.T) {
 	expectedSubaccountId := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.SubaccountId
 	order := types.Order{
@@ -420,7 +486,9 @@ func TestOrder_IsStatefulOrder(t *testing.T) {
 		}
 
 		expectedIsStatefulOrder := orderFlags == types.OrderIdFlags_LongTerm ||
-			orderFlags == types.OrderIdFlags_Conditional
+			orderFlags == types.OrderIdFlags_Conditional ||
+			orderFlags == types.OrderIdFlags_Twap ||
+			orderFlags == types.OrderIdFlags_TwapSuborder
 		require.Equal(t, expectedIsStatefulOrder, order.IsStatefulOrder(), "OrderFlag: %d", i)
 	}
 }
@@ -524,3 +592,159 @@ func TestOrder_GetClientMetadata(t *testing.T) {
 	clientMetadata := order.GetClientMetadata()
 	require.Equal(t, uint32(100), clientMetadata)
 }
+
+func TestOrder_GetSelfTradePreventionRemovalReason(t *testing.T) {
+	tests := map[string]struct {
+		selfTradePreventionMode types.Order_SelfTradePreventionMode
+		expectedRemovalReason   types.OrderRemoval_RemovalReason
+	}{
+		"Unspecified": {
+			selfTradePreventionMode: types.Order_SELF_TRADE_PREVENTION_MODE_UNSPECIFIED,
+			expectedRemovalReason:   types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+		},
+		"Cancel maker": {
+			selfTradePreventionMode: types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_MAKER,
+			expectedRemovalReason:   types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE,
+		},
+		"Cancel taker": {
+			selfTradePreventionMode: types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_TAKER,
+			expectedRemovalReason:   types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_TAKER,
+		},
+		"Cancel both": {
+			selfTradePreventionMode: types.Order_SELF_TRADE_PREVENTION_MODE_CANCEL_BOTH,
+			expectedRemovalReason:   types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_CANCEL_BOTH,
+		},
+		"Decrement and cancel": {
+			selfTradePreventionMode: types.Order_SELF_TRADE_PREVENTION_MODE_DECREMENT_AND_CANCEL,
+			expectedRemovalReason:   types.OrderRemoval_REMOVAL_REASON_SELF_TRADE_DECREMENT_AND_CANCEL,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := types.Order{
+				SelfTradePreventionMode: tc.selfTradePreventionMode,
+			}
+			require.Equal(t, tc.expectedRemovalReason, order.GetSelfTradePreventionRemovalReason())
+		})
+	}
+}
+
+func TestOrder_GetTrailingStopTriggerSubticks(t *testing.T) {
+	tests := map[string]struct {
+		side                   types.Order_Side
+		triggerSubticks        uint64
+		trailingOffsetSubticks uint64
+		trailingOffsetPpm      uint32
+		oraclePrice            *big.Rat
+		subticksPerTick        types.SubticksPerTick
+
+		expectedTriggerSubticks types.Subticks
+		expectedMoved           bool
+	}{
+		"Sell: oracle price increases, trigger moves up": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         90,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(105, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 95,
+			expectedMoved:           true,
+		},
+		"Sell: oracle price decreases, trigger does not move": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         90,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(95, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 90,
+			expectedMoved:           false,
+		},
+		"Sell: trigger is rounded down to a multiple of subticks per tick": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         90,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(109, 1),
+			subticksPerTick:         5,
+			expectedTriggerSubticks: 95,
+			expectedMoved:           true,
+		},
+		"Sell: offset ppm": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         900,
+			trailingOffsetPpm:       100_000,
+			oraclePrice:             big.NewRat(2_000, 1),
+			subticksPerTick:         10,
+			expectedTriggerSubticks: 1_800,
+			expectedMoved:           true,
+		},
+		"Sell: offset larger than oracle price, trigger does not move": {
+			side:                    types.Order_SIDE_SELL,
+			triggerSubticks:         5,
+			trailingOffsetSubticks:  100,
+			oraclePrice:             big.NewRat(50, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 5,
+			expectedMoved:           false,
+		},
+		"Buy: oracle price decreases, trigger moves down": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         110,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(95, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 105,
+			expectedMoved:           true,
+		},
+		"Buy: oracle price increases, trigger does not move": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         110,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(105, 1),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 110,
+			expectedMoved:           false,
+		},
+		"Buy: trigger is rounded up to a multiple of subticks per tick": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         110,
+			trailingOffsetSubticks:  10,
+			oraclePrice:             big.NewRat(91, 1),
+			subticksPerTick:         5,
+			expectedTriggerSubticks: 105,
+			expectedMoved:           true,
+		},
+		"Buy: offset ppm with fractional oracle price": {
+			side:                    types.Order_SIDE_BUY,
+			triggerSubticks:         1_200,
+			trailingOffsetPpm:       50_000,
+			oraclePrice:             big.NewRat(2_001, 2),
+			subticksPerTick:         1,
+			expectedTriggerSubticks: 1_051,
+			expectedMoved:           true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			order := types.Order{
+				OrderId:                         types.OrderId{OrderFlags: types.OrderIdFlags_Conditional},
+				Side:                            tc.side,
+				ConditionType:                   types.Order_CONDITION_TYPE_TRAILING_STOP,
+				ConditionalOrderTriggerSubticks: tc.triggerSubticks,
+				TrailingOffsetSubticks:          tc.trailingOffsetSubticks,
+				TrailingOffsetPpm:               tc.trailingOffsetPpm,
+			}
+			triggerSubticks, moved := order.GetTrailingStopTriggerSubticks(tc.oraclePrice, tc.subticksPerTick)
+			require.Equal(t, tc.expectedTriggerSubticks, triggerSubticks)
+			require.Equal(t, tc.expectedMoved, moved)
+		})
+	}
+}
+
+func TestOrder_GetTrailingStopTriggerSubticks_PanicsWithNonTrailingStopOrder(t *testing.T) {
+	require.Panics(t, func() {
+		order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+		order.GetTrailingStopTriggerSubticks(big.NewRat(10, 1), 1)
+	})
+}
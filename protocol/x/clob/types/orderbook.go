@@ -187,6 +187,21 @@ const (
 	// with either multiple positions in isolated perpetuals or both an isolated and a cross perpetual
 	// position.
 	ViolatesIsolatedSubaccountConstraints
+	// SelfTradeCancelTaker indicates the taker order would have matched against a maker order from the
+	// same subaccount and had self-trade prevention mode cancel-taker, and its remaining size was
+	// therefore canceled.
+	SelfTradeCancelTaker
+	// SelfTradeCancelBoth indicates the taker order would have matched against a maker order from the
+	// same subaccount and had self-trade prevention mode cancel-both, and its remaining size was
+	// therefore canceled along with the maker order.
+	SelfTradeCancelBoth
+	// SelfTradeDecrementAndCancel indicates the taker order would have matched against a maker order
+	// from the same subaccount and had self-trade prevention mode decrement-and-cancel, and its remaining
+	// size was therefore canceled after being decremented.
+	SelfTradeDecrementAndCancel
+	// OutsidePriceBand indicates the remaining size of the taker order would have crossed maker orders
+	// priced outside of the price band of the clob pair, and was therefore canceled.
+	OutsidePriceBand
 )
 
 // String returns a string representation of this `OrderStatus` enum.
@@ -210,6 +225,14 @@ func (os OrderStatus) String() string {
 		return "LiquidationExceededSubaccountMaxInsuranceLost"
 	case ViolatesIsolatedSubaccountConstraints:
 		return "ViolatesIsolatedSubaccountConstraints"
+	case SelfTradeCancelTaker:
+		return "SelfTradeCancelTaker"
+	case SelfTradeCancelBoth:
+		return "SelfTradeCancelBoth"
+	case SelfTradeDecrementAndCancel:
+		return "SelfTradeDecrementAndCancel"
+	case OutsidePriceBand:
+		return "OutsidePriceBand"
 	default:
 		return "Unknown"
 	}
@@ -220,6 +243,12 @@ func (os OrderStatus) IsSuccess() bool {
 	return os == Success
 }
 
+// IsSelfTradePrevention returns `true` if this `OrderStatus` enum indicates the order was canceled due
+// to self-trade prevention, else returns `false`.
+func (os OrderStatus) IsSelfTradePrevention() bool {
+	return os == SelfTradeCancelTaker || os == SelfTradeCancelBoth || os == SelfTradeDecrementAndCancel
+}
+
 // FillType represents the type of the fill.
 type FillType uint
 
@@ -0,0 +1,71 @@
+package types
+
+import (
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// OrderbookSnapshot is a snapshot of the orders of an orderbook and of its price levels, aggregating the
+// remaining size of all orders at each price.
+type OrderbookSnapshot struct {
+	// Bid price levels, sorted by descending price.
+	Bids []OrderbookLevel
+	// Ask price levels, sorted by ascending price.
+	Asks []OrderbookLevel
+	// Bid orders, sorted by matching priority.
+	BidOrders []OrderbookSnapshotOrder
+	// Ask orders, sorted by matching priority.
+	AskOrders []OrderbookSnapshotOrder
+	// Mid price of the orderbook. Only set if `MidPriceExists` is true.
+	MidPrice Subticks
+	// Whether the orderbook has both bids and asks, and therefore a mid price.
+	MidPriceExists bool
+}
+
+// OrderbookSnapshotOrder is an order resting on the orderbook when a snapshot was captured.
+type OrderbookSnapshotOrder struct {
+	Order Order
+	// Remaining size of the order in base quantums.
+	RemainingQuantums satypes.BaseQuantums
+}
+
+// GroupOrderbookLevels groups price levels sorted from the best to the worst price by multiples of
+// `groupSubticks`, and returns at most `depth` grouped price levels. Bid price levels are grouped down and
+// ask price levels are grouped up to the nearest multiple of `groupSubticks`, such that a grouped price
+// level never shows a better price than the orders it contains. Price levels are not grouped if
+// `groupSubticks` is zero.
+func GroupOrderbookLevels(
+	levels []OrderbookLevel,
+	isBuy bool,
+	groupSubticks uint64,
+	depth uint32,
+) []OrderbookLevel {
+	grouped := make([]OrderbookLevel, 0, min(len(levels), int(depth)))
+	for _, level := range levels {
+		subticks := level.Subticks
+		if groupSubticks > 0 {
+			if remainder := subticks % groupSubticks; remainder != 0 {
+				subticks -= remainder
+				// Ask price levels are grouped up, unless that overflows.
+				if !isBuy && subticks <= ^uint64(0)-groupSubticks {
+					subticks += groupSubticks
+				}
+			}
+		}
+
+		if n := len(grouped); n > 0 && grouped[n-1].Subticks == subticks {
+			grouped[n-1].Quantums += level.Quantums
+			grouped[n-1].NumOrders += level.NumOrders
+			continue
+		}
+
+		if len(grouped) == int(depth) {
+			break
+		}
+		grouped = append(grouped, OrderbookLevel{
+			Subticks:  subticks,
+			Quantums:  level.Quantums,
+			NumOrders: level.NumOrders,
+		})
+	}
+	return grouped
+}
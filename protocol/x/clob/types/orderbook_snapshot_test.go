@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupOrderbookLevels(t *testing.T) {
+	bids := []types.OrderbookLevel{
+		{Subticks: 120, Quantums: 1, NumOrders: 1},
+		{Subticks: 110, Quantums: 2, NumOrders: 1},
+		{Subticks: 100, Quantums: 3, NumOrders: 2},
+		{Subticks: 90, Quantums: 4, NumOrders: 1},
+	}
+	asks := []types.OrderbookLevel{
+		{Subticks: 130, Quantums: 1, NumOrders: 1},
+		{Subticks: 140, Quantums: 2, NumOrders: 1},
+		{Subticks: 150, Quantums: 3, NumOrders: 2},
+		{Subticks: 160, Quantums: 4, NumOrders: 1},
+	}
+
+	tests := map[string]struct {
+		levels        []types.OrderbookLevel
+		isBuy         bool
+		groupSubticks uint64
+		depth         uint32
+
+		expected []types.OrderbookLevel
+	}{
+		"levels are not grouped without group subticks": {
+			levels:   bids,
+			isBuy:    true,
+			depth:    10,
+			expected: bids,
+		},
+		"levels are truncated to the depth": {
+			levels:   asks,
+			isBuy:    false,
+			depth:    2,
+			expected: asks[:2],
+		},
+		"bid levels are grouped down": {
+			levels:        bids,
+			isBuy:         true,
+			groupSubticks: 50,
+			depth:         10,
+			expected: []types.OrderbookLevel{
+				{Subticks: 100, Quantums: 6, NumOrders: 4},
+				{Subticks: 50, Quantums: 4, NumOrders: 1},
+			},
+		},
+		"ask levels are grouped up": {
+			levels:        asks,
+			isBuy:         false,
+			groupSubticks: 50,
+			depth:         10,
+			expected: []types.OrderbookLevel{
+				{Subticks: 150, Quantums: 6, NumOrders: 4},
+				{Subticks: 200, Quantums: 4, NumOrders: 1},
+			},
+		},
+		"grouped levels are truncated to the depth": {
+			levels:        bids,
+			isBuy:         true,
+			groupSubticks: 20,
+			depth:         2,
+			expected: []types.OrderbookLevel{
+				{Subticks: 120, Quantums: 1, NumOrders: 1},
+				{Subticks: 100, Quantums: 5, NumOrders: 3},
+			},
+		},
+		"ask levels that would overflow are grouped down": {
+			levels:        []types.OrderbookLevel{{Subticks: math.MaxUint64, Quantums: 1, NumOrders: 1}},
+			isBuy:         false,
+			groupSubticks: 10,
+			depth:         10,
+			expected: []types.OrderbookLevel{
+				{Subticks: math.MaxUint64 - math.MaxUint64%10, Quantums: 1, NumOrders: 1},
+			},
+		},
+		"no levels": {
+			levels:   nil,
+			isBuy:    true,
+			depth:    10,
+			expected: []types.OrderbookLevel{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expected,
+				types.GroupOrderbookLevels(tc.levels, tc.isBuy, tc.groupSubticks, tc.depth),
+			)
+		})
+	}
+}
@@ -83,6 +83,26 @@ func TestString(t *testing.T) {
 
 			expectedString: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"Order status is SelfTradeCancelTaker": {
+			orderStatus: types.SelfTradeCancelTaker,
+
+			expectedString: "SelfTradeCancelTaker",
+		},
+		"Order status is SelfTradeCancelBoth": {
+			orderStatus: types.SelfTradeCancelBoth,
+
+			expectedString: "SelfTradeCancelBoth",
+		},
+		"Order status is SelfTradeDecrementAndCancel": {
+			orderStatus: types.SelfTradeDecrementAndCancel,
+
+			expectedString: "SelfTradeDecrementAndCancel",
+		},
+		"Order status is OutsidePriceBand": {
+			orderStatus: types.OutsidePriceBand,
+
+			expectedString: "OutsidePriceBand",
+		},
 		"Order status is unknown enum value": {
 			orderStatus: 999,
 
@@ -122,7 +142,7 @@ func TestIsSuccess(t *testing.T) {
 			expectedIsSuccess: false,
 		},
 		"Order status of unknown enum value is not successful": {
-			orderStatus: 10,
+			orderStatus: 999,
 
 			expectedIsSuccess: false,
 		},
@@ -135,3 +155,45 @@ func TestIsSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSelfTradePrevention(t *testing.T) {
+	tests := map[string]struct {
+		// Parameters.
+		orderStatus types.OrderStatus
+
+		// Expectations.
+		expectedIsSelfTradePrevention bool
+	}{
+		"Order status of Success is not self-trade prevention": {
+			orderStatus: types.Success,
+
+			expectedIsSelfTradePrevention: false,
+		},
+		"Order status of Undercollateralized is not self-trade prevention": {
+			orderStatus: types.Undercollateralized,
+
+			expectedIsSelfTradePrevention: false,
+		},
+		"Order status of SelfTradeCancelTaker is self-trade prevention": {
+			orderStatus: types.SelfTradeCancelTaker,
+
+			expectedIsSelfTradePrevention: true,
+		},
+		"Order status of SelfTradeCancelBoth is self-trade prevention": {
+			orderStatus: types.SelfTradeCancelBoth,
+
+			expectedIsSelfTradePrevention: true,
+		},
+		"Order status of SelfTradeDecrementAndCancel is self-trade prevention": {
+			orderStatus: types.SelfTradeDecrementAndCancel,
+
+			expectedIsSelfTradePrevention: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedIsSelfTradePrevention, tc.orderStatus.IsSelfTradePrevention())
+		})
+	}
+}
@@ -312,3 +312,161 @@ func TestPendingUpdates(t *testing.T) {
 		})
 	}
 }
+
+type spotFill struct {
+	subaccountId         satypes.SubaccountId
+	baseAssetId          uint32
+	isBuy                bool
+	bigFillBaseQuantums  *big.Int
+	bigFillQuoteQuantums *big.Int
+	feePpm               int32
+}
+
+func TestPendingUpdates_SpotFills(t *testing.T) {
+	tests := []struct {
+		name            string
+		spotFills       []spotFill
+		perpetualFills  []perpetualFill
+		expectedUpdates []satypes.Update
+	}{
+		{
+			name: "buy and sell fills (with fees)",
+			spotFills: []spotFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                true,
+					feePpm:               500,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(10_000), // fee = 5_000_000 / 1_000_000
+				},
+				{
+					subaccountId:         constants.Bob_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                false,
+					feePpm:               200,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(10_000), // fee = 2_000_000 / 1_000_000
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Bob_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(9_998), // 10_000 - (fee) 2
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(-100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-10_005), // - 10_000 - (fee) 5
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(100),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{},
+				},
+			},
+		},
+		{
+			name: "spot and perpetual fills for same account (no fees)",
+			spotFills: []spotFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(2),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(50),
+					bigFillQuoteQuantums: big.NewInt(500),
+				},
+				{
+					subaccountId:         constants.Alice_Num0,
+					baseAssetId:          uint32(1),
+					isBuy:                false,
+					bigFillBaseQuantums:  big.NewInt(20),
+					bigFillQuoteQuantums: big.NewInt(200),
+				},
+			},
+			perpetualFills: []perpetualFill{
+				{
+					subaccountId:         constants.Alice_Num0,
+					perpetualId:          uint32(0),
+					isBuy:                true,
+					bigFillBaseQuantums:  big.NewInt(100),
+					bigFillQuoteQuantums: big.NewInt(100),
+				},
+			},
+			expectedUpdates: []satypes.Update{
+				{
+					SubaccountId: constants.Alice_Num0,
+					AssetUpdates: []satypes.AssetUpdate{
+						{
+							AssetId:          uint32(0),
+							BigQuantumsDelta: big.NewInt(-400), // - 500 + 200 - 100
+						},
+						{
+							AssetId:          uint32(1),
+							BigQuantumsDelta: big.NewInt(-20),
+						},
+						{
+							AssetId:          uint32(2),
+							BigQuantumsDelta: big.NewInt(50),
+						},
+					},
+					PerpetualUpdates: []satypes.PerpetualUpdate{
+						{
+							PerpetualId:      uint32(0),
+							BigQuantumsDelta: big.NewInt(100),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Run many times for determinism.
+			for i := 0; i < 100; i++ {
+				pendingUpdates := types.NewPendingUpdates()
+
+				for _, spotFill := range tt.spotFills {
+					pendingUpdates.AddSpotFill(
+						spotFill.subaccountId,
+						spotFill.baseAssetId,
+						spotFill.isBuy,
+						spotFill.feePpm,
+						spotFill.bigFillBaseQuantums,
+						spotFill.bigFillQuoteQuantums,
+					)
+				}
+
+				for _, perpetualFill := range tt.perpetualFills {
+					pendingUpdates.AddPerpetualFill(
+						perpetualFill.subaccountId,
+						perpetualFill.perpetualId,
+						perpetualFill.isBuy,
+						perpetualFill.feePpm,
+						perpetualFill.bigFillBaseQuantums,
+						perpetualFill.bigFillQuoteQuantums,
+					)
+				}
+
+				updates := pendingUpdates.ConvertToUpdates()
+
+				require.Equal(t, tt.expectedUpdates, updates)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// IsEnabled returns true if the price band is set and has a non-zero width.
+func (c *PriceBandConfig) IsEnabled() bool {
+	return c != nil && c.BandPpm > 0
+}
+
+// Validate validates the price band config. It returns an error if the price band is enabled and
+// any of the following conditions are true:
+//   - `BandPpm` is not less than one million.
+//   - `Mode` is unspecified.
+func (c *PriceBandConfig) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+
+	if c.BandPpm >= lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"invalid PriceBandConfig parameter: BandPpm must be < %d. Got %d",
+			lib.OneMillion,
+			c.BandPpm,
+		)
+	}
+
+	if c.Mode != PriceBandConfig_MODE_REJECT && c.Mode != PriceBandConfig_MODE_CLAMP {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"invalid PriceBandConfig parameter: unsupported Mode %+v",
+			c.Mode,
+		)
+	}
+
+	return nil
+}
+
+// GetPriceBandSubticks returns the inclusive lower and upper bounds in subticks of the price band
+// around the provided oracle price. The lower bound is rounded up and the upper bound is rounded down,
+// and the upper bound is capped at the maximum `uint64`.
+func (c *PriceBandConfig) GetPriceBandSubticks(
+	oraclePriceSubticksRat *big.Rat,
+) (
+	lowerSubticks uint64,
+	upperSubticks uint64,
+) {
+	lowerRat := lib.BigRatMulPpm(oraclePriceSubticksRat, lib.OneMillion-c.BandPpm)
+	lowerSubticks = lib.BigRatRound(lowerRat, true).Uint64()
+
+	upperRat := lib.BigRatMulPpm(oraclePriceSubticksRat, lib.OneMillion+c.BandPpm)
+	upper := lib.BigRatRound(upperRat, false)
+	if !upper.IsUint64() {
+		return lowerSubticks, math.MaxUint64
+	}
+	return lowerSubticks, upper.Uint64()
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceBandConfig_Validate(t *testing.T) {
+	tests := map[string]struct {
+		config        *types.PriceBandConfig
+		expectedError string
+	}{
+		"nil config is valid": {
+			config: nil,
+		},
+		"disabled config is valid": {
+			config: &types.PriceBandConfig{},
+		},
+		"reject mode is valid": {
+			config: &types.PriceBandConfig{BandPpm: 50_000, Mode: types.PriceBandConfig_MODE_REJECT},
+		},
+		"clamp mode is valid": {
+			config: &types.PriceBandConfig{BandPpm: 999_999, Mode: types.PriceBandConfig_MODE_CLAMP},
+		},
+		"band of one million is invalid": {
+			config:        &types.PriceBandConfig{BandPpm: 1_000_000, Mode: types.PriceBandConfig_MODE_REJECT},
+			expectedError: "BandPpm must be < 1000000",
+		},
+		"unspecified mode is invalid": {
+			config:        &types.PriceBandConfig{BandPpm: 50_000},
+			expectedError: "unsupported Mode",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidClobPairParameter)
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestPriceBandConfig_GetPriceBandSubticks(t *testing.T) {
+	tests := map[string]struct {
+		bandPpm                uint32
+		oraclePriceSubticksRat *big.Rat
+
+		expectedLowerSubticks uint64
+		expectedUpperSubticks uint64
+	}{
+		"integer bounds": {
+			bandPpm:                100_000,
+			oraclePriceSubticksRat: big.NewRat(1_000, 1),
+
+			expectedLowerSubticks: 900,
+			expectedUpperSubticks: 1_100,
+		},
+		"lower bound is rounded up and upper bound is rounded down": {
+			bandPpm:                100_000,
+			oraclePriceSubticksRat: big.NewRat(1_005, 1),
+
+			expectedLowerSubticks: 905,   // 904.5
+			expectedUpperSubticks: 1_105, // 1105.5
+		},
+		"upper bound is capped at max uint64": {
+			bandPpm:                500_000,
+			oraclePriceSubticksRat: new(big.Rat).SetUint64(math.MaxUint64),
+
+			expectedLowerSubticks: math.MaxUint64/2 + 1,
+			expectedUpperSubticks: math.MaxUint64,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := &types.PriceBandConfig{BandPpm: tc.bandPpm, Mode: types.PriceBandConfig_MODE_CLAMP}
+			lowerSubticks, upperSubticks := config.GetPriceBandSubticks(tc.oraclePriceSubticksRat)
+			require.Equal(t, tc.expectedLowerSubticks, lowerSubticks)
+			require.Equal(t, tc.expectedUpperSubticks, upperSubticks)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,16 +34,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // - Stateful order IDs forcefully removed in the last block.
 // - Conditional order IDs triggered in the last block.
 // - Conditional order IDs placed, but not triggered in the last block.
+// - TWAP suborder IDs generated in the last block.
+// - Market-maker protections tripped in the last block.
+// - Subaccounts whose heartbeat expired in the last block.
 // - The height of the block in which the events occurred.
 type ProcessProposerMatchesEvents struct {
-	PlacedLongTermOrderIds                  []OrderId `protobuf:"bytes,1,rep,name=placed_long_term_order_ids,json=placedLongTermOrderIds,proto3" json:"placed_long_term_order_ids"`
-	ExpiredStatefulOrderIds                 []OrderId `protobuf:"bytes,2,rep,name=expired_stateful_order_ids,json=expiredStatefulOrderIds,proto3" json:"expired_stateful_order_ids"`
-	OrderIdsFilledInLastBlock               []OrderId `protobuf:"bytes,3,rep,name=order_ids_filled_in_last_block,json=orderIdsFilledInLastBlock,proto3" json:"order_ids_filled_in_last_block"`
-	PlacedStatefulCancellationOrderIds      []OrderId `protobuf:"bytes,4,rep,name=placed_stateful_cancellation_order_ids,json=placedStatefulCancellationOrderIds,proto3" json:"placed_stateful_cancellation_order_ids"`
-	RemovedStatefulOrderIds                 []OrderId `protobuf:"bytes,5,rep,name=removed_stateful_order_ids,json=removedStatefulOrderIds,proto3" json:"removed_stateful_order_ids"`
-	ConditionalOrderIdsTriggeredInLastBlock []OrderId `protobuf:"bytes,6,rep,name=conditional_order_ids_triggered_in_last_block,json=conditionalOrderIdsTriggeredInLastBlock,proto3" json:"conditional_order_ids_triggered_in_last_block"`
-	PlacedConditionalOrderIds               []OrderId `protobuf:"bytes,7,rep,name=placed_conditional_order_ids,json=placedConditionalOrderIds,proto3" json:"placed_conditional_order_ids"`
-	BlockHeight                             uint32    `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PlacedLongTermOrderIds                  []OrderId                   `protobuf:"bytes,1,rep,name=placed_long_term_order_ids,json=placedLongTermOrderIds,proto3" json:"placed_long_term_order_ids"`
+	ExpiredStatefulOrderIds                 []OrderId                   `protobuf:"bytes,2,rep,name=expired_stateful_order_ids,json=expiredStatefulOrderIds,proto3" json:"expired_stateful_order_ids"`
+	OrderIdsFilledInLastBlock               []OrderId                   `protobuf:"bytes,3,rep,name=order_ids_filled_in_last_block,json=orderIdsFilledInLastBlock,proto3" json:"order_ids_filled_in_last_block"`
+	PlacedStatefulCancellationOrderIds      []OrderId                   `protobuf:"bytes,4,rep,name=placed_stateful_cancellation_order_ids,json=placedStatefulCancellationOrderIds,proto3" json:"placed_stateful_cancellation_order_ids"`
+	RemovedStatefulOrderIds                 []OrderId                   `protobuf:"bytes,5,rep,name=removed_stateful_order_ids,json=removedStatefulOrderIds,proto3" json:"removed_stateful_order_ids"`
+	ConditionalOrderIdsTriggeredInLastBlock []OrderId                   `protobuf:"bytes,6,rep,name=conditional_order_ids_triggered_in_last_block,json=conditionalOrderIdsTriggeredInLastBlock,proto3" json:"conditional_order_ids_triggered_in_last_block"`
+	PlacedConditionalOrderIds               []OrderId                   `protobuf:"bytes,7,rep,name=placed_conditional_order_ids,json=placedConditionalOrderIds,proto3" json:"placed_conditional_order_ids"`
+	BlockHeight                             uint32                      `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ReplacedStatefulOrderIds                []OrderId                   `protobuf:"bytes,9,rep,name=replaced_stateful_order_ids,json=replacedStatefulOrderIds,proto3" json:"replaced_stateful_order_ids"`
+	PlacedTwapSuborderIds                   []OrderId                   `protobuf:"bytes,10,rep,name=placed_twap_suborder_ids,json=placedTwapSuborderIds,proto3" json:"placed_twap_suborder_ids"`
+	MarketMakerProtectionTrips              []MarketMakerProtectionTrip `protobuf:"bytes,11,rep,name=market_maker_protection_trips,json=marketMakerProtectionTrips,proto3" json:"market_maker_protection_trips"`
+	ExpiredHeartbeatSubaccountIds           []types.SubaccountId        `protobuf:"bytes,12,rep,name=expired_heartbeat_subaccount_ids,json=expiredHeartbeatSubaccountIds,proto3" json:"expired_heartbeat_subaccount_ids"`
 }
 
 func (m *ProcessProposerMatchesEvents) Reset()         { *m = ProcessProposerMatchesEvents{} }
@@ -134,6 +142,34 @@ func (m *ProcessProposerMatchesEvents) GetBlockHeight() uint32 {
 	return 0
 }
 
+func (m *ProcessProposerMatchesEvents) GetReplacedStatefulOrderIds() []OrderId {
+	if m != nil {
+		return m.ReplacedStatefulOrderIds
+	}
+	return nil
+}
+
+func (m *ProcessProposerMatchesEvents) GetPlacedTwapSuborderIds() []OrderId {
+	if m != nil {
+		return m.PlacedTwapSuborderIds
+	}
+	return nil
+}
+
+func (m *ProcessProposerMatchesEvents) GetMarketMakerProtectionTrips() []MarketMakerProtectionTrip {
+	if m != nil {
+		return m.MarketMakerProtectionTrips
+	}
+	return nil
+}
+
+func (m *ProcessProposerMatchesEvents) GetExpiredHeartbeatSubaccountIds() []types.SubaccountId {
+	if m != nil {
+		return m.ExpiredHeartbeatSubaccountIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProcessProposerMatchesEvents)(nil), "dydxprotocol.clob.ProcessProposerMatchesEvents")
 }
@@ -143,36 +179,45 @@ func init() {
 }
 
 var fileDescriptor_4626e94e6961a770 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x36, 0x0a, 0xf2, 0xe0, 0x40, 0x84, 0xa0, 0x44, 0x23, 0x8c, 0x1d, 0x60, 0x97,
-	0x25, 0x12, 0x20, 0xb8, 0x77, 0x02, 0x31, 0x69, 0x88, 0x6a, 0xec, 0x84, 0x40, 0x96, 0x6b, 0xbf,
-	0x25, 0x16, 0x8e, 0x5f, 0x64, 0x7b, 0x55, 0x77, 0xe3, 0x23, 0xf0, 0x09, 0xf8, 0x3c, 0x3b, 0xee,
-	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0x38, 0x69, 0x95, 0x29, 0x3d, 0xe4, 0x16, 0x3d, 0x3f, 0xff,
-	0x7e, 0xef, 0xfd, 0x23, 0x93, 0x77, 0xe2, 0x52, 0xcc, 0x4b, 0x83, 0x0e, 0x39, 0xaa, 0x94, 0x2b,
-	0x9c, 0xa6, 0xa5, 0x41, 0x0e, 0xd6, 0xd2, 0xd2, 0x60, 0x89, 0x16, 0x0c, 0x2d, 0x98, 0xe3, 0x39,
-	0x58, 0x0a, 0x33, 0xd0, 0xce, 0x26, 0xbe, 0x3b, 0x7c, 0xd0, 0xbe, 0x98, 0x54, 0x17, 0xa3, 0x87,
-	0x19, 0x66, 0xe8, 0x4b, 0x69, 0xf5, 0x55, 0x37, 0x46, 0x4f, 0xbb, 0x06, 0x34, 0x02, 0x4c, 0x7d,
-	0xbc, 0xff, 0x7b, 0x48, 0x76, 0x27, 0xb5, 0x71, 0xd2, 0x08, 0x3f, 0xd5, 0xbe, 0xf7, 0x5e, 0x17,
-	0x7e, 0x23, 0x51, 0xa9, 0x18, 0x07, 0x41, 0x15, 0xea, 0x8c, 0x3a, 0x30, 0x05, 0xf5, 0x00, 0x2a,
-	0x85, 0x1d, 0x05, 0x7b, 0x5b, 0x07, 0x3b, 0xaf, 0xa2, 0xa4, 0x33, 0x4d, 0xf2, 0xb9, 0xea, 0x39,
-	0x16, 0xe3, 0xed, 0xab, 0xbf, 0xcf, 0x06, 0xa7, 0x8f, 0x6a, 0xc6, 0x09, 0xea, 0xec, 0x0c, 0x4c,
-	0xd1, 0x1c, 0xda, 0xf0, 0x3b, 0x89, 0x60, 0x5e, 0x4a, 0x03, 0x82, 0x5a, 0xc7, 0x1c, 0x9c, 0x5f,
-	0xa8, 0x16, 0xfd, 0x56, 0x4f, 0xfa, 0xe3, 0x86, 0xf1, 0xa5, 0x41, 0xac, 0xf1, 0x9c, 0xc4, 0x6b,
-	0x1a, 0x3d, 0x97, 0x4a, 0x81, 0xa0, 0x52, 0x53, 0xc5, 0xac, 0xa3, 0x53, 0x85, 0xfc, 0xc7, 0x68,
-	0xab, 0xa7, 0xe2, 0x09, 0x36, 0xcc, 0x0f, 0x9e, 0x72, 0xac, 0x4f, 0x98, 0x75, 0xe3, 0x0a, 0x11,
-	0x3a, 0xf2, 0xa2, 0x49, 0x68, 0xbd, 0x02, 0x67, 0x9a, 0x83, 0x52, 0xcc, 0x49, 0xd4, 0xad, 0x7d,
-	0xb6, 0x7b, 0xca, 0xf6, 0x6b, 0xde, 0x6a, 0x9d, 0xa3, 0x16, 0xad, 0x9d, 0x9c, 0x81, 0x02, 0x67,
-	0x9b, 0x93, 0xbb, 0xdd, 0x37, 0xb9, 0x86, 0xd1, 0x49, 0xee, 0x67, 0x40, 0x0e, 0x39, 0x6a, 0x21,
-	0x2b, 0x29, 0x6b, 0xa1, 0xa9, 0x33, 0x32, 0xcb, 0xc0, 0x74, 0x92, 0x1c, 0xf6, 0x54, 0xbe, 0x6c,
-	0x61, 0x57, 0xba, 0xb3, 0x15, 0xb3, 0x9d, 0x2b, 0x23, 0xbb, 0x4d, 0xae, 0x1b, 0x07, 0x19, 0xdd,
-	0xe9, 0xfb, 0xeb, 0x6a, 0xca, 0x51, 0x57, 0x1b, 0x3e, 0x27, 0xf7, 0xfc, 0xf0, 0x34, 0x07, 0x99,
-	0xe5, 0x6e, 0x74, 0x77, 0x2f, 0x38, 0xb8, 0x7f, 0xba, 0xe3, 0x6b, 0x1f, 0x7d, 0x69, 0x3c, 0xb9,
-	0x5a, 0xc4, 0xc1, 0xf5, 0x22, 0x0e, 0xfe, 0x2d, 0xe2, 0xe0, 0xd7, 0x32, 0x1e, 0x5c, 0x2f, 0xe3,
-	0xc1, 0x9f, 0x65, 0x3c, 0xf8, 0xfa, 0x36, 0x93, 0x2e, 0xbf, 0x98, 0x26, 0x1c, 0x8b, 0xf4, 0xc6,
-	0x23, 0x9b, 0xbd, 0x39, 0xe4, 0x39, 0x93, 0x3a, 0x5d, 0x57, 0xe6, 0xf5, 0xc3, 0x73, 0x97, 0x25,
-	0xd8, 0xe9, 0xd0, 0x97, 0x5f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xfa, 0x18, 0x53, 0xfc,
-	0x03, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0x05, 0x51, 0x06, 0x3c, 0xd8, 0xf8, 0xa7, 0x16, 0xa8, 0xc8, 0x01, 0x31, 0x91,
+	0x6e, 0xa2, 0x46, 0xef, 0x10, 0x0d, 0x24, 0x10, 0x37, 0xb0, 0x17, 0x8d, 0x66, 0x32, 0x9d, 0xbe,
+	0xb4, 0x13, 0xda, 0xce, 0x64, 0x66, 0xca, 0x9f, 0x9b, 0x1f, 0xc1, 0x8f, 0xc5, 0x91, 0xa3, 0x27,
+	0x63, 0xe0, 0x8b, 0x98, 0x4e, 0xa7, 0xdd, 0x62, 0x31, 0xe9, 0x6d, 0x32, 0xef, 0xfb, 0xfe, 0x9e,
+	0xe7, 0x7d, 0xba, 0x3b, 0xe8, 0x43, 0x74, 0x1e, 0x9d, 0x09, 0xc9, 0x35, 0xa7, 0x3c, 0x1d, 0xd1,
+	0x94, 0x87, 0x23, 0x21, 0x39, 0x05, 0xa5, 0xb0, 0x90, 0x5c, 0x70, 0x05, 0x12, 0x67, 0x44, 0xd3,
+	0x04, 0x14, 0x86, 0x13, 0xc8, 0xb5, 0x0a, 0x4c, 0xb7, 0xf3, 0xb0, 0x3d, 0x18, 0x94, 0x83, 0xde,
+	0xa3, 0x98, 0xc7, 0xdc, 0x5c, 0x8d, 0xca, 0x53, 0xd5, 0xe8, 0x2d, 0x75, 0x15, 0xb2, 0x4c, 0xd8,
+	0xe2, 0x4a, 0xb7, 0xc8, 0x65, 0x04, 0xd2, 0x96, 0x5f, 0xdd, 0x28, 0xab, 0x22, 0x24, 0x94, 0xf2,
+	0x22, 0xd7, 0xaa, 0x75, 0xae, 0x5a, 0xd7, 0x2e, 0xe6, 0xd1, 0xf2, 0xb8, 0x72, 0x3e, 0xb6, 0xc6,
+	0xf7, 0x2b, 0xdf, 0x1f, 0x8d, 0x6d, 0xe7, 0x1b, 0xf2, 0x44, 0x4a, 0x28, 0x44, 0x38, 0xe5, 0x79,
+	0x8c, 0x35, 0xc8, 0x0c, 0x1b, 0x2d, 0xcc, 0x22, 0xe5, 0x0e, 0x57, 0x67, 0x36, 0x16, 0xde, 0x78,
+	0x41, 0x67, 0xab, 0xe0, 0x73, 0xd9, 0xb3, 0x1b, 0x6d, 0xcd, 0x5e, 0xfc, 0x7e, 0x3e, 0x38, 0x78,
+	0x52, 0x31, 0xf6, 0x78, 0x1e, 0x4f, 0x40, 0x66, 0xb6, 0xa8, 0x9c, 0xef, 0xc8, 0x83, 0x33, 0xc1,
+	0x24, 0x44, 0x58, 0x69, 0xa2, 0xe1, 0xa8, 0x48, 0x5b, 0xf4, 0x3b, 0x3d, 0xe9, 0x4f, 0x2d, 0xe3,
+	0xd0, 0x22, 0x1a, 0x3c, 0x45, 0x7e, 0x43, 0xc3, 0x47, 0x2c, 0x4d, 0x21, 0xc2, 0x2c, 0xc7, 0x29,
+	0x51, 0x1a, 0x87, 0x29, 0xa7, 0xc7, 0xee, 0x4c, 0x4f, 0x89, 0x67, 0xdc, 0x32, 0x3f, 0x19, 0xca,
+	0x6e, 0xbe, 0x47, 0x94, 0xde, 0x2a, 0x11, 0x8e, 0x46, 0xeb, 0x36, 0xa1, 0x66, 0x05, 0x4a, 0x72,
+	0x0a, 0x69, 0x4a, 0x34, 0xe3, 0x79, 0x6b, 0x9f, 0xd9, 0x9e, 0x62, 0x6b, 0x15, 0xaf, 0x5e, 0x67,
+	0xbb, 0x45, 0x6b, 0x27, 0x27, 0x21, 0xe3, 0x27, 0xb7, 0x27, 0x77, 0xb7, 0x6f, 0x72, 0x96, 0xd1,
+	0x49, 0xee, 0xc7, 0x10, 0x6d, 0x52, 0x9e, 0x47, 0xac, 0x14, 0x25, 0x2d, 0x34, 0xd6, 0x92, 0xc5,
+	0x31, 0xc8, 0x4e, 0x92, 0x73, 0x3d, 0x25, 0x5f, 0xb6, 0xb0, 0xb5, 0xdc, 0xa4, 0x66, 0xb6, 0x73,
+	0x25, 0x68, 0xd9, 0xe6, 0x7a, 0xab, 0x11, 0xf7, 0x5e, 0xdf, 0x4f, 0x57, 0x51, 0xb6, 0xbb, 0xb2,
+	0xce, 0x0b, 0xb4, 0x68, 0xcc, 0xe3, 0x04, 0x58, 0x9c, 0x68, 0xf7, 0xfe, 0xea, 0x70, 0xe3, 0xc1,
+	0xc1, 0x82, 0xb9, 0xdb, 0x31, 0x57, 0x0e, 0x46, 0x4b, 0x12, 0xfe, 0xfd, 0xbe, 0x53, 0x13, 0xf3,
+	0x3d, 0x4d, 0xb8, 0x35, 0xa4, 0x93, 0xf4, 0x17, 0xe4, 0x5a, 0xbc, 0x3e, 0x25, 0x02, 0xab, 0x22,
+	0x9c, 0xd2, 0x51, 0x4f, 0xfa, 0xe3, 0x8a, 0x30, 0x39, 0x25, 0xe2, 0xd0, 0xce, 0x97, 0xe8, 0x02,
+	0xad, 0x64, 0x44, 0x1e, 0x83, 0xc6, 0x19, 0x39, 0x06, 0x59, 0x3e, 0x4d, 0x1a, 0xa8, 0xf9, 0x51,
+	0x6a, 0xc9, 0x84, 0x72, 0x17, 0x0c, 0xff, 0xf5, 0x2d, 0xfc, 0x7d, 0x33, 0xb7, 0x5f, 0x8e, 0x8d,
+	0x9b, 0xa9, 0x89, 0x64, 0xc2, 0x2a, 0x7a, 0xd9, 0xff, 0x1a, 0x4a, 0xd9, 0xd5, 0xfa, 0x4f, 0x9d,
+	0x00, 0x91, 0x3a, 0x04, 0xa2, 0xf1, 0xf4, 0xe5, 0x31, 0x9b, 0x2d, 0x1a, 0xe5, 0xf5, 0x9b, 0xca,
+	0xd3, 0x1e, 0x15, 0x1c, 0x36, 0xe7, 0x66, 0xcb, 0x15, 0x4b, 0xdd, 0xa9, 0xa1, 0xed, 0x1e, 0xb5,
+	0x35, 0xfe, 0xfa, 0x3e, 0x66, 0x3a, 0x29, 0xc2, 0x80, 0xf2, 0x6c, 0x74, 0xe3, 0x09, 0x3c, 0x79,
+	0xb7, 0x49, 0x13, 0xc2, 0xf2, 0x51, 0x73, 0x73, 0x56, 0xbd, 0x9a, 0xfa, 0x5c, 0x80, 0xba, 0xb8,
+	0xf2, 0x87, 0x97, 0x57, 0xfe, 0xf0, 0xcf, 0x95, 0x3f, 0xfc, 0x79, 0xed, 0x0f, 0x2e, 0xaf, 0xfd,
+	0xc1, 0xaf, 0x6b, 0x7f, 0x10, 0xce, 0x99, 0xf6, 0xb7, 0x7f, 0x07, 0x00, 0xcb, 0x43, 0x5c, 0x76,
+	0xee, 0x05, 0x00, 0x00,
 }
 
 func (m *ProcessProposerMatchesEvents) Marshal() (dAtA []byte, err error) {
@@ -195,6 +240,62 @@ func (m *ProcessProposerMatchesEvents) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpiredHeartbeatSubaccountIds) > 0 {
+		for iNdEx := len(m.ExpiredHeartbeatSubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiredHeartbeatSubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MarketMakerProtectionTrips) > 0 {
+		for iNdEx := len(m.MarketMakerProtectionTrips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketMakerProtectionTrips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PlacedTwapSuborderIds) > 0 {
+		for iNdEx := len(m.PlacedTwapSuborderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlacedTwapSuborderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ReplacedStatefulOrderIds) > 0 {
+		for iNdEx := len(m.ReplacedStatefulOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplacedStatefulOrderIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintProcessProposerMatchesEvents(dAtA, i, uint64(m.BlockHeight))
 		i--
@@ -363,6 +464,30 @@ func (m *ProcessProposerMatchesEvents) Size() (n int) {
 	if m.BlockHeight != 0 {
 		n += 1 + sovProcessProposerMatchesEvents(uint64(m.BlockHeight))
 	}
+	if len(m.ReplacedStatefulOrderIds) > 0 {
+		for _, e := range m.ReplacedStatefulOrderIds {
+			l = e.Size()
+			n += 1 + l + sovProcessProposerMatchesEvents(uint64(l))
+		}
+	}
+	if len(m.PlacedTwapSuborderIds) > 0 {
+		for _, e := range m.PlacedTwapSuborderIds {
+			l = e.Size()
+			n += 1 + l + sovProcessProposerMatchesEvents(uint64(l))
+		}
+	}
+	if len(m.MarketMakerProtectionTrips) > 0 {
+		for _, e := range m.MarketMakerProtectionTrips {
+			l = e.Size()
+			n += 1 + l + sovProcessProposerMatchesEvents(uint64(l))
+		}
+	}
+	if len(m.ExpiredHeartbeatSubaccountIds) > 0 {
+		for _, e := range m.ExpiredHeartbeatSubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovProcessProposerMatchesEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -658,6 +783,142 @@ func (m *ProcessProposerMatchesEvents) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedStatefulOrderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessProposerMatchesEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedStatefulOrderIds = append(m.ReplacedStatefulOrderIds, OrderId{})
+			if err := m.ReplacedStatefulOrderIds[len(m.ReplacedStatefulOrderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedTwapSuborderIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessProposerMatchesEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacedTwapSuborderIds = append(m.PlacedTwapSuborderIds, OrderId{})
+			if err := m.PlacedTwapSuborderIds[len(m.PlacedTwapSuborderIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMakerProtectionTrips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessProposerMatchesEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketMakerProtectionTrips = append(m.MarketMakerProtectionTrips, MarketMakerProtectionTrip{})
+			if err := m.MarketMakerProtectionTrips[len(m.MarketMakerProtectionTrips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredHeartbeatSubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProcessProposerMatchesEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProcessProposerMatchesEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredHeartbeatSubaccountIds = append(m.ExpiredHeartbeatSubaccountIds, types.SubaccountId{})
+			if err := m.ExpiredHeartbeatSubaccountIds[len(m.ExpiredHeartbeatSubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProcessProposerMatchesEvents(dAtA[iNdEx:])
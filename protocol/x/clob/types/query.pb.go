@@ -11,7 +11,9 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
-	types "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -623,6 +625,12 @@ type QueryStatefulOrderResponse struct {
 	FillAmount uint64 `protobuf:"varint,2,opt,name=fill_amount,json=fillAmount,proto3" json:"fill_amount,omitempty"`
 	// Triggered status.
 	Triggered bool `protobuf:"varint,3,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	// The order group the order belongs to. Nil if the order is not part of an
+	// order group.
+	OrderGroup *OrderGroup `protobuf:"bytes,4,opt,name=order_group,json=orderGroup,proto3" json:"order_group,omitempty"`
+	// The execution progress of a TWAP order. Nil if the order is not a TWAP
+	// order.
+	TwapOrderState *TwapOrderState `protobuf:"bytes,5,opt,name=twap_order_state,json=twapOrderState,proto3" json:"twap_order_state,omitempty"`
 }
 
 func (m *QueryStatefulOrderResponse) Reset()         { *m = QueryStatefulOrderResponse{} }
@@ -679,23 +687,46 @@ func (m *QueryStatefulOrderResponse) GetTriggered() bool {
 	return false
 }
 
-// QueryLiquidationsConfigurationRequest is a request message for
-// LiquidationsConfiguration.
-type QueryLiquidationsConfigurationRequest struct {
+func (m *QueryStatefulOrderResponse) GetOrderGroup() *OrderGroup {
+	if m != nil {
+		return m.OrderGroup
+	}
+	return nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Reset()         { *m = QueryLiquidationsConfigurationRequest{} }
-func (m *QueryLiquidationsConfigurationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLiquidationsConfigurationRequest) ProtoMessage()    {}
-func (*QueryLiquidationsConfigurationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStatefulOrderResponse) GetTwapOrderState() *TwapOrderState {
+	if m != nil {
+		return m.TwapOrderState
+	}
+	return nil
+}
+
+// QueryOrderbookRequest is a request message for Orderbook.
+type QueryOrderbookRequest struct {
+	// Id of the clob pair to query the orderbook of.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Maximum number of price levels returned per side of the orderbook. If zero,
+	// a default depth is used.
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Price range in subticks that price levels are grouped by. Must be a
+	// multiple of the subticks per tick of the clob pair. Bids are grouped down
+	// and asks are grouped up to the nearest multiple. If zero, price levels are
+	// not grouped.
+	GroupSubticks uint64 `protobuf:"varint,3,opt,name=group_subticks,json=groupSubticks,proto3" json:"group_subticks,omitempty"`
+}
+
+func (m *QueryOrderbookRequest) Reset()         { *m = QueryOrderbookRequest{} }
+func (m *QueryOrderbookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookRequest) ProtoMessage()    {}
+func (*QueryOrderbookRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3365c195b25c5bc0, []int{12}
 }
-func (m *QueryLiquidationsConfigurationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOrderbookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLiquidationsConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOrderbookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLiquidationsConfigurationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOrderbookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -705,38 +736,62 @@ func (m *QueryLiquidationsConfigurationRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryLiquidationsConfigurationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLiquidationsConfigurationRequest.Merge(m, src)
+func (m *QueryOrderbookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookRequest.Merge(m, src)
 }
-func (m *QueryLiquidationsConfigurationRequest) XXX_Size() int {
+func (m *QueryOrderbookRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLiquidationsConfigurationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLiquidationsConfigurationRequest.DiscardUnknown(m)
+func (m *QueryOrderbookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLiquidationsConfigurationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOrderbookRequest proto.InternalMessageInfo
 
-// QueryLiquidationsConfigurationResponse is a response message that contains
-// the LiquidationsConfiguration.
-type QueryLiquidationsConfigurationResponse struct {
-	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,1,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
+func (m *QueryOrderbookRequest) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Reset() {
-	*m = QueryLiquidationsConfigurationResponse{}
+func (m *QueryOrderbookRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
-func (m *QueryLiquidationsConfigurationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLiquidationsConfigurationResponse) ProtoMessage()    {}
-func (*QueryLiquidationsConfigurationResponse) Descriptor() ([]byte, []int) {
+
+func (m *QueryOrderbookRequest) GetGroupSubticks() uint64 {
+	if m != nil {
+		return m.GroupSubticks
+	}
+	return 0
+}
+
+// OrderbookLevel is a price level of an orderbook, aggregating the remaining
+// size of all orders at the price.
+type OrderbookLevel struct {
+	// Price of the level in subticks.
+	Subticks uint64 `protobuf:"varint,1,opt,name=subticks,proto3" json:"subticks,omitempty"`
+	// Total remaining size of all orders at the price level in base quantums.
+	Quantums uint64 `protobuf:"varint,2,opt,name=quantums,proto3" json:"quantums,omitempty"`
+	// Number of orders at the price level.
+	NumOrders uint32 `protobuf:"varint,3,opt,name=num_orders,json=numOrders,proto3" json:"num_orders,omitempty"`
+}
+
+func (m *OrderbookLevel) Reset()         { *m = OrderbookLevel{} }
+func (m *OrderbookLevel) String() string { return proto.CompactTextString(m) }
+func (*OrderbookLevel) ProtoMessage()    {}
+func (*OrderbookLevel) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3365c195b25c5bc0, []int{13}
 }
-func (m *QueryLiquidationsConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *OrderbookLevel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLiquidationsConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OrderbookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLiquidationsConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OrderbookLevel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -746,44 +801,73 @@ func (m *QueryLiquidationsConfigurationResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryLiquidationsConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLiquidationsConfigurationResponse.Merge(m, src)
+func (m *OrderbookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderbookLevel.Merge(m, src)
 }
-func (m *QueryLiquidationsConfigurationResponse) XXX_Size() int {
+func (m *OrderbookLevel) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLiquidationsConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLiquidationsConfigurationResponse.DiscardUnknown(m)
+func (m *OrderbookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderbookLevel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLiquidationsConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_OrderbookLevel proto.InternalMessageInfo
 
-func (m *QueryLiquidationsConfigurationResponse) GetLiquidationsConfig() LiquidationsConfig {
+func (m *OrderbookLevel) GetSubticks() uint64 {
 	if m != nil {
-		return m.LiquidationsConfig
+		return m.Subticks
 	}
-	return LiquidationsConfig{}
+	return 0
 }
 
-// StreamOrderbookUpdatesRequest is a request message for the
-// StreamOrderbookUpdates method.
-type StreamOrderbookUpdatesRequest struct {
-	// Clob pair ids to stream orderbook updates for.
-	ClobPairId []uint32 `protobuf:"varint,1,rep,packed,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+func (m *OrderbookLevel) GetQuantums() uint64 {
+	if m != nil {
+		return m.Quantums
+	}
+	return 0
 }
 
-func (m *StreamOrderbookUpdatesRequest) Reset()         { *m = StreamOrderbookUpdatesRequest{} }
-func (m *StreamOrderbookUpdatesRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdatesRequest) ProtoMessage()    {}
-func (*StreamOrderbookUpdatesRequest) Descriptor() ([]byte, []int) {
+func (m *OrderbookLevel) GetNumOrders() uint32 {
+	if m != nil {
+		return m.NumOrders
+	}
+	return 0
+}
+
+// QueryOrderbookResponse is a response message that contains the price levels
+// of the orderbook of a clob pair.
+type QueryOrderbookResponse struct {
+	// Id of the clob pair.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Height of the last committed block when the orderbook was captured. The
+	// orderbook contains all orders known to the node after the block was
+	// committed.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Bid price levels, sorted by descending price.
+	Bids []OrderbookLevel `protobuf:"bytes,3,rep,name=bids,proto3" json:"bids"`
+	// Ask price levels, sorted by ascending price.
+	Asks []OrderbookLevel `protobuf:"bytes,4,rep,name=asks,proto3" json:"asks"`
+	// Price of the best bid in subticks. Zero if there are no bids.
+	BestBidSubticks uint64 `protobuf:"varint,5,opt,name=best_bid_subticks,json=bestBidSubticks,proto3" json:"best_bid_subticks,omitempty"`
+	// Price of the best ask in subticks. Zero if there are no asks.
+	BestAskSubticks uint64 `protobuf:"varint,6,opt,name=best_ask_subticks,json=bestAskSubticks,proto3" json:"best_ask_subticks,omitempty"`
+	// Mid price of the orderbook in subticks. Zero if there are no bids or no
+	// asks.
+	MidPriceSubticks uint64 `protobuf:"varint,7,opt,name=mid_price_subticks,json=midPriceSubticks,proto3" json:"mid_price_subticks,omitempty"`
+}
+
+func (m *QueryOrderbookResponse) Reset()         { *m = QueryOrderbookResponse{} }
+func (m *QueryOrderbookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderbookResponse) ProtoMessage()    {}
+func (*QueryOrderbookResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3365c195b25c5bc0, []int{14}
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryOrderbookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryOrderbookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryOrderbookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -793,49 +877,87 @@ func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdatesRequest.Merge(m, src)
+func (m *QueryOrderbookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderbookResponse.Merge(m, src)
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Size() int {
+func (m *QueryOrderbookResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdatesRequest.DiscardUnknown(m)
+func (m *QueryOrderbookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderbookResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryOrderbookResponse proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdatesRequest) GetClobPairId() []uint32 {
+func (m *QueryOrderbookResponse) GetClobPairId() uint32 {
 	if m != nil {
 		return m.ClobPairId
 	}
+	return 0
+}
+
+func (m *QueryOrderbookResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *QueryOrderbookResponse) GetBids() []OrderbookLevel {
+	if m != nil {
+		return m.Bids
+	}
 	return nil
 }
 
-// StreamOrderbookUpdatesResponse is a response message for the
-// StreamOrderbookUpdates method.
-type StreamOrderbookUpdatesResponse struct {
-	// Orderbook updates for the clob pair.
-	Updates []StreamUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
-	// ---Additional fields used to debug issues---
-	// Block height of the updates.
-	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Exec mode of the updates.
-	ExecMode uint32 `protobuf:"varint,3,opt,name=exec_mode,json=execMode,proto3" json:"exec_mode,omitempty"`
+func (m *QueryOrderbookResponse) GetAsks() []OrderbookLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) Reset()         { *m = StreamOrderbookUpdatesResponse{} }
-func (m *StreamOrderbookUpdatesResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdatesResponse) ProtoMessage()    {}
-func (*StreamOrderbookUpdatesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryOrderbookResponse) GetBestBidSubticks() uint64 {
+	if m != nil {
+		return m.BestBidSubticks
+	}
+	return 0
+}
+
+func (m *QueryOrderbookResponse) GetBestAskSubticks() uint64 {
+	if m != nil {
+		return m.BestAskSubticks
+	}
+	return 0
+}
+
+func (m *QueryOrderbookResponse) GetMidPriceSubticks() uint64 {
+	if m != nil {
+		return m.MidPriceSubticks
+	}
+	return 0
+}
+
+// QuerySubaccountRiskRequest is a request message for SubaccountRisk.
+type QuerySubaccountRiskRequest struct {
+	// The address of the wallet that owns the subaccount.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The unique number of the subaccount for the owner.
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QuerySubaccountRiskRequest) Reset()         { *m = QuerySubaccountRiskRequest{} }
+func (m *QuerySubaccountRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountRiskRequest) ProtoMessage()    {}
+func (*QuerySubaccountRiskRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3365c195b25c5bc0, []int{15}
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubaccountRiskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubaccountRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubaccountRiskRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -845,64 +967,50 @@ func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdatesResponse.Merge(m, src)
+func (m *QuerySubaccountRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountRiskRequest.Merge(m, src)
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Size() int {
+func (m *QuerySubaccountRiskRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdatesResponse.DiscardUnknown(m)
+func (m *QuerySubaccountRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountRiskRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdatesResponse proto.InternalMessageInfo
-
-func (m *StreamOrderbookUpdatesResponse) GetUpdates() []StreamUpdate {
-	if m != nil {
-		return m.Updates
-	}
-	return nil
-}
+var xxx_messageInfo_QuerySubaccountRiskRequest proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdatesResponse) GetBlockHeight() uint32 {
+func (m *QuerySubaccountRiskRequest) GetOwner() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
-func (m *StreamOrderbookUpdatesResponse) GetExecMode() uint32 {
+func (m *QuerySubaccountRiskRequest) GetNumber() uint32 {
 	if m != nil {
-		return m.ExecMode
+		return m.Number
 	}
 	return 0
 }
 
-// StreamUpdate is an update that will be pushed through the
-// GRPC stream.
-type StreamUpdate struct {
-	// Contains one of an StreamOrderbookUpdate,
-	// StreamOrderbookFill.
-	//
-	// Types that are valid to be assigned to UpdateMessage:
-	//
-	//	*StreamUpdate_OrderbookUpdate
-	//	*StreamUpdate_OrderFill
-	UpdateMessage isStreamUpdate_UpdateMessage `protobuf_oneof:"update_message"`
+// QuerySubaccountRiskResponse is a response message that contains the risk of
+// a subaccount.
+type QuerySubaccountRiskResponse struct {
+	SubaccountRisk SubaccountRisk `protobuf:"bytes,1,opt,name=subaccount_risk,json=subaccountRisk,proto3" json:"subaccount_risk"`
 }
 
-func (m *StreamUpdate) Reset()         { *m = StreamUpdate{} }
-func (m *StreamUpdate) String() string { return proto.CompactTextString(m) }
-func (*StreamUpdate) ProtoMessage()    {}
-func (*StreamUpdate) Descriptor() ([]byte, []int) {
+func (m *QuerySubaccountRiskResponse) Reset()         { *m = QuerySubaccountRiskResponse{} }
+func (m *QuerySubaccountRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountRiskResponse) ProtoMessage()    {}
+func (*QuerySubaccountRiskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3365c195b25c5bc0, []int{16}
 }
-func (m *StreamUpdate) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubaccountRiskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubaccountRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubaccountRiskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -912,88 +1020,137 @@ func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StreamUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamUpdate.Merge(m, src)
+func (m *QuerySubaccountRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountRiskResponse.Merge(m, src)
 }
-func (m *StreamUpdate) XXX_Size() int {
+func (m *QuerySubaccountRiskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamUpdate.DiscardUnknown(m)
+func (m *QuerySubaccountRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountRiskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamUpdate proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubaccountRiskResponse proto.InternalMessageInfo
 
-type isStreamUpdate_UpdateMessage interface {
-	isStreamUpdate_UpdateMessage()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *QuerySubaccountRiskResponse) GetSubaccountRisk() SubaccountRisk {
+	if m != nil {
+		return m.SubaccountRisk
+	}
+	return SubaccountRisk{}
+}
+
+// SubaccountRisk contains the collateral, margin requirements and liquidation
+// risk of a subaccount, computed from the current oracle prices. All values in
+// quote quantums are signed.
+type SubaccountRisk struct {
+	// Id of the subaccount.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// Total net collateral of the subaccount in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// Total initial margin requirement of the subaccount in quote quantums.
+	InitialMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=initial_margin,json=initialMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin"`
+	// Total maintenance margin requirement of the subaccount in quote quantums.
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
+	// Free collateral of the subaccount in quote quantums, that is the net
+	// collateral minus the initial margin requirement.
+	FreeCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,5,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"free_collateral"`
+	// Whether the subaccount can currently be liquidated.
+	IsLiquidatable bool `protobuf:"varint,6,opt,name=is_liquidatable,json=isLiquidatable,proto3" json:"is_liquidatable,omitempty"`
+	// Risk of each perpetual position of the subaccount.
+	PerpetualPositions []PerpetualPositionRisk `protobuf:"bytes,7,rep,name=perpetual_positions,json=perpetualPositions,proto3" json:"perpetual_positions"`
+}
+
+func (m *SubaccountRisk) Reset()         { *m = SubaccountRisk{} }
+func (m *SubaccountRisk) String() string { return proto.CompactTextString(m) }
+func (*SubaccountRisk) ProtoMessage()    {}
+func (*SubaccountRisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{17}
 }
-
-type StreamUpdate_OrderbookUpdate struct {
-	OrderbookUpdate *StreamOrderbookUpdate `protobuf:"bytes,1,opt,name=orderbook_update,json=orderbookUpdate,proto3,oneof" json:"orderbook_update,omitempty"`
+func (m *SubaccountRisk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type StreamUpdate_OrderFill struct {
-	OrderFill *StreamOrderbookFill `protobuf:"bytes,2,opt,name=order_fill,json=orderFill,proto3,oneof" json:"order_fill,omitempty"`
+func (m *SubaccountRisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubaccountRisk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubaccountRisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubaccountRisk.Merge(m, src)
+}
+func (m *SubaccountRisk) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubaccountRisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubaccountRisk.DiscardUnknown(m)
 }
 
-func (*StreamUpdate_OrderbookUpdate) isStreamUpdate_UpdateMessage() {}
-func (*StreamUpdate_OrderFill) isStreamUpdate_UpdateMessage()       {}
+var xxx_messageInfo_SubaccountRisk proto.InternalMessageInfo
 
-func (m *StreamUpdate) GetUpdateMessage() isStreamUpdate_UpdateMessage {
+func (m *SubaccountRisk) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.UpdateMessage
+		return m.SubaccountId
 	}
-	return nil
+	return types.SubaccountId{}
 }
 
-func (m *StreamUpdate) GetOrderbookUpdate() *StreamOrderbookUpdate {
-	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderbookUpdate); ok {
-		return x.OrderbookUpdate
+func (m *SubaccountRisk) GetIsLiquidatable() bool {
+	if m != nil {
+		return m.IsLiquidatable
 	}
-	return nil
+	return false
 }
 
-func (m *StreamUpdate) GetOrderFill() *StreamOrderbookFill {
-	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderFill); ok {
-		return x.OrderFill
+func (m *SubaccountRisk) GetPerpetualPositions() []PerpetualPositionRisk {
+	if m != nil {
+		return m.PerpetualPositions
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamUpdate) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamUpdate_OrderbookUpdate)(nil),
-		(*StreamUpdate_OrderFill)(nil),
-	}
-}
-
-// StreamOrderbookUpdate provides information on an orderbook update. Used in
-// the full node GRPC stream.
-type StreamOrderbookUpdate struct {
-	// Orderbook updates for the clob pair. Can contain order place, removals,
-	// or updates.
-	Updates []types.OffChainUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
-	// Snapshot indicates if the response is from a snapshot of the orderbook.
-	// This is true for the initial response and false for all subsequent updates.
-	// Note that if the snapshot is true, then all previous entries should be
-	// discarded and the orderbook should be resynced.
-	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-}
-
-func (m *StreamOrderbookUpdate) Reset()         { *m = StreamOrderbookUpdate{} }
-func (m *StreamOrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdate) ProtoMessage()    {}
-func (*StreamOrderbookUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{17}
+// PerpetualPositionRisk contains the liquidation risk of a perpetual position
+// of a subaccount.
+type PerpetualPositionRisk struct {
+	// Id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Id of the clob pair of the perpetual.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Size of the position in base quantums.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// Oracle price of the perpetual in subticks.
+	OraclePriceSubticks uint64 `protobuf:"varint,4,opt,name=oracle_price_subticks,json=oraclePriceSubticks,proto3" json:"oracle_price_subticks,omitempty"`
+	// Price in subticks at which closing the whole position leaves the
+	// subaccount with zero net collateral. Zero if the subaccount can close the
+	// position at any price without going bankrupt.
+	BankruptcyPriceSubticks uint64 `protobuf:"varint,5,opt,name=bankruptcy_price_subticks,json=bankruptcyPriceSubticks,proto3" json:"bankruptcy_price_subticks,omitempty"`
+	// Estimated risk price in subticks at which the subaccount becomes
+	// liquidatable, assuming the risk prices of all other positions stay the
+	// same. Zero if no such price exists.
+	EstimatedLiquidationPriceSubticks uint64 `protobuf:"varint,6,opt,name=estimated_liquidation_price_subticks,json=estimatedLiquidationPriceSubticks,proto3" json:"estimated_liquidation_price_subticks,omitempty"`
+	// Risk price of the perpetual in subticks, at which positions are valued to
+	// determine whether the subaccount is liquidatable. This is the mark price
+	// of perpetuals which enable it, and the oracle price otherwise.
+	RiskPriceSubticks uint64 `protobuf:"varint,7,opt,name=risk_price_subticks,json=riskPriceSubticks,proto3" json:"risk_price_subticks,omitempty"`
+}
+
+func (m *PerpetualPositionRisk) Reset()         { *m = PerpetualPositionRisk{} }
+func (m *PerpetualPositionRisk) String() string { return proto.CompactTextString(m) }
+func (*PerpetualPositionRisk) ProtoMessage()    {}
+func (*PerpetualPositionRisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{18}
 }
-func (m *StreamOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+func (m *PerpetualPositionRisk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PerpetualPositionRisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PerpetualPositionRisk.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1003,57 +1160,78 @@ func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdate.Merge(m, src)
+func (m *PerpetualPositionRisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualPositionRisk.Merge(m, src)
 }
-func (m *StreamOrderbookUpdate) XXX_Size() int {
+func (m *PerpetualPositionRisk) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdate.DiscardUnknown(m)
+func (m *PerpetualPositionRisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualPositionRisk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdate proto.InternalMessageInfo
+var xxx_messageInfo_PerpetualPositionRisk proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdate) GetUpdates() []types.OffChainUpdateV1 {
+func (m *PerpetualPositionRisk) GetPerpetualId() uint32 {
 	if m != nil {
-		return m.Updates
+		return m.PerpetualId
 	}
-	return nil
+	return 0
 }
 
-func (m *StreamOrderbookUpdate) GetSnapshot() bool {
+func (m *PerpetualPositionRisk) GetClobPairId() uint32 {
 	if m != nil {
-		return m.Snapshot
+		return m.ClobPairId
 	}
-	return false
+	return 0
 }
 
-// StreamOrderbookFill provides information on an orderbook fill. Used in
-// the full node GRPC stream.
-type StreamOrderbookFill struct {
-	// Clob match. Provides information on which orders were matched
-	// and the type of order. Fill amounts here are relative.
-	ClobMatch *ClobMatch `protobuf:"bytes,1,opt,name=clob_match,json=clobMatch,proto3" json:"clob_match,omitempty"`
-	// All orders involved in the specified clob match. Used to look up
-	// price of a match through a given maker order id.
-	Orders []Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
-	// Resulting fill amounts for each order in the orders array.
-	FillAmounts []uint64 `protobuf:"varint,3,rep,packed,name=fill_amounts,json=fillAmounts,proto3" json:"fill_amounts,omitempty"`
+func (m *PerpetualPositionRisk) GetOraclePriceSubticks() uint64 {
+	if m != nil {
+		return m.OraclePriceSubticks
+	}
+	return 0
 }
 
-func (m *StreamOrderbookFill) Reset()         { *m = StreamOrderbookFill{} }
-func (m *StreamOrderbookFill) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookFill) ProtoMessage()    {}
-func (*StreamOrderbookFill) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{18}
+func (m *PerpetualPositionRisk) GetBankruptcyPriceSubticks() uint64 {
+	if m != nil {
+		return m.BankruptcyPriceSubticks
+	}
+	return 0
 }
-func (m *StreamOrderbookFill) XXX_Unmarshal(b []byte) error {
+
+func (m *PerpetualPositionRisk) GetEstimatedLiquidationPriceSubticks() uint64 {
+	if m != nil {
+		return m.EstimatedLiquidationPriceSubticks
+	}
+	return 0
+}
+
+func (m *PerpetualPositionRisk) GetRiskPriceSubticks() uint64 {
+	if m != nil {
+		return m.RiskPriceSubticks
+	}
+	return 0
+}
+
+// QuerySimulateOrderRequest is a request message for SimulateOrder.
+type QuerySimulateOrderRequest struct {
+	// The order to simulate.
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+}
+
+func (m *QuerySimulateOrderRequest) Reset()         { *m = QuerySimulateOrderRequest{} }
+func (m *QuerySimulateOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{19}
+}
+func (m *QuerySimulateOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySimulateOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookFill.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySimulateOrderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1063,1655 +1241,5209 @@ func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookFill) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookFill.Merge(m, src)
+func (m *QuerySimulateOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderRequest.Merge(m, src)
 }
-func (m *StreamOrderbookFill) XXX_Size() int {
+func (m *QuerySimulateOrderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookFill) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookFill.DiscardUnknown(m)
+func (m *QuerySimulateOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookFill proto.InternalMessageInfo
+var xxx_messageInfo_QuerySimulateOrderRequest proto.InternalMessageInfo
 
-func (m *StreamOrderbookFill) GetClobMatch() *ClobMatch {
+func (m *QuerySimulateOrderRequest) GetOrder() Order {
 	if m != nil {
-		return m.ClobMatch
+		return m.Order
 	}
-	return nil
+	return Order{}
 }
 
-func (m *StreamOrderbookFill) GetOrders() []Order {
-	if m != nil {
-		return m.Orders
-	}
-	return nil
+// SimulatedFill is a fill of the simulated order against a maker order.
+type SimulatedFill struct {
+	// Id of the maker order.
+	MakerOrderId OrderId `protobuf:"bytes,1,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id"`
+	// Price of the fill in subticks, that is the price of the maker order.
+	Subticks uint64 `protobuf:"varint,2,opt,name=subticks,proto3" json:"subticks,omitempty"`
+	// Size of the fill in base quantums.
+	FillQuantums uint64 `protobuf:"varint,3,opt,name=fill_quantums,json=fillQuantums,proto3" json:"fill_quantums,omitempty"`
+	// Fee paid by the subaccount of the simulated order for the fill in quote
+	// quantums. Negative for rebates.
+	FeeQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=fee_quote_quantums,json=feeQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"fee_quote_quantums"`
 }
 
-func (m *StreamOrderbookFill) GetFillAmounts() []uint64 {
-	if m != nil {
-		return m.FillAmounts
+func (m *SimulatedFill) Reset()         { *m = SimulatedFill{} }
+func (m *SimulatedFill) String() string { return proto.CompactTextString(m) }
+func (*SimulatedFill) ProtoMessage()    {}
+func (*SimulatedFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{20}
+}
+func (m *SimulatedFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func init() {
-	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
-	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
-	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
-	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*QueryStatefulOrderRequest)(nil), "dydxprotocol.clob.QueryStatefulOrderRequest")
-	proto.RegisterType((*QueryStatefulOrderResponse)(nil), "dydxprotocol.clob.QueryStatefulOrderResponse")
-	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
-	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
-	proto.RegisterType((*StreamOrderbookUpdatesRequest)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesRequest")
-	proto.RegisterType((*StreamOrderbookUpdatesResponse)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesResponse")
-	proto.RegisterType((*StreamUpdate)(nil), "dydxprotocol.clob.StreamUpdate")
-	proto.RegisterType((*StreamOrderbookUpdate)(nil), "dydxprotocol.clob.StreamOrderbookUpdate")
-	proto.RegisterType((*StreamOrderbookFill)(nil), "dydxprotocol.clob.StreamOrderbookFill")
+func (m *SimulatedFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedFill.Merge(m, src)
 }
-
-func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
-
-var fileDescriptor_3365c195b25c5bc0 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0xdf, 0x49, 0x42, 0xbb, 0x79, 0x49, 0xda, 0x32, 0x69, 0xda, 0xad, 0x93, 0x6e, 0x52, 0x43,
-	0x93, 0x4d, 0x4a, 0xd7, 0x4d, 0x5a, 0x55, 0xa5, 0x41, 0x45, 0x49, 0x44, 0x3f, 0xa4, 0x86, 0x06,
-	0xf7, 0x83, 0x0a, 0x2a, 0x59, 0x5e, 0x7b, 0xd6, 0xb1, 0x6a, 0x7b, 0x36, 0xf6, 0xec, 0x2a, 0x11,
-	0x42, 0x48, 0x1c, 0xb8, 0x00, 0x12, 0x12, 0x07, 0x0e, 0x48, 0x48, 0x88, 0x3f, 0x01, 0x71, 0x44,
-	0x94, 0x5b, 0x8f, 0x95, 0xb8, 0x70, 0x40, 0x08, 0xb5, 0x9c, 0xf9, 0x1b, 0x90, 0x67, 0xc6, 0x9b,
-	0xdd, 0xd8, 0xde, 0x4d, 0x72, 0xd9, 0xb5, 0xdf, 0xbc, 0x8f, 0xdf, 0x7b, 0xf3, 0x9b, 0x79, 0xcf,
-	0x70, 0xd6, 0xde, 0xb1, 0xb7, 0x1b, 0x21, 0x65, 0xd4, 0xa2, 0x9e, 0x66, 0x79, 0xb4, 0xa6, 0x6d,
-	0x35, 0x49, 0xb8, 0x53, 0xe5, 0x32, 0xfc, 0x7a, 0xe7, 0x72, 0x35, 0x5e, 0x56, 0x4e, 0x3a, 0xd4,
-	0xa1, 0x5c, 0xa4, 0xc5, 0x4f, 0x42, 0x51, 0x99, 0x72, 0x28, 0x75, 0x3c, 0xa2, 0x99, 0x0d, 0x57,
-	0x33, 0x83, 0x80, 0x32, 0x93, 0xb9, 0x34, 0x88, 0xe4, 0xea, 0x82, 0x45, 0x23, 0x9f, 0x46, 0x5a,
-	0xcd, 0x8c, 0x88, 0xf0, 0xaf, 0xb5, 0x16, 0x6b, 0x84, 0x99, 0x8b, 0x5a, 0xc3, 0x74, 0xdc, 0x80,
-	0x2b, 0x4b, 0x5d, 0x2d, 0x8d, 0xa8, 0xe6, 0x51, 0xeb, 0xa9, 0x11, 0x9a, 0x8c, 0x18, 0x9e, 0xeb,
-	0xbb, 0xcc, 0xb0, 0x68, 0x50, 0x77, 0x1d, 0x69, 0x70, 0x2e, 0x6d, 0x10, 0xff, 0x18, 0x0d, 0xd3,
-	0x0d, 0xa5, 0xca, 0xa5, 0xb4, 0x0a, 0xd9, 0x6a, 0xba, 0x6c, 0xc7, 0x60, 0x2e, 0x09, 0xb3, 0x9c,
-	0x66, 0xd4, 0x85, 0x86, 0x36, 0x49, 0x1c, 0x4e, 0xa7, 0x97, 0x7d, 0x93, 0x59, 0x9b, 0x24, 0xc9,
-	0xf8, 0x42, 0x5a, 0xc1, 0x73, 0xb7, 0x9a, 0xae, 0x2d, 0xea, 0xd2, 0x1d, 0x6c, 0x32, 0xc3, 0x1b,
-	0x69, 0xc9, 0xc5, 0x1b, 0x5d, 0x8b, 0x6e, 0x60, 0x93, 0x6d, 0x12, 0x6a, 0xb4, 0x5e, 0x37, 0xac,
-	0x4d, 0xd3, 0x0d, 0x8c, 0x66, 0xc3, 0x36, 0x19, 0x89, 0xd2, 0x12, 0x61, 0xaf, 0xce, 0xc3, 0xe9,
-	0x0f, 0xe2, 0x8a, 0xdf, 0x22, 0x6c, 0xcd, 0xa3, 0xb5, 0x0d, 0xd3, 0x0d, 0x75, 0xb2, 0xd5, 0x24,
-	0x11, 0xc3, 0xc7, 0x60, 0xc0, 0xb5, 0x4b, 0x68, 0x06, 0x55, 0xc6, 0xf4, 0x01, 0xd7, 0x56, 0x3f,
-	0x84, 0x09, 0xae, 0xba, 0xab, 0x17, 0x35, 0x68, 0x10, 0x11, 0x7c, 0x03, 0x86, 0xdb, 0x25, 0xe5,
-	0xfa, 0x23, 0x4b, 0x93, 0xd5, 0x14, 0x35, 0xaa, 0x89, 0xdd, 0xea, 0xd0, 0xf3, 0xbf, 0xa7, 0x0b,
-	0x7a, 0xd1, 0x92, 0xef, 0xaa, 0x29, 0x31, 0xac, 0x78, 0xde, 0x5e, 0x0c, 0x37, 0x01, 0x76, 0x29,
-	0x20, 0x7d, 0xcf, 0x56, 0x05, 0x5f, 0xaa, 0x31, 0x5f, 0xaa, 0x82, 0x8f, 0x92, 0x2f, 0xd5, 0x0d,
-	0xd3, 0x21, 0xd2, 0x56, 0xef, 0xb0, 0x54, 0x7f, 0x42, 0x50, 0xea, 0x02, 0xbf, 0xe2, 0x79, 0x79,
-	0xf8, 0x07, 0x0f, 0x88, 0x1f, 0xdf, 0xea, 0x02, 0x39, 0xc0, 0x41, 0xce, 0xf5, 0x05, 0x29, 0x82,
-	0x77, 0xa1, 0xfc, 0x0b, 0xc1, 0xf4, 0x3a, 0x69, 0xbd, 0x4f, 0x6d, 0xf2, 0x80, 0xc6, 0xbf, 0x6b,
-	0xa6, 0x67, 0x35, 0x3d, 0xbe, 0x98, 0x54, 0xe4, 0x09, 0x9c, 0x12, 0x84, 0x6f, 0x84, 0xb4, 0x41,
-	0x23, 0x12, 0x1a, 0x92, 0x5a, 0xed, 0xea, 0xa4, 0x91, 0x3f, 0x32, 0xbd, 0x98, 0x5a, 0x34, 0x5c,
-	0x27, 0xad, 0x75, 0xa1, 0xad, 0x9f, 0xe4, 0x5e, 0x36, 0xa4, 0x13, 0x29, 0xc5, 0x1f, 0xc3, 0x44,
-	0x2b, 0x51, 0x36, 0x7c, 0xd2, 0x32, 0x7c, 0xc2, 0x42, 0xd7, 0x8a, 0xda, 0x59, 0xa5, 0x9d, 0x77,
-	0x01, 0x5e, 0x17, 0xea, 0xfa, 0x78, 0xab, 0x33, 0xa4, 0x10, 0xaa, 0xff, 0x21, 0x98, 0xc9, 0x4f,
-	0x4f, 0x6e, 0x86, 0x03, 0x47, 0x43, 0x12, 0x35, 0x3d, 0x16, 0xc9, 0xad, 0xb8, 0xd5, 0x2f, 0x66,
-	0x86, 0x97, 0x58, 0x61, 0x25, 0xb0, 0x1f, 0x51, 0xaf, 0xe9, 0x93, 0x0d, 0x12, 0xc6, 0x5b, 0x27,
-	0xb7, 0x2d, 0xf1, 0xae, 0x98, 0x30, 0x9e, 0xa1, 0x85, 0x67, 0x60, 0xb4, 0x4d, 0x06, 0xa3, 0xcd,
-	0x7f, 0x48, 0x36, 0xfb, 0x8e, 0x8d, 0x4f, 0xc0, 0xa0, 0x4f, 0x5a, 0xbc, 0x22, 0x03, 0x7a, 0xfc,
-	0x88, 0x4f, 0xc1, 0x91, 0x16, 0x77, 0x52, 0x1a, 0x9c, 0x41, 0x95, 0x21, 0x5d, 0xbe, 0xa9, 0x0b,
-	0x50, 0xe1, 0xa4, 0x7b, 0x8f, 0xdf, 0x26, 0x0f, 0x5c, 0x12, 0xde, 0x8d, 0xef, 0x92, 0x35, 0x7e,
-	0xba, 0x9b, 0x61, 0xe7, 0xbe, 0xaa, 0xdf, 0x23, 0x98, 0xdf, 0x87, 0xb2, 0xac, 0x52, 0x00, 0xa5,
-	0xbc, 0x2b, 0x4a, 0xf2, 0x40, 0xcb, 0x28, 0x5b, 0x2f, 0xd7, 0xb2, 0x3c, 0x13, 0x24, 0x4b, 0x47,
-	0x9d, 0x87, 0x39, 0x0e, 0x6e, 0x35, 0x26, 0x8d, 0x6e, 0x32, 0x92, 0x9f, 0xc8, 0x77, 0x48, 0x66,
-	0xdd, 0x53, 0x57, 0xe6, 0xf1, 0x14, 0x4e, 0xe7, 0x5c, 0xdf, 0x32, 0x8d, 0x6a, 0x46, 0x1a, 0x3d,
-	0x1c, 0xcb, 0x2c, 0x04, 0xb9, 0xf7, 0xa8, 0xa8, 0x8f, 0xe1, 0x0c, 0x07, 0x76, 0x9f, 0x99, 0x8c,
-	0xd4, 0x9b, 0xde, 0xbd, 0xf8, 0xca, 0x4e, 0xce, 0xd5, 0x32, 0x14, 0xf9, 0x15, 0x9e, 0xec, 0xf9,
-	0xc8, 0x92, 0x92, 0x11, 0x9a, 0x9b, 0xdc, 0xb1, 0x13, 0x2e, 0x51, 0xf1, 0xaa, 0xfe, 0x82, 0x40,
-	0xc9, 0x72, 0x2d, 0xb3, 0x7c, 0x0c, 0xc7, 0x85, 0xef, 0x86, 0x67, 0x5a, 0xc4, 0x27, 0x01, 0x93,
-	0x21, 0xe6, 0x33, 0x42, 0xdc, 0xa5, 0x81, 0xf3, 0x80, 0x84, 0x3e, 0x77, 0xb1, 0x91, 0x18, 0xc8,
-	0x88, 0xc7, 0x68, 0x97, 0x14, 0x4f, 0xc3, 0x48, 0xdd, 0xf5, 0x3c, 0xc3, 0xf4, 0x69, 0x33, 0x60,
-	0x9c, 0x93, 0x43, 0x3a, 0xc4, 0xa2, 0x15, 0x2e, 0xc1, 0x53, 0x30, 0xcc, 0x42, 0xd7, 0x71, 0x48,
-	0x48, 0x6c, 0xce, 0xce, 0xa2, 0xbe, 0x2b, 0x50, 0xe7, 0xe0, 0x3c, 0x87, 0x7d, 0xb7, 0xa3, 0xf9,
-	0x64, 0x6e, 0xea, 0x17, 0x08, 0x66, 0xfb, 0x69, 0xca, 0x64, 0x9f, 0xc0, 0x78, 0x46, 0x2f, 0x93,
-	0x09, 0x9f, 0xcf, 0x4a, 0x38, 0xe5, 0x52, 0x26, 0x8b, 0xbd, 0xd4, 0x8a, 0xba, 0x02, 0x67, 0xef,
-	0xb3, 0x90, 0x98, 0xa2, 0x3c, 0x35, 0x4a, 0x9f, 0x3e, 0x14, 0xfd, 0x2c, 0xd9, 0xc7, 0xf4, 0xf9,
-	0x1d, 0xec, 0x3e, 0xbf, 0xea, 0x8f, 0x08, 0xca, 0x79, 0x3e, 0x64, 0x0e, 0xef, 0xc2, 0x51, 0xd9,
-	0x26, 0xe5, 0x25, 0x34, 0x9d, 0x81, 0x5b, 0xf8, 0x10, 0xa6, 0x09, 0x21, 0xa4, 0x15, 0x3e, 0x07,
-	0xa3, 0x82, 0xd7, 0x9b, 0xc4, 0x75, 0x36, 0xc5, 0xc6, 0x8c, 0xe9, 0x23, 0x5c, 0x76, 0x9b, 0x8b,
-	0xf0, 0x24, 0x0c, 0x93, 0x6d, 0x62, 0x19, 0x3e, 0xb5, 0xc5, 0xbd, 0x31, 0xa6, 0x17, 0x63, 0xc1,
-	0x3a, 0xb5, 0x89, 0xfa, 0x0c, 0xc1, 0x68, 0xa7, 0x7f, 0xfc, 0x10, 0x4e, 0xd0, 0x04, 0xad, 0x6c,
-	0xe1, 0xb2, 0xa4, 0x95, 0x5c, 0x68, 0x7b, 0xd2, 0xbb, 0x5d, 0xd0, 0x8f, 0xd3, 0x6e, 0x51, 0xdc,
-	0xba, 0x04, 0x33, 0x63, 0xca, 0xc8, 0x4b, 0x7e, 0xb6, 0xbf, 0xc3, 0x9b, 0xae, 0xe7, 0xdd, 0x2e,
-	0xe8, 0xc3, 0xdc, 0x36, 0x7e, 0x59, 0x3d, 0x01, 0xc7, 0x04, 0x2a, 0xc3, 0x27, 0x51, 0x64, 0x3a,
-	0x44, 0xfd, 0x1a, 0xc1, 0x44, 0x26, 0x0e, 0xfc, 0x78, 0x6f, 0x75, 0xaf, 0x75, 0x47, 0x94, 0x53,
-	0x4c, 0x35, 0x3d, 0xb3, 0xdc, 0xab, 0xd7, 0xd7, 0x62, 0x81, 0x70, 0xf4, 0x68, 0x71, 0x6f, 0xd9,
-	0x15, 0x28, 0x46, 0x81, 0xd9, 0x88, 0x36, 0xa9, 0x28, 0x79, 0x51, 0x6f, 0xbf, 0xab, 0x3f, 0x23,
-	0x18, 0xcf, 0x48, 0x03, 0x2f, 0x03, 0x27, 0x87, 0x68, 0xa3, 0xb2, 0xa6, 0x53, 0x39, 0xed, 0x9f,
-	0xb7, 0x49, 0x9d, 0x4f, 0x0b, 0xfc, 0x11, 0x5f, 0x85, 0x23, 0xbc, 0x06, 0x71, 0x83, 0x8c, 0x33,
-	0x29, 0xe5, 0xdd, 0x19, 0x12, 0xa9, 0xd4, 0xc6, 0x73, 0x30, 0xda, 0x71, 0x6e, 0xa3, 0xd2, 0xe0,
-	0xcc, 0x60, 0x65, 0x48, 0xea, 0x8c, 0xec, 0x1e, 0xdf, 0x68, 0xe9, 0x07, 0x80, 0xd7, 0xf8, 0xc1,
-	0xc3, 0x5f, 0x22, 0x28, 0x26, 0x23, 0x08, 0x5e, 0xc8, 0x88, 0x93, 0x33, 0xc7, 0x29, 0x95, 0x3c,
-	0xdd, 0xbd, 0x83, 0x9c, 0x3a, 0xff, 0xf9, 0x1f, 0xff, 0x7e, 0x3b, 0xf0, 0x06, 0x3e, 0xa7, 0xf5,
-	0x18, 0x9a, 0xb5, 0x4f, 0x5c, 0xfb, 0x53, 0xfc, 0x15, 0x82, 0x91, 0x8e, 0x59, 0x2a, 0x1f, 0x50,
-	0x7a, 0xa8, 0x53, 0x2e, 0xf4, 0x03, 0xd4, 0x31, 0x9c, 0xa9, 0x6f, 0x72, 0x4c, 0x65, 0x3c, 0xd5,
-	0x0b, 0x13, 0xfe, 0x0d, 0x41, 0x29, 0x6f, 0x28, 0xc0, 0x4b, 0x07, 0x9a, 0x20, 0x04, 0xc6, 0xcb,
-	0x87, 0x98, 0x3a, 0xd4, 0xeb, 0x1c, 0xeb, 0x95, 0xeb, 0x68, 0x41, 0xd5, 0xb4, 0xcc, 0xa9, 0xdd,
-	0x08, 0xa8, 0x4d, 0x0c, 0x46, 0xc5, 0xbf, 0xd5, 0x01, 0xf2, 0x77, 0x04, 0x53, 0xbd, 0xfa, 0x33,
-	0x5e, 0xce, 0xab, 0xda, 0x3e, 0xa6, 0x0b, 0xe5, 0x9d, 0xc3, 0x19, 0xcb, 0xbc, 0x66, 0x79, 0x5e,
-	0x33, 0xb8, 0xac, 0xf5, 0xfc, 0x52, 0xc2, 0xbf, 0x22, 0x98, 0xec, 0xd1, 0x9c, 0xf1, 0xf5, 0x3c,
-	0x14, 0xfd, 0xc7, 0x0a, 0x65, 0xf9, 0x50, 0xb6, 0x32, 0x81, 0xf3, 0x3c, 0x81, 0x69, 0x7c, 0xb6,
-	0xe7, 0xe7, 0x23, 0x7e, 0x86, 0xe0, 0x4c, 0x6e, 0x83, 0xc3, 0xd7, 0xf2, 0x10, 0xf4, 0xeb, 0x9e,
-	0xca, 0xdb, 0x87, 0xb0, 0x94, 0xc8, 0xab, 0x1c, 0x79, 0x05, 0xcf, 0x6a, 0xfb, 0xfa, 0x64, 0xc4,
-	0x01, 0x8c, 0x75, 0xcd, 0x20, 0xf8, 0xad, 0xbc, 0xd8, 0x59, 0x53, 0x90, 0x72, 0x71, 0x9f, 0xda,
-	0x12, 0x5d, 0x01, 0x7f, 0x06, 0xa7, 0xb2, 0x7b, 0x29, 0xbe, 0xb4, 0xdf, 0xbe, 0x94, 0xb4, 0x6e,
-	0x65, 0xf1, 0x00, 0x16, 0x02, 0xc0, 0x25, 0xb4, 0xba, 0xf1, 0xfc, 0x65, 0x19, 0xbd, 0x78, 0x59,
-	0x46, 0xff, 0xbc, 0x2c, 0xa3, 0x6f, 0x5e, 0x95, 0x0b, 0x2f, 0x5e, 0x95, 0x0b, 0x7f, 0xbe, 0x2a,
-	0x17, 0x3e, 0xba, 0xea, 0xb8, 0x6c, 0xb3, 0x59, 0xab, 0x5a, 0xd4, 0xef, 0x2e, 0x5e, 0xeb, 0xca,
-	0x45, 0xde, 0x56, 0xb4, 0xb6, 0x64, 0x5b, 0x14, 0x94, 0xed, 0x34, 0x48, 0x54, 0x3b, 0xc2, 0xc5,
-	0x97, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x63, 0x36, 0x67, 0x96, 0xfd, 0x10, 0x00, 0x00,
+func (m *SimulatedFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedFill.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SimulatedFill proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a ClobPair by id.
-	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
-	// Queries the stateful order for a given order id.
-	StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error)
-	// Streams orderbook updates. Updates contain orderbook data
-	// such as order placements, updates, and fills.
-	StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error)
+func (m *SimulatedFill) GetMakerOrderId() OrderId {
+	if m != nil {
+		return m.MakerOrderId
+	}
+	return OrderId{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *SimulatedFill) GetSubticks() uint64 {
+	if m != nil {
+		return m.Subticks
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *SimulatedFill) GetFillQuantums() uint64 {
+	if m != nil {
+		return m.FillQuantums
+	}
+	return 0
 }
 
-func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
-	out := new(QueryClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QuerySimulateOrderResponse is a response message that contains the expected
+// result of placing an order.
+type QuerySimulateOrderResponse struct {
+	// Height of the last committed block when the orderbook that the order was
+	// simulated against was captured.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Fills of the order against maker orders, in matching order.
+	Fills []SimulatedFill `protobuf:"bytes,2,rep,name=fills,proto3" json:"fills"`
+	// Total filled size of the order in base quantums.
+	TotalFilledQuantums uint64 `protobuf:"varint,3,opt,name=total_filled_quantums,json=totalFilledQuantums,proto3" json:"total_filled_quantums,omitempty"`
+	// Average price of the fills in subticks, rounded towards the price of the
+	// order. Zero if the order is not filled.
+	AverageFillSubticks uint64 `protobuf:"varint,4,opt,name=average_fill_subticks,json=averageFillSubticks,proto3" json:"average_fill_subticks,omitempty"`
+	// Fee rate of the subaccount of the order as a taker in ppm.
+	TakerFeePpm int32 `protobuf:"varint,5,opt,name=taker_fee_ppm,json=takerFeePpm,proto3" json:"taker_fee_ppm,omitempty"`
+	// Total fee paid by the subaccount of the order in quote quantums. Negative
+	// for rebates.
+	TotalFeeQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,6,opt,name=total_fee_quote_quantums,json=totalFeeQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"total_fee_quote_quantums"`
+	// Remaining size of the order in base quantums that would rest on the
+	// orderbook.
+	RestingQuantums uint64 `protobuf:"varint,7,opt,name=resting_quantums,json=restingQuantums,proto3" json:"resting_quantums,omitempty"`
+	// Reason the order, or its remaining size, would be removed. Unspecified if
+	// the order would not be removed.
+	RemovalReason OrderRemoval_RemovalReason `protobuf:"varint,8,opt,name=removal_reason,json=removalReason,proto3,enum=dydxprotocol.clob.OrderRemoval_RemovalReason" json:"removal_reason,omitempty"`
+	// Total net collateral of the subaccount after the fills in quote quantums.
+	NetCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,9,opt,name=net_collateral,json=netCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_collateral"`
+	// Total initial margin requirement of the subaccount after the fills in
+	// quote quantums.
+	InitialMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,10,opt,name=initial_margin,json=initialMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"initial_margin"`
+	// Total maintenance margin requirement of the subaccount after the fills in
+	// quote quantums.
+	MaintenanceMargin github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,11,opt,name=maintenance_margin,json=maintenanceMargin,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"maintenance_margin"`
+	// Free collateral of the subaccount after the fills in quote quantums.
+	FreeCollateral github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,12,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"free_collateral"`
+}
+
+func (m *QuerySimulateOrderResponse) Reset()         { *m = QuerySimulateOrderResponse{} }
+func (m *QuerySimulateOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{21}
+}
+func (m *QuerySimulateOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulateOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
-	out := new(QueryClobPairAllResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateOrderResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
-	out := new(MevNodeToNodeCalculationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetFills() []SimulatedFill {
+	if m != nil {
+		return m.Fills
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
-	out := new(QueryEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetTotalFilledQuantums() uint64 {
+	if m != nil {
+		return m.TotalFilledQuantums
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
-	out := new(QueryBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetAverageFillSubticks() uint64 {
+	if m != nil {
+		return m.AverageFillSubticks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
-	out := new(QueryLiquidationsConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetTakerFeePpm() int32 {
+	if m != nil {
+		return m.TakerFeePpm
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error) {
-	out := new(QueryStatefulOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/StatefulOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetRestingQuantums() uint64 {
+	if m != nil {
+		return m.RestingQuantums
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.clob.Query/StreamOrderbookUpdates", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &queryStreamOrderbookUpdatesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *QuerySimulateOrderResponse) GetRemovalReason() OrderRemoval_RemovalReason {
+	if m != nil {
+		return m.RemovalReason
 	}
-	return x, nil
+	return OrderRemoval_REMOVAL_REASON_UNSPECIFIED
 }
 
-type Query_StreamOrderbookUpdatesClient interface {
-	Recv() (*StreamOrderbookUpdatesResponse, error)
-	grpc.ClientStream
+// QueryLiquidationsConfigurationRequest is a request message for
+// LiquidationsConfiguration.
+type QueryLiquidationsConfigurationRequest struct {
 }
 
-type queryStreamOrderbookUpdatesClient struct {
-	grpc.ClientStream
+func (m *QueryLiquidationsConfigurationRequest) Reset()         { *m = QueryLiquidationsConfigurationRequest{} }
+func (m *QueryLiquidationsConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationsConfigurationRequest) ProtoMessage()    {}
+func (*QueryLiquidationsConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{22}
 }
-
-func (x *queryStreamOrderbookUpdatesClient) Recv() (*StreamOrderbookUpdatesResponse, error) {
-	m := new(StreamOrderbookUpdatesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *QueryLiquidationsConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidationsConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationsConfigurationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a ClobPair by id.
-	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
-	// Queries the stateful order for a given order id.
-	StatefulOrder(context.Context, *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error)
-	// Streams orderbook updates. Updates contain orderbook data
-	// such as order placements, updates, and fills.
-	StreamOrderbookUpdates(*StreamOrderbookUpdatesRequest, Query_StreamOrderbookUpdatesServer) error
+func (m *QueryLiquidationsConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationsConfigurationRequest.Merge(m, src)
+}
+func (m *QueryLiquidationsConfigurationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidationsConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationsConfigurationRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryLiquidationsConfigurationRequest proto.InternalMessageInfo
+
+// QueryLiquidationsConfigurationResponse is a response message that contains
+// the LiquidationsConfiguration.
+type QueryLiquidationsConfigurationResponse struct {
+	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,1,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 }
 
-func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
+func (m *QueryLiquidationsConfigurationResponse) Reset() {
+	*m = QueryLiquidationsConfigurationResponse{}
 }
-func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+func (m *QueryLiquidationsConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidationsConfigurationResponse) ProtoMessage()    {}
+func (*QueryLiquidationsConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{23}
 }
-func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+func (m *QueryLiquidationsConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+func (m *QueryLiquidationsConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidationsConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
+func (m *QueryLiquidationsConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidationsConfigurationResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+func (m *QueryLiquidationsConfigurationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StatefulOrder(ctx context.Context, req *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatefulOrder not implemented")
+func (m *QueryLiquidationsConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidationsConfigurationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) StreamOrderbookUpdates(req *StreamOrderbookUpdatesRequest, srv Query_StreamOrderbookUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamOrderbookUpdates not implemented")
+
+var xxx_messageInfo_QueryLiquidationsConfigurationResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidationsConfigurationResponse) GetLiquidationsConfig() LiquidationsConfig {
+	if m != nil {
+		return m.LiquidationsConfig
+	}
+	return LiquidationsConfig{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// StreamOrderbookUpdatesRequest is a request message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesRequest struct {
+	// Clob pair ids to stream orderbook updates for.
+	ClobPairId []uint32 `protobuf:"varint,1,rep,packed,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Subaccount ids to stream subaccount updates for.
+	SubaccountIds []*types.SubaccountId `protobuf:"bytes,2,rep,name=subaccount_ids,json=subaccountIds,proto3" json:"subaccount_ids,omitempty"`
+	// Optional token to resume a previous stream. If the updates missed since the
+	// token's sequence number are still retained by the node, they are replayed
+	// on the resumed stream. Otherwise, a new stream is started from snapshots.
+	ResumeToken *StreamResumeToken `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+func (m *StreamOrderbookUpdatesRequest) Reset()         { *m = StreamOrderbookUpdatesRequest{} }
+func (m *StreamOrderbookUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesRequest) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{24}
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.Merge(m, src)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.DiscardUnknown(m)
 }
 
-func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPairAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+var xxx_messageInfo_StreamOrderbookUpdatesRequest proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdatesRequest) GetClobPairId() []uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MevNodeToNodeCalculationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+func (m *StreamOrderbookUpdatesRequest) GetSubaccountIds() []*types.SubaccountId {
+	if m != nil {
+		return m.SubaccountIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEquityTierLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
+func (m *StreamOrderbookUpdatesRequest) GetResumeToken() *StreamResumeToken {
+	if m != nil {
+		return m.ResumeToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRateLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// StreamResumeToken identifies the last update received by a client on a
+// stream.
+type StreamResumeToken struct {
+	// Id of the stream to resume.
+	StreamId uint32 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Sequence number of the last update received on the stream.
+	LastSequenceNumber uint64 `protobuf:"varint,2,opt,name=last_sequence_number,json=lastSequenceNumber,proto3" json:"last_sequence_number,omitempty"`
+	// Secret of the stream, returned in the responses of the stream. A stream
+	// can only be resumed with its secret.
+	ResumeSecret []byte `protobuf:"bytes,3,opt,name=resume_secret,json=resumeSecret,proto3" json:"resume_secret,omitempty"`
 }
 
-func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidationsConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+func (m *StreamResumeToken) Reset()         { *m = StreamResumeToken{} }
+func (m *StreamResumeToken) String() string { return proto.CompactTextString(m) }
+func (*StreamResumeToken) ProtoMessage()    {}
+func (*StreamResumeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{25}
+}
+func (m *StreamResumeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamResumeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamResumeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StreamResumeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamResumeToken.Merge(m, src)
+}
+func (m *StreamResumeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamResumeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamResumeToken.DiscardUnknown(m)
 }
 
-func _Query_StatefulOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatefulOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StatefulOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/StatefulOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StatefulOrder(ctx, req.(*QueryStatefulOrderRequest))
+var xxx_messageInfo_StreamResumeToken proto.InternalMessageInfo
+
+func (m *StreamResumeToken) GetStreamId() uint32 {
+	if m != nil {
+		return m.StreamId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_StreamOrderbookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamOrderbookUpdatesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *StreamResumeToken) GetLastSequenceNumber() uint64 {
+	if m != nil {
+		return m.LastSequenceNumber
 	}
-	return srv.(QueryServer).StreamOrderbookUpdates(m, &queryStreamOrderbookUpdatesServer{stream})
+	return 0
 }
 
-type Query_StreamOrderbookUpdatesServer interface {
-	Send(*StreamOrderbookUpdatesResponse) error
-	grpc.ServerStream
+func (m *StreamResumeToken) GetResumeSecret() []byte {
+	if m != nil {
+		return m.ResumeSecret
+	}
+	return nil
 }
 
-type queryStreamOrderbookUpdatesServer struct {
-	grpc.ServerStream
+// StreamOrderbookUpdatesResponse is a response message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesResponse struct {
+	// Orderbook updates for the clob pair.
+	Updates []StreamUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// ---Additional fields used to debug issues---
+	// Block height of the updates.
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Exec mode of the updates.
+	ExecMode uint32 `protobuf:"varint,3,opt,name=exec_mode,json=execMode,proto3" json:"exec_mode,omitempty"`
+	// Id of the stream. Used to resume the stream after a disconnect.
+	StreamId uint32 `protobuf:"varint,4,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Secret of the stream. Required to resume the stream after a disconnect.
+	ResumeSecret []byte `protobuf:"bytes,5,opt,name=resume_secret,json=resumeSecret,proto3" json:"resume_secret,omitempty"`
 }
 
-func (x *queryStreamOrderbookUpdatesServer) Send(m *StreamOrderbookUpdatesResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *StreamOrderbookUpdatesResponse) Reset()         { *m = StreamOrderbookUpdatesResponse{} }
+func (m *StreamOrderbookUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesResponse) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{26}
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.Merge(m, src)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClobPair",
-			Handler:    _Query_ClobPair_Handler,
-		},
-		{
-			MethodName: "ClobPairAll",
-			Handler:    _Query_ClobPairAll_Handler,
-		},
-		{
-			MethodName: "MevNodeToNodeCalculation",
-			Handler:    _Query_MevNodeToNodeCalculation_Handler,
-		},
-		{
-			MethodName: "EquityTierLimitConfiguration",
-			Handler:    _Query_EquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "BlockRateLimitConfiguration",
-			Handler:    _Query_BlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "LiquidationsConfiguration",
-			Handler:    _Query_LiquidationsConfiguration_Handler,
-		},
-		{
-			MethodName: "StatefulOrder",
-			Handler:    _Query_StatefulOrder_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamOrderbookUpdates",
-			Handler:       _Query_StreamOrderbookUpdates_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "dydxprotocol/clob/query.proto",
+var xxx_messageInfo_StreamOrderbookUpdatesResponse proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdatesResponse) GetUpdates() []StreamUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
 }
 
-func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookUpdatesResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdatesResponse) GetExecMode() uint32 {
+	if m != nil {
+		return m.ExecMode
+	}
+	return 0
 }
 
-func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *StreamOrderbookUpdatesResponse) GetStreamId() uint32 {
+	if m != nil {
+		return m.StreamId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookUpdatesResponse) GetResumeSecret() []byte {
+	if m != nil {
+		return m.ResumeSecret
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamUpdate is an update that will be pushed through the
+// GRPC stream.
+type StreamUpdate struct {
+	// Contains one of an StreamOrderbookUpdate,
+	// StreamOrderbookFill, StreamSubaccountUpdate.
+	//
+	// Types that are valid to be assigned to UpdateMessage:
+	//	*StreamUpdate_OrderbookUpdate
+	//	*StreamUpdate_OrderFill
+	//	*StreamUpdate_SubaccountUpdate
+	UpdateMessage isStreamUpdate_UpdateMessage `protobuf_oneof:"update_message"`
+	// Sequence number of the update within the stream. Sequence numbers start
+	// at 1 and increase by 1 for every update sent on the stream, so clients
+	// can detect missed updates.
+	SequenceNumber uint64 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
 }
 
-func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *StreamUpdate) Reset()         { *m = StreamUpdate{} }
+func (m *StreamUpdate) String() string { return proto.CompactTextString(m) }
+func (*StreamUpdate) ProtoMessage()    {}
+func (*StreamUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{27}
+}
+func (m *StreamUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *StreamUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamUpdate.Merge(m, src)
+}
+func (m *StreamUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamUpdate.DiscardUnknown(m)
 }
 
-func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamUpdate proto.InternalMessageInfo
+
+type isStreamUpdate_UpdateMessage interface {
+	isStreamUpdate_UpdateMessage()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamUpdate_OrderbookUpdate struct {
+	OrderbookUpdate *StreamOrderbookUpdate `protobuf:"bytes,1,opt,name=orderbook_update,json=orderbookUpdate,proto3,oneof" json:"orderbook_update,omitempty"`
+}
+type StreamUpdate_OrderFill struct {
+	OrderFill *StreamOrderbookFill `protobuf:"bytes,2,opt,name=order_fill,json=orderFill,proto3,oneof" json:"order_fill,omitempty"`
+}
+type StreamUpdate_SubaccountUpdate struct {
+	SubaccountUpdate *types.StreamSubaccountUpdate `protobuf:"bytes,3,opt,name=subaccount_update,json=subaccountUpdate,proto3,oneof" json:"subaccount_update,omitempty"`
+}
+
+func (*StreamUpdate_OrderbookUpdate) isStreamUpdate_UpdateMessage()  {}
+func (*StreamUpdate_OrderFill) isStreamUpdate_UpdateMessage()        {}
+func (*StreamUpdate_SubaccountUpdate) isStreamUpdate_UpdateMessage() {}
+
+func (m *StreamUpdate) GetUpdateMessage() isStreamUpdate_UpdateMessage {
+	if m != nil {
+		return m.UpdateMessage
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamUpdate) GetOrderbookUpdate() *StreamOrderbookUpdate {
+	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderbookUpdate); ok {
+		return x.OrderbookUpdate
+	}
+	return nil
 }
 
-func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *StreamUpdate) GetOrderFill() *StreamOrderbookFill {
+	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderFill); ok {
+		return x.OrderFill
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamUpdate) GetSubaccountUpdate() *types.StreamSubaccountUpdate {
+	if x, ok := m.GetUpdateMessage().(*StreamUpdate_SubaccountUpdate); ok {
+		return x.SubaccountUpdate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamUpdate) GetSequenceNumber() uint64 {
+	if m != nil {
+		return m.SequenceNumber
+	}
+	return 0
 }
 
-func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClobPair) > 0 {
-		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamUpdate_OrderbookUpdate)(nil),
+		(*StreamUpdate_OrderFill)(nil),
+		(*StreamUpdate_SubaccountUpdate)(nil),
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// StreamOrderbookUpdate provides information on an orderbook update. Used in
+// the full node GRPC stream.
+type StreamOrderbookUpdate struct {
+	// Orderbook updates for the clob pair. Can contain order place, removals,
+	// or updates.
+	Updates []types1.OffChainUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// Snapshot indicates if the response is from a snapshot of the orderbook.
+	// This is true for the initial response and false for all subsequent updates.
+	// Note that if the snapshot is true, then all previous entries should be
+	// discarded and the orderbook should be resynced.
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdate) Reset()         { *m = StreamOrderbookUpdate{} }
+func (m *StreamOrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdate) ProtoMessage()    {}
+func (*StreamOrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{28}
 }
-
-func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorMevMetrics != nil {
-		{
-			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockProposerMatches != nil {
-		{
-			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *StreamOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StreamOrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdate.Merge(m, src)
 }
-
-func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdate.DiscardUnknown(m)
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_StreamOrderbookUpdate proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdate) GetUpdates() []types1.OffChainUpdateV1 {
+	if m != nil {
+		return m.Updates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamOrderbookFill provides information on an orderbook fill. Used in
+// the full node GRPC stream.
+type StreamOrderbookFill struct {
+	// Clob match. Provides information on which orders were matched
+	// and the type of order. Fill amounts here are relative.
+	ClobMatch *ClobMatch `protobuf:"bytes,1,opt,name=clob_match,json=clobMatch,proto3" json:"clob_match,omitempty"`
+	// All orders involved in the specified clob match. Used to look up
+	// price of a match through a given maker order id.
+	Orders []Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	// Resulting fill amounts for each order in the orders array.
+	FillAmounts []uint64 `protobuf:"varint,3,rep,packed,name=fill_amounts,json=fillAmounts,proto3" json:"fill_amounts,omitempty"`
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Mev != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
-		i--
-		dAtA[i] = 0x15
-	}
-	if m.ClobPairId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *StreamOrderbookFill) Reset()         { *m = StreamOrderbookFill{} }
+func (m *StreamOrderbookFill) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookFill) ProtoMessage()    {}
+func (*StreamOrderbookFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{29}
 }
-
-func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookFill.Merge(m, src)
+}
+func (m *StreamOrderbookFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookFill.DiscardUnknown(m)
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_StreamOrderbookFill proto.InternalMessageInfo
+
+func (m *StreamOrderbookFill) GetClobMatch() *ClobMatch {
+	if m != nil {
+		return m.ClobMatch
+	}
+	return nil
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookFill) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *StreamOrderbookFill) GetFillAmounts() []uint64 {
+	if m != nil {
+		return m.FillAmounts
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
+	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
+	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
+	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*QueryStatefulOrderRequest)(nil), "dydxprotocol.clob.QueryStatefulOrderRequest")
+	proto.RegisterType((*QueryStatefulOrderResponse)(nil), "dydxprotocol.clob.QueryStatefulOrderResponse")
+	proto.RegisterType((*QueryOrderbookRequest)(nil), "dydxprotocol.clob.QueryOrderbookRequest")
+	proto.RegisterType((*OrderbookLevel)(nil), "dydxprotocol.clob.OrderbookLevel")
+	proto.RegisterType((*QueryOrderbookResponse)(nil), "dydxprotocol.clob.QueryOrderbookResponse")
+	proto.RegisterType((*QuerySubaccountRiskRequest)(nil), "dydxprotocol.clob.QuerySubaccountRiskRequest")
+	proto.RegisterType((*QuerySubaccountRiskResponse)(nil), "dydxprotocol.clob.QuerySubaccountRiskResponse")
+	proto.RegisterType((*SubaccountRisk)(nil), "dydxprotocol.clob.SubaccountRisk")
+	proto.RegisterType((*PerpetualPositionRisk)(nil), "dydxprotocol.clob.PerpetualPositionRisk")
+	proto.RegisterType((*QuerySimulateOrderRequest)(nil), "dydxprotocol.clob.QuerySimulateOrderRequest")
+	proto.RegisterType((*SimulatedFill)(nil), "dydxprotocol.clob.SimulatedFill")
+	proto.RegisterType((*QuerySimulateOrderResponse)(nil), "dydxprotocol.clob.QuerySimulateOrderResponse")
+	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
+	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
+	proto.RegisterType((*StreamOrderbookUpdatesRequest)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesRequest")
+	proto.RegisterType((*StreamResumeToken)(nil), "dydxprotocol.clob.StreamResumeToken")
+	proto.RegisterType((*StreamOrderbookUpdatesResponse)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesResponse")
+	proto.RegisterType((*StreamUpdate)(nil), "dydxprotocol.clob.StreamUpdate")
+	proto.RegisterType((*StreamOrderbookUpdate)(nil), "dydxprotocol.clob.StreamOrderbookUpdate")
+	proto.RegisterType((*StreamOrderbookFill)(nil), "dydxprotocol.clob.StreamOrderbookFill")
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
 
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_3365c195b25c5bc0 = []byte{
+	// 2581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdc, 0xc6,
+	0xf5, 0x17, 0xa5, 0x95, 0x2d, 0xbd, 0xd5, 0xae, 0xe4, 0x91, 0xed, 0x6c, 0xd6, 0xb6, 0x24, 0x33,
+	0xb1, 0x2c, 0x39, 0xf1, 0xae, 0x2d, 0xe7, 0x1b, 0xe4, 0x6b, 0x05, 0x69, 0x2d, 0xa3, 0xb2, 0xdd,
+	0x5a, 0x89, 0x44, 0x29, 0x69, 0xd0, 0x06, 0x20, 0x66, 0x97, 0xa3, 0x15, 0x21, 0x92, 0x43, 0x71,
+	0xc8, 0xb5, 0x5d, 0xc3, 0x28, 0x9a, 0x43, 0x7b, 0x68, 0x0b, 0x04, 0x28, 0x8a, 0x02, 0xed, 0xb1,
+	0xd7, 0xde, 0x72, 0x2c, 0x8a, 0xfe, 0x38, 0x05, 0x3d, 0x05, 0xe8, 0xa5, 0x2d, 0xd2, 0xa0, 0xb0,
+	0x7b, 0xee, 0xdf, 0x50, 0xcc, 0x0f, 0x72, 0xc9, 0x5d, 0x52, 0x2b, 0x1b, 0x6a, 0x73, 0x91, 0x76,
+	0xde, 0x7c, 0xde, 0xcf, 0x79, 0xf3, 0x66, 0xf8, 0x06, 0x2e, 0x58, 0x8f, 0xac, 0x87, 0x7e, 0x40,
+	0x43, 0xda, 0xa6, 0x4e, 0xb3, 0xed, 0xd0, 0x56, 0xf3, 0x20, 0x22, 0xc1, 0xa3, 0x86, 0xa0, 0xa1,
+	0x53, 0xe9, 0xe9, 0x06, 0x9f, 0xae, 0x9f, 0xee, 0xd0, 0x0e, 0x15, 0xa4, 0x26, 0xff, 0x25, 0x81,
+	0xf5, 0xf3, 0x1d, 0x4a, 0x3b, 0x0e, 0x69, 0x62, 0xdf, 0x6e, 0x62, 0xcf, 0xa3, 0x21, 0x0e, 0x6d,
+	0xea, 0x31, 0x35, 0x7b, 0xa5, 0x4d, 0x99, 0x4b, 0x59, 0xb3, 0x85, 0x19, 0x91, 0xf2, 0x9b, 0xdd,
+	0xeb, 0x2d, 0x12, 0xe2, 0xeb, 0x4d, 0x1f, 0x77, 0x6c, 0x4f, 0x80, 0x15, 0xb6, 0x39, 0x68, 0x51,
+	0xcb, 0xa1, 0xed, 0x7d, 0x33, 0xc0, 0x21, 0x31, 0x1d, 0xdb, 0xb5, 0x43, 0xb3, 0x4d, 0xbd, 0x5d,
+	0xbb, 0xa3, 0x18, 0x2e, 0x0e, 0x32, 0xf0, 0x3f, 0xa6, 0x8f, 0xed, 0x40, 0x41, 0xae, 0x0d, 0x42,
+	0xc8, 0x41, 0x64, 0x87, 0x8f, 0xcc, 0xd0, 0x26, 0x41, 0x9e, 0xd0, 0x9c, 0xb8, 0xd0, 0xc0, 0x22,
+	0xb1, 0xc0, 0xc5, 0x82, 0x69, 0x33, 0x20, 0x2e, 0xed, 0x62, 0x27, 0x76, 0x7c, 0x7e, 0x10, 0xe7,
+	0xe2, 0xb0, 0xbd, 0x47, 0x62, 0xc0, 0x6b, 0x83, 0x00, 0xc7, 0x3e, 0x88, 0x6c, 0x4b, 0xc6, 0x2f,
+	0x6b, 0xd4, 0xb9, 0x1c, 0x69, 0xa4, 0xab, 0x26, 0xdf, 0xc9, 0x4c, 0xda, 0x9e, 0x45, 0x1e, 0x92,
+	0xa0, 0x49, 0x77, 0x77, 0xcd, 0xf6, 0x1e, 0xb6, 0x3d, 0x33, 0xf2, 0x2d, 0x1c, 0x12, 0x36, 0x48,
+	0x51, 0xfc, 0x4b, 0x19, 0x7e, 0x16, 0xb5, 0x70, 0xbb, 0x4d, 0x23, 0x2f, 0x64, 0x4d, 0x16, 0x06,
+	0x04, 0xbb, 0xb6, 0x17, 0x9b, 0xb1, 0x5c, 0x8c, 0x4c, 0x7e, 0x4b, 0xa8, 0xbe, 0x0c, 0x2f, 0x6d,
+	0xf1, 0xe5, 0xbe, 0x43, 0xc2, 0xdb, 0x0e, 0x6d, 0x6d, 0x62, 0x3b, 0x30, 0xc8, 0x41, 0x44, 0x58,
+	0x88, 0xaa, 0x30, 0x6a, 0x5b, 0x35, 0x6d, 0x41, 0x5b, 0xaa, 0x18, 0xa3, 0xb6, 0xa5, 0x7f, 0x1b,
+	0xce, 0x08, 0x68, 0x0f, 0xc7, 0x7c, 0xea, 0x31, 0x82, 0xde, 0x81, 0xc9, 0x64, 0x3d, 0x05, 0xbe,
+	0xbc, 0x72, 0xae, 0x31, 0x90, 0x97, 0x8d, 0x98, 0x6f, 0xad, 0xf4, 0xd9, 0x97, 0xf3, 0x23, 0xc6,
+	0x44, 0x5b, 0x8d, 0x75, 0xac, 0x6c, 0xb8, 0xe5, 0x38, 0xfd, 0x36, 0xac, 0x03, 0xf4, 0xf2, 0x4f,
+	0xc9, 0x5e, 0x6c, 0xc8, 0x64, 0x6d, 0xf0, 0x64, 0x6d, 0xc8, 0xcd, 0xa0, 0x92, 0xb5, 0xb1, 0x89,
+	0x3b, 0x44, 0xf1, 0x1a, 0x29, 0x4e, 0xfd, 0xd7, 0x1a, 0xd4, 0x32, 0xc6, 0xdf, 0x72, 0x9c, 0x22,
+	0xfb, 0xc7, 0x9e, 0xd3, 0x7e, 0x74, 0x27, 0x63, 0xe4, 0xa8, 0x30, 0xf2, 0xf2, 0x50, 0x23, 0xa5,
+	0xf2, 0x8c, 0x95, 0x5f, 0x68, 0x30, 0xbf, 0x41, 0xba, 0xef, 0x52, 0x8b, 0xec, 0x50, 0xfe, 0xf7,
+	0x36, 0x76, 0xda, 0x91, 0x23, 0x26, 0xe3, 0x88, 0x7c, 0x04, 0x67, 0xe5, 0x6e, 0xf3, 0x03, 0xea,
+	0x53, 0x46, 0x02, 0x53, 0xe5, 0x6b, 0x12, 0x9d, 0x41, 0xcb, 0x3f, 0xc0, 0x0e, 0xcf, 0x57, 0x1a,
+	0x6c, 0x90, 0xee, 0x86, 0x44, 0x1b, 0xa7, 0x85, 0x94, 0x4d, 0x25, 0x44, 0x51, 0xd1, 0x77, 0xe1,
+	0x4c, 0x37, 0x06, 0x9b, 0x2e, 0xe9, 0x9a, 0x2e, 0x09, 0x03, 0xbb, 0xcd, 0x12, 0xaf, 0x06, 0x85,
+	0x67, 0x0c, 0xde, 0x90, 0x70, 0x63, 0xb6, 0x9b, 0x56, 0x29, 0x89, 0xfa, 0xbf, 0x35, 0x58, 0x28,
+	0x76, 0x4f, 0x2d, 0x46, 0x07, 0x4e, 0x06, 0x84, 0x45, 0x4e, 0xc8, 0xd4, 0x52, 0xdc, 0x19, 0xa6,
+	0x33, 0x47, 0x0a, 0x07, 0xdc, 0xf2, 0xac, 0x0f, 0xa8, 0x13, 0xb9, 0x64, 0x93, 0x04, 0x7c, 0xe9,
+	0xd4, 0xb2, 0xc5, 0xd2, 0xeb, 0x18, 0x66, 0x73, 0x50, 0x68, 0x01, 0xa6, 0x92, 0x64, 0x30, 0x93,
+	0xfc, 0x87, 0x78, 0xb1, 0xef, 0x59, 0x68, 0x06, 0xc6, 0x5c, 0xd2, 0x15, 0x11, 0x19, 0x35, 0xf8,
+	0x4f, 0x74, 0x16, 0x4e, 0x74, 0x85, 0x90, 0xda, 0xd8, 0x82, 0xb6, 0x54, 0x32, 0xd4, 0x48, 0xbf,
+	0x02, 0x4b, 0x22, 0xe9, 0xbe, 0x21, 0x4a, 0xd9, 0x8e, 0x4d, 0x82, 0xfb, 0xbc, 0x90, 0xdd, 0x16,
+	0x25, 0x23, 0x0a, 0xd2, 0xeb, 0xaa, 0xff, 0x4a, 0x83, 0xe5, 0x23, 0x80, 0x55, 0x94, 0x3c, 0xa8,
+	0x15, 0xd5, 0x47, 0x95, 0x07, 0xcd, 0x9c, 0xb0, 0x1d, 0x26, 0x5a, 0x85, 0xe7, 0x0c, 0xc9, 0xc3,
+	0xe8, 0xcb, 0x70, 0x59, 0x18, 0xb7, 0xc6, 0x93, 0xc6, 0xc0, 0x21, 0x29, 0x76, 0xe4, 0x17, 0x1a,
+	0x2c, 0x0d, 0xc7, 0x2a, 0x3f, 0xf6, 0xe1, 0xa5, 0x82, 0xb3, 0x43, 0xb9, 0xd1, 0xc8, 0x71, 0xe3,
+	0x10, 0xc1, 0xca, 0x8b, 0xd3, 0xad, 0x1c, 0x88, 0xfe, 0x21, 0xbc, 0x2c, 0x0c, 0xdb, 0x0e, 0x71,
+	0x48, 0x76, 0x23, 0xe7, 0x3d, 0x7e, 0x20, 0xc4, 0xfb, 0x6a, 0x15, 0x26, 0xe4, 0x01, 0xa1, 0xd6,
+	0xbc, 0xbc, 0x52, 0xcf, 0x51, 0x2d, 0x58, 0xee, 0x59, 0x71, 0x2e, 0x51, 0x39, 0xd4, 0xff, 0x34,
+	0x0a, 0xf5, 0x3c, 0xd1, 0xca, 0xcb, 0x0f, 0x61, 0x5a, 0xca, 0xf6, 0x1d, 0xdc, 0x26, 0x2e, 0xf1,
+	0x42, 0xa5, 0x62, 0x39, 0x47, 0xc5, 0x7d, 0xea, 0x75, 0x76, 0x48, 0xe0, 0x0a, 0x11, 0x9b, 0x31,
+	0x83, 0xd2, 0x58, 0xa5, 0x19, 0x2a, 0x9a, 0x87, 0xf2, 0xae, 0xed, 0x38, 0x26, 0x76, 0x79, 0x4d,
+	0x17, 0x39, 0x59, 0x32, 0x80, 0x93, 0x6e, 0x09, 0x0a, 0x3a, 0x0f, 0x93, 0x61, 0x60, 0x77, 0x3a,
+	0x24, 0x20, 0x96, 0xc8, 0xce, 0x09, 0xa3, 0x47, 0x40, 0xef, 0x40, 0x59, 0x1a, 0xd6, 0x09, 0x68,
+	0xe4, 0xd7, 0x4a, 0xc2, 0xa8, 0x0b, 0x45, 0x7e, 0xdf, 0xe1, 0x20, 0x03, 0x68, 0xf2, 0x1b, 0x7d,
+	0x0b, 0x66, 0xc2, 0x07, 0xd8, 0x37, 0xa5, 0x10, 0xc6, 0x9d, 0xaf, 0x8d, 0x0b, 0x21, 0x17, 0x73,
+	0x84, 0xec, 0x3c, 0xc0, 0xbe, 0x10, 0x24, 0xa2, 0x64, 0x54, 0xc3, 0xcc, 0x58, 0xef, 0xaa, 0xf3,
+	0x45, 0x90, 0x5a, 0x94, 0xee, 0xc7, 0x4b, 0x33, 0x7c, 0x4b, 0x9e, 0x86, 0x71, 0x8b, 0xf8, 0xe1,
+	0x9e, 0x08, 0x40, 0xc5, 0x90, 0x03, 0x74, 0x09, 0xaa, 0xc2, 0x2f, 0x93, 0x45, 0xad, 0xd0, 0x6e,
+	0xef, 0x33, 0xb5, 0x3d, 0x2b, 0x82, 0xba, 0xad, 0x88, 0x7a, 0x07, 0xaa, 0x89, 0xca, 0xfb, 0xa4,
+	0x4b, 0x1c, 0x54, 0x87, 0x89, 0x84, 0x45, 0x13, 0x2c, 0xc9, 0x98, 0xcf, 0x1d, 0x44, 0xd8, 0x0b,
+	0x23, 0x97, 0xa9, 0x70, 0x27, 0x63, 0x74, 0x01, 0xc0, 0x8b, 0x5c, 0x19, 0x0d, 0xa9, 0xac, 0x62,
+	0x4c, 0x7a, 0x91, 0x5c, 0x4a, 0xa6, 0xff, 0x63, 0x14, 0xce, 0xf6, 0x7b, 0xa8, 0x32, 0x64, 0xb8,
+	0x8b, 0x17, 0x61, 0x4a, 0xee, 0x94, 0x3d, 0x62, 0x77, 0xf6, 0x42, 0xe5, 0x69, 0x59, 0xd0, 0xee,
+	0x0a, 0x12, 0x5a, 0x85, 0x52, 0xcb, 0xb6, 0xb8, 0xe2, 0xb1, 0x82, 0x15, 0xc8, 0xfa, 0xa9, 0x72,
+	0x4a, 0x30, 0x71, 0x66, 0xcc, 0xf6, 0x59, 0xad, 0xf4, 0x9c, 0xcc, 0x9c, 0x09, 0x5d, 0x81, 0x53,
+	0x2d, 0xc2, 0x42, 0xb3, 0x65, 0x5b, 0xbd, 0x60, 0x8f, 0x8b, 0xe8, 0x4c, 0xf3, 0x89, 0x35, 0xdb,
+	0x8a, 0xc3, 0x9d, 0x60, 0x31, 0xdb, 0xef, 0x61, 0x4f, 0xf4, 0xb0, 0xb7, 0xd8, 0x7e, 0x82, 0x7d,
+	0x1d, 0x90, 0x6b, 0x5b, 0xa6, 0x1f, 0xd8, 0x6d, 0xd2, 0x03, 0x9f, 0x14, 0xe0, 0x19, 0xd7, 0xb6,
+	0x36, 0xf9, 0x44, 0xb2, 0x90, 0xdf, 0x8c, 0x37, 0x61, 0x72, 0xc9, 0x31, 0x6c, 0x96, 0x64, 0xd1,
+	0x69, 0x18, 0xa7, 0x0f, 0x3c, 0x22, 0x6f, 0x28, 0x93, 0x86, 0x1c, 0xf0, 0xd2, 0xed, 0x45, 0x6e,
+	0x8b, 0x04, 0x2a, 0xa0, 0x6a, 0xa4, 0x53, 0x38, 0x97, 0x2b, 0x4b, 0xad, 0xd7, 0x26, 0x4c, 0xf7,
+	0xae, 0x52, 0x66, 0x60, 0xb3, 0x7d, 0xb5, 0xa3, 0xf3, 0x02, 0x97, 0x95, 0x11, 0xef, 0x64, 0x96,
+	0xa1, 0xea, 0xbf, 0x1d, 0x87, 0x6a, 0x16, 0x88, 0xb6, 0xa0, 0x92, 0x52, 0x62, 0x5b, 0xf9, 0x27,
+	0x7c, 0x0f, 0xc2, 0x52, 0x9a, 0x92, 0x1a, 0x35, 0xc5, 0x52, 0x34, 0x44, 0xa1, 0xea, 0x11, 0x5e,
+	0x62, 0x1d, 0x07, 0x87, 0x24, 0xc0, 0x8e, 0x70, 0x7b, 0x6a, 0xed, 0x2e, 0xc7, 0xfe, 0xfd, 0xcb,
+	0xf9, 0xaf, 0x77, 0xec, 0x70, 0x2f, 0x6a, 0x35, 0xda, 0xd4, 0xcd, 0x5e, 0xf3, 0xbb, 0x6f, 0x5c,
+	0x15, 0x77, 0xd2, 0x66, 0x42, 0xb1, 0xc2, 0x47, 0x3e, 0x61, 0x8d, 0x6d, 0x12, 0xd8, 0xd8, 0xb1,
+	0xbf, 0x87, 0x5b, 0x0e, 0xb9, 0xe7, 0x85, 0x46, 0xc5, 0x23, 0xe1, 0xed, 0x44, 0x3c, 0x57, 0x68,
+	0x7b, 0x76, 0x68, 0x63, 0xc7, 0x74, 0x71, 0xd0, 0xb1, 0xbd, 0xda, 0xd8, 0x71, 0x2b, 0x54, 0xf2,
+	0x37, 0x84, 0x78, 0xf4, 0x00, 0x90, 0x8b, 0x6d, 0x2f, 0x24, 0x1e, 0xf6, 0xda, 0x24, 0x56, 0x5a,
+	0x3a, 0x66, 0xa5, 0xa7, 0x52, 0x3a, 0x94, 0xe2, 0x03, 0x98, 0xde, 0x0d, 0x08, 0x49, 0xc7, 0x76,
+	0xfc, 0x98, 0xb5, 0x56, 0xb9, 0x82, 0x54, 0x70, 0x2f, 0xc3, 0xb4, 0xcd, 0xcc, 0xf8, 0x73, 0x84,
+	0xa3, 0xc4, 0x46, 0x9a, 0x30, 0xaa, 0x36, 0xbb, 0x9f, 0xa2, 0x22, 0x13, 0x66, 0x7d, 0x12, 0xf8,
+	0x24, 0x8c, 0xb0, 0x63, 0xfa, 0x94, 0xd9, 0xe2, 0xdb, 0xa5, 0x76, 0x52, 0xec, 0xf5, 0xa5, 0x9c,
+	0x94, 0xdd, 0x8c, 0xd1, 0x9b, 0x0a, 0x9c, 0xca, 0x5c, 0xe4, 0xf7, 0x4f, 0x32, 0xfd, 0xd3, 0x31,
+	0x38, 0x93, 0xcb, 0xc3, 0xeb, 0x56, 0x4f, 0x75, 0x52, 0xd9, 0xca, 0x09, 0xed, 0x9e, 0x35, 0x50,
+	0xfc, 0x46, 0x07, 0x8a, 0x9f, 0x95, 0x2a, 0xba, 0xc7, 0x9d, 0x3f, 0xbd, 0xf2, 0xbd, 0x02, 0x67,
+	0x68, 0x80, 0xdb, 0x0e, 0xe9, 0x2f, 0x38, 0x25, 0x51, 0x70, 0x66, 0xe5, 0x64, 0xa6, 0xe6, 0xa0,
+	0x9b, 0xf0, 0x72, 0x0b, 0x7b, 0xfb, 0x41, 0xe4, 0x87, 0xed, 0x47, 0xfd, 0x7c, 0xb2, 0x02, 0xbe,
+	0xd4, 0x03, 0x64, 0x79, 0xdf, 0x83, 0x57, 0x09, 0x0b, 0x6d, 0x17, 0x87, 0xc4, 0x32, 0x53, 0x1f,
+	0x95, 0xfd, 0x62, 0x64, 0x71, 0xbc, 0x98, 0x60, 0xef, 0xf7, 0xa0, 0x59, 0x81, 0x0d, 0x98, 0xe5,
+	0xa5, 0x28, 0xbf, 0x5e, 0x9e, 0xe2, 0x53, 0xd9, 0x82, 0xb9, 0x15, 0x5f, 0x88, 0x6c, 0x97, 0x5f,
+	0xa0, 0x49, 0xe6, 0x42, 0xf4, 0x06, 0x8c, 0x8b, 0x83, 0x4c, 0x55, 0x9d, 0x5a, 0xd1, 0x89, 0xa0,
+	0xb2, 0x42, 0x82, 0xf5, 0x4f, 0x46, 0xa1, 0x12, 0x8b, 0xb3, 0xd6, 0x6d, 0xc7, 0x41, 0xeb, 0x50,
+	0x75, 0xf1, 0x3e, 0x09, 0xcc, 0xe7, 0xbe, 0x5e, 0x4d, 0x09, 0x3e, 0x45, 0xcb, 0x1c, 0xca, 0xa3,
+	0x7d, 0x87, 0xf2, 0x2b, 0x50, 0x11, 0xd7, 0xa0, 0x4c, 0x92, 0x94, 0x8c, 0x29, 0x4e, 0xdc, 0x8a,
+	0x97, 0xb7, 0x0b, 0x68, 0x97, 0x10, 0xf3, 0x20, 0xa2, 0x21, 0xe9, 0x21, 0x8f, 0xbb, 0x32, 0xcc,
+	0xec, 0x12, 0xb2, 0xc5, 0x55, 0xc4, 0x7a, 0xf5, 0x2f, 0x4e, 0xc6, 0xe7, 0x52, 0x36, 0xcc, 0xea,
+	0x28, 0xe9, 0x3f, 0xd8, 0xb5, 0xc1, 0x83, 0xfd, 0x6d, 0x18, 0xe7, 0x9e, 0x70, 0xbf, 0xf9, 0x86,
+	0x5d, 0xc8, 0x3b, 0x63, 0xd2, 0x31, 0x8f, 0x97, 0x44, 0x30, 0xf1, 0xb4, 0x0e, 0x69, 0x88, 0x1d,
+	0x93, 0x0f, 0x89, 0xd5, 0x1f, 0xa4, 0x59, 0x31, 0xb9, 0x2e, 0xe6, 0xb6, 0x52, 0x5b, 0x01, 0x77,
+	0x49, 0x80, 0x3b, 0x44, 0x70, 0x0d, 0x6c, 0x05, 0x35, 0xc9, 0xb9, 0x92, 0xec, 0xd3, 0xa1, 0x12,
+	0x8a, 0x85, 0xe6, 0x51, 0xf6, 0x7d, 0x57, 0xa4, 0xff, 0xb8, 0x51, 0x16, 0xc4, 0x75, 0x42, 0x36,
+	0x7d, 0x17, 0xfd, 0x40, 0x83, 0x9a, 0x32, 0x66, 0x70, 0x29, 0x4e, 0x1c, 0xf3, 0x52, 0x48, 0xb7,
+	0xd7, 0xfb, 0xd6, 0x03, 0x2d, 0xc3, 0x4c, 0xc0, 0xf7, 0x92, 0xd7, 0xe9, 0xa9, 0x96, 0x5b, 0x64,
+	0x5a, 0xd1, 0x13, 0xe8, 0x0e, 0x54, 0x55, 0xbf, 0xc8, 0x0c, 0x08, 0x66, 0xd4, 0xab, 0x4d, 0x2c,
+	0x68, 0x4b, 0xd5, 0x95, 0xab, 0x45, 0xb9, 0x6b, 0x48, 0x74, 0x43, 0xfd, 0x37, 0x04, 0x93, 0x51,
+	0x09, 0xd2, 0xc3, 0x9c, 0x43, 0x78, 0xf2, 0x7f, 0x7d, 0x08, 0xc3, 0x57, 0x71, 0x08, 0x97, 0xbf,
+	0x92, 0x43, 0x78, 0xea, 0xbf, 0x7b, 0x08, 0xeb, 0x97, 0xe1, 0x92, 0xd8, 0xdd, 0xa9, 0xaa, 0xcc,
+	0x72, 0x3f, 0x8c, 0x7f, 0xa8, 0xc1, 0xe2, 0x30, 0xa4, 0xaa, 0x09, 0x1f, 0xc1, 0x6c, 0x4e, 0x93,
+	0x51, 0x15, 0xce, 0x4b, 0x79, 0x1f, 0x8d, 0x03, 0x22, 0xe3, 0xc3, 0xda, 0x19, 0x98, 0xd1, 0xff,
+	0xa6, 0xc1, 0x85, 0x6d, 0xd1, 0x32, 0x4c, 0xae, 0xf4, 0xef, 0xcb, 0x4e, 0x63, 0xf1, 0x17, 0xd7,
+	0x58, 0xdf, 0x89, 0xbc, 0x01, 0xd5, 0xcc, 0xdd, 0x34, 0xae, 0x4d, 0x47, 0xbc, 0x9c, 0x1a, 0x95,
+	0xf4, 0xb5, 0x94, 0xa1, 0x3b, 0x30, 0x15, 0x10, 0x16, 0xb9, 0xc4, 0x0c, 0xe9, 0x3e, 0x91, 0x97,
+	0xc4, 0xf2, 0xca, 0xab, 0x79, 0x85, 0x4e, 0x18, 0x6e, 0x08, 0xf0, 0x0e, 0xc7, 0x1a, 0xe5, 0xa0,
+	0x37, 0xd0, 0x7f, 0xa4, 0xc1, 0xa9, 0x01, 0x08, 0x3a, 0x07, 0x93, 0xb2, 0x47, 0xda, 0xbb, 0x81,
+	0x4c, 0x48, 0xc2, 0x3d, 0x0b, 0x5d, 0x83, 0xd3, 0x0e, 0x66, 0xa1, 0xc9, 0xb8, 0xf3, 0x3c, 0x5d,
+	0x53, 0x1f, 0x04, 0x25, 0x03, 0xf1, 0xb9, 0x6d, 0x35, 0xf5, 0xae, 0x98, 0xe1, 0xc7, 0x8d, 0xb2,
+	0x96, 0x91, 0x76, 0x40, 0x42, 0x79, 0x27, 0x31, 0x94, 0x0b, 0xdb, 0x82, 0xa6, 0x3f, 0xd3, 0x60,
+	0xae, 0x28, 0xca, 0x6a, 0x99, 0xbf, 0x06, 0x27, 0x55, 0x8b, 0x57, 0xf5, 0xba, 0xe6, 0x0b, 0x1d,
+	0x96, 0xac, 0x71, 0xdf, 0x41, 0x71, 0x1d, 0xe5, 0xa3, 0xf0, 0x1c, 0x4c, 0x92, 0x87, 0xa4, 0x6d,
+	0xba, 0xd4, 0x22, 0xea, 0x93, 0x74, 0x82, 0x13, 0x36, 0xa8, 0x45, 0xb2, 0x71, 0x29, 0xf5, 0xc5,
+	0x65, 0xc0, 0xcb, 0xf1, 0x1c, 0x2f, 0xff, 0x3c, 0x0a, 0x53, 0x69, 0x0b, 0xd1, 0xfb, 0x30, 0x43,
+	0x63, 0x7f, 0x55, 0x03, 0x5b, 0xe5, 0xed, 0x52, 0xa1, 0x73, 0x7d, 0x01, 0xba, 0x3b, 0x62, 0x4c,
+	0xd3, 0x2c, 0x89, 0xf7, 0x58, 0x05, 0x49, 0x1c, 0x47, 0xaa, 0x1b, 0xb9, 0x38, 0x5c, 0x20, 0x3f,
+	0xa0, 0xee, 0x8e, 0x18, 0x93, 0x82, 0x97, 0x0f, 0x90, 0x09, 0xa7, 0x52, 0x89, 0xab, 0x0c, 0x94,
+	0xe9, 0x76, 0xed, 0x90, 0xdc, 0x15, 0x62, 0x7b, 0x19, 0x9c, 0x18, 0x3a, 0xc3, 0xfa, 0x68, 0xfc,
+	0x52, 0xde, 0x9f, 0x49, 0xf2, 0xd0, 0xac, 0xb2, 0x4c, 0x16, 0xad, 0xcd, 0x40, 0x55, 0xaa, 0x37,
+	0x5d, 0xc2, 0x18, 0xee, 0x10, 0xfd, 0xa7, 0x1a, 0x9c, 0xc9, 0x8d, 0x08, 0xfa, 0xb0, 0x3f, 0x53,
+	0xde, 0xca, 0xda, 0xaa, 0x5e, 0x13, 0x1a, 0x83, 0x6f, 0x07, 0xef, 0xed, 0xee, 0xde, 0xe6, 0x04,
+	0x29, 0xe8, 0x83, 0xeb, 0xfd, 0x29, 0xc4, 0xaf, 0x55, 0x1e, 0xf6, 0xd9, 0x1e, 0x95, 0xe9, 0x33,
+	0x61, 0x24, 0x63, 0xfd, 0x53, 0x0d, 0x66, 0x73, 0x02, 0x8a, 0x56, 0x41, 0x94, 0x02, 0xd9, 0x79,
+	0x56, 0xab, 0x7b, 0xbe, 0xa0, 0x63, 0x2e, 0x3a, 0xcb, 0xc6, 0x64, 0x3b, 0xfe, 0x89, 0xde, 0x84,
+	0x13, 0xaa, 0x41, 0x22, 0x2b, 0xc6, 0xb0, 0x8b, 0xa5, 0x42, 0xa3, 0xcb, 0x30, 0x95, 0x6a, 0x75,
+	0xc9, 0x2e, 0x47, 0x49, 0x61, 0xca, 0xbd, 0x8e, 0x17, 0x5b, 0xf9, 0xb8, 0x0a, 0xe3, 0xa2, 0xce,
+	0xa2, 0x1f, 0x6b, 0x30, 0x11, 0x77, 0xed, 0xd1, 0x95, 0x1c, 0x3d, 0x05, 0x4f, 0x1f, 0xf5, 0xa5,
+	0x22, 0x6c, 0xff, 0xdb, 0x87, 0xbe, 0xfc, 0xf1, 0x5f, 0xfe, 0xf5, 0xb3, 0xd1, 0x57, 0xd0, 0xc5,
+	0xe6, 0x21, 0x8f, 0x5c, 0xcd, 0xc7, 0xb6, 0xf5, 0x04, 0xfd, 0x44, 0x83, 0x72, 0xea, 0xf9, 0xa1,
+	0xd8, 0xa0, 0xc1, 0x77, 0x90, 0xfa, 0x6b, 0xc3, 0x0c, 0x4a, 0xbd, 0x67, 0xe8, 0xaf, 0x0a, 0x9b,
+	0xe6, 0xd0, 0xf9, 0xc3, 0x6c, 0x42, 0xbf, 0xd7, 0xa0, 0x56, 0xd4, 0x47, 0x47, 0x2b, 0xcf, 0xd5,
+	0x74, 0x97, 0x36, 0xde, 0x78, 0x81, 0x46, 0xbd, 0x7e, 0x53, 0xd8, 0xfa, 0xc6, 0x4d, 0xed, 0x8a,
+	0xde, 0x6c, 0xe6, 0xbe, 0x9e, 0x99, 0x1e, 0xb5, 0xf8, 0xb1, 0x20, 0xff, 0xb7, 0x53, 0x46, 0xfe,
+	0x51, 0x83, 0xf3, 0x87, 0xb5, 0xb4, 0xd1, 0x6a, 0x51, 0xd4, 0x8e, 0xd0, 0x90, 0xaf, 0xbf, 0xfd,
+	0x62, 0xcc, 0xca, 0xaf, 0x45, 0xe1, 0xd7, 0x02, 0x9a, 0x6b, 0x1e, 0xfa, 0xb2, 0x89, 0x7e, 0xa7,
+	0xc1, 0xb9, 0x43, 0xfa, 0xd9, 0xe8, 0x66, 0x91, 0x15, 0xc3, 0x3b, 0xf1, 0xf5, 0xd5, 0x17, 0xe2,
+	0x55, 0x0e, 0x5c, 0x12, 0x0e, 0xcc, 0xa3, 0x0b, 0x87, 0x3e, 0xf7, 0xa2, 0x3f, 0x68, 0xf0, 0x72,
+	0xe1, 0x7d, 0x06, 0xbd, 0x55, 0x64, 0xc1, 0xb0, 0xcb, 0x52, 0xfd, 0xff, 0x5f, 0x80, 0x53, 0x59,
+	0xde, 0x10, 0x96, 0x2f, 0xa1, 0xc5, 0xe6, 0x91, 0x9e, 0x6e, 0x91, 0x07, 0x95, 0x4c, 0xdb, 0x1e,
+	0xbd, 0x5e, 0xa4, 0x3b, 0xef, 0xe1, 0xa0, 0x7e, 0xf5, 0x88, 0x68, 0x65, 0xdd, 0x08, 0xfa, 0xb9,
+	0x06, 0x93, 0x49, 0x3d, 0x45, 0x85, 0xa5, 0xa6, 0xbf, 0x0d, 0x5e, 0x5f, 0x3e, 0x02, 0x52, 0x29,
+	0xb9, 0x21, 0x42, 0x70, 0x15, 0xbd, 0xd6, 0x2c, 0x78, 0x06, 0xe7, 0xe8, 0xe6, 0xe3, 0xf4, 0x1d,
+	0xef, 0x09, 0xfa, 0x8d, 0x36, 0xd0, 0x81, 0x2c, 0xf6, 0x2d, 0xaf, 0xc5, 0x5a, 0x6f, 0x1c, 0x15,
+	0xae, 0xcc, 0x5c, 0x15, 0x66, 0xfe, 0x1f, 0xba, 0x91, 0x63, 0x66, 0x5f, 0x7b, 0xb5, 0xf9, 0x58,
+	0xf4, 0x6b, 0x9f, 0x34, 0x1f, 0xcb, 0x33, 0xf5, 0x09, 0xfa, 0xa5, 0xd6, 0xeb, 0x34, 0x0c, 0x5b,
+	0xb7, 0x9c, 0xfe, 0x46, 0xfd, 0xea, 0x11, 0xd1, 0xca, 0xd6, 0xd7, 0x85, 0xad, 0x8b, 0xbc, 0x50,
+	0xe5, 0xd5, 0x7a, 0xa6, 0x98, 0x64, 0x97, 0x03, 0x7d, 0x1f, 0xce, 0xe6, 0xdf, 0xfd, 0xd0, 0xb5,
+	0xa3, 0xde, 0x82, 0xe2, 0xcb, 0x78, 0xfd, 0xfa, 0x73, 0x70, 0x48, 0x63, 0xaf, 0x69, 0x6b, 0x9b,
+	0xdf, 0x79, 0xf3, 0xe8, 0x5f, 0x3a, 0x0f, 0xa5, 0x0f, 0xe2, 0x7b, 0xe7, 0xb3, 0xa7, 0x73, 0xda,
+	0xe7, 0x4f, 0xe7, 0xb4, 0x7f, 0x3e, 0x9d, 0xd3, 0x3e, 0x79, 0x36, 0x37, 0xf2, 0xf9, 0xb3, 0xb9,
+	0x91, 0xbf, 0x3e, 0x9b, 0x1b, 0x69, 0x9d, 0x10, 0xf0, 0x1b, 0xff, 0x19, 0x00, 0xa0, 0xa4, 0xbb,
+	0xc0, 0x91, 0x22, 0x00, 0x00,
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a ClobPair by id.
+	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries the stateful order for a given order id.
+	StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error)
+	// Queries the price levels of the orderbook of a clob pair, aggregated by
+	// price, from the local memclob of the node.
+	Orderbook(ctx context.Context, in *QueryOrderbookRequest, opts ...grpc.CallOption) (*QueryOrderbookResponse, error)
+	// Queries the collateral, margin requirements and liquidation risk of a
+	// subaccount.
+	SubaccountRisk(ctx context.Context, in *QuerySubaccountRiskRequest, opts ...grpc.CallOption) (*QuerySubaccountRiskResponse, error)
+	// Simulates placing an order against the orderbook of the node without
+	// placing it, returning the expected fills, fees and resulting collateral.
+	SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error)
+	// Streams orderbook updates. Updates contain orderbook data
+	// such as order placements, updates, and fills, as well as position
+	// updates of the requested subaccounts.
+	StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error)
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryStatefulOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
+	out := new(QueryClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryStatefulOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryStatefulOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
+	out := new(QueryClobPairAllResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStatefulOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
+	out := new(MevNodeToNodeCalculationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStatefulOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
+	out := new(QueryEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStatefulOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Triggered {
-		i--
-		if m.Triggered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FillAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.OrderPlacement.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
+	out := new(QueryBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
+	out := new(QueryLiquidationsConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error) {
+	out := new(QueryStatefulOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/StatefulOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) Orderbook(ctx context.Context, in *QueryOrderbookRequest, opts ...grpc.CallOption) (*QueryOrderbookResponse, error) {
+	out := new(QueryOrderbookResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/Orderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SubaccountRisk(ctx context.Context, in *QuerySubaccountRiskRequest, opts ...grpc.CallOption) (*QuerySubaccountRiskResponse, error) {
+	out := new(QuerySubaccountRiskResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/SubaccountRisk", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error) {
+	out := new(QuerySimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/SimulateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *StreamOrderbookUpdatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.clob.Query/StreamOrderbookUpdates", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StreamOrderbookUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StreamOrderbookUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClobPairId) > 0 {
-		dAtA12 := make([]byte, len(m.ClobPairId)*10)
-		var j11 int
-		for _, num := range m.ClobPairId {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintQuery(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0xa
+	x := &queryStreamOrderbookUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StreamOrderbookUpdatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Query_StreamOrderbookUpdatesClient interface {
+	Recv() (*StreamOrderbookUpdatesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *StreamOrderbookUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecMode != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExecMode))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryStreamOrderbookUpdatesClient struct {
+	grpc.ClientStream
 }
 
-func (m *StreamUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *queryStreamOrderbookUpdatesClient) Recv() (*StreamOrderbookUpdatesResponse, error) {
+	m := new(StreamOrderbookUpdatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *StreamUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a ClobPair by id.
+	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries the stateful order for a given order id.
+	StatefulOrder(context.Context, *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error)
+	// Queries the price levels of the orderbook of a clob pair, aggregated by
+	// price, from the local memclob of the node.
+	Orderbook(context.Context, *QueryOrderbookRequest) (*QueryOrderbookResponse, error)
+	// Queries the collateral, margin requirements and liquidation risk of a
+	// subaccount.
+	SubaccountRisk(context.Context, *QuerySubaccountRiskRequest) (*QuerySubaccountRiskResponse, error)
+	// Simulates placing an order against the orderbook of the node without
+	// placing it, returning the expected fills, fees and resulting collateral.
+	SimulateOrder(context.Context, *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error)
+	// Streams orderbook updates. Updates contain orderbook data
+	// such as order placements, updates, and fills, as well as position
+	// updates of the requested subaccounts.
+	StreamOrderbookUpdates(*StreamOrderbookUpdatesRequest, Query_StreamOrderbookUpdatesServer) error
 }
 
-func (m *StreamUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateMessage != nil {
-		{
-			size := m.UpdateMessage.Size()
-			i -= size
-			if _, err := m.UpdateMessage.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *StreamUpdate_OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
 }
-
-func (m *StreamUpdate_OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderbookUpdate != nil {
-		{
-			size, err := m.OrderbookUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
 }
-func (m *StreamUpdate_OrderFill) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
 }
-
-func (m *StreamUpdate_OrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderFill != nil {
-		{
-			size, err := m.OrderFill.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
 }
-func (m *StreamOrderbookUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
 }
-
-func (m *StreamOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) StatefulOrder(ctx context.Context, req *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatefulOrder not implemented")
+}
+func (*UnimplementedQueryServer) Orderbook(ctx context.Context, req *QueryOrderbookRequest) (*QueryOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Orderbook not implemented")
+}
+func (*UnimplementedQueryServer) SubaccountRisk(ctx context.Context, req *QuerySubaccountRiskRequest) (*QuerySubaccountRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountRisk not implemented")
+}
+func (*UnimplementedQueryServer) SimulateOrder(ctx context.Context, req *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedQueryServer) StreamOrderbookUpdates(req *StreamOrderbookUpdatesRequest, srv Query_StreamOrderbookUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderbookUpdates not implemented")
 }
 
-func (m *StreamOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Snapshot {
-		i--
-		if m.Snapshot {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *StreamOrderbookFill) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClobPairRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StreamOrderbookFill) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamOrderbookFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FillAmounts) > 0 {
-		dAtA16 := make([]byte, len(m.FillAmounts)*10)
-		var j15 int
-		for _, num := range m.FillAmounts {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintQuery(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1a
+func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairAll(ctx, in)
 	}
-	if m.ClobMatch != nil {
-		{
-			size, err := m.ClobMatch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MevNodeToNodeCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryGetClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquityTierLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRateLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ClobPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationsConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClobPairAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StatefulOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatefulOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ClobPair) > 0 {
-		for _, e := range m.ClobPair {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).StatefulOrder(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/StatefulOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatefulOrder(ctx, req.(*QueryStatefulOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Orderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockProposerMatches != nil {
-		l = m.BlockProposerMatches.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Orderbook(ctx, in)
 	}
-	if m.ValidatorMevMetrics != nil {
-		l = m.ValidatorMevMetrics.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/Orderbook",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Orderbook(ctx, req.(*QueryOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SubaccountRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountRisk(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/SubaccountRisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountRisk(ctx, req.(*QuerySubaccountRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClobPairId != 0 {
-		n += 1 + sovQuery(uint64(m.ClobPairId))
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOrder(ctx, in)
 	}
-	if m.Mev != 0 {
-		n += 5
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/SimulateOrder",
 	}
-	if m.Volume != 0 {
-		n += 1 + sovQuery(uint64(m.Volume))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOrder(ctx, req.(*QuerySimulateOrderRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StreamOrderbookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderbookUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	return n
+	return srv.(QueryServer).StreamOrderbookUpdates(m, &queryStreamOrderbookUpdatesServer{stream})
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type Query_StreamOrderbookUpdatesServer interface {
+	Send(*StreamOrderbookUpdatesResponse) error
+	grpc.ServerStream
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type queryStreamOrderbookUpdatesServer struct {
+	grpc.ServerStream
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (x *queryStreamOrderbookUpdatesServer) Send(m *StreamOrderbookUpdatesResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *QueryStatefulOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClobPair",
+			Handler:    _Query_ClobPair_Handler,
+		},
+		{
+			MethodName: "ClobPairAll",
+			Handler:    _Query_ClobPairAll_Handler,
+		},
+		{
+			MethodName: "MevNodeToNodeCalculation",
+			Handler:    _Query_MevNodeToNodeCalculation_Handler,
+		},
+		{
+			MethodName: "EquityTierLimitConfiguration",
+			Handler:    _Query_EquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "BlockRateLimitConfiguration",
+			Handler:    _Query_BlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "LiquidationsConfiguration",
+			Handler:    _Query_LiquidationsConfiguration_Handler,
+		},
+		{
+			MethodName: "StatefulOrder",
+			Handler:    _Query_StatefulOrder_Handler,
+		},
+		{
+			MethodName: "Orderbook",
+			Handler:    _Query_Orderbook_Handler,
+		},
+		{
+			MethodName: "SubaccountRisk",
+			Handler:    _Query_SubaccountRisk_Handler,
+		},
+		{
+			MethodName: "SimulateOrder",
+			Handler:    _Query_SimulateOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderbookUpdates",
+			Handler:       _Query_StreamOrderbookUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dydxprotocol/clob/query.proto",
 }
 
-func (m *QueryStatefulOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OrderPlacement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.FillAmount != 0 {
-		n += 1 + sovQuery(uint64(m.FillAmount))
-	}
-	if m.Triggered {
-		n += 2
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamOrderbookUpdatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClobPairId) > 0 {
-		l = 0
-		for _, e := range m.ClobPairId {
-			l += sovQuery(uint64(e))
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
-	}
-	if m.ExecMode != 0 {
-		n += 1 + sovQuery(uint64(m.ExecMode))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateMessage != nil {
-		n += m.UpdateMessage.Size()
+func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StreamUpdate_OrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderbookUpdate != nil {
-		l = m.OrderbookUpdate.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *StreamUpdate_OrderFill) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderFill != nil {
-		l = m.OrderFill.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-func (m *StreamOrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClobPair) > 0 {
+		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ValidatorMevMetrics != nil {
+		{
+			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockProposerMatches != nil {
+		{
+			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mev != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatefulOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatefulOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatefulOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatefulOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatefulOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatefulOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapOrderState != nil {
+		{
+			size, err := m.TwapOrderState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OrderGroup != nil {
+		{
+			size, err := m.OrderGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Triggered {
+		i--
+		if m.Triggered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FillAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderPlacement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupSubticks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderbookLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderbookLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderbookLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumOrders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOrders))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Quantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Quantums))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Subticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Subticks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderbookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderbookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderbookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MidPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MidPriceSubticks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BestAskSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestAskSubticks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BestBidSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestBidSubticks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SubaccountRisk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubaccountRisk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubaccountRisk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubaccountRisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerpetualPositions) > 0 {
+		for iNdEx := len(m.PerpetualPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerpetualPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.IsLiquidatable {
+		i--
+		if m.IsLiquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialMargin.Size()
+		i -= size
+		if _, err := m.InitialMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualPositionRisk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualPositionRisk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualPositionRisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RiskPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RiskPriceSubticks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EstimatedLiquidationPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedLiquidationPriceSubticks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BankruptcyPriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BankruptcyPriceSubticks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OraclePriceSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OraclePriceSubticks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeQuoteQuantums.Size()
+		i -= size
+		if _, err := m.FeeQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.FillQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillQuantums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Subticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Subticks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MakerOrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaintenanceMargin.Size()
+		i -= size
+		if _, err := m.MaintenanceMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InitialMargin.Size()
+		i -= size
+		if _, err := m.InitialMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.NetCollateral.Size()
+		i -= size
+		if _, err := m.NetCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.RemovalReason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemovalReason))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RestingQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RestingQuantums))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TotalFeeQuoteQuantums.Size()
+		i -= size
+		if _, err := m.TotalFeeQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TakerFeePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFeePpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AverageFillSubticks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageFillSubticks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalFilledQuantums != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalFilledQuantums))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeToken != nil {
+		{
+			size, err := m.ResumeToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubaccountIds) > 0 {
+		for iNdEx := len(m.SubaccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubaccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClobPairId) > 0 {
+		dAtA19 := make([]byte, len(m.ClobPairId)*10)
+		var j18 int
+		for _, num := range m.ClobPairId {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintQuery(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamResumeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamResumeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamResumeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResumeSecret) > 0 {
+		i -= len(m.ResumeSecret)
+		copy(dAtA[i:], m.ResumeSecret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ResumeSecret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastSequenceNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSequenceNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResumeSecret) > 0 {
+		i -= len(m.ResumeSecret)
+		copy(dAtA[i:], m.ResumeSecret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ResumeSecret)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExecMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SequenceNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SequenceNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdateMessage != nil {
+		{
+			size := m.UpdateMessage.Size()
+			i -= size
+			if _, err := m.UpdateMessage.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamUpdate_OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate_OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderbookUpdate != nil {
+		{
+			size, err := m.OrderbookUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamUpdate_OrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate_OrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderFill != nil {
+		{
+			size, err := m.OrderFill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamUpdate_SubaccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate_SubaccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SubaccountUpdate != nil {
+		{
+			size, err := m.SubaccountUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FillAmounts) > 0 {
+		dAtA24 := make([]byte, len(m.FillAmounts)*10)
+		var j23 int
+		for _, num := range m.FillAmounts {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintQuery(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobMatch != nil {
+		{
+			size, err := m.ClobMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClobPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClobPairAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for _, e := range m.ClobPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockProposerMatches != nil {
+		l = m.BlockProposerMatches.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidatorMevMetrics != nil {
+		l = m.ValidatorMevMetrics.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Mev != 0 {
+		n += 5
+	}
+	if m.Volume != 0 {
+		n += 1 + sovQuery(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatefulOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatefulOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderPlacement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FillAmount != 0 {
+		n += 1 + sovQuery(uint64(m.FillAmount))
+	}
+	if m.Triggered {
+		n += 2
+	}
+	if m.OrderGroup != nil {
+		l = m.OrderGroup.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TwapOrderState != nil {
+		l = m.TwapOrderState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderbookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if m.GroupSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.GroupSubticks))
+	}
+	return n
+}
+
+func (m *OrderbookLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subticks != 0 {
+		n += 1 + sovQuery(uint64(m.Subticks))
+	}
+	if m.Quantums != 0 {
+		n += 1 + sovQuery(uint64(m.Quantums))
+	}
+	if m.NumOrders != 0 {
+		n += 1 + sovQuery(uint64(m.NumOrders))
+	}
+	return n
+}
+
+func (m *QueryOrderbookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BestBidSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.BestBidSubticks))
+	}
+	if m.BestAskSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.BestAskSubticks))
+	}
+	if m.MidPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.MidPriceSubticks))
+	}
+	return n
+}
+
+func (m *QuerySubaccountRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QuerySubaccountRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountRisk.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SubaccountRisk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsLiquidatable {
+		n += 2
+	}
+	if len(m.PerpetualPositions) > 0 {
+		for _, e := range m.PerpetualPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PerpetualPositionRisk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OraclePriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.OraclePriceSubticks))
+	}
+	if m.BankruptcyPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.BankruptcyPriceSubticks))
+	}
+	if m.EstimatedLiquidationPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedLiquidationPriceSubticks))
+	}
+	if m.RiskPriceSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.RiskPriceSubticks))
+	}
+	return n
+}
+
+func (m *QuerySimulateOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SimulatedFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerOrderId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Subticks != 0 {
+		n += 1 + sovQuery(uint64(m.Subticks))
+	}
+	if m.FillQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.FillQuantums))
+	}
+	l = m.FeeQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalFilledQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.TotalFilledQuantums))
+	}
+	if m.AverageFillSubticks != 0 {
+		n += 1 + sovQuery(uint64(m.AverageFillSubticks))
+	}
+	if m.TakerFeePpm != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFeePpm))
+	}
+	l = m.TotalFeeQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RestingQuantums != 0 {
+		n += 1 + sovQuery(uint64(m.RestingQuantums))
+	}
+	if m.RemovalReason != 0 {
+		n += 1 + sovQuery(uint64(m.RemovalReason))
+	}
+	l = m.NetCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InitialMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaintenanceMargin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StreamOrderbookUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPairId) > 0 {
+		l = 0
+		for _, e := range m.ClobPairId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.SubaccountIds) > 0 {
+		for _, e := range m.SubaccountIds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ResumeToken != nil {
+		l = m.ResumeToken.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamResumeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	if m.LastSequenceNumber != 0 {
+		n += 1 + sovQuery(uint64(m.LastSequenceNumber))
+	}
+	l = len(m.ResumeSecret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamOrderbookUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.ExecMode != 0 {
+		n += 1 + sovQuery(uint64(m.ExecMode))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	l = len(m.ResumeSecret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateMessage != nil {
+		n += m.UpdateMessage.Size()
+	}
+	if m.SequenceNumber != 0 {
+		n += 1 + sovQuery(uint64(m.SequenceNumber))
+	}
+	return n
+}
+
+func (m *StreamUpdate_OrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderbookUpdate != nil {
+		l = m.OrderbookUpdate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *StreamUpdate_OrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderFill != nil {
+		l = m.OrderFill.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *StreamUpdate_SubaccountUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubaccountUpdate != nil {
+		l = m.SubaccountUpdate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *StreamOrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	return n
+}
+
+func (m *StreamOrderbookFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobMatch != nil {
+		l = m.ClobMatch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FillAmounts) > 0 {
+		l = 0
+		for _, e := range m.FillAmounts {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClobPair = append(m.ClobPair, ClobPair{})
+			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockProposerMatches == nil {
+				m.BlockProposerMatches = &ValidatorMevMatches{}
+			}
+			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorMevMetrics == nil {
+				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
+			}
+			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mev = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatefulOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatefulOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatefulOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatefulOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPlacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+			}
+			m.FillAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Triggered = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderGroup == nil {
+				m.OrderGroup = &OrderGroup{}
+			}
+			if err := m.OrderGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapOrderState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TwapOrderState == nil {
+				m.TwapOrderState = &TwapOrderState{}
+			}
+			if err := m.TwapOrderState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderbookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderbookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderbookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSubticks", wireType)
+			}
+			m.GroupSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderbookLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderbookLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderbookLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			m.Quantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOrders", wireType)
+			}
+			m.NumOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderbookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderbookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderbookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, OrderbookLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, OrderbookLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBidSubticks", wireType)
+			}
+			m.BestBidSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestBidSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAskSubticks", wireType)
+			}
+			m.BestAskSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestAskSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MidPriceSubticks", wireType)
+			}
+			m.MidPriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MidPriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Snapshot {
-		n += 2
-	}
-	return n
-}
 
-func (m *StreamOrderbookFill) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClobMatch != nil {
-		l = m.ClobMatch.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.FillAmounts) > 0 {
-		l = 0
-		for _, e := range m.FillAmounts {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2734,17 +6466,49 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.Id = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2754,7 +6518,7 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.Number |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2780,7 +6544,7 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubaccountRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,15 +6567,15 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubaccountRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubaccountRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountRisk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2838,7 +6602,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountRisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2863,7 +6627,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *SubaccountRisk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2886,15 +6650,15 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubaccountRisk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubaccountRisk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2921,68 +6685,114 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2992,29 +6802,48 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClobPair = append(m.ClobPair, ClobPair{})
-			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidatable = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3041,10 +6870,8 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerpetualPositions = append(m.PerpetualPositions, PerpetualPositionRisk{})
+			if err := m.PerpetualPositions[len(m.PerpetualPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3069,7 +6896,7 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+func (m *PerpetualPositionRisk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3092,17 +6919,55 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualPositionRisk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualPositionRisk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3112,33 +6977,30 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockProposerMatches == nil {
-				m.BlockProposerMatches = &ValidatorMevMatches{}
-			}
-			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceSubticks", wireType)
 			}
-			var msglen int
+			m.OraclePriceSubticks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3148,28 +7010,68 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OraclePriceSubticks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptcyPriceSubticks", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.BankruptcyPriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BankruptcyPriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedLiquidationPriceSubticks", wireType)
 			}
-			if m.ValidatorMevMetrics == nil {
-				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
+			m.EstimatedLiquidationPriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedLiquidationPriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskPriceSubticks", wireType)
+			}
+			m.RiskPriceSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RiskPriceSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3191,7 +7093,7 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3214,15 +7116,15 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3249,8 +7151,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3275,7 +7176,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+func (m *SimulatedFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3298,17 +7199,17 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderId", wireType)
 			}
-			m.ClobPairId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3318,27 +7219,49 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Mev = float32(math.Float32frombits(v))
+			if err := m.MakerOrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subticks", wireType)
+			}
+			m.Subticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Subticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FillQuantums", wireType)
 			}
-			m.Volume = 0
+			m.FillQuantums = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3348,61 +7271,44 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				m.FillQuantums |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeQuoteQuantums", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FeeQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3424,7 +7330,7 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3447,15 +7353,34 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3482,115 +7407,243 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fills = append(m.Fills, SimulatedFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFilledQuantums", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.TotalFilledQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFilledQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageFillSubticks", wireType)
+			}
+			m.AverageFillSubticks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageFillSubticks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeePpm", wireType)
+			}
+			m.TakerFeePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeeQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TotalFeeQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestingQuantums", wireType)
+			}
+			m.RestingQuantums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestingQuantums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalReason", wireType)
+			}
+			m.RemovalReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalReason |= OrderRemoval_RemovalReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetCollateral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NetCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMargin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaintenanceMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3600,22 +7653,22 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3640,7 +7693,7 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3663,45 +7716,12 @@ func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatefulOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatefulOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3723,7 +7743,7 @@ func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3746,15 +7766,15 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatefulOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatefulOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3781,49 +7801,10 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderPlacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
-			}
-			m.FillAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FillAmount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Triggered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3845,7 +7826,7 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamOrderbookUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,65 +7849,125 @@ func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClobPairId = append(m.ClobPairId, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClobPairId) == 0 {
+					m.ClobPairId = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClobPairId = append(m.ClobPairId, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SubaccountIds = append(m.SubaccountIds, &types.SubaccountId{})
+			if err := m.SubaccountIds[len(m.SubaccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3953,7 +7994,10 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResumeToken == nil {
+				m.ResumeToken = &StreamResumeToken{}
+			}
+			if err := m.ResumeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3978,7 +8022,7 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamOrderbookUpdatesRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamResumeToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4001,88 +8045,84 @@ func (m *StreamOrderbookUpdatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamResumeToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamOrderbookUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamResumeToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.ClobPairId = append(m.ClobPairId, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequenceNumber", wireType)
+			}
+			m.LastSequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ClobPairId) == 0 {
-					m.ClobPairId = make([]uint32, 0, elementCount)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ClobPairId = append(m.ClobPairId, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ResumeSecret = append(m.ResumeSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeSecret == nil {
+				m.ResumeSecret = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4205,6 +8245,59 @@ func (m *StreamOrderbookUpdatesResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeSecret = append(m.ResumeSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResumeSecret == nil {
+				m.ResumeSecret = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4325,6 +8418,60 @@ func (m *StreamUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdateMessage = &StreamUpdate_OrderFill{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.StreamSubaccountUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.UpdateMessage = &StreamUpdate_SubaccountUpdate{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4404,7 +8551,7 @@ func (m *StreamOrderbookUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types.OffChainUpdateV1{})
+			m.Updates = append(m.Updates, types1.OffChainUpdateV1{})
 			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -291,6 +291,91 @@ func (m *MsgPlaceOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceOrderResponse proto.InternalMessageInfo
 
+// MsgReplaceOrder is a request type used for atomically replacing an existing
+// stateful order. The order id of the new order must match the order id of the
+// order being replaced, and any fill amount of the existing order carries over
+// to the new order.
+type MsgReplaceOrder struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{6}
+}
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
+}
+func (m *MsgReplaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
+
+func (m *MsgReplaceOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+// MsgReplaceOrderResponse is a response type used for replacing orders.
+type MsgReplaceOrderResponse struct {
+}
+
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{7}
+}
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
+}
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
+
 // MsgCancelOrder is a request type used for canceling orders.
 type MsgCancelOrder struct {
 	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
@@ -306,7 +391,7 @@ func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
 func (m *MsgCancelOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrder) ProtoMessage()    {}
 func (*MsgCancelOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{6}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
 }
 func (m *MsgCancelOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +480,7 @@ func (m *MsgCancelOrderResponse) Reset()         { *m = MsgCancelOrderResponse{}
 func (m *MsgCancelOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelOrderResponse) ProtoMessage()    {}
 func (*MsgCancelOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{7}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
 }
 func (m *MsgCancelOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +525,7 @@ func (m *MsgBatchCancel) Reset()         { *m = MsgBatchCancel{} }
 func (m *MsgBatchCancel) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchCancel) ProtoMessage()    {}
 func (*MsgBatchCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{8}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
 }
 func (m *MsgBatchCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +591,7 @@ func (m *OrderBatch) Reset()         { *m = OrderBatch{} }
 func (m *OrderBatch) String() string { return proto.CompactTextString(m) }
 func (*OrderBatch) ProtoMessage()    {}
 func (*OrderBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{9}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
 }
 func (m *OrderBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +647,7 @@ func (m *MsgBatchCancelResponse) Reset()         { *m = MsgBatchCancelResponse{}
 func (m *MsgBatchCancelResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchCancelResponse) ProtoMessage()    {}
 func (*MsgBatchCancelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{10}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
 }
 func (m *MsgBatchCancelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,26 +690,28 @@ func (m *MsgBatchCancelResponse) GetShortTermFailed() []*OrderBatch {
 	return nil
 }
 
-// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
-type MsgUpdateClobPair struct {
-	// Authority is the address that may send this message.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// `clob_pair` is the ClobPair to write to state.
-	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
+// MsgBatchPlaceOrder is a request type used for placing a batch of short term
+// orders. This msg is not atomic. Placements are performed in the order the
+// orders are specified, even if some placements are invalid or fail.
+type MsgBatchPlaceOrder struct {
+	// The subaccount all orders in this batch are placed for.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The batch of short term orders that will be placed.
+	ShortTermOrders []Order `protobuf:"bytes,2,rep,name=short_term_orders,json=shortTermOrders,proto3" json:"short_term_orders"`
 }
 
-func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
-func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPair) ProtoMessage()    {}
-func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
+func (m *MsgBatchPlaceOrder) Reset()         { *m = MsgBatchPlaceOrder{} }
+func (m *MsgBatchPlaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrder) ProtoMessage()    {}
+func (*MsgBatchPlaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
 }
-func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchPlaceOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchPlaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchPlaceOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -634,49 +721,54 @@ func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
+func (m *MsgBatchPlaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrder.Merge(m, src)
 }
-func (m *MsgUpdateClobPair) XXX_Size() int {
+func (m *MsgBatchPlaceOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
+func (m *MsgBatchPlaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchPlaceOrder proto.InternalMessageInfo
 
-func (m *MsgUpdateClobPair) GetAuthority() string {
+func (m *MsgBatchPlaceOrder) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
+func (m *MsgBatchPlaceOrder) GetShortTermOrders() []Order {
 	if m != nil {
-		return m.ClobPair
+		return m.ShortTermOrders
 	}
-	return ClobPair{}
+	return nil
 }
 
-// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
-// status.
-type MsgUpdateClobPairResponse struct {
+// MsgBatchPlaceOrderResponse is a response type used for placing a batch of
+// short term orders. It indicates which order placements have succeeded or
+// failed.
+type MsgBatchPlaceOrderResponse struct {
+	// The ids of the short term orders that were placed successfully.
+	ShortTermSucceeded []OrderId `protobuf:"bytes,1,rep,name=short_term_succeeded,json=shortTermSucceeded,proto3" json:"short_term_succeeded"`
+	// The short term order placements that have failed.
+	ShortTermFailed []OrderPlacementFailure `protobuf:"bytes,2,rep,name=short_term_failed,json=shortTermFailed,proto3" json:"short_term_failed"`
 }
 
-func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
-func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
-func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
+func (m *MsgBatchPlaceOrderResponse) Reset()         { *m = MsgBatchPlaceOrderResponse{} }
+func (m *MsgBatchPlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchPlaceOrderResponse) ProtoMessage()    {}
+func (*MsgBatchPlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchPlaceOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchPlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchPlaceOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -686,44 +778,52 @@ func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
+func (m *MsgBatchPlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchPlaceOrderResponse.Merge(m, src)
 }
-func (m *MsgUpdateClobPairResponse) XXX_Size() int {
+func (m *MsgBatchPlaceOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
+func (m *MsgBatchPlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchPlaceOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchPlaceOrderResponse proto.InternalMessageInfo
 
-// OperationRaw represents an operation in the proposed operations.
-// Note that the `order_placement` operation is a signed message.
-type OperationRaw struct {
-	// operationRaw represents an operation that occurred, which can be a match,
-	// a signed order placement, or an order removal.
-	//
-	// Types that are valid to be assigned to Operation:
-	//
-	//	*OperationRaw_Match
-	//	*OperationRaw_ShortTermOrderPlacement
-	//	*OperationRaw_OrderRemoval
-	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
+func (m *MsgBatchPlaceOrderResponse) GetShortTermSucceeded() []OrderId {
+	if m != nil {
+		return m.ShortTermSucceeded
+	}
+	return nil
 }
 
-func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
-func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
-func (*OperationRaw) ProtoMessage()    {}
-func (*OperationRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
+func (m *MsgBatchPlaceOrderResponse) GetShortTermFailed() []OrderPlacementFailure {
+	if m != nil {
+		return m.ShortTermFailed
+	}
+	return nil
 }
-func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+
+// OrderPlacementFailure represents a failed placement of an order in a batch.
+type OrderPlacementFailure struct {
+	// The id of the order that failed to be placed.
+	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	// The reason the order placement failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *OrderPlacementFailure) Reset()         { *m = OrderPlacementFailure{} }
+func (m *OrderPlacementFailure) String() string { return proto.CompactTextString(m) }
+func (*OrderPlacementFailure) ProtoMessage()    {}
+func (*OrderPlacementFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
+}
+func (m *OrderPlacementFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OrderPlacementFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OrderPlacementFailure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -733,96 +833,56 @@ func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OperationRaw) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperationRaw.Merge(m, src)
+func (m *OrderPlacementFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPlacementFailure.Merge(m, src)
 }
-func (m *OperationRaw) XXX_Size() int {
+func (m *OrderPlacementFailure) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperationRaw) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
-
-type isOperationRaw_Operation interface {
-	isOperationRaw_Operation()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type OperationRaw_Match struct {
-	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
-}
-type OperationRaw_ShortTermOrderPlacement struct {
-	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
-}
-type OperationRaw_OrderRemoval struct {
-	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
+func (m *OrderPlacementFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPlacementFailure.DiscardUnknown(m)
 }
 
-func (*OperationRaw_Match) isOperationRaw_Operation()                   {}
-func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation() {}
-func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()            {}
+var xxx_messageInfo_OrderPlacementFailure proto.InternalMessageInfo
 
-func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+func (m *OrderPlacementFailure) GetOrderId() OrderId {
 	if m != nil {
-		return m.Operation
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetMatch() *ClobMatch {
-	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
-		return x.Match
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
-	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
-		return x.ShortTermOrderPlacement
-	}
-	return nil
-}
-
-func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
-	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
-		return x.OrderRemoval
+		return m.OrderId
 	}
-	return nil
+	return OrderId{}
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*OperationRaw) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*OperationRaw_Match)(nil),
-		(*OperationRaw_ShortTermOrderPlacement)(nil),
-		(*OperationRaw_OrderRemoval)(nil),
+func (m *OrderPlacementFailure) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
 }
 
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// request type.
-type MsgUpdateEquityTierLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the equity tier limit configuration to update to. All fields must
-	// be set.
-	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+// MsgCancelAllOrders is a request type used for canceling all open orders of a
+// subaccount. Short-Term orders are removed from the orderbook when the msg is
+// received, and Long-Term and conditional orders are removed from state when
+// the msg is included in a block.
+type MsgCancelAllOrders struct {
+	// The subaccount whose orders will be cancelled.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The clob pairs whose orders will be cancelled. If empty, the orders of
+	// all clob pairs will be cancelled.
+	ClobPairIds []uint32 `protobuf:"varint,2,rep,packed,name=clob_pair_ids,json=clobPairIds,proto3" json:"clob_pair_ids,omitempty"`
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
-func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
+func (m *MsgCancelAllOrders) Reset()         { *m = MsgCancelAllOrders{} }
+func (m *MsgCancelAllOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrders) ProtoMessage()    {}
+func (*MsgCancelAllOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrders.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -832,53 +892,49 @@ func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+func (m *MsgCancelAllOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrders.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+func (m *MsgCancelAllOrders) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
+func (m *MsgCancelAllOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrders proto.InternalMessageInfo
 
-func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
+func (m *MsgCancelAllOrders) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
+func (m *MsgCancelAllOrders) GetClobPairIds() []uint32 {
 	if m != nil {
-		return m.EquityTierLimitConfig
+		return m.ClobPairIds
 	}
-	return EquityTierLimitConfiguration{}
+	return nil
 }
 
-// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
-// response type.
-type MsgUpdateEquityTierLimitConfigurationResponse struct {
+// MsgCancelAllOrdersResponse is a response type used for canceling all open
+// orders of a subaccount.
+type MsgCancelAllOrdersResponse struct {
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
-}
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
+func (m *MsgCancelAllOrdersResponse) Reset()         { *m = MsgCancelAllOrdersResponse{} }
+func (m *MsgCancelAllOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllOrdersResponse) ProtoMessage()    {}
+func (*MsgCancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAllOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAllOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAllOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -888,39 +944,43 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, de
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
+func (m *MsgCancelAllOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.Merge(m, src)
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+func (m *MsgCancelAllOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
+func (m *MsgCancelAllOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAllOrdersResponse proto.InternalMessageInfo
 
-// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
-// request type.
-type MsgUpdateBlockRateLimitConfiguration struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the block rate limit configuration to update to. All fields must be
-	// set.
-	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
+// MsgHeartbeat is a request type used for arming, refreshing or disarming the
+// dead man's switch of a subaccount. If no heartbeat is received within
+// `timeout_seconds` of the latest heartbeat, all Long-Term and conditional
+// orders of the subaccount are canceled.
+type MsgHeartbeat struct {
+	// The subaccount whose dead man's switch is updated.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The number of seconds from the block time of this heartbeat after which
+	// the orders of the subaccount are canceled. A value of zero disarms the
+	// dead man's switch.
+	TimeoutSeconds uint32 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
-func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
-func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
+func (m *MsgHeartbeat) Reset()         { *m = MsgHeartbeat{} }
+func (m *MsgHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeat) ProtoMessage()    {}
+func (*MsgHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *MsgHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgHeartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -930,53 +990,49 @@ func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
+func (m *MsgHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeat.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+func (m *MsgHeartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
+func (m *MsgHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_MsgHeartbeat proto.InternalMessageInfo
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+func (m *MsgHeartbeat) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+func (m *MsgHeartbeat) GetTimeoutSeconds() uint32 {
 	if m != nil {
-		return m.BlockRateLimitConfig
+		return m.TimeoutSeconds
 	}
-	return BlockRateLimitConfiguration{}
+	return 0
 }
 
-// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
-// liquidations config.
-type MsgUpdateBlockRateLimitConfigurationResponse struct {
+// MsgHeartbeatResponse is a response type used for arming, refreshing or
+// disarming the dead man's switch of a subaccount.
+type MsgHeartbeatResponse struct {
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
-	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
-}
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
-func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
+func (m *MsgHeartbeatResponse) Reset()         { *m = MsgHeartbeatResponse{} }
+func (m *MsgHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeatResponse) ProtoMessage()    {}
+func (*MsgHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgHeartbeatResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -986,40 +1042,43 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, det
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
+func (m *MsgHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeatResponse.Merge(m, src)
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+func (m *MsgHeartbeatResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
+func (m *MsgHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeatResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgHeartbeatResponse proto.InternalMessageInfo
 
-// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
-// config.
-type MsgUpdateLiquidationsConfig struct {
-	// Authority is the address that may send this message.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the liquidations configuration to update to. All fields must
-	// be set.
-	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
+// MsgSetMarketMakerProtectionConfig is a request type used for setting or
+// clearing the market-maker protection settings of a subaccount on a clob
+// pair. Setting the settings resets the maker fills accumulated so far.
+type MsgSetMarketMakerProtectionConfig struct {
+	// The subaccount whose market-maker protection settings are updated.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The clob pair the settings apply to.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The market-maker protection settings. An empty config disables
+	// market-maker protection of the subaccount on the clob pair.
+	Config MarketMakerProtectionConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
-func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
-func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
+func (m *MsgSetMarketMakerProtectionConfig) Reset()         { *m = MsgSetMarketMakerProtectionConfig{} }
+func (m *MsgSetMarketMakerProtectionConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMarketMakerProtectionConfig) ProtoMessage()    {}
+func (*MsgSetMarketMakerProtectionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetMarketMakerProtectionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMarketMakerProtectionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMarketMakerProtectionConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1029,48 +1088,61 @@ func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
+func (m *MsgSetMarketMakerProtectionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketMakerProtectionConfig.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+func (m *MsgSetMarketMakerProtectionConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
+func (m *MsgSetMarketMakerProtectionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketMakerProtectionConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMarketMakerProtectionConfig proto.InternalMessageInfo
 
-func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
+func (m *MsgSetMarketMakerProtectionConfig) GetSubaccountId() types.SubaccountId {
 	if m != nil {
-		return m.Authority
+		return m.SubaccountId
 	}
-	return ""
+	return types.SubaccountId{}
 }
 
-func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+func (m *MsgSetMarketMakerProtectionConfig) GetClobPairId() uint32 {
 	if m != nil {
-		return m.LiquidationsConfig
+		return m.ClobPairId
 	}
-	return LiquidationsConfig{}
+	return 0
 }
 
-// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
-type MsgUpdateLiquidationsConfigResponse struct {
+func (m *MsgSetMarketMakerProtectionConfig) GetConfig() MarketMakerProtectionConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MarketMakerProtectionConfig{}
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
-func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
-func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
+// MsgSetMarketMakerProtectionConfigResponse is a response type used for
+// setting or clearing the market-maker protection settings of a subaccount on
+// a clob pair.
+type MsgSetMarketMakerProtectionConfigResponse struct {
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgSetMarketMakerProtectionConfigResponse) Reset() {
+	*m = MsgSetMarketMakerProtectionConfigResponse{}
+}
+func (m *MsgSetMarketMakerProtectionConfigResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetMarketMakerProtectionConfigResponse) ProtoMessage() {}
+func (*MsgSetMarketMakerProtectionConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
+}
+func (m *MsgSetMarketMakerProtectionConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetMarketMakerProtectionConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetMarketMakerProtectionConfigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1080,1608 +1152,4284 @@ func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
+func (m *MsgSetMarketMakerProtectionConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMarketMakerProtectionConfigResponse.Merge(m, src)
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+func (m *MsgSetMarketMakerProtectionConfigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
+func (m *MsgSetMarketMakerProtectionConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMarketMakerProtectionConfigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetMarketMakerProtectionConfigResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
-	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
-	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
-	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
-	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
-	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgBatchCancel)(nil), "dydxprotocol.clob.MsgBatchCancel")
-	proto.RegisterType((*OrderBatch)(nil), "dydxprotocol.clob.OrderBatch")
-	proto.RegisterType((*MsgBatchCancelResponse)(nil), "dydxprotocol.clob.MsgBatchCancelResponse")
-	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
-	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
-	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
-	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
-	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
-	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+// MsgResetMarketMakerProtection is a request type used for resetting tripped
+// market-maker protection of a subaccount on a clob pair, allowing new orders
+// to be placed before the freeze interval elapses.
+type MsgResetMarketMakerProtection struct {
+	// The subaccount whose market-maker protection is reset.
+	SubaccountId types.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// The clob pair on which market-maker protection is reset.
+	ClobPairId uint32 `protobuf:"varint,2,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
-
-var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 1149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x24, 0x45,
-	0x18, 0x9e, 0x5e, 0xd4, 0x85, 0x17, 0x86, 0x85, 0x5a, 0x56, 0x66, 0x1b, 0x19, 0x86, 0x11, 0xc8,
-	0xac, 0x2e, 0x33, 0x2b, 0x6e, 0xd0, 0x68, 0xfc, 0x1a, 0xb2, 0x1b, 0x48, 0x96, 0x00, 0x0d, 0x26,
-	0x46, 0x4d, 0x3a, 0x3d, 0xdd, 0xc5, 0x50, 0xd9, 0xee, 0xa9, 0xa1, 0xab, 0x07, 0xe1, 0xba, 0xbf,
-	0xc0, 0xbb, 0x31, 0xfa, 0x13, 0x3c, 0xec, 0xc1, 0xbb, 0x97, 0x3d, 0x6e, 0x3c, 0x6d, 0xa2, 0x51,
-	0x03, 0x07, 0xff, 0x86, 0xe9, 0xaa, 0xee, 0x9a, 0xea, 0xed, 0xee, 0x61, 0x24, 0x1e, 0xbc, 0x40,
-	0x57, 0xd5, 0xf3, 0x7e, 0x3c, 0xcf, 0xfb, 0xd6, 0xc7, 0x80, 0xee, 0x9c, 0x39, 0xa7, 0x5d, 0x9f,
-	0x06, 0xd4, 0xa6, 0x6e, 0xc3, 0x76, 0x69, 0xab, 0x11, 0x9c, 0xd6, 0xf9, 0x04, 0x9a, 0x56, 0xd7,
-	0xea, 0xe1, 0x9a, 0x7e, 0xdb, 0xa6, 0xcc, 0xa3, 0xcc, 0xe4, 0xb3, 0x0d, 0x31, 0x10, 0x68, 0x7d,
-	0x56, 0x8c, 0x1a, 0x1e, 0x6b, 0x37, 0x4e, 0xde, 0x09, 0xff, 0x45, 0x0b, 0x33, 0x6d, 0xda, 0xa6,
-	0xc2, 0x20, 0xfc, 0x8a, 0x66, 0x1b, 0xe9, 0xc0, 0x2d, 0x97, 0xda, 0x8f, 0x4d, 0xdf, 0x0a, 0xb0,
-	0xe9, 0x12, 0x8f, 0x04, 0xa6, 0x4d, 0x3b, 0x87, 0x24, 0x76, 0xb3, 0x98, 0x36, 0x08, 0xff, 0x98,
-	0x5d, 0x8b, 0xf8, 0x11, 0xe4, 0x5e, 0x1a, 0x82, 0x8f, 0x7b, 0x24, 0x38, 0x33, 0x03, 0x82, 0xfd,
-	0x2c, 0xa7, 0x0b, 0x69, 0x0b, 0xcf, 0x0a, 0xec, 0x23, 0x1c, 0xb3, 0x9a, 0x4f, 0x03, 0xa8, 0xef,
-	0xe0, 0x38, 0xe2, 0x4a, 0xce, 0xb2, 0xe9, 0x63, 0x8f, 0x9e, 0x58, 0x6e, 0xec, 0xe6, 0xed, 0x34,
-	0xce, 0x25, 0xc7, 0x3d, 0xe2, 0x58, 0x01, 0xa1, 0x1d, 0x96, 0x4c, 0xea, 0x4e, 0x02, 0xcc, 0x7a,
-	0x2d, 0xcb, 0xb6, 0x69, 0xaf, 0x13, 0x30, 0xe5, 0x5b, 0x40, 0xab, 0xdf, 0x69, 0x30, 0xbd, 0xcd,
-	0xda, 0x1b, 0x3e, 0xb6, 0x02, 0xbc, 0xe1, 0xd2, 0xd6, 0xae, 0x45, 0x7c, 0xb4, 0x0e, 0x63, 0x56,
-	0x2f, 0x38, 0xa2, 0x3e, 0x09, 0xce, 0x4a, 0x5a, 0x45, 0xab, 0x8d, 0x35, 0x4b, 0xbf, 0x3e, 0x5d,
-	0x9d, 0x89, 0xea, 0xf5, 0x99, 0xe3, 0xf8, 0x98, 0xb1, 0xfd, 0xc0, 0x27, 0x9d, 0xb6, 0xd1, 0x87,
-	0xa2, 0x8f, 0x61, 0x4c, 0x4a, 0x5a, 0xba, 0x56, 0xd1, 0x6a, 0xe3, 0x6b, 0x73, 0xf5, 0x54, 0x13,
-	0xd4, 0xe3, 0x38, 0xcd, 0x57, 0x9e, 0xfd, 0xb1, 0x50, 0x30, 0x46, 0xed, 0x68, 0xfc, 0xc1, 0xe4,
-	0x93, 0xbf, 0x7f, 0x7a, 0xab, 0xef, 0xaf, 0x3a, 0x07, 0xb7, 0x53, 0xc9, 0x19, 0x98, 0x75, 0x69,
-	0x87, 0xe1, 0x2a, 0x81, 0x5b, 0xdb, 0xac, 0xbd, 0xeb, 0xd3, 0x2e, 0x65, 0xd8, 0xd9, 0xe9, 0x62,
-	0x5f, 0x68, 0x81, 0x76, 0x61, 0x8a, 0xca, 0x91, 0x79, 0xdc, 0xc3, 0x3d, 0x5c, 0xd2, 0x2a, 0x23,
-	0xb5, 0xf1, 0xb5, 0x85, 0x8c, 0x64, 0xa4, 0xa1, 0x61, 0x7d, 0x13, 0x25, 0x74, 0xa3, 0x6f, 0xbe,
-	0x17, 0x5a, 0x57, 0x17, 0x60, 0x3e, 0x33, 0x94, 0xcc, 0xe5, 0x01, 0x14, 0x43, 0x80, 0x6b, 0xd9,
-	0x78, 0x27, 0x2c, 0x1f, 0xba, 0x0f, 0xaf, 0xf2, 0x3a, 0x72, 0xf5, 0xc6, 0xd7, 0x4a, 0x59, 0x81,
-	0xc3, 0xf5, 0x28, 0xa2, 0x00, 0x57, 0x67, 0x05, 0x25, 0xe9, 0x46, 0xfa, 0xff, 0x59, 0x83, 0xc9,
-	0x50, 0x09, 0xab, 0x63, 0x63, 0x57, 0x44, 0xf8, 0x10, 0x46, 0x45, 0xa7, 0x10, 0x27, 0x0a, 0xa2,
-	0xe7, 0x05, 0xd9, 0x72, 0xa2, 0x30, 0xd7, 0xa9, 0x18, 0xa2, 0x15, 0x98, 0x6c, 0x53, 0xea, 0x98,
-	0x01, 0x71, 0x4d, 0xbe, 0x6b, 0x78, 0xb5, 0x8a, 0x9b, 0x05, 0x63, 0x22, 0x9c, 0x3f, 0x20, 0x6e,
-	0x33, 0x9c, 0x45, 0x0d, 0xb8, 0x99, 0xc4, 0x99, 0x01, 0xf1, 0x70, 0x69, 0xa4, 0xa2, 0xd5, 0xae,
-	0x6f, 0x16, 0x8c, 0x29, 0x15, 0x7c, 0x40, 0x3c, 0xdc, 0x9c, 0x52, 0x1c, 0xd3, 0x0e, 0xa6, 0x87,
-	0xd5, 0x12, 0xbc, 0x9e, 0xcc, 0x5c, 0x92, 0xfa, 0x5d, 0x90, 0x6a, 0x86, 0xfb, 0x45, 0xac, 0xa3,
-	0x3d, 0x28, 0xf6, 0x5b, 0xb4, 0xcf, 0x6c, 0x25, 0xc9, 0x4c, 0xe9, 0xe8, 0xfa, 0xbe, 0xfc, 0x96,
-	0x2c, 0x27, 0x98, 0x32, 0x87, 0xf6, 0x00, 0xb1, 0x23, 0xea, 0x07, 0x66, 0x80, 0x7d, 0xcf, 0xb4,
-	0x79, 0x1c, 0x56, 0xba, 0xc6, 0xfb, 0x61, 0x3e, 0xb7, 0x2c, 0x61, 0x4e, 0x91, 0xbb, 0x29, 0x6e,
-	0x7e, 0x80, 0x7d, 0x4f, 0x24, 0xc9, 0xd0, 0x52, 0x4a, 0xbd, 0x50, 0x90, 0x62, 0x52, 0xbb, 0xea,
-	0x36, 0x40, 0xdf, 0x17, 0xaa, 0xc0, 0x84, 0xdc, 0x1a, 0x31, 0xb1, 0xa2, 0x01, 0x71, 0xeb, 0x6f,
-	0x39, 0x68, 0x1e, 0xc0, 0x76, 0x09, 0xe6, 0xbc, 0x45, 0x82, 0x45, 0x63, 0x4c, 0xcc, 0x6c, 0x39,
-	0xac, 0xfa, 0x54, 0xe3, 0x42, 0x2a, 0x6a, 0xc5, 0x42, 0xa2, 0x1d, 0x98, 0x51, 0x28, 0xb2, 0x9e,
-	0x6d, 0x63, 0xec, 0x60, 0x27, 0x6a, 0xfa, 0xc1, 0x24, 0x0d, 0x24, 0xe9, 0xed, 0xc7, 0x86, 0x68,
-	0x0b, 0xa6, 0x15, 0x87, 0x87, 0x16, 0x71, 0xb1, 0x33, 0x94, 0x64, 0xc6, 0x0d, 0xe9, 0xed, 0x21,
-	0xb7, 0x8a, 0x0f, 0x98, 0xcf, 0xbb, 0xce, 0xff, 0xf7, 0x80, 0x49, 0x26, 0x27, 0xfb, 0xf3, 0x85,
-	0x06, 0x13, 0xea, 0xe9, 0x10, 0x6e, 0x6a, 0x7e, 0xb8, 0x47, 0x5d, 0xf9, 0x46, 0x4e, 0xe4, 0xed,
-	0x10, 0xb3, 0x59, 0x30, 0x04, 0x18, 0x7d, 0x04, 0xba, 0x22, 0xa6, 0xd8, 0xb3, 0xdd, 0x70, 0x8b,
-	0x7b, 0xb8, 0x13, 0x70, 0x12, 0x13, 0x9b, 0x05, 0x63, 0x56, 0x0a, 0xc7, 0xd5, 0xdc, 0x8d, 0x01,
-	0xe8, 0x21, 0x14, 0x13, 0x37, 0x02, 0xef, 0xb5, 0x9c, 0xa3, 0x4c, 0x6c, 0x2f, 0x0e, 0x0b, 0xb7,
-	0x32, 0x55, 0xc6, 0xcd, 0x71, 0x18, 0x93, 0xc7, 0x5a, 0xf5, 0x4f, 0x0d, 0x96, 0x25, 0xf1, 0x07,
-	0xfc, 0x8a, 0x3b, 0x20, 0xd8, 0x7f, 0x14, 0x5e, 0x70, 0x1b, 0xfc, 0x2a, 0xe9, 0x09, 0xe4, 0x95,
-	0x2b, 0xd5, 0x81, 0x52, 0xde, 0xd5, 0x19, 0x15, 0xae, 0x91, 0xc1, 0x60, 0x50, 0x2a, 0x51, 0x31,
-	0x6f, 0xe1, 0x2c, 0x4c, 0xaa, 0xb2, 0x0d, 0x58, 0x1d, 0x8a, 0xa0, 0xac, 0xf6, 0x6f, 0x1a, 0x2c,
-	0x49, 0x0b, 0xbe, 0x83, 0x0d, 0x2b, 0xc0, 0xff, 0xa1, 0x22, 0x8f, 0x61, 0x36, 0xe7, 0x81, 0x12,
-	0x95, 0xb4, 0x9e, 0x21, 0xc8, 0x80, 0x44, 0x22, 0x3d, 0x66, 0x5a, 0x19, 0x90, 0x94, 0x1c, 0x75,
-	0xb8, 0x3b, 0x0c, 0x39, 0xa9, 0xc6, 0x2f, 0x1a, 0xcc, 0x49, 0x83, 0x47, 0xca, 0x4b, 0x43, 0xc0,
-	0xaf, 0x2c, 0xc2, 0xd7, 0x70, 0x33, 0xe3, 0xdd, 0x12, 0x75, 0xc4, 0x72, 0x86, 0x00, 0xe9, 0xd8,
-	0x11, 0x6f, 0xe4, 0xa6, 0x56, 0x52, 0xac, 0x97, 0xe1, 0xcd, 0x01, 0x24, 0x62, 0xb2, 0x6b, 0x3f,
-	0x8c, 0xc2, 0xc8, 0x36, 0x6b, 0xa3, 0x2e, 0xa0, 0x8c, 0xe7, 0x44, 0x2d, 0x23, 0xab, 0xcc, 0xd7,
-	0x80, 0x7e, 0x6f, 0x58, 0xa4, 0x3c, 0xb9, 0xbf, 0x00, 0x50, 0x1e, 0x0d, 0x95, 0x1c, 0x7b, 0x89,
-	0xd0, 0x6b, 0x97, 0x21, 0xa4, 0xe7, 0xaf, 0x60, 0x5c, 0x7d, 0x2d, 0x2c, 0x66, 0x1b, 0x2a, 0x10,
-	0xfd, 0xce, 0xa5, 0x10, 0xd5, 0xb9, 0x7a, 0x6b, 0xe7, 0x38, 0x57, 0x20, 0x79, 0xce, 0xb3, 0x6e,
-	0x33, 0x07, 0x26, 0x5f, 0x7a, 0x8e, 0x2e, 0xe5, 0x64, 0x96, 0x40, 0xe9, 0x77, 0x87, 0x41, 0xa9,
-	0x51, 0x5e, 0xba, 0x93, 0x72, 0xa2, 0x24, 0x51, 0x79, 0x51, 0xb2, 0xaf, 0x10, 0xf4, 0xa3, 0x06,
-	0xd5, 0x21, 0x0e, 0xd9, 0xf7, 0x07, 0x39, 0x1d, 0x64, 0xa9, 0x7f, 0x7a, 0x55, 0x4b, 0x99, 0xe2,
-	0xf7, 0x1a, 0x2c, 0x5e, 0x7e, 0xe8, 0xbd, 0x37, 0x28, 0xce, 0x00, 0x43, 0xfd, 0x93, 0x2b, 0x1a,
-	0xca, 0xfc, 0x9e, 0x68, 0x50, 0xca, 0x3d, 0x86, 0xea, 0x83, 0xbc, 0xa7, 0xf1, 0xfa, 0xfa, 0xbf,
-	0xc3, 0xc7, 0x49, 0x34, 0x77, 0x9f, 0x9d, 0x97, 0xb5, 0xe7, 0xe7, 0x65, 0xed, 0xaf, 0xf3, 0xb2,
-	0xf6, 0xed, 0x45, 0xb9, 0xf0, 0xfc, 0xa2, 0x5c, 0x78, 0x71, 0x51, 0x2e, 0x7c, 0xb9, 0xde, 0x26,
-	0xc1, 0x51, 0xaf, 0x55, 0xb7, 0xa9, 0x97, 0xfc, 0x45, 0x7a, 0x72, 0x7f, 0xd5, 0x3e, 0xb2, 0x48,
-	0xa7, 0x21, 0x67, 0x4e, 0xa3, 0x9f, 0xc7, 0x67, 0x5d, 0xcc, 0x5a, 0xaf, 0xf1, 0xe9, 0x77, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x64, 0x28, 0x2e, 0x40, 0x0f, 0x00, 0x00,
+func (m *MsgResetMarketMakerProtection) Reset()         { *m = MsgResetMarketMakerProtection{} }
+func (m *MsgResetMarketMakerProtection) String() string { return proto.CompactTextString(m) }
+func (*MsgResetMarketMakerProtection) ProtoMessage()    {}
+func (*MsgResetMarketMakerProtection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{22}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
-	BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+func (m *MsgResetMarketMakerProtection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgResetMarketMakerProtection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetMarketMakerProtection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgResetMarketMakerProtection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetMarketMakerProtection.Merge(m, src)
 }
-
-func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
-	out := new(MsgProposedOperationsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResetMarketMakerProtection) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
-	out := new(MsgPlaceOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResetMarketMakerProtection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetMarketMakerProtection.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgResetMarketMakerProtection proto.InternalMessageInfo
 
-func (c *msgClient) BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error) {
-	out := new(MsgBatchCancelResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchCancel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResetMarketMakerProtection) GetSubaccountId() types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return out, nil
+	return types.SubaccountId{}
 }
 
-func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
-	out := new(MsgCreateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResetMarketMakerProtection) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
-	out := new(MsgUpdateClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgResetMarketMakerProtectionResponse is a response type used for resetting
+// tripped market-maker protection of a subaccount on a clob pair.
+type MsgResetMarketMakerProtectionResponse struct {
 }
 
-func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResetMarketMakerProtectionResponse) Reset()         { *m = MsgResetMarketMakerProtectionResponse{} }
+func (m *MsgResetMarketMakerProtectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetMarketMakerProtectionResponse) ProtoMessage()    {}
+func (*MsgResetMarketMakerProtectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{23}
 }
-
-func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgResetMarketMakerProtectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
-	out := new(MsgUpdateLiquidationsConfigResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgResetMarketMakerProtectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetMarketMakerProtectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgResetMarketMakerProtectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetMarketMakerProtectionResponse.Merge(m, src)
+}
+func (m *MsgResetMarketMakerProtectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetMarketMakerProtectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetMarketMakerProtectionResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ProposedOperations is a temporary message used by block proposers
-	// for matching orders as part of the ABCI++ workaround.
-	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
-	// PlaceOrder allows accounts to place orders on the orderbook.
-	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
-	// CancelOrder allows accounts to cancel existing orders on the orderbook.
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
-	BatchCancel(context.Context, *MsgBatchCancel) (*MsgBatchCancelResponse, error)
-	// CreateClobPair creates a new clob pair.
-	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
-	// UpdateClobPair sets the status of a clob pair. Should return an error
-	// if the authority is not in the clob keeper's set of authorities,
-	// if the ClobPair id is not found in state, or if the update includes
-	// an unsupported status transition.
-	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
-	// UpdateEquityTierLimitConfiguration updates the equity tier limit
-	// configuration in state.
-	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
-	// UpdateBlockRateLimitConfiguration updates the block rate limit
-	// configuration in state.
-	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
-	// UpdateLiquidationsConfig updates the liquidations configuration in state.
-	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+var xxx_messageInfo_MsgResetMarketMakerProtectionResponse proto.InternalMessageInfo
+
+// MsgUpdateClobPair is a request type used for updating a ClobPair in state.
+type MsgUpdateClobPair struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// `clob_pair` is the ClobPair to write to state.
+	ClobPair ClobPair `protobuf:"bytes,2,opt,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
+func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPair) ProtoMessage()    {}
+func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{24}
+}
+func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClobPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClobPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPair.Merge(m, src)
+}
+func (m *MsgUpdateClobPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClobPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPair.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
+var xxx_messageInfo_MsgUpdateClobPair proto.InternalMessageInfo
+
+func (m *MsgUpdateClobPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+
+func (m *MsgUpdateClobPair) GetClobPair() ClobPair {
+	if m != nil {
+		return m.ClobPair
+	}
+	return ClobPair{}
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+// MsgUpdateClobPairResponse is a response type used for setting a ClobPair's
+// status.
+type MsgUpdateClobPairResponse struct {
 }
-func (*UnimplementedMsgServer) BatchCancel(ctx context.Context, req *MsgBatchCancel) (*MsgBatchCancelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchCancel not implemented")
+
+func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResponse{} }
+func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
+func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{25}
 }
-func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+func (m *MsgUpdateClobPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+func (m *MsgUpdateClobPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPairResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+func (m *MsgUpdateClobPairResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
+func (m *MsgUpdateClobPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPairResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateClobPairResponse proto.InternalMessageInfo
+
+// OperationRaw represents an operation in the proposed operations.
+// Note that the `order_placement` operation is a signed message.
+type OperationRaw struct {
+	// operationRaw represents an operation that occurred, which can be a match,
+	// a signed order placement, an order removal, or the placement of orders of
+	// a signed batch of order placements.
+	//
+	// Types that are valid to be assigned to Operation:
+	//	*OperationRaw_Match
+	//	*OperationRaw_ShortTermOrderPlacement
+	//	*OperationRaw_OrderRemoval
+	//	*OperationRaw_ShortTermOrderBatchPlacement
+	Operation isOperationRaw_Operation `protobuf_oneof:"operation"`
 }
 
-func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProposedOperations)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProposedOperations(ctx, in)
+func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
+func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
+func (*OperationRaw) ProtoMessage()    {}
+func (*OperationRaw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{26}
+}
+func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationRaw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationRaw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
+}
+func (m *OperationRaw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationRaw.Merge(m, src)
+}
+func (m *OperationRaw) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationRaw) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationRaw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationRaw proto.InternalMessageInfo
+
+type isOperationRaw_Operation interface {
+	isOperationRaw_Operation()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type OperationRaw_Match struct {
+	Match *ClobMatch `protobuf:"bytes,1,opt,name=match,proto3,oneof" json:"match,omitempty"`
+}
+type OperationRaw_ShortTermOrderPlacement struct {
+	ShortTermOrderPlacement []byte `protobuf:"bytes,2,opt,name=short_term_order_placement,json=shortTermOrderPlacement,proto3,oneof" json:"short_term_order_placement,omitempty"`
+}
+type OperationRaw_OrderRemoval struct {
+	OrderRemoval *OrderRemoval `protobuf:"bytes,3,opt,name=order_removal,json=orderRemoval,proto3,oneof" json:"order_removal,omitempty"`
+}
+type OperationRaw_ShortTermOrderBatchPlacement struct {
+	ShortTermOrderBatchPlacement *ShortTermOrderBatchPlacement `protobuf:"bytes,4,opt,name=short_term_order_batch_placement,json=shortTermOrderBatchPlacement,proto3,oneof" json:"short_term_order_batch_placement,omitempty"`
+}
+
+func (*OperationRaw_Match) isOperationRaw_Operation()                        {}
+func (*OperationRaw_ShortTermOrderPlacement) isOperationRaw_Operation()      {}
+func (*OperationRaw_OrderRemoval) isOperationRaw_Operation()                 {}
+func (*OperationRaw_ShortTermOrderBatchPlacement) isOperationRaw_Operation() {}
+
+func (m *OperationRaw) GetOperation() isOperationRaw_Operation {
+	if m != nil {
+		return m.Operation
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
+	return nil
+}
+
+func (m *OperationRaw) GetMatch() *ClobMatch {
+	if x, ok := m.GetOperation().(*OperationRaw_Match); ok {
+		return x.Match
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceOrder)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *OperationRaw) GetShortTermOrderPlacement() []byte {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderPlacement); ok {
+		return x.ShortTermOrderPlacement
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceOrder(ctx, in)
+	return nil
+}
+
+func (m *OperationRaw) GetOrderRemoval() *OrderRemoval {
+	if x, ok := m.GetOperation().(*OperationRaw_OrderRemoval); ok {
+		return x.OrderRemoval
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
+	return nil
+}
+
+func (m *OperationRaw) GetShortTermOrderBatchPlacement() *ShortTermOrderBatchPlacement {
+	if x, ok := m.GetOperation().(*OperationRaw_ShortTermOrderBatchPlacement); ok {
+		return x.ShortTermOrderBatchPlacement
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*OperationRaw) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*OperationRaw_Match)(nil),
+		(*OperationRaw_ShortTermOrderPlacement)(nil),
+		(*OperationRaw_OrderRemoval)(nil),
+		(*OperationRaw_ShortTermOrderBatchPlacement)(nil),
 	}
-	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+// ShortTermOrderBatchPlacement represents the placement of the short term
+// orders of a signed `MsgBatchPlaceOrder` in the proposed operations.
+type ShortTermOrderBatchPlacement struct {
+	// The signed transaction bytes of the `MsgBatchPlaceOrder`.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// The indices of the placed orders in the `MsgBatchPlaceOrder`, in the order
+	// they were placed.
+	OrderIndices []uint32 `protobuf:"varint,2,rep,packed,name=order_indices,json=orderIndices,proto3" json:"order_indices,omitempty"`
 }
 
-func _Msg_BatchCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBatchCancel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BatchCancel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/BatchCancel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BatchCancel(ctx, req.(*MsgBatchCancel))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ShortTermOrderBatchPlacement) Reset()         { *m = ShortTermOrderBatchPlacement{} }
+func (m *ShortTermOrderBatchPlacement) String() string { return proto.CompactTextString(m) }
+func (*ShortTermOrderBatchPlacement) ProtoMessage()    {}
+func (*ShortTermOrderBatchPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{27}
 }
-
-func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ShortTermOrderBatchPlacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClobPair)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
+func (m *ShortTermOrderBatchPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShortTermOrderBatchPlacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEquityTierLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ShortTermOrderBatchPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.Merge(m, src)
+}
+func (m *ShortTermOrderBatchPlacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShortTermOrderBatchPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShortTermOrderBatchPlacement.DiscardUnknown(m)
 }
 
-func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBlockRateLimitConfiguration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
+var xxx_messageInfo_ShortTermOrderBatchPlacement proto.InternalMessageInfo
+
+func (m *ShortTermOrderBatchPlacement) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateLiquidationsConfig)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
+func (m *ShortTermOrderBatchPlacement) GetOrderIndices() []uint32 {
+	if m != nil {
+		return m.OrderIndices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ProposedOperations",
-			Handler:    _Msg_ProposedOperations_Handler,
-		},
-		{
-			MethodName: "PlaceOrder",
-			Handler:    _Msg_PlaceOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "BatchCancel",
-			Handler:    _Msg_BatchCancel_Handler,
-		},
-		{
-			MethodName: "CreateClobPair",
-			Handler:    _Msg_CreateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateClobPair",
-			Handler:    _Msg_UpdateClobPair_Handler,
-		},
-		{
-			MethodName: "UpdateEquityTierLimitConfiguration",
-			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateBlockRateLimitConfiguration",
-			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "UpdateLiquidationsConfig",
-			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/clob/tx.proto",
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// request type.
+type MsgUpdateEquityTierLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the equity tier limit configuration to update to. All fields must
+	// be set.
+	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,2,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
 }
 
-func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdateEquityTierLimitConfiguration{} }
+func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{28}
 }
-
-func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.Merge(m, src)
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEquityTierLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateEquityTierLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfiguration) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
+	if m != nil {
+		return m.EquityTierLimitConfig
+	}
+	return EquityTierLimitConfiguration{}
 }
 
-func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateEquityTierLimitConfiguration is the Msg/EquityTierLimitConfiguration
+// response type.
+type MsgUpdateEquityTierLimitConfigurationResponse struct {
 }
 
-func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateEquityTierLimitConfigurationResponse{}
 }
-
-func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{29}
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.Merge(m, src)
 }
-
-func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateEquityTierLimitConfigurationResponse proto.InternalMessageInfo
 
-func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateBlockRateLimitConfiguration is the Msg/BlockRateLimitConfiguration
+// request type.
+type MsgUpdateBlockRateLimitConfiguration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the block rate limit configuration to update to. All fields must be
+	// set.
+	BlockRateLimitConfig BlockRateLimitConfiguration `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 }
 
-func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateBlockRateLimitConfiguration{} }
+func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
+func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{30}
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.Merge(m, src)
 }
-
-func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockRateLimitConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration.DiscardUnknown(m)
 }
 
-func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfiguration proto.InternalMessageInfo
+
+func (m *MsgUpdateBlockRateLimitConfiguration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBlockRateLimitConfiguration) GetBlockRateLimitConfig() BlockRateLimitConfiguration {
+	if m != nil {
+		return m.BlockRateLimitConfig
 	}
-	return dAtA[:n], nil
+	return BlockRateLimitConfiguration{}
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateBlockRateLimitConfiguration is a response type for updating the
+// liquidations config.
+type MsgUpdateBlockRateLimitConfigurationResponse struct {
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GoodTilOneof != nil {
-		{
-			size := m.GoodTilOneof.Size()
-			i -= size
-			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	{
-		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Reset() {
+	*m = MsgUpdateBlockRateLimitConfigurationResponse{}
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
+func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{31}
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.Merge(m, src)
 }
-
-func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
-	i--
-	dAtA[i] = 0x1d
-	return len(dAtA) - i, nil
-}
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUpdateBlockRateLimitConfigurationResponse proto.InternalMessageInfo
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateLiquidationsConfig is a request type for updating the liquidations
+// config.
+type MsgUpdateLiquidationsConfig struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the liquidations configuration to update to. All fields must
+	// be set.
+	LiquidationsConfig LiquidationsConfig `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 }
 
-func (m *MsgBatchCancel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidationsConfig{} }
+func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{32}
 }
-
-func (m *MsgBatchCancel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgBatchCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GoodTilBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ShortTermCancels) > 0 {
-		for iNdEx := len(m.ShortTermCancels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ShortTermCancels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateLiquidationsConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *OrderBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *OrderBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.Merge(m, src)
 }
-
-func (m *OrderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientIds) > 0 {
-		dAtA6 := make([]byte, len(m.ClientIds)*10)
-		var j5 int
-		for _, num := range m.ClientIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ClobPairId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUpdateLiquidationsConfig) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgBatchCancelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateLiquidationsConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfig.DiscardUnknown(m)
 }
 
-func (m *MsgBatchCancelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUpdateLiquidationsConfig proto.InternalMessageInfo
 
-func (m *MsgBatchCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShortTermFailed) > 0 {
-		for iNdEx := len(m.ShortTermFailed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ShortTermFailed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ShortTermSucceeded) > 0 {
-		for iNdEx := len(m.ShortTermSucceeded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ShortTermSucceeded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MsgUpdateLiquidationsConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateLiquidationsConfig) GetLiquidationsConfig() LiquidationsConfig {
+	if m != nil {
+		return m.LiquidationsConfig
 	}
-	return dAtA[:n], nil
+	return LiquidationsConfig{}
 }
 
-func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateLiquidationsConfig is the Msg/LiquidationsConfig response type.
+type MsgUpdateLiquidationsConfigResponse struct {
 }
 
-func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLiquidationsConfigResponse{} }
+func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{33}
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationsConfigResponse.DiscardUnknown(m)
 }
 
-func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
+	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
+	proto.RegisterType((*MsgProposedOperations)(nil), "dydxprotocol.clob.MsgProposedOperations")
+	proto.RegisterType((*MsgProposedOperationsResponse)(nil), "dydxprotocol.clob.MsgProposedOperationsResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "dydxprotocol.clob.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "dydxprotocol.clob.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "dydxprotocol.clob.MsgReplaceOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "dydxprotocol.clob.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "dydxprotocol.clob.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgBatchCancel)(nil), "dydxprotocol.clob.MsgBatchCancel")
+	proto.RegisterType((*OrderBatch)(nil), "dydxprotocol.clob.OrderBatch")
+	proto.RegisterType((*MsgBatchCancelResponse)(nil), "dydxprotocol.clob.MsgBatchCancelResponse")
+	proto.RegisterType((*MsgBatchPlaceOrder)(nil), "dydxprotocol.clob.MsgBatchPlaceOrder")
+	proto.RegisterType((*MsgBatchPlaceOrderResponse)(nil), "dydxprotocol.clob.MsgBatchPlaceOrderResponse")
+	proto.RegisterType((*OrderPlacementFailure)(nil), "dydxprotocol.clob.OrderPlacementFailure")
+	proto.RegisterType((*MsgCancelAllOrders)(nil), "dydxprotocol.clob.MsgCancelAllOrders")
+	proto.RegisterType((*MsgCancelAllOrdersResponse)(nil), "dydxprotocol.clob.MsgCancelAllOrdersResponse")
+	proto.RegisterType((*MsgHeartbeat)(nil), "dydxprotocol.clob.MsgHeartbeat")
+	proto.RegisterType((*MsgHeartbeatResponse)(nil), "dydxprotocol.clob.MsgHeartbeatResponse")
+	proto.RegisterType((*MsgSetMarketMakerProtectionConfig)(nil), "dydxprotocol.clob.MsgSetMarketMakerProtectionConfig")
+	proto.RegisterType((*MsgSetMarketMakerProtectionConfigResponse)(nil), "dydxprotocol.clob.MsgSetMarketMakerProtectionConfigResponse")
+	proto.RegisterType((*MsgResetMarketMakerProtection)(nil), "dydxprotocol.clob.MsgResetMarketMakerProtection")
+	proto.RegisterType((*MsgResetMarketMakerProtectionResponse)(nil), "dydxprotocol.clob.MsgResetMarketMakerProtectionResponse")
+	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
+	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
+	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
+	proto.RegisterType((*ShortTermOrderBatchPlacement)(nil), "dydxprotocol.clob.ShortTermOrderBatchPlacement")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration")
+	proto.RegisterType((*MsgUpdateEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfiguration)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration")
+	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
+	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
 }
 
-func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
+
+var fileDescriptor_19b9e2c0de4ab64a = []byte{
+	// 1577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xa6, 0x9f, 0x79, 0xb6, 0x93, 0x74, 0x9b, 0x34, 0xce, 0x26, 0x71, 0x9c, 0x6d, 0xd3,
+	0x26, 0x6d, 0x63, 0x97, 0x50, 0x95, 0x8a, 0xef, 0xba, 0x6a, 0x49, 0x50, 0xad, 0xa6, 0x9b, 0x54,
+	0x42, 0x05, 0x75, 0xb5, 0xde, 0x9d, 0x3a, 0x4b, 0xd6, 0x1e, 0x77, 0x67, 0x5c, 0x92, 0x6b, 0x4f,
+	0x08, 0x2e, 0x1c, 0x7a, 0x43, 0x48, 0xfc, 0x09, 0x48, 0xf4, 0xc0, 0x9d, 0x4b, 0x85, 0x38, 0x54,
+	0x70, 0x41, 0x02, 0x01, 0x6a, 0x25, 0xf8, 0x37, 0xd0, 0xee, 0xec, 0x8e, 0x77, 0xbd, 0x1f, 0x76,
+	0x53, 0x22, 0xb8, 0x38, 0x9e, 0x99, 0xdf, 0x7b, 0xef, 0xf7, 0x7e, 0xf3, 0xf5, 0xc6, 0x01, 0xc9,
+	0xd8, 0x35, 0x76, 0xda, 0x36, 0xa6, 0x58, 0xc7, 0x56, 0x45, 0xb7, 0x70, 0xbd, 0x42, 0x77, 0xca,
+	0x6e, 0x87, 0x78, 0x2c, 0x38, 0x56, 0x76, 0xc6, 0xa4, 0x29, 0x1d, 0x93, 0x26, 0x26, 0xaa, 0xdb,
+	0x5b, 0x61, 0x0d, 0x86, 0x96, 0x26, 0x59, 0xab, 0xd2, 0x24, 0x8d, 0xca, 0x83, 0x57, 0x9c, 0x3f,
+	0xde, 0xc0, 0x78, 0x03, 0x37, 0x30, 0x33, 0x70, 0xbe, 0x79, 0xbd, 0x95, 0x68, 0xe0, 0xba, 0x85,
+	0xf5, 0x6d, 0xd5, 0xd6, 0x28, 0x52, 0x2d, 0xb3, 0x69, 0x52, 0x55, 0xc7, 0xad, 0x7b, 0xa6, 0xef,
+	0x66, 0x3e, 0x6a, 0xe0, 0x7c, 0xa8, 0x6d, 0xcd, 0xb4, 0x3d, 0xc8, 0x85, 0x28, 0x04, 0xdd, 0xef,
+	0x98, 0x74, 0x57, 0xa5, 0x26, 0xb2, 0xe3, 0x9c, 0xce, 0x45, 0x2d, 0x9a, 0x1a, 0xd5, 0xb7, 0x90,
+	0x9f, 0xd5, 0x74, 0x0c, 0xa0, 0xd9, 0xf6, 0x06, 0x67, 0xa3, 0x83, 0xd8, 0x36, 0x90, 0x4f, 0xe7,
+	0x74, 0xc2, 0xb0, 0x6a, 0xa3, 0x26, 0x7e, 0xa0, 0x59, 0x7e, 0x8c, 0x73, 0x51, 0x9c, 0x65, 0xde,
+	0xef, 0x98, 0x86, 0x46, 0x4d, 0xdc, 0x22, 0x61, 0xc6, 0x4b, 0x21, 0x30, 0xe9, 0xd4, 0x35, 0x5d,
+	0xc7, 0x9d, 0x16, 0x25, 0x81, 0xef, 0x0c, 0x2a, 0x7f, 0x29, 0xc0, 0xb1, 0x1a, 0x69, 0x5c, 0xb5,
+	0x91, 0x46, 0xd1, 0x55, 0x0b, 0xd7, 0xd7, 0x35, 0xd3, 0x16, 0x2f, 0xc1, 0xb0, 0xd6, 0xa1, 0x5b,
+	0xd8, 0x36, 0xe9, 0x6e, 0x41, 0x28, 0x09, 0x8b, 0xc3, 0xd5, 0xc2, 0x4f, 0x8f, 0x97, 0xc7, 0xbd,
+	0xc9, 0xbc, 0x62, 0x18, 0x36, 0x22, 0x64, 0x83, 0xda, 0x66, 0xab, 0xa1, 0x74, 0xa1, 0xe2, 0xdb,
+	0x30, 0xcc, 0xf5, 0x2e, 0x0c, 0x95, 0x84, 0xc5, 0xec, 0xca, 0x74, 0x39, 0xb2, 0x42, 0xca, 0x7e,
+	0x9c, 0xea, 0xc1, 0x27, 0xbf, 0xcf, 0x65, 0x94, 0xa3, 0xba, 0xd7, 0x7e, 0x7d, 0xe4, 0xe1, 0xdf,
+	0xdf, 0x9c, 0xed, 0xfa, 0x93, 0xa7, 0x61, 0x2a, 0x42, 0x4e, 0x41, 0xa4, 0x8d, 0x5b, 0x04, 0xc9,
+	0x26, 0x4c, 0xd4, 0x48, 0x63, 0xdd, 0xc6, 0x6d, 0x4c, 0x90, 0x71, 0xb3, 0x8d, 0x6c, 0xa6, 0x85,
+	0xb8, 0x0e, 0x63, 0x98, 0xb7, 0xd4, 0xfb, 0x1d, 0xd4, 0x41, 0x05, 0xa1, 0x74, 0x60, 0x31, 0xbb,
+	0x32, 0x17, 0x43, 0x86, 0x1b, 0x2a, 0xda, 0x27, 0x1e, 0xa1, 0xd1, 0xae, 0xf9, 0x2d, 0xc7, 0x5a,
+	0x9e, 0x83, 0xd9, 0xd8, 0x50, 0x9c, 0xcb, 0x35, 0xc8, 0x3b, 0x00, 0x4b, 0xd3, 0xd1, 0x4d, 0x67,
+	0xfa, 0xc4, 0x8b, 0x70, 0xc8, 0x9d, 0x47, 0x57, 0xbd, 0xec, 0x4a, 0x21, 0x2e, 0xb0, 0x33, 0xee,
+	0x45, 0x64, 0x60, 0x79, 0x12, 0x26, 0x42, 0x6e, 0xb8, 0xff, 0xf7, 0x60, 0xb4, 0x46, 0x1a, 0x0a,
+	0x6a, 0xbf, 0x6c, 0x84, 0x29, 0x98, 0xec, 0x71, 0xc4, 0x63, 0x7c, 0x27, 0xc0, 0x88, 0xa3, 0xb6,
+	0xd6, 0xd2, 0x91, 0xc5, 0x62, 0xbc, 0x01, 0x47, 0xd9, 0x6a, 0x34, 0x0d, 0x2f, 0x8c, 0x94, 0x14,
+	0x66, 0xcd, 0xf0, 0x02, 0x1d, 0xc1, 0xac, 0x29, 0x9e, 0x86, 0x91, 0x06, 0xc6, 0x86, 0x4a, 0x4d,
+	0x4b, 0x75, 0xb7, 0xad, 0xbb, 0x22, 0xf2, 0xab, 0x19, 0x25, 0xe7, 0xf4, 0x6f, 0x9a, 0x56, 0xd5,
+	0xe9, 0x15, 0x2b, 0x70, 0x3c, 0x8c, 0x53, 0xa9, 0xd9, 0x44, 0x85, 0x03, 0x25, 0x61, 0xf1, 0xc8,
+	0x6a, 0x46, 0x19, 0x0b, 0x82, 0x37, 0xcd, 0x26, 0xaa, 0x8e, 0x05, 0x1c, 0xe3, 0x16, 0xc2, 0xf7,
+	0xe4, 0x02, 0x9c, 0x08, 0x33, 0xe7, 0x49, 0xfd, 0xc6, 0x92, 0xaa, 0x3a, 0x1b, 0x96, 0x8d, 0x8b,
+	0xb7, 0x20, 0xdf, 0xdd, 0x06, 0xdd, 0xcc, 0x4e, 0x87, 0x33, 0xeb, 0x42, 0x48, 0x79, 0x83, 0x7f,
+	0xe7, 0x59, 0xe6, 0x48, 0xa0, 0x4f, 0xbc, 0x05, 0x22, 0xd9, 0xc2, 0x36, 0x55, 0x29, 0xb2, 0x9b,
+	0xaa, 0xee, 0xc6, 0x21, 0x85, 0x21, 0x77, 0xcd, 0xcd, 0x26, 0x4e, 0x8c, 0xc3, 0xc9, 0x73, 0x37,
+	0xe6, 0x9a, 0x6f, 0x22, 0xbb, 0xc9, 0x48, 0x12, 0xf1, 0x54, 0x44, 0x3d, 0x47, 0x90, 0x7c, 0x58,
+	0x3b, 0xb9, 0x06, 0xd0, 0xf5, 0x25, 0x96, 0x20, 0xc7, 0xb7, 0x9f, 0x9f, 0x58, 0x5e, 0x01, 0x7f,
+	0x7b, 0xad, 0x19, 0xe2, 0x2c, 0x80, 0x6e, 0x99, 0xc8, 0xcd, 0x9b, 0x11, 0xcc, 0x2b, 0xc3, 0xac,
+	0x67, 0xcd, 0x20, 0xf2, 0x63, 0xc1, 0x15, 0x32, 0xa0, 0x96, 0x2f, 0xa4, 0x78, 0x13, 0xc6, 0x03,
+	0x29, 0x92, 0x8e, 0xae, 0x23, 0x64, 0x20, 0xa3, 0x20, 0x0c, 0x90, 0xa4, 0x22, 0xf2, 0xf4, 0x36,
+	0x7c, 0x43, 0x71, 0x0d, 0x8e, 0x05, 0x1c, 0xde, 0xd3, 0x4c, 0x0b, 0x19, 0x03, 0x49, 0xa6, 0x8c,
+	0x72, 0x6f, 0xd7, 0x5d, 0x2b, 0xf9, 0x5b, 0x01, 0x44, 0x9f, 0x76, 0x60, 0x0f, 0xee, 0xc3, 0x44,
+	0xbf, 0x1f, 0x22, 0xed, 0xae, 0x74, 0x7f, 0x9e, 0xfb, 0x6d, 0xc0, 0x2e, 0x6b, 0xb7, 0x97, 0xc8,
+	0x3f, 0x0a, 0x20, 0x45, 0x59, 0x73, 0xc1, 0x95, 0x54, 0xc1, 0xfb, 0xef, 0xc3, 0x38, 0xcd, 0xef,
+	0x24, 0x6b, 0xbe, 0x98, 0xe4, 0xd0, 0xa5, 0xd6, 0x44, 0x2d, 0xea, 0x88, 0xdd, 0xb1, 0x51, 0x24,
+	0x1d, 0x6f, 0x12, 0x3e, 0x86, 0x89, 0x58, 0xfc, 0xcb, 0x1d, 0x22, 0xe3, 0x70, 0x08, 0xd9, 0x36,
+	0x66, 0xb7, 0xc9, 0xb0, 0xc2, 0x1a, 0xf2, 0xe7, 0x6c, 0xc2, 0xd9, 0x12, 0xbd, 0x62, 0xb1, 0x3d,
+	0x4f, 0xf6, 0x63, 0xc2, 0x65, 0xc8, 0x07, 0xb7, 0x94, 0xbf, 0x67, 0xb2, 0xdd, 0x3d, 0x45, 0xe4,
+	0x19, 0x90, 0xa2, 0x64, 0xf8, 0x09, 0xf4, 0x99, 0x00, 0xb9, 0x1a, 0x69, 0xac, 0x22, 0xcd, 0xa6,
+	0x75, 0xa4, 0xd1, 0xfd, 0x60, 0x79, 0x06, 0x46, 0x9d, 0x33, 0x13, 0x77, 0xa8, 0x4a, 0x90, 0x8e,
+	0x5b, 0x2e, 0x4f, 0x67, 0xef, 0x8f, 0x78, 0xdd, 0x1b, 0xac, 0x57, 0x3e, 0x01, 0xe3, 0x41, 0x2e,
+	0x9c, 0xe4, 0x5f, 0x02, 0xcc, 0xd7, 0x48, 0x63, 0x03, 0xd1, 0x9a, 0x66, 0x6f, 0x3b, 0x9f, 0xdb,
+	0xc8, 0x5e, 0xb7, 0x31, 0x45, 0xba, 0x73, 0xd1, 0x5d, 0x75, 0xab, 0x8b, 0xfd, 0x60, 0xde, 0x7b,
+	0x64, 0x0d, 0x45, 0x8e, 0xac, 0x1b, 0x70, 0x98, 0x15, 0x37, 0xee, 0x01, 0x98, 0x5d, 0x29, 0xc7,
+	0x2c, 0x9e, 0x14, 0xd2, 0x5e, 0x54, 0xcf, 0x87, 0x7c, 0x0e, 0x96, 0xfa, 0xe6, 0xc9, 0x55, 0x79,
+	0x24, 0xb8, 0xf7, 0xbe, 0x82, 0x48, 0x02, 0xfe, 0x3f, 0x51, 0x44, 0x3e, 0x03, 0x0b, 0xa9, 0xac,
+	0x38, 0x7f, 0xaf, 0xb8, 0xbb, 0xdd, 0x36, 0xfe, 0xbf, 0xc5, 0x5d, 0x98, 0x1c, 0xa7, 0xfe, 0xf3,
+	0x10, 0xe4, 0x82, 0x95, 0x99, 0x53, 0xee, 0xb8, 0x55, 0xb7, 0xa7, 0xf0, 0x4c, 0x42, 0xe4, 0x9a,
+	0x83, 0x59, 0xcd, 0x28, 0x0c, 0x2c, 0xbe, 0x05, 0x52, 0xef, 0x79, 0xad, 0xb6, 0xfd, 0x03, 0xca,
+	0x4d, 0x22, 0xb7, 0x9a, 0x51, 0x26, 0xc3, 0x47, 0x33, 0x3f, 0xc1, 0xc4, 0xeb, 0x90, 0x0f, 0x55,
+	0xe3, 0xde, 0x12, 0x9c, 0x4b, 0x3a, 0xbf, 0x14, 0x06, 0x73, 0x4a, 0x1c, 0x1c, 0x68, 0x8b, 0xbb,
+	0x50, 0x8a, 0xd0, 0xa8, 0x3b, 0x04, 0x03, 0x64, 0x0e, 0xba, 0xae, 0x2b, 0x31, 0xae, 0x37, 0x42,
+	0xec, 0xba, 0xf7, 0x85, 0x63, 0xb6, 0x9a, 0x51, 0x66, 0x48, 0xca, 0x78, 0x35, 0x0b, 0xc3, 0xbc,
+	0x9a, 0x95, 0xef, 0xc2, 0x4c, 0x9a, 0x33, 0x71, 0x0a, 0x8e, 0xd2, 0x1d, 0xb5, 0xbe, 0x4b, 0x11,
+	0x71, 0x75, 0xce, 0x29, 0x47, 0xe8, 0x4e, 0xd5, 0x69, 0x8a, 0x27, 0x7d, 0x29, 0xcc, 0x96, 0x61,
+	0xea, 0xc8, 0x3f, 0x08, 0x59, 0x9e, 0x6b, 0xac, 0x4f, 0xfe, 0x43, 0x80, 0x05, 0x3e, 0xa7, 0xd7,
+	0xdc, 0x67, 0xd5, 0xa6, 0x89, 0xec, 0x1b, 0xce, 0xa3, 0x8a, 0xed, 0xad, 0x0e, 0x63, 0xb2, 0xe7,
+	0x45, 0xd8, 0x82, 0x42, 0xd2, 0x73, 0xad, 0x30, 0x94, 0xa8, 0x60, 0x1a, 0x15, 0x6f, 0x9d, 0x4e,
+	0xa0, 0x38, 0x4c, 0x64, 0xd1, 0x56, 0x60, 0x79, 0xa0, 0x04, 0xf9, 0x42, 0xfe, 0x55, 0x80, 0x53,
+	0xdc, 0xc2, 0x2d, 0xda, 0x14, 0x8d, 0xa2, 0x7f, 0x51, 0x91, 0x6d, 0x98, 0x4c, 0x78, 0x14, 0xa7,
+	0x1c, 0x98, 0x29, 0x44, 0x3c, 0x3d, 0xc6, 0xeb, 0x31, 0x90, 0x88, 0x1c, 0x65, 0x38, 0x3f, 0x48,
+	0x72, 0x5c, 0x8d, 0xef, 0x05, 0x98, 0xe6, 0x06, 0x37, 0x02, 0x0f, 0x58, 0xef, 0x86, 0xd9, 0xab,
+	0x08, 0x1f, 0xc1, 0xf1, 0x98, 0xe7, 0xb0, 0xb7, 0x22, 0x16, 0x62, 0x04, 0x88, 0xc6, 0xf6, 0xcb,
+	0x26, 0x2b, 0x32, 0x12, 0xc9, 0x7a, 0x01, 0x4e, 0xa6, 0x24, 0xe1, 0x27, 0xbb, 0xf2, 0x43, 0x1e,
+	0x0e, 0xd4, 0x48, 0x43, 0x6c, 0x83, 0x18, 0xf3, 0x4a, 0x8d, 0x2b, 0xb8, 0x62, 0x1f, 0x99, 0xd2,
+	0x85, 0x41, 0x91, 0xbc, 0x76, 0xfc, 0x00, 0x20, 0x50, 0x07, 0x97, 0x12, 0xec, 0x39, 0x42, 0x5a,
+	0xec, 0x87, 0xe0, 0x9e, 0x3f, 0x84, 0x6c, 0xf0, 0x81, 0x38, 0x1f, 0x6f, 0x18, 0x80, 0x48, 0x4b,
+	0x7d, 0x21, 0x41, 0xe7, 0xc1, 0x87, 0x5a, 0x82, 0xf3, 0x00, 0x44, 0x5a, 0xea, 0x0b, 0xe1, 0xce,
+	0x1b, 0x30, 0xda, 0xfb, 0x40, 0x58, 0x48, 0xb1, 0x0e, 0xa8, 0xb3, 0x3c, 0x10, 0x8c, 0x07, 0xba,
+	0x0b, 0xb9, 0xd0, 0x43, 0x5d, 0x8e, 0x37, 0x0f, 0x62, 0xa4, 0xb3, 0xfd, 0x31, 0xc1, 0x44, 0x7a,
+	0x0b, 0xdf, 0x85, 0x34, 0x8d, 0x39, 0x4c, 0x5a, 0x1e, 0x08, 0xc6, 0x03, 0xdd, 0x86, 0xe1, 0x6e,
+	0xd5, 0x3a, 0x17, 0x6f, 0xcb, 0x01, 0xd2, 0x99, 0x3e, 0x00, 0xee, 0xf6, 0x91, 0x00, 0xc5, 0x3e,
+	0x85, 0xe6, 0xc5, 0x78, 0x5f, 0xe9, 0x56, 0xd2, 0x9b, 0x7b, 0xb1, 0xe2, 0xb4, 0x3e, 0x15, 0x40,
+	0x4a, 0xa9, 0xf4, 0x2e, 0x24, 0xcd, 0x50, 0x92, 0x85, 0x74, 0xf9, 0x45, 0x2d, 0x38, 0x15, 0x03,
+	0x46, 0x7a, 0x7e, 0x90, 0x3b, 0x95, 0x30, 0x73, 0x21, 0x94, 0x74, 0x7e, 0x10, 0x54, 0x30, 0x4a,
+	0x4f, 0x65, 0x98, 0x10, 0x25, 0x8c, 0x92, 0xce, 0x0f, 0x82, 0xe2, 0x51, 0xbe, 0x16, 0x40, 0x1e,
+	0xa0, 0x1e, 0xb8, 0x9c, 0xe6, 0x34, 0xcd, 0x52, 0x7a, 0x77, 0xaf, 0x96, 0x9c, 0xe2, 0x57, 0x02,
+	0xcc, 0xf7, 0xbf, 0x9f, 0x5f, 0x4b, 0x8b, 0x93, 0x62, 0x28, 0xbd, 0xb3, 0x47, 0x43, 0xce, 0xef,
+	0xa1, 0x00, 0x85, 0xc4, 0x1b, 0xb3, 0x9c, 0xe6, 0x3d, 0x8a, 0x97, 0x2e, 0xbd, 0x18, 0xde, 0x27,
+	0x51, 0x5d, 0xbf, 0x73, 0xa9, 0x61, 0xd2, 0xad, 0x4e, 0xbd, 0xac, 0xe3, 0x66, 0xf8, 0x87, 0xf9,
+	0x07, 0x17, 0x97, 0xf5, 0x2d, 0xcd, 0x6c, 0x55, 0x78, 0xcf, 0x8e, 0xf7, 0x5f, 0x82, 0xdd, 0x36,
+	0x22, 0x4f, 0x9e, 0x15, 0x85, 0xa7, 0xcf, 0x8a, 0xc2, 0x9f, 0xcf, 0x8a, 0xc2, 0x17, 0xcf, 0x8b,
+	0x99, 0xa7, 0xcf, 0x8b, 0x99, 0x5f, 0x9e, 0x17, 0x33, 0xf5, 0xc3, 0x2e, 0xfc, 0xd5, 0x7f, 0x06,
+	0x00, 0x2c, 0x35, 0x76, 0xb8, 0x5f, 0x18, 0x00, 0x00,
 }
 
-func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Operation != nil {
-		{
-			size := m.Operation.Size()
-			i -= size
-			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
+	BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error)
+	// BatchPlaceOrder allows accounts to place a batch of short term orders on
+	// the orderbook.
+	BatchPlaceOrder(ctx context.Context, in *MsgBatchPlaceOrder, opts ...grpc.CallOption) (*MsgBatchPlaceOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically replace an existing stateful
+	// order on the orderbook.
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
+	// CancelAllOrders allows accounts to cancel all of their open orders on the
+	// orderbook, optionally filtered by clob pair.
+	CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error)
+	// Heartbeat arms, refreshes or disarms the dead man's switch of a
+	// subaccount.
+	Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error)
+	// SetMarketMakerProtectionConfig sets or clears the market-maker protection
+	// settings of a subaccount on a clob pair.
+	SetMarketMakerProtectionConfig(ctx context.Context, in *MsgSetMarketMakerProtectionConfig, opts ...grpc.CallOption) (*MsgSetMarketMakerProtectionConfigResponse, error)
+	// ResetMarketMakerProtection resets tripped market-maker protection of a
+	// subaccount on a clob pair.
+	ResetMarketMakerProtection(ctx context.Context, in *MsgResetMarketMakerProtection, opts ...grpc.CallOption) (*MsgResetMarketMakerProtectionResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
 }
 
-func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Match != nil {
-		{
-			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *msgClient) ProposedOperations(ctx context.Context, in *MsgProposedOperations, opts ...grpc.CallOption) (*MsgProposedOperationsResponse, error) {
+	out := new(MsgProposedOperationsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ProposedOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ShortTermOrderPlacement != nil {
-		i -= len(m.ShortTermOrderPlacement)
-		copy(dAtA[i:], m.ShortTermOrderPlacement)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderRemoval != nil {
-		{
-			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error) {
+	out := new(MsgBatchCancelResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *msgClient) BatchPlaceOrder(ctx context.Context, in *MsgBatchPlaceOrder, opts ...grpc.CallOption) (*MsgBatchPlaceOrderResponse, error) {
+	out := new(MsgBatchPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/BatchPlaceOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) CancelAllOrders(ctx context.Context, in *MsgCancelAllOrders, opts ...grpc.CallOption) (*MsgCancelAllOrdersResponse, error) {
+	out := new(MsgCancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error) {
+	out := new(MsgHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetMarketMakerProtectionConfig(ctx context.Context, in *MsgSetMarketMakerProtectionConfig, opts ...grpc.CallOption) (*MsgSetMarketMakerProtectionConfigResponse, error) {
+	out := new(MsgSetMarketMakerProtectionConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/SetMarketMakerProtectionConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResetMarketMakerProtection(ctx context.Context, in *MsgResetMarketMakerProtection, opts ...grpc.CallOption) (*MsgResetMarketMakerProtectionResponse, error) {
+	out := new(MsgResetMarketMakerProtectionResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ResetMarketMakerProtection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
+	out := new(MsgCreateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateClobPair(ctx context.Context, in *MsgUpdateClobPair, opts ...grpc.CallOption) (*MsgUpdateClobPairResponse, error) {
+	out := new(MsgUpdateClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPair", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateEquityTierLimitConfiguration(ctx context.Context, in *MsgUpdateEquityTierLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	out := new(MsgUpdateEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	out := new(MsgUpdateBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error) {
+	out := new(MsgUpdateLiquidationsConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ProposedOperations is a temporary message used by block proposers
+	// for matching orders as part of the ABCI++ workaround.
+	ProposedOperations(context.Context, *MsgProposedOperations) (*MsgProposedOperationsResponse, error)
+	// PlaceOrder allows accounts to place orders on the orderbook.
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	// CancelOrder allows accounts to cancel existing orders on the orderbook.
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
+	BatchCancel(context.Context, *MsgBatchCancel) (*MsgBatchCancelResponse, error)
+	// BatchPlaceOrder allows accounts to place a batch of short term orders on
+	// the orderbook.
+	BatchPlaceOrder(context.Context, *MsgBatchPlaceOrder) (*MsgBatchPlaceOrderResponse, error)
+	// ReplaceOrder allows accounts to atomically replace an existing stateful
+	// order on the orderbook.
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
+	// CancelAllOrders allows accounts to cancel all of their open orders on the
+	// orderbook, optionally filtered by clob pair.
+	CancelAllOrders(context.Context, *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error)
+	// Heartbeat arms, refreshes or disarms the dead man's switch of a
+	// subaccount.
+	Heartbeat(context.Context, *MsgHeartbeat) (*MsgHeartbeatResponse, error)
+	// SetMarketMakerProtectionConfig sets or clears the market-maker protection
+	// settings of a subaccount on a clob pair.
+	SetMarketMakerProtectionConfig(context.Context, *MsgSetMarketMakerProtectionConfig) (*MsgSetMarketMakerProtectionConfigResponse, error)
+	// ResetMarketMakerProtection resets tripped market-maker protection of a
+	// subaccount on a clob pair.
+	ResetMarketMakerProtection(context.Context, *MsgResetMarketMakerProtection) (*MsgResetMarketMakerProtectionResponse, error)
+	// CreateClobPair creates a new clob pair.
+	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
+	// UpdateClobPair sets the status of a clob pair. Should return an error
+	// if the authority is not in the clob keeper's set of authorities,
+	// if the ClobPair id is not found in state, or if the update includes
+	// an unsupported status transition.
+	UpdateClobPair(context.Context, *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error)
+	// UpdateEquityTierLimitConfiguration updates the equity tier limit
+	// configuration in state.
+	UpdateEquityTierLimitConfiguration(context.Context, *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error)
+	// UpdateBlockRateLimitConfiguration updates the block rate limit
+	// configuration in state.
+	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
+	// UpdateLiquidationsConfig updates the liquidations configuration in state.
+	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ProposedOperations(ctx context.Context, req *MsgProposedOperations) (*MsgProposedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposedOperations not implemented")
+}
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchCancel(ctx context.Context, req *MsgBatchCancel) (*MsgBatchCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancel not implemented")
+}
+func (*UnimplementedMsgServer) BatchPlaceOrder(ctx context.Context, req *MsgBatchPlaceOrder) (*MsgBatchPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelAllOrders(ctx context.Context, req *MsgCancelAllOrders) (*MsgCancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedMsgServer) Heartbeat(ctx context.Context, req *MsgHeartbeat) (*MsgHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedMsgServer) SetMarketMakerProtectionConfig(ctx context.Context, req *MsgSetMarketMakerProtectionConfig) (*MsgSetMarketMakerProtectionConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketMakerProtectionConfig not implemented")
+}
+func (*UnimplementedMsgServer) ResetMarketMakerProtection(ctx context.Context, req *MsgResetMarketMakerProtection) (*MsgResetMarketMakerProtectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMarketMakerProtection not implemented")
+}
+func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClobPair(ctx context.Context, req *MsgUpdateClobPair) (*MsgUpdateClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPair not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEquityTierLimitConfiguration(ctx context.Context, req *MsgUpdateEquityTierLimitConfiguration) (*MsgUpdateEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Context, req *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockRateLimitConfiguration not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_ProposedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposedOperations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ProposedOperations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ProposedOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposedOperations(ctx, req.(*MsgProposedOperations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_BatchCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCancel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCancel(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchCancel",
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCancel(ctx, req.(*MsgBatchCancel))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_BatchPlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).BatchPlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/BatchPlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchPlaceOrder(ctx, req.(*MsgBatchPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProposedOperations) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.OperationsQueue) > 0 {
-		for _, e := range m.OperationsQueue {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgProposedOperationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ReplaceOrder",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgPlaceOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPlaceOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAllOrders)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAllOrders(ctx, in)
 	}
-	var l int
-	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.GoodTilOneof != nil {
-		n += m.GoodTilOneof.Size()
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CancelAllOrders",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAllOrders(ctx, req.(*MsgCancelAllOrders))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.GoodTilBlock))
-	return n
-}
-func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Heartbeat(ctx, in)
 	}
-	var l int
-	_ = l
-	n += 5
-	return n
-}
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/Heartbeat",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Heartbeat(ctx, req.(*MsgHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBatchCancel) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetMarketMakerProtectionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketMakerProtectionConfig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SubaccountId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.ShortTermCancels) > 0 {
-		for _, e := range m.ShortTermCancels {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketMakerProtectionConfig(ctx, in)
 	}
-	if m.GoodTilBlock != 0 {
-		n += 1 + sovTx(uint64(m.GoodTilBlock))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/SetMarketMakerProtectionConfig",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketMakerProtectionConfig(ctx, req.(*MsgSetMarketMakerProtectionConfig))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrderBatch) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResetMarketMakerProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetMarketMakerProtection)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClobPairId != 0 {
-		n += 1 + sovTx(uint64(m.ClobPairId))
+	if interceptor == nil {
+		return srv.(MsgServer).ResetMarketMakerProtection(ctx, in)
 	}
-	if len(m.ClientIds) > 0 {
-		l = 0
-		for _, e := range m.ClientIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ResetMarketMakerProtection",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetMarketMakerProtection(ctx, req.(*MsgResetMarketMakerProtection))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBatchCancelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ShortTermSucceeded) > 0 {
-		for _, e := range m.ShortTermSucceeded {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClobPair(ctx, in)
 	}
-	if len(m.ShortTermFailed) > 0 {
-		for _, e := range m.ShortTermFailed {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/CreateClobPair",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClobPair(ctx, req.(*MsgCreateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateClobPair) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClobPair)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClobPair(ctx, in)
 	}
-	l = m.ClobPair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgUpdateClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPair",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClobPair(ctx, req.(*MsgUpdateClobPair))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperationRaw) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateEquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEquityTierLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Operation != nil {
-		n += m.Operation.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, in)
 	}
-	return n
-}
-
-func (m *OperationRaw_Match) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateEquityTierLimitConfiguration",
 	}
-	var l int
-	_ = l
-	if m.Match != nil {
-		l = m.Match.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEquityTierLimitConfiguration(ctx, req.(*MsgUpdateEquityTierLimitConfiguration))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_UpdateBlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockRateLimitConfiguration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ShortTermOrderPlacement != nil {
-		l = len(m.ShortTermOrderPlacement)
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, in)
 	}
-	return n
-}
-func (m *OperationRaw_OrderRemoval) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateBlockRateLimitConfiguration",
 	}
-	var l int
-	_ = l
-	if m.OrderRemoval != nil {
-		l = m.OrderRemoval.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockRateLimitConfiguration(ctx, req.(*MsgUpdateBlockRateLimitConfiguration))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLiquidationsConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateLiquidationsConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLiquidationsConfig(ctx, req.(*MsgUpdateLiquidationsConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposedOperations",
+			Handler:    _Msg_ProposedOperations_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "BatchCancel",
+			Handler:    _Msg_BatchCancel_Handler,
+		},
+		{
+			MethodName: "BatchPlaceOrder",
+			Handler:    _Msg_BatchPlaceOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelAllOrders",
+			Handler:    _Msg_CancelAllOrders_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Msg_Heartbeat_Handler,
+		},
+		{
+			MethodName: "SetMarketMakerProtectionConfig",
+			Handler:    _Msg_SetMarketMakerProtectionConfig_Handler,
+		},
+		{
+			MethodName: "ResetMarketMakerProtection",
+			Handler:    _Msg_ResetMarketMakerProtection_Handler,
+		},
+		{
+			MethodName: "CreateClobPair",
+			Handler:    _Msg_CreateClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateClobPair",
+			Handler:    _Msg_UpdateClobPair_Handler,
+		},
+		{
+			MethodName: "UpdateEquityTierLimitConfiguration",
+			Handler:    _Msg_UpdateEquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateBlockRateLimitConfiguration",
+			Handler:    _Msg_UpdateBlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "UpdateLiquidationsConfig",
+			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/clob/tx.proto",
+}
+
+func (m *MsgCreateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposedOperations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposedOperations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposedOperations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperationsQueue) > 0 {
+		for iNdEx := len(m.OperationsQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationsQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposedOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilOneof != nil {
+		{
+			size := m.GoodTilOneof.Size()
+			i -= size
+			if _, err := m.GoodTilOneof.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder_GoodTilBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.GoodTilBlockTime))
+	i--
+	dAtA[i] = 0x1d
+	return len(dAtA) - i, nil
+}
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GoodTilBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GoodTilBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShortTermCancels) > 0 {
+		for iNdEx := len(m.ShortTermCancels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermCancels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientIds) > 0 {
+		dAtA7 := make([]byte, len(m.ClientIds)*10)
+		var j6 int
+		for _, num := range m.ClientIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortTermFailed) > 0 {
+		for iNdEx := len(m.ShortTermFailed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermFailed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ShortTermSucceeded) > 0 {
+		for iNdEx := len(m.ShortTermSucceeded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermSucceeded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortTermOrders) > 0 {
+		for iNdEx := len(m.ShortTermOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShortTermFailed) > 0 {
+		for iNdEx := len(m.ShortTermFailed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermFailed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ShortTermSucceeded) > 0 {
+		for iNdEx := len(m.ShortTermSucceeded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortTermSucceeded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderPlacementFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderPlacementFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderPlacementFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClobPairIds) > 0 {
+		dAtA11 := make([]byte, len(m.ClobPairIds)*10)
+		var j10 int
+		for _, num := range m.ClobPairIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketMakerProtectionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketMakerProtectionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketMakerProtectionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMarketMakerProtectionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMarketMakerProtectionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMarketMakerProtectionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetMarketMakerProtection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetMarketMakerProtection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetMarketMakerProtection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetMarketMakerProtectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetMarketMakerProtectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetMarketMakerProtectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationRaw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRaw_Match) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Match != nil {
+		{
+			size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_ShortTermOrderPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderPlacement != nil {
+		i -= len(m.ShortTermOrderPlacement)
+		copy(dAtA[i:], m.ShortTermOrderPlacement)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ShortTermOrderPlacement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_OrderRemoval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderRemoval != nil {
+		{
+			size, err := m.OrderRemoval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRaw_ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShortTermOrderBatchPlacement != nil {
+		{
+			size, err := m.ShortTermOrderBatchPlacement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShortTermOrderBatchPlacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShortTermOrderBatchPlacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShortTermOrderBatchPlacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIndices) > 0 {
+		dAtA13 := make([]byte, len(m.OrderIndices)*10)
+		var j12 int
+		for _, num := range m.OrderIndices {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposedOperations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperationsQueue) > 0 {
+		for _, e := range m.OperationsQueue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposedOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReplaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.GoodTilOneof != nil {
+		n += m.GoodTilOneof.Size()
+	}
+	return n
+}
+
+func (m *MsgCancelOrder_GoodTilBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.GoodTilBlock))
+	return n
+}
+func (m *MsgCancelOrder_GoodTilBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ShortTermCancels) > 0 {
+		for _, e := range m.ShortTermCancels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.GoodTilBlock != 0 {
+		n += 1 + sovTx(uint64(m.GoodTilBlock))
+	}
+	return n
+}
+
+func (m *OrderBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	if len(m.ClientIds) > 0 {
+		l = 0
+		for _, e := range m.ClientIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgBatchCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShortTermSucceeded) > 0 {
+		for _, e := range m.ShortTermSucceeded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShortTermFailed) > 0 {
+		for _, e := range m.ShortTermFailed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ShortTermOrders) > 0 {
+		for _, e := range m.ShortTermOrders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShortTermSucceeded) > 0 {
+		for _, e := range m.ShortTermSucceeded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ShortTermFailed) > 0 {
+		for _, e := range m.ShortTermFailed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderPlacementFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ClobPairIds) > 0 {
+		l = 0
+		for _, e := range m.ClobPairIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *MsgHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMarketMakerProtectionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMarketMakerProtectionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetMarketMakerProtection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ClobPairId != 0 {
+		n += 1 + sovTx(uint64(m.ClobPairId))
+	}
+	return n
+}
+
+func (m *MsgResetMarketMakerProtectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateClobPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OperationRaw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	return n
+}
+
+func (m *OperationRaw_Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match != nil {
+		l = m.Match.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderPlacement != nil {
+		l = len(m.ShortTermOrderPlacement)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_OrderRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderRemoval != nil {
+		l = m.OrderRemoval.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *OperationRaw_ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortTermOrderBatchPlacement != nil {
+		l = m.ShortTermOrderBatchPlacement.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *ShortTermOrderBatchPlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OrderIndices) > 0 {
+		l = 0
+		for _, e := range m.OrderIndices {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
+			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermCancels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermCancels = append(m.ShortTermCancels, OrderBatch{})
+			if err := m.ShortTermCancels[len(m.ShortTermCancels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+			}
+			m.GoodTilBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClientIds = append(m.ClientIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClientIds) == 0 {
+					m.ClientIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClientIds = append(m.ClientIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2704,17 +5452,17 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermSucceeded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2724,27 +5472,29 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ShortTermSucceeded = append(m.ShortTermSucceeded, &OrderBatch{})
+			if err := m.ShortTermSucceeded[len(m.ShortTermSucceeded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermFailed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2771,7 +5521,8 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShortTermFailed = append(m.ShortTermFailed, &OrderBatch{})
+			if err := m.ShortTermFailed[len(m.ShortTermFailed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2796,7 +5547,7 @@ func (m *MsgCreateClobPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchPlaceOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2819,12 +5570,79 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchPlaceOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermOrders = append(m.ShortTermOrders, Order{})
+			if err := m.ShortTermOrders[len(m.ShortTermOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2846,7 +5664,7 @@ func (m *MsgCreateClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2869,15 +5687,15 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchPlaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationsQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermSucceeded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2904,8 +5722,42 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperationsQueue = append(m.OperationsQueue, OperationRaw{})
-			if err := m.OperationsQueue[len(m.OperationsQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShortTermSucceeded = append(m.ShortTermSucceeded, OrderId{})
+			if err := m.ShortTermSucceeded[len(m.ShortTermSucceeded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortTermFailed = append(m.ShortTermFailed, OrderPlacementFailure{})
+			if err := m.ShortTermFailed[len(m.ShortTermFailed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2930,7 +5782,7 @@ func (m *MsgProposedOperations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
+func (m *OrderPlacementFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2953,12 +5805,77 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderPlacementFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderPlacementFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2980,7 +5897,7 @@ func (m *MsgProposedOperationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAllOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3003,15 +5920,15 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAllOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAllOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3038,10 +5955,86 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClobPairIds = append(m.ClobPairIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClobPairIds) == 0 {
+					m.ClobPairIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClobPairIds = append(m.ClobPairIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3063,7 +6056,7 @@ func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3086,10 +6079,10 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3113,7 +6106,7 @@ func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3136,15 +6129,15 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3171,15 +6164,15 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var v uint32
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3189,23 +6182,11 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint32(b&0x7F) << shift
+				m.TimeoutSeconds |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlock{v}
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GoodTilOneof = &MsgCancelOrder_GoodTilBlockTime{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3227,7 +6208,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3250,10 +6231,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3277,7 +6258,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketMakerProtectionConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3300,10 +6281,10 @@ func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBatchCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMarketMakerProtectionConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBatchCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMarketMakerProtectionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3340,8 +6321,27 @@ func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermCancels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3368,30 +6368,10 @@ func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortTermCancels = append(m.ShortTermCancels, OrderBatch{})
-			if err := m.ShortTermCancels[len(m.ShortTermCancels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlock", wireType)
-			}
-			m.GoodTilBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GoodTilBlock |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3413,7 +6393,7 @@ func (m *MsgBatchCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMarketMakerProtectionConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3429,114 +6409,19 @@ func (m *OrderBatch) Unmarshal(dAtA []byte) error {
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
-			}
-			m.ClobPairId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ClientIds = append(m.ClientIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ClientIds) == 0 {
-					m.ClientIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ClientIds = append(m.ClientIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientIds", wireType)
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMarketMakerProtectionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMarketMakerProtectionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3558,7 +6443,7 @@ func (m *OrderBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetMarketMakerProtection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3581,15 +6466,15 @@ func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBatchCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetMarketMakerProtection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBatchCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetMarketMakerProtection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermSucceeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3616,16 +6501,15 @@ func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortTermSucceeded = append(m.ShortTermSucceeded, &OrderBatch{})
-			if err := m.ShortTermSucceeded[len(m.ShortTermSucceeded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermFailed", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
 			}
-			var msglen int
+			m.ClobPairId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3635,26 +6519,61 @@ func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClobPairId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortTermFailed = append(m.ShortTermFailed, &OrderBatch{})
-			if err := m.ShortTermFailed[len(m.ShortTermFailed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetMarketMakerProtectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetMarketMakerProtectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetMarketMakerProtectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3973,6 +6892,201 @@ func (m *OperationRaw) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &OperationRaw_OrderRemoval{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortTermOrderBatchPlacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShortTermOrderBatchPlacement{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &OperationRaw_ShortTermOrderBatchPlacement{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShortTermOrderBatchPlacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShortTermOrderBatchPlacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIndices = append(m.OrderIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIndices) == 0 {
+					m.OrderIndices = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIndices = append(m.OrderIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIndices", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
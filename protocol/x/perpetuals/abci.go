@@ -15,5 +15,6 @@ func EndBlocker(ctx sdk.Context, k types.PerpetualsKeeper) {
 	// first so that new samples are processed in `MaybeProcessNewFundingTickEpoch`.
 	k.MaybeProcessNewFundingSampleEpoch(ctx)
 	k.MaybeProcessNewFundingTickEpoch(ctx)
+	k.UpdateMarkPrices(ctx)
 	k.SendOIUpdatesToIndexer(ctx)
 }
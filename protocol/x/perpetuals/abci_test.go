@@ -28,6 +28,10 @@ func TestEndBlocker(t *testing.T) {
 					"MaybeProcessNewFundingSampleEpoch",
 					ctx,
 				).Return(nil)
+				mck.On(
+					"UpdateMarkPrices",
+					ctx,
+				)
 				mck.On(
 					"SendOIUpdatesToIndexer",
 					ctx,
@@ -29,7 +29,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryPremiumSamples())
 	cmd.AddCommand(CmdQueryPremiumVotes())
+	cmd.AddCommand(CmdQueryPredictedFunding())
 	cmd.AddCommand(CmdQueryAllLiquidityTiers())
+	cmd.AddCommand(CmdListMarkPrice())
+	cmd.AddCommand(CmdShowMarkPrice())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AllMarkPrices(
+	c context.Context,
+	req *types.QueryAllMarkPricesRequest,
+) (*types.QueryAllMarkPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var markPrices []types.MarkPrice
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	store := ctx.KVStore(k.storeKey)
+	markPriceStore := prefix.NewStore(store, []byte(types.MarkPriceKeyPrefix))
+
+	pageRes, err := query.Paginate(markPriceStore, req.Pagination, func(key []byte, value []byte) error {
+		var markPrice types.MarkPrice
+		if err := k.cdc.Unmarshal(value, &markPrice); err != nil {
+			return err
+		}
+
+		markPrices = append(markPrices, markPrice)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMarkPricesResponse{MarkPrices: markPrices, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MarkPrice(c context.Context, req *types.QueryMarkPriceRequest) (*types.QueryMarkPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	val, found := k.GetMarkPrice(
+		ctx,
+		req.Id,
+	)
+	if !found {
+		return nil,
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf(
+					"Mark price for perpetual id %+v not found.",
+					req.Id,
+				),
+			)
+	}
+
+	return &types.QueryMarkPriceResponse{MarkPrice: val}, nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMarkPrice(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	perp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perp.Params.Id,
+		perp.Params.Ticker,
+		perp.Params.MarketId,
+		perp.Params.AtomicResolution,
+		perp.Params.DefaultFundingPpm,
+		perp.Params.LiquidityTier,
+		perp.Params.MarketType,
+	)
+	require.NoError(t, err)
+	require.NoError(t, pc.PerpetualsKeeper.AddPremiumVotes(
+		pc.Ctx,
+		[]types.FundingPremium{{PerpetualId: perp.Params.Id, PremiumPpm: -1_000}},
+	))
+	pc.PerpetualsKeeper.UpdateMarkPrices(pc.Ctx)
+
+	markPrice := types.MarkPrice{
+		PerpetualId: perp.Params.Id,
+		Price:       4_999_500_000,
+		Exponent:    constants.TestMarketPrices[perp.Params.MarketId].Exponent,
+		BasisPpm:    -100,
+	}
+
+	for name, tc := range map[string]struct {
+		req         *types.QueryMarkPriceRequest
+		res         *types.QueryMarkPriceResponse
+		expectedErr error
+	}{
+		"nil request": {
+			req:         nil,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"found": {
+			req: &types.QueryMarkPriceRequest{Id: perp.Params.Id},
+			res: &types.QueryMarkPriceResponse{MarkPrice: markPrice},
+		},
+		"not found": {
+			req:         &types.QueryMarkPriceRequest{Id: 100},
+			expectedErr: status.Error(codes.NotFound, "Mark price for perpetual id 100 not found."),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := pc.PerpetualsKeeper.MarkPrice(pc.Ctx, tc.req)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		req         *types.QueryAllMarkPricesRequest
+		res         *types.QueryAllMarkPricesResponse
+		expectedErr error
+	}{
+		"nil request": {
+			req:         nil,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"valid request": {
+			req: &types.QueryAllMarkPricesRequest{},
+			res: &types.QueryAllMarkPricesResponse{
+				MarkPrices: []types.MarkPrice{markPrice},
+				Pagination: &query.PageResponse{Total: 1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := pc.PerpetualsKeeper.AllMarkPrices(pc.Ctx, tc.req)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
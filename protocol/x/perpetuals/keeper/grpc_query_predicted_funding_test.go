@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPredictedFunding(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	perp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perp.Params.Id,
+		perp.Params.Ticker,
+		perp.Params.MarketId,
+		perp.Params.AtomicResolution,
+		perp.Params.DefaultFundingPpm,
+		perp.Params.LiquidityTier,
+		perp.Params.MarketType,
+	)
+	require.NoError(t, err)
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingTickEpochInfoName),
+			Duration: 3600,
+			NextTick: 3600,
+		},
+	)
+	require.NoError(t, err)
+	keepertest.PopulateTestPremiumStore(
+		t,
+		pc.Ctx,
+		pc.PerpetualsKeeper,
+		[]types.Perpetual{perp},
+		constants.GenerateConstantFundingPremiums(-2000, 10),
+		false, // isVote
+	)
+
+	tests := map[string]struct {
+		req         *types.QueryPredictedFundingRequest
+		res         *types.QueryPredictedFundingResponse
+		expectedErr error
+	}{
+		"nil request": {
+			req:         nil,
+			res:         nil,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"valid request": {
+			req: &types.QueryPredictedFundingRequest{},
+			res: &types.QueryPredictedFundingResponse{
+				PredictedFunding: []types.PredictedFunding{
+					{
+						PerpetualId:     perp.Params.Id,
+						PremiumPpm:      -2000,
+						FundingRatePpm:  -2000,
+						NextFundingTick: 3600,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := pc.PerpetualsKeeper.PredictedFunding(pc.Ctx, tc.req)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+)
+
+// GetMarkPrice returns the mark price of a perpetual, and whether it exists.
+func (k Keeper) GetMarkPrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	markPrice types.MarkPrice,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarkPriceKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return markPrice, false
+	}
+
+	k.cdc.MustUnmarshal(b, &markPrice)
+	return markPrice, true
+}
+
+// GetAllMarkPrices returns the mark prices of all perpetuals, sorted by perpetual id.
+func (k Keeper) GetAllMarkPrices(ctx sdk.Context) (list []types.MarkPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarkPriceKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var markPrice types.MarkPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &markPrice)
+		list = append(list, markPrice)
+	}
+
+	return list
+}
+
+// setMarkPrice stores the mark price of a perpetual.
+func (k Keeper) setMarkPrice(
+	ctx sdk.Context,
+	markPrice types.MarkPrice,
+) {
+	b := k.cdc.MustMarshal(&markPrice)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarkPriceKeyPrefix))
+	store.Set(lib.Uint32ToKey(markPrice.PerpetualId), b)
+}
+
+// setBlockPremiumVotes records the premium votes added in the current block in the transient store.
+func (k Keeper) setBlockPremiumVotes(
+	ctx sdk.Context,
+	votes []types.FundingPremium,
+) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.BlockPremiumVotesKeyPrefix))
+	for _, vote := range votes {
+		b := k.cdc.MustMarshal(&vote)
+		store.Set(lib.Uint32ToKey(vote.PerpetualId), b)
+	}
+}
+
+// getBlockPremiumVotePpm returns the premium vote of a perpetual added in the current block.
+// Perpetuals without a premium vote have a premium of zero.
+func (k Keeper) getBlockPremiumVotePpm(
+	ctx sdk.Context,
+	perpetualId uint32,
+) int32 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.BlockPremiumVotesKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return 0
+	}
+
+	var vote types.FundingPremium
+	k.cdc.MustUnmarshal(b, &vote)
+	return vote.PremiumPpm
+}
+
+// UpdateMarkPrices computes the mark price of every perpetual from its oracle price and the
+// premium vote of the current block, which is derived from the impact bid and ask prices of
+// the orderbook. The premium vote is smoothed into the basis of the mark price with an
+// exponential moving average, and the basis is bounded by the max basis of the perpetual.
+// Mark prices which changed are written to state and sent to the indexer.
+// This function is called in EndBlocker.
+func (k Keeper) UpdateMarkPrices(ctx sdk.Context) {
+	markPriceUpdates := make([]*indexerevents.MarkPriceUpdate, 0)
+
+	for _, perpetual := range k.GetAllPerpetuals(ctx) {
+		marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+		if err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"UpdateMarkPrices: failed to get market price",
+				err,
+				log.PerpetualId, perpetual.Params.Id,
+			)
+			continue
+		}
+
+		// Skip perpetuals whose oracle price has not been initialized.
+		if marketPrice.Price == 0 {
+			continue
+		}
+
+		prevMarkPrice, found := k.GetMarkPrice(ctx, perpetual.Params.Id)
+		basisPpm := types.GetNextBasisPpm(
+			prevMarkPrice.BasisPpm,
+			k.getBlockPremiumVotePpm(ctx, perpetual.Params.Id),
+			perpetual.Params.GetMarkPriceEmaSmoothingPpm(),
+			perpetual.Params.GetMarkPriceMaxBasisPpm(),
+		)
+		markPrice := types.MarkPrice{
+			PerpetualId: perpetual.Params.Id,
+			Price:       types.GetMarkPriceFromBasis(marketPrice.Price, basisPpm),
+			Exponent:    marketPrice.Exponent,
+			BasisPpm:    basisPpm,
+		}
+		if found && markPrice == prevMarkPrice {
+			continue
+		}
+
+		k.setMarkPrice(ctx, markPrice)
+		markPriceUpdates = append(
+			markPriceUpdates,
+			&indexerevents.MarkPriceUpdate{
+				PerpetualId: markPrice.PerpetualId,
+				Price:       markPrice.Price,
+				BasisPpm:    markPrice.BasisPpm,
+			},
+		)
+	}
+
+	if len(markPriceUpdates) == 0 {
+		return
+	}
+
+	k.GetIndexerEventManager().AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeMarkPriceUpdate,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.MarkPriceUpdateEventVersion,
+		indexer_manager.GetBytes(
+			&indexerevents.MarkPriceUpdateEventV1{
+				MarkPriceUpdates: markPriceUpdates,
+			},
+		),
+	)
+}
+
+// GetPerpetualAndRiskPrice retrieves a Perpetual by its id and the price used to determine whether
+// positions in the perpetual can be liquidated. The risk price is the mark price of the perpetual if
+// the mark price is enabled and has been computed, and the oracle price otherwise.
+func (k Keeper) GetPerpetualAndRiskPrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (types.Perpetual, pricestypes.MarketPrice, error) {
+	perpetual, marketPrice, err := k.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if err != nil {
+		return perpetual, marketPrice, err
+	}
+
+	// The mark price is the zero value if it has not been computed.
+	markPrice, _ := k.GetMarkPrice(ctx, perpetualId)
+	return perpetual, GetRiskPrice(perpetual, marketPrice, markPrice), nil
+}
+
+// GetRiskPrice returns the risk price of a perpetual given its oracle price and mark price. This is
+// the mark price if the perpetual enables it and the mark price has been computed, and the oracle
+// price otherwise.
+//
+// Note that this is a stateless function.
+func GetRiskPrice(
+	perpetual types.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+	markPrice types.MarkPrice,
+) pricestypes.MarketPrice {
+	if !perpetual.Params.IsMarkPriceEnabled() ||
+		markPrice.Price == 0 ||
+		markPrice.Exponent != marketPrice.Exponent {
+		return marketPrice
+	}
+
+	marketPrice.Price = markPrice.Price
+	return marketPrice
+}
+
+// GetNetCollateralAtRiskPrice returns the net collateral in quote quantums of a position, valued
+// at the risk price of the perpetual. See `GetPerpetualAndRiskPrice` for details.
+func (k Keeper) GetNetCollateralAtRiskPrice(
+	ctx sdk.Context,
+	id uint32,
+	bigQuantums *big.Int,
+) (
+	bigNetCollateralQuoteQuantums *big.Int,
+	err error,
+) {
+	perpetual, riskPrice, err := k.GetPerpetualAndRiskPrice(ctx, id)
+	if err != nil {
+		return new(big.Int), err
+	}
+
+	return GetNetNotionalInQuoteQuantums(perpetual, riskPrice, bigQuantums), nil
+}
+
+// GetMarginRequirementsAtRiskPrice returns initial and maintenance margin requirements in quote
+// quantums of a position, valued at the risk price of the perpetual. See `GetPerpetualAndRiskPrice`
+// for details.
+func (k Keeper) GetMarginRequirementsAtRiskPrice(
+	ctx sdk.Context,
+	id uint32,
+	bigQuantums *big.Int,
+) (
+	bigInitialMarginQuoteQuantums *big.Int,
+	bigMaintenanceMarginQuoteQuantums *big.Int,
+	err error,
+) {
+	perpetual, riskPrice, err := k.GetPerpetualAndRiskPrice(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.getMarginRequirementsAtPrice(ctx, perpetual, riskPrice, bigQuantums)
+}
@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+// getMarkPriceUpdateEventsFromIndexerBlock returns all mark price update events from the indexer block.
+func getMarkPriceUpdateEventsFromIndexerBlock(
+	ctx sdk.Context,
+	perpetualsKeeper *keeper.Keeper,
+) []*indexerevents.MarkPriceUpdateEventV1 {
+	block := perpetualsKeeper.GetIndexerEventManager().ProduceBlock(ctx)
+	var markPriceUpdateEvents []*indexerevents.MarkPriceUpdateEventV1
+	for _, event := range block.Events {
+		if event.Subtype != indexerevents.SubtypeMarkPriceUpdate {
+			continue
+		}
+		if _, ok := event.OrderingWithinBlock.(*indexer_manager.IndexerTendermintEvent_BlockEvent_); ok {
+			var markPriceUpdateEvent indexerevents.MarkPriceUpdateEventV1
+			err := proto.Unmarshal(event.DataBytes, &markPriceUpdateEvent)
+			if err != nil {
+				panic(err)
+			}
+			markPriceUpdateEvents = append(markPriceUpdateEvents, &markPriceUpdateEvent)
+		}
+	}
+	return markPriceUpdateEvents
+}
+
+func TestUpdateMarkPrices(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	btcPerp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	ethPerp := constants.EthUsd_0DefaultFunding_9AtomicResolution
+	for _, p := range []types.Perpetual{btcPerp, ethPerp} {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			pc.Ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+	// ETH uses the mark price with a faster moving basis, which is bounded by 0.2%.
+	_, err := pc.PerpetualsKeeper.SetPerpetualMarkPriceConfig(
+		pc.Ctx,
+		ethPerp.Params.Id,
+		&types.MarkPriceConfig{
+			Enabled:         true,
+			EmaSmoothingPpm: 500_000,
+			MaxBasisPpm:     2_000,
+		},
+	)
+	require.NoError(t, err)
+
+	btcOraclePrice := constants.TestMarketPrices[btcPerp.Params.MarketId]
+	ethOraclePrice := constants.TestMarketPrices[ethPerp.Params.MarketId]
+
+	// Mark prices are not computed yet, so the oracle price is the risk price.
+	_, found := pc.PerpetualsKeeper.GetMarkPrice(pc.Ctx, ethPerp.Params.Id)
+	require.False(t, found)
+	_, riskPrice, err := pc.PerpetualsKeeper.GetPerpetualAndRiskPrice(pc.Ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, ethOraclePrice, riskPrice)
+
+	// The basis moves towards the premium votes of the block.
+	require.NoError(t, pc.PerpetualsKeeper.AddPremiumVotes(
+		pc.Ctx,
+		[]types.FundingPremium{
+			{PerpetualId: btcPerp.Params.Id, PremiumPpm: 1_000},
+			{PerpetualId: ethPerp.Params.Id, PremiumPpm: 3_000},
+		},
+	))
+	pc.PerpetualsKeeper.UpdateMarkPrices(pc.Ctx)
+
+	expectedBtcMarkPrice := types.MarkPrice{
+		PerpetualId: btcPerp.Params.Id,
+		Price:       5_000_500_000,
+		Exponent:    btcOraclePrice.Exponent,
+		BasisPpm:    100,
+	}
+	expectedEthMarkPrice := types.MarkPrice{
+		PerpetualId: ethPerp.Params.Id,
+		Price:       3_004_500_000,
+		Exponent:    ethOraclePrice.Exponent,
+		BasisPpm:    1_500,
+	}
+	require.Equal(
+		t,
+		[]types.MarkPrice{expectedBtcMarkPrice, expectedEthMarkPrice},
+		pc.PerpetualsKeeper.GetAllMarkPrices(pc.Ctx),
+	)
+
+	// The mark price is only the risk price of perpetuals which enable it.
+	_, riskPrice, err = pc.PerpetualsKeeper.GetPerpetualAndRiskPrice(pc.Ctx, btcPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, btcOraclePrice, riskPrice)
+	_, riskPrice, err = pc.PerpetualsKeeper.GetPerpetualAndRiskPrice(pc.Ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	expectedEthRiskPrice := pricestypes.MarketPrice{
+		Id:       ethOraclePrice.Id,
+		Exponent: ethOraclePrice.Exponent,
+		Price:    expectedEthMarkPrice.Price,
+	}
+	require.Equal(t, expectedEthRiskPrice, riskPrice)
+
+	// Collateral and margin requirements at the risk price use the mark price.
+	bigQuantums := big.NewInt(-1_000_000_000)
+	ethPerp, err = pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	netCollateral, err := pc.PerpetualsKeeper.GetNetCollateralAtRiskPrice(pc.Ctx, ethPerp.Params.Id, bigQuantums)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		keeper.GetNetNotionalInQuoteQuantums(ethPerp, expectedEthRiskPrice, bigQuantums),
+		netCollateral,
+	)
+	liquidityTier, err := pc.PerpetualsKeeper.GetLiquidityTier(pc.Ctx, ethPerp.Params.LiquidityTier)
+	require.NoError(t, err)
+	expectedInitialMargin, expectedMaintenanceMargin := keeper.GetMarginRequirementsInQuoteQuantums(
+		ethPerp,
+		expectedEthRiskPrice,
+		liquidityTier,
+		bigQuantums,
+	)
+	initialMargin, maintenanceMargin, err := pc.PerpetualsKeeper.GetMarginRequirementsAtRiskPrice(
+		pc.Ctx,
+		ethPerp.Params.Id,
+		bigQuantums,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedInitialMargin, initialMargin)
+	require.Equal(t, expectedMaintenanceMargin, maintenanceMargin)
+
+	// The BTC basis is unchanged, and the ETH basis is bounded by its max basis.
+	require.NoError(t, pc.PerpetualsKeeper.AddPremiumVotes(
+		pc.Ctx,
+		[]types.FundingPremium{
+			{PerpetualId: btcPerp.Params.Id, PremiumPpm: 100},
+			{PerpetualId: ethPerp.Params.Id, PremiumPpm: 3_000},
+		},
+	))
+	pc.PerpetualsKeeper.UpdateMarkPrices(pc.Ctx)
+
+	expectedEthMarkPrice.Price = 3_006_000_000
+	expectedEthMarkPrice.BasisPpm = 2_000
+	require.Equal(
+		t,
+		[]types.MarkPrice{expectedBtcMarkPrice, expectedEthMarkPrice},
+		pc.PerpetualsKeeper.GetAllMarkPrices(pc.Ctx),
+	)
+
+	// Only mark prices which changed are sent to the indexer.
+	require.Equal(
+		t,
+		[]*indexerevents.MarkPriceUpdateEventV1{
+			{
+				MarkPriceUpdates: []*indexerevents.MarkPriceUpdate{
+					{PerpetualId: btcPerp.Params.Id, Price: 5_000_500_000, BasisPpm: 100},
+					{PerpetualId: ethPerp.Params.Id, Price: 3_004_500_000, BasisPpm: 1_500},
+				},
+			},
+			{
+				MarkPriceUpdates: []*indexerevents.MarkPriceUpdate{
+					{PerpetualId: ethPerp.Params.Id, Price: 3_006_000_000, BasisPpm: 2_000},
+				},
+			},
+		},
+		getMarkPriceUpdateEventsFromIndexerBlock(pc.Ctx, pc.PerpetualsKeeper),
+	)
+}
+
+func TestGetRiskPrice(t *testing.T) {
+	oraclePrice := pricestypes.MarketPrice{
+		Id:       0,
+		Exponent: -5,
+		Price:    5_000_000_000,
+	}
+	markPriceEnabled := constants.BtcUsd_100PercentMarginRequirement
+	markPriceEnabled.Params.MarkPriceConfig = &types.MarkPriceConfig{Enabled: true}
+
+	tests := map[string]struct {
+		perpetual         types.Perpetual
+		markPrice         types.MarkPrice
+		expectedRiskPrice uint64
+	}{
+		"mark price is not enabled": {
+			perpetual:         constants.BtcUsd_100PercentMarginRequirement,
+			markPrice:         types.MarkPrice{Price: 5_100_000_000, Exponent: -5},
+			expectedRiskPrice: 5_000_000_000,
+		},
+		"mark price has not been computed": {
+			perpetual:         markPriceEnabled,
+			markPrice:         types.MarkPrice{},
+			expectedRiskPrice: 5_000_000_000,
+		},
+		"mark price exponent does not match the oracle price exponent": {
+			perpetual:         markPriceEnabled,
+			markPrice:         types.MarkPrice{Price: 5_100_000_000, Exponent: -6},
+			expectedRiskPrice: 5_000_000_000,
+		},
+		"mark price is enabled": {
+			perpetual:         markPriceEnabled,
+			markPrice:         types.MarkPrice{Price: 5_100_000_000, Exponent: -5},
+			expectedRiskPrice: 5_100_000_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			riskPrice := keeper.GetRiskPrice(tc.perpetual, oraclePrice, tc.markPrice)
+			require.Equal(t, oraclePrice.Id, riskPrice.Id)
+			require.Equal(t, oraclePrice.Exponent, riskPrice.Exponent)
+			require.Equal(t, tc.expectedRiskPrice, riskPrice.Price)
+		})
+	}
+}
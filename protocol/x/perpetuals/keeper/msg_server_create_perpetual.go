@@ -38,5 +38,25 @@ func (k msgServer) CreatePerpetual(
 		return &types.MsgCreatePerpetualResponse{}, err
 	}
 
+	if msg.Params.FundingConfig != nil {
+		if _, err := k.Keeper.SetPerpetualFundingConfig(
+			ctx,
+			msg.Params.Id,
+			msg.Params.FundingConfig,
+		); err != nil {
+			return &types.MsgCreatePerpetualResponse{}, err
+		}
+	}
+
+	if msg.Params.MarkPriceConfig != nil {
+		if _, err := k.Keeper.SetPerpetualMarkPriceConfig(
+			ctx,
+			msg.Params.Id,
+			msg.Params.MarkPriceConfig,
+		); err != nil {
+			return &types.MsgCreatePerpetualResponse{}, err
+		}
+	}
+
 	return &types.MsgCreatePerpetualResponse{}, nil
 }
@@ -30,6 +30,14 @@ func TestCreatePerpetual(t *testing.T) {
 		perptest.WithMarketId(2),
 		perptest.WithMarketType(types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_ISOLATED),
 	)
+	testPerpFundingConfig := *perptest.GeneratePerpetual(
+		perptest.WithId(4),
+		perptest.WithMarketId(1),
+		perptest.WithFundingConfig(&types.PerpetualFundingConfig{
+			IntervalMultiplier:        8,
+			FundingRateClampFactorPpm: 1_000_000,
+		}),
+	)
 	testMarket1 := *pricestest.GenerateMarketParamPrice(pricestest.WithId(1))
 	testMarket2 := *pricestest.GenerateMarketParamPrice(pricestest.WithId(2))
 	testCases := map[string]struct {
@@ -71,6 +79,22 @@ func TestCreatePerpetual(t *testing.T) {
 			},
 			expectedPerpetuals: []types.Perpetual{testPerp1, testPerp2},
 		},
+		"Succeeds: create new perpetual with funding config": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestLiquidityTiers(t, ctx, perpKeeper)
+				keepertest.CreateTestPriceMarkets(
+					t,
+					ctx,
+					pricesKeeper,
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgCreatePerpetual{
+				Authority: lib.GovModuleAddress.String(),
+				Params:    testPerpFundingConfig.Params,
+			},
+			expectedPerpetuals: []types.Perpetual{testPerpFundingConfig},
+		},
 		"Succeeds: create new isolated market perpetual": {
 			setup: func(
 				t *testing.T,
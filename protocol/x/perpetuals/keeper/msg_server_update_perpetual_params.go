@@ -23,6 +23,22 @@ func (k msgServer) UpdatePerpetualParams(
 
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
 
+	if _, err := k.Keeper.SetPerpetualFundingConfig(
+		ctx,
+		msg.PerpetualParams.Id,
+		msg.PerpetualParams.FundingConfig,
+	); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.SetPerpetualMarkPriceConfig(
+		ctx,
+		msg.PerpetualParams.Id,
+		msg.PerpetualParams.MarkPriceConfig,
+	); err != nil {
+		return nil, err
+	}
+
 	_, err := k.Keeper.ModifyPerpetual(
 		ctx,
 		msg.PerpetualParams.Id,
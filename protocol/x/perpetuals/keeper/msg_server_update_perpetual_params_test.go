@@ -79,6 +79,115 @@ func TestUpdatePerpetualParams(t *testing.T) {
 				},
 			},
 		},
+		"Success: set funding config": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: lib.GovModuleAddress.String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					FundingConfig: &types.PerpetualFundingConfig{
+						IntervalMultiplier:        8,
+						RemovedTailSampleRatioPpm: 50_000,
+					},
+				},
+			},
+		},
+		"Failure: invalid funding config": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: lib.GovModuleAddress.String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					FundingConfig: &types.PerpetualFundingConfig{
+						IntervalMultiplier: types.MaxFundingIntervalMultiplier + 1,
+					},
+				},
+			},
+			expectedErr: "Funding config is invalid",
+		},
+		"Success: set mark price config": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: lib.GovModuleAddress.String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					MarkPriceConfig: &types.MarkPriceConfig{
+						Enabled:         true,
+						EmaSmoothingPpm: 200_000,
+						MaxBasisPpm:     5_000,
+					},
+				},
+			},
+		},
+		"Failure: invalid mark price config": {
+			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
+				keepertest.CreateTestPricesAndPerpetualMarkets(
+					t,
+					ctx,
+					perpKeeper,
+					pricesKeeper,
+					[]types.Perpetual{testPerp},
+					[]pricestypes.MarketParamPrice{testMarket1},
+				)
+			},
+			msg: &types.MsgUpdatePerpetualParams{
+				Authority: lib.GovModuleAddress.String(),
+				PerpetualParams: types.PerpetualParams{
+					Id:                testPerp.Params.Id,
+					Ticker:            testPerp.Params.Ticker,
+					MarketId:          testPerp.Params.MarketId,
+					AtomicResolution:  testPerp.Params.AtomicResolution,
+					DefaultFundingPpm: testPerp.Params.DefaultFundingPpm,
+					LiquidityTier:     testPerp.Params.LiquidityTier,
+					MarkPriceConfig: &types.MarkPriceConfig{
+						MaxBasisPpm: types.MaxMarkPriceBasisPpm + 1,
+					},
+				},
+			},
+			expectedErr: "Mark price config is invalid",
+		},
 		"Failure: updates a non-existing perpetual ID": {
 			setup: func(t *testing.T, ctx sdk.Context, perpKeeper *perpkeeper.Keeper, pricesKeeper *priceskeeper.Keeper) {
 				keepertest.CreateTestPricesAndPerpetualMarkets(
@@ -207,6 +316,8 @@ func TestUpdatePerpetualParams(t *testing.T) {
 					updatedPerpetualInState.Params.AtomicResolution,
 				)
 				require.Equal(t, testPerp.Params.MarketType, updatedPerpetualInState.Params.MarketType)
+				require.Equal(t, tc.msg.PerpetualParams.FundingConfig, updatedPerpetualInState.Params.FundingConfig)
+				require.Equal(t, tc.msg.PerpetualParams.MarkPriceConfig, updatedPerpetualInState.Params.MarkPriceConfig)
 			}
 		})
 	}
@@ -213,6 +213,55 @@ func (k Keeper) SetPerpetualMarketType(
 	return perpetual, nil
 }
 
+// SetPerpetualFundingConfig sets the funding config of an existing perpetual. A nil
+// `fundingConfig` resets the perpetual to the module-wide funding parameters.
+func (k Keeper) SetPerpetualFundingConfig(
+	ctx sdk.Context,
+	perpetualId uint32,
+	fundingConfig *types.PerpetualFundingConfig,
+) (types.Perpetual, error) {
+	// Get perpetual.
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return perpetual, err
+	}
+
+	// Modify perpetual.
+	perpetual.Params.FundingConfig = fundingConfig
+
+	// Store the modified perpetual.
+	if err := k.ValidateAndSetPerpetual(ctx, perpetual); err != nil {
+		return types.Perpetual{}, err
+	}
+
+	return perpetual, nil
+}
+
+// SetPerpetualMarkPriceConfig sets the mark price config of an existing perpetual. A nil
+// `markPriceConfig` resets the perpetual to the module-wide mark price defaults, under which
+// the mark price is not used.
+func (k Keeper) SetPerpetualMarkPriceConfig(
+	ctx sdk.Context,
+	perpetualId uint32,
+	markPriceConfig *types.MarkPriceConfig,
+) (types.Perpetual, error) {
+	// Get perpetual.
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return perpetual, err
+	}
+
+	// Modify perpetual.
+	perpetual.Params.MarkPriceConfig = markPriceConfig
+
+	// Store the modified perpetual.
+	if err := k.ValidateAndSetPerpetual(ctx, perpetual); err != nil {
+		return types.Perpetual{}, err
+	}
+
+	return perpetual, nil
+}
+
 // GetPerpetual returns a perpetual from its id.
 func (k Keeper) GetPerpetual(
 	ctx sdk.Context,
@@ -257,8 +306,8 @@ func (k Keeper) GetAllPerpetuals(ctx sdk.Context) (list []types.Perpetual) {
 // or `PremiumVotes`.
 // - `combineFunc`: a function that converts a list of premium values into one
 // premium value (e.g. average or median)
-// - `filterFunc`: a function that takes in a list of premium values and filter
-// out some values.
+// - `filterFunc`: a function that takes in a perpetual Id and its list of premium
+// values and filter out some values.
 // - `minNumPremiumsRequired`: minimum number of premium values required for each
 // market. Padding will be added if `NumPremiums < minNumPremiumsRequired`.
 func (k Keeper) processStoredPremiums(
@@ -267,7 +316,7 @@ func (k Keeper) processStoredPremiums(
 	premiumKey string,
 	minNumPremiumsRequired uint32,
 	combineFunc func([]int32) int32,
-	filterFunc func([]int32) []int32,
+	filterFunc func(perpetualId uint32, premiums []int32) []int32,
 ) (
 	perpIdToPremium map[uint32]int32,
 ) {
@@ -320,7 +369,9 @@ func (k Keeper) processStoredPremiums(
 		padding := make([]int32, lenPadding)
 		paddedPremiums := append(marketPremiums.Premiums, padding...)
 
-		perpIdToPremium[marketPremiums.PerpetualId] = combineFunc(filterFunc(paddedPremiums))
+		perpIdToPremium[marketPremiums.PerpetualId] = combineFunc(
+			filterFunc(marketPremiums.PerpetualId, paddedPremiums),
+		)
 	}
 
 	return perpIdToPremium
@@ -346,8 +397,8 @@ func (k Keeper) processPremiumVotesIntoSamples(
 		// `MustGetMedian` panics when the padded list is empty, which breaks the invariant that
 		// Max(premiumStore.NumPremiums, minNumPremiumsRequired) > 0.
 		// See details in implementation of `processStoredPremiums`.
-		lib.MustGetMedian[int32],                     // combineFunc
-		func(input []int32) []int32 { return input }, // filterFunc
+		lib.MustGetMedian[int32],                               // combineFunc
+		func(_ uint32, input []int32) []int32 { return input }, // filterFunc
 	)
 
 	newSamples := []types.FundingPremium{}
@@ -608,6 +659,81 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 	}
 }
 
+// getRemoveSampleTailsFuncForPerpetuals returns a function that removes the tails of the premium
+// samples of a perpetual, according to the removed tail sample ratio of the perpetual.
+func (k Keeper) getRemoveSampleTailsFuncForPerpetuals(
+	ctx sdk.Context,
+	perpetuals []types.Perpetual,
+) func(perpetualId uint32, premiums []int32) []int32 {
+	perpIdToTailRemovalRatePpm := make(map[uint32]uint32, len(perpetuals))
+	for _, perp := range perpetuals {
+		perpIdToTailRemovalRatePpm[perp.Params.Id] = perp.Params.GetRemovedTailSampleRatioPpm()
+	}
+
+	return func(perpetualId uint32, premiums []int32) []int32 {
+		tailRemovalRatePpm, found := perpIdToTailRemovalRatePpm[perpetualId]
+		if !found {
+			tailRemovalRatePpm = types.RemovedTailSampleRatioPpm
+		}
+		return k.GetRemoveSampleTailsFunc(ctx, tailRemovalRatePpm)(premiums)
+	}
+}
+
+// getFundingRatePpm returns the clamped 8-hour funding rate of a perpetual given its premium rate.
+// The funding rate is the premium rate adjusted by the interest rate component of the perpetual,
+// plus the default funding rate of the perpetual.
+func (k Keeper) getFundingRatePpm(
+	ctx sdk.Context,
+	perp types.Perpetual,
+	premiumPpm int32,
+	params types.Params,
+) (*big.Int, error) {
+	bigFundingRatePpm := perp.Params.FundingConfig.ApplyInterestRate(
+		new(big.Int).SetInt64(int64(premiumPpm)),
+	)
+
+	// funding rate = premium + default funding
+	bigFundingRatePpm.Add(
+		bigFundingRatePpm,
+		new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
+	)
+
+	liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return an error if maintenance fraction ppm is larger than its maximum value.
+	if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
+		return nil, errorsmod.Wrapf(
+			types.ErrMaintenanceFractionPpmExceedsMax,
+			"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
+			perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
+		)
+	}
+
+	// Clamp funding rate according to equation:
+	// |R| <= clamp_factor * (initial margin - maintenance margin)
+	fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(
+		perp.Params.GetFundingRateClampFactorPpm(params.FundingRateClampFactorPpm),
+	)
+	bigFundingRatePpm = lib.BigIntClamp(
+		bigFundingRatePpm,
+		new(big.Int).Neg(fundingRateUpperBoundPpm),
+		fundingRateUpperBoundPpm,
+	)
+
+	if bigFundingRatePpm.Cmp(lib.BigMaxInt32()) > 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrFundingRateInt32Overflow,
+			"perpetual Id = (%d), funding rate = (%v)",
+			perp.Params.Id, bigFundingRatePpm,
+		)
+	}
+
+	return bigFundingRatePpm, nil
+}
+
 // MaybeProcessNewFundingTickEpoch processes funding ticks if the current block
 // is the start of a new funding-tick epoch. Otherwise, do nothing.
 func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
@@ -637,27 +763,17 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 		fundingSampleEpochInfo.Duration,
 	)
 
-	// TODO(DEC-1449): Read `RemovedTailSampleRatioPpm` from state. Determine initial value.
-	// This value should be 0% or some low value like 5%, since we already has a layer of
-	// filtering we compute samples as median of premium votes.
-	tailRemovalRatePpm := types.RemovedTailSampleRatioPpm
-
-	// Get `sampleTailsRemovalFunc` which removes a percentage of top and bottom samples
-	// from the input after sorting.
-
-	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, tailRemovalRatePpm)
-
 	// Process stored samples from last `funding-tick` epoch, and retrieve
 	// a mapping from `perpetualId` to summarized premium rate for this epoch.
-	// For premiums, we first remove a fixed amount of bottom/top samples, then
-	// take the average of the remaining samples.
+	// For premiums, we first remove the configured ratio of bottom/top samples of
+	// each perpetual, then take the average of the remaining samples.
 	perpIdToPremiumPpm := k.processStoredPremiums(
 		ctx,
 		fundingTickEpochInfo,
 		types.PremiumSamplesKey,
 		minSampleRequiredForPremiumRate,
-		lib.AvgInt32,           // combineFunc
-		sampleTailsRemovalFunc, // filterFunc
+		lib.AvgInt32, // combineFunc
+		k.getRemoveSampleTailsFuncForPerpetuals(ctx, allPerps), // filterFunc
 	)
 
 	newFundingRatesAndIndicesForEvent := []indexerevents.FundingUpdateV1{}
@@ -677,37 +793,21 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 			premiumPpm = 0
 		}
 
-		bigFundingRatePpm := new(big.Int).SetInt64(int64(premiumPpm))
-
-		// funding rate = premium + default funding
-		bigFundingRatePpm.Add(
-			bigFundingRatePpm,
-			new(big.Int).SetInt64(int64(perp.Params.DefaultFundingPpm)),
-		)
+		// Perpetuals with a funding interval spanning multiple `funding-tick` epochs accumulate
+		// the premium rates of each epoch, and are only charged funding once the interval has
+		// elapsed. The premium rate charged is the average of the accumulated premium rates.
+		premiumsPpm := append(k.getPendingFundingPremiums(ctx, perp.Params.Id), premiumPpm)
+		if uint32(len(premiumsPpm)) < perp.Params.GetFundingIntervalMultiplier() {
+			k.setPendingFundingPremiums(ctx, perp.Params.Id, premiumsPpm)
+			continue
+		}
+		k.deletePendingFundingPremiums(ctx, perp.Params.Id)
 
-		liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
+		bigFundingRatePpm, err := k.getFundingRatePpm(ctx, perp, lib.AvgInt32(premiumsPpm), params)
 		if err != nil {
 			panic(err)
 		}
 
-		// Panic if maintenance fraction ppm is larger than its maximum value.
-		if liquidityTier.MaintenanceFractionPpm > types.MaxMaintenanceFractionPpm {
-			panic(errorsmod.Wrapf(
-				types.ErrMaintenanceFractionPpmExceedsMax,
-				"perpetual Id = (%d), liquidity tier Id = (%d), maintenance fraction ppm = (%v)",
-				perp.Params.Id, perp.Params.LiquidityTier, liquidityTier.MaintenanceFractionPpm,
-			))
-		}
-
-		// Clamp funding rate according to equation:
-		// |R| <= clamp_factor * (initial margin - maintenance margin)
-		fundingRateUpperBoundPpm := liquidityTier.GetMaxAbsFundingClampPpm(params.FundingRateClampFactorPpm)
-		bigFundingRatePpm = lib.BigIntClamp(
-			bigFundingRatePpm,
-			new(big.Int).Neg(fundingRateUpperBoundPpm),
-			fundingRateUpperBoundPpm,
-		)
-
 		// Emit clamped funding rate.
 		telemetry.SetGaugeWithLabels(
 			[]string{
@@ -723,14 +823,6 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 			},
 		)
 
-		if bigFundingRatePpm.Cmp(lib.BigMaxInt32()) > 0 {
-			panic(errorsmod.Wrapf(
-				types.ErrFundingRateInt32Overflow,
-				"perpetual Id = (%d), funding rate = (%v)",
-				perp.Params.Id, bigFundingRatePpm,
-			))
-		}
-
 		// Update the funding index if the funding rate is non-zero.
 		if bigFundingRatePpm.Sign() != 0 {
 			// Get the price of the perpetual from state.
@@ -744,10 +836,11 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 				perp,
 				marketPrice,
 				bigFundingRatePpm,
-				// use funding-tick duration as `timeSinceLastFunding`
+				// use the duration of all `funding-tick` epochs in the funding interval
+				// as `timeSinceLastFunding`
 				// TODO(DEC-1483): Handle the case when duration value is updated
 				// during the epoch.
-				fundingTickEpochInfo.Duration,
+				fundingTickEpochInfo.Duration*uint32(len(premiumsPpm)),
 			)
 
 			// Update the funding index in state.
@@ -782,6 +875,56 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 	k.SetEmptyPremiumSamples(ctx)
 }
 
+// GetPredictedFundings returns the funding rate each perpetual is expected to be charged at its
+// next funding payment, based on the premium samples collected during the current `funding-tick`
+// epoch. Unlike when processing a funding tick, the premium samples are not padded to the expected
+// number of samples per `funding-tick` epoch, since the remaining samples are yet to be collected.
+// Does not make any changes to state.
+func (k Keeper) GetPredictedFundings(ctx sdk.Context) ([]types.PredictedFunding, error) {
+	allPerps := k.GetAllPerpetuals(ctx)
+	params := k.GetParams(ctx)
+	fundingTickEpochInfo := k.epochsKeeper.MustGetFundingTickEpochInfo(ctx)
+
+	perpIdToPremiumPpm := k.processStoredPremiums(
+		ctx,
+		fundingTickEpochInfo,
+		types.PremiumSamplesKey,
+		0,            // minNumPremiumsRequired
+		lib.AvgInt32, // combineFunc
+		k.getRemoveSampleTailsFuncForPerpetuals(ctx, allPerps), // filterFunc
+	)
+
+	predictedFundings := make([]types.PredictedFunding, 0, len(allPerps))
+	for _, perp := range allPerps {
+		premiumsPpm := append(
+			k.getPendingFundingPremiums(ctx, perp.Params.Id),
+			perpIdToPremiumPpm[perp.Params.Id],
+		)
+		premiumPpm := lib.AvgInt32(premiumsPpm)
+
+		bigFundingRatePpm, err := k.getFundingRatePpm(ctx, perp, premiumPpm, params)
+		if err != nil {
+			return nil, err
+		}
+
+		// The funding payment happens at the end of the funding interval of the perpetual.
+		nextFundingTick := fundingTickEpochInfo.NextTick
+		numEpochsInInterval := perp.Params.GetFundingIntervalMultiplier()
+		if numEpochs := uint32(len(premiumsPpm)); numEpochs < numEpochsInInterval {
+			nextFundingTick += (numEpochsInInterval - numEpochs) * fundingTickEpochInfo.Duration
+		}
+
+		predictedFundings = append(predictedFundings, types.PredictedFunding{
+			PerpetualId:     perp.Params.Id,
+			PremiumPpm:      premiumPpm,
+			FundingRatePpm:  int32(bigFundingRatePpm.Int64()),
+			NextFundingTick: nextFundingTick,
+		})
+	}
+
+	return predictedFundings, nil
+}
+
 // GetNetNotional returns the net notional in quote quantums, which can be represented by the following equation:
 // `quantums / 10^baseAtomicResolution * marketPrice * 10^marketExponent * 10^quoteAtomicResolution`.
 // Note that longs are positive, and shorts are negative.
@@ -939,6 +1082,24 @@ func (k Keeper) GetMarginRequirements(
 	if err != nil {
 		return nil, nil, err
 	}
+
+	return k.getMarginRequirementsAtPrice(ctx, perpetual, marketPrice, bigQuantums)
+}
+
+// getMarginRequirementsAtPrice returns initial and maintenance margin requirements in quote quantums
+// of a position in `perpetual`, valued at `marketPrice`.
+//
+// Returns an error if the liquidity tier of the perpetual does not exist.
+func (k Keeper) getMarginRequirementsAtPrice(
+	ctx sdk.Context,
+	perpetual types.Perpetual,
+	marketPrice pricestypes.MarketPrice,
+	bigQuantums *big.Int,
+) (
+	bigInitialMarginQuoteQuantums *big.Int,
+	bigMaintenanceMarginQuoteQuantums *big.Int,
+	err error,
+) {
 	// Get perpetual's liquidity tier.
 	liquidityTier, err := k.GetLiquidityTier(ctx, perpetual.Params.LiquidityTier)
 	if err != nil {
@@ -1101,17 +1262,23 @@ func (k Keeper) getPremiumStore(ctx sdk.Context, key string) (
 	return premiumStore
 }
 
-// AddPremiumVotes adds a list of new premium votes to state.
+// AddPremiumVotes adds a list of new premium votes to state. The votes are also recorded in the
+// transient store, where they are sampled as the basis of the mark prices at the end of the block.
 func (k Keeper) AddPremiumVotes(
 	ctx sdk.Context,
 	newVotes []types.FundingPremium,
 ) error {
-	return k.addToPremiumStore(
+	if err := k.addToPremiumStore(
 		ctx,
 		newVotes,
 		types.PremiumVotesKey,
 		metrics.AddPremiumVotes,
-	)
+	); err != nil {
+		return err
+	}
+
+	k.setBlockPremiumVotes(ctx, newVotes)
+	return nil
 }
 
 // AddPremiumSamples adds a list of new premium samples to state.
@@ -1401,6 +1568,48 @@ func (k Keeper) SetPremiumVotes(
 	k.setPremiumStore(ctx, premiumStore, types.PremiumVotesKey)
 }
 
+// getPendingFundingPremiums returns the premium rates of the `funding-tick` epochs of the current
+// funding interval of a perpetual which have not been charged yet.
+func (k Keeper) getPendingFundingPremiums(
+	ctx sdk.Context,
+	perpetualId uint32,
+) []int32 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingFundingPremiumsKeyPrefix))
+
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return []int32{}
+	}
+
+	var marketPremiums types.MarketPremiums
+	k.cdc.MustUnmarshal(b, &marketPremiums)
+	return marketPremiums.Premiums
+}
+
+// setPendingFundingPremiums stores the premium rates of the `funding-tick` epochs of the current
+// funding interval of a perpetual which have not been charged yet.
+func (k Keeper) setPendingFundingPremiums(
+	ctx sdk.Context,
+	perpetualId uint32,
+	premiums []int32,
+) {
+	b := k.cdc.MustMarshal(&types.MarketPremiums{
+		PerpetualId: perpetualId,
+		Premiums:    premiums,
+	})
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingFundingPremiumsKeyPrefix))
+	store.Set(lib.Uint32ToKey(perpetualId), b)
+}
+
+// deletePendingFundingPremiums removes the pending premium rates of a perpetual.
+func (k Keeper) deletePendingFundingPremiums(
+	ctx sdk.Context,
+	perpetualId uint32,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PendingFundingPremiumsKeyPrefix))
+	store.Delete(lib.Uint32ToKey(perpetualId))
+}
+
 // PerformStatefulPremiumVotesValidation performs stateful validation on `MsgAddPremiumVotes`.
 // For each vote, it checks that:
 // - The perpetual Id is valid.
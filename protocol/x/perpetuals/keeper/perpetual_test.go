@@ -2000,6 +2000,73 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 				},
 			},
 		},
+		"Success: 60 equivalent samples of 12 percent, 20% IM, 18% MM, perpetual clamp factor of 100%": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				withFundingConfig(
+					constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM,
+					&types.PerpetualFundingConfig{FundingRateClampFactorPpm: 1_000_000},
+				),
+			},
+			// Premium sample = 12%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(120_000, 60),
+			// funding rate is clamped to 100% * (20% - 18%) = 2%
+			expectedFundingIndexDeltaStrings: []string{"12500"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution_20IM_18MM.GetId(),
+					FundingValuePpm: 20_000,
+					FundingIndex:    dtypes.NewInt(12500),
+				},
+			},
+		},
+		"Success: 60 equivalent samples of 0.001 percent, perpetual interest rate component": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   3600,
+			testPerpetuals: []types.Perpetual{
+				withFundingConfig(
+					constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+					&types.PerpetualFundingConfig{
+						InterestRatePpm:      100,
+						InterestRateClampPpm: 500,
+					},
+				),
+			},
+			// Premium sample = 0.001%, length = 60.
+			testFundingSamples: constants.GenerateConstantFundingPremiums(1000, 60),
+			// Premium rate is pulled towards the interest rate by at most 0.0005%.
+			expectedFundingIndexDeltaStrings: []string{"312"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 500,
+					FundingIndex:    dtypes.NewInt(312),
+				},
+			},
+		},
+		"Success: 10 samples with outliers, perpetual removes 10 percent of tails": {
+			testFundingSampleDuration: 60,
+			testFundingTickDuration:   600,
+			testPerpetuals: []types.Perpetual{
+				withFundingConfig(
+					constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+					&types.PerpetualFundingConfig{RemovedTailSampleRatioPpm: 100_000},
+				),
+			},
+			// The highest and lowest samples are removed, leaving 8 samples of 0.001%.
+			testFundingSamples: []int32{
+				100_000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, -50_000,
+			},
+			expectedFundingIndexDeltaStrings: []string{"104"},
+			fundingRatesAndIndices: []indexerevents.FundingUpdateV1{
+				{
+					PerpetualId:     constants.BtcUsd_0DefaultFunding_10AtomicResolution.GetId(),
+					FundingValuePpm: 1_000,
+					FundingIndex:    dtypes.NewInt(104),
+				},
+			},
+		},
 		"Success: 60 equivalent samples of 0.001 percent, 60 samples expected, two perpetuals": {
 			testFundingSampleDuration: 60,
 			testFundingTickDuration:   3600,
@@ -2180,6 +2247,10 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 					p.Params.MarketType,
 				)
 				require.NoError(t, err)
+				if p.Params.FundingConfig != nil {
+					perp, err = pc.PerpetualsKeeper.SetPerpetualFundingConfig(pc.Ctx, p.Params.Id, p.Params.FundingConfig)
+					require.NoError(t, err)
+				}
 				oldPerps[i] = perp
 			}
 
@@ -2259,6 +2330,220 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 	}
 }
 
+// withFundingConfig returns a copy of `perp` with the given funding config.
+func withFundingConfig(perp types.Perpetual, fundingConfig *types.PerpetualFundingConfig) types.Perpetual {
+	perp.Params.FundingConfig = fundingConfig
+	return perp
+}
+
+func TestMaybeProcessNewFundingTickEpoch_FundingIntervalMultiplier(t *testing.T) {
+	testCurrentFundingTickEpochStartBlock := uint32(23)
+
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithBlockHeight(int64(testCurrentFundingTickEpochStartBlock))
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	// BTC is charged funding every two `funding-tick` epochs, ETH every `funding-tick` epoch.
+	btcPerp := withFundingConfig(
+		constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+		&types.PerpetualFundingConfig{IntervalMultiplier: 2},
+	)
+	ethPerp := constants.EthUsd_0DefaultFunding_9AtomicResolution
+	perps := []types.Perpetual{btcPerp, ethPerp}
+	for _, p := range perps {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+	_, err := pc.PerpetualsKeeper.SetPerpetualFundingConfig(ctx, btcPerp.Params.Id, btcPerp.Params.FundingConfig)
+	require.NoError(t, err)
+
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpochStartBlock: testCurrentFundingTickEpochStartBlock,
+			CurrentEpoch:           1,
+		},
+	)
+	require.NoError(t, err)
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	)
+	require.NoError(t, err)
+
+	// First `funding-tick` epoch with premium samples of 0.001%. Only ETH is charged funding.
+	keepertest.PopulateTestPremiumStore(
+		t,
+		ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		constants.GenerateConstantFundingPremiums(1000, 60),
+		false, // isVote
+	)
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+
+	btc, err := pc.PerpetualsKeeper.GetPerpetual(ctx, btcPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewInt(0), btc.FundingIndex)
+	eth, err := pc.PerpetualsKeeper.GetPerpetual(ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewInt(375), eth.FundingIndex)
+	require.Contains(
+		t,
+		getFundingBlockEventsFromIndexerBlock(ctx, pc.PerpetualsKeeper),
+		indexerevents.NewFundingRatesAndIndicesEvent([]indexerevents.FundingUpdateV1{
+			{
+				PerpetualId:     ethPerp.Params.Id,
+				FundingValuePpm: 1_000,
+				FundingIndex:    dtypes.NewInt(375),
+			},
+		}),
+	)
+
+	// Second `funding-tick` epoch with premium samples of 0.003%. BTC is charged the average
+	// premium rate of 0.002% over both epochs.
+	keepertest.PopulateTestPremiumStore(
+		t,
+		ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		constants.GenerateConstantFundingPremiums(3000, 60),
+		false, // isVote
+	)
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+
+	btc, err = pc.PerpetualsKeeper.GetPerpetual(ctx, btcPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewInt(2500), btc.FundingIndex)
+	eth, err = pc.PerpetualsKeeper.GetPerpetual(ctx, ethPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewInt(375+1125), eth.FundingIndex)
+
+	// Third `funding-tick` epoch without premium samples. BTC starts a new funding interval.
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx)
+
+	btc, err = pc.PerpetualsKeeper.GetPerpetual(ctx, btcPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, dtypes.NewInt(2500), btc.FundingIndex)
+}
+
+func TestGetPredictedFundings(t *testing.T) {
+	testNextTick := uint32(1_700_000_000)
+
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	btcPerp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	// ETH is charged funding every three `funding-tick` epochs, and its premium rate is
+	// pulled towards an interest rate of 0.0001% by at most 0.0005%.
+	ethPerp := withFundingConfig(
+		constants.EthUsd_0DefaultFunding_9AtomicResolution,
+		&types.PerpetualFundingConfig{
+			IntervalMultiplier:   3,
+			InterestRatePpm:      100,
+			InterestRateClampPpm: 500,
+		},
+	)
+	perps := []types.Perpetual{btcPerp, ethPerp}
+	for _, p := range perps {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			pc.Ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+	_, err := pc.PerpetualsKeeper.SetPerpetualFundingConfig(pc.Ctx, ethPerp.Params.Id, ethPerp.Params.FundingConfig)
+	require.NoError(t, err)
+
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingTickEpochInfoName),
+			Duration: 3600,
+			NextTick: testNextTick,
+		},
+	)
+	require.NoError(t, err)
+
+	// Without premium samples, only the default funding and interest rate components remain.
+	predictedFundings, err := pc.PerpetualsKeeper.GetPredictedFundings(pc.Ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.PredictedFunding{
+			{
+				PerpetualId:     btcPerp.Params.Id,
+				PremiumPpm:      0,
+				FundingRatePpm:  0,
+				NextFundingTick: testNextTick,
+			},
+			{
+				PerpetualId:     ethPerp.Params.Id,
+				PremiumPpm:      0,
+				FundingRatePpm:  100,
+				NextFundingTick: testNextTick + 2*3600,
+			},
+		},
+		predictedFundings,
+	)
+
+	// Half of the premium samples of a `funding-tick` epoch are collected. Premium samples are
+	// not padded to the expected number of samples.
+	keepertest.PopulateTestPremiumStore(
+		t,
+		pc.Ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		constants.GenerateConstantFundingPremiums(1000, 30),
+		false, // isVote
+	)
+	predictedFundings, err = pc.PerpetualsKeeper.GetPredictedFundings(pc.Ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.PredictedFunding{
+			{
+				PerpetualId:     btcPerp.Params.Id,
+				PremiumPpm:      1_000,
+				FundingRatePpm:  1_000,
+				NextFundingTick: testNextTick,
+			},
+			{
+				PerpetualId:     ethPerp.Params.Id,
+				PremiumPpm:      1_000,
+				FundingRatePpm:  500,
+				NextFundingTick: testNextTick + 2*3600,
+			},
+		},
+		predictedFundings,
+	)
+
+	// Predicting funding does not modify state.
+	require.Equal(t, uint32(30), pc.PerpetualsKeeper.GetPremiumSamples(pc.Ctx).NumPremiums)
+}
+
 // getFundingBlockEventsFromIndexerBlock returns all funding events from the indexer block.
 func getFundingBlockEventsFromIndexerBlock(
 	ctx sdk.Context,
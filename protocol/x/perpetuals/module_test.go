@@ -211,13 +211,16 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "perpetuals", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 9, len(cmd.Commands()))
 	require.Equal(t, "get-all-liquidity-tiers", cmd.Commands()[0].Name())
 	require.Equal(t, "get-params", cmd.Commands()[1].Name())
-	require.Equal(t, "get-premium-samples", cmd.Commands()[2].Name())
-	require.Equal(t, "get-premium-votes", cmd.Commands()[3].Name())
-	require.Equal(t, "list-perpetual", cmd.Commands()[4].Name())
-	require.Equal(t, "show-perpetual", cmd.Commands()[5].Name())
+	require.Equal(t, "get-predicted-funding", cmd.Commands()[2].Name())
+	require.Equal(t, "get-premium-samples", cmd.Commands()[3].Name())
+	require.Equal(t, "get-premium-votes", cmd.Commands()[4].Name())
+	require.Equal(t, "list-mark-price", cmd.Commands()[5].Name())
+	require.Equal(t, "list-perpetual", cmd.Commands()[6].Name())
+	require.Equal(t, "show-mark-price", cmd.Commands()[7].Name())
+	require.Equal(t, "show-perpetual", cmd.Commands()[8].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -315,7 +318,9 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 				 "atomic_resolution":0,
 				 "default_funding_ppm":0,
 				 "liquidity_tier":0,
-				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS"
+				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS",
+				 "funding_config":null,
+				 "mark_price_config":null
 			  },
 			  "funding_index":"0",
 			  "open_interest":"0"
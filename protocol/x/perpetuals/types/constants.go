@@ -6,6 +6,27 @@ const (
 	// For example, if 60 funding sample entries were collected during an epoch,
 	// `RemovedTailSamplePctPpm` of the top and bottom funding sample values are removed before
 	// taking the average.
-	// TODO(DEC-1105): Move this constant to state so that it can be changed via governance.
+	// This value is used for perpetuals without a `PerpetualFundingConfig`.
 	RemovedTailSampleRatioPpm uint32 = 0
+
+	// MaxRemovedTailSampleRatioPpm is the exclusive upper bound of the ratio of funding samples
+	// removed on each end of the sorted funding samples. Removing 50% from each end would
+	// remove all samples.
+	MaxRemovedTailSampleRatioPpm uint32 = 500_000
+
+	// MaxFundingIntervalMultiplier is the maximum number of `funding-tick` epochs between two
+	// funding payments of a perpetual.
+	MaxFundingIntervalMultiplier uint32 = 24
+
+	// DefaultMarkPriceEmaSmoothingPpm is the smoothing factor (in ppm) of the exponential moving
+	// average of the mark price basis, used for perpetuals without a `MarkPriceConfig` override.
+	DefaultMarkPriceEmaSmoothingPpm uint32 = 100_000
+
+	// DefaultMarkPriceMaxBasisPpm is the maximum absolute basis (in ppm) between the mark price and
+	// the oracle price, used for perpetuals without a `MarkPriceConfig` override.
+	DefaultMarkPriceMaxBasisPpm uint32 = 10_000
+
+	// MaxMarkPriceBasisPpm is the upper bound of the maximum absolute basis of a perpetual's
+	// mark price.
+	MaxMarkPriceBasisPpm uint32 = 500_000
 )
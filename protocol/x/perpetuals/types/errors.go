@@ -122,6 +122,21 @@ var (
 		25,
 		"open interest would become negative after update",
 	)
+	ErrInvalidFundingConfig = errorsmod.Register(
+		ModuleName,
+		26,
+		"Funding config is invalid",
+	)
+	ErrInvalidMarkPriceConfig = errorsmod.Register(
+		ModuleName,
+		27,
+		"Mark price config is invalid",
+	)
+	ErrMarkPriceDoesNotExist = errorsmod.Register(
+		ModuleName,
+		28,
+		"Mark price does not exist",
+	)
 
 	// Errors for Not Implemented
 	ErrNotImplementedFunding = errorsmod.Register(ModuleName, 1001, "Not Implemented: Perpetuals Funding")
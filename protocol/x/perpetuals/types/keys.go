@@ -26,6 +26,15 @@ const (
 	// `funding-tick` epoch.
 	PremiumSamplesKey = "PremSamples"
 
+	// PendingFundingPremiumsKeyPrefix is the prefix to retrieve the `MarketPremiums` of a
+	// perpetual that stores the premium rates of `funding-tick` epochs which have not
+	// been charged yet, because the funding interval of the perpetual spans multiple
+	// `funding-tick` epochs.
+	PendingFundingPremiumsKeyPrefix = "PendFundPrem:"
+
+	// MarkPriceKeyPrefix is the prefix to retrieve the `MarkPrice` of a perpetual.
+	MarkPriceKeyPrefix = "MarkPrice:"
+
 	// LiquidityTierKeyPrefix is the prefix to retrieve all `LiquidityTier`s.
 	LiquidityTierKeyPrefix = "LiqTier:"
 
@@ -34,6 +43,10 @@ const (
 
 	// UpdatedOIKeyPrefix is the key to retrieve the updated OI for the module.
 	UpdatedOIKeyPrefix = "UpdatedOI"
+
+	// BlockPremiumVotesKeyPrefix is the transient prefix to retrieve the premium votes of a
+	// perpetual added in the current block, which are sampled as the basis of its mark price.
+	BlockPremiumVotesKeyPrefix = "BlockPremVotes:"
 )
 
 // Module Accounts
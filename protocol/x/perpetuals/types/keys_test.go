@@ -16,6 +16,8 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Perp:", types.PerpetualKeyPrefix)
 	require.Equal(t, "PremVotes", types.PremiumVotesKey)
 	require.Equal(t, "PremSamples", types.PremiumSamplesKey)
+	require.Equal(t, "PendFundPrem:", types.PendingFundingPremiumsKeyPrefix)
+	require.Equal(t, "MarkPrice:", types.MarkPriceKeyPrefix)
 	require.Equal(t, "LiqTier:", types.LiquidityTierKeyPrefix)
 	require.Equal(t, "Params", types.ParamsKey)
 }
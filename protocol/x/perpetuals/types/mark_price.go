@@ -0,0 +1,91 @@
+package types
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// IsMarkPriceEnabled returns true if liquidations and conditional order triggers of the perpetual
+// use the mark price instead of the oracle price.
+func (p *PerpetualParams) IsMarkPriceEnabled() bool {
+	return p.MarkPriceConfig != nil && p.MarkPriceConfig.Enabled
+}
+
+// GetMarkPriceEmaSmoothingPpm returns the smoothing factor of the mark price basis of the perpetual.
+func (p *PerpetualParams) GetMarkPriceEmaSmoothingPpm() uint32 {
+	if p.MarkPriceConfig == nil || p.MarkPriceConfig.EmaSmoothingPpm == 0 {
+		return DefaultMarkPriceEmaSmoothingPpm
+	}
+	return p.MarkPriceConfig.EmaSmoothingPpm
+}
+
+// GetMarkPriceMaxBasisPpm returns the maximum absolute basis of the mark price of the perpetual.
+func (p *PerpetualParams) GetMarkPriceMaxBasisPpm() uint32 {
+	if p.MarkPriceConfig == nil || p.MarkPriceConfig.MaxBasisPpm == 0 {
+		return DefaultMarkPriceMaxBasisPpm
+	}
+	return p.MarkPriceConfig.MaxBasisPpm
+}
+
+// Validate performs stateless validation on the mark price config of a perpetual.
+func (c *MarkPriceConfig) Validate() error {
+	if c.EmaSmoothingPpm > lib.OneMillion {
+		return errorsmod.Wrapf(
+			ErrInvalidMarkPriceConfig,
+			"ema smoothing ppm %d exceeds maximum %d",
+			c.EmaSmoothingPpm,
+			lib.OneMillion,
+		)
+	}
+
+	if c.MaxBasisPpm > MaxMarkPriceBasisPpm {
+		return errorsmod.Wrapf(
+			ErrInvalidMarkPriceConfig,
+			"max basis ppm %d exceeds maximum %d",
+			c.MaxBasisPpm,
+			MaxMarkPriceBasisPpm,
+		)
+	}
+
+	return nil
+}
+
+// GetNextBasisPpm returns the exponential moving average of the basis after applying a new
+// basis sample according to equation: B' = B + smoothing * (sample - B). The result is clamped
+// to `[-maxBasisPpm, maxBasisPpm]`.
+//
+// Note that this is a stateless function.
+func GetNextBasisPpm(
+	basisPpm int32,
+	sampleBasisPpm int32,
+	emaSmoothingPpm uint32,
+	maxBasisPpm uint32,
+) int32 {
+	// Round the adjustment towards the sample, so that the basis converges to a constant sample.
+	bigDeltaPpm := new(big.Int).Sub(lib.BigI(sampleBasisPpm), lib.BigI(basisPpm))
+	bigNextBasisPpm := lib.BigIntMulSignedPpm(
+		bigDeltaPpm,
+		int32(lib.Min(emaSmoothingPpm, lib.OneMillion)),
+		bigDeltaPpm.Sign() > 0,
+	)
+	bigNextBasisPpm.Add(bigNextBasisPpm, lib.BigI(basisPpm))
+
+	maxAbsBasisPpm := int32(lib.Min(maxBasisPpm, uint32(math.MaxInt32)))
+	return lib.BigInt32Clamp(bigNextBasisPpm, -maxAbsBasisPpm, maxAbsBasisPpm)
+}
+
+// GetMarkPriceFromBasis returns the oracle price adjusted by the basis according to equation:
+// mark = oracle * (1 + basis). The result is rounded towards zero.
+//
+// Note that this is a stateless function.
+func GetMarkPriceFromBasis(oraclePrice uint64, basisPpm int32) uint64 {
+	bigMarkPrice := lib.BigIntMulSignedPpm(
+		new(big.Int).SetUint64(oraclePrice),
+		int32(lib.OneMillion)+basisPpm,
+		false,
+	)
+	return lib.BigUint64Clamp(bigMarkPrice, 0, math.MaxUint64)
+}
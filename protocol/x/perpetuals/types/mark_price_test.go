@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerpetualParams_MarkPriceConfigGetters(t *testing.T) {
+	params := types.PerpetualParams{}
+	require.False(t, params.IsMarkPriceEnabled())
+	require.Equal(t, types.DefaultMarkPriceEmaSmoothingPpm, params.GetMarkPriceEmaSmoothingPpm())
+	require.Equal(t, types.DefaultMarkPriceMaxBasisPpm, params.GetMarkPriceMaxBasisPpm())
+
+	params.MarkPriceConfig = &types.MarkPriceConfig{}
+	require.False(t, params.IsMarkPriceEnabled())
+	require.Equal(t, types.DefaultMarkPriceEmaSmoothingPpm, params.GetMarkPriceEmaSmoothingPpm())
+	require.Equal(t, types.DefaultMarkPriceMaxBasisPpm, params.GetMarkPriceMaxBasisPpm())
+
+	params.MarkPriceConfig = &types.MarkPriceConfig{
+		Enabled:         true,
+		EmaSmoothingPpm: 500_000,
+		MaxBasisPpm:     2_000,
+	}
+	require.True(t, params.IsMarkPriceEnabled())
+	require.Equal(t, uint32(500_000), params.GetMarkPriceEmaSmoothingPpm())
+	require.Equal(t, uint32(2_000), params.GetMarkPriceMaxBasisPpm())
+}
+
+func TestGetNextBasisPpm(t *testing.T) {
+	tests := map[string]struct {
+		basisPpm         int32
+		sampleBasisPpm   int32
+		emaSmoothingPpm  uint32
+		maxBasisPpm      uint32
+		expectedBasisPpm int32
+	}{
+		"sample equals basis": {
+			basisPpm:         1_000,
+			sampleBasisPpm:   1_000,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: 1_000,
+		},
+		"moves towards higher sample": {
+			basisPpm:         1_000,
+			sampleBasisPpm:   3_000,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: 1_200,
+		},
+		"moves towards lower sample": {
+			basisPpm:         1_000,
+			sampleBasisPpm:   -1_000,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: 800,
+		},
+		"rounds towards higher sample": {
+			basisPpm:         -9,
+			sampleBasisPpm:   0,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: -8,
+		},
+		"rounds towards lower sample": {
+			basisPpm:         9,
+			sampleBasisPpm:   0,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: 8,
+		},
+		"full smoothing takes the sample": {
+			basisPpm:         1_000,
+			sampleBasisPpm:   -2_000,
+			emaSmoothingPpm:  1_000_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: -2_000,
+		},
+		"clamped to max basis": {
+			basisPpm:         9_000,
+			sampleBasisPpm:   50_000,
+			emaSmoothingPpm:  100_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: 10_000,
+		},
+		"clamped to negative max basis": {
+			basisPpm:         0,
+			sampleBasisPpm:   math.MinInt32,
+			emaSmoothingPpm:  1_000_000,
+			maxBasisPpm:      10_000,
+			expectedBasisPpm: -10_000,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expectedBasisPpm,
+				types.GetNextBasisPpm(tc.basisPpm, tc.sampleBasisPpm, tc.emaSmoothingPpm, tc.maxBasisPpm),
+			)
+		})
+	}
+}
+
+func TestGetMarkPriceFromBasis(t *testing.T) {
+	require.Equal(t, uint64(5_000_000_000), types.GetMarkPriceFromBasis(5_000_000_000, 0))
+	require.Equal(t, uint64(5_005_000_000), types.GetMarkPriceFromBasis(5_000_000_000, 1_000))
+	require.Equal(t, uint64(4_995_000_000), types.GetMarkPriceFromBasis(5_000_000_000, -1_000))
+	// Rounded towards zero.
+	require.Equal(t, uint64(100), types.GetMarkPriceFromBasis(101, -5_000))
+	require.Equal(t, uint64(math.MaxUint64), types.GetMarkPriceFromBasis(math.MaxUint64, 500_000))
+}
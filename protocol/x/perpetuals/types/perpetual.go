@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -36,5 +37,104 @@ func (p *PerpetualParams) Validate() error {
 			lib.IntToString(p.DefaultFundingPpm))
 	}
 
+	// Validate `fundingConfig`.
+	if p.FundingConfig != nil {
+		if err := p.FundingConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate `markPriceConfig`.
+	if p.MarkPriceConfig != nil {
+		if err := p.MarkPriceConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetFundingIntervalMultiplier returns the number of `funding-tick` epochs between two funding
+// payments of the perpetual.
+func (p *PerpetualParams) GetFundingIntervalMultiplier() uint32 {
+	if p.FundingConfig == nil || p.FundingConfig.IntervalMultiplier == 0 {
+		return 1
+	}
+	return p.FundingConfig.IntervalMultiplier
+}
+
+// GetFundingRateClampFactorPpm returns the funding rate clamp factor of the perpetual, falling
+// back to the module-wide `defaultClampFactorPpm` if the perpetual does not override it.
+func (p *PerpetualParams) GetFundingRateClampFactorPpm(defaultClampFactorPpm uint32) uint32 {
+	if p.FundingConfig == nil || p.FundingConfig.FundingRateClampFactorPpm == 0 {
+		return defaultClampFactorPpm
+	}
+	return p.FundingConfig.FundingRateClampFactorPpm
+}
+
+// GetRemovedTailSampleRatioPpm returns the ratio of premium samples removed from each end of
+// the sorted premium samples of the perpetual.
+func (p *PerpetualParams) GetRemovedTailSampleRatioPpm() uint32 {
+	if p.FundingConfig == nil {
+		return RemovedTailSampleRatioPpm
+	}
+	return p.FundingConfig.RemovedTailSampleRatioPpm
+}
+
+// Validate performs stateless validation on the funding config of a perpetual.
+func (c *PerpetualFundingConfig) Validate() error {
+	if c.IntervalMultiplier > MaxFundingIntervalMultiplier {
+		return errorsmod.Wrapf(
+			ErrInvalidFundingConfig,
+			"interval multiplier %d exceeds maximum %d",
+			c.IntervalMultiplier,
+			MaxFundingIntervalMultiplier,
+		)
+	}
+
+	if c.RemovedTailSampleRatioPpm >= MaxRemovedTailSampleRatioPpm {
+		return errorsmod.Wrapf(
+			ErrInvalidFundingConfig,
+			"removed tail sample ratio ppm %d must be less than %d",
+			c.RemovedTailSampleRatioPpm,
+			MaxRemovedTailSampleRatioPpm,
+		)
+	}
+
+	if lib.AbsInt32(c.InterestRatePpm) > MaxDefaultFundingPpmAbs {
+		return errorsmod.Wrapf(
+			ErrInvalidFundingConfig,
+			"interest rate ppm %d magnitude exceeds maximum %d",
+			c.InterestRatePpm,
+			MaxDefaultFundingPpmAbs,
+		)
+	}
+
+	if c.InterestRateClampPpm > MaxDefaultFundingPpmAbs {
+		return errorsmod.Wrapf(
+			ErrInvalidFundingConfig,
+			"interest rate clamp ppm %d exceeds maximum %d",
+			c.InterestRateClampPpm,
+			MaxDefaultFundingPpmAbs,
+		)
+	}
+
 	return nil
 }
+
+// ApplyInterestRate pulls `premiumPpm` towards the interest rate of the funding config
+// according to equation: P' = P + clamp(I - P, -interest_rate_clamp, interest_rate_clamp).
+// Returns `premiumPpm` unchanged if the interest rate component is disabled.
+func (c *PerpetualFundingConfig) ApplyInterestRate(premiumPpm *big.Int) *big.Int {
+	if c == nil || c.InterestRateClampPpm == 0 {
+		return premiumPpm
+	}
+
+	clampPpm := new(big.Int).SetUint64(uint64(c.InterestRateClampPpm))
+	adjustmentPpm := lib.BigIntClamp(
+		new(big.Int).Sub(big.NewInt(int64(c.InterestRatePpm)), premiumPpm),
+		new(big.Int).Neg(clampPpm),
+		clampPpm,
+	)
+	return new(big.Int).Add(premiumPpm, adjustmentPpm)
+}
@@ -129,6 +129,12 @@ type PerpetualParams struct {
 	LiquidityTier uint32 `protobuf:"varint,6,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
 	// The market type specifying if this perpetual is cross or isolated
 	MarketType PerpetualMarketType `protobuf:"varint,7,opt,name=market_type,json=marketType,proto3,enum=dydxprotocol.perpetuals.PerpetualMarketType" json:"market_type,omitempty"`
+	// The funding configuration of this perpetual. The module-wide funding
+	// parameters are used if unset.
+	FundingConfig *PerpetualFundingConfig `protobuf:"bytes,8,opt,name=funding_config,json=fundingConfig,proto3" json:"funding_config,omitempty"`
+	// The mark price configuration of this perpetual. The module-wide mark
+	// price defaults are used if unset.
+	MarkPriceConfig *MarkPriceConfig `protobuf:"bytes,9,opt,name=mark_price_config,json=markPriceConfig,proto3" json:"mark_price_config,omitempty"`
 }
 
 func (m *PerpetualParams) Reset()         { *m = PerpetualParams{} }
@@ -213,6 +219,257 @@ func (m *PerpetualParams) GetMarketType() PerpetualMarketType {
 	return PerpetualMarketType_PERPETUAL_MARKET_TYPE_UNSPECIFIED
 }
 
+func (m *PerpetualParams) GetFundingConfig() *PerpetualFundingConfig {
+	if m != nil {
+		return m.FundingConfig
+	}
+	return nil
+}
+
+func (m *PerpetualParams) GetMarkPriceConfig() *MarkPriceConfig {
+	if m != nil {
+		return m.MarkPriceConfig
+	}
+	return nil
+}
+
+// PerpetualFundingConfig stores the funding parameters of a single perpetual.
+// Zero-valued fields fall back to the module-wide defaults.
+type PerpetualFundingConfig struct {
+	// The number of `funding-tick` epochs between two funding payments of this
+	// perpetual. Premium rates of the intermediate epochs are averaged and
+	// applied at once. Zero is treated as one.
+	IntervalMultiplier uint32 `protobuf:"varint,1,opt,name=interval_multiplier,json=intervalMultiplier,proto3" json:"interval_multiplier,omitempty"`
+	// Funding rate clamp factor in parts-per-million, used for clamping 8-hour
+	// funding rates according to equation: |R| <= funding_rate_clamp_factor *
+	// (initial margin - maintenance margin). Zero falls back to
+	// `Params.funding_rate_clamp_factor_ppm`.
+	FundingRateClampFactorPpm uint32 `protobuf:"varint,2,opt,name=funding_rate_clamp_factor_ppm,json=fundingRateClampFactorPpm,proto3" json:"funding_rate_clamp_factor_ppm,omitempty"`
+	// The ratio (in ppm) of premium samples removed from each end of the sorted
+	// premium samples of a `funding-tick` epoch before averaging.
+	RemovedTailSampleRatioPpm uint32 `protobuf:"varint,3,opt,name=removed_tail_sample_ratio_ppm,json=removedTailSampleRatioPpm,proto3" json:"removed_tail_sample_ratio_ppm,omitempty"`
+	// The 8-hour interest rate in parts-per-million. The premium rate is pulled
+	// towards the interest rate according to equation:
+	// P' = P + clamp(I - P, -interest_rate_clamp, interest_rate_clamp).
+	InterestRatePpm int32 `protobuf:"zigzag32,4,opt,name=interest_rate_ppm,json=interestRatePpm,proto3" json:"interest_rate_ppm,omitempty"`
+	// The maximum adjustment (in ppm) applied to the premium rate by the
+	// interest rate component. Zero disables the interest rate component.
+	InterestRateClampPpm uint32 `protobuf:"varint,5,opt,name=interest_rate_clamp_ppm,json=interestRateClampPpm,proto3" json:"interest_rate_clamp_ppm,omitempty"`
+}
+
+func (m *PerpetualFundingConfig) Reset()         { *m = PerpetualFundingConfig{} }
+func (m *PerpetualFundingConfig) String() string { return proto.CompactTextString(m) }
+func (*PerpetualFundingConfig) ProtoMessage()    {}
+func (*PerpetualFundingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{2}
+}
+func (m *PerpetualFundingConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualFundingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualFundingConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualFundingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualFundingConfig.Merge(m, src)
+}
+func (m *PerpetualFundingConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualFundingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualFundingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualFundingConfig proto.InternalMessageInfo
+
+func (m *PerpetualFundingConfig) GetIntervalMultiplier() uint32 {
+	if m != nil {
+		return m.IntervalMultiplier
+	}
+	return 0
+}
+
+func (m *PerpetualFundingConfig) GetFundingRateClampFactorPpm() uint32 {
+	if m != nil {
+		return m.FundingRateClampFactorPpm
+	}
+	return 0
+}
+
+func (m *PerpetualFundingConfig) GetRemovedTailSampleRatioPpm() uint32 {
+	if m != nil {
+		return m.RemovedTailSampleRatioPpm
+	}
+	return 0
+}
+
+func (m *PerpetualFundingConfig) GetInterestRatePpm() int32 {
+	if m != nil {
+		return m.InterestRatePpm
+	}
+	return 0
+}
+
+func (m *PerpetualFundingConfig) GetInterestRateClampPpm() uint32 {
+	if m != nil {
+		return m.InterestRateClampPpm
+	}
+	return 0
+}
+
+// MarkPriceConfig stores the mark price parameters of a single perpetual.
+// Zero-valued numeric fields fall back to the module-wide defaults.
+type MarkPriceConfig struct {
+	// Whether liquidations and conditional order triggers of this perpetual
+	// use the mark price instead of the oracle price.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The smoothing factor (in ppm) of the exponential moving average of the
+	// basis, applied once per block according to equation:
+	// B' = B + ema_smoothing * (sample - B).
+	EmaSmoothingPpm uint32 `protobuf:"varint,2,opt,name=ema_smoothing_ppm,json=emaSmoothingPpm,proto3" json:"ema_smoothing_ppm,omitempty"`
+	// The maximum absolute basis (in ppm) between the mark price and the oracle
+	// price.
+	MaxBasisPpm uint32 `protobuf:"varint,3,opt,name=max_basis_ppm,json=maxBasisPpm,proto3" json:"max_basis_ppm,omitempty"`
+}
+
+func (m *MarkPriceConfig) Reset()         { *m = MarkPriceConfig{} }
+func (m *MarkPriceConfig) String() string { return proto.CompactTextString(m) }
+func (*MarkPriceConfig) ProtoMessage()    {}
+func (*MarkPriceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{3}
+}
+func (m *MarkPriceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkPriceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkPriceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkPriceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPriceConfig.Merge(m, src)
+}
+func (m *MarkPriceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkPriceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPriceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPriceConfig proto.InternalMessageInfo
+
+func (m *MarkPriceConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MarkPriceConfig) GetEmaSmoothingPpm() uint32 {
+	if m != nil {
+		return m.EmaSmoothingPpm
+	}
+	return 0
+}
+
+func (m *MarkPriceConfig) GetMaxBasisPpm() uint32 {
+	if m != nil {
+		return m.MaxBasisPpm
+	}
+	return 0
+}
+
+// MarkPrice is the mark price of a perpetual, i.e. the oracle price adjusted
+// by a bounded, smoothed basis sampled from the impact bid and ask prices of
+// the orderbook.
+type MarkPrice struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The mark price, in the same exponent as the oracle price of the market.
+	Price uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	// The exponent of the mark price.
+	Exponent int32 `protobuf:"zigzag32,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// The smoothed basis of the mark price relative to the oracle price, in
+	// parts-per-million.
+	BasisPpm int32 `protobuf:"zigzag32,4,opt,name=basis_ppm,json=basisPpm,proto3" json:"basis_ppm,omitempty"`
+}
+
+func (m *MarkPrice) Reset()         { *m = MarkPrice{} }
+func (m *MarkPrice) String() string { return proto.CompactTextString(m) }
+func (*MarkPrice) ProtoMessage()    {}
+func (*MarkPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{4}
+}
+func (m *MarkPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPrice.Merge(m, src)
+}
+func (m *MarkPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPrice proto.InternalMessageInfo
+
+func (m *MarkPrice) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *MarkPrice) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *MarkPrice) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *MarkPrice) GetBasisPpm() int32 {
+	if m != nil {
+		return m.BasisPpm
+	}
+	return 0
+}
+
 // MarketPremiums stores a list of premiums for a single perpetual market.
 type MarketPremiums struct {
 	// perpetual_id is the Id of the perpetual market.
@@ -227,7 +484,7 @@ func (m *MarketPremiums) Reset()         { *m = MarketPremiums{} }
 func (m *MarketPremiums) String() string { return proto.CompactTextString(m) }
 func (*MarketPremiums) ProtoMessage()    {}
 func (*MarketPremiums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{2}
+	return fileDescriptor_ce7204eee10038be, []int{5}
 }
 func (m *MarketPremiums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,7 +550,7 @@ func (m *PremiumStore) Reset()         { *m = PremiumStore{} }
 func (m *PremiumStore) String() string { return proto.CompactTextString(m) }
 func (*PremiumStore) ProtoMessage()    {}
 func (*PremiumStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{3}
+	return fileDescriptor_ce7204eee10038be, []int{6}
 }
 func (m *PremiumStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +632,7 @@ func (m *LiquidityTier) Reset()         { *m = LiquidityTier{} }
 func (m *LiquidityTier) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTier) ProtoMessage()    {}
 func (*LiquidityTier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{4}
+	return fileDescriptor_ce7204eee10038be, []int{7}
 }
 func (m *LiquidityTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,6 +722,9 @@ func init() {
 	proto.RegisterEnum("dydxprotocol.perpetuals.PerpetualMarketType", PerpetualMarketType_name, PerpetualMarketType_value)
 	proto.RegisterType((*Perpetual)(nil), "dydxprotocol.perpetuals.Perpetual")
 	proto.RegisterType((*PerpetualParams)(nil), "dydxprotocol.perpetuals.PerpetualParams")
+	proto.RegisterType((*PerpetualFundingConfig)(nil), "dydxprotocol.perpetuals.PerpetualFundingConfig")
+	proto.RegisterType((*MarkPriceConfig)(nil), "dydxprotocol.perpetuals.MarkPriceConfig")
+	proto.RegisterType((*MarkPrice)(nil), "dydxprotocol.perpetuals.MarkPrice")
 	proto.RegisterType((*MarketPremiums)(nil), "dydxprotocol.perpetuals.MarketPremiums")
 	proto.RegisterType((*PremiumStore)(nil), "dydxprotocol.perpetuals.PremiumStore")
 	proto.RegisterType((*LiquidityTier)(nil), "dydxprotocol.perpetuals.LiquidityTier")
@@ -475,55 +735,73 @@ func init() {
 }
 
 var fileDescriptor_ce7204eee10038be = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
-	0x14, 0x8d, 0xdd, 0x10, 0xda, 0xc9, 0xcf, 0x97, 0x4c, 0xab, 0x7e, 0x56, 0x2b, 0xa5, 0x69, 0xa4,
-	0xaa, 0x11, 0x94, 0x44, 0x2a, 0x20, 0xb1, 0x60, 0x41, 0x93, 0x26, 0xc2, 0x22, 0x69, 0x2d, 0x27,
-	0x45, 0x02, 0x09, 0x8d, 0x26, 0xf6, 0x34, 0x1d, 0xd5, 0x1e, 0x0f, 0xf6, 0x18, 0x1a, 0x76, 0xbc,
-	0x41, 0x5f, 0x83, 0x25, 0x6f, 0xd1, 0x65, 0x97, 0x88, 0x45, 0x85, 0xda, 0x2d, 0x0f, 0x81, 0x3c,
-	0x9e, 0xba, 0x49, 0x7f, 0x04, 0x0b, 0x56, 0x1e, 0xdf, 0x73, 0xce, 0x9d, 0x33, 0xd7, 0xc7, 0x03,
-	0xf6, 0xdd, 0xb9, 0x7b, 0xc5, 0xc3, 0x40, 0x04, 0x4e, 0xe0, 0x75, 0x38, 0x09, 0x39, 0x11, 0x31,
-	0xf6, 0xa2, 0xa7, 0x65, 0x5b, 0xa2, 0xf0, 0xfd, 0x22, 0xb1, 0xfd, 0x44, 0xdc, 0xda, 0x98, 0x05,
-	0xb3, 0x40, 0x02, 0x9d, 0x64, 0x95, 0xd2, 0x9b, 0xbf, 0xeb, 0x60, 0xcd, 0x7a, 0x24, 0xc1, 0x01,
-	0x28, 0x70, 0x1c, 0x62, 0x3f, 0x32, 0xb4, 0x86, 0xd6, 0x2a, 0x1e, 0xb6, 0xda, 0x6f, 0x74, 0x6b,
-	0x67, 0x1a, 0x4b, 0xf2, 0xbb, 0xf9, 0x9b, 0xbb, 0x9d, 0x9c, 0xad, 0xd4, 0xd0, 0x07, 0xe5, 0xf3,
-	0x98, 0xb9, 0x94, 0xcd, 0x10, 0x65, 0x2e, 0xb9, 0x32, 0xf4, 0x86, 0xd6, 0x2a, 0x75, 0xbf, 0x4e,
-	0x48, 0x7f, 0xde, 0xed, 0x7c, 0x35, 0xa3, 0xe2, 0x22, 0x9e, 0xb6, 0x9d, 0xc0, 0xef, 0x2c, 0x9d,
-	0xeb, 0xa7, 0xcf, 0x3e, 0x71, 0x2e, 0x30, 0x65, 0x9d, 0xac, 0xe2, 0x8a, 0x39, 0x27, 0x51, 0x7b,
-	0x4c, 0x42, 0x8a, 0x3d, 0xfa, 0x0b, 0x9e, 0x7a, 0xc4, 0x64, 0xc2, 0x2e, 0xa9, 0xf6, 0x66, 0xd2,
-	0x3d, 0xd9, 0x2e, 0xe0, 0x84, 0x21, 0xca, 0x04, 0x09, 0x49, 0x24, 0x8c, 0x95, 0xff, 0x7b, 0xbb,
-	0xa4, 0xbd, 0xa9, 0xba, 0x37, 0x7f, 0xd3, 0xc1, 0xbb, 0x67, 0xe7, 0x87, 0x15, 0xa0, 0x53, 0x57,
-	0x4e, 0xad, 0x6c, 0xeb, 0xd4, 0x85, 0x9b, 0xa0, 0x20, 0xa8, 0x73, 0x49, 0x42, 0x79, 0xf4, 0x35,
-	0x5b, 0xbd, 0xc1, 0x6d, 0xb0, 0xe6, 0xe3, 0xf0, 0x92, 0x08, 0x44, 0x5d, 0x69, 0xb3, 0x6c, 0xaf,
-	0xa6, 0x05, 0xd3, 0x85, 0x1f, 0x83, 0x1a, 0x16, 0x81, 0x4f, 0x1d, 0x14, 0x92, 0x28, 0xf0, 0x62,
-	0x41, 0x03, 0x66, 0xe4, 0x1b, 0x5a, 0xab, 0x66, 0x57, 0x53, 0xc0, 0xce, 0xea, 0xb0, 0x0d, 0xd6,
-	0x5d, 0x72, 0x8e, 0x63, 0x4f, 0xa0, 0xc7, 0x59, 0x73, 0xee, 0x1b, 0x1f, 0x48, 0x7a, 0x4d, 0x41,
-	0x83, 0x14, 0xb1, 0xb8, 0x0f, 0xf7, 0x40, 0xc5, 0xa3, 0x3f, 0xc6, 0xd4, 0xa5, 0x62, 0x8e, 0x04,
-	0x25, 0xa1, 0x51, 0x90, 0xdb, 0x97, 0xb3, 0xea, 0x84, 0x92, 0x10, 0x8e, 0x40, 0x51, 0x19, 0x4c,
-	0x46, 0x61, 0x7c, 0xd8, 0xd0, 0x5a, 0x95, 0xc3, 0x83, 0x7f, 0xcf, 0xc1, 0x48, 0x8a, 0x26, 0x73,
-	0x4e, 0x6c, 0xe0, 0x67, 0xeb, 0xe6, 0x29, 0xa8, 0xa4, 0x88, 0x15, 0x12, 0x9f, 0xc6, 0x7e, 0x04,
-	0x77, 0x41, 0x29, 0xd3, 0xa3, 0x6c, 0x66, 0xc5, 0xac, 0x66, 0xba, 0x70, 0x0b, 0xac, 0x72, 0x45,
-	0x37, 0xf4, 0xc6, 0x4a, 0xab, 0x66, 0x67, 0xef, 0xcd, 0x6b, 0x0d, 0x94, 0x54, 0xaf, 0xb1, 0x08,
-	0x42, 0x02, 0x7f, 0x00, 0xeb, 0xd8, 0xf3, 0x90, 0x32, 0x9d, 0xe9, 0xb4, 0xc6, 0x4a, 0xab, 0x78,
-	0xb8, 0xff, 0xa6, 0xf1, 0x65, 0x57, 0x2a, 0xbf, 0x35, 0xec, 0x79, 0x2f, 0xed, 0xb2, 0xd8, 0x47,
-	0x0b, 0x7e, 0xa4, 0x5d, 0x16, 0xfb, 0x8f, 0x94, 0xe6, 0xdf, 0x3a, 0x28, 0x0f, 0x97, 0x86, 0xf8,
-	0x3c, 0x0d, 0x10, 0xe4, 0x19, 0xf6, 0x89, 0xca, 0x82, 0x5c, 0xc3, 0x03, 0x00, 0x29, 0xa3, 0x82,
-	0x62, 0xe9, 0x7d, 0x46, 0x99, 0xfc, 0x7c, 0x69, 0x24, 0xaa, 0x0a, 0x19, 0x49, 0x20, 0xf9, 0x7a,
-	0x5f, 0x00, 0xc3, 0xc7, 0x49, 0xbe, 0x19, 0x66, 0x0e, 0x41, 0xe7, 0x21, 0x76, 0x92, 0x14, 0x48,
-	0x4d, 0x5e, 0x6a, 0x36, 0x17, 0xf0, 0x81, 0x82, 0x53, 0xe5, 0xe6, 0x14, 0x47, 0x04, 0xf1, 0x20,
-	0xa2, 0x52, 0xc2, 0x82, 0xe4, 0x81, 0x3d, 0x19, 0x95, 0x7c, 0x57, 0x37, 0x34, 0x7b, 0x23, 0x61,
-	0x58, 0x8a, 0x70, 0xa2, 0x70, 0xb8, 0x0f, 0xde, 0x51, 0x9f, 0x63, 0x47, 0x3c, 0x49, 0x92, 0xc8,
-	0xe4, 0xed, 0x4a, 0x5a, 0xce, 0x88, 0x9f, 0x83, 0xf7, 0x4b, 0xff, 0x1f, 0xf2, 0x82, 0x9f, 0x49,
-	0x88, 0x1c, 0xcc, 0x65, 0x7e, 0xf2, 0xf6, 0xc6, 0xe2, 0xff, 0x33, 0x4c, 0xc0, 0x1e, 0xe6, 0x2f,
-	0x65, 0x31, 0xe7, 0x4a, 0xb6, 0xfa, 0x52, 0x76, 0x96, 0x80, 0x3d, 0xcc, 0x3f, 0xfa, 0x55, 0x03,
-	0xeb, 0xaf, 0xc4, 0x0e, 0xee, 0x81, 0x5d, 0xab, 0x6f, 0x5b, 0xfd, 0xc9, 0xd9, 0xd1, 0x10, 0x8d,
-	0x8e, 0xec, 0x6f, 0xfa, 0x13, 0x34, 0xf9, 0xce, 0xea, 0xa3, 0xb3, 0x93, 0xb1, 0xd5, 0xef, 0x99,
-	0x03, 0xb3, 0x7f, 0x5c, 0xcd, 0xc1, 0x1d, 0xb0, 0xfd, 0x3a, 0xad, 0x67, 0x9f, 0x8e, 0xc7, 0x55,
-	0x0d, 0x36, 0x41, 0xfd, 0x75, 0x82, 0x39, 0x3e, 0x1d, 0x1e, 0x4d, 0xfa, 0xc7, 0x55, 0xbd, 0xfb,
-	0xed, 0xcd, 0x7d, 0x5d, 0xbb, 0xbd, 0xaf, 0x6b, 0x7f, 0xdd, 0xd7, 0xb5, 0xeb, 0x87, 0x7a, 0xee,
-	0xf6, 0xa1, 0x9e, 0xfb, 0xe3, 0xa1, 0x9e, 0xfb, 0xfe, 0xcb, 0xff, 0x7e, 0xd9, 0x5c, 0x2d, 0xde,
-	0xe3, 0xf2, 0xe2, 0x99, 0x16, 0x24, 0xf8, 0xe9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x28,
-	0x1e, 0x85, 0xef, 0x05, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xf6, 0xca, 0x8a, 0x23, 0x8f, 0x2d, 0xc9, 0xa2, 0x0d, 0x67, 0xe3, 0x20, 0xb2, 0x22, 0x20,
+	0xb0, 0x90, 0xa6, 0x12, 0xe0, 0x36, 0x40, 0x0f, 0x3d, 0xd8, 0x56, 0x64, 0x54, 0xa8, 0x1d, 0x2f,
+	0x56, 0x72, 0x80, 0x16, 0x28, 0x08, 0x6a, 0x97, 0x92, 0x89, 0x2c, 0x77, 0xd9, 0x5d, 0xae, 0x2b,
+	0xb7, 0xe8, 0xa1, 0x6f, 0x90, 0x47, 0x69, 0xdf, 0x22, 0xc7, 0xdc, 0x5a, 0xf4, 0x10, 0x14, 0xf6,
+	0xb5, 0x0f, 0x51, 0x90, 0xfb, 0x63, 0xf9, 0xa7, 0x75, 0x0e, 0x3d, 0x69, 0x39, 0xdf, 0x37, 0x33,
+	0x1f, 0x87, 0xc3, 0xa1, 0x60, 0xcb, 0x3d, 0x73, 0xa7, 0x22, 0x0c, 0x64, 0xe0, 0x04, 0x5e, 0x47,
+	0xd0, 0x50, 0x50, 0x19, 0x13, 0x2f, 0xba, 0xfc, 0x6c, 0x6b, 0x14, 0x3d, 0x98, 0x25, 0xb6, 0x2f,
+	0x89, 0x1b, 0x6b, 0x93, 0x60, 0x12, 0x68, 0xa0, 0xa3, 0xbe, 0x12, 0x7a, 0xf3, 0xb7, 0x02, 0x2c,
+	0x5a, 0x19, 0x09, 0xed, 0xc3, 0x82, 0x20, 0x21, 0xe1, 0x91, 0x69, 0x34, 0x8c, 0xd6, 0xd2, 0x76,
+	0xab, 0xfd, 0x2f, 0xd1, 0xda, 0xb9, 0x8f, 0xa5, 0xf9, 0x7b, 0xc5, 0x77, 0x1f, 0x36, 0xe7, 0xec,
+	0xd4, 0x1b, 0x71, 0x28, 0x8f, 0x63, 0xdf, 0x65, 0xfe, 0x04, 0x33, 0xdf, 0xa5, 0x53, 0xb3, 0xd0,
+	0x30, 0x5a, 0xcb, 0x7b, 0x5f, 0x29, 0xd2, 0x9f, 0x1f, 0x36, 0x77, 0x26, 0x4c, 0x9e, 0xc4, 0xa3,
+	0xb6, 0x13, 0xf0, 0xce, 0x95, 0x7d, 0x9d, 0x7e, 0xfe, 0xa9, 0x73, 0x42, 0x98, 0xdf, 0xc9, 0x2d,
+	0xae, 0x3c, 0x13, 0x34, 0x6a, 0x0f, 0x68, 0xc8, 0x88, 0xc7, 0x7e, 0x24, 0x23, 0x8f, 0xf6, 0x7d,
+	0x69, 0x2f, 0xa7, 0xe1, 0xfb, 0x2a, 0xba, 0x4a, 0x17, 0x08, 0xea, 0x63, 0xe6, 0x4b, 0x1a, 0xd2,
+	0x48, 0x9a, 0xf3, 0xff, 0x77, 0x3a, 0x15, 0xbe, 0x9f, 0x46, 0x6f, 0xfe, 0x3e, 0x0f, 0xd5, 0x6b,
+	0xfb, 0x47, 0x15, 0x28, 0x30, 0x57, 0x57, 0xad, 0x6c, 0x17, 0x98, 0x8b, 0xd6, 0x61, 0x41, 0x32,
+	0xe7, 0x0d, 0x0d, 0xf5, 0xd6, 0x17, 0xed, 0x74, 0x85, 0x1e, 0xc1, 0x22, 0x27, 0xe1, 0x1b, 0x2a,
+	0x31, 0x73, 0xb5, 0xcc, 0xb2, 0x5d, 0x4a, 0x0c, 0x7d, 0x17, 0x7d, 0x02, 0x35, 0x22, 0x03, 0xce,
+	0x1c, 0x1c, 0xd2, 0x28, 0xf0, 0x62, 0xc9, 0x02, 0xdf, 0x2c, 0x36, 0x8c, 0x56, 0xcd, 0x5e, 0x49,
+	0x00, 0x3b, 0xb7, 0xa3, 0x36, 0xac, 0xba, 0x74, 0x4c, 0x62, 0x4f, 0xe2, 0xac, 0xd6, 0x42, 0x70,
+	0xf3, 0x9e, 0xa6, 0xd7, 0x52, 0x68, 0x3f, 0x41, 0x2c, 0xc1, 0xd1, 0x53, 0xa8, 0x78, 0xec, 0xfb,
+	0x98, 0xb9, 0x4c, 0x9e, 0x61, 0xc9, 0x68, 0x68, 0x2e, 0xe8, 0xf4, 0xe5, 0xdc, 0x3a, 0x64, 0x34,
+	0x44, 0x87, 0xb0, 0x94, 0x0a, 0x54, 0xa5, 0x30, 0xef, 0x37, 0x8c, 0x56, 0x65, 0xfb, 0xf9, 0xdd,
+	0x7d, 0x70, 0xa8, 0x9d, 0x86, 0x67, 0x82, 0xda, 0xc0, 0xf3, 0x6f, 0xf4, 0x1a, 0x2a, 0x99, 0x3a,
+	0x27, 0xf0, 0xc7, 0x6c, 0x62, 0x96, 0x74, 0x67, 0x75, 0xee, 0x8e, 0x98, 0x6a, 0xef, 0x6a, 0x37,
+	0xbb, 0x3c, 0x9e, 0x5d, 0xa2, 0x21, 0xd4, 0x54, 0x16, 0x2c, 0x42, 0xe6, 0xd0, 0x2c, 0xf4, 0xe2,
+	0x1d, 0x4d, 0xab, 0x34, 0x5a, 0xca, 0x21, 0x8d, 0x59, 0xe5, 0x57, 0x0d, 0xcd, 0x5f, 0x0b, 0xb0,
+	0x7e, 0x7b, 0x7e, 0xd4, 0x81, 0x55, 0xdd, 0x5e, 0xa7, 0xc4, 0xc3, 0x3c, 0xf6, 0x24, 0x13, 0x9e,
+	0xaa, 0x61, 0x72, 0xe2, 0x28, 0x83, 0x0e, 0x73, 0x04, 0xed, 0xc0, 0xe3, 0x6c, 0xe7, 0x21, 0x91,
+	0x14, 0x3b, 0x1e, 0xe1, 0x02, 0x8f, 0x89, 0x23, 0x83, 0x50, 0x9f, 0x54, 0x41, 0xbb, 0x3e, 0x4c,
+	0x49, 0x36, 0x91, 0xb4, 0xab, 0x28, 0xfb, 0x9a, 0xa1, 0x4e, 0x6c, 0x07, 0x1e, 0x87, 0x94, 0x07,
+	0xa7, 0xd4, 0xc5, 0x92, 0x30, 0x0f, 0x47, 0x84, 0x0b, 0x8f, 0xaa, 0x68, 0x2c, 0xd0, 0x11, 0x92,
+	0xfe, 0x79, 0x98, 0x92, 0x86, 0x84, 0x79, 0x03, 0x4d, 0xb1, 0x15, 0x43, 0x45, 0x78, 0x06, 0xb5,
+	0xec, 0x4e, 0x24, 0x22, 0x94, 0x57, 0xd2, 0x50, 0xd5, 0x0c, 0x50, 0x89, 0x15, 0xf7, 0x05, 0x3c,
+	0xb8, 0xca, 0x4d, 0x04, 0x67, 0x3d, 0x55, 0xb6, 0xd7, 0x66, 0x3d, 0xb4, 0x54, 0x4b, 0xf0, 0xe6,
+	0x4f, 0x50, 0xbd, 0x56, 0x56, 0x64, 0xc2, 0x7d, 0xea, 0xab, 0xab, 0x93, 0x5c, 0x88, 0x92, 0x9d,
+	0x2d, 0x95, 0x1e, 0xca, 0x09, 0x8e, 0x78, 0x10, 0xc8, 0x93, 0xac, 0x63, 0x93, 0x3a, 0x54, 0x29,
+	0x27, 0x83, 0xcc, 0xae, 0xf4, 0x34, 0xa1, 0xcc, 0xc9, 0x14, 0x8f, 0x48, 0xc4, 0xa2, 0x99, 0xdd,
+	0x2e, 0x71, 0x32, 0xdd, 0x53, 0x36, 0x95, 0xfc, 0x67, 0x58, 0xcc, 0x93, 0xa3, 0x27, 0xb0, 0x9c,
+	0x9f, 0x35, 0xce, 0x2f, 0xe3, 0x52, 0x6e, 0xeb, 0xbb, 0x68, 0x0d, 0xee, 0xe9, 0x86, 0xd1, 0x39,
+	0x8b, 0x76, 0xb2, 0x40, 0x1b, 0x50, 0xa2, 0x53, 0x11, 0xf8, 0xd4, 0x4f, 0x26, 0x47, 0xcd, 0xce,
+	0xd7, 0xea, 0xbe, 0x5e, 0x2a, 0x48, 0x2a, 0x57, 0x1a, 0x65, 0xe9, 0x8f, 0xa0, 0x92, 0xb4, 0xbd,
+	0x15, 0x52, 0xce, 0x62, 0x1e, 0x7d, 0x8c, 0x86, 0x0d, 0x28, 0x89, 0x94, 0x6e, 0x16, 0x1a, 0xf3,
+	0x2a, 0x60, 0xb6, 0x6e, 0xbe, 0x35, 0x60, 0x39, 0x8d, 0x35, 0x90, 0x41, 0x48, 0xd1, 0x77, 0xb0,
+	0x4a, 0x3c, 0x0f, 0xa7, 0x37, 0x32, 0xf7, 0x33, 0x1a, 0xf3, 0xad, 0xa5, 0xed, 0xad, 0xff, 0x6c,
+	0xf4, 0x4b, 0x55, 0xe9, 0x70, 0xae, 0x11, 0xcf, 0xbb, 0x29, 0xd7, 0x8f, 0x39, 0x9e, 0xd1, 0xa3,
+	0xe5, 0xfa, 0x31, 0xcf, 0x28, 0xcd, 0xbf, 0x0b, 0x50, 0x3e, 0xb8, 0x32, 0x21, 0xae, 0x8f, 0x3a,
+	0x04, 0x45, 0x9f, 0x70, 0x9a, 0x0e, 0x3a, 0xfd, 0x8d, 0x9e, 0x03, 0x62, 0x3e, 0x93, 0x8c, 0x68,
+	0xed, 0x13, 0xe6, 0xcf, 0x9c, 0xe0, 0x4a, 0x8a, 0x1c, 0x6a, 0x40, 0x1d, 0xf5, 0x17, 0x60, 0x72,
+	0xa2, 0xba, 0xcb, 0x27, 0xbe, 0x43, 0xf1, 0x38, 0x24, 0x8e, 0x1a, 0x71, 0x79, 0xcd, 0xcb, 0xf6,
+	0xfa, 0x0c, 0xbe, 0x9f, 0xc2, 0x89, 0xe7, 0xfa, 0x88, 0x44, 0x14, 0x8b, 0x20, 0x62, 0xda, 0xc5,
+	0x0f, 0xd4, 0x0f, 0xf1, 0x74, 0xcf, 0x16, 0xf7, 0x0a, 0xa6, 0x61, 0xaf, 0x29, 0x86, 0x95, 0x12,
+	0x5e, 0xa5, 0x38, 0xda, 0x82, 0x2a, 0xe3, 0x82, 0x38, 0xf2, 0xd2, 0x65, 0x41, 0x37, 0x45, 0x25,
+	0x31, 0xe7, 0xc4, 0x17, 0xf0, 0xe0, 0xca, 0xe3, 0x82, 0xbd, 0xe0, 0x07, 0x1a, 0x62, 0x87, 0x08,
+	0x3d, 0x1c, 0x8b, 0xf6, 0xda, 0xec, 0xe3, 0x70, 0xa0, 0xc0, 0x2e, 0x11, 0x37, 0xdd, 0x62, 0x21,
+	0x52, 0xb7, 0xd2, 0x4d, 0xb7, 0x63, 0x05, 0x76, 0x89, 0x78, 0xf6, 0x8b, 0x01, 0xab, 0xb7, 0xcc,
+	0x54, 0xf4, 0x14, 0x9e, 0x58, 0x3d, 0xdb, 0xea, 0x0d, 0x8f, 0x77, 0x0f, 0xf0, 0xe1, 0xae, 0xfd,
+	0x75, 0x6f, 0x88, 0x87, 0xdf, 0x58, 0x3d, 0x7c, 0xfc, 0x6a, 0x60, 0xf5, 0xba, 0xfd, 0xfd, 0x7e,
+	0xef, 0xe5, 0xca, 0x1c, 0xda, 0x84, 0x47, 0xb7, 0xd3, 0xba, 0xf6, 0xd1, 0x60, 0xb0, 0x62, 0xa0,
+	0x26, 0xd4, 0x6f, 0x27, 0xf4, 0x07, 0x47, 0x07, 0xbb, 0xc3, 0xde, 0xcb, 0x95, 0xc2, 0xde, 0xeb,
+	0x77, 0xe7, 0x75, 0xe3, 0xfd, 0x79, 0xdd, 0xf8, 0xeb, 0xbc, 0x6e, 0xbc, 0xbd, 0xa8, 0xcf, 0xbd,
+	0xbf, 0xa8, 0xcf, 0xfd, 0x71, 0x51, 0x9f, 0xfb, 0xf6, 0xcb, 0x8f, 0x7f, 0x49, 0xa7, 0xb3, 0x7f,
+	0x52, 0xf4, 0xab, 0x3a, 0x5a, 0xd0, 0xe0, 0x67, 0xff, 0x0c, 0x00, 0xb0, 0x5d, 0x71, 0xff, 0xcc,
+	0x08, 0x00, 0x00,
 }
 
 func (m *Perpetual) Marshal() (dAtA []byte, err error) {
@@ -599,6 +877,30 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MarkPriceConfig != nil {
+		{
+			size, err := m.MarkPriceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPerpetual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FundingConfig != nil {
+		{
+			size, err := m.FundingConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPerpetual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.MarketType != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.MarketType))
 		i--
@@ -639,6 +941,140 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PerpetualFundingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualFundingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualFundingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InterestRateClampPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.InterestRateClampPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InterestRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.InterestRatePpm)<<1)^uint32((m.InterestRatePpm>>31))))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedTailSampleRatioPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.RemovedTailSampleRatioPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FundingRateClampFactorPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.FundingRateClampFactorPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IntervalMultiplier != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.IntervalMultiplier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkPriceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkPriceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkPriceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBasisPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.MaxBasisPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EmaSmoothingPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.EmaSmoothingPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.BasisPpm)<<1)^uint32((m.BasisPpm>>31))))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64((uint32(m.Exponent)<<1)^uint32((m.Exponent>>31))))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Price != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketPremiums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -660,21 +1096,21 @@ func (m *MarketPremiums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Premiums) > 0 {
-		dAtA2 := make([]byte, len(m.Premiums)*5)
-		var j3 int
+		dAtA4 := make([]byte, len(m.Premiums)*5)
+		var j5 int
 		for _, num := range m.Premiums {
-			x4 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x4 >= 1<<7 {
-				dAtA2[j3] = uint8(uint64(x4)&0x7f | 0x80)
-				j3++
-				x4 >>= 7
-			}
-			dAtA2[j3] = uint8(x4)
-			j3++
+			x6 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x6 >= 1<<7 {
+				dAtA4[j5] = uint8(uint64(x6)&0x7f | 0x80)
+				j5++
+				x6 >>= 7
+			}
+			dAtA4[j5] = uint8(x6)
+			j5++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA2[:j3])
-		i = encodeVarintPerpetual(dAtA, i, uint64(j3))
+		i -= j5
+		copy(dAtA[i:], dAtA4[:j5])
+		i = encodeVarintPerpetual(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -847,20 +1283,91 @@ func (m *PerpetualParams) Size() (n int) {
 	if m.MarketType != 0 {
 		n += 1 + sovPerpetual(uint64(m.MarketType))
 	}
+	if m.FundingConfig != nil {
+		l = m.FundingConfig.Size()
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	if m.MarkPriceConfig != nil {
+		l = m.MarkPriceConfig.Size()
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
 	return n
 }
 
-func (m *MarketPremiums) Size() (n int) {
+func (m *PerpetualFundingConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PerpetualId != 0 {
-		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	if m.IntervalMultiplier != 0 {
+		n += 1 + sovPerpetual(uint64(m.IntervalMultiplier))
 	}
-	if len(m.Premiums) > 0 {
-		l = 0
+	if m.FundingRateClampFactorPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.FundingRateClampFactorPpm))
+	}
+	if m.RemovedTailSampleRatioPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.RemovedTailSampleRatioPpm))
+	}
+	if m.InterestRatePpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.InterestRatePpm))
+	}
+	if m.InterestRateClampPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.InterestRateClampPpm))
+	}
+	return n
+}
+
+func (m *MarkPriceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.EmaSmoothingPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.EmaSmoothingPpm))
+	}
+	if m.MaxBasisPpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.MaxBasisPpm))
+	}
+	return n
+}
+
+func (m *MarkPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	if m.Price != 0 {
+		n += 1 + sovPerpetual(uint64(m.Price))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sozPerpetual(uint64(m.Exponent))
+	}
+	if m.BasisPpm != 0 {
+		n += 1 + sozPerpetual(uint64(m.BasisPpm))
+	}
+	return n
+}
+
+func (m *MarketPremiums) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	if len(m.Premiums) > 0 {
+		l = 0
 		for _, e := range m.Premiums {
 			l += sozPerpetual(uint64(e))
 		}
@@ -1255,6 +1762,463 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FundingConfig == nil {
+				m.FundingConfig = &PerpetualFundingConfig{}
+			}
+			if err := m.FundingConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPriceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarkPriceConfig == nil {
+				m.MarkPriceConfig = &MarkPriceConfig{}
+			}
+			if err := m.MarkPriceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualFundingConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualFundingConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualFundingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMultiplier", wireType)
+			}
+			m.IntervalMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateClampFactorPpm", wireType)
+			}
+			m.FundingRateClampFactorPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRateClampFactorPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedTailSampleRatioPpm", wireType)
+			}
+			m.RemovedTailSampleRatioPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovedTailSampleRatioPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRatePpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.InterestRatePpm = v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateClampPpm", wireType)
+			}
+			m.InterestRateClampPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestRateClampPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkPriceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkPriceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkPriceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaSmoothingPpm", wireType)
+			}
+			m.EmaSmoothingPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmaSmoothingPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBasisPpm", wireType)
+			}
+			m.MaxBasisPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBasisPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Exponent = v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPpm", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.BasisPpm = v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
@@ -40,6 +41,100 @@ func TestPerpetualParams_Validate(t *testing.T) {
 			},
 			expectedErr: "DefaultFundingPpm magnitude exceeds maximum value",
 		},
+		{
+			desc: "Valid funding config",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				FundingConfig: &types.PerpetualFundingConfig{
+					IntervalMultiplier:        types.MaxFundingIntervalMultiplier,
+					FundingRateClampFactorPpm: 1_000_000,
+					RemovedTailSampleRatioPpm: 100_000,
+					InterestRatePpm:           -1_000_000,
+					InterestRateClampPpm:      1_000_000,
+				},
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Funding interval multiplier exceeds maximum",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				FundingConfig: &types.PerpetualFundingConfig{
+					IntervalMultiplier: types.MaxFundingIntervalMultiplier + 1,
+				},
+			},
+			expectedErr: "interval multiplier 25 exceeds maximum 24",
+		},
+		{
+			desc: "Removed tail sample ratio removes all samples",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				FundingConfig: &types.PerpetualFundingConfig{
+					RemovedTailSampleRatioPpm: 500_000,
+				},
+			},
+			expectedErr: "removed tail sample ratio ppm 500000 must be less than 500000",
+		},
+		{
+			desc: "Interest rate magnitude exceeds maximum",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				FundingConfig: &types.PerpetualFundingConfig{
+					InterestRatePpm: -1_000_001,
+				},
+			},
+			expectedErr: "interest rate ppm -1000001 magnitude exceeds maximum 1000000",
+		},
+		{
+			desc: "Interest rate clamp exceeds maximum",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				FundingConfig: &types.PerpetualFundingConfig{
+					InterestRateClampPpm: 1_000_001,
+				},
+			},
+			expectedErr: "interest rate clamp ppm 1000001 exceeds maximum 1000000",
+		},
+		{
+			desc: "Valid mark price config",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				MarkPriceConfig: &types.MarkPriceConfig{
+					Enabled:         true,
+					EmaSmoothingPpm: 1_000_000,
+					MaxBasisPpm:     500_000,
+				},
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Mark price ema smoothing exceeds maximum",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				MarkPriceConfig: &types.MarkPriceConfig{
+					EmaSmoothingPpm: 1_000_001,
+				},
+			},
+			expectedErr: "ema smoothing ppm 1000001 exceeds maximum 1000000",
+		},
+		{
+			desc: "Mark price max basis exceeds maximum",
+			params: types.PerpetualParams{
+				Ticker:     "test",
+				MarketType: types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				MarkPriceConfig: &types.MarkPriceConfig{
+					MaxBasisPpm: 500_001,
+				},
+			},
+			expectedErr: "max basis ppm 500001 exceeds maximum 500000",
+		},
 	}
 
 	for _, tc := range tests {
@@ -53,3 +148,79 @@ func TestPerpetualParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPerpetualParams_FundingConfigGetters(t *testing.T) {
+	params := types.PerpetualParams{}
+	require.Equal(t, uint32(1), params.GetFundingIntervalMultiplier())
+	require.Equal(t, uint32(6_000_000), params.GetFundingRateClampFactorPpm(6_000_000))
+	require.Equal(t, types.RemovedTailSampleRatioPpm, params.GetRemovedTailSampleRatioPpm())
+
+	params.FundingConfig = &types.PerpetualFundingConfig{}
+	require.Equal(t, uint32(1), params.GetFundingIntervalMultiplier())
+	require.Equal(t, uint32(6_000_000), params.GetFundingRateClampFactorPpm(6_000_000))
+	require.Equal(t, uint32(0), params.GetRemovedTailSampleRatioPpm())
+
+	params.FundingConfig = &types.PerpetualFundingConfig{
+		IntervalMultiplier:        8,
+		FundingRateClampFactorPpm: 1_000_000,
+		RemovedTailSampleRatioPpm: 50_000,
+	}
+	require.Equal(t, uint32(8), params.GetFundingIntervalMultiplier())
+	require.Equal(t, uint32(1_000_000), params.GetFundingRateClampFactorPpm(6_000_000))
+	require.Equal(t, uint32(50_000), params.GetRemovedTailSampleRatioPpm())
+}
+
+func TestPerpetualFundingConfig_ApplyInterestRate(t *testing.T) {
+	tests := map[string]struct {
+		fundingConfig      *types.PerpetualFundingConfig
+		premiumPpm         int64
+		expectedPremiumPpm int64
+	}{
+		"nil funding config": {
+			fundingConfig:      nil,
+			premiumPpm:         1_000,
+			expectedPremiumPpm: 1_000,
+		},
+		"interest rate component disabled": {
+			fundingConfig: &types.PerpetualFundingConfig{
+				InterestRatePpm: 100,
+			},
+			premiumPpm:         1_000,
+			expectedPremiumPpm: 1_000,
+		},
+		"premium within clamp of interest rate": {
+			fundingConfig: &types.PerpetualFundingConfig{
+				InterestRatePpm:      100,
+				InterestRateClampPpm: 500,
+			},
+			premiumPpm:         -300,
+			expectedPremiumPpm: 100,
+		},
+		"premium above interest rate": {
+			fundingConfig: &types.PerpetualFundingConfig{
+				InterestRatePpm:      100,
+				InterestRateClampPpm: 500,
+			},
+			premiumPpm:         1_000,
+			expectedPremiumPpm: 500,
+		},
+		"premium below interest rate": {
+			fundingConfig: &types.PerpetualFundingConfig{
+				InterestRatePpm:      100,
+				InterestRateClampPpm: 500,
+			},
+			premiumPpm:         -1_000,
+			expectedPremiumPpm: -500,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				big.NewInt(tc.expectedPremiumPpm),
+				tc.fundingConfig.ApplyInterestRate(big.NewInt(tc.premiumPpm)),
+			)
+		})
+	}
+}
@@ -566,6 +566,357 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPredictedFundingRequest is the request type for the PredictedFunding
+// RPC method.
+type QueryPredictedFundingRequest struct {
+}
+
+func (m *QueryPredictedFundingRequest) Reset()         { *m = QueryPredictedFundingRequest{} }
+func (m *QueryPredictedFundingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPredictedFundingRequest) ProtoMessage()    {}
+func (*QueryPredictedFundingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{12}
+}
+func (m *QueryPredictedFundingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPredictedFundingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPredictedFundingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPredictedFundingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPredictedFundingRequest.Merge(m, src)
+}
+func (m *QueryPredictedFundingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPredictedFundingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPredictedFundingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPredictedFundingRequest proto.InternalMessageInfo
+
+// QueryPredictedFundingResponse is the response type for the PredictedFunding
+// RPC method.
+type QueryPredictedFundingResponse struct {
+	PredictedFunding []PredictedFunding `protobuf:"bytes,1,rep,name=predicted_funding,json=predictedFunding,proto3" json:"predicted_funding"`
+}
+
+func (m *QueryPredictedFundingResponse) Reset()         { *m = QueryPredictedFundingResponse{} }
+func (m *QueryPredictedFundingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPredictedFundingResponse) ProtoMessage()    {}
+func (*QueryPredictedFundingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{13}
+}
+func (m *QueryPredictedFundingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPredictedFundingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPredictedFundingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPredictedFundingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPredictedFundingResponse.Merge(m, src)
+}
+func (m *QueryPredictedFundingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPredictedFundingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPredictedFundingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPredictedFundingResponse proto.InternalMessageInfo
+
+func (m *QueryPredictedFundingResponse) GetPredictedFunding() []PredictedFunding {
+	if m != nil {
+		return m.PredictedFunding
+	}
+	return nil
+}
+
+// PredictedFunding is the funding rate a perpetual is expected to be charged
+// at its next funding payment, based on the premium samples collected so far.
+type PredictedFunding struct {
+	// Id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The summarized premium rate of the premium samples collected so far, in
+	// parts-per-million.
+	PremiumPpm int32 `protobuf:"zigzag32,2,opt,name=premium_ppm,json=premiumPpm,proto3" json:"premium_ppm,omitempty"`
+	// The predicted 8-hour funding rate after adding the default funding and
+	// interest rate components and clamping, in parts-per-million.
+	FundingRatePpm int32 `protobuf:"zigzag32,3,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+	// The unix timestamp (in seconds) at which the next funding payment of the
+	// perpetual is processed.
+	NextFundingTick uint32 `protobuf:"varint,4,opt,name=next_funding_tick,json=nextFundingTick,proto3" json:"next_funding_tick,omitempty"`
+}
+
+func (m *PredictedFunding) Reset()         { *m = PredictedFunding{} }
+func (m *PredictedFunding) String() string { return proto.CompactTextString(m) }
+func (*PredictedFunding) ProtoMessage()    {}
+func (*PredictedFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{14}
+}
+func (m *PredictedFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PredictedFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PredictedFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PredictedFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictedFunding.Merge(m, src)
+}
+func (m *PredictedFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *PredictedFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictedFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictedFunding proto.InternalMessageInfo
+
+func (m *PredictedFunding) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PredictedFunding) GetPremiumPpm() int32 {
+	if m != nil {
+		return m.PremiumPpm
+	}
+	return 0
+}
+
+func (m *PredictedFunding) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
+	}
+	return 0
+}
+
+func (m *PredictedFunding) GetNextFundingTick() uint32 {
+	if m != nil {
+		return m.NextFundingTick
+	}
+	return 0
+}
+
+// QueryMarkPriceRequest is the request type for the MarkPrice RPC method.
+type QueryMarkPriceRequest struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMarkPriceRequest) Reset()         { *m = QueryMarkPriceRequest{} }
+func (m *QueryMarkPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkPriceRequest) ProtoMessage()    {}
+func (*QueryMarkPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{15}
+}
+func (m *QueryMarkPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkPriceRequest.Merge(m, src)
+}
+func (m *QueryMarkPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkPriceRequest proto.InternalMessageInfo
+
+func (m *QueryMarkPriceRequest) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryMarkPriceResponse is the response type for the MarkPrice RPC method.
+type QueryMarkPriceResponse struct {
+	MarkPrice MarkPrice `protobuf:"bytes,1,opt,name=mark_price,json=markPrice,proto3" json:"mark_price"`
+}
+
+func (m *QueryMarkPriceResponse) Reset()         { *m = QueryMarkPriceResponse{} }
+func (m *QueryMarkPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarkPriceResponse) ProtoMessage()    {}
+func (*QueryMarkPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{16}
+}
+func (m *QueryMarkPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarkPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarkPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarkPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarkPriceResponse.Merge(m, src)
+}
+func (m *QueryMarkPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarkPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarkPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarkPriceResponse proto.InternalMessageInfo
+
+func (m *QueryMarkPriceResponse) GetMarkPrice() MarkPrice {
+	if m != nil {
+		return m.MarkPrice
+	}
+	return MarkPrice{}
+}
+
+// QueryAllMarkPricesRequest is the request type for the AllMarkPrices RPC
+// method.
+type QueryAllMarkPricesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMarkPricesRequest) Reset()         { *m = QueryAllMarkPricesRequest{} }
+func (m *QueryAllMarkPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarkPricesRequest) ProtoMessage()    {}
+func (*QueryAllMarkPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{17}
+}
+func (m *QueryAllMarkPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMarkPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMarkPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMarkPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarkPricesRequest.Merge(m, src)
+}
+func (m *QueryAllMarkPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMarkPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarkPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMarkPricesRequest proto.InternalMessageInfo
+
+func (m *QueryAllMarkPricesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllMarkPricesResponse is the response type for the AllMarkPrices RPC
+// method.
+type QueryAllMarkPricesResponse struct {
+	MarkPrices []MarkPrice         `protobuf:"bytes,1,rep,name=mark_prices,json=markPrices,proto3" json:"mark_prices"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllMarkPricesResponse) Reset()         { *m = QueryAllMarkPricesResponse{} }
+func (m *QueryAllMarkPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarkPricesResponse) ProtoMessage()    {}
+func (*QueryAllMarkPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{18}
+}
+func (m *QueryAllMarkPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllMarkPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMarkPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllMarkPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarkPricesResponse.Merge(m, src)
+}
+func (m *QueryAllMarkPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMarkPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarkPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllMarkPricesResponse proto.InternalMessageInfo
+
+func (m *QueryAllMarkPricesResponse) GetMarkPrices() []MarkPrice {
+	if m != nil {
+		return m.MarkPrices
+	}
+	return nil
+}
+
+func (m *QueryAllMarkPricesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
 	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
@@ -579,6 +930,13 @@ func init() {
 	proto.RegisterType((*QueryPremiumSamplesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.perpetuals.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.perpetuals.QueryParamsResponse")
+	proto.RegisterType((*QueryPredictedFundingRequest)(nil), "dydxprotocol.perpetuals.QueryPredictedFundingRequest")
+	proto.RegisterType((*QueryPredictedFundingResponse)(nil), "dydxprotocol.perpetuals.QueryPredictedFundingResponse")
+	proto.RegisterType((*PredictedFunding)(nil), "dydxprotocol.perpetuals.PredictedFunding")
+	proto.RegisterType((*QueryMarkPriceRequest)(nil), "dydxprotocol.perpetuals.QueryMarkPriceRequest")
+	proto.RegisterType((*QueryMarkPriceResponse)(nil), "dydxprotocol.perpetuals.QueryMarkPriceResponse")
+	proto.RegisterType((*QueryAllMarkPricesRequest)(nil), "dydxprotocol.perpetuals.QueryAllMarkPricesRequest")
+	proto.RegisterType((*QueryAllMarkPricesResponse)(nil), "dydxprotocol.perpetuals.QueryAllMarkPricesResponse")
 }
 
 func init() {
@@ -586,52 +944,69 @@ func init() {
 }
 
 var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x53, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x51, 0x71, 0x78, 0x42, 0x18, 0x57, 0x54, 0x3c, 0xf1, 0xd0, 0x13, 0x09, 0xa2,
-	0xde, 0x4a, 0x60, 0xb4, 0xd1, 0x42, 0x0a, 0x6c, 0x2c, 0x30, 0x22, 0x85, 0x0d, 0x5e, 0x92, 0x9d,
-	0x63, 0x67, 0xee, 0xb2, 0xc7, 0xdd, 0x86, 0x21, 0xe3, 0xd8, 0x58, 0x5b, 0x38, 0x63, 0x6d, 0xa7,
-	0x25, 0xad, 0xb5, 0x25, 0x25, 0x33, 0x36, 0x56, 0x8e, 0x03, 0xd6, 0xfe, 0x0d, 0x4e, 0x76, 0xf7,
-	0x92, 0x3b, 0xc8, 0x91, 0x84, 0xa1, 0xcb, 0xec, 0xfb, 0xf1, 0xfd, 0xec, 0xf7, 0xf6, 0xbd, 0x09,
-	0xdc, 0xae, 0x35, 0x6b, 0xdb, 0x41, 0xc8, 0x05, 0xaf, 0x72, 0x8f, 0x04, 0x34, 0x0c, 0xa8, 0x68,
-	0x38, 0x5e, 0x44, 0x36, 0x1b, 0x34, 0x6c, 0xda, 0x32, 0x82, 0xaf, 0x26, 0x93, 0xec, 0x4e, 0x92,
-	0x31, 0xee, 0x72, 0x97, 0xcb, 0x00, 0x69, 0xfd, 0x52, 0xe9, 0xc6, 0xa4, 0xcb, 0xb9, 0xeb, 0x51,
-	0xe2, 0x04, 0x8c, 0x38, 0xf5, 0x3a, 0x17, 0x8e, 0x60, 0xbc, 0x1e, 0xe9, 0xe8, 0x5c, 0x95, 0x47,
-	0x3e, 0x8f, 0x48, 0xc5, 0x89, 0xa8, 0x52, 0x21, 0x5b, 0xf3, 0x15, 0x2a, 0x9c, 0x79, 0x12, 0x38,
-	0x2e, 0xab, 0xcb, 0x64, 0x9d, 0x3b, 0x9d, 0x45, 0x17, 0x38, 0xa1, 0xe3, 0xc7, 0x1d, 0x8b, 0x99,
-	0x59, 0xf1, 0x4f, 0x95, 0x68, 0x15, 0xe1, 0xf2, 0xcb, 0x96, 0xe0, 0x4a, 0x7c, 0x5e, 0xa6, 0x9b,
-	0x0d, 0x1a, 0x09, 0x5c, 0x80, 0x3c, 0xab, 0x4d, 0xa0, 0x9b, 0x68, 0x76, 0xb4, 0x9c, 0x67, 0x35,
-	0xeb, 0x2d, 0x5c, 0x39, 0x9c, 0x18, 0x05, 0xbc, 0x1e, 0x51, 0xbc, 0x0c, 0xc3, 0xed, 0xae, 0xb2,
-	0xe0, 0x42, 0xc9, 0xb2, 0x33, 0xec, 0xb1, 0xdb, 0xe5, 0x4b, 0x67, 0x77, 0x7f, 0x4f, 0xe5, 0xca,
-	0x9d, 0x52, 0xab, 0x0a, 0xd7, 0xa4, 0xc2, 0x33, 0xcf, 0x6b, 0x67, 0x45, 0x31, 0xce, 0x32, 0x40,
-	0xc7, 0x0a, 0xad, 0x32, 0x63, 0x2b, 0xdf, 0xec, 0x96, 0x6f, 0xb6, 0xfa, 0x3a, 0xda, 0x37, 0x7b,
-	0xc5, 0x71, 0xa9, 0xae, 0x2d, 0x27, 0x2a, 0xad, 0x1d, 0x04, 0x46, 0x37, 0x95, 0xee, 0x77, 0x39,
-	0x73, 0xc2, 0xbb, 0xe0, 0xe7, 0x29, 0xdc, 0xbc, 0xc4, 0x2d, 0xf6, 0xc4, 0x55, 0x10, 0x29, 0x5e,
-	0x17, 0x6e, 0xc4, 0xb8, 0x2f, 0xd8, 0x66, 0x83, 0xd5, 0x98, 0x68, 0xae, 0x32, 0x1a, 0x9e, 0xba,
-	0x31, 0x3f, 0x10, 0x98, 0x59, 0x4a, 0xda, 0x9c, 0xd7, 0x30, 0xe6, 0xc5, 0x91, 0x75, 0xd1, 0x0a,
-	0x69, 0x8b, 0x66, 0x32, 0x2d, 0x4a, 0x75, 0xd2, 0x36, 0x15, 0xbc, 0x54, 0xfb, 0xd3, 0xf3, 0xca,
-	0x80, 0x09, 0xf5, 0x44, 0x43, 0xea, 0xb3, 0x86, 0xbf, 0xc6, 0x05, 0x8d, 0x6d, 0xb2, 0x7c, 0xfd,
-	0xb8, 0xd2, 0x31, 0x7d, 0xb1, 0x15, 0x18, 0x0d, 0xd4, 0xf9, 0xfa, 0x56, 0x2b, 0xa0, 0x6d, 0xbc,
-	0x93, 0xfd, 0xe5, 0x55, 0xf6, 0x2b, 0xc1, 0x43, 0xaa, 0x6f, 0x35, 0x12, 0x24, 0x3a, 0x5b, 0x93,
-	0xfa, 0x95, 0xc5, 0x89, 0x8e, 0x1f, 0x78, 0x1d, 0x98, 0x08, 0xae, 0x77, 0x8d, 0x6a, 0x9c, 0x55,
-	0x18, 0x8b, 0x71, 0x22, 0x15, 0x3a, 0x09, 0x50, 0x21, 0x48, 0x75, 0xb7, 0xc6, 0x01, 0x2b, 0x51,
-	0xb9, 0x27, 0x62, 0x94, 0x55, 0xb8, 0x94, 0x3a, 0xd5, 0x08, 0x4f, 0x61, 0x48, 0xed, 0x13, 0xad,
-	0x3c, 0x95, 0xad, 0x2c, 0xd3, 0xb4, 0xa6, 0x2e, 0x2a, 0xfd, 0x3b, 0x0f, 0xe7, 0x64, 0x5b, 0xfc,
-	0x05, 0xc1, 0x70, 0x7b, 0x4e, 0xb0, 0x9d, 0xd9, 0xa6, 0xeb, 0x12, 0x32, 0x48, 0xdf, 0xf9, 0x8a,
-	0xdb, 0x22, 0x1f, 0x7e, 0xfe, 0xfd, 0x9c, 0xbf, 0x8b, 0x8b, 0xa4, 0xe7, 0x02, 0x24, 0xef, 0x58,
-	0xed, 0x3d, 0xfe, 0x8a, 0x60, 0x34, 0xb5, 0x0a, 0x70, 0xe9, 0x78, 0xcd, 0x6e, 0xdb, 0xc9, 0x58,
-	0x18, 0xa8, 0x46, 0xb3, 0xce, 0x49, 0xd6, 0x69, 0x6c, 0xf5, 0x66, 0xc5, 0xdf, 0x11, 0x5c, 0x3c,
-	0x32, 0x98, 0xf8, 0x51, 0x4f, 0xd9, 0xae, 0x3b, 0xc3, 0x78, 0x3c, 0x70, 0x9d, 0x46, 0x7e, 0x28,
-	0x91, 0xe7, 0xf0, 0x6c, 0x26, 0xf2, 0xa1, 0x05, 0x81, 0xbf, 0x21, 0x18, 0x49, 0xce, 0x1c, 0x9e,
-	0xef, 0xf1, 0x49, 0x8f, 0xce, 0xae, 0x51, 0x1a, 0xa4, 0x44, 0x93, 0xda, 0x92, 0x74, 0x16, 0xcf,
-	0x64, 0x9b, 0x9b, 0x9c, 0x78, 0xbc, 0x83, 0xa0, 0x90, 0x1e, 0x47, 0xbc, 0xd0, 0x97, 0x6c, 0x7a,
-	0xb4, 0x8d, 0xc5, 0xc1, 0x8a, 0xfa, 0xf6, 0xf5, 0xd0, 0x42, 0xc0, 0x1f, 0x11, 0x0c, 0xa9, 0xd1,
-	0xc3, 0xf7, 0x7a, 0x48, 0x26, 0xe7, 0xdd, 0xb8, 0xdf, 0x5f, 0xb2, 0xe6, 0x2a, 0x4a, 0xae, 0x5b,
-	0x78, 0x8a, 0x1c, 0xff, 0xaf, 0x63, 0x69, 0x6d, 0x77, 0xdf, 0x44, 0x7b, 0xfb, 0x26, 0xfa, 0xb3,
-	0x6f, 0xa2, 0x4f, 0x07, 0x66, 0x6e, 0xef, 0xc0, 0xcc, 0xfd, 0x3a, 0x30, 0x73, 0x6f, 0x9e, 0xb8,
-	0x4c, 0x6c, 0x34, 0x2a, 0x76, 0x95, 0xfb, 0xe9, 0x26, 0x5b, 0x8b, 0x0f, 0xaa, 0x1b, 0x0e, 0xab,
-	0x93, 0xf6, 0xc9, 0x76, 0xb2, 0xb1, 0x68, 0x06, 0x34, 0xaa, 0x0c, 0xc9, 0xe0, 0xc2, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0x45, 0x0d, 0x89, 0x94, 0x09, 0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xd3, 0x12, 0x29, 0x2f, 0xc9, 0x26, 0x19, 0x4a, 0x49, 0x4d, 0xba, 0xa1, 0x6e, 0x9b,
+	0x4d, 0xb7, 0xc5, 0x26, 0x9b, 0x52, 0x2e, 0x70, 0xa0, 0x87, 0x54, 0x95, 0xa8, 0xb4, 0x2c, 0xa1,
+	0x07, 0x84, 0xb4, 0x4c, 0xec, 0xc1, 0x1d, 0x65, 0x6d, 0x4f, 0xec, 0xd9, 0x28, 0x11, 0xea, 0x85,
+	0x33, 0x07, 0x24, 0xce, 0x70, 0xa2, 0x37, 0x2a, 0x71, 0x81, 0x33, 0xc7, 0x1e, 0x2b, 0x71, 0xe1,
+	0x84, 0x50, 0xc2, 0x9d, 0x7f, 0xa1, 0xda, 0xf9, 0xe1, 0xb5, 0x37, 0x76, 0xbc, 0x5b, 0xe5, 0xb6,
+	0x7a, 0xef, 0x7b, 0xef, 0x7d, 0xef, 0x8d, 0xf5, 0x7d, 0x0b, 0xd7, 0xbd, 0x23, 0xef, 0x90, 0xc5,
+	0x11, 0x8f, 0xdc, 0xa8, 0xe7, 0x30, 0x12, 0x33, 0xc2, 0xfb, 0xb8, 0x97, 0x38, 0xfb, 0x7d, 0x12,
+	0x1f, 0xd9, 0x22, 0x83, 0xde, 0xce, 0x82, 0xec, 0x21, 0xc8, 0xbc, 0xe4, 0x47, 0x7e, 0x24, 0x12,
+	0xce, 0xe0, 0x97, 0x84, 0x9b, 0xab, 0x7e, 0x14, 0xf9, 0x3d, 0xe2, 0x60, 0x46, 0x1d, 0x1c, 0x86,
+	0x11, 0xc7, 0x9c, 0x46, 0x61, 0xa2, 0xb2, 0x4d, 0x37, 0x4a, 0x82, 0x28, 0x71, 0x76, 0x71, 0x42,
+	0xe4, 0x14, 0xe7, 0x60, 0x73, 0x97, 0x70, 0xbc, 0xe9, 0x30, 0xec, 0xd3, 0x50, 0x80, 0x15, 0xf6,
+	0x46, 0x19, 0x3b, 0x86, 0x63, 0x1c, 0xe8, 0x8e, 0x8d, 0x52, 0x94, 0xfe, 0x29, 0x81, 0x56, 0x03,
+	0xde, 0xfa, 0x6c, 0x30, 0xb0, 0xad, 0xe3, 0x1d, 0xb2, 0xdf, 0x27, 0x09, 0x47, 0x35, 0x98, 0xa6,
+	0xde, 0x8a, 0xf1, 0xae, 0xb1, 0xb1, 0xd0, 0x99, 0xa6, 0x9e, 0xf5, 0x35, 0x5c, 0x1e, 0x05, 0x26,
+	0x2c, 0x0a, 0x13, 0x82, 0xb6, 0x61, 0x36, 0xed, 0x2a, 0x0a, 0xe6, 0x5a, 0x96, 0x5d, 0x72, 0x1e,
+	0x3b, 0x2d, 0xbf, 0x7f, 0xf1, 0xc5, 0x3f, 0x6b, 0x53, 0x9d, 0x61, 0xa9, 0xe5, 0xc2, 0x15, 0x31,
+	0xe1, 0x93, 0x5e, 0x2f, 0x45, 0x25, 0x9a, 0xce, 0x36, 0xc0, 0xf0, 0x14, 0x6a, 0xca, 0xba, 0x2d,
+	0xef, 0x66, 0x0f, 0xee, 0x66, 0xcb, 0xd7, 0x51, 0x77, 0xb3, 0xdb, 0xd8, 0x27, 0xaa, 0xb6, 0x93,
+	0xa9, 0xb4, 0x9e, 0x1b, 0x60, 0x16, 0x4d, 0x29, 0xde, 0xe5, 0xc2, 0x6b, 0xee, 0x82, 0x1e, 0xe4,
+	0xe8, 0x4e, 0x0b, 0xba, 0x8d, 0x4a, 0xba, 0x92, 0x44, 0x8e, 0xaf, 0x0f, 0x57, 0x35, 0xdd, 0x4f,
+	0xe9, 0x7e, 0x9f, 0x7a, 0x94, 0x1f, 0xed, 0x50, 0x12, 0x9f, 0xfb, 0x61, 0xfe, 0x34, 0xa0, 0x5e,
+	0x36, 0x49, 0x1d, 0xe7, 0x0b, 0x58, 0xec, 0xe9, 0x4c, 0x97, 0x0f, 0x52, 0xea, 0x44, 0xeb, 0xa5,
+	0x27, 0xca, 0x75, 0x52, 0x67, 0xaa, 0xf5, 0x72, 0xed, 0xcf, 0xef, 0x56, 0x26, 0xac, 0xc8, 0x4f,
+	0x34, 0x26, 0x01, 0xed, 0x07, 0x8f, 0x23, 0x4e, 0xf4, 0x99, 0xac, 0x00, 0xae, 0x14, 0xe4, 0xd4,
+	0x62, 0x6d, 0x58, 0x60, 0x32, 0xde, 0x3d, 0x18, 0x24, 0xd4, 0x19, 0x6f, 0x96, 0xbf, 0xbc, 0x44,
+	0x7f, 0xce, 0xa3, 0x98, 0xa8, 0xad, 0xe6, 0x59, 0xa6, 0xb3, 0xb5, 0xaa, 0xbe, 0x32, 0x0d, 0xc4,
+	0x01, 0xeb, 0x0d, 0xc9, 0x24, 0xf0, 0x4e, 0x61, 0x56, 0xd1, 0xd9, 0x81, 0x45, 0x4d, 0x27, 0x91,
+	0xa9, 0xd7, 0x21, 0x54, 0x63, 0xb9, 0xee, 0xd6, 0x25, 0x40, 0x72, 0xa8, 0xd0, 0x09, 0x4d, 0x65,
+	0x07, 0xde, 0xcc, 0x45, 0x15, 0x85, 0x8f, 0x61, 0x46, 0xea, 0x89, 0x9a, 0xbc, 0x56, 0x3e, 0x59,
+	0xc0, 0xd4, 0x4c, 0x55, 0x64, 0xd5, 0x61, 0x55, 0x2f, 0xe8, 0x51, 0x97, 0x13, 0x6f, 0xbb, 0x1f,
+	0x7a, 0x34, 0xf4, 0xf5, 0xd4, 0xa7, 0x70, 0xb5, 0x24, 0xaf, 0xe6, 0x7f, 0x05, 0xcb, 0x4c, 0xe7,
+	0xba, 0xdf, 0xc8, 0xa4, 0xfa, 0xd8, 0x6e, 0x9d, 0x75, 0x84, 0x5c, 0x37, 0x45, 0x6a, 0x89, 0x8d,
+	0xc4, 0xad, 0x5f, 0x0d, 0x58, 0x1a, 0x05, 0xa3, 0x6b, 0x30, 0x9f, 0xf6, 0xea, 0xa6, 0xd2, 0x37,
+	0x97, 0xc6, 0x1e, 0x7a, 0x68, 0x0d, 0xe6, 0xf4, 0xc3, 0x30, 0x16, 0x88, 0x4f, 0x75, 0xb9, 0x03,
+	0x2a, 0xd4, 0x66, 0x01, 0xda, 0x80, 0x25, 0x45, 0xb6, 0x1b, 0x63, 0x4e, 0x04, 0xea, 0x82, 0x40,
+	0xd5, 0x54, 0xbc, 0x83, 0x39, 0x19, 0x20, 0x9b, 0xb0, 0x1c, 0x92, 0x43, 0xae, 0x77, 0xeb, 0x72,
+	0xea, 0xee, 0xad, 0x5c, 0x14, 0x23, 0x17, 0x07, 0x09, 0xc5, 0x6a, 0x87, 0xba, 0x7b, 0xa9, 0x46,
+	0x3f, 0xc2, 0xf1, 0x5e, 0x3b, 0xa6, 0x2e, 0x29, 0xd3, 0x68, 0x0c, 0x97, 0x47, 0x81, 0xea, 0x9e,
+	0x0f, 0x00, 0x02, 0x1c, 0xef, 0x75, 0xd9, 0x20, 0x5a, 0x29, 0xd2, 0x69, 0xbd, 0x16, 0xb6, 0x40,
+	0x07, 0xb2, 0x22, 0x9d, 0xa2, 0xce, 0x5d, 0x8b, 0x7e, 0xcb, 0x88, 0x74, 0x76, 0x8a, 0x5a, 0xe6,
+	0x21, 0xcc, 0x0d, 0x97, 0x49, 0x2a, 0x65, 0x7a, 0x74, 0x1b, 0x48, 0xb7, 0x39, 0x3f, 0xed, 0x69,
+	0xfd, 0x3f, 0x07, 0x6f, 0x08, 0xca, 0xe8, 0x27, 0x03, 0x66, 0x53, 0x67, 0x40, 0x76, 0x29, 0xad,
+	0x42, 0xdb, 0x35, 0x9d, 0xb1, 0xf1, 0x92, 0x84, 0xe5, 0x7c, 0xf7, 0xd7, 0x7f, 0x3f, 0x4e, 0xdf,
+	0x42, 0x0d, 0xa7, 0xd2, 0xf2, 0x9d, 0x6f, 0xa9, 0xf7, 0x14, 0xfd, 0x62, 0xc0, 0x42, 0xce, 0xfc,
+	0x50, 0xeb, 0xec, 0x99, 0x45, 0x7e, 0x6c, 0x6e, 0x4d, 0x54, 0xa3, 0xb8, 0x36, 0x05, 0xd7, 0x1b,
+	0xc8, 0xaa, 0xe6, 0x8a, 0xfe, 0x30, 0x60, 0xf9, 0x94, 0x15, 0xa1, 0x7b, 0x95, 0x63, 0x0b, 0x5d,
+	0xd2, 0xfc, 0x70, 0xe2, 0x3a, 0x45, 0xf9, 0x7d, 0x41, 0xb9, 0x89, 0x36, 0x4a, 0x29, 0x8f, 0x58,
+	0x22, 0x7a, 0x66, 0xc0, 0x7c, 0xd6, 0x65, 0xd0, 0x66, 0xc5, 0x93, 0x9e, 0x76, 0x2b, 0xb3, 0x35,
+	0x49, 0x89, 0x62, 0x6a, 0x0b, 0xa6, 0x1b, 0x68, 0xbd, 0xfc, 0xb8, 0x59, 0x8f, 0x43, 0xcf, 0x0d,
+	0xa8, 0xe5, 0x0d, 0x08, 0x6d, 0x8d, 0x35, 0x36, 0x6f, 0x66, 0xe6, 0xdd, 0xc9, 0x8a, 0xc6, 0xbe,
+	0xeb, 0x88, 0x05, 0xa2, 0xef, 0x0d, 0x98, 0x91, 0x66, 0x83, 0x6e, 0x57, 0x8c, 0xcc, 0x3a, 0x9c,
+	0x79, 0x67, 0x3c, 0xb0, 0xe2, 0xd5, 0x10, 0xbc, 0xae, 0xa1, 0x35, 0xe7, 0xec, 0xff, 0xd9, 0xe8,
+	0xf7, 0x22, 0x0f, 0xf9, 0xa0, 0xf2, 0x16, 0x45, 0x76, 0x68, 0xde, 0x9b, 0xb4, 0x4c, 0x91, 0x6d,
+	0x09, 0xb2, 0x77, 0x50, 0xf3, 0xac, 0x23, 0xe6, 0x4d, 0x14, 0xfd, 0x6c, 0xc0, 0x6c, 0xaa, 0x88,
+	0x55, 0xf2, 0x34, 0xea, 0x38, 0xa6, 0x33, 0x36, 0x7e, 0xec, 0x77, 0x1e, 0x4a, 0xb9, 0xd4, 0xa7,
+	0x67, 0x52, 0x9f, 0x1e, 0x0d, 0x45, 0xba, 0x5a, 0x9f, 0x4e, 0x59, 0x91, 0xb9, 0x35, 0x51, 0x8d,
+	0x22, 0x7b, 0x5b, 0x90, 0xbd, 0x89, 0xae, 0x8f, 0x41, 0xf6, 0xfe, 0xe3, 0x17, 0xc7, 0x75, 0xe3,
+	0xe5, 0x71, 0xdd, 0xf8, 0xf7, 0xb8, 0x6e, 0xfc, 0x70, 0x52, 0x9f, 0x7a, 0x79, 0x52, 0x9f, 0xfa,
+	0xfb, 0xa4, 0x3e, 0xf5, 0xe5, 0x47, 0x3e, 0xe5, 0x4f, 0xfa, 0xbb, 0xb6, 0x1b, 0x05, 0xf9, 0x46,
+	0x07, 0x77, 0xdf, 0x73, 0x9f, 0x60, 0x1a, 0x3a, 0x69, 0xe4, 0x30, 0xdb, 0x9c, 0x1f, 0x31, 0x92,
+	0xec, 0xce, 0x88, 0xe4, 0xd6, 0xab, 0x01, 0x00, 0x3e, 0xf0, 0x27, 0xbc, 0x87, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -658,6 +1033,12 @@ type QueryClient interface {
 	PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error)
 	// Queries the perpetual params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the predicted funding rates of all perpetuals.
+	PredictedFunding(ctx context.Context, in *QueryPredictedFundingRequest, opts ...grpc.CallOption) (*QueryPredictedFundingResponse, error)
+	// Queries the mark price of a perpetual by id.
+	MarkPrice(ctx context.Context, in *QueryMarkPriceRequest, opts ...grpc.CallOption) (*QueryMarkPriceResponse, error)
+	// Queries the mark prices of all perpetuals.
+	AllMarkPrices(ctx context.Context, in *QueryAllMarkPricesRequest, opts ...grpc.CallOption) (*QueryAllMarkPricesResponse, error)
 }
 
 type queryClient struct {
@@ -722,6 +1103,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PredictedFunding(ctx context.Context, in *QueryPredictedFundingRequest, opts ...grpc.CallOption) (*QueryPredictedFundingResponse, error) {
+	out := new(QueryPredictedFundingResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PredictedFunding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarkPrice(ctx context.Context, in *QueryMarkPriceRequest, opts ...grpc.CallOption) (*QueryMarkPriceResponse, error) {
+	out := new(QueryMarkPriceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/MarkPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMarkPrices(ctx context.Context, in *QueryAllMarkPricesRequest, opts ...grpc.CallOption) (*QueryAllMarkPricesResponse, error) {
+	out := new(QueryAllMarkPricesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllMarkPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Perpetual by id.
@@ -736,6 +1144,12 @@ type QueryServer interface {
 	PremiumSamples(context.Context, *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error)
 	// Queries the perpetual params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the predicted funding rates of all perpetuals.
+	PredictedFunding(context.Context, *QueryPredictedFundingRequest) (*QueryPredictedFundingResponse, error)
+	// Queries the mark price of a perpetual by id.
+	MarkPrice(context.Context, *QueryMarkPriceRequest) (*QueryMarkPriceResponse, error)
+	// Queries the mark prices of all perpetuals.
+	AllMarkPrices(context.Context, *QueryAllMarkPricesRequest) (*QueryAllMarkPricesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -760,6 +1174,15 @@ func (*UnimplementedQueryServer) PremiumSamples(ctx context.Context, req *QueryP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PredictedFunding(ctx context.Context, req *QueryPredictedFundingRequest) (*QueryPredictedFundingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictedFunding not implemented")
+}
+func (*UnimplementedQueryServer) MarkPrice(ctx context.Context, req *QueryMarkPriceRequest) (*QueryMarkPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPrice not implemented")
+}
+func (*UnimplementedQueryServer) AllMarkPrices(ctx context.Context, req *QueryAllMarkPricesRequest) (*QueryAllMarkPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMarkPrices not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -873,40 +1296,106 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.perpetuals.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Perpetual",
-			Handler:    _Query_Perpetual_Handler,
-		},
-		{
-			MethodName: "AllPerpetuals",
-			Handler:    _Query_AllPerpetuals_Handler,
-		},
-		{
-			MethodName: "AllLiquidityTiers",
-			Handler:    _Query_AllLiquidityTiers_Handler,
-		},
-		{
-			MethodName: "PremiumVotes",
-			Handler:    _Query_PremiumVotes_Handler,
-		},
-		{
-			MethodName: "PremiumSamples",
-			Handler:    _Query_PremiumSamples_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/perpetuals/query.proto",
-}
-
-func (m *QueryPerpetualRequest) Marshal() (dAtA []byte, err error) {
+func _Query_PredictedFunding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPredictedFundingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PredictedFunding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PredictedFunding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PredictedFunding(ctx, req.(*QueryPredictedFundingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarkPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarkPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarkPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/MarkPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarkPrice(ctx, req.(*QueryMarkPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMarkPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarkPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMarkPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/AllMarkPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMarkPrices(ctx, req.(*QueryAllMarkPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.perpetuals.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Perpetual",
+			Handler:    _Query_Perpetual_Handler,
+		},
+		{
+			MethodName: "AllPerpetuals",
+			Handler:    _Query_AllPerpetuals_Handler,
+		},
+		{
+			MethodName: "AllLiquidityTiers",
+			Handler:    _Query_AllLiquidityTiers_Handler,
+		},
+		{
+			MethodName: "PremiumVotes",
+			Handler:    _Query_PremiumVotes_Handler,
+		},
+		{
+			MethodName: "PremiumSamples",
+			Handler:    _Query_PremiumSamples_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PredictedFunding",
+			Handler:    _Query_PredictedFunding_Handler,
+		},
+		{
+			MethodName: "MarkPrice",
+			Handler:    _Query_MarkPrice_Handler,
+		},
+		{
+			MethodName: "AllMarkPrices",
+			Handler:    _Query_AllMarkPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dydxprotocol/perpetuals/query.proto",
+}
+
+func (m *QueryPerpetualRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1303,171 +1792,1083 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPredictedFundingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPerpetualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+
+func (m *QueryPredictedFundingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPerpetualResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPredictedFundingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Perpetual.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPerpetualsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPredictedFundingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPerpetualsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPredictedFundingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPredictedFundingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Perpetual) > 0 {
-		for _, e := range m.Perpetual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.PredictedFunding) > 0 {
+		for iNdEx := len(m.PredictedFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PredictedFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLiquidityTiersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *PredictedFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLiquidityTiersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PredictedFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PredictedFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityTiers) > 0 {
-		for _, e := range m.LiquidityTiers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.NextFundingTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextFundingTick))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint32(m.FundingRatePpm)<<1)^uint32((m.FundingRatePpm>>31))))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.PremiumPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64((uint32(m.PremiumPpm)<<1)^uint32((m.PremiumPpm>>31))))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPremiumVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMarkPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPremiumVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PremiumVotes.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMarkPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPremiumSamplesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMarkPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPremiumSamplesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMarkPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PremiumSamples.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryMarkPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarkPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MarkPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarkPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarkPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarkPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkPrices) > 0 {
+		for iNdEx := len(m.MarkPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarkPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Perpetual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPerpetualsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPerpetualsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Perpetual) > 0 {
+		for _, e := range m.Perpetual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLiquidityTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLiquidityTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityTiers) > 0 {
+		for _, e := range m.LiquidityTiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPremiumVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPremiumSamplesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumSamplesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumSamples.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPredictedFundingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPredictedFundingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PredictedFunding) > 0 {
+		for _, e := range m.PredictedFunding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PredictedFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.PremiumPpm != 0 {
+		n += 1 + sozQuery(uint64(m.PremiumPpm))
+	}
+	if m.FundingRatePpm != 0 {
+		n += 1 + sozQuery(uint64(m.FundingRatePpm))
+	}
+	if m.NextFundingTick != 0 {
+		n += 1 + sovQuery(uint64(m.NextFundingTick))
+	}
+	return n
+}
+
+func (m *QueryMarkPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMarkPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarkPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMarkPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarkPrices) > 0 {
+		for _, e := range m.MarkPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perpetual = append(m.Perpetual, Perpetual{})
+			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityTiers = append(m.LiquidityTiers, LiquidityTier{})
+			if err := m.LiquidityTiers[len(m.LiquidityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1490,31 +2891,12 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1536,7 +2918,7 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1559,15 +2941,15 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1594,7 +2976,7 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PremiumVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1619,7 +3001,7 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1642,15 +3024,65 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamples", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1677,10 +3109,7 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PremiumSamples.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1705,7 +3134,7 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1728,15 +3157,65 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1763,47 +3242,60 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Perpetual = append(m.Perpetual, Perpetual{})
-			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPredictedFundingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPredictedFundingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPredictedFundingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1825,7 +3317,7 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPredictedFundingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,15 +3340,15 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPredictedFundingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPredictedFundingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedFunding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1883,10 +3375,8 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PredictedFunding = append(m.PredictedFunding, PredictedFunding{})
+			if err := m.PredictedFunding[len(m.PredictedFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1911,7 +3401,7 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
+func (m *PredictedFunding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,17 +3424,17 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PredictedFunding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PredictedFunding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1954,31 +3444,16 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityTiers = append(m.LiquidityTiers, LiquidityTier{})
-			if err := m.LiquidityTiers[len(m.LiquidityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumPpm", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1988,28 +3463,53 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.PremiumPpm = v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.FundingRatePpm = v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingTick", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextFundingTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFundingTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2031,7 +3531,7 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarkPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,12 +3554,31 @@ func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarkPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarkPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2081,7 +3600,7 @@ func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarkPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,15 +3623,15 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarkPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarkPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2139,7 +3658,7 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PremiumVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2164,57 +3683,7 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumSamplesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMarkPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2237,15 +3706,15 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumSamplesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMarkPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMarkPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2272,7 +3741,10 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PremiumSamples.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2297,7 +3769,7 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMarkPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,65 +3792,49 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMarkPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMarkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrices", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MarkPrices = append(m.MarkPrices, MarkPrice{})
+			if err := m.MarkPrices[len(m.MarkPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2405,7 +3861,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -115,6 +115,16 @@ type PerpetualsKeeper interface {
 		id uint32,
 		marketType PerpetualMarketType,
 	) (Perpetual, error)
+	SetPerpetualFundingConfig(
+		ctx sdk.Context,
+		id uint32,
+		fundingConfig *PerpetualFundingConfig,
+	) (Perpetual, error)
+	SetPerpetualMarkPriceConfig(
+		ctx sdk.Context,
+		id uint32,
+		markPriceConfig *MarkPriceConfig,
+	) (Perpetual, error)
 	GetPerpetual(
 		ctx sdk.Context,
 		id uint32,
@@ -124,6 +134,7 @@ type PerpetualsKeeper interface {
 	) []Perpetual
 	GetAllLiquidityTiers(ctx sdk.Context) (list []LiquidityTier)
 	SendOIUpdatesToIndexer(ctx sdk.Context)
+	UpdateMarkPrices(ctx sdk.Context)
 	ValidateAndSetPerpetual(
 		ctx sdk.Context,
 		perpetual Perpetual,
@@ -352,6 +352,9 @@ func TestMsgDepositToSubaccount(t *testing.T) {
 
 		// Whether CheckTx errors.
 		checkTxIsError bool
+
+		// Whether DeliverTx errors.
+		deliverTxIsError bool
 	}{
 		"Deposit from Alice account to Alice subaccount": {
 			accountAccAddress: constants.AliceAccAddress,
@@ -375,13 +378,12 @@ func TestMsgDepositToSubaccount(t *testing.T) {
 			quantums: big.NewInt(7_000_000),
 			asset:    *constants.Usdc,
 		},
-		"Deposit a non-USDC asset": {
-			accountAccAddress:       constants.AliceAccAddress,
-			subaccountId:            constants.Carl_Num0,
-			quantums:                big.NewInt(7_000_000),
-			asset:                   *constants.BtcUsd, // non-USDC asset
-			checkTxResponseContains: "Non-USDC asset transfer not implemented",
-			checkTxIsError:          true,
+		"Deposit an asset that does not exist": {
+			accountAccAddress: constants.AliceAccAddress,
+			subaccountId:      constants.Carl_Num0,
+			quantums:          big.NewInt(7_000_000),
+			asset:             *constants.BtcUsd, // not in genesis
+			deliverTxIsError:  true,
 		},
 		"Deposit zero amount": {
 			accountAccAddress:       constants.AliceAccAddress,
@@ -444,6 +446,28 @@ func TestMsgDepositToSubaccount(t *testing.T) {
 
 			// Check that no indexer events are emitted so far.
 			require.Empty(t, msgSender.GetOnchainMessages())
+
+			if tc.deliverTxIsError {
+				// Check that DeliverTx fails.
+				tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{
+					ValidateFinalizeBlock: func(
+						context sdktypes.Context,
+						request abcitypes.RequestFinalizeBlock,
+						response abcitypes.ResponseFinalizeBlock,
+					) (haltChain bool) {
+						for i, tx := range request.Txs {
+							if bytes.Equal(tx, CheckTx_MsgDepositToSubaccount.Tx) {
+								require.True(t, response.TxResults[i].IsErr())
+							} else {
+								require.True(t, response.TxResults[i].IsOK())
+							}
+						}
+						return false
+					},
+				})
+				return
+			}
+
 			// Advance to block 3 for transactions to be delivered.
 			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
 
@@ -549,6 +573,9 @@ func TestMsgWithdrawFromSubaccount(t *testing.T) {
 
 		// Whether CheckTx errors.
 		checkTxIsError bool
+
+		// Whether DeliverTx errors.
+		deliverTxIsError bool
 	}{
 		"Withdraw from Alice subaccount to Alice account": {
 			accountAccAddress: constants.AliceAccAddress,
@@ -569,13 +596,12 @@ func TestMsgWithdrawFromSubaccount(t *testing.T) {
 			quantums:          big.NewInt(7_000_000),
 			asset:             *constants.Usdc,
 		},
-		"Withdraw a non-USDC asset": {
-			accountAccAddress:       constants.AliceAccAddress,
-			subaccountId:            constants.Carl_Num0,
-			quantums:                big.NewInt(7_000_000),
-			asset:                   *constants.BtcUsd, // non-USDC asset
-			checkTxResponseContains: "Non-USDC asset transfer not implemented",
-			checkTxIsError:          true,
+		"Withdraw an asset that does not exist": {
+			accountAccAddress: constants.AliceAccAddress,
+			subaccountId:      constants.Carl_Num0,
+			quantums:          big.NewInt(7_000_000),
+			asset:             *constants.BtcUsd, // not in genesis
+			deliverTxIsError:  true,
 		},
 		"Withdraw zero amount": {
 			accountAccAddress:       constants.AliceAccAddress,
@@ -638,6 +664,28 @@ func TestMsgWithdrawFromSubaccount(t *testing.T) {
 
 			// Check that no indexer events are emitted so far.
 			require.Empty(t, msgSender.GetOnchainMessages())
+
+			if tc.deliverTxIsError {
+				// Check that DeliverTx fails.
+				tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{
+					ValidateFinalizeBlock: func(
+						context sdktypes.Context,
+						request abcitypes.RequestFinalizeBlock,
+						response abcitypes.ResponseFinalizeBlock,
+					) (haltChain bool) {
+						for i, tx := range request.Txs {
+							if bytes.Equal(tx, CheckTx_MsgWithdrawFromSubaccount.Tx) {
+								require.True(t, response.TxResults[i].IsErr())
+							} else {
+								require.True(t, response.TxResults[i].IsOK())
+							}
+						}
+						return false
+					},
+				})
+				return
+			}
+
 			// Advance to block 3 for transactions to be delivered.
 			ctx = tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{})
 
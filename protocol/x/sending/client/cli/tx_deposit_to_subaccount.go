@@ -20,7 +20,7 @@ func CmdDepositToSubaccount() *cobra.Command {
 		Long: `Deposit funds from an account to a subaccount.
 Note, the '--from' flag is ignored as it is implied from [sender_key_or_address].
 [recipient_address] and [recipient_subaccount_number] together specify the recipient subaccount.
-[quantums] specifies the amount to deposit.
+[quantums] specifies the amount to deposit, in quantums of the asset given by the '--asset-id' flag.
 `,
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -42,6 +42,11 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 				return err
 			}
 
+			argAssetId, err := cmd.Flags().GetUint32(FlagAssetId)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -53,7 +58,7 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 					Owner:  argRecipientOwner,
 					Number: argRecipientNumber,
 				},
-				assettypes.AssetUsdc.Id,
+				argAssetId,
 				argAmount,
 			)
 
@@ -65,6 +70,7 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagAssetId, assettypes.AssetUsdc.Id, "Id of the asset to deposit")
 
 	return cmd
 }
@@ -20,7 +20,7 @@ func CmdWithdrawFromSubaccount() *cobra.Command {
 		Long: `Withdraw funds from a subaccount to an account.
 Note, the '--from' flag is ignored as it is implied from [sender_key_or_address].
 [sender_key_or_address] and [sender_subaccount_number] together specify the sender subaccount.
-[quantums] specifies the amount to withdraw.
+[quantums] specifies the amount to withdraw, in quantums of the asset given by the '--asset-id' flag.
 `,
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -42,6 +42,11 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 				return err
 			}
 
+			argAssetId, err := cmd.Flags().GetUint32(FlagAssetId)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -53,7 +58,7 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 					Number: argSenderNumber,
 				},
 				argRecipient,
-				assettypes.AssetUsdc.Id,
+				argAssetId,
 				argAmount,
 			)
 
@@ -65,6 +70,7 @@ Note, the '--from' flag is ignored as it is implied from [sender_key_or_address]
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagAssetId, assettypes.AssetUsdc.Id, "Id of the asset to withdraw")
 
 	return cmd
 }
@@ -30,6 +30,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			),
 		)
 	}
+
+	// Initialize the auto-deleveraging information of the perpetual positions in genesis.
+	k.InitializeAdlPositions(ctx)
 }
 
 // ExportGenesis returns the subaccounts module's exported genesis.
@@ -0,0 +1,451 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetAdlPosition returns the auto-deleveraging information of the perpetual position of a
+// subaccount, and whether it exists.
+func (k Keeper) GetAdlPosition(
+	ctx sdk.Context,
+	perpetualId uint32,
+	subaccountId types.SubaccountId,
+) (
+	adlPosition types.AdlPosition,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionKeyPrefix))
+
+	b := store.Get(types.AdlPositionKey(perpetualId, subaccountId))
+	if b == nil {
+		return adlPosition, false
+	}
+
+	k.cdc.MustUnmarshal(b, &adlPosition)
+	return adlPosition, true
+}
+
+// setAdlPosition stores the auto-deleveraging information of a perpetual position.
+func (k Keeper) setAdlPosition(
+	ctx sdk.Context,
+	adlPosition types.AdlPosition,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionKeyPrefix))
+	store.Set(
+		types.AdlPositionKey(adlPosition.PerpetualId, adlPosition.SubaccountId),
+		k.cdc.MustMarshal(&adlPosition),
+	)
+}
+
+// removeAdlPosition removes the auto-deleveraging information of the perpetual position of a
+// subaccount, along with its entry in the auto-deleveraging rank index.
+func (k Keeper) removeAdlPosition(
+	ctx sdk.Context,
+	perpetualId uint32,
+	subaccountId types.SubaccountId,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionKeyPrefix))
+	store.Delete(types.AdlPositionKey(perpetualId, subaccountId))
+	k.removeAdlRank(ctx, perpetualId, subaccountId)
+}
+
+// updateAdlPositions updates the auto-deleveraging information of the perpetual positions
+// modified by `settledUpdates`. It must be called before the perpetual updates are applied to the
+// settled subaccounts. The fill notional of an update with a single perpetual update is the negated
+// USDC delta of the update, and otherwise the oracle notional of the perpetual update.
+// Positions which are closed by the updates are removed.
+func (k Keeper) updateAdlPositions(
+	ctx sdk.Context,
+	settledUpdates []SettledUpdate,
+) error {
+	for _, u := range settledUpdates {
+		var bigUsdcDeltaQuantums *big.Int
+		for _, assetUpdate := range u.AssetUpdates {
+			if assetUpdate.AssetId == assettypes.AssetUsdc.Id {
+				bigUsdcDeltaQuantums = assetUpdate.GetBigQuantums()
+			}
+		}
+
+		for _, perpetualUpdate := range u.PerpetualUpdates {
+			perpetualId := perpetualUpdate.GetId()
+			bigDeltaQuantums := perpetualUpdate.GetBigQuantums()
+			if bigDeltaQuantums.Sign() == 0 {
+				continue
+			}
+
+			bigOldQuantums := new(big.Int)
+			if position, exists := u.SettledSubaccount.GetPerpetualPositionForId(perpetualId); exists {
+				bigOldQuantums = position.GetBigQuantums()
+			}
+			bigNewQuantums := new(big.Int).Add(bigOldQuantums, bigDeltaQuantums)
+			if bigNewQuantums.Sign() == 0 {
+				k.removeAdlPosition(ctx, perpetualId, *u.SettledSubaccount.Id)
+				continue
+			}
+
+			var bigFillQuoteQuantums *big.Int
+			if len(u.PerpetualUpdates) == 1 && bigUsdcDeltaQuantums != nil {
+				bigFillQuoteQuantums = new(big.Int).Neg(bigUsdcDeltaQuantums)
+			} else {
+				var err error
+				bigFillQuoteQuantums, err = k.perpetualsKeeper.GetNetCollateral(ctx, perpetualId, bigDeltaQuantums)
+				if err != nil {
+					return err
+				}
+			}
+
+			adlPosition, _ := k.GetAdlPosition(ctx, perpetualId, *u.SettledSubaccount.Id)
+			bigOldEntryQuoteQuantums := adlPosition.EntryQuoteQuantums.BigInt()
+			if bigOldEntryQuoteQuantums == nil || bigOldEntryQuoteQuantums.Sign() != bigOldQuantums.Sign() {
+				// Positions without an entry notional are valued at the oracle price.
+				var err error
+				bigOldEntryQuoteQuantums, err = k.perpetualsKeeper.GetNetCollateral(ctx, perpetualId, bigOldQuantums)
+				if err != nil {
+					return err
+				}
+			}
+
+			k.setAdlPosition(ctx, types.AdlPosition{
+				SubaccountId: *u.SettledSubaccount.Id,
+				PerpetualId:  perpetualId,
+				IsLong:       bigNewQuantums.Sign() > 0,
+				EntryQuoteQuantums: dtypes.NewIntFromBigInt(
+					types.GetUpdatedAdlEntryQuoteQuantums(
+						bigOldQuantums,
+						bigOldEntryQuoteQuantums,
+						bigDeltaQuantums,
+						bigFillQuoteQuantums,
+					),
+				),
+			})
+		}
+	}
+
+	return nil
+}
+
+// InitializeAdlPositions stores the auto-deleveraging information of all perpetual positions in
+// state which do not have it on the same side as the position, such as positions written at genesis
+// or positions opened before auto-deleveraging information was tracked, and ranks all positions at
+// the current oracle prices. The entry notional of these positions is their oracle notional.
+// Positions which cannot be valued are skipped.
+func (k Keeper) InitializeAdlPositions(ctx sdk.Context) {
+	for _, subaccount := range k.GetAllSubaccount(ctx) {
+		for _, position := range subaccount.PerpetualPositions {
+			bigQuantums := position.GetBigQuantums()
+			adlPosition, found := k.GetAdlPosition(ctx, position.PerpetualId, *subaccount.Id)
+			if found && adlPosition.IsLong == (bigQuantums.Sign() > 0) {
+				continue
+			}
+
+			bigEntryQuoteQuantums, err := k.perpetualsKeeper.GetNetCollateral(ctx, position.PerpetualId, bigQuantums)
+			if err != nil {
+				continue
+			}
+			k.setAdlPosition(ctx, types.AdlPosition{
+				SubaccountId:       *subaccount.Id,
+				PerpetualId:        position.PerpetualId,
+				IsLong:             bigQuantums.Sign() > 0,
+				EntryQuoteQuantums: dtypes.NewIntFromBigInt(bigEntryQuoteQuantums),
+			})
+		}
+
+		if len(subaccount.PerpetualPositions) > 0 {
+			k.rankAdlPositionsOfSubaccount(ctx, *subaccount.Id)
+		}
+	}
+
+	for _, perpetual := range k.perpetualsKeeper.GetAllPerpetuals(ctx) {
+		if _, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetual.Params.Id); err == nil {
+			k.setAdlOraclePrice(ctx, perpetual.Params.Id, marketPrice.Price)
+		}
+	}
+}
+
+// UpdateAdlRanks re-ranks the positions of all subaccounts with a position in a perpetual whose
+// oracle price changed since its positions were last ranked. Since the score of a position depends
+// on the net collateral of its subaccount, all positions of these subaccounts are re-ranked.
+// It should be called in EndBlocker once the prices and funding indices of the block are final.
+func (k Keeper) UpdateAdlRanks(ctx sdk.Context) {
+	subaccountIds := make([]types.SubaccountId, 0)
+	seenSubaccountIds := make(map[types.SubaccountId]bool)
+	for _, perpetual := range k.perpetualsKeeper.GetAllPerpetuals(ctx) {
+		perpetualId := perpetual.Params.Id
+		_, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+		if err != nil {
+			continue
+		}
+		if oraclePrice, found := k.getAdlOraclePrice(ctx, perpetualId); found && oraclePrice == marketPrice.Price {
+			continue
+		}
+		k.setAdlOraclePrice(ctx, perpetualId, marketPrice.Price)
+
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			append([]byte(types.AdlPositionKeyPrefix), lib.Uint32ToKey(perpetualId)...),
+		)
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			var adlPosition types.AdlPosition
+			k.cdc.MustUnmarshal(iterator.Value(), &adlPosition)
+			if !seenSubaccountIds[adlPosition.SubaccountId] {
+				seenSubaccountIds[adlPosition.SubaccountId] = true
+				subaccountIds = append(subaccountIds, adlPosition.SubaccountId)
+			}
+		}
+		iterator.Close()
+	}
+
+	for _, subaccountId := range subaccountIds {
+		k.rankAdlPositionsOfSubaccount(ctx, subaccountId)
+	}
+}
+
+// getAdlOraclePrice returns the oracle price at which the positions of a perpetual were last
+// ranked, and whether it exists.
+func (k Keeper) getAdlOraclePrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	price uint64,
+	found bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlOraclePriceKeyPrefix))
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+// setAdlOraclePrice stores the oracle price at which the positions of a perpetual were last ranked.
+func (k Keeper) setAdlOraclePrice(
+	ctx sdk.Context,
+	perpetualId uint32,
+	price uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlOraclePriceKeyPrefix))
+	store.Set(lib.Uint32ToKey(perpetualId), binary.BigEndian.AppendUint64(nil, price))
+}
+
+// rankAdlPositionsOfSubaccount ranks all perpetual positions of a subaccount by their
+// auto-deleveraging scores at the current oracle prices, after settling funding.
+func (k Keeper) rankAdlPositionsOfSubaccount(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) {
+	settledSubaccount, _, err := k.getSettledSubaccount(ctx, k.GetSubaccount(ctx, subaccountId))
+	if err != nil {
+		return
+	}
+	k.rankAdlPositions(ctx, settledSubaccount)
+}
+
+// rankAdlPositions ranks all perpetual positions of a settled subaccount by their
+// auto-deleveraging scores at the current oracle prices. Positions without auto-deleveraging
+// information are not ranked, and positions which cannot be valued are given the lowest possible
+// score.
+func (k Keeper) rankAdlPositions(
+	ctx sdk.Context,
+	settledSubaccount types.Subaccount,
+) {
+	bigNetCollateral, _, _, err := k.internalGetNetCollateralAndMarginRequirements(
+		ctx,
+		SettledUpdate{SettledSubaccount: settledSubaccount},
+		k.perpetualsKeeper,
+	)
+
+	for _, position := range settledSubaccount.PerpetualPositions {
+		adlPosition, found := k.GetAdlPosition(ctx, position.PerpetualId, *settledSubaccount.Id)
+		if !found {
+			continue
+		}
+
+		score := int64(math.MinInt64)
+		if err == nil {
+			score = k.getAdlScore(ctx, adlPosition, position, bigNetCollateral)
+		}
+		k.setAdlRank(ctx, adlPosition, score)
+	}
+}
+
+// getAdlScore returns the auto-deleveraging score of a perpetual position at the current oracle
+// prices, given the net collateral of its subaccount after settling funding. Positions without an
+// entry notional on the same side as the position use their oracle notional as the entry notional.
+// Positions which cannot be valued are given the lowest possible score.
+func (k Keeper) getAdlScore(
+	ctx sdk.Context,
+	adlPosition types.AdlPosition,
+	position *types.PerpetualPosition,
+	bigNetCollateral *big.Int,
+) int64 {
+	bigQuantums := position.GetBigQuantums()
+	bigNotionalQuoteQuantums, err := k.perpetualsKeeper.GetNetCollateral(ctx, position.PerpetualId, bigQuantums)
+	if err != nil {
+		return math.MinInt64
+	}
+
+	bigEntryQuoteQuantums := adlPosition.EntryQuoteQuantums.BigInt()
+	if bigEntryQuoteQuantums == nil || bigEntryQuoteQuantums.Sign() != bigQuantums.Sign() {
+		bigEntryQuoteQuantums = bigNotionalQuoteQuantums
+	}
+
+	return types.GetAdlScore(bigNotionalQuoteQuantums, bigEntryQuoteQuantums, bigNetCollateral)
+}
+
+// setAdlRank indexes a perpetual position in the auto-deleveraging rank index of its perpetual and
+// side by its score, replacing the previous entry of the position, if any.
+func (k Keeper) setAdlRank(
+	ctx sdk.Context,
+	adlPosition types.AdlPosition,
+	score int64,
+) {
+	positionKey := types.AdlPositionKey(adlPosition.PerpetualId, adlPosition.SubaccountId)
+	rankKey := types.AdlRankKey(adlPosition.PerpetualId, adlPosition.IsLong, score, adlPosition.SubaccountId)
+	positionRankStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionRankKeyPrefix))
+	if bytes.Equal(positionRankStore.Get(positionKey), rankKey) {
+		return
+	}
+	k.removeAdlRank(ctx, adlPosition.PerpetualId, adlPosition.SubaccountId)
+
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlRankKeyPrefix))
+	rankStore.Set(rankKey, k.cdc.MustMarshal(&adlPosition.SubaccountId))
+	positionRankStore.Set(positionKey, rankKey)
+
+	sideKeyPrefix := types.GetAdlRankSideKeyPrefixFromRankKey(rankKey)
+	k.setAdlRankCount(ctx, sideKeyPrefix, k.getAdlRankCount(ctx, sideKeyPrefix)+1)
+}
+
+// removeAdlRank removes the perpetual position of a subaccount from the auto-deleveraging rank
+// index, if it is indexed.
+func (k Keeper) removeAdlRank(
+	ctx sdk.Context,
+	perpetualId uint32,
+	subaccountId types.SubaccountId,
+) {
+	positionKey := types.AdlPositionKey(perpetualId, subaccountId)
+	positionRankStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionRankKeyPrefix))
+	rankKey := positionRankStore.Get(positionKey)
+	if rankKey == nil {
+		return
+	}
+
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlRankKeyPrefix))
+	rankStore.Delete(rankKey)
+	positionRankStore.Delete(positionKey)
+
+	sideKeyPrefix := types.GetAdlRankSideKeyPrefixFromRankKey(rankKey)
+	k.setAdlRankCount(ctx, sideKeyPrefix, k.getAdlRankCount(ctx, sideKeyPrefix)-1)
+}
+
+// getAdlRankCount returns the number of positions in the auto-deleveraging rank index of the
+// perpetual and side of `sideKeyPrefix`.
+func (k Keeper) getAdlRankCount(
+	ctx sdk.Context,
+	sideKeyPrefix []byte,
+) uint32 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlRankCountKeyPrefix))
+	b := store.Get(sideKeyPrefix)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+// setAdlRankCount stores the number of positions in the auto-deleveraging rank index of the
+// perpetual and side of `sideKeyPrefix`.
+func (k Keeper) setAdlRankCount(
+	ctx sdk.Context,
+	sideKeyPrefix []byte,
+	count uint32,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlRankCountKeyPrefix))
+	if count == 0 {
+		store.Delete(sideKeyPrefix)
+		return
+	}
+	store.Set(sideKeyPrefix, lib.Uint32ToKey(count))
+}
+
+// getAdlRankSideStore returns the auto-deleveraging rank index of the positions in the perpetual
+// and side of `sideKeyPrefix`, iterated by descending score and then by ascending subaccount id.
+func (k Keeper) getAdlRankSideStore(
+	ctx sdk.Context,
+	sideKeyPrefix []byte,
+) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.AdlRankKeyPrefix), sideKeyPrefix...),
+	)
+}
+
+// GetAdlRankedSubaccountIds returns the ids of up to `limit` subaccounts with a position in the
+// perpetual on the given side, ordered by descending auto-deleveraging score. Ties are broken by
+// ascending subaccount id. Only the first `limit` entries of the rank index are iterated.
+func (k Keeper) GetAdlRankedSubaccountIds(
+	ctx sdk.Context,
+	perpetualId uint32,
+	isLong bool,
+	limit uint32,
+) []types.SubaccountId {
+	iterator := k.getAdlRankSideStore(ctx, types.AdlRankSideKeyPrefix(perpetualId, isLong)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	subaccountIds := make([]types.SubaccountId, 0)
+	for ; iterator.Valid() && uint32(len(subaccountIds)) < limit; iterator.Next() {
+		var subaccountId types.SubaccountId
+		k.cdc.MustUnmarshal(iterator.Value(), &subaccountId)
+		subaccountIds = append(subaccountIds, subaccountId)
+	}
+
+	return subaccountIds
+}
+
+// GetAdlRank returns the auto-deleveraging information of the perpetual position of a subaccount,
+// the score at which it is ranked, its 1-indexed rank in the auto-deleveraging queue of its
+// perpetual and side, and the number of positions in the queue of its perpetual and side.
+// The rank is the number of entries of the rank index up to and including the position.
+// Returns false if the subaccount has no ranked position in the perpetual.
+func (k Keeper) GetAdlRank(
+	ctx sdk.Context,
+	perpetualId uint32,
+	subaccountId types.SubaccountId,
+) (
+	adlPosition types.AdlPosition,
+	score int64,
+	rank uint32,
+	numPositions uint32,
+	found bool,
+) {
+	adlPosition, found = k.GetAdlPosition(ctx, perpetualId, subaccountId)
+	if !found {
+		return adlPosition, 0, 0, 0, false
+	}
+
+	positionRankStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.AdlPositionRankKeyPrefix))
+	rankKey := positionRankStore.Get(types.AdlPositionKey(perpetualId, subaccountId))
+	if rankKey == nil {
+		return adlPosition, 0, 0, 0, false
+	}
+
+	sideKeyPrefix := types.GetAdlRankSideKeyPrefixFromRankKey(rankKey)
+	iterator := k.getAdlRankSideStore(ctx, sideKeyPrefix).Iterator(nil, rankKey[len(sideKeyPrefix):])
+	defer iterator.Close()
+
+	rank = 1
+	for ; iterator.Valid(); iterator.Next() {
+		rank++
+	}
+
+	return adlPosition, types.GetAdlScoreFromRankKey(rankKey), rank, k.getAdlRankCount(ctx, sideKeyPrefix), true
+}
@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdlRanking(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _ := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	// The oracle price of BTC is $50,000.
+	p := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	for _, id := range []types.SubaccountId{
+		constants.Alice_Num0,
+		constants.Bob_Num0,
+		constants.Carl_Num0,
+		constants.Dave_Num0,
+	} {
+		keeper.SetSubaccount(ctx, types.Subaccount{
+			Id:             &id,
+			AssetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(100_000_000_000)), // $100,000
+		})
+	}
+	require.Empty(t, keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, true, 10))
+	require.Empty(t, keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, false, 10))
+
+	// match buys `quantums` of BTC for `buyer` from `seller` for `quoteQuantums` of USDC.
+	match := func(buyer, seller types.SubaccountId, quantums int64, quoteQuantums int64) {
+		success, _, err := keeper.UpdateSubaccounts(
+			ctx,
+			[]types.Update{
+				{
+					SubaccountId: buyer,
+					AssetUpdates: []types.AssetUpdate{
+						{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(-quoteQuantums)},
+					},
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{PerpetualId: p.Params.Id, BigQuantumsDelta: big.NewInt(quantums)},
+					},
+				},
+				{
+					SubaccountId: seller,
+					AssetUpdates: []types.AssetUpdate{
+						{AssetId: assettypes.AssetUsdc.Id, BigQuantumsDelta: big.NewInt(quoteQuantums)},
+					},
+					PerpetualUpdates: []types.PerpetualUpdate{
+						{PerpetualId: p.Params.Id, BigQuantumsDelta: big.NewInt(-quantums)},
+					},
+				},
+			},
+			types.Match,
+		)
+		require.NoError(t, err)
+		require.True(t, success)
+	}
+
+	// Alice buys 1 BTC from Carl at $45,000, and Bob buys 1 BTC from Dave at $50,000.
+	match(constants.Alice_Num0, constants.Carl_Num0, 100_000_000, 45_000_000_000)
+	match(constants.Bob_Num0, constants.Dave_Num0, 100_000_000, 50_000_000_000)
+
+	// Positions are ranked by their scores at the oracle price.
+	adlPosition, found := keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.AdlPosition{
+			SubaccountId:       constants.Alice_Num0,
+			PerpetualId:        p.Params.Id,
+			IsLong:             true,
+			EntryQuoteQuantums: dtypes.NewInt(45_000_000_000),
+		},
+		adlPosition,
+	)
+
+	rankedAdlPosition, score, rank, numPositions, found := keeper.GetAdlRank(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, adlPosition, rankedAdlPosition)
+	// Unrealized PnL of $5,000 multiplied by an effective leverage of $50,000 / $105,000.
+	require.Equal(t, int64(5_000_000_000*50_000_000_000/105_000_000_000), score)
+	require.Equal(t, uint32(1), rank)
+	require.Equal(t, uint32(2), numPositions)
+
+	adlPosition, score, rank, numPositions, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Carl_Num0)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.AdlPosition{
+			SubaccountId:       constants.Carl_Num0,
+			PerpetualId:        p.Params.Id,
+			IsLong:             false,
+			EntryQuoteQuantums: dtypes.NewInt(-45_000_000_000),
+		},
+		adlPosition,
+	)
+	// Unrealized PnL of -$5,000 multiplied by an effective leverage of $50,000 / $95,000.
+	require.Equal(t, int64(-5_000_000_000*50_000_000_000/95_000_000_000), score)
+	require.Equal(t, uint32(2), rank)
+	require.Equal(t, uint32(2), numPositions)
+
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, true, 10),
+	)
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Dave_Num0, constants.Carl_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, false, 10),
+	)
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Dave_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, false, 1),
+	)
+
+	// Positions are re-ranked once the oracle price changes. At $55,000, Alice has an unrealized PnL
+	// of $10,000 and an effective leverage of $55,000 / $110,000.
+	require.NoError(t, pricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+		pricestypes.NewMarketPriceUpdate(p.Params.MarketId, 5_500_000_000),
+	}))
+	_, score, _, _, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, int64(5_000_000_000*50_000_000_000/105_000_000_000), score)
+	keeper.UpdateAdlRanks(ctx)
+	_, score, _, _, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, int64(10_000_000_000*55_000_000_000/110_000_000_000), score)
+	require.NoError(t, pricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+		pricestypes.NewMarketPriceUpdate(p.Params.MarketId, 5_000_000_000),
+	}))
+	keeper.UpdateAdlRanks(ctx)
+
+	// Alice sells 0.5 BTC to Bob at $60,000. Alice's entry notional is reduced proportionally, and
+	// Bob's entry notional is increased by the fill notional.
+	match(constants.Bob_Num0, constants.Alice_Num0, 50_000_000, 30_000_000_000)
+
+	adlPosition, found = keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, dtypes.NewInt(22_500_000_000), adlPosition.EntryQuoteQuantums)
+	adlPosition, score, _, _, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Bob_Num0)
+	require.True(t, found)
+	require.Equal(t, dtypes.NewInt(80_000_000_000), adlPosition.EntryQuoteQuantums)
+	require.Negative(t, score)
+
+	// Alice closes her position by selling 0.5 BTC to Dave, and is removed from the index.
+	match(constants.Dave_Num0, constants.Alice_Num0, 50_000_000, 25_000_000_000)
+
+	_, found = keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.False(t, found)
+	_, _, _, _, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Alice_Num0)
+	require.False(t, found)
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Bob_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, true, 10),
+	)
+	_, _, rank, numPositions, found = keeper.GetAdlRank(ctx, p.Params.Id, constants.Bob_Num0)
+	require.True(t, found)
+	require.Equal(t, uint32(1), rank)
+	require.Equal(t, uint32(1), numPositions)
+
+	adlPosition, found = keeper.GetAdlPosition(ctx, p.Params.Id, constants.Dave_Num0)
+	require.True(t, found)
+	require.Equal(t, dtypes.NewInt(-25_000_000_000), adlPosition.EntryQuoteQuantums)
+}
+
+func TestInitializeAdlPositions(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _ := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	// The oracle price of BTC is $50,000.
+	p := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Positions written directly to state have no auto-deleveraging information until initialized.
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Alice_Num0,
+		AssetPositions:     testutil.CreateUsdcAssetPosition(big.NewInt(-40_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+	})
+	keeper.SetSubaccount(ctx, types.Subaccount{
+		Id:                 &constants.Bob_Num0,
+		AssetPositions:     testutil.CreateUsdcAssetPosition(big.NewInt(60_000_000_000)),
+		PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCShort},
+	})
+	_, found := keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.False(t, found)
+	require.Empty(t, keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, true, 10))
+
+	// Initialized positions are valued at the oracle price.
+	keeper.InitializeAdlPositions(ctx)
+
+	adlPosition, found := keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.AdlPosition{
+			SubaccountId:       constants.Alice_Num0,
+			PerpetualId:        p.Params.Id,
+			IsLong:             true,
+			EntryQuoteQuantums: dtypes.NewInt(50_000_000_000),
+		},
+		adlPosition,
+	)
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Alice_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, true, 10),
+	)
+	require.Equal(
+		t,
+		[]types.SubaccountId{constants.Bob_Num0},
+		keeper.GetAdlRankedSubaccountIds(ctx, p.Params.Id, false, 10),
+	)
+	adlPosition, found = keeper.GetAdlPosition(ctx, p.Params.Id, constants.Bob_Num0)
+	require.True(t, found)
+	require.Equal(t, dtypes.NewInt(-50_000_000_000), adlPosition.EntryQuoteQuantums)
+
+	// Positions which already have auto-deleveraging information are unchanged.
+	require.NoError(t, pricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+		pricestypes.NewMarketPriceUpdate(p.Params.MarketId, 5_500_000_000),
+	}))
+	keeper.InitializeAdlPositions(ctx)
+
+	adlPosition, found = keeper.GetAdlPosition(ctx, p.Params.Id, constants.Alice_Num0)
+	require.True(t, found)
+	require.Equal(t, dtypes.NewInt(50_000_000_000), adlPosition.EntryQuoteQuantums)
+}
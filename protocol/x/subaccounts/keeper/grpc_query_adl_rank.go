@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AdlRank(
+	c context.Context,
+	req *types.QueryAdlRankRequest,
+) (*types.QueryAdlRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	subaccountId := types.SubaccountId{
+		Owner:  req.Owner,
+		Number: req.Number,
+	}
+	adlPosition, score, rank, numPositions, found := k.GetAdlRank(ctx, req.PerpetualId, subaccountId)
+	if !found {
+		return nil,
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf(
+					"Subaccount %+v has no position in perpetual id %+v.",
+					subaccountId,
+					req.PerpetualId,
+				),
+			)
+	}
+
+	return &types.QueryAdlRankResponse{
+		AdlPosition:  adlPosition,
+		Rank:         rank,
+		NumPositions: numPositions,
+		Score:        score,
+	}, nil
+}
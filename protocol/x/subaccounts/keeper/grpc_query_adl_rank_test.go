@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+func TestQueryAdlRank(t *testing.T) {
+	for testName, tc := range map[string]struct {
+		// Parameters
+		request *types.QueryAdlRankRequest
+
+		// Expectations
+		response *types.QueryAdlRankResponse
+		err      error
+	}{
+		"Nil request results in error": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"Success": {
+			request: &types.QueryAdlRankRequest{
+				Owner:       constants.Alice_Num0.Owner,
+				Number:      constants.Alice_Num0.Number,
+				PerpetualId: 0,
+			},
+			response: &types.QueryAdlRankResponse{
+				AdlPosition: types.AdlPosition{
+					SubaccountId:       constants.Alice_Num0,
+					PerpetualId:        0,
+					IsLong:             true,
+					EntryQuoteQuantums: dtypes.NewInt(50_000_000_000),
+				},
+				Rank:         2,
+				NumPositions: 2,
+				// No unrealized PnL at the oracle price.
+				Score: 0,
+			},
+		},
+		"Subaccount without position": {
+			request: &types.QueryAdlRankRequest{
+				Owner:       constants.Carl_Num0.Owner,
+				Number:      constants.Carl_Num0.Number,
+				PerpetualId: 0,
+			},
+			err: status.Error(codes.NotFound, fmt.Sprintf(
+				"Subaccount %+v has no position in perpetual id %+v.",
+				constants.Carl_Num0,
+				uint32(0),
+			)),
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _ := keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			p := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+			_, err := perpetualsKeeper.CreatePerpetual(
+				ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			// Alice and Bob have the same score, so Bob is ranked first by subaccount id.
+			for _, id := range []types.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+				keeper.SetSubaccount(ctx, types.Subaccount{
+					Id:                 &id,
+					AssetPositions:     keepertest.CreateUsdcAssetPosition(big.NewInt(-40_000_000_000)),
+					PerpetualPositions: []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong},
+				})
+			}
+			keeper.InitializeAdlPositions(ctx)
+
+			response, err := keeper.AdlRank(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
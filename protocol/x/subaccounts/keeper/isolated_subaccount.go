@@ -40,6 +40,12 @@ func (k Keeper) checkIsolatedSubaccountConstraints(
 		if err != nil {
 			return false, nil, err
 		}
+		if result == types.Success {
+			result, err = isValidIsolatedAssetUpdates(u, perpIdToMarketType)
+			if err != nil {
+				return false, nil, err
+			}
+		}
 		if result != types.Success {
 			success = false
 		}
@@ -139,6 +145,61 @@ func isValidIsolatedPerpetualUpdates(
 	return types.Success, nil
 }
 
+// Checks whether the asset updates to a settled subaccount violates constraints for isolated
+// perpetuals. Collateral pools of isolated perpetuals only hold USDC, so the constraints being
+// checked are:
+//   - a subaccount with a position in an isolated perpetual cannot have updates for non-USDC assets
+//   - a subaccount with a non-USDC asset position or update cannot have a position or updates for
+//     isolated perpetuals
+func isValidIsolatedAssetUpdates(
+	settledUpdate SettledUpdate,
+	perpIdToMarketType map[uint32]perptypes.PerpetualMarketType,
+) (types.UpdateResult, error) {
+	hasNonUsdcAsset := false
+	for _, assetUpdate := range settledUpdate.AssetUpdates {
+		if assetUpdate.AssetId != assettypes.AssetUsdc.Id {
+			hasNonUsdcAsset = true
+			break
+		}
+	}
+	for _, assetPosition := range settledUpdate.SettledSubaccount.AssetPositions {
+		if assetPosition.AssetId != assettypes.AssetUsdc.Id {
+			hasNonUsdcAsset = true
+			break
+		}
+	}
+
+	// If the subaccount neither holds nor receives non-USDC assets, then this update does not
+	// violate constraints for isolated markets.
+	if !hasNonUsdcAsset {
+		return types.Success, nil
+	}
+
+	perpetualIds := make([]uint32, 0, len(settledUpdate.SettledSubaccount.PerpetualPositions)+
+		len(settledUpdate.PerpetualUpdates))
+	for _, perpetualPosition := range settledUpdate.SettledSubaccount.PerpetualPositions {
+		perpetualIds = append(perpetualIds, perpetualPosition.PerpetualId)
+	}
+	for _, perpetualUpdate := range settledUpdate.PerpetualUpdates {
+		perpetualIds = append(perpetualIds, perpetualUpdate.PerpetualId)
+	}
+
+	for _, perpetualId := range perpetualIds {
+		marketType, exists := perpIdToMarketType[perpetualId]
+		if !exists {
+			return types.UpdateCausedError, errorsmod.Wrap(
+				perptypes.ErrPerpetualDoesNotExist, lib.UintToString(perpetualId),
+			)
+		}
+
+		if marketType == perptypes.PerpetualMarketType_PERPETUAL_MARKET_TYPE_ISOLATED {
+			return types.ViolatesIsolatedSubaccountConstraints, nil
+		}
+	}
+
+	return types.Success, nil
+}
+
 // GetIsolatedPerpetualStateTransition computes whether an isolated perpetual position will be
 // opened or closed for a subaccount.
 // This function assumes that the subaccount is valid under isolated perpetual constraints.
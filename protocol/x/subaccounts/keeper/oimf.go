@@ -47,6 +47,7 @@ func getDeltaLongFromSettledUpdate(
 // For `Match` updates:
 //   - returns a struct `OpenInterest` if input updates results in OI delta.
 //   - returns nil if OI delta is zero.
+//   - returns nil if neither update has perpetual updates (spot matches).
 //   - panics if update format is invalid.
 //
 // For other update types, returns nil.
@@ -67,6 +68,11 @@ func GetDeltaOpenInterestFromUpdates(
 		)
 	}
 
+	// Spot matches only exchange assets and do not change open interest.
+	if len(settledUpdates[0].PerpetualUpdates) == 0 && len(settledUpdates[1].PerpetualUpdates) == 0 {
+		return nil
+	}
+
 	if len(settledUpdates[0].PerpetualUpdates) != 1 || len(settledUpdates[1].PerpetualUpdates) != 1 {
 		panic(
 			fmt.Sprintf(
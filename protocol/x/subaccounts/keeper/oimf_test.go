@@ -215,6 +215,34 @@ func TestGetDeltaOpenInterestFromUpdates(t *testing.T) {
 			},
 			expectedVal: nil, // delta is 0
 		},
+		"Spot match without perp updates, return nil": {
+			updateType: types.Match,
+			settledUpdates: []keeper.SettledUpdate{
+				{
+					SettledSubaccount: types.Subaccount{
+						Id: aliceSubaccountId,
+					},
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          1,
+							BigQuantumsDelta: big.NewInt(500),
+						},
+					},
+				},
+				{
+					SettledSubaccount: types.Subaccount{
+						Id: bobSubaccountId,
+					},
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          1,
+							BigQuantumsDelta: big.NewInt(-500),
+						},
+					},
+				},
+			},
+			expectedVal: nil,
+		},
 		"Not Match update, return nil": {
 			updateType: types.CollatCheck,
 			settledUpdates: []keeper.SettledUpdate{
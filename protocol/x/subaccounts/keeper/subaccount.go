@@ -22,6 +22,7 @@ import (
 	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -327,6 +328,11 @@ func (k Keeper) UpdateSubaccounts(
 		}
 	}
 
+	// Update the auto-deleveraging information of the updated perpetual positions.
+	if err := k.updateAdlPositions(ctx, settledUpdates); err != nil {
+		return false, nil, err
+	}
+
 	// Apply the updates to perpetual positions.
 	UpdatePerpetualPositions(
 		settledUpdates,
@@ -352,8 +358,14 @@ func (k Keeper) UpdateSubaccounts(
 
 	// Apply all updates, including a subaccount update event in the Indexer block message
 	// per update and emit a cometbft event for each settled funding payment.
+	streamSubaccountUpdates := make([]types.StreamSubaccountUpdate, 0, len(settledUpdates))
 	for _, u := range settledUpdates {
 		k.SetSubaccount(ctx, u.SettledSubaccount)
+		// Re-rank the positions of subaccounts whose perpetual positions changed. The ranks of other
+		// subaccounts are refreshed when oracle prices change.
+		if len(u.PerpetualUpdates) > 0 {
+			k.rankAdlPositions(ctx, u.SettledSubaccount)
+		}
 		// Below access is safe because for all updated subaccounts' IDs, this map
 		// is populated as getSettledSubaccount() is called in getSettledUpdates().
 		fundingPayments := subaccountIdToFundingPayments[*u.SettledSubaccount.Id]
@@ -389,11 +401,58 @@ func (k Keeper) UpdateSubaccounts(
 				),
 			)
 		}
+
+		if k.GetGrpcStreamingManager().Enabled() {
+			streamSubaccountUpdates = append(
+				streamSubaccountUpdates,
+				getStreamSubaccountUpdate(u, fundingPayments),
+			)
+		}
+	}
+
+	// Stage the subaccount updates for the gRPC streams. Updates are only staged in DeliverTx and are
+	// sent once the block is finalized, so that updates of failed transactions are never streamed.
+	if lib.IsDeliverTxMode(ctx) {
+		for _, streamSubaccountUpdate := range streamSubaccountUpdates {
+			k.GetGrpcStreamingManager().StageFinalizeBlockSubaccountUpdate(ctx, streamSubaccountUpdate)
+		}
 	}
 
 	return success, successPerUpdate, err
 }
 
+// GetStreamSubaccountUpdate returns a snapshot of all the asset and perpetual positions of a
+// subaccount to be sent to the gRPC streams.
+func (k Keeper) GetStreamSubaccountUpdate(
+	ctx sdk.Context,
+	id types.SubaccountId,
+) types.StreamSubaccountUpdate {
+	subaccount := k.GetSubaccount(ctx, id)
+	return types.StreamSubaccountUpdate{
+		SubaccountId:              id,
+		UpdatedPerpetualPositions: subaccount.PerpetualPositions,
+		UpdatedAssetPositions:     subaccount.AssetPositions,
+		SettledFundingPayments:    []types.SettledFundingPayment{},
+		Snapshot:                  true,
+	}
+}
+
+// SendSubaccountUpdates sends the subaccount updates to the gRPC streaming manager.
+func (k Keeper) SendSubaccountUpdates(
+	ctx sdk.Context,
+	subaccountUpdates []types.StreamSubaccountUpdate,
+) {
+	if len(subaccountUpdates) == 0 {
+		return
+	}
+
+	k.GetGrpcStreamingManager().SendSubaccountUpdates(
+		subaccountUpdates,
+		lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		ctx.ExecMode(),
+	)
+}
+
 // CanUpdateSubaccounts will validate all `updates` to the relevant subaccounts.
 // The `updates` do not have to contain unique `SubaccountIds`.
 // Each update is considered in isolation. Thus if two updates are provided
@@ -688,6 +747,18 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			}
 		}
 
+		// Only USDC can be borrowed, so the update must not leave a negative balance of any other asset.
+		// Spot matches settle by exchanging assets and cannot borrow USDC either.
+		hasNegativeBalance, err := hasNegativeAssetBalance(u, updateType == types.SpotMatch)
+		if err != nil {
+			return false, nil, err
+		}
+		if hasNegativeBalance {
+			success = false
+			successPerUpdate[i] = types.InsufficientAssetBalance
+			continue
+		}
+
 		// Branch the state to calculate the new OIMF after OI increase.
 		// The branched state is only needed for this purpose and is always discarded.
 		branchedContext, _ := ctx.CacheContext()
@@ -717,6 +788,7 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			err := k.internalGetNetCollateralAndMarginRequirements(
 			branchedContext,
 			u,
+			k.perpetualsKeeper,
 		)
 
 		// if `internalGetNetCollateralAndMarginRequirements`, returns error.
@@ -748,6 +820,7 @@ func (k Keeper) internalCanUpdateSubaccounts(
 					err = k.internalGetNetCollateralAndMarginRequirements(
 					ctx,
 					emptyUpdate,
+					k.perpetualsKeeper,
 				)
 				if err != nil {
 					return false, nil, err
@@ -764,6 +837,11 @@ func (k Keeper) internalCanUpdateSubaccounts(
 			)
 		}
 
+		// A collateralized subaccount without perpetual positions must not borrow USDC.
+		if result.IsSuccess() && borrowsUsdcWithoutPerpetualPositions(u, updateType) {
+			result = types.InsufficientAssetBalance
+		}
+
 		// If this state transition is not valid, the overall success is now false.
 		if !result.IsSuccess() {
 			success = false
@@ -775,6 +853,72 @@ func (k Keeper) internalCanUpdateSubaccounts(
 	return success, successPerUpdate, nil
 }
 
+// hasNegativeAssetBalance returns true if applying the asset updates in `settledUpdate` would
+// leave the subaccount with a negative balance of any asset other than USDC. If `isSpotMatch` is true,
+// it also returns true if the update decreases the USDC balance and leaves it negative.
+// The input subaccount must be settled.
+func hasNegativeAssetBalance(settledUpdate SettledUpdate, isSpotMatch bool) (bool, error) {
+	if len(settledUpdate.AssetUpdates) == 0 {
+		return false, nil
+	}
+
+	assetSizes, err := applyUpdatesToPositions(
+		settledUpdate.SettledSubaccount.AssetPositions,
+		settledUpdate.AssetUpdates,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	for _, size := range assetSizes {
+		if size.GetBigQuantums().Sign() >= 0 {
+			continue
+		}
+		if size.GetId() != assettypes.AssetUsdc.Id {
+			return true, nil
+		}
+		if isSpotMatch && decreasesAssetBalance(settledUpdate.AssetUpdates, assettypes.AssetUsdc.Id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// borrowsUsdcWithoutPerpetualPositions returns true if `settledUpdate` is a withdrawal or transfer
+// that decreases the USDC balance of a subaccount without perpetual positions and leaves it negative.
+// Such a subaccount would borrow USDC against its other assets only, which cannot be liquidated.
+// The input subaccount must be settled.
+func borrowsUsdcWithoutPerpetualPositions(settledUpdate SettledUpdate, updateType types.UpdateType) bool {
+	if updateType != types.Withdrawal && updateType != types.Transfer {
+		return false
+	}
+	if len(settledUpdate.SettledSubaccount.PerpetualPositions) > 0 || len(settledUpdate.PerpetualUpdates) > 0 {
+		return false
+	}
+
+	for _, assetUpdate := range settledUpdate.AssetUpdates {
+		if assetUpdate.AssetId != assettypes.AssetUsdc.Id || assetUpdate.BigQuantumsDelta.Sign() >= 0 {
+			continue
+		}
+		bigNewUsdcBalance := new(big.Int).Add(
+			settledUpdate.SettledSubaccount.GetUsdcPosition(),
+			assetUpdate.BigQuantumsDelta,
+		)
+		return bigNewUsdcBalance.Sign() < 0
+	}
+	return false
+}
+
+// decreasesAssetBalance returns true if `assetUpdates` decrease the balance of the asset with id `assetId`.
+func decreasesAssetBalance(assetUpdates []types.AssetUpdate, assetId uint32) bool {
+	for _, assetUpdate := range assetUpdates {
+		if assetUpdate.AssetId == assetId && assetUpdate.BigQuantumsDelta.Sign() < 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // IsValidStateTransitionForUndercollateralizedSubaccount returns an `UpdateResult`
 // denoting whether this state transition is valid. This function accepts the collateral and
 // margin requirements of a subaccount before and after an update ("cur" and
@@ -878,9 +1022,74 @@ func (k Keeper) GetNetCollateralAndMarginRequirements(
 	return k.internalGetNetCollateralAndMarginRequirements(
 		ctx,
 		settledUpdate,
+		k.perpetualsKeeper,
 	)
 }
 
+// GetNetCollateralAndMarginRequirementsAtRiskPrices is the same as `GetNetCollateralAndMarginRequirements`,
+// except that perpetual positions are valued at the risk price of their perpetual instead of the oracle
+// price. The risk price is the mark price of perpetuals which enable it, and the oracle price otherwise.
+// It is used to determine whether a subaccount can be liquidated.
+//
+// All return values are denoted in quote quantums.
+func (k Keeper) GetNetCollateralAndMarginRequirementsAtRiskPrices(
+	ctx sdk.Context,
+	update types.Update,
+) (
+	bigNetCollateral *big.Int,
+	bigInitialMargin *big.Int,
+	bigMaintenanceMargin *big.Int,
+	err error,
+) {
+	subaccount := k.GetSubaccount(ctx, update.SubaccountId)
+
+	settledSubaccount, _, err := k.getSettledSubaccount(ctx, subaccount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	settledUpdate := SettledUpdate{
+		SettledSubaccount: settledSubaccount,
+		AssetUpdates:      update.AssetUpdates,
+		PerpetualUpdates:  update.PerpetualUpdates,
+	}
+
+	return k.internalGetNetCollateralAndMarginRequirements(
+		ctx,
+		settledUpdate,
+		riskPriceProductKeeper{k.perpetualsKeeper},
+	)
+}
+
+// riskPriceProductKeeper is a `ProductKeeper` which values perpetual positions at the risk price
+// of their perpetual.
+type riskPriceProductKeeper struct {
+	types.PerpetualsKeeper
+}
+
+func (pk riskPriceProductKeeper) GetNetCollateral(
+	ctx sdk.Context,
+	id uint32,
+	bigQuantums *big.Int,
+) (
+	bigNetCollateralQuoteQuantums *big.Int,
+	err error,
+) {
+	return pk.GetNetCollateralAtRiskPrice(ctx, id, bigQuantums)
+}
+
+func (pk riskPriceProductKeeper) GetMarginRequirements(
+	ctx sdk.Context,
+	id uint32,
+	bigQuantums *big.Int,
+) (
+	bigInitialMarginQuoteQuantums *big.Int,
+	bigMaintenanceMarginQuoteQuantums *big.Int,
+	err error,
+) {
+	return pk.GetMarginRequirementsAtRiskPrice(ctx, id, bigQuantums)
+}
+
 // internalGetNetCollateralAndMarginRequirements returns the total net collateral, total initial margin
 // requirement, and total maintenance margin requirement for the `Subaccount` as if unsettled funding
 // of existing positions were settled, and the `bigQuoteBalanceDeltaQuantums`, `assetUpdates`, and
@@ -891,10 +1100,13 @@ func (k Keeper) GetNetCollateralAndMarginRequirements(
 // The provided update can also be "zeroed" in order to get information about
 // the current state of the subaccount (i.e. with no changes).
 //
+// Perpetual positions are valued using `perpetualsKeeper`.
+//
 // If two position updates reference the same position, an error is returned.
 func (k Keeper) internalGetNetCollateralAndMarginRequirements(
 	ctx sdk.Context,
 	settledUpdate SettledUpdate,
+	perpetualsKeeper types.ProductKeeper,
 ) (
 	bigNetCollateral *big.Int,
 	bigInitialMargin *big.Int,
@@ -972,7 +1184,7 @@ func (k Keeper) internalGetNetCollateralAndMarginRequirements(
 	// Iterate over all perpetuals and updates and calculate change to net collateral and margin requirements.
 	// TODO(DEC-110): `perp.GetSettlement()`, factor in unsettled funding.
 	for _, size := range perpetualSizes {
-		err := calculate(k.perpetualsKeeper, size)
+		err := calculate(perpetualsKeeper, size)
 		if err != nil {
 			return big.NewInt(0), big.NewInt(0), big.NewInt(0), err
 		}
@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -98,6 +99,33 @@ func getUpdatedPerpetualPositions(
 	return updatedPerpetualPositions
 }
 
+// getStreamSubaccountUpdate returns the subaccount update that is sent to the gRPC streams
+// for a settled update. It contains the updated perpetual and asset positions and the
+// settled funding payments of the subaccount.
+func getStreamSubaccountUpdate(
+	update SettledUpdate,
+	fundingPayments map[uint32]dtypes.SerializableInt,
+) types.StreamSubaccountUpdate {
+	settledFundingPayments := make([]types.SettledFundingPayment, 0, len(fundingPayments))
+	for _, perpetualId := range lib.GetSortedKeys[lib.Sortable[uint32]](fundingPayments) {
+		settledFundingPayments = append(
+			settledFundingPayments,
+			types.SettledFundingPayment{
+				PerpetualId: perpetualId,
+				FundingPaid: fundingPayments[perpetualId],
+			},
+		)
+	}
+
+	return types.StreamSubaccountUpdate{
+		SubaccountId:              *update.SettledSubaccount.Id,
+		UpdatedPerpetualPositions: getUpdatedPerpetualPositions(update, fundingPayments),
+		UpdatedAssetPositions:     getUpdatedAssetPositions(update),
+		SettledFundingPayments:    settledFundingPayments,
+		Snapshot:                  false,
+	}
+}
+
 // For each settledUpdate in settledUpdates, updates its SettledSubaccount.PerpetualPositions
 // to reflect settledUpdate.PerpetualUpdates.
 // For newly created positions, use `perpIdToFundingIndex` map to populate the `FundingIndex` field.
@@ -233,6 +233,32 @@ func TestSubaccountGetNonExistent(t *testing.T) {
 	require.False(t, acct.MarginEnabled)
 }
 
+func TestGetStreamSubaccountUpdate(t *testing.T) {
+	ctx, keeper, _, _, _, _, _, _, _ := testutil.SubaccountsKeepers(t, true)
+	items := createNSubaccount(keeper, ctx, 3, big.NewInt(1_000))
+	for _, item := range items {
+		update := keeper.GetStreamSubaccountUpdate(ctx, *item.Id)
+		require.Equal(
+			t,
+			types.StreamSubaccountUpdate{
+				SubaccountId:              *item.Id,
+				UpdatedPerpetualPositions: item.PerpetualPositions,
+				UpdatedAssetPositions:     item.AssetPositions,
+				SettledFundingPayments:    []types.SettledFundingPayment{},
+				Snapshot:                  true,
+			},
+			update,
+		)
+	}
+
+	// Snapshot of a subaccount that does not exist is empty.
+	update := keeper.GetStreamSubaccountUpdate(ctx, constants.Bob_Num1)
+	require.Equal(t, constants.Bob_Num1, update.SubaccountId)
+	require.Empty(t, update.UpdatedAssetPositions)
+	require.Empty(t, update.UpdatedPerpetualPositions)
+	require.True(t, update.Snapshot)
+}
+
 func TestGetAllSubaccount(t *testing.T) {
 	ctx, keeper, _, _, _, _, _, _, _ := testutil.SubaccountsKeepers(t, true)
 	items := createNSubaccount(keeper, ctx, 10, big.NewInt(1_000))
@@ -2816,6 +2842,7 @@ func TestUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -4260,6 +4287,7 @@ func TestUpdateSubaccounts_WithdrawalsBlocked(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -5338,6 +5366,223 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 				},
 			},
 		},
+		"Spot - selling more of the base asset than the subaccount holds": {
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.InsufficientAssetBalance},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(50_000_000), // 0.5 BTC
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(50_000_000_000), // 50,000 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+			},
+		},
+		"Spot - selling all of the base asset the subaccount holds": {
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(50_000_000_000), // 50,000 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(-100_000_000), // -1 BTC
+						},
+					},
+				},
+			},
+		},
+		"Spot - buying the base asset with more USDC than the subaccount holds, backed by the asset": {
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)), // 1,000 USDC
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-45_500_000_000), // -45,500 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+		},
+		"Spot - buying the base asset above the oracle price without enough collateral": {
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.NewlyUndercollateralized},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)), // 1,000 USDC
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-52_000_000_000), // -52,000 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+		},
+		"Spot match - buying the base asset with more USDC than the subaccount holds": {
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.InsufficientAssetBalance},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000)), // 1,000 USDC
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-50_000_000_000), // -50,000 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+			updateType: types.SpotMatch,
+		},
+		"Withdrawal - borrowing USDC against a non-USDC asset without perpetual positions": {
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.InsufficientAssetBalance},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-2_000_000_000), // -2,000 USDC
+						},
+					},
+				},
+			},
+			updateType: types.Withdrawal,
+		},
+		"Withdrawal - withdrawing USDC held by a subaccount with a non-USDC asset": {
+			expectedSuccess:          true,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.Success},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-1_000_000_000), // -1,000 USDC
+						},
+					},
+				},
+			},
+			updateType: types.Withdrawal,
+		},
+		"Isolated subaccounts - subaccount with isolated perpetual position receives a non-USDC asset": {
+			expectedSuccess:          false,
+			expectedSuccessPerUpdate: []types.UpdateResult{types.ViolatesIsolatedSubaccountConstraints},
+			assets: []*asstypes.Asset{
+				constants.BtcUsd,
+			},
+			perpetuals: []perptypes.Perpetual{
+				constants.IsoUsd_IsolatedMarket,
+			},
+			assetPositions: testutil.CreateUsdcAssetPosition(big.NewInt(1_000_000_000_000)),
+			perpetualPositions: []*types.PerpetualPosition{
+				{
+					PerpetualId:  uint32(3),
+					Quantums:     dtypes.NewInt(1_000_000_000), // 1 ISO
+					FundingIndex: dtypes.NewInt(0),
+				},
+			},
+			updates: []types.Update{
+				{
+					AssetUpdates: []types.AssetUpdate{
+						{
+							AssetId:          constants.Usdc.Id,
+							BigQuantumsDelta: big.NewInt(-50_000_000_000), // -50,000 USDC
+						},
+						{
+							AssetId:          constants.BtcUsd.Id,
+							BigQuantumsDelta: big.NewInt(100_000_000), // 1 BTC
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -5357,6 +5602,7 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -5443,6 +5689,9 @@ func TestCanUpdateSubaccounts(t *testing.T) {
 }
 
 func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
+	btcUsdWithLargerHaircut := *constants.BtcUsd
+	btcUsdWithLargerHaircut.HaircutPpm = 250_000 // 25%
+
 	tests := map[string]struct {
 		// state
 		perpetuals []perptypes.Perpetual
@@ -5742,7 +5991,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"asset with no balance and update": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(90_000_000_000), // 2 BTC at $50,000 less 10%
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -5757,7 +6008,9 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 			},
 		},
 		"single positive asset": {
-			expectedErr: asstypes.ErrNotImplementedMulticollateral,
+			expectedNetCollateral:     big.NewInt(45_000_000_000), // 1 BTC at $50,000 less 10%
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
 			assets: []*asstypes.Asset{
 				constants.BtcUsd,
 			},
@@ -5765,6 +6018,17 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 				&constants.Long_Asset_1BTC,
 			},
 		},
+		"single positive asset with a larger haircut": {
+			expectedNetCollateral:     big.NewInt(37_500_000_000), // 1 BTC at $50,000 less 25%
+			expectedInitialMargin:     big.NewInt(0),
+			expectedMaintenanceMargin: big.NewInt(0),
+			assets: []*asstypes.Asset{
+				&btcUsdWithLargerHaircut,
+			},
+			assetPositions: []*types.AssetPosition{
+				&constants.Long_Asset_1BTC,
+			},
+		},
 		"single negative asset": {
 			expectedErr: asstypes.ErrNotImplementedMargin,
 			assets: []*asstypes.Asset{
@@ -5793,6 +6057,7 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 					a.HasMarket,
 					a.MarketId,
 					a.AtomicResolution,
+					a.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -5850,6 +6115,86 @@ func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 	}
 }
 
+func TestGetNetCollateralAndMarginRequirementsAtRiskPrices(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _ := testutil.SubaccountsKeepers(t, true)
+	testutil.CreateTestMarkets(t, ctx, pricesKeeper)
+	testutil.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	require.NoError(t, testutil.CreateUsdcAsset(ctx, assetsKeeper))
+
+	p := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := perpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(1_000))[0]
+	subaccount.PerpetualPositions = []*types.PerpetualPosition{&constants.PerpetualPosition_OneBTCLong}
+	subaccount.AssetPositions = testutil.CreateUsdcAssetPosition(big.NewInt(-40_000_000_000)) // -$40,000
+	keeper.SetSubaccount(ctx, subaccount)
+	update := types.Update{SubaccountId: *subaccount.Id}
+
+	// The mark price of BTC is 1% below its oracle price.
+	require.NoError(t, perpetualsKeeper.AddPremiumVotes(
+		ctx,
+		[]perptypes.FundingPremium{{PerpetualId: p.Params.Id, PremiumPpm: -10_000}},
+	))
+	_, err = perpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ctx,
+		p.Params.Id,
+		&perptypes.MarkPriceConfig{EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	perpetualsKeeper.UpdateMarkPrices(ctx)
+
+	netCollateral, initialMargin, maintenanceMargin, err := keeper.GetNetCollateralAndMarginRequirements(ctx, update)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10_000_000_000).String(), netCollateral.String()) // $10,000
+
+	// The oracle price is used while the mark price is disabled.
+	riskNetCollateral, riskInitialMargin, riskMaintenanceMargin, err :=
+		keeper.GetNetCollateralAndMarginRequirementsAtRiskPrices(ctx, update)
+	require.NoError(t, err)
+	require.Equal(t, netCollateral.String(), riskNetCollateral.String())
+	require.Equal(t, initialMargin.String(), riskInitialMargin.String())
+	require.Equal(t, maintenanceMargin.String(), riskMaintenanceMargin.String())
+
+	_, err = perpetualsKeeper.SetPerpetualMarkPriceConfig(
+		ctx,
+		p.Params.Id,
+		&perptypes.MarkPriceConfig{Enabled: true, EmaSmoothingPpm: 1_000_000},
+	)
+	require.NoError(t, err)
+	riskNetCollateral, riskInitialMargin, riskMaintenanceMargin, err =
+		keeper.GetNetCollateralAndMarginRequirementsAtRiskPrices(ctx, update)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(9_500_000_000).String(), riskNetCollateral.String()) // $9,500
+	require.Equal(
+		t,
+		new(big.Int).Div(new(big.Int).Mul(initialMargin, big.NewInt(99)), big.NewInt(100)).String(),
+		riskInitialMargin.String(),
+	)
+	require.Equal(
+		t,
+		new(big.Int).Div(new(big.Int).Mul(maintenanceMargin, big.NewInt(99)), big.NewInt(100)).String(),
+		riskMaintenanceMargin.String(),
+	)
+
+	// Margin requirements used for trading are still based on the oracle price.
+	newNetCollateral, newInitialMargin, newMaintenanceMargin, err :=
+		keeper.GetNetCollateralAndMarginRequirements(ctx, update)
+	require.NoError(t, err)
+	require.Equal(t, netCollateral.String(), newNetCollateral.String())
+	require.Equal(t, initialMargin.String(), newInitialMargin.String())
+	require.Equal(t, maintenanceMargin.String(), newMaintenanceMargin.String())
+}
+
 func TestIsValidStateTransitionForUndercollateralizedSubaccount_ZeroMarginRequirements(t *testing.T) {
 	tests := map[string]struct {
 		bigCurNetCollateral     *big.Int
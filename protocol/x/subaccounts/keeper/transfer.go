@@ -12,6 +12,28 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
+// validateCollateralAssetForTransfer returns an error if the asset cannot be transferred into
+// or out of subaccounts. USDC and any asset with an oracle market are enabled as collateral.
+func (k Keeper) validateCollateralAssetForTransfer(
+	ctx sdk.Context,
+	assetId uint32,
+) error {
+	if assetId == assettypes.AssetUsdc.Id {
+		return nil
+	}
+
+	asset, exists := k.assetsKeeper.GetAsset(ctx, assetId)
+	if !exists {
+		return errorsmod.Wrap(assettypes.ErrAssetDoesNotExist, lib.UintToString(assetId))
+	}
+
+	if !asset.HasMarket {
+		return errorsmod.Wrap(types.ErrAssetNotEnabledAsCollateral, lib.UintToString(assetId))
+	}
+
+	return nil
+}
+
 // getValidSubaccountUpdatesForTransfer generates subaccount updates and check
 // for validity with `CanUpdateSubaccount()`
 // Returns the subaccount updates if check is successful.
@@ -30,21 +52,16 @@ func (k Keeper) getValidSubaccountUpdatesForTransfer(
 		bigBalanceDelta.Neg(bigBalanceDelta)
 	}
 
-	if assetId == 0 {
-		updates = []types.Update{
-			{
-				SubaccountId: subaccountId,
-				AssetUpdates: []types.AssetUpdate{
-					{
-						AssetId:          assettypes.AssetUsdc.Id,
-						BigQuantumsDelta: bigBalanceDelta,
-					},
+	updates = []types.Update{
+		{
+			SubaccountId: subaccountId,
+			AssetUpdates: []types.AssetUpdate{
+				{
+					AssetId:          assetId,
+					BigQuantumsDelta: bigBalanceDelta,
 				},
 			},
-		}
-	} else {
-		// TODO(DEC-715): Support non-USDC assets.
-		return nil, types.ErrAssetTransferThroughBankNotImplemented
+		},
 	}
 
 	success, successPerUpdate, err := k.CanUpdateSubaccounts(ctx, updates, types.Transfer)
@@ -93,9 +110,8 @@ func (k Keeper) DepositFundsFromAccountToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateCollateralAssetForTransfer(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -156,9 +172,8 @@ func (k Keeper) WithdrawFundsFromSubaccountToAccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateCollateralAssetForTransfer(ctx, assetId); err != nil {
+		return err
 	}
 
 	if quantums.Sign() <= 0 {
@@ -217,6 +232,34 @@ func (k Keeper) TransferFeesToFeeCollectorModule(
 	assetId uint32,
 	quantums *big.Int,
 	perpetualId uint32,
+) error {
+	collateralPoolAddr, err := k.GetCollateralPoolFromPerpetualId(ctx, perpetualId)
+	if err != nil {
+		return err
+	}
+
+	return k.transferFeesToFeeCollectorModuleFromCollateralPool(ctx, assetId, quantums, collateralPoolAddr)
+}
+
+// TransferSpotFeesToFeeCollectorModule translates the assetId and quantums into a sdk.Coin,
+// and moves the funds from the cross collateral pool to the `fee_collector` module account.
+// Spot markets can only be traded by subaccounts without isolated positions, whose collateral
+// is held in the cross collateral pool. Does not change any individual subaccount state.
+func (k Keeper) TransferSpotFeesToFeeCollectorModule(
+	ctx sdk.Context,
+	assetId uint32,
+	quantums *big.Int,
+) error {
+	return k.transferFeesToFeeCollectorModuleFromCollateralPool(ctx, assetId, quantums, types.ModuleAddress)
+}
+
+// transferFeesToFeeCollectorModuleFromCollateralPool moves the fees from the given collateral pool
+// to the `fee_collector` module account. Negative fees are moved in the opposite direction.
+func (k Keeper) transferFeesToFeeCollectorModuleFromCollateralPool(
+	ctx sdk.Context,
+	assetId uint32,
+	quantums *big.Int,
+	collateralPoolAddr sdk.AccAddress,
 ) error {
 	// TODO(DEC-715): Support non-USDC assets.
 	if assetId != assettypes.AssetUsdc.Id {
@@ -236,11 +279,6 @@ func (k Keeper) TransferFeesToFeeCollectorModule(
 		return err
 	}
 
-	collateralPoolAddr, err := k.GetCollateralPoolFromPerpetualId(ctx, perpetualId)
-	if err != nil {
-		return err
-	}
-
 	// Send coins from `subaccounts` to the `auth` module fee collector account.
 	fromModuleAddr := collateralPoolAddr
 	toModuleAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
@@ -330,9 +368,8 @@ func (k Keeper) TransferFundsFromSubaccountToSubaccount(
 	assetId uint32,
 	quantums *big.Int,
 ) error {
-	// TODO(DEC-715): Support non-USDC assets.
-	if assetId != assettypes.AssetUsdc.Id {
-		return types.ErrAssetTransferThroughBankNotImplemented
+	if err := k.validateCollateralAssetForTransfer(ctx, assetId); err != nil {
+		return err
 	}
 
 	updates := []types.Update{
@@ -340,7 +377,7 @@ func (k Keeper) TransferFundsFromSubaccountToSubaccount(
 			SubaccountId: senderSubaccountId,
 			AssetUpdates: []types.AssetUpdate{
 				{
-					AssetId:          assettypes.AssetUsdc.Id,
+					AssetId:          assetId,
 					BigQuantumsDelta: new(big.Int).Neg(quantums),
 				},
 			},
@@ -349,7 +386,7 @@ func (k Keeper) TransferFundsFromSubaccountToSubaccount(
 			SubaccountId: recipientSubaccountId,
 			AssetUpdates: []types.AssetUpdate{
 				{
-					AssetId:          assettypes.AssetUsdc.Id,
+					AssetId:          assetId,
 					BigQuantumsDelta: new(big.Int).Set(quantums),
 				},
 			},
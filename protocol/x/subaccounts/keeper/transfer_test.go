@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	auth_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/auth"
 	bank_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/bank"
@@ -184,8 +185,68 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			expectedAccAddressBalance: big.NewInt(0),
 		},
 
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
+		"DepositFundsFromAccountToSubaccount: deposit non-USDC asset": {
+			testTransferFundToAccount:  false,
+			accAddressBalance:          big.NewInt(300_000_000),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(50_000_000),
+			quantums:                   big.NewInt(100_000_000), // 1 BTC
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(50_000_000),
+				},
+			},
+			collateralPoolAddr: types.ModuleAddress,
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(150_000_000),
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(500),
+			expectedSubaccountsModuleAccBalance: big.NewInt(150_000_000),
+			expectedAccAddressBalance:           big.NewInt(200_000_000),
+		},
+		"WithdrawFundsFromSubaccountToAccount: withdraw non-USDC asset backing a negative USDC balance": {
+			testTransferFundToAccount:  true,
+			accAddressBalance:          big.NewInt(0),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(100_000_000),
+			quantums:                   big.NewInt(40_000_000), // 0.4 BTC
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(-20_000_000_000), // -$20,000
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+			collateralPoolAddr: types.ModuleAddress,
+			expectedAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(-20_000_000_000),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(60_000_000), // $30,000
+				},
+			},
+			expectedQuoteBalance:                big.NewInt(-20_000_000_000),
+			expectedSubaccountsModuleAccBalance: big.NewInt(60_000_000),
+			expectedAccAddressBalance:           big.NewInt(40_000_000),
+		},
 		// TODO(CORE-169): Add tests for when the input quantums is rounded down to
 		// a integer denom amount.
 	}
@@ -233,6 +294,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 				tc.asset.HasMarket,
 				tc.asset.MarketId,
 				tc.asset.AtomicResolution,
+				tc.asset.HaircutPpm,
 			)
 			require.NoError(t, err)
 
@@ -385,15 +447,15 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			collateralPoolAddr:         types.ModuleAddress,
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"WithdrawFundsFromSubaccountToAccount: do not support assets other than USDC": {
+		"WithdrawFundsFromSubaccountToAccount: do not support assets without an oracle market": {
 			testTransferFundToAccount:  true,
 			accAddressBalance:          big.NewInt(500),
-			asset:                      *constants.BtcUsd,
+			asset:                      *constants.EthNoMarket,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			collateralPoolAddr:         types.ModuleAddress,
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrAssetNotEnabledAsCollateral,
 		},
 		"WithdrawFundsFromSubaccountToAccount: asset ID doesn't exist": {
 			testTransferFundToAccount:  true,
@@ -426,15 +488,15 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			collateralPoolAddr:         types.ModuleAddress,
 			expectedErr:                types.ErrAssetTransferQuantumsNotPositive,
 		},
-		"DepositFundsFromAccountToSubaccount: do not support assets other than USDC": {
+		"DepositFundsFromAccountToSubaccount: do not support assets without an oracle market": {
 			testTransferFundToAccount:  false,
 			accAddressBalance:          big.NewInt(500),
-			asset:                      *constants.BtcUsd,
+			asset:                      *constants.EthNoMarket,
 			subaccountModuleAccBalance: big.NewInt(500),
 			quantums:                   big.NewInt(500),
 			assetPositions:             keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			collateralPoolAddr:         types.ModuleAddress,
-			expectedErr:                types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr:                types.ErrAssetNotEnabledAsCollateral,
 		},
 		"DepositFundsFromAccountToSubaccount: failure, asset ID doesn't exist": {
 			testTransferFundToAccount:  false,
@@ -447,8 +509,25 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 			collateralPoolAddr:         types.ModuleAddress,
 			expectedErr:                asstypes.ErrAssetDoesNotExist,
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
+		"WithdrawFundsFromSubaccountToAccount: withdrawal leaves subaccount undercollateralized": {
+			testTransferFundToAccount:  true,
+			accAddressBalance:          big.NewInt(0),
+			asset:                      *constants.BtcUsd,
+			subaccountModuleAccBalance: big.NewInt(100_000_000),
+			quantums:                   big.NewInt(50_000_000), // 0.5 BTC
+			assetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(-40_000_000_000), // -$40,000
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000), // 1 BTC
+				},
+			},
+			collateralPoolAddr: types.ModuleAddress,
+			expectedErr:        types.ErrFailedToUpdateSubaccounts,
+		},
 	}
 
 	for name, tc := range tests {
@@ -500,6 +579,7 @@ func TestWithdrawFundsFromSubaccountToAccount_DepositFundsFromAccountToSubaccoun
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -718,8 +798,49 @@ func TestTransferFundsFromSubaccountToSubaccount_Success(t *testing.T) {
 			expectedSenderCollateralPoolBalance:    big.NewInt(100),  // 600 - 500
 			expectedRecipientCollateralPoolBalance: big.NewInt(1200), // 700 + 500
 		},
-		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
-		// is implemented.
+		"Send non-USDC asset from non-isolated subaccount to non-isolated subaccount": {
+			asset:    *constants.BtcUsd,
+			quantums: big.NewInt(25_000_000), // 0.25 BTC
+			senderAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(100_000_000),
+				},
+			},
+			recipientAssetPositions:        keepertest.CreateUsdcAssetPosition(big.NewInt(600)),
+			senderCollateralPoolBalance:    big.NewInt(100_000_000),
+			recipientCollateralPoolBalance: big.NewInt(100_000_000), // same collateral pool, same balance
+			senderCollateralPoolAddr:       types.ModuleAddress,
+			recipientCollateralPoolAddr:    types.ModuleAddress,
+			expectedSenderAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(500),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(75_000_000),
+				},
+			},
+			expectedRecipientAssetPositions: []*types.AssetPosition{
+				{
+					AssetId:  constants.Usdc.Id,
+					Quantums: dtypes.NewInt(600),
+				},
+				{
+					AssetId:  constants.BtcUsd.Id,
+					Quantums: dtypes.NewInt(25_000_000),
+				},
+			},
+			expectedSenderQuoteBalance:             big.NewInt(500),
+			expectedRecipientQuoteBalance:          big.NewInt(600),
+			expectedSenderCollateralPoolBalance:    big.NewInt(100_000_000), // no changes to collateral pools
+			expectedRecipientCollateralPoolBalance: big.NewInt(100_000_000),
+		},
 		// TODO(CORE-169): Add tests for when the input quantums is rounded down to
 		// a integer denom amount.
 	}
@@ -754,6 +875,7 @@ func TestTransferFundsFromSubaccountToSubaccount_Success(t *testing.T) {
 				tc.asset.HasMarket,
 				tc.asset.MarketId,
 				tc.asset.AtomicResolution,
+				tc.asset.HaircutPpm,
 			)
 			require.NoError(t, err)
 
@@ -1003,8 +1125,8 @@ func TestTransferFundsFromSubaccountToSubaccount_Failure(t *testing.T) {
 			quantums:    big.NewInt(500),
 			expectedErr: sdkerrors.ErrInsufficientFunds,
 		},
-		"Do not support assets other than USDC": {
-			asset:                *constants.BtcUsd,
+		"Do not support assets without an oracle market": {
+			asset:                *constants.EthNoMarket,
 			senderAssetPositions: keepertest.CreateUsdcAssetPosition(big.NewInt(500)),
 			senderPerpetualPositions: []*types.PerpetualPosition{
 				&constants.PerpetualPosition_OneISOLong,
@@ -1022,7 +1144,7 @@ func TestTransferFundsFromSubaccountToSubaccount_Failure(t *testing.T) {
 				types.ModuleName + ":" + lib.UintToString(constants.PerpetualPosition_OneISO2Long.PerpetualId),
 			),
 			quantums:    big.NewInt(500),
-			expectedErr: types.ErrAssetTransferThroughBankNotImplemented,
+			expectedErr: types.ErrAssetNotEnabledAsCollateral,
 		},
 		"Asset ID doesn't exist": {
 			skipSetUpUsdc:        true,
@@ -1087,6 +1209,7 @@ func TestTransferFundsFromSubaccountToSubaccount_Failure(t *testing.T) {
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
@@ -1179,6 +1302,7 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 		asset       asstypes.Asset
 		quantums    *big.Int
 		perpetualId uint32
+		isSpot      bool
 
 		collateralPoolAddr sdk.AccAddress
 
@@ -1270,6 +1394,16 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 			expectedSubaccountsModuleAccBalance: big.NewInt(1000),
 			expectedFeeModuleAccBalance:         big.NewInt(1000),
 		},
+		"success - spot fees sent from cross collateral pool": {
+			asset:                               *constants.Usdc,
+			feeModuleAccBalance:                 big.NewInt(2500),
+			subaccountModuleAccBalance:          big.NewInt(600),
+			quantums:                            big.NewInt(500),
+			isSpot:                              true,
+			collateralPoolAddr:                  types.ModuleAddress,
+			expectedSubaccountsModuleAccBalance: big.NewInt(100),  // 600 - 500
+			expectedFeeModuleAccBalance:         big.NewInt(3000), // 500 + 2500
+		},
 		// TODO(DEC-715): Add more test for non-USDC assets, after asset update
 		// is implemented.
 	}
@@ -1341,11 +1475,17 @@ func TestTransferFeesToFeeCollectorModule(t *testing.T) {
 					tc.asset.HasMarket,
 					tc.asset.MarketId,
 					tc.asset.AtomicResolution,
+					tc.asset.HaircutPpm,
 				)
 				require.NoError(t, err)
 			}
 
-			err := keeper.TransferFeesToFeeCollectorModule(ctx, tc.asset.Id, tc.quantums, tc.perpetualId)
+			var err error
+			if tc.isSpot {
+				err = keeper.TransferSpotFeesToFeeCollectorModule(ctx, tc.asset.Id, tc.quantums)
+			} else {
+				err = keeper.TransferFeesToFeeCollectorModule(ctx, tc.asset.Id, tc.quantums, tc.perpetualId)
+			}
 
 			if tc.expectedErr != nil {
 				require.ErrorIs(t,
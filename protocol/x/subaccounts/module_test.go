@@ -196,9 +196,10 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "subaccounts", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
+	require.Equal(t, 3, len(cmd.Commands()))
 	require.Equal(t, "list-subaccount", cmd.Commands()[0].Name())
-	require.Equal(t, "show-subaccount", cmd.Commands()[1].Name())
+	require.Equal(t, "show-adl-rank", cmd.Commands()[1].Name())
+	require.Equal(t, "show-subaccount", cmd.Commands()[2].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// AdlPositionKey returns the store key of the auto-deleveraging information of the perpetual
+// position of a subaccount.
+func AdlPositionKey(perpetualId uint32, subaccountId SubaccountId) []byte {
+	return append(lib.Uint32ToKey(perpetualId), subaccountId.ToStateKey()...)
+}
+
+// adlRankSideKeyPrefixLength is the length of the prefix of the keys of the auto-deleveraging rank
+// index of a perpetual and side.
+const adlRankSideKeyPrefixLength = 5
+
+// AdlRankSideKeyPrefix returns the prefix of the keys of the auto-deleveraging rank index of the
+// positions in a perpetual on the given side.
+func AdlRankSideKeyPrefix(perpetualId uint32, isLong bool) []byte {
+	side := byte(0)
+	if isLong {
+		side = 1
+	}
+	return append(lib.Uint32ToKey(perpetualId), side)
+}
+
+// AdlRankKey returns the key of a perpetual position in the auto-deleveraging rank index. Keys of
+// the same perpetual and side are ordered by descending score, and then by ascending subaccount id.
+func AdlRankKey(perpetualId uint32, isLong bool, score int64, subaccountId SubaccountId) []byte {
+	// Flipping the sign bit orders the scores as unsigned integers, and the complement reverses the
+	// order so that higher scores come first.
+	key := binary.BigEndian.AppendUint64(
+		AdlRankSideKeyPrefix(perpetualId, isLong),
+		^(uint64(score) ^ (1 << 63)),
+	)
+	return append(key, subaccountId.ToStateKey()...)
+}
+
+// GetAdlRankSideKeyPrefixFromRankKey returns the prefix of the perpetual and side of a key of the
+// auto-deleveraging rank index.
+func GetAdlRankSideKeyPrefixFromRankKey(rankKey []byte) []byte {
+	return rankKey[:adlRankSideKeyPrefixLength]
+}
+
+// GetAdlScoreFromRankKey returns the score of a key of the auto-deleveraging rank index.
+func GetAdlScoreFromRankKey(rankKey []byte) int64 {
+	encodedScore := binary.BigEndian.Uint64(rankKey[adlRankSideKeyPrefixLength : adlRankSideKeyPrefixLength+8])
+	return int64(^encodedScore ^ (1 << 63))
+}
+
+// GetAdlScore returns the auto-deleveraging score of a position, which is its unrealized PnL
+// multiplied by the effective leverage of its subaccount. The effective leverage is the absolute
+// notional value of the position divided by the net collateral of the subaccount.
+// Positions of subaccounts with non-positive net collateral have the lowest possible score.
+//
+// All inputs are denoted in quote quantums, and the score is bounded to an `int64`.
+func GetAdlScore(
+	bigNotionalQuoteQuantums *big.Int,
+	bigEntryQuoteQuantums *big.Int,
+	bigNetCollateral *big.Int,
+) int64 {
+	if bigNetCollateral.Sign() <= 0 {
+		return math.MinInt64
+	}
+
+	score := new(big.Int).Sub(bigNotionalQuoteQuantums, bigEntryQuoteQuantums)
+	score.Mul(score, new(big.Int).Abs(bigNotionalQuoteQuantums))
+	score.Quo(score, bigNetCollateral)
+
+	return lib.BigIntClamp(
+		score,
+		big.NewInt(math.MinInt64),
+		big.NewInt(math.MaxInt64),
+	).Int64()
+}
+
+// GetUpdatedAdlEntryQuoteQuantums returns the signed entry notional of a position after it is
+// updated by `bigDeltaQuantums` for a signed fill notional of `bigFillQuoteQuantums`.
+// Increasing a position adds the fill notional to the entry notional, and reducing a position
+// reduces the entry notional proportionally so that the average entry price is unchanged.
+// If the position is flipped to the other side, the entry notional of the new position is
+// taken at the price of the fill.
+func GetUpdatedAdlEntryQuoteQuantums(
+	bigOldQuantums *big.Int,
+	bigOldEntryQuoteQuantums *big.Int,
+	bigDeltaQuantums *big.Int,
+	bigFillQuoteQuantums *big.Int,
+) *big.Int {
+	bigNewQuantums := new(big.Int).Add(bigOldQuantums, bigDeltaQuantums)
+
+	switch {
+	case bigNewQuantums.Sign() == 0:
+		return new(big.Int)
+	case bigOldQuantums.Sign() == 0 || bigOldQuantums.Sign() == bigDeltaQuantums.Sign():
+		return new(big.Int).Add(bigOldEntryQuoteQuantums, bigFillQuoteQuantums)
+	case bigNewQuantums.Sign() == bigOldQuantums.Sign():
+		result := new(big.Int).Mul(bigOldEntryQuoteQuantums, bigNewQuantums)
+		return result.Quo(result, bigOldQuantums)
+	default:
+		result := new(big.Int).Mul(bigFillQuoteQuantums, bigNewQuantums)
+		return result.Quo(result, bigDeltaQuantums)
+	}
+}
@@ -0,0 +1,175 @@
+package types_test
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdlRankKey_Ordering(t *testing.T) {
+	// Keys are ordered by descending score, and then by ascending subaccount id.
+	orderedKeys := []struct {
+		subaccountId types.SubaccountId
+		score        int64
+	}{
+		{subaccountId: constants.Alice_Num0, score: math.MaxInt64},
+		{subaccountId: constants.Alice_Num0, score: 1_000},
+		{subaccountId: constants.Alice_Num0, score: 0},
+		{subaccountId: constants.Alice_Num1, score: 0},
+		{subaccountId: constants.Alice_Num0, score: -1},
+		{subaccountId: constants.Alice_Num0, score: math.MinInt64},
+	}
+	for i, k := range orderedKeys {
+		rankKey := types.AdlRankKey(1, true, k.score, k.subaccountId)
+		require.Equal(t, k.score, types.GetAdlScoreFromRankKey(rankKey))
+		require.Equal(t, types.AdlRankSideKeyPrefix(1, true), types.GetAdlRankSideKeyPrefixFromRankKey(rankKey))
+		if i > 0 {
+			previous := orderedKeys[i-1]
+			require.Equal(
+				t,
+				-1,
+				bytes.Compare(types.AdlRankKey(1, true, previous.score, previous.subaccountId), rankKey),
+			)
+		}
+	}
+
+	// Keys of different perpetuals and sides have different prefixes.
+	long := types.AdlRankKey(1, true, 0, constants.Alice_Num0)
+	short := types.AdlRankKey(1, false, 0, constants.Alice_Num0)
+	require.True(t, bytes.HasPrefix(long, types.AdlRankSideKeyPrefix(1, true)))
+	require.True(t, bytes.HasPrefix(short, types.AdlRankSideKeyPrefix(1, false)))
+	require.False(t, bytes.HasPrefix(long, types.AdlRankSideKeyPrefix(0, true)))
+}
+
+func TestGetAdlScore(t *testing.T) {
+	tests := map[string]struct {
+		notional      *big.Int
+		entry         *big.Int
+		netCollateral *big.Int
+
+		expectedScore int64
+	}{
+		"Profitable long": {
+			notional:      big.NewInt(1_100),
+			entry:         big.NewInt(1_000),
+			netCollateral: big.NewInt(550),
+			expectedScore: 200, // $100 PnL at 2x leverage
+		},
+		"Profitable short": {
+			notional:      big.NewInt(-900),
+			entry:         big.NewInt(-1_000),
+			netCollateral: big.NewInt(300),
+			expectedScore: 300, // $100 PnL at 3x leverage
+		},
+		"Unprofitable long": {
+			notional:      big.NewInt(900),
+			entry:         big.NewInt(1_000),
+			netCollateral: big.NewInt(900),
+			expectedScore: -100, // -$100 PnL at 1x leverage
+		},
+		"Rounds towards zero": {
+			notional:      big.NewInt(1_100),
+			entry:         big.NewInt(1_000),
+			netCollateral: big.NewInt(3_000),
+			expectedScore: 36,
+		},
+		"Zero net collateral": {
+			notional:      big.NewInt(1_100),
+			entry:         big.NewInt(1_000),
+			netCollateral: big.NewInt(0),
+			expectedScore: math.MinInt64,
+		},
+		"Negative net collateral": {
+			notional:      big.NewInt(1_100),
+			entry:         big.NewInt(1_000),
+			netCollateral: big.NewInt(-1),
+			expectedScore: math.MinInt64,
+		},
+		"Clamped to int64": {
+			notional:      new(big.Int).Lsh(big.NewInt(1), 64),
+			entry:         big.NewInt(0),
+			netCollateral: big.NewInt(1),
+			expectedScore: math.MaxInt64,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expectedScore, types.GetAdlScore(tc.notional, tc.entry, tc.netCollateral))
+		})
+	}
+}
+
+func TestGetUpdatedAdlEntryQuoteQuantums(t *testing.T) {
+	tests := map[string]struct {
+		oldQuantums *big.Int
+		oldEntry    *big.Int
+		delta       *big.Int
+		fill        *big.Int
+
+		expectedEntry *big.Int
+	}{
+		"Open long": {
+			oldQuantums:   big.NewInt(0),
+			oldEntry:      big.NewInt(0),
+			delta:         big.NewInt(10),
+			fill:          big.NewInt(1_000),
+			expectedEntry: big.NewInt(1_000),
+		},
+		"Open short": {
+			oldQuantums:   big.NewInt(0),
+			oldEntry:      big.NewInt(0),
+			delta:         big.NewInt(-10),
+			fill:          big.NewInt(-1_000),
+			expectedEntry: big.NewInt(-1_000),
+		},
+		"Increase long": {
+			oldQuantums:   big.NewInt(10),
+			oldEntry:      big.NewInt(1_000),
+			delta:         big.NewInt(10),
+			fill:          big.NewInt(1_200),
+			expectedEntry: big.NewInt(2_200),
+		},
+		"Reduce long": {
+			oldQuantums:   big.NewInt(10),
+			oldEntry:      big.NewInt(1_000),
+			delta:         big.NewInt(-4),
+			fill:          big.NewInt(-800),
+			expectedEntry: big.NewInt(600),
+		},
+		"Reduce short": {
+			oldQuantums:   big.NewInt(-10),
+			oldEntry:      big.NewInt(-1_000),
+			delta:         big.NewInt(3),
+			fill:          big.NewInt(200),
+			expectedEntry: big.NewInt(-700),
+		},
+		"Close long": {
+			oldQuantums:   big.NewInt(10),
+			oldEntry:      big.NewInt(1_000),
+			delta:         big.NewInt(-10),
+			fill:          big.NewInt(-1_500),
+			expectedEntry: big.NewInt(0),
+		},
+		"Flip long to short": {
+			oldQuantums:   big.NewInt(10),
+			oldEntry:      big.NewInt(1_000),
+			delta:         big.NewInt(-15),
+			fill:          big.NewInt(-1_800),
+			expectedEntry: big.NewInt(-600),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				tc.expectedEntry.String(),
+				types.GetUpdatedAdlEntryQuoteQuantums(tc.oldQuantums, tc.oldEntry, tc.delta, tc.fill).String(),
+			)
+		})
+	}
+}
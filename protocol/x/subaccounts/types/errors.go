@@ -47,6 +47,8 @@ var (
 	ErrAssetPositionNotSupported      = errorsmod.Register(ModuleName, 302, "asset position is not supported")
 	ErrMultAssetPositionsNotSupported = errorsmod.Register(
 		ModuleName, 303, "having multiple asset positions is not supported")
+	ErrAssetPositionNegativeQuantum = errorsmod.Register(
+		ModuleName, 304, "non-USDC asset position's quantum cannot be negative")
 
 	// 400 - 499: perpetual position related.
 	ErrPerpPositionsOutOfOrder = errorsmod.Register(ModuleName, 400, "perpetual positions are out of order")
@@ -71,4 +73,6 @@ var (
 		ModuleName, 500, "asset transfer quantums is not positive")
 	ErrAssetTransferThroughBankNotImplemented = errorsmod.Register(
 		ModuleName, 501, "asset transfer (other than USDC) through the bank module is not implemented")
+	ErrAssetNotEnabledAsCollateral = errorsmod.Register(
+		ModuleName, 502, "asset without an oracle market is not enabled as collateral")
 )
@@ -6,8 +6,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 )
 
 // ProductKeeper represents a generic interface for a keeper
@@ -41,6 +43,13 @@ type ProductKeeper interface {
 
 type AssetsKeeper interface {
 	ProductKeeper
+	GetAsset(
+		ctx sdk.Context,
+		id uint32,
+	) (
+		val assettypes.Asset,
+		exists bool,
+	)
 	ConvertAssetToCoin(
 		ctx sdk.Context,
 		assetId uint32,
@@ -72,10 +81,35 @@ type PerpetualsKeeper interface {
 		err error,
 	)
 	GetAllPerpetuals(ctx sdk.Context) []perptypes.Perpetual
+	GetPerpetualAndMarketPrice(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (
+		perptypes.Perpetual,
+		pricestypes.MarketPrice,
+		error,
+	)
 	GetInsuranceFundName(ctx sdk.Context, perpetualId uint32) (string, error)
 	GetInsuranceFundModuleAddress(ctx sdk.Context, perpetualId uint32) (sdk.AccAddress, error)
 	IsIsolatedPerpetual(ctx sdk.Context, perpetualId uint32) (bool, error)
 	ModifyOpenInterest(ctx sdk.Context, perpetualId uint32, bigQuantums *big.Int) error
+	GetNetCollateralAtRiskPrice(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigNetCollateralQuoteQuantums *big.Int,
+		err error,
+	)
+	GetMarginRequirementsAtRiskPrice(
+		ctx sdk.Context,
+		id uint32,
+		bigQuantums *big.Int,
+	) (
+		bigInitialMarginQuoteQuantums *big.Int,
+		bigMaintenanceMarginQuoteQuantums *big.Int,
+		err error,
+	)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -27,22 +28,18 @@ func (gs GenesisState) Validate() error {
 		includedAccounts[*subaccountId] = true
 
 		// Validate AssetPositions.
-		// TODO(DEC-582): once we support different assets, remove this validation.
-		if len(sa.GetAssetPositions()) > 1 {
-			return ErrMultAssetPositionsNotSupported
-		}
 		for i := 0; i < len(sa.GetAssetPositions()); i++ {
 			assetP := sa.GetAssetPositions()[i]
 			if i > 0 && assetP.AssetId <= sa.GetAssetPositions()[i-1].AssetId {
 				return ErrAssetPositionsOutOfOrder
 			}
-			// TODO(DEC-582): once we support different assets, remove this validation.
-			if assetP.AssetId != 0 {
-				return ErrAssetPositionNotSupported
-			}
 			if assetP.GetBigQuantums().Sign() == 0 {
 				return ErrAssetPositionZeroQuantum
 			}
+			// Only USDC can be borrowed, so balances of other assets cannot be negative.
+			if assetP.AssetId != assettypes.AssetUsdc.Id && assetP.GetBigQuantums().Sign() < 0 {
+				return ErrAssetPositionNegativeQuantum
+			}
 		}
 
 		// Validate PerpetualPositions.
@@ -118,7 +118,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: types.ErrDuplicateSubaccountIds,
 		},
-		"invalid: multiple asset positions": {
+		"valid: multiple asset positions": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -126,21 +126,22 @@ func TestGenesisState_Validate(t *testing.T) {
 							Owner:  sample.AccAddress(),
 							Number: uint32(127),
 						},
-						AssetPositions: []*types.AssetPosition{ // multiple asset positions.
+						AssetPositions: []*types.AssetPosition{
 							{
-								AssetId: 0,
+								AssetId:  0,
+								Quantums: dtypes.NewInt(-1_000),
 							},
 							{
-								AssetId: 1,
+								AssetId:  1,
+								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
-			expectedError: types.ErrMultAssetPositionsNotSupported,
+			expectedError: nil,
 		},
-		// TODO(DEC-582): once we support different assets, add a test case for the asset ordering.
-		"invalid: asset position id != 0": {
+		"invalid: asset positions out of order": {
 			genState: &types.GenesisState{
 				Subaccounts: []types.Subaccount{
 					{
@@ -150,14 +151,37 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 						AssetPositions: []*types.AssetPosition{
 							{
-								AssetId:  1, // asset id must be zero (0 = USDC).
+								AssetId:  1, // out of order.
+								Quantums: dtypes.NewInt(1_000),
+							},
+							{
+								AssetId:  0,
 								Quantums: dtypes.NewInt(1_000),
 							},
 						},
 					},
 				},
 			},
-			expectedError: types.ErrAssetPositionNotSupported,
+			expectedError: types.ErrAssetPositionsOutOfOrder,
+		},
+		"invalid: non-USDC asset position is negative": {
+			genState: &types.GenesisState{
+				Subaccounts: []types.Subaccount{
+					{
+						Id: &types.SubaccountId{
+							Owner:  sample.AccAddress(),
+							Number: uint32(127),
+						},
+						AssetPositions: []*types.AssetPosition{
+							{
+								AssetId:  1, // only USDC can be negative.
+								Quantums: dtypes.NewInt(-1_000),
+							},
+						},
+					},
+				},
+			},
+			expectedError: types.ErrAssetPositionNegativeQuantum,
 		},
 		"invalid: asset position quantum == 0": {
 			genState: &types.GenesisState{
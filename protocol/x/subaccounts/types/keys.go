@@ -19,4 +19,19 @@ const (
 	// Suffix for the store key to the last block a negative TNC subaccount was seen in state for the
 	// cross collateral pool.
 	CrossCollateralSuffix = "cross"
+	// AdlPositionKeyPrefix is the prefix to retrieve the auto-deleveraging information of a
+	// perpetual position, keyed by perpetual id and subaccount id.
+	AdlPositionKeyPrefix = "AdlPos:"
+	// AdlRankKeyPrefix is the prefix of the auto-deleveraging rank index, which orders the positions
+	// of each perpetual and side by descending auto-deleveraging score.
+	AdlRankKeyPrefix = "AdlRank:"
+	// AdlPositionRankKeyPrefix is the prefix to retrieve the key of a perpetual position in the
+	// auto-deleveraging rank index, keyed by perpetual id and subaccount id.
+	AdlPositionRankKeyPrefix = "AdlPosRank:"
+	// AdlRankCountKeyPrefix is the prefix to retrieve the number of positions in the auto-deleveraging
+	// rank index of each perpetual and side.
+	AdlRankCountKeyPrefix = "AdlRankCnt:"
+	// AdlOraclePriceKeyPrefix is the prefix to retrieve the oracle price at which the positions of each
+	// perpetual were last ranked, keyed by perpetual id.
+	AdlOraclePriceKeyPrefix = "AdlPrice:"
 )
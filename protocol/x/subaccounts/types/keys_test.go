@@ -14,4 +14,9 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "SA:", types.SubaccountKeyPrefix)
+	require.Equal(t, "AdlPos:", types.AdlPositionKeyPrefix)
+	require.Equal(t, "AdlRank:", types.AdlRankKeyPrefix)
+	require.Equal(t, "AdlPosRank:", types.AdlPositionRankKeyPrefix)
+	require.Equal(t, "AdlRankCnt:", types.AdlRankCountKeyPrefix)
+	require.Equal(t, "AdlPrice:", types.AdlOraclePriceKeyPrefix)
 }
@@ -438,6 +438,145 @@ func (m *QueryCollateralPoolAddressResponse) GetCollateralPoolAddress() string {
 	return ""
 }
 
+// QueryAdlRankRequest is the request type for fetching the auto-deleveraging
+// rank of a perpetual position of a subaccount.
+type QueryAdlRankRequest struct {
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number      uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	PerpetualId uint32 `protobuf:"varint,3,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *QueryAdlRankRequest) Reset()         { *m = QueryAdlRankRequest{} }
+func (m *QueryAdlRankRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdlRankRequest) ProtoMessage()    {}
+func (*QueryAdlRankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
+}
+func (m *QueryAdlRankRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdlRankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdlRankRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdlRankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdlRankRequest.Merge(m, src)
+}
+func (m *QueryAdlRankRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdlRankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdlRankRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdlRankRequest proto.InternalMessageInfo
+
+func (m *QueryAdlRankRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryAdlRankRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryAdlRankRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// QueryAdlRankResponse is the response type for fetching the
+// auto-deleveraging rank of a perpetual position of a subaccount.
+type QueryAdlRankResponse struct {
+	// The auto-deleveraging information of the position.
+	AdlPosition AdlPosition `protobuf:"bytes,1,opt,name=adl_position,json=adlPosition,proto3" json:"adl_position"`
+	// The 1-indexed rank of the position in the auto-deleveraging queue of its
+	// perpetual and side. Positions with a lower rank are deleveraged first.
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The number of positions in the auto-deleveraging queue of the perpetual
+	// and side of the position.
+	NumPositions uint32 `protobuf:"varint,3,opt,name=num_positions,json=numPositions,proto3" json:"num_positions,omitempty"`
+	// The ADL score at which the position is ranked, which is its unrealized PnL
+	// in quote quantums multiplied by the effective leverage of the subaccount.
+	Score int64 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *QueryAdlRankResponse) Reset()         { *m = QueryAdlRankResponse{} }
+func (m *QueryAdlRankResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdlRankResponse) ProtoMessage()    {}
+func (*QueryAdlRankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{9}
+}
+func (m *QueryAdlRankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdlRankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdlRankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdlRankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdlRankResponse.Merge(m, src)
+}
+func (m *QueryAdlRankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdlRankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdlRankResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdlRankResponse proto.InternalMessageInfo
+
+func (m *QueryAdlRankResponse) GetAdlPosition() AdlPosition {
+	if m != nil {
+		return m.AdlPosition
+	}
+	return AdlPosition{}
+}
+
+func (m *QueryAdlRankResponse) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *QueryAdlRankResponse) GetNumPositions() uint32 {
+	if m != nil {
+		return m.NumPositions
+	}
+	return 0
+}
+
+func (m *QueryAdlRankResponse) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
@@ -447,6 +586,8 @@ func init() {
 	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoResponse)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse")
 	proto.RegisterType((*QueryCollateralPoolAddressRequest)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest")
 	proto.RegisterType((*QueryCollateralPoolAddressResponse)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse")
+	proto.RegisterType((*QueryAdlRankRequest)(nil), "dydxprotocol.subaccounts.QueryAdlRankRequest")
+	proto.RegisterType((*QueryAdlRankResponse)(nil), "dydxprotocol.subaccounts.QueryAdlRankResponse")
 }
 
 func init() {
@@ -454,56 +595,64 @@ func init() {
 }
 
 var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0x8d, 0xc3, 0x82, 0xb4, 0xc3, 0x72, 0x19, 0xf1, 0x23, 0x58, 0xab, 0x2c, 0x58, 0x59, 0x60,
-	0x57, 0x60, 0x6f, 0x80, 0xd5, 0x4a, 0xbb, 0x8b, 0xd4, 0xa4, 0x12, 0x14, 0x7a, 0x00, 0x02, 0x08,
-	0xa9, 0x52, 0x65, 0x8d, 0xed, 0xc1, 0x58, 0x35, 0x33, 0xc6, 0x33, 0x0e, 0x20, 0xc4, 0xa5, 0x7f,
-	0x41, 0xa5, 0x5e, 0x7a, 0xeb, 0xb5, 0xd7, 0xaa, 0xfd, 0x23, 0x38, 0xa2, 0xf6, 0xd2, 0x43, 0x55,
-	0x55, 0xa1, 0x7f, 0x48, 0x95, 0xf1, 0x24, 0x76, 0x00, 0x93, 0x80, 0x7a, 0xb3, 0x67, 0xbe, 0xf7,
-	0xbe, 0xf7, 0x9e, 0xfd, 0x7d, 0xa0, 0xe4, 0x9c, 0x38, 0xc7, 0x41, 0x48, 0x39, 0xb5, 0xa9, 0x6f,
-	0xb0, 0xc8, 0x42, 0xb6, 0x4d, 0x23, 0xc2, 0x99, 0x71, 0x18, 0xe1, 0xf0, 0x44, 0x17, 0x57, 0xb0,
-	0x90, 0xae, 0xd2, 0x53, 0x55, 0xea, 0xb8, 0x4d, 0xd9, 0x01, 0x65, 0xa6, 0xb8, 0x34, 0xe2, 0x97,
-	0x18, 0xa4, 0x0e, 0xbb, 0xd4, 0xa5, 0xf1, 0x79, 0xf3, 0x49, 0x9e, 0xfe, 0xea, 0x52, 0xea, 0xfa,
-	0xd8, 0x40, 0x81, 0x67, 0x20, 0x42, 0x28, 0x47, 0xdc, 0xa3, 0xa4, 0x85, 0xf9, 0x33, 0x66, 0x30,
-	0x2c, 0xc4, 0x70, 0xac, 0xc0, 0xa8, 0x97, 0x2d, 0xcc, 0x51, 0xd9, 0x08, 0x90, 0xeb, 0x11, 0x51,
-	0x2c, 0x6b, 0xff, 0xc8, 0x94, 0x9e, 0x3c, 0xc7, 0xa5, 0x9a, 0x0d, 0xc6, 0x37, 0x9b, 0x64, 0x2b,
-	0x98, 0x6f, 0xb5, 0xef, 0x6a, 0xf8, 0x30, 0xc2, 0x8c, 0x43, 0x1d, 0xf4, 0xd3, 0x23, 0x82, 0xc3,
-	0x82, 0x32, 0xa1, 0xcc, 0xfc, 0x5c, 0x2d, 0x7c, 0x78, 0x3f, 0x37, 0x2c, 0x8d, 0x54, 0x1c, 0x27,
-	0xc4, 0x8c, 0x6d, 0xf1, 0xd0, 0x23, 0x6e, 0x2d, 0x2e, 0x83, 0xa3, 0x60, 0x80, 0x44, 0x07, 0x16,
-	0x0e, 0x0b, 0xf9, 0x09, 0x65, 0x66, 0xa8, 0x26, 0xdf, 0x34, 0x0c, 0xc6, 0x44, 0x93, 0x74, 0x07,
-	0x16, 0x50, 0xc2, 0x30, 0x5c, 0x03, 0x20, 0xd1, 0x24, 0xfa, 0x0c, 0xce, 0x97, 0xf4, 0xac, 0x50,
-	0xf5, 0x84, 0xa1, 0xfa, 0xd3, 0xf9, 0x97, 0xdf, 0x72, 0xb5, 0x14, 0xba, 0xed, 0xa5, 0xe2, 0xfb,
-	0xd7, 0xbd, 0x2c, 0x03, 0x90, 0xe4, 0x24, 0x1b, 0x4d, 0xe9, 0xd2, 0x4d, 0x33, 0x54, 0x3d, 0xfe,
-	0xac, 0x32, 0x54, 0x7d, 0x03, 0xb9, 0x58, 0x62, 0x6b, 0x29, 0xa4, 0xf6, 0x56, 0x01, 0xea, 0x15,
-	0x33, 0x15, 0xdf, 0xcf, 0xf4, 0xd3, 0x77, 0x7f, 0x3f, 0x70, 0xa5, 0x43, 0x72, 0x5e, 0x48, 0x9e,
-	0xee, 0x2a, 0x39, 0x16, 0xd2, 0xa1, 0x79, 0x07, 0xfc, 0xd5, 0xfa, 0xc8, 0xbb, 0x1e, 0xdf, 0x77,
-	0x42, 0x74, 0x84, 0xfc, 0x0a, 0x71, 0xb6, 0x43, 0x44, 0xd8, 0x1e, 0x0e, 0x59, 0xd5, 0xa7, 0xf6,
-	0x33, 0xec, 0xac, 0x92, 0x3d, 0xda, 0xca, 0x6b, 0x12, 0xfc, 0x12, 0xe0, 0x30, 0xc0, 0x3c, 0x42,
-	0xbe, 0xe9, 0x39, 0x22, 0xb1, 0xa1, 0xda, 0x60, 0xfb, 0x6c, 0xd5, 0xd1, 0x5e, 0xe7, 0x41, 0xf9,
-	0x0e, 0xbc, 0x32, 0xa1, 0x75, 0xf0, 0x3b, 0xc1, 0x2e, 0xe2, 0x5e, 0x1d, 0x9b, 0x9c, 0xd8, 0x66,
-	0x62, 0xd8, 0x64, 0x18, 0x13, 0x13, 0x71, 0xd3, 0x6a, 0xc2, 0x64, 0xc7, 0x89, 0x56, 0xf1, 0x36,
-	0xb1, 0x93, 0xb4, 0xb6, 0x30, 0x26, 0x15, 0x2e, 0xe8, 0xe1, 0xbf, 0x40, 0xb5, 0xf7, 0x91, 0x47,
-	0x4c, 0x1a, 0x71, 0xe4, 0xe2, 0x2b, 0x2c, 0xf1, 0x9f, 0x38, 0x2a, 0x2a, 0xd6, 0x45, 0x41, 0x1a,
-	0xfb, 0x14, 0xcc, 0x1e, 0xb5, 0x95, 0x33, 0x13, 0x11, 0xc7, 0xe4, 0x2d, 0xf1, 0x66, 0x44, 0xac,
-	0x58, 0x7f, 0xc2, 0xd6, 0x27, 0xd8, 0xa6, 0x53, 0x98, 0xb4, 0xdd, 0x9d, 0x16, 0x40, 0xd2, 0x6b,
-	0xcb, 0x60, 0x52, 0x04, 0xf4, 0x90, 0xfa, 0x3e, 0xe2, 0x38, 0x44, 0xfe, 0x06, 0xa5, 0xbe, 0x9c,
-	0x9d, 0x3b, 0x24, 0x5d, 0x07, 0xda, 0x6d, 0x3c, 0x32, 0xd9, 0x0d, 0x30, 0x66, 0xb7, 0x0b, 0xcc,
-	0x80, 0x52, 0xdf, 0x44, 0x71, 0x49, 0xd7, 0x01, 0x1e, 0xb1, 0x6f, 0x62, 0x9e, 0x6f, 0x0c, 0x80,
-	0x7e, 0xd1, 0x18, 0xbe, 0x53, 0x00, 0x48, 0xe2, 0x87, 0x0b, 0xd9, 0xbf, 0x74, 0xe6, 0x3a, 0x51,
-	0xcb, 0x5d, 0x40, 0xd7, 0xd7, 0x83, 0xb6, 0xf4, 0xfc, 0xe3, 0xb7, 0x97, 0xf9, 0x7f, 0xe0, 0xdf,
-	0x46, 0x0f, 0x2b, 0xcd, 0x38, 0x15, 0x6b, 0xe8, 0xcc, 0x38, 0x8d, 0xf7, 0xce, 0x19, 0x7c, 0xa3,
-	0x80, 0xa1, 0x8e, 0x39, 0xed, 0x2a, 0xfc, 0xa6, 0xdd, 0xa1, 0x2e, 0xf6, 0x2c, 0x3c, 0xb5, 0x0a,
-	0xb4, 0x59, 0xa1, 0x7d, 0x0a, 0x96, 0x7a, 0xd1, 0x0e, 0x5f, 0xe5, 0x41, 0xa9, 0x97, 0x39, 0x82,
-	0x6b, 0xdd, 0xa3, 0xef, 0x75, 0xc8, 0xd5, 0xc7, 0x3f, 0x84, 0x4b, 0xfa, 0xdd, 0x15, 0x7e, 0x37,
-	0xe1, 0x7a, 0xb6, 0xdf, 0xec, 0x59, 0x6b, 0x4d, 0x9a, 0x47, 0xf6, 0xa8, 0x71, 0x9a, 0x9e, 0x87,
-	0x33, 0xf8, 0x59, 0x01, 0x23, 0x37, 0xfe, 0xf9, 0xf0, 0xbf, 0x2e, 0xfa, 0x6f, 0x9b, 0x3b, 0xf5,
-	0xff, 0xfb, 0x81, 0xa5, 0xdb, 0x47, 0xc2, 0x6d, 0x15, 0x3e, 0xc8, 0x76, 0x9b, 0x31, 0x8c, 0x57,
-	0xec, 0x55, 0x77, 0xcf, 0x1b, 0x45, 0xe5, 0xa2, 0x51, 0x54, 0xbe, 0x36, 0x8a, 0xca, 0x8b, 0xcb,
-	0x62, 0xee, 0xe2, 0xb2, 0x98, 0xfb, 0x74, 0x59, 0xcc, 0x3d, 0x59, 0x72, 0x3d, 0xbe, 0x1f, 0x59,
-	0xba, 0x4d, 0x0f, 0x3a, 0xbb, 0xd4, 0x17, 0xe7, 0xc4, 0x42, 0x33, 0xda, 0x27, 0xc7, 0x1d, 0x9d,
-	0xf9, 0x49, 0x80, 0x99, 0x35, 0x20, 0x6e, 0x17, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x51,
-	0x19, 0x95, 0xca, 0x08, 0x00, 0x00,
+	// 911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0x49, 0x81, 0x97, 0xe4, 0x32, 0xa4, 0xed, 0xd6, 0x42, 0x4b, 0x6a, 0xd2, 0x36,
+	0xa0, 0xc6, 0x26, 0x6d, 0x11, 0x12, 0x50, 0x89, 0x5d, 0x50, 0x4b, 0x83, 0x44, 0x52, 0xa7, 0x55,
+	0x24, 0x24, 0x64, 0x8d, 0xed, 0x89, 0x63, 0x75, 0x76, 0xc6, 0xf5, 0x8c, 0x93, 0x46, 0x51, 0x24,
+	0xc4, 0x2f, 0x40, 0xe2, 0x02, 0x27, 0xae, 0x5c, 0x11, 0xe5, 0x3f, 0xf4, 0x58, 0xc1, 0x85, 0x03,
+	0x42, 0x28, 0xe1, 0x87, 0xa0, 0x1d, 0x8f, 0xd7, 0xde, 0xdd, 0x38, 0xbb, 0xad, 0x7a, 0xf3, 0xcc,
+	0x7c, 0xef, 0xbd, 0xef, 0x7b, 0x33, 0xef, 0xdb, 0x85, 0xe5, 0xf0, 0x20, 0x7c, 0x92, 0xa4, 0x5c,
+	0xf2, 0x80, 0x53, 0x47, 0x64, 0x3e, 0x0e, 0x02, 0x9e, 0x31, 0x29, 0x9c, 0xc7, 0x19, 0x49, 0x0f,
+	0x6c, 0x75, 0x84, 0x9a, 0x55, 0x94, 0x5d, 0x41, 0x99, 0x97, 0x02, 0x2e, 0xba, 0x5c, 0x78, 0xea,
+	0xd0, 0xc9, 0x17, 0x79, 0x90, 0xb9, 0x18, 0xf1, 0x88, 0xe7, 0xfb, 0xbd, 0x2f, 0xbd, 0xfb, 0x56,
+	0xc4, 0x79, 0x44, 0x89, 0x83, 0x93, 0xd8, 0xc1, 0x8c, 0x71, 0x89, 0x65, 0xcc, 0x59, 0x11, 0xf3,
+	0x5e, 0x9e, 0xc1, 0xf1, 0xb1, 0x20, 0x39, 0x03, 0x67, 0x6f, 0xcd, 0x27, 0x12, 0xaf, 0x39, 0x09,
+	0x8e, 0x62, 0xa6, 0xc0, 0x1a, 0x6b, 0xd5, 0x52, 0xc7, 0x21, 0xd5, 0x98, 0x77, 0x6b, 0x31, 0xe5,
+	0x77, 0x0e, 0xb5, 0x02, 0xb8, 0x74, 0xbf, 0x57, 0xf0, 0x2e, 0x91, 0x5b, 0xfd, 0x33, 0x97, 0x3c,
+	0xce, 0x88, 0x90, 0xc8, 0x86, 0x59, 0xbe, 0xcf, 0x48, 0xda, 0x34, 0x96, 0x8c, 0x95, 0x37, 0x3a,
+	0xcd, 0x3f, 0x9e, 0xae, 0x2e, 0x6a, 0xb1, 0xed, 0x30, 0x4c, 0x89, 0x10, 0x5b, 0x32, 0x8d, 0x59,
+	0xe4, 0xe6, 0x30, 0x74, 0x01, 0xce, 0xb1, 0xac, 0xeb, 0x93, 0xb4, 0xd9, 0x58, 0x32, 0x56, 0x16,
+	0x5c, 0xbd, 0xb2, 0x08, 0x5c, 0x54, 0x45, 0xaa, 0x15, 0x44, 0xc2, 0x99, 0x20, 0x68, 0x1d, 0xa0,
+	0xe4, 0xa4, 0xea, 0xcc, 0xdd, 0x58, 0xb6, 0xeb, 0x1a, 0x6f, 0x97, 0x19, 0x3a, 0x33, 0xcf, 0xfe,
+	0x79, 0x7b, 0xca, 0xad, 0x44, 0xf7, 0xb5, 0xb4, 0x29, 0x1d, 0xd5, 0x72, 0x07, 0xa0, 0xec, 0xa5,
+	0x2e, 0x74, 0xd5, 0xd6, 0x6a, 0x7a, 0x8d, 0xb7, 0xf3, 0xab, 0xd7, 0x8d, 0xb7, 0x37, 0x71, 0x44,
+	0x74, 0xac, 0x5b, 0x89, 0xb4, 0x7e, 0x35, 0xc0, 0x1c, 0x12, 0xd3, 0xa6, 0xb4, 0x56, 0xcf, 0xf4,
+	0xcb, 0xeb, 0x41, 0x77, 0x07, 0x28, 0x37, 0x14, 0xe5, 0x6b, 0x63, 0x29, 0xe7, 0x44, 0x06, 0x38,
+	0x3f, 0x84, 0xf7, 0x8b, 0x4b, 0xde, 0x8e, 0xe5, 0x6e, 0x98, 0xe2, 0x7d, 0x4c, 0xdb, 0x2c, 0x7c,
+	0x90, 0x62, 0x26, 0x76, 0x48, 0x2a, 0x3a, 0x94, 0x07, 0x8f, 0x48, 0x78, 0x8f, 0xed, 0xf0, 0xa2,
+	0x5f, 0x97, 0x61, 0x3e, 0x21, 0x69, 0x42, 0x64, 0x86, 0xa9, 0x17, 0x87, 0xaa, 0x63, 0x0b, 0xee,
+	0x5c, 0x7f, 0xef, 0x5e, 0x68, 0xfd, 0xdc, 0x80, 0xb5, 0x17, 0xc8, 0xab, 0x3b, 0xb4, 0x01, 0x57,
+	0x18, 0x89, 0xb0, 0x8c, 0xf7, 0x88, 0x27, 0x59, 0xe0, 0x95, 0x82, 0x3d, 0x41, 0x08, 0xf3, 0xb0,
+	0xf4, 0xfc, 0x5e, 0x98, 0xae, 0xb8, 0x54, 0x80, 0x1f, 0xb0, 0xa0, 0xec, 0xd6, 0x16, 0x21, 0xac,
+	0x2d, 0x55, 0x7a, 0xf4, 0x11, 0x98, 0xc1, 0x2e, 0x8e, 0x99, 0xc7, 0x33, 0x89, 0x23, 0x32, 0x94,
+	0x25, 0x7f, 0x89, 0x17, 0x14, 0x62, 0x43, 0x01, 0xaa, 0xb1, 0xdf, 0xc0, 0xf5, 0xfd, 0x3e, 0x73,
+	0xe1, 0x61, 0x16, 0x7a, 0xb2, 0x20, 0xef, 0x65, 0xcc, 0xcf, 0xf9, 0x97, 0xd9, 0xa6, 0x55, 0xb6,
+	0x6b, 0x95, 0x98, 0xaa, 0xdc, 0x87, 0x45, 0x80, 0x4e, 0x6f, 0xdd, 0x81, 0xcb, 0xaa, 0x41, 0x9f,
+	0x71, 0x4a, 0xb1, 0x24, 0x29, 0xa6, 0x9b, 0x9c, 0x53, 0x3d, 0x3b, 0x2f, 0xd0, 0xe9, 0x3d, 0xb0,
+	0xce, 0xca, 0xa3, 0x3b, 0xbb, 0x09, 0x17, 0x83, 0x3e, 0xc0, 0x4b, 0x38, 0xa7, 0x1e, 0xce, 0x21,
+	0x63, 0x07, 0xf8, 0x7c, 0x70, 0x5a, 0x66, 0xeb, 0x5b, 0x03, 0xde, 0xcc, 0x47, 0x2a, 0xa4, 0x2e,
+	0x66, 0x8f, 0x5e, 0xb1, 0x31, 0x8c, 0x48, 0x9f, 0x1e, 0x95, 0xfe, 0xbb, 0x01, 0x8b, 0x83, 0x14,
+	0xb4, 0xda, 0xaf, 0x60, 0x1e, 0x87, 0x3d, 0x99, 0x22, 0xae, 0x8c, 0xf4, 0x95, 0xfa, 0x59, 0x6b,
+	0x87, 0x74, 0x53, 0x83, 0xf5, 0xb0, 0xcd, 0xe1, 0x72, 0x0b, 0x21, 0x98, 0x49, 0x31, 0x2b, 0x1e,
+	0x8c, 0xfa, 0x46, 0xef, 0xc0, 0x02, 0xcb, 0xba, 0xfd, 0x1a, 0x42, 0x13, 0x9c, 0x67, 0x59, 0xb7,
+	0x88, 0x13, 0x68, 0x11, 0x66, 0x45, 0xc0, 0x53, 0xd2, 0x9c, 0x59, 0x32, 0x56, 0xa6, 0xdd, 0x7c,
+	0x71, 0xe3, 0xa7, 0xd7, 0x61, 0x56, 0xf1, 0x46, 0xbf, 0x19, 0x00, 0xe5, 0xcb, 0x45, 0x37, 0xeb,
+	0x19, 0xd6, 0x3a, 0xb1, 0xb9, 0x36, 0x26, 0x68, 0xd4, 0x59, 0xad, 0xdb, 0xdf, 0xfd, 0xf9, 0xdf,
+	0x0f, 0x8d, 0x0f, 0xd1, 0x07, 0xce, 0x04, 0xbf, 0x06, 0xce, 0xa1, 0xba, 0xa8, 0x23, 0xe7, 0x30,
+	0xbf, 0x99, 0x23, 0xf4, 0x8b, 0x01, 0x0b, 0x03, 0x16, 0x37, 0x96, 0xf8, 0x69, 0xb6, 0x6b, 0xde,
+	0x9a, 0x98, 0x78, 0xc5, 0x45, 0xad, 0xeb, 0x8a, 0xfb, 0x55, 0xb4, 0x3c, 0x09, 0x77, 0xf4, 0x63,
+	0x03, 0x96, 0x27, 0xb1, 0x20, 0xb4, 0x3e, 0xbe, 0xf5, 0x93, 0xfa, 0xa3, 0xf9, 0xe5, 0x2b, 0xc9,
+	0xa5, 0xf5, 0x6e, 0x2b, 0xbd, 0xf7, 0xd1, 0x46, 0xbd, 0xde, 0x7a, 0x9b, 0x2a, 0x4c, 0x2a, 0x66,
+	0x3b, 0xdc, 0x39, 0xac, 0xce, 0xd3, 0x11, 0xfa, 0xdb, 0x80, 0xf3, 0xa7, 0x9a, 0x06, 0xfa, 0x78,
+	0x0c, 0xff, 0xb3, 0x2c, 0xcb, 0xfc, 0xe4, 0xe5, 0x82, 0xb5, 0xda, 0x2f, 0x94, 0xda, 0x0e, 0xfa,
+	0xb4, 0x5e, 0x6d, 0x8d, 0x8f, 0x0d, 0xcb, 0x7b, 0x6a, 0xc0, 0x6b, 0xda, 0x17, 0xd0, 0xea, 0xb8,
+	0xe7, 0x39, 0x60, 0x61, 0xa6, 0x3d, 0x29, 0x5c, 0x93, 0x5e, 0x57, 0xa4, 0x3f, 0x47, 0x1d, 0xe7,
+	0xac, 0x3f, 0x60, 0x5e, 0xcf, 0x36, 0x46, 0x86, 0x69, 0x88, 0x76, 0x67, 0xfb, 0xeb, 0xdb, 0x51,
+	0x2c, 0x77, 0x33, 0xdf, 0x0e, 0x78, 0x77, 0x30, 0xdf, 0xde, 0xad, 0x55, 0xf5, 0x53, 0xe5, 0xf4,
+	0x77, 0x9e, 0x0c, 0xd4, 0x90, 0x07, 0x09, 0x11, 0xcf, 0x8e, 0x5b, 0xc6, 0xf3, 0xe3, 0x96, 0xf1,
+	0xef, 0x71, 0xcb, 0xf8, 0xfe, 0xa4, 0x35, 0xf5, 0xfc, 0xa4, 0x35, 0xf5, 0xd7, 0x49, 0x6b, 0xca,
+	0x3f, 0xa7, 0xa2, 0x6e, 0xfe, 0x3f, 0x00, 0x56, 0xdc, 0x90, 0xfe, 0xe0, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -527,6 +676,9 @@ type QueryClient interface {
 	GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
 	// Queries the collateral pool account address for a perpetual id.
 	CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error)
+	// Queries the auto-deleveraging rank of a perpetual position of a
+	// subaccount.
+	AdlRank(ctx context.Context, in *QueryAdlRankRequest, opts ...grpc.CallOption) (*QueryAdlRankResponse, error)
 }
 
 type queryClient struct {
@@ -573,6 +725,15 @@ func (c *queryClient) CollateralPoolAddress(ctx context.Context, in *QueryCollat
 	return out, nil
 }
 
+func (c *queryClient) AdlRank(ctx context.Context, in *QueryAdlRankRequest, opts ...grpc.CallOption) (*QueryAdlRankResponse, error) {
+	out := new(QueryAdlRankResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/AdlRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Subaccount by id
@@ -584,6 +745,9 @@ type QueryServer interface {
 	GetWithdrawalAndTransfersBlockedInfo(context.Context, *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
 	// Queries the collateral pool account address for a perpetual id.
 	CollateralPoolAddress(context.Context, *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error)
+	// Queries the auto-deleveraging rank of a perpetual position of a
+	// subaccount.
+	AdlRank(context.Context, *QueryAdlRankRequest) (*QueryAdlRankResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -602,6 +766,9 @@ func (*UnimplementedQueryServer) GetWithdrawalAndTransfersBlockedInfo(ctx contex
 func (*UnimplementedQueryServer) CollateralPoolAddress(ctx context.Context, req *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralPoolAddress not implemented")
 }
+func (*UnimplementedQueryServer) AdlRank(ctx context.Context, req *QueryAdlRankRequest) (*QueryAdlRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdlRank not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -679,6 +846,24 @@ func _Query_CollateralPoolAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AdlRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdlRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdlRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/AdlRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdlRank(ctx, req.(*QueryAdlRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.subaccounts.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -699,6 +884,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralPoolAddress",
 			Handler:    _Query_CollateralPoolAddress_Handler,
 		},
+		{
+			MethodName: "AdlRank",
+			Handler:    _Query_AdlRank_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/subaccounts/query.proto",
@@ -980,6 +1169,94 @@ func (m *QueryCollateralPoolAddressResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAdlRankRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdlRankRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdlRankRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdlRankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdlRankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdlRankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumPositions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPositions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Rank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.AdlPosition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1105,6 +1382,45 @@ func (m *QueryCollateralPoolAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAdlRankRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryAdlRankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AdlPosition.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Rank != 0 {
+		n += 1 + sovQuery(uint64(m.Rank))
+	}
+	if m.NumPositions != 0 {
+		n += 1 + sovQuery(uint64(m.NumPositions))
+	}
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1828,6 +2144,266 @@ func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAdlRankRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdlRankRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdlRankRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdlRankResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdlRankResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdlRankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdlPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdlPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPositions", wireType)
+			}
+			m.NumPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPositions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -62,6 +62,7 @@ var updateResultStringMap = map[UpdateResult]string{
 	WithdrawalsAndTransfersBlocked:        "WithdrawalsAndTransfersBlocked",
 	UpdateCausedError:                     "UpdateCausedError",
 	ViolatesIsolatedSubaccountConstraints: "ViolatesIsolatedSubaccountConstraints",
+	InsufficientAssetBalance:              "InsufficientAssetBalance",
 }
 
 const (
@@ -71,6 +72,7 @@ const (
 	WithdrawalsAndTransfersBlocked
 	UpdateCausedError
 	ViolatesIsolatedSubaccountConstraints
+	InsufficientAssetBalance
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -109,6 +111,7 @@ const (
 	Deposit
 	Match
 	CollatCheck
+	SpotMatch
 )
 
 var updateTypeStringMap = map[UpdateType]string{
@@ -118,6 +121,7 @@ var updateTypeStringMap = map[UpdateType]string{
 	Deposit:               "Deposit",
 	Match:                 "Match",
 	CollatCheck:           "CollatCheck",
+	SpotMatch:             "SpotMatch",
 }
 
 func (u UpdateType) String() string {
@@ -92,8 +92,12 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.ViolatesIsolatedSubaccountConstraints,
 			expectedResult: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"InsufficientAssetBalance": {
+			value:          types.InsufficientAssetBalance,
+			expectedResult: "InsufficientAssetBalance",
+		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(6),
+			value:          types.UpdateResult(7),
 			expectedResult: "UnexpectedError",
 		},
 	}
@@ -168,6 +172,10 @@ func TestUpdateTypeString(t *testing.T) {
 			value:          types.Match,
 			expectedResult: "Match",
 		},
+		"SpotMatch": {
+			value:          types.SpotMatch,
+			expectedResult: "SpotMatch",
+		},
 		"UnexpectedError": {
 			value:          types.UpdateType(999),
 			expectedResult: "UnexpectedUpdateTypeError",
@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryVault())
 	cmd.AddCommand(CmdQueryListVault())
 	cmd.AddCommand(CmdQueryListOwnerShares())
+	cmd.AddCommand(CmdQueryRedeemableAmount())
 
 	return cmd
 }
@@ -176,3 +177,46 @@ func CmdQueryListOwnerShares() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryRedeemableAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-redeemable-amount [type] [number] [owner]",
+		Short: "get the amount of quote quantums that an owner can redeem from a vault",
+		Long: "get the amount of quote quantums that an owner can redeem from a vault by its type and number. " +
+			"Current support types are: clob.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RedeemableAmount(
+				context.Background(),
+				&types.QueryRedeemableAmountRequest{
+					Type:   vaultType,
+					Number: uint32(vaultNumber),
+					Owner:  args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
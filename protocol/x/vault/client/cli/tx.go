@@ -25,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdDepositToVault())
+	cmd.AddCommand(CmdWithdrawFromVault())
 
 	return cmd
 }
@@ -88,3 +89,65 @@ func CmdDepositToVault() *cobra.Command {
 
 	return cmd
 }
+
+func CmdWithdrawFromVault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-from-vault [vault_type] [vault_number] [withdrawer_owner] [withdrawer_number] [shares]",
+		Short: "Broadcast message WithdrawFromVault",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Parse vault type.
+			vaultType, err := GetVaultTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Parse vault number.
+			vaultNumber, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Parse withdrawer number.
+			withdrawerNumber, err := cast.ToUint32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			// Parse shares.
+			shares, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create MsgWithdrawFromVault.
+			msg := &types.MsgWithdrawFromVault{
+				VaultId: &types.VaultId{
+					Type:   vaultType,
+					Number: vaultNumber,
+				},
+				SubaccountId: &satypes.SubaccountId{
+					Owner:  args[2],
+					Number: withdrawerNumber,
+				},
+				Shares: &types.NumShares{
+					NumShares: dtypes.NewIntFromUint64(shares),
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+func (k Keeper) RedeemableAmount(
+	goCtx context.Context,
+	req *types.QueryRedeemableAmountRequest,
+) (*types.QueryRedeemableAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	vaultId := types.VaultId{
+		Type:   req.Type,
+		Number: req.Number,
+	}
+	if _, exists := k.GetTotalShares(ctx, vaultId); !exists {
+		return nil, status.Error(codes.NotFound, "vault not found")
+	}
+
+	// Get owner shares.
+	ownerShares, exists := k.GetOwnerShares(ctx, vaultId, req.Owner)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "owner shares not found")
+	}
+
+	// Get quote quantums that all of the owner shares can be redeemed for.
+	redeemable, err := k.GetRedeemableQuoteQuantums(ctx, vaultId, ownerShares.NumShares.BigInt())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedeemableAmountResponse{
+		Shares:                  ownerShares,
+		RedeemableQuoteQuantums: dtypes.NewIntFromBigInt(redeemable),
+		UnlockBlockHeight:       k.GetOwnerUnlockBlockHeight(ctx, vaultId, req.Owner),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedeemableAmount(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Request.
+		req *vaulttypes.QueryRedeemableAmountRequest
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Vault equity.
+		equity *big.Int
+		// Owner shares.
+		ownerShares map[string]*big.Int
+		// Withdrawal fee in ppm.
+		withdrawalFeePpm uint32
+		// Withdrawal lockup in blocks.
+		withdrawalLockupBlocks uint32
+
+		/* --- Expectations --- */
+		expectedResponse *vaulttypes.QueryRedeemableAmountResponse
+		expectedErr      string
+	}{
+		"Success": {
+			req: &vaulttypes.QueryRedeemableAmountRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			vaultId: constants.Vault_Clob_0,
+			equity:  big.NewInt(4_000),
+			ownerShares: map[string]*big.Int{
+				constants.Alice_Num0.Owner: big.NewInt(1_250),
+				constants.Bob_Num0.Owner:   big.NewInt(3_750),
+			},
+			expectedResponse: &vaulttypes.QueryRedeemableAmountResponse{
+				Shares:                  vaulttypes.BigIntToNumShares(big.NewInt(1_250)),
+				RedeemableQuoteQuantums: dtypes.NewInt(1_000),
+				UnlockBlockHeight:       1,
+			},
+		},
+		"Success: with withdrawal fee and lockup": {
+			req: &vaulttypes.QueryRedeemableAmountRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			vaultId: constants.Vault_Clob_0,
+			equity:  big.NewInt(4_000),
+			ownerShares: map[string]*big.Int{
+				constants.Alice_Num0.Owner: big.NewInt(1_250),
+				constants.Bob_Num0.Owner:   big.NewInt(3_750),
+			},
+			withdrawalFeePpm:       10_000, // 1%
+			withdrawalLockupBlocks: 20,
+			expectedResponse: &vaulttypes.QueryRedeemableAmountResponse{
+				Shares:                  vaulttypes.BigIntToNumShares(big.NewInt(1_250)),
+				RedeemableQuoteQuantums: dtypes.NewInt(990),
+				UnlockBlockHeight:       21,
+			},
+		},
+		"Error: owner shares not found": {
+			req: &vaulttypes.QueryRedeemableAmountRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+				Owner:  constants.Carl_Num0.Owner,
+			},
+			vaultId: constants.Vault_Clob_0,
+			equity:  big.NewInt(4_000),
+			ownerShares: map[string]*big.Int{
+				constants.Alice_Num0.Owner: big.NewInt(1_250),
+			},
+			expectedErr: "owner shares not found",
+		},
+		"Error: vault not found": {
+			req: &vaulttypes.QueryRedeemableAmountRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 1,
+				Owner:  constants.Alice_Num0.Owner,
+			},
+			vaultId: constants.Vault_Clob_0,
+			equity:  big.NewInt(4_000),
+			ownerShares: map[string]*big.Int{
+				constants.Alice_Num0.Owner: big.NewInt(1_250),
+			},
+			expectedErr: "vault not found",
+		},
+		"Error: nil request": {
+			req:     nil,
+			vaultId: constants.Vault_Clob_0,
+			equity:  big.NewInt(4_000),
+			ownerShares: map[string]*big.Int{
+				constants.Alice_Num0.Owner: big.NewInt(1_250),
+			},
+			expectedErr: "invalid request",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Set withdrawal fee and lockup.
+			params := k.GetParams(ctx)
+			params.WithdrawalFeePpm = tc.withdrawalFeePpm
+			params.WithdrawalLockupBlocks = tc.withdrawalLockupBlocks
+			err := k.SetParams(ctx, params)
+			require.NoError(t, err)
+
+			// Set vault equity.
+			tApp.App.SubaccountsKeeper.SetSubaccount(ctx, satypes.Subaccount{
+				Id: tc.vaultId.ToSubaccountId(),
+				AssetPositions: []*satypes.AssetPosition{
+					{
+						AssetId:  0,
+						Quantums: dtypes.NewIntFromBigInt(tc.equity),
+					},
+				},
+			})
+
+			// Set owner shares and last deposit heights.
+			totalShares := big.NewInt(0)
+			for owner, shares := range tc.ownerShares {
+				err := k.SetOwnerShares(ctx, tc.vaultId, owner, vaulttypes.BigIntToNumShares(shares))
+				require.NoError(t, err)
+				k.SetOwnerLastDepositHeight(ctx, tc.vaultId, owner, 1)
+				totalShares.Add(totalShares, shares)
+			}
+
+			// Set total shares.
+			err = k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(totalShares))
+			require.NoError(t, err)
+
+			// Check RedeemableAmount query response is as expected.
+			response, err := k.RedeemableAmount(ctx, tc.req)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResponse, response)
+			}
+		})
+	}
+}
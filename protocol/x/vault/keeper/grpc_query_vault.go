@@ -54,6 +54,7 @@ func (k Keeper) Vault(
 		Equity:       equity.Uint64(),
 		Inventory:    inventory.Uint64(),
 		TotalShares:  totalShares.NumShares.BigInt().Uint64(),
+		VaultParams:  k.GetEffectiveVaultParams(ctx, vaultId),
 	}, nil
 }
 
@@ -15,6 +15,16 @@ import (
 )
 
 func TestVault(t *testing.T) {
+	defaultQuotingParams := vaulttypes.DefaultParams().QuotingParams()
+	quotingParams := vaulttypes.QuotingParams{
+		Layers:                           3,
+		SpreadMinPpm:                     4_000,
+		SpreadBufferPpm:                  2_000,
+		SkewFactorPpm:                    500_000,
+		OrderSizePctPpm:                  200_000,
+		OrderExpirationSeconds:           10,
+		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000),
+	}
 	tests := map[string]struct {
 		/* --- Setup --- */
 		// Vault ID.
@@ -27,12 +37,15 @@ func TestVault(t *testing.T) {
 		inventory *big.Int
 		// Total shares.
 		totalShares *big.Int
+		// Vault params, if any.
+		vaultParams *vaulttypes.VaultParams
 		// Query request.
 		req *vaulttypes.QueryVaultRequest
 
 		/* --- Expectations --- */
-		expectedEquity uint64
-		expectedErr    string
+		expectedEquity      uint64
+		expectedVaultParams vaulttypes.VaultParams
+		expectedErr         string
 	}{
 		"Success": {
 			req: &vaulttypes.QueryVaultRequest{
@@ -45,6 +58,49 @@ func TestVault(t *testing.T) {
 			inventory:      big.NewInt(200),
 			totalShares:    big.NewInt(300),
 			expectedEquity: 500,
+			expectedVaultParams: vaulttypes.VaultParams{
+				Status:        vaulttypes.VaultStatus_VAULT_STATUS_QUOTING,
+				QuotingParams: &defaultQuotingParams,
+			},
+		},
+		"Success: vault params with status only": {
+			req: &vaulttypes.QueryVaultRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:     constants.Vault_Clob_0,
+			asset:       big.NewInt(100),
+			perpId:      0,
+			inventory:   big.NewInt(200),
+			totalShares: big.NewInt(300),
+			vaultParams: &vaulttypes.VaultParams{
+				Status: vaulttypes.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+			},
+			expectedEquity: 500,
+			expectedVaultParams: vaulttypes.VaultParams{
+				Status:        vaulttypes.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+				QuotingParams: &defaultQuotingParams,
+			},
+		},
+		"Success: vault params with quoting params": {
+			req: &vaulttypes.QueryVaultRequest{
+				Type:   vaulttypes.VaultType_VAULT_TYPE_CLOB,
+				Number: 0,
+			},
+			vaultId:     constants.Vault_Clob_0,
+			asset:       big.NewInt(100),
+			perpId:      0,
+			inventory:   big.NewInt(200),
+			totalShares: big.NewInt(300),
+			vaultParams: &vaulttypes.VaultParams{
+				Status:        vaulttypes.VaultStatus_VAULT_STATUS_STAND_BY,
+				QuotingParams: &quotingParams,
+			},
+			expectedEquity: 500,
+			expectedVaultParams: vaulttypes.VaultParams{
+				Status:        vaulttypes.VaultStatus_VAULT_STATUS_STAND_BY,
+				QuotingParams: &quotingParams,
+			},
 		},
 		"Error: query non-existent vault": {
 			req: &vaulttypes.QueryVaultRequest{
@@ -104,6 +160,12 @@ func TestVault(t *testing.T) {
 			err := k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(tc.totalShares))
 			require.NoError(t, err)
 
+			// Set vault params if specified.
+			if tc.vaultParams != nil {
+				err := k.SetVaultParams(ctx, tc.vaultId, *tc.vaultParams)
+				require.NoError(t, err)
+			}
+
 			// Check Vault query response is as expected.
 			response, err := k.Vault(ctx, tc.req)
 			if tc.expectedErr != "" {
@@ -116,6 +178,7 @@ func TestVault(t *testing.T) {
 					Equity:       tc.expectedEquity,
 					Inventory:    tc.inventory.Uint64(),
 					TotalShares:  tc.totalShares.Uint64(),
+					VaultParams:  tc.expectedVaultParams,
 				}
 				require.Equal(t, expectedResponse, *response)
 			}
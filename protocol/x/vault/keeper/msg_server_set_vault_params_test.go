@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetVaultParams(t *testing.T) {
+	tests := map[string]struct {
+		// Msg.
+		msg *types.MsgSetVaultParams
+		// Expected error
+		expectedErr string
+	}{
+		"Success - Status only": {
+			msg: &types.MsgSetVaultParams{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob_0,
+				VaultParams: types.VaultParams{
+					Status: types.VaultStatus_VAULT_STATUS_STAND_BY,
+				},
+			},
+		},
+		"Success - Status and quoting params": {
+			msg: &types.MsgSetVaultParams{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob_1,
+				VaultParams: types.VaultParams{
+					Status: types.VaultStatus_VAULT_STATUS_QUOTING,
+					QuotingParams: &types.QuotingParams{
+						Layers:                           3,
+						SpreadMinPpm:                     4_000,
+						SpreadBufferPpm:                  2_000,
+						SkewFactorPpm:                    500_000,
+						OrderSizePctPpm:                  50_000,
+						OrderExpirationSeconds:           5,
+						ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+					},
+				},
+			},
+		},
+		"Failure - Invalid Authority": {
+			msg: &types.MsgSetVaultParams{
+				Authority: constants.AliceAccAddress.String(),
+				VaultId:   constants.Vault_Clob_0,
+				VaultParams: types.VaultParams{
+					Status: types.VaultStatus_VAULT_STATUS_STAND_BY,
+				},
+			},
+			expectedErr: "invalid authority",
+		},
+		"Failure - Unspecified Status": {
+			msg: &types.MsgSetVaultParams{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob_0,
+				VaultParams: types.VaultParams{
+					Status: types.VaultStatus_VAULT_STATUS_UNSPECIFIED,
+				},
+			},
+			expectedErr: types.ErrUnspecifiedVaultStatus.Error(),
+		},
+		"Failure - Invalid Quoting Params": {
+			msg: &types.MsgSetVaultParams{
+				Authority: lib.GovModuleAddress.String(),
+				VaultId:   constants.Vault_Clob_0,
+				VaultParams: types.VaultParams{
+					Status: types.VaultStatus_VAULT_STATUS_QUOTING,
+					QuotingParams: &types.QuotingParams{
+						Layers:                           3,
+						SpreadMinPpm:                     0, // invalid
+						SpreadBufferPpm:                  2_000,
+						SkewFactorPpm:                    500_000,
+						OrderSizePctPpm:                  50_000,
+						OrderExpirationSeconds:           5,
+						ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+					},
+				},
+			},
+			expectedErr: types.ErrInvalidSpreadMinPpm.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+			ms := keeper.NewMsgServerImpl(k)
+
+			_, err := ms.SetVaultParams(ctx, tc.msg)
+			vaultParams, exists := k.GetVaultParams(ctx, tc.msg.VaultId)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				require.False(t, exists)
+			} else {
+				require.NoError(t, err)
+				require.True(t, exists)
+				require.Equal(t, tc.msg.VaultParams, vaultParams)
+			}
+		})
+	}
+}
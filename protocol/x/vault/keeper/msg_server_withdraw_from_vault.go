@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	sendingtypes "github.com/dydxprotocol/v4-chain/protocol/x/sending/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+)
+
+// WithdrawFromVault redeems shares of a vault and transfers the redeemed
+// quote quantums from the vault to a subaccount.
+func (k msgServer) WithdrawFromVault(
+	goCtx context.Context,
+	msg *types.MsgWithdrawFromVault,
+) (*types.MsgWithdrawFromVaultResponse, error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	// Burn shares of the owner.
+	// Note: Shares should be burned before the transfer for the redeemed
+	// amount to be calculated against the current vault equity.
+	redeemedQuoteQuantums, err := k.BurnShares(
+		ctx,
+		*msg.VaultId,
+		msg.SubaccountId.Owner,
+		msg.Shares.NumShares.BigInt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancel resting orders of the vault as they are sized against the equity
+	// prior to the withdrawal. Orders are placed again in the EndBlocker.
+	switch msg.VaultId.Type {
+	case types.VaultType_VAULT_TYPE_CLOB:
+		k.CancelAllVaultClobOrders(ctx, *msg.VaultId)
+	default:
+		log.ErrorLog(ctx, "Failed to cancel vault orders: unknown vault type", "vaultId", *msg.VaultId)
+	}
+
+	// Transfer from vault to recipient subaccount.
+	err = k.sendingKeeper.ProcessTransfer(
+		ctx,
+		&sendingtypes.Transfer{
+			Sender:    *msg.VaultId.ToSubaccountId(),
+			Recipient: *msg.SubaccountId,
+			AssetId:   assettypes.AssetUsdc.Id,
+			Amount:    redeemedQuoteQuantums.Uint64(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit metric on vault equity.
+	equity, err := k.GetVaultEquity(ctx, *msg.VaultId)
+	if err != nil {
+		log.ErrorLogWithError(ctx, "Failed to get vault equity", err, "vaultId", *msg.VaultId)
+	} else {
+		msg.VaultId.SetGaugeWithLabels(
+			metrics.VaultEquity,
+			float32(equity.Int64()),
+		)
+	}
+
+	return &types.MsgWithdrawFromVaultResponse{
+		RedeemedQuoteQuantums: dtypes.NewIntFromBigInt(redeemedQuoteQuantums),
+	}, nil
+}
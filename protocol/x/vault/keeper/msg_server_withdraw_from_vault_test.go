@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	vaulttypes "github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgWithdrawFromVault(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Depositor, who also withdraws.
+		depositor satypes.SubaccountId
+		// Amount that depositor deposits (in quote quantums) at block 2.
+		depositAmount *big.Int
+		// Withdrawal fee in ppm.
+		withdrawalFeePpm uint32
+		// Withdrawal lockup in blocks.
+		withdrawalLockupBlocks uint32
+		// Block at which the withdrawal is delivered.
+		withdrawalBlock uint32
+		// Shares to withdraw.
+		sharesToWithdraw *big.Int
+		// Signer of the withdrawal message.
+		msgSigner string
+
+		/* --- Expectations --- */
+		// A string that CheckTx response should contain, if any.
+		checkTxResponseContains string
+		// Whether CheckTx fails.
+		checkTxFails bool
+		// Whether DeliverTx fails.
+		deliverTxFails bool
+		// Expected owner shares after the withdrawal.
+		expectedOwnerShares *big.Int
+		// Expected total shares after the withdrawal.
+		expectedTotalShares *big.Int
+		// Expected depositor USDC balance after the withdrawal.
+		expectedDepositorBalance *big.Int
+		// Expected vault equity after the withdrawal.
+		expectedVaultEquity *big.Int
+	}{
+		"Successful withdrawal of some shares": {
+			vaultId:                  constants.Vault_Clob_0,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000),
+			withdrawalBlock:          3,
+			sharesToWithdraw:         big.NewInt(400),
+			msgSigner:                constants.Alice_Num0.Owner,
+			expectedOwnerShares:      big.NewInt(600),
+			expectedTotalShares:      big.NewInt(600),
+			expectedDepositorBalance: big.NewInt(400),
+			expectedVaultEquity:      big.NewInt(600),
+		},
+		"Successful withdrawal of all shares with a withdrawal fee": {
+			vaultId:                  constants.Vault_Clob_1,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000),
+			withdrawalFeePpm:         50_000, // 5%
+			withdrawalBlock:          3,
+			sharesToWithdraw:         big.NewInt(1_000),
+			msgSigner:                constants.Alice_Num0.Owner,
+			expectedOwnerShares:      big.NewInt(0),
+			expectedTotalShares:      big.NewInt(0),
+			expectedDepositorBalance: big.NewInt(950),
+			expectedVaultEquity:      big.NewInt(50),
+		},
+		"Successful withdrawal of all shares cancels resting vault orders": {
+			vaultId:                  constants.Vault_Clob_0,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000_000_000), // Activates the vault.
+			withdrawalBlock:          3,
+			sharesToWithdraw:         big.NewInt(1_000_000_000),
+			msgSigner:                constants.Alice_Num0.Owner,
+			expectedOwnerShares:      big.NewInt(0),
+			expectedTotalShares:      big.NewInt(0),
+			expectedDepositorBalance: big.NewInt(1_000_000_000),
+			expectedVaultEquity:      big.NewInt(0),
+		},
+		"Successful withdrawal after the lockup elapses": {
+			vaultId:                  constants.Vault_Clob_0,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000),
+			withdrawalLockupBlocks:   3,
+			withdrawalBlock:          5,
+			sharesToWithdraw:         big.NewInt(1_000),
+			msgSigner:                constants.Alice_Num0.Owner,
+			expectedOwnerShares:      big.NewInt(0),
+			expectedTotalShares:      big.NewInt(0),
+			expectedDepositorBalance: big.NewInt(1_000),
+			expectedVaultEquity:      big.NewInt(0),
+		},
+		"Failed withdrawal during the lockup": {
+			vaultId:                  constants.Vault_Clob_0,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000),
+			withdrawalLockupBlocks:   3,
+			withdrawalBlock:          4,
+			sharesToWithdraw:         big.NewInt(1_000),
+			msgSigner:                constants.Alice_Num0.Owner,
+			deliverTxFails:           true,
+			expectedOwnerShares:      big.NewInt(1_000),
+			expectedTotalShares:      big.NewInt(1_000),
+			expectedDepositorBalance: big.NewInt(0),
+			expectedVaultEquity:      big.NewInt(1_000),
+		},
+		"Failed withdrawal due to insufficient shares": {
+			vaultId:                  constants.Vault_Clob_0,
+			depositor:                constants.Alice_Num0,
+			depositAmount:            big.NewInt(1_000),
+			withdrawalBlock:          3,
+			sharesToWithdraw:         big.NewInt(1_001),
+			msgSigner:                constants.Alice_Num0.Owner,
+			deliverTxFails:           true,
+			expectedOwnerShares:      big.NewInt(1_000),
+			expectedTotalShares:      big.NewInt(1_000),
+			expectedDepositorBalance: big.NewInt(0),
+			expectedVaultEquity:      big.NewInt(1_000),
+		},
+		"Failed withdrawal due to incorrect signer": {
+			vaultId:                 constants.Vault_Clob_0,
+			depositor:               constants.Alice_Num0,
+			depositAmount:           big.NewInt(1_000),
+			withdrawalBlock:         3,
+			sharesToWithdraw:        big.NewInt(1_000),
+			msgSigner:               constants.Bob_Num0.Owner, // Incorrect signer.
+			checkTxFails:            true,
+			checkTxResponseContains: "does not match signer address",
+		},
+		"Failed withdrawal due to non-positive shares": {
+			vaultId:                 constants.Vault_Clob_0,
+			depositor:               constants.Alice_Num0,
+			depositAmount:           big.NewInt(1_000),
+			withdrawalBlock:         3,
+			sharesToWithdraw:        big.NewInt(0),
+			msgSigner:               constants.Alice_Num0.Owner,
+			checkTxFails:            true,
+			checkTxResponseContains: "Withdrawal amount is invalid",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize tApp and ctx.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				// Initialize balance of depositor.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: &(tc.depositor),
+								AssetPositions: []*satypes.AssetPosition{
+									{
+										AssetId:  0,
+										Quantums: dtypes.NewIntFromBigInt(tc.depositAmount),
+									},
+								},
+							},
+						}
+					},
+				)
+				// Initialize withdrawal fee and lockup.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.WithdrawalFeePpm = tc.withdrawalFeePpm
+						genesisState.Params.WithdrawalLockupBlocks = tc.withdrawalLockupBlocks
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+
+			// Deposit to vault at block 2.
+			checkTxResp := tApp.CheckTx(testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: tc.depositor.Owner,
+					Gas:                  constants.TestGasLimit,
+					FeeAmt:               constants.TestFeeCoins_5Cents,
+				},
+				&vaulttypes.MsgDepositToVault{
+					VaultId:       &(tc.vaultId),
+					SubaccountId:  &(tc.depositor),
+					QuoteQuantums: dtypes.NewIntFromBigInt(tc.depositAmount),
+				},
+			))
+			require.Conditionf(t, checkTxResp.IsOK, "Expected CheckTx to succeed. Response: %+v", checkTxResp)
+			ctx = tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{})
+			if tc.withdrawalBlock > 3 {
+				ctx = tApp.AdvanceToBlock(tc.withdrawalBlock-1, testapp.AdvanceToBlockOptions{})
+			}
+
+			// Construct message.
+			msgWithdrawFromVault := vaulttypes.MsgWithdrawFromVault{
+				VaultId:      &(tc.vaultId),
+				SubaccountId: &(tc.depositor),
+				Shares:       &vaulttypes.NumShares{NumShares: dtypes.NewIntFromBigInt(tc.sharesToWithdraw)},
+			}
+
+			// Invoke CheckTx.
+			CheckTx_MsgWithdrawFromVault := testapp.MustMakeCheckTx(
+				ctx,
+				tApp.App,
+				testapp.MustMakeCheckTxOptions{
+					AccAddressForSigning: tc.msgSigner,
+					Gas:                  constants.TestGasLimit,
+					FeeAmt:               constants.TestFeeCoins_5Cents,
+				},
+				&msgWithdrawFromVault,
+			)
+			checkTxResp = tApp.CheckTx(CheckTx_MsgWithdrawFromVault)
+
+			// Check that CheckTx response log contains expected string, if any.
+			if tc.checkTxResponseContains != "" {
+				require.Contains(t, checkTxResp.Log, tc.checkTxResponseContains)
+			}
+			// Check that CheckTx succeeds or errors out as expected.
+			if tc.checkTxFails {
+				require.Conditionf(t, checkTxResp.IsErr, "Expected CheckTx to error. Response: %+v", checkTxResp)
+				return
+			}
+			require.Conditionf(t, checkTxResp.IsOK, "Expected CheckTx to succeed. Response: %+v", checkTxResp)
+
+			// Advance to withdrawal block and check that DeliverTx is as expected.
+			ctx = tApp.AdvanceToBlock(tc.withdrawalBlock, testapp.AdvanceToBlockOptions{
+				ValidateFinalizeBlock: func(
+					context sdktypes.Context,
+					request abcitypes.RequestFinalizeBlock,
+					response abcitypes.ResponseFinalizeBlock,
+				) (haltChain bool) {
+					for i, tx := range request.Txs {
+						if bytes.Equal(tx, CheckTx_MsgWithdrawFromVault.Tx) {
+							require.Equal(t, tc.deliverTxFails, response.TxResults[i].IsErr())
+						} else {
+							require.True(t, response.TxResults[i].IsOK())
+						}
+					}
+					return false
+				},
+			})
+
+			// Check that total shares of the vault is as expected.
+			totalShares, exists := tApp.App.VaultKeeper.GetTotalShares(ctx, tc.vaultId)
+			require.True(t, exists)
+			require.Equal(t, vaulttypes.BigIntToNumShares(tc.expectedTotalShares), totalShares)
+			// Check that owner shares of the depositor is as expected.
+			ownerShares, exists := tApp.App.VaultKeeper.GetOwnerShares(ctx, tc.vaultId, tc.depositor.Owner)
+			require.True(t, exists)
+			require.Equal(t, vaulttypes.BigIntToNumShares(tc.expectedOwnerShares), ownerShares)
+			// Check that balance of the depositor is as expected.
+			depositor := tApp.App.SubaccountsKeeper.GetSubaccount(ctx, tc.depositor)
+			require.Equal(t, tc.expectedDepositorBalance, depositor.GetUsdcPosition())
+			// Check that the vault has no resting orders.
+			require.Empty(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx))
+			// Check that equity of the vault is as expected.
+			vaultEquity, err := tApp.App.VaultKeeper.GetVaultEquity(ctx, tc.vaultId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedVaultEquity, vaultEquity)
+		})
+	}
+}
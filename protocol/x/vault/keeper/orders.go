@@ -16,9 +16,9 @@ import (
 // RefreshAllVaultOrders refreshes all orders for all vaults by
 // 1. Cancelling all existing orders.
 // 2. Placing new orders.
+// Vaults on stand-by only have their existing orders cancelled.
 func (k Keeper) RefreshAllVaultOrders(ctx sdk.Context) {
 	// Iterate through all vaults.
-	params := k.GetParams(ctx)
 	numActiveVaults := 0
 	totalSharesIterator := k.getTotalSharesIterator(ctx)
 	defer totalSharesIterator.Close()
@@ -36,10 +36,20 @@ func (k Keeper) RefreshAllVaultOrders(ctx sdk.Context) {
 			continue
 		}
 
+		// Cancel existing orders and skip if vault is on stand-by.
+		vaultParams := k.GetEffectiveVaultParams(ctx, *vaultId)
+		if vaultParams.Status == types.VaultStatus_VAULT_STATUS_STAND_BY {
+			if vaultId.Type == types.VaultType_VAULT_TYPE_CLOB {
+				k.CancelAllVaultClobOrders(ctx, *vaultId)
+			}
+			continue
+		}
+
 		// Skip if vault has no perpetual positions and strictly less than `activation_threshold_quote_quantums` USDC.
 		vault := k.subaccountsKeeper.GetSubaccount(ctx, *vaultId.ToSubaccountId())
 		if vault.PerpetualPositions == nil || len(vault.PerpetualPositions) == 0 {
-			if vault.GetUsdcPosition().Cmp(params.ActivationThresholdQuoteQuantums.BigInt()) == -1 {
+			activationThreshold := vaultParams.QuotingParams.ActivationThresholdQuoteQuantums.BigInt()
+			if vault.GetUsdcPosition().Cmp(activationThreshold) == -1 {
 				continue
 			}
 		}
@@ -70,30 +80,7 @@ func (k Keeper) RefreshAllVaultOrders(ctx sdk.Context) {
 // RefreshVaultClobOrders refreshes orders of a CLOB vault.
 func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (err error) {
 	// Cancel CLOB orders from last block.
-	ordersToCancel, err := k.GetVaultClobOrders(
-		ctx.WithBlockHeight(ctx.BlockHeight()-1),
-		vaultId,
-	)
-	if err != nil {
-		log.ErrorLogWithError(ctx, "Failed to get vault clob orders to cancel", err, "vaultId", vaultId)
-		return err
-	}
-	orderExpirationSeconds := k.GetParams(ctx).OrderExpirationSeconds
-	for _, order := range ordersToCancel {
-		if _, exists := k.clobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId); exists {
-			err := k.clobKeeper.HandleMsgCancelOrder(ctx, clobtypes.NewMsgCancelOrderStateful(
-				order.OrderId,
-				uint32(ctx.BlockTime().Unix())+orderExpirationSeconds,
-			))
-			if err != nil {
-				log.ErrorLogWithError(ctx, "Failed to cancel order", err, "order", order, "vaultId", vaultId)
-			}
-			vaultId.IncrCounterWithLabels(
-				metrics.VaultCancelOrder,
-				metrics.GetLabelForBoolValue(metrics.Success, err == nil),
-			)
-		}
-	}
+	k.CancelAllVaultClobOrders(ctx, vaultId)
 
 	// Place new CLOB orders.
 	ordersToPlace, err := k.GetVaultClobOrders(ctx, vaultId)
@@ -115,6 +102,30 @@ func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (
 	return nil
 }
 
+// CancelAllVaultClobOrders cancels all resting orders of a CLOB vault. The resting orders are read
+// from state rather than derived from the vault's quoting params, since the params (e.g. the number of
+// layers) may have changed since the orders were placed.
+func (k Keeper) CancelAllVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) {
+	params := k.GetEffectiveVaultParams(ctx, vaultId).QuotingParams
+	for _, order := range k.clobKeeper.GetAllStatefulOrdersForSubaccount(ctx, *vaultId.ToSubaccountId()) {
+		orderId := order.OrderId
+		if !orderId.IsLongTermOrder() || orderId.ClobPairId != vaultId.Number {
+			continue
+		}
+		err := k.clobKeeper.HandleMsgCancelOrder(ctx, clobtypes.NewMsgCancelOrderStateful(
+			orderId,
+			uint32(ctx.BlockTime().Unix())+params.OrderExpirationSeconds,
+		))
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Failed to cancel order", err, "orderId", orderId, "vaultId", vaultId)
+		}
+		vaultId.IncrCounterWithLabels(
+			metrics.VaultCancelOrder,
+			metrics.GetLabelForBoolValue(metrics.Success, err == nil),
+		)
+	}
+}
+
 // GetVaultClobOrders returns a list of long term orders for a given CLOB vault.
 // Let n be number of layers, then the function returns orders at [a_0, b_0, a_1, b_1, ..., a_{n-1}, b_{n-1}]
 // where a_i and b_i are the ask price and bid price at i-th layer. To compute a_i and b_i:
@@ -125,6 +136,8 @@ func (k Keeper) RefreshVaultClobOrders(ctx sdk.Context, vaultId types.VaultId) (
 // - leverage = open notional / equity
 // - spread = max(spread_min, spread_buffer + min_price_change)
 // and size of each order is calculated as `order_size * equity / oraclePrice`.
+// A close-only vault only gets the orders that reduce its position, i.e. asks if it is long
+// and bids if it is short, with their total size capped at the size of the position.
 func (k Keeper) GetVaultClobOrders(
 	ctx sdk.Context,
 	vaultId types.VaultId,
@@ -185,7 +198,8 @@ func (k Keeper) GetVaultClobOrders(
 		new(big.Rat).SetInt(equity),
 	)
 	// Get parameters.
-	params := k.GetParams(ctx)
+	vaultParams := k.GetEffectiveVaultParams(ctx, vaultId)
+	params := vaultParams.QuotingParams
 	// Calculate order size (in base quantums).
 	// order_size = order_size_pct * equity / oracle_price
 	// = order_size_pct * equity / (price * 10^exponent / 10^quote_atomic_resolution) / 10^base_atomic_resolution
@@ -327,6 +341,34 @@ func (k Keeper) GetVaultClobOrders(
 		spreadMultiplier = spreadMultiplier.Mul(spreadMultiplier, spreadBaseMultiplier)
 	}
 
+	if vaultParams.Status == types.VaultStatus_VAULT_STATUS_CLOSE_ONLY {
+		reducingSide := clobtypes.Order_SIDE_SELL
+		if inventory.Sign() < 0 {
+			reducingSide = clobtypes.Order_SIDE_BUY
+		}
+		// Keep reducing orders until their total size reaches the size of the position.
+		remainingQuantums := new(big.Int).Abs(inventory)
+		closeOnlyOrders := make([]*clobtypes.Order, 0, params.Layers)
+		for _, order := range orders {
+			if order.Side != reducingSide || remainingQuantums.Sign() == 0 {
+				continue
+			}
+			if remainingQuantums.Cmp(new(big.Int).SetUint64(order.Quantums)) < 0 {
+				order.Quantums = lib.BigRatRoundToNearestMultiple(
+					new(big.Rat).SetInt(remainingQuantums),
+					uint32(clobPair.StepBaseQuantums),
+					false,
+				)
+				if order.Quantums == 0 {
+					break
+				}
+			}
+			remainingQuantums.Sub(remainingQuantums, new(big.Int).SetUint64(order.Quantums))
+			closeOnlyOrders = append(closeOnlyOrders, order)
+		}
+		return closeOnlyOrders, nil
+	}
+
 	return orders, nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cometbft/cometbft/types"
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
@@ -28,6 +29,9 @@ func TestRefreshAllVaultOrders(t *testing.T) {
 		assetQuantums []*big.Int
 		// Activation threshold (quote quantums) of vaults.
 		activationThresholdQuoteQuantums *big.Int
+		// Status of each vault ID above, set after orders of last block are placed.
+		// Vault params are not set for unspecified statuses.
+		vaultStatuses []vaulttypes.VaultStatus
 	}{
 		"Two Vaults, Both Positive Shares, Both above Activation Threshold": {
 			vaultIds: []vaulttypes.VaultId{
@@ -104,6 +108,44 @@ func TestRefreshAllVaultOrders(t *testing.T) {
 			},
 			activationThresholdQuoteQuantums: big.NewInt(123_456_789),
 		},
+		"Two Vaults, Both Positive Shares, Both above Activation Threshold, One Stand-by": {
+			vaultIds: []vaulttypes.VaultId{
+				constants.Vault_Clob_0,
+				constants.Vault_Clob_1,
+			},
+			totalShares: []*big.Int{
+				big.NewInt(1_000),
+				big.NewInt(200),
+			},
+			assetQuantums: []*big.Int{
+				big.NewInt(1_000_000_000),
+				big.NewInt(1_000_000_000),
+			},
+			activationThresholdQuoteQuantums: big.NewInt(1_000_000_000),
+			vaultStatuses: []vaulttypes.VaultStatus{
+				vaulttypes.VaultStatus_VAULT_STATUS_QUOTING,
+				vaulttypes.VaultStatus_VAULT_STATUS_STAND_BY,
+			},
+		},
+		"Two Vaults, Both Positive Shares, Both above Activation Threshold, One Close-only": {
+			vaultIds: []vaulttypes.VaultId{
+				constants.Vault_Clob_0,
+				constants.Vault_Clob_1,
+			},
+			totalShares: []*big.Int{
+				big.NewInt(1_000),
+				big.NewInt(200),
+			},
+			assetQuantums: []*big.Int{
+				big.NewInt(1_000_000_000),
+				big.NewInt(1_000_000_000),
+			},
+			activationThresholdQuoteQuantums: big.NewInt(1_000_000_000),
+			vaultStatuses: []vaulttypes.VaultStatus{
+				vaulttypes.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+				vaulttypes.VaultStatus_VAULT_STATUS_UNSPECIFIED,
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -176,14 +218,29 @@ func TestRefreshAllVaultOrders(t *testing.T) {
 			}
 			require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), numPreviousOrders)
 
+			// Set status of each vault ID.
+			for i, status := range tc.vaultStatuses {
+				if status == vaulttypes.VaultStatus_VAULT_STATUS_UNSPECIFIED {
+					continue
+				}
+				err := tApp.App.VaultKeeper.SetVaultParams(ctx, tc.vaultIds[i], vaulttypes.VaultParams{
+					Status: status,
+				})
+				require.NoError(t, err)
+			}
+
 			// Refresh all vault orders.
 			tApp.App.VaultKeeper.RefreshAllVaultOrders(ctx)
 
 			// Check orders are as expected, i.e. orders from last block have been
-			// cancelled and orders from this block have been placed.
+			// cancelled and orders from this block have been placed, unless the
+			// vault is on stand-by.
 			numExpectedOrders := 0
 			allExpectedOrderIds := make(map[clobtypes.OrderId]bool)
 			for i, vaultId := range tc.vaultIds {
+				if len(tc.vaultStatuses) > 0 && tc.vaultStatuses[i] == vaulttypes.VaultStatus_VAULT_STATUS_STAND_BY {
+					continue
+				}
 				if tc.totalShares[i].Sign() > 0 && tc.assetQuantums[i].Cmp(tc.activationThresholdQuoteQuantums) >= 0 {
 					expectedOrders, err := tApp.App.VaultKeeper.GetVaultClobOrders(ctx, vaultId)
 					require.NoError(t, err)
@@ -279,6 +336,64 @@ func TestRefreshVaultClobOrders(t *testing.T) {
 	}
 }
 
+func TestRefreshVaultClobOrders_FewerLayers(t *testing.T) {
+	vaultId := constants.Vault_Clob_0
+
+	// Initialize tApp and ctx (in deliverTx mode).
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		// Initialize vault with quote quantums to be able to place orders.
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: vaultId.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							{
+								AssetId:  assettypes.AssetUsdc.Id,
+								Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain().WithIsCheckTx(false)
+
+	// Place orders with the default number of layers.
+	require.NoError(t, tApp.App.VaultKeeper.RefreshVaultClobOrders(ctx, vaultId))
+	params := tApp.App.VaultKeeper.GetParams(ctx)
+	require.Greater(t, params.Layers, uint32(1))
+	require.Len(t, tApp.App.ClobKeeper.GetAllStatefulOrders(ctx), int(params.Layers*2))
+
+	// Lower the number of layers of the vault and refresh orders in the next block.
+	quotingParams := params.QuotingParams()
+	quotingParams.Layers = 1
+	require.NoError(t, tApp.App.VaultKeeper.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+		Status:        vaulttypes.VaultStatus_VAULT_STATUS_QUOTING,
+		QuotingParams: &quotingParams,
+	}))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	// Initialize the process proposer matches events of the next block, as is done in BeginBlocker.
+	tApp.App.ClobKeeper.MustSetProcessProposerMatchesEvents(
+		ctx,
+		clobtypes.ProcessProposerMatchesEvents{BlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight())},
+	)
+	require.NoError(t, tApp.App.VaultKeeper.RefreshVaultClobOrders(ctx, vaultId))
+
+	// Check that orders at all previous layers are cancelled.
+	allStatefulOrders := tApp.App.ClobKeeper.GetAllStatefulOrders(ctx)
+	expectedOrders, err := tApp.App.VaultKeeper.GetVaultClobOrders(ctx, vaultId)
+	require.NoError(t, err)
+	require.Len(t, allStatefulOrders, 2)
+	for i, order := range allStatefulOrders {
+		require.Equal(t, *expectedOrders[i], order)
+	}
+}
+
 func TestGetVaultClobOrders(t *testing.T) {
 	tests := map[string]struct {
 		/* --- Setup --- */
@@ -700,6 +815,188 @@ func TestGetVaultClobOrders(t *testing.T) {
 	}
 }
 
+func TestGetVaultClobOrders_CloseOnly(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault asset.
+		vaultAssetQuoteQuantums *big.Int
+		// Vault inventory.
+		vaultInventoryBaseQuantums *big.Int
+
+		/* --- Expectations --- */
+		// Indices of expected orders in the orders that the vault quotes with, i.e.
+		// [a_0, b_0, a_1, b_1].
+		expectedOrderIndices []int
+		// Quantums of expected orders.
+		expectedOrderQuantums []uint64
+	}{
+		"Long position, only asks up to position size": {
+			vaultAssetQuoteQuantums:    big.NewInt(850_000_000), // 850 USDC
+			vaultInventoryBaseQuantums: big.NewInt(30_000_000),  // 0.003 BTC
+			// order_size = 10% * 1_000 / 50_000 = 0.002 BTC = 20_000_000 base quantums
+			expectedOrderIndices:  []int{0, 2},
+			expectedOrderQuantums: []uint64{20_000_000, 10_000_000},
+		},
+		"Short position, only bids up to position size": {
+			vaultAssetQuoteQuantums:    big.NewInt(1_150_000_000), // 1,150 USDC
+			vaultInventoryBaseQuantums: big.NewInt(-30_000_000),   // -0.003 BTC
+			expectedOrderIndices:       []int{1, 3},
+			expectedOrderQuantums:      []uint64{20_000_000, 10_000_000},
+		},
+		"Long position larger than all asks": {
+			vaultAssetQuoteQuantums:    big.NewInt(750_000_000), // 750 USDC
+			vaultInventoryBaseQuantums: big.NewInt(50_000_000),  // 0.005 BTC
+			expectedOrderIndices:       []int{0, 2},
+			expectedOrderQuantums:      []uint64{20_000_000, 20_000_000},
+		},
+		"Long position smaller than step base quantums": {
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(3),
+			expectedOrderIndices:       []int{},
+			expectedOrderQuantums:      []uint64{},
+		},
+		"No position": {
+			vaultAssetQuoteQuantums:    big.NewInt(1_000_000_000), // 1,000 USDC
+			vaultInventoryBaseQuantums: big.NewInt(0),
+			expectedOrderIndices:       []int{},
+			expectedOrderQuantums:      []uint64{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			vaultId := constants.Vault_Clob_0
+			// Initialize tApp and ctx.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				// Initialize prices module with test market param and market price.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *pricestypes.GenesisState) {
+						genesisState.MarketParams = []pricestypes.MarketParam{constants.TestMarketParams[0]}
+						genesisState.MarketPrices = []pricestypes.MarketPrice{constants.TestMarketPrices[0]}
+					},
+				)
+				// Initialize perpetuals module with test perpetual.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *perptypes.GenesisState) {
+						genesisState.LiquidityTiers = constants.LiquidityTiers
+						genesisState.Perpetuals = []perptypes.Perpetual{
+							constants.BtcUsd_0DefaultFunding_10AtomicResolution,
+						}
+					},
+				)
+				// Initialize clob module with test clob pair.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *clobtypes.GenesisState) {
+						genesisState.ClobPairs = []clobtypes.ClobPair{constants.ClobPair_Btc}
+					},
+				)
+				// Initialize subaccounts module with vault's equity and inventory.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						perpPositions := []*satypes.PerpetualPosition{}
+						if tc.vaultInventoryBaseQuantums.Sign() != 0 {
+							perpPositions = append(
+								perpPositions,
+								&satypes.PerpetualPosition{
+									PerpetualId: constants.BtcUsd_0DefaultFunding_10AtomicResolution.Params.Id,
+									Quantums:    dtypes.NewIntFromBigInt(tc.vaultInventoryBaseQuantums),
+								},
+							)
+						}
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									{
+										AssetId:  assettypes.AssetUsdc.Id,
+										Quantums: dtypes.NewIntFromBigInt(tc.vaultAssetQuoteQuantums),
+									},
+								},
+								PerpetualPositions: perpPositions,
+							},
+						}
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.VaultKeeper
+
+			// Get orders that the vault quotes with.
+			quotingOrders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+			require.Len(t, quotingOrders, 4)
+
+			// Set vault to close-only and get orders.
+			err = k.SetVaultParams(ctx, vaultId, vaulttypes.VaultParams{
+				Status: vaulttypes.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+			})
+			require.NoError(t, err)
+			orders, err := k.GetVaultClobOrders(ctx, vaultId)
+			require.NoError(t, err)
+
+			// Compare expected orders with actual orders.
+			expectedOrders := make([]*clobtypes.Order, len(tc.expectedOrderIndices))
+			for i, index := range tc.expectedOrderIndices {
+				expectedOrders[i] = quotingOrders[index]
+				expectedOrders[i].Quantums = tc.expectedOrderQuantums[i]
+			}
+			require.Equal(t, expectedOrders, orders)
+		})
+	}
+}
+
+func TestGetVaultClobOrders_VaultQuotingParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *satypes.GenesisState) {
+				genesisState.Subaccounts = []satypes.Subaccount{
+					{
+						Id: constants.Vault_Clob_0.ToSubaccountId(),
+						AssetPositions: []*satypes.AssetPosition{
+							{
+								AssetId:  assettypes.AssetUsdc.Id,
+								Quantums: dtypes.NewInt(1_000_000_000), // 1,000 USDC
+							},
+						},
+					},
+				}
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Vault quotes with module quoting params by default.
+	orders, err := k.GetVaultClobOrders(ctx, constants.Vault_Clob_0)
+	require.NoError(t, err)
+	require.Len(t, orders, int(2*k.GetParams(ctx).Layers))
+
+	// Vault quotes with its own quoting params if set.
+	quotingParams := k.GetParams(ctx).QuotingParams()
+	quotingParams.Layers = 4
+	quotingParams.OrderExpirationSeconds = 7
+	err = k.SetVaultParams(ctx, constants.Vault_Clob_0, vaulttypes.VaultParams{
+		Status:        vaulttypes.VaultStatus_VAULT_STATUS_QUOTING,
+		QuotingParams: &quotingParams,
+	})
+	require.NoError(t, err)
+	orders, err = k.GetVaultClobOrders(ctx, constants.Vault_Clob_0)
+	require.NoError(t, err)
+	require.Len(t, orders, 8)
+	for _, order := range orders {
+		require.Equal(t, uint32(ctx.BlockTime().Unix())+7, order.GetGoodTilBlockTime())
+	}
+}
+
 func TestGetVaultClobOrderClientId(t *testing.T) {
 	tests := map[string]struct {
 		/* --- Setup --- */
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
@@ -33,3 +34,60 @@ func (k Keeper) SetParams(
 
 	return nil
 }
+
+// GetVaultParams returns `VaultParams` of a vault in state.
+func (k Keeper) GetVaultParams(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) (
+	vaultParams types.VaultParams,
+	exists bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.VaultParamsKeyPrefix))
+	b := store.Get(vaultId.ToStateKey())
+	if b == nil {
+		return vaultParams, false
+	}
+
+	k.cdc.MustUnmarshal(b, &vaultParams)
+	return vaultParams, true
+}
+
+// SetVaultParams sets `VaultParams` of a vault in state.
+// Returns an error iff validation fails.
+func (k Keeper) SetVaultParams(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	vaultParams types.VaultParams,
+) error {
+	if vaultId.Type == types.VaultType_VAULT_TYPE_UNSPECIFIED {
+		return types.ErrInvalidVaultType
+	}
+	if err := vaultParams.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.VaultParamsKeyPrefix))
+	b := k.cdc.MustMarshal(&vaultParams)
+	store.Set(vaultId.ToStateKey(), b)
+
+	return nil
+}
+
+// GetEffectiveVaultParams returns the parameters that a vault operates with, i.e.
+// `VaultParams` of the vault with quoting parameters falling back to those in module
+// `Params`. A vault without `VaultParams` in state is quoting.
+func (k Keeper) GetEffectiveVaultParams(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) types.VaultParams {
+	vaultParams, exists := k.GetVaultParams(ctx, vaultId)
+	if !exists {
+		vaultParams.Status = types.VaultStatus_VAULT_STATUS_QUOTING
+	}
+	if vaultParams.QuotingParams == nil {
+		quotingParams := k.GetParams(ctx).QuotingParams()
+		vaultParams.QuotingParams = &quotingParams
+	}
+	return vaultParams
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 	"github.com/stretchr/testify/require"
 )
@@ -46,3 +47,113 @@ func TestGetSetParams(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, newParams, k.GetParams(ctx))
 }
+
+func TestGetSetVaultParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	// Vault params should not exist at genesis.
+	_, exists := k.GetVaultParams(ctx, constants.Vault_Clob_0)
+	require.False(t, exists)
+
+	// Set vault params of vault clob 0 and get.
+	vaultParams := types.VaultParams{
+		Status: types.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+		QuotingParams: &types.QuotingParams{
+			Layers:                           3,
+			SpreadMinPpm:                     4_000,
+			SpreadBufferPpm:                  2_000,
+			SkewFactorPpm:                    999_999,
+			OrderSizePctPpm:                  200_000,
+			OrderExpirationSeconds:           10,
+			ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+		},
+	}
+	err := k.SetVaultParams(ctx, constants.Vault_Clob_0, vaultParams)
+	require.NoError(t, err)
+	got, exists := k.GetVaultParams(ctx, constants.Vault_Clob_0)
+	require.True(t, exists)
+	require.Equal(t, vaultParams, got)
+
+	// Vault params of vault clob 1 should not exist.
+	_, exists = k.GetVaultParams(ctx, constants.Vault_Clob_1)
+	require.False(t, exists)
+
+	// Set invalid vault params and get.
+	invalidVaultParams := types.VaultParams{
+		Status: types.VaultStatus_VAULT_STATUS_UNSPECIFIED, // invalid
+	}
+	err = k.SetVaultParams(ctx, constants.Vault_Clob_0, invalidVaultParams)
+	require.ErrorIs(t, err, types.ErrUnspecifiedVaultStatus)
+	got, exists = k.GetVaultParams(ctx, constants.Vault_Clob_0)
+	require.True(t, exists)
+	require.Equal(t, vaultParams, got)
+
+	// Set vault params of a vault with unspecified type.
+	err = k.SetVaultParams(
+		ctx,
+		types.VaultId{
+			Type:   types.VaultType_VAULT_TYPE_UNSPECIFIED,
+			Number: 0,
+		},
+		vaultParams,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidVaultType)
+}
+
+func TestGetEffectiveVaultParams(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	defaultQuotingParams := types.DefaultParams().QuotingParams()
+	quotingParams := types.QuotingParams{
+		Layers:                           3,
+		SpreadMinPpm:                     4_000,
+		SpreadBufferPpm:                  2_000,
+		SkewFactorPpm:                    999_999,
+		OrderSizePctPpm:                  200_000,
+		OrderExpirationSeconds:           10,
+		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000),
+	}
+
+	// A vault without vault params is quoting with module quoting params.
+	require.Equal(
+		t,
+		types.VaultParams{
+			Status:        types.VaultStatus_VAULT_STATUS_QUOTING,
+			QuotingParams: &defaultQuotingParams,
+		},
+		k.GetEffectiveVaultParams(ctx, constants.Vault_Clob_0),
+	)
+
+	// A vault without quoting params falls back to module quoting params.
+	err := k.SetVaultParams(ctx, constants.Vault_Clob_0, types.VaultParams{
+		Status: types.VaultStatus_VAULT_STATUS_STAND_BY,
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		types.VaultParams{
+			Status:        types.VaultStatus_VAULT_STATUS_STAND_BY,
+			QuotingParams: &defaultQuotingParams,
+		},
+		k.GetEffectiveVaultParams(ctx, constants.Vault_Clob_0),
+	)
+
+	// A vault with quoting params uses them.
+	err = k.SetVaultParams(ctx, constants.Vault_Clob_1, types.VaultParams{
+		Status:        types.VaultStatus_VAULT_STATUS_QUOTING,
+		QuotingParams: &quotingParams,
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		types.VaultParams{
+			Status:        types.VaultStatus_VAULT_STATUS_QUOTING,
+			QuotingParams: &quotingParams,
+		},
+		k.GetEffectiveVaultParams(ctx, constants.Vault_Clob_1),
+	)
+}
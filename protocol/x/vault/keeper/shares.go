@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/x/vault/types"
 )
@@ -101,6 +104,88 @@ func (k Keeper) getVaultOwnerSharesStore(
 	return prefix.NewStore(store, vaultId.ToStateKeyPrefix())
 }
 
+// GetOwnerLastDepositHeight gets the block height of the most recent deposit of an owner into a vault.
+func (k Keeper) GetOwnerLastDepositHeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) (height uint32, exists bool) {
+	store := k.getVaultOwnerLastDepositHeightStore(ctx, vaultId)
+
+	b := store.Get([]byte(owner))
+	if b == nil {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(b), true
+}
+
+// SetOwnerLastDepositHeight sets the block height of the most recent deposit of an owner into a vault.
+func (k Keeper) SetOwnerLastDepositHeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	height uint32,
+) {
+	store := k.getVaultOwnerLastDepositHeightStore(ctx, vaultId)
+	store.Set([]byte(owner), lib.Uint32ToKey(height))
+}
+
+// getVaultOwnerLastDepositHeightStore returns the store for owner last deposit heights of a given vault.
+func (k Keeper) getVaultOwnerLastDepositHeightStore(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OwnerLastDepositHeightKeyPrefix))
+	return prefix.NewStore(store, vaultId.ToStateKeyPrefix())
+}
+
+// GetOwnerUnlockBlockHeight returns the first block height at which `owner` can withdraw from a vault,
+// which is `withdrawal_lockup_blocks` after the owner's most recent deposit into the vault.
+func (k Keeper) GetOwnerUnlockBlockHeight(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+) uint32 {
+	lastDepositHeight, exists := k.GetOwnerLastDepositHeight(ctx, vaultId, owner)
+	if !exists {
+		return 0
+	}
+	return lastDepositHeight + k.GetParams(ctx).WithdrawalLockupBlocks
+}
+
+// GetRedeemableQuoteQuantums returns the number of quote quantums that `shares` of a vault
+// can be redeemed for, net of the withdrawal fee. This is calculated as
+// `vault equity * shares / total shares * (1 - withdrawal fee)`, rounded down.
+func (k Keeper) GetRedeemableQuoteQuantums(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	shares *big.Int,
+) (*big.Int, error) {
+	totalShares, exists := k.GetTotalShares(ctx, vaultId)
+	bigTotalShares := totalShares.NumShares.BigInt()
+	if !exists || bigTotalShares.Sign() <= 0 {
+		return nil, types.ErrZeroDenominator
+	}
+
+	equity, err := k.GetVaultEquity(ctx, vaultId)
+	if err != nil {
+		return nil, err
+	}
+	if equity.Sign() <= 0 {
+		return nil, types.ErrNonPositiveEquity
+	}
+
+	// For example:
+	// - a vault currently has 5000 shares and 4000 equity (in quote quantums)
+	// - each share is worth 4000 / 5000 = 0.8 quote quantums
+	// - a redemption of 1250 shares is thus worth 1250 * 0.8 = 1000 quote quantums
+	// - with a 1% withdrawal fee, 1000 * 0.99 = 990 quote quantums are redeemed
+	redeemable := new(big.Int).Mul(equity, shares)
+	redeemable.Quo(redeemable, bigTotalShares)
+	return lib.BigIntMulPpm(redeemable, lib.OneMillion-k.GetParams(ctx).WithdrawalFeePpm), nil
+}
+
 // MintShares mints shares of a vault for `owner` based on `quantumsToDeposit` by:
 // 1. Increasing total shares of the vault.
 // 2. Increasing owner shares of the vault for given `owner`.
@@ -191,5 +276,87 @@ func (k Keeper) MintShares(
 		}
 	}
 
+	// Record the deposit height of the owner for the withdrawal lockup.
+	k.SetOwnerLastDepositHeight(ctx, vaultId, owner, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+
 	return nil
 }
+
+// BurnShares burns `sharesToBurn` shares of a vault owned by `owner` and returns the number of
+// quote quantums that the shares are redeemed for, by:
+// 1. Decreasing total shares of the vault.
+// 2. Decreasing owner shares of the vault for given `owner`.
+// Note that this function does not transfer the redeemed quote quantums.
+func (k Keeper) BurnShares(
+	ctx sdk.Context,
+	vaultId types.VaultId,
+	owner string,
+	sharesToBurn *big.Int,
+) (redeemedQuoteQuantums *big.Int, err error) {
+	// Shares to burn should be positive.
+	if sharesToBurn.Sign() <= 0 {
+		return nil, types.ErrInvalidWithdrawalAmount
+	}
+
+	// Owner must own enough shares.
+	ownerShares, exists := k.GetOwnerShares(ctx, vaultId, owner)
+	existingOwnerShares := ownerShares.NumShares.BigInt()
+	if !exists || existingOwnerShares.Cmp(sharesToBurn) < 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientShares,
+			"owner %s has %v shares, cannot burn %v shares",
+			owner,
+			existingOwnerShares,
+			sharesToBurn,
+		)
+	}
+
+	// Owner must not be within the withdrawal lockup.
+	unlockBlockHeight := k.GetOwnerUnlockBlockHeight(ctx, vaultId, owner)
+	if ctx.BlockHeight() < int64(unlockBlockHeight) {
+		return nil, errorsmod.Wrapf(
+			types.ErrWithdrawalLocked,
+			"owner %s cannot withdraw until block %d",
+			owner,
+			unlockBlockHeight,
+		)
+	}
+
+	// Calculate quote quantums to redeem.
+	redeemedQuoteQuantums, err = k.GetRedeemableQuoteQuantums(ctx, vaultId, sharesToBurn)
+	if err != nil {
+		return nil, err
+	}
+	if redeemedQuoteQuantums.Sign() == 0 {
+		return nil, types.ErrZeroQuantumsToRedeem
+	}
+
+	// Decrease TotalShares of the vault.
+	totalShares, _ := k.GetTotalShares(ctx, vaultId)
+	existingTotalShares := totalShares.NumShares.BigInt()
+	err = k.SetTotalShares(
+		ctx,
+		vaultId,
+		types.BigIntToNumShares(
+			existingTotalShares.Sub(existingTotalShares, sharesToBurn),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decrease owner shares in the vault.
+	err = k.SetOwnerShares(
+		ctx,
+		vaultId,
+		owner,
+		types.BigIntToNumShares(
+			existingOwnerShares.Sub(existingOwnerShares, sharesToBurn),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return redeemedQuoteQuantums, nil
+}
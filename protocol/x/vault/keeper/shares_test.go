@@ -355,6 +355,276 @@ func TestMintShares(t *testing.T) {
 					vaulttypes.BigIntToNumShares(tc.expectedOwnerShares),
 					ownerShares,
 				)
+				// Check that owner last deposit height is the current block height.
+				lastDepositHeight, exists := tApp.App.VaultKeeper.GetOwnerLastDepositHeight(
+					ctx,
+					tc.vaultId,
+					tc.owner,
+				)
+				require.True(t, exists)
+				require.Equal(t, uint32(ctx.BlockHeight()), lastDepositHeight)
+			}
+		})
+	}
+}
+
+func TestGetSetOwnerLastDepositHeight(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.VaultKeeper
+
+	alice := constants.AliceAccAddress.String()
+	bob := constants.BobAccAddress.String()
+
+	// Get last deposit height for Alice in vault clob 0.
+	_, exists := k.GetOwnerLastDepositHeight(ctx, constants.Vault_Clob_0, alice)
+	require.False(t, exists)
+	require.Equal(t, uint32(0), k.GetOwnerUnlockBlockHeight(ctx, constants.Vault_Clob_0, alice))
+
+	// Set last deposit height for Alice in vault clob 0 and get.
+	k.SetOwnerLastDepositHeight(ctx, constants.Vault_Clob_0, alice, 7)
+	got, exists := k.GetOwnerLastDepositHeight(ctx, constants.Vault_Clob_0, alice)
+	require.True(t, exists)
+	require.Equal(t, uint32(7), got)
+
+	// Last deposit height of Bob in vault clob 0 and of Alice in vault clob 1 should not exist.
+	_, exists = k.GetOwnerLastDepositHeight(ctx, constants.Vault_Clob_0, bob)
+	require.False(t, exists)
+	_, exists = k.GetOwnerLastDepositHeight(ctx, constants.Vault_Clob_1, alice)
+	require.False(t, exists)
+
+	// Unlock block height should be last deposit height plus withdrawal lockup blocks.
+	require.Equal(t, uint32(7), k.GetOwnerUnlockBlockHeight(ctx, constants.Vault_Clob_0, alice))
+	params := k.GetParams(ctx)
+	params.WithdrawalLockupBlocks = 20
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, uint32(27), k.GetOwnerUnlockBlockHeight(ctx, constants.Vault_Clob_0, alice))
+}
+
+func TestBurnShares(t *testing.T) {
+	tests := map[string]struct {
+		/* --- Setup --- */
+		// Vault ID.
+		vaultId vaulttypes.VaultId
+		// Existing vault equity.
+		equity *big.Int
+		// Existing vault TotalShares.
+		totalShares *big.Int
+		// Owner that withdraws.
+		owner string
+		// Existing owner shares.
+		ownerShares *big.Int
+		// Block height of the owner's last deposit.
+		lastDepositHeight uint32
+		// Withdrawal fee in ppm.
+		withdrawalFeePpm uint32
+		// Withdrawal lockup in blocks.
+		withdrawalLockupBlocks uint32
+		// Block height of the withdrawal.
+		blockHeight int64
+		// Shares to burn.
+		sharesToBurn *big.Int
+
+		/* --- Expectations --- */
+		// Expected redeemed quote quantums.
+		expectedRedeemedQuoteQuantums *big.Int
+		// Expected TotalShares after burning.
+		expectedTotalShares *big.Int
+		// Expected OwnerShares after burning.
+		expectedOwnerShares *big.Int
+		// Expected error.
+		expectedErr error
+	}{
+		"Equity 4000, TotalShares 5000, OwnerShares 2500, Burn 1250": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(4_000),
+			totalShares:  big.NewInt(5_000),
+			owner:        constants.AliceAccAddress.String(),
+			ownerShares:  big.NewInt(2_500),
+			sharesToBurn: big.NewInt(1_250),
+			// Should redeem `4_000 * 1_250 / 5_000 = 1_000` quote quantums.
+			expectedRedeemedQuoteQuantums: big.NewInt(1_000),
+			expectedTotalShares:           big.NewInt(3_750),
+			expectedOwnerShares:           big.NewInt(1_250),
+		},
+		"Equity 4000, TotalShares 5000, OwnerShares 2500, Burn 1250, Fee 1%": {
+			vaultId:          constants.Vault_Clob_0,
+			equity:           big.NewInt(4_000),
+			totalShares:      big.NewInt(5_000),
+			owner:            constants.AliceAccAddress.String(),
+			ownerShares:      big.NewInt(2_500),
+			withdrawalFeePpm: 10_000,
+			sharesToBurn:     big.NewInt(1_250),
+			// Should redeem `1_000 * 0.99 = 990` quote quantums.
+			expectedRedeemedQuoteQuantums: big.NewInt(990),
+			expectedTotalShares:           big.NewInt(3_750),
+			expectedOwnerShares:           big.NewInt(1_250),
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares 1000, Burn 1000": {
+			vaultId:      constants.Vault_Clob_1,
+			equity:       big.NewInt(1_000),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.BobAccAddress.String(),
+			ownerShares:  big.NewInt(1_000),
+			sharesToBurn: big.NewInt(1_000),
+			// Should redeem all equity.
+			expectedRedeemedQuoteQuantums: big.NewInt(1_000),
+			expectedTotalShares:           big.NewInt(0),
+			expectedOwnerShares:           big.NewInt(0),
+		},
+		"Equity 8000, TotalShares 3000, OwnerShares 100, Burn 1": {
+			vaultId:      constants.Vault_Clob_1,
+			equity:       big.NewInt(8_000),
+			totalShares:  big.NewInt(3_000),
+			owner:        constants.CarlAccAddress.String(),
+			ownerShares:  big.NewInt(100),
+			sharesToBurn: big.NewInt(1),
+			// Should redeem `2.67` quote quantums, round down to 2.
+			expectedRedeemedQuoteQuantums: big.NewInt(2),
+			expectedTotalShares:           big.NewInt(2_999),
+			expectedOwnerShares:           big.NewInt(99),
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares 500, Burn 500, Lockup elapsed": {
+			vaultId:                constants.Vault_Clob_0,
+			equity:                 big.NewInt(1_000),
+			totalShares:            big.NewInt(1_000),
+			owner:                  constants.AliceAccAddress.String(),
+			ownerShares:            big.NewInt(500),
+			lastDepositHeight:      5,
+			withdrawalLockupBlocks: 10,
+			blockHeight:            15,
+			sharesToBurn:           big.NewInt(500),
+			// Should redeem `500` quote quantums.
+			expectedRedeemedQuoteQuantums: big.NewInt(500),
+			expectedTotalShares:           big.NewInt(500),
+			expectedOwnerShares:           big.NewInt(0),
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares 500, Burn 500, Lockup not elapsed": {
+			vaultId:                constants.Vault_Clob_0,
+			equity:                 big.NewInt(1_000),
+			totalShares:            big.NewInt(1_000),
+			owner:                  constants.AliceAccAddress.String(),
+			ownerShares:            big.NewInt(500),
+			lastDepositHeight:      5,
+			withdrawalLockupBlocks: 10,
+			blockHeight:            14,
+			sharesToBurn:           big.NewInt(500),
+			expectedErr:            vaulttypes.ErrWithdrawalLocked,
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares 500, Burn 501": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(1_000),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.AliceAccAddress.String(),
+			ownerShares:  big.NewInt(500),
+			sharesToBurn: big.NewInt(501),
+			expectedErr:  vaulttypes.ErrInsufficientShares,
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares non-existent, Burn 1": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(1_000),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.AliceAccAddress.String(),
+			sharesToBurn: big.NewInt(1),
+			expectedErr:  vaulttypes.ErrInsufficientShares,
+		},
+		"Equity 1000, TotalShares 1000, OwnerShares 500, Burn 0": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(1_000),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.AliceAccAddress.String(),
+			ownerShares:  big.NewInt(500),
+			sharesToBurn: big.NewInt(0),
+			expectedErr:  vaulttypes.ErrInvalidWithdrawalAmount,
+		},
+		"Equity 1, TotalShares 1000, OwnerShares 500, Burn 1": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(1),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.AliceAccAddress.String(),
+			ownerShares:  big.NewInt(500),
+			sharesToBurn: big.NewInt(1),
+			expectedErr:  vaulttypes.ErrZeroQuantumsToRedeem,
+		},
+		"Equity -1, TotalShares 1000, OwnerShares 500, Burn 1": {
+			vaultId:      constants.Vault_Clob_0,
+			equity:       big.NewInt(-1),
+			totalShares:  big.NewInt(1_000),
+			owner:        constants.AliceAccAddress.String(),
+			ownerShares:  big.NewInt(500),
+			sharesToBurn: big.NewInt(1),
+			expectedErr:  vaulttypes.ErrNonPositiveEquity,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Initialize tApp and ctx.
+			tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis types.GenesisDoc) {
+				genesis = testapp.DefaultGenesis()
+				// Initialize vault with its existing equity.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *satypes.GenesisState) {
+						genesisState.Subaccounts = []satypes.Subaccount{
+							{
+								Id: tc.vaultId.ToSubaccountId(),
+								AssetPositions: []*satypes.AssetPosition{
+									{
+										AssetId:  0,
+										Quantums: dtypes.NewIntFromBigInt(tc.equity),
+									},
+								},
+							},
+						}
+					},
+				)
+				// Initialize withdrawal fee and lockup.
+				testapp.UpdateGenesisDocWithAppStateForModule(
+					&genesis,
+					func(genesisState *vaulttypes.GenesisState) {
+						genesisState.Params.WithdrawalFeePpm = tc.withdrawalFeePpm
+						genesisState.Params.WithdrawalLockupBlocks = tc.withdrawalLockupBlocks
+					},
+				)
+				return genesis
+			}).Build()
+			ctx := tApp.InitChain().WithBlockHeight(tc.blockHeight)
+			k := tApp.App.VaultKeeper
+
+			// Set vault's existing total shares.
+			err := k.SetTotalShares(ctx, tc.vaultId, vaulttypes.BigIntToNumShares(tc.totalShares))
+			require.NoError(t, err)
+			// Set vault's existing owner shares and last deposit height if specified.
+			if tc.ownerShares != nil {
+				err := k.SetOwnerShares(ctx, tc.vaultId, tc.owner, vaulttypes.BigIntToNumShares(tc.ownerShares))
+				require.NoError(t, err)
+				k.SetOwnerLastDepositHeight(ctx, tc.vaultId, tc.owner, tc.lastDepositHeight)
+			}
+
+			// Burn shares.
+			redeemedQuoteQuantums, err := k.BurnShares(ctx, tc.vaultId, tc.owner, tc.sharesToBurn)
+			if tc.expectedErr != nil {
+				// Check that error is as expected.
+				require.ErrorIs(t, err, tc.expectedErr)
+				// Check that TotalShares is unchanged.
+				totalShares, _ := k.GetTotalShares(ctx, tc.vaultId)
+				require.Equal(t, vaulttypes.BigIntToNumShares(tc.totalShares), totalShares)
+				// Check that OwnerShares is unchanged.
+				ownerShares, _ := k.GetOwnerShares(ctx, tc.vaultId, tc.owner)
+				require.Equal(t, vaulttypes.BigIntToNumShares(tc.ownerShares), ownerShares)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedRedeemedQuoteQuantums, redeemedQuoteQuantums)
+				// Check that TotalShares is as expected.
+				totalShares, exists := k.GetTotalShares(ctx, tc.vaultId)
+				require.True(t, exists)
+				require.Equal(t, vaulttypes.BigIntToNumShares(tc.expectedTotalShares), totalShares)
+				// Check that OwnerShares is as expected.
+				ownerShares, exists := k.GetOwnerShares(ctx, tc.vaultId, tc.owner)
+				require.True(t, exists)
+				require.Equal(t, vaulttypes.BigIntToNumShares(tc.expectedOwnerShares), ownerShares)
 			}
 		})
 	}
@@ -82,7 +82,8 @@ func (k Keeper) DecommissionNonPositiveEquityVaults(
 	}
 }
 
-// DecommissionVault decommissions a vault by deleting its total shares and owner shares.
+// DecommissionVault decommissions a vault by deleting its total shares, owner shares, and
+// owner last deposit heights.
 func (k Keeper) DecommissionVault(
 	ctx sdk.Context,
 	vaultId types.VaultId,
@@ -98,4 +99,12 @@ func (k Keeper) DecommissionVault(
 	for ; ownerSharesIterator.Valid(); ownerSharesIterator.Next() {
 		ownerSharesStore.Delete(ownerSharesIterator.Key())
 	}
+
+	// Delete all OwnerLastDepositHeights of the vault.
+	lastDepositHeightStore := k.getVaultOwnerLastDepositHeightStore(ctx, vaultId)
+	lastDepositHeightIterator := storetypes.KVStorePrefixIterator(lastDepositHeightStore, []byte{})
+	defer lastDepositHeightIterator.Close()
+	for ; lastDepositHeightIterator.Valid(); lastDepositHeightIterator.Next() {
+		lastDepositHeightStore.Delete(lastDepositHeightIterator.Key())
+	}
 }
@@ -206,17 +206,20 @@ func TestDecommissionVault(t *testing.T) {
 					shares,
 				)
 				require.NoError(t, err)
+				k.SetOwnerLastDepositHeight(ctx, tc.vaultId, owner, 1)
 			}
 
 			// Decommission vault.
 			k.DecommissionVault(ctx, tc.vaultId)
 
-			// Check that total shares and owner shares are deleted.
+			// Check that total shares, owner shares, and owner last deposit heights are deleted.
 			_, exists := k.GetTotalShares(ctx, tc.vaultId)
 			require.Equal(t, false, exists)
 			for _, owner := range tc.owners {
 				_, exists = k.GetOwnerShares(ctx, tc.vaultId, owner)
 				require.Equal(t, false, exists)
+				_, exists = k.GetOwnerLastDepositHeight(ctx, tc.vaultId, owner)
+				require.Equal(t, false, exists)
 			}
 		})
 	}
@@ -70,4 +70,39 @@ var (
 		13,
 		"ActivationThresholdQuoteQuantums must be non-negative",
 	)
+	ErrInvalidWithdrawalAmount = errorsmod.Register(
+		ModuleName,
+		14,
+		"Withdrawal amount is invalid",
+	)
+	ErrInsufficientShares = errorsmod.Register(
+		ModuleName,
+		15,
+		"Owner does not have enough shares",
+	)
+	ErrWithdrawalLocked = errorsmod.Register(
+		ModuleName,
+		16,
+		"Withdrawal is locked",
+	)
+	ErrZeroQuantumsToRedeem = errorsmod.Register(
+		ModuleName,
+		17,
+		"Cannot redeem zero quote quantums",
+	)
+	ErrInvalidWithdrawalFeePpm = errorsmod.Register(
+		ModuleName,
+		18,
+		"WithdrawalFeePpm must be strictly less than 1_000_000",
+	)
+	ErrUnspecifiedVaultStatus = errorsmod.Register(
+		ModuleName,
+		19,
+		"VaultStatus must be specified",
+	)
+	ErrInvalidVaultType = errorsmod.Register(
+		ModuleName,
+		20,
+		"Vault type is invalid",
+	)
 )
@@ -16,10 +16,10 @@ type ClobKeeper interface {
 	GetClobPair(ctx sdk.Context, id clobtypes.ClobPairId) (val clobtypes.ClobPair, found bool)
 
 	// Order.
-	GetLongTermOrderPlacement(
+	GetAllStatefulOrdersForSubaccount(
 		ctx sdk.Context,
-		orderId clobtypes.OrderId,
-	) (val clobtypes.LongTermOrderPlacement, found bool)
+		subaccountId satypes.SubaccountId,
+	) []clobtypes.Order
 	HandleMsgCancelOrder(
 		ctx sdk.Context,
 		msg *clobtypes.MsgCancelOrder,
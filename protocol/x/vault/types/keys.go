@@ -18,6 +18,14 @@ const (
 	// OwnerShares store: vaultId VaultId -> owner string -> shares NumShares.
 	OwnerSharesKeyPrefix = "OwnerShares:"
 
+	// OwnerLastDepositHeightKeyPrefix is the prefix to retrieve all OwnerLastDepositHeights.
+	// OwnerLastDepositHeight store: vaultId VaultId -> owner string -> block height uint32.
+	OwnerLastDepositHeightKeyPrefix = "OwnerLastDepositHeight:"
+
+	// VaultParamsKeyPrefix is the prefix to retrieve all VaultParams.
+	// VaultParams store: vaultId VaultId -> VaultParams.
+	VaultParamsKeyPrefix = "VaultParams:"
+
 	// ParamsKey is the key to retrieve Params.
 	ParamsKey = "Params"
 )
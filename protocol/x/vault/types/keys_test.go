@@ -14,4 +14,8 @@ func TestModuleKeys(t *testing.T) {
 
 func TestStateKeys(t *testing.T) {
 	require.Equal(t, "TotalShares:", types.TotalSharesKeyPrefix)
+	require.Equal(t, "OwnerShares:", types.OwnerSharesKeyPrefix)
+	require.Equal(t, "OwnerLastDepositHeight:", types.OwnerLastDepositHeightKeyPrefix)
+	require.Equal(t, "VaultParams:", types.VaultParamsKeyPrefix)
+	require.Equal(t, "Params", types.ParamsKey)
 }
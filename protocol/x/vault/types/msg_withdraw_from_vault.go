@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgWithdrawFromVault{}
+
+// ValidateBasic performs stateless validation on a MsgWithdrawFromVault.
+func (msg *MsgWithdrawFromVault) ValidateBasic() error {
+	// Validate subaccount to withdraw to.
+	if err := msg.SubaccountId.Validate(); err != nil {
+		return err
+	}
+
+	// Validate that shares is positive.
+	if msg.Shares == nil || msg.Shares.NumShares.BigInt().Sign() <= 0 {
+		return ErrInvalidWithdrawalAmount
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
 // DefaultParams returns a default set of `x/vault` parameters.
@@ -16,11 +17,40 @@ func DefaultParams() Params {
 		OrderSizePctPpm:                  100_000,                      // 10%
 		OrderExpirationSeconds:           2,                            // 2 seconds
 		ActivationThresholdQuoteQuantums: dtypes.NewInt(1_000_000_000), // 1_000 USDC
+		WithdrawalFeePpm:                 0,                            // no fee
+		WithdrawalLockupBlocks:           0,                            // no lockup
 	}
 }
 
 // Validate validates `x/vault` parameters.
 func (p Params) Validate() error {
+	if err := p.QuotingParams().Validate(); err != nil {
+		return err
+	}
+	// Withdrawal fee ppm must be strictly less than 100%.
+	if p.WithdrawalFeePpm >= lib.OneMillion {
+		return ErrInvalidWithdrawalFeePpm
+	}
+
+	return nil
+}
+
+// QuotingParams returns the quoting parameters in `x/vault` parameters, which vaults
+// quote with unless overridden in their `VaultParams`.
+func (p Params) QuotingParams() QuotingParams {
+	return QuotingParams{
+		Layers:                           p.Layers,
+		SpreadMinPpm:                     p.SpreadMinPpm,
+		SpreadBufferPpm:                  p.SpreadBufferPpm,
+		SkewFactorPpm:                    p.SkewFactorPpm,
+		OrderSizePctPpm:                  p.OrderSizePctPpm,
+		OrderExpirationSeconds:           p.OrderExpirationSeconds,
+		ActivationThresholdQuoteQuantums: p.ActivationThresholdQuoteQuantums,
+	}
+}
+
+// Validate validates quoting parameters.
+func (p QuotingParams) Validate() error {
 	// Layers must be less than or equal to MaxUint8.
 	if p.Layers > math.MaxUint8 {
 		return ErrInvalidLayers
@@ -44,3 +74,19 @@ func (p Params) Validate() error {
 
 	return nil
 }
+
+// Validate validates individual vault parameters.
+func (v VaultParams) Validate() error {
+	// Validate status.
+	if v.Status == VaultStatus_VAULT_STATUS_UNSPECIFIED {
+		return ErrUnspecifiedVaultStatus
+	}
+	// Validate quoting parameters if specified.
+	if v.QuotingParams != nil {
+		if err := v.QuotingParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -46,6 +46,13 @@ type Params struct {
 	// and has strictly less than this amount of quote asset, it will not
 	// activate.
 	ActivationThresholdQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,7,opt,name=activation_threshold_quote_quantums,json=activationThresholdQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"activation_threshold_quote_quantums"`
+	// The fee charged on withdrawals from a vault, in parts-per-million of the
+	// redeemed amount. The fee stays in the vault and accrues to the remaining
+	// shareholders.
+	WithdrawalFeePpm uint32 `protobuf:"varint,8,opt,name=withdrawal_fee_ppm,json=withdrawalFeePpm,proto3" json:"withdrawal_fee_ppm,omitempty"`
+	// The number of blocks after an owner's most recent deposit into a vault
+	// during which the owner cannot withdraw from that vault.
+	WithdrawalLockupBlocks uint32 `protobuf:"varint,9,opt,name=withdrawal_lockup_blocks,json=withdrawalLockupBlocks,proto3" json:"withdrawal_lockup_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -123,39 +130,218 @@ func (m *Params) GetOrderExpirationSeconds() uint32 {
 	return 0
 }
 
+func (m *Params) GetWithdrawalFeePpm() uint32 {
+	if m != nil {
+		return m.WithdrawalFeePpm
+	}
+	return 0
+}
+
+func (m *Params) GetWithdrawalLockupBlocks() uint32 {
+	if m != nil {
+		return m.WithdrawalLockupBlocks
+	}
+	return 0
+}
+
+// QuotingParams stores the parameters that a vault quotes with.
+type QuotingParams struct {
+	// The number of layers of orders a vault places. For example if
+	// `layers=2`, a vault places 2 asks and 2 bids.
+	Layers uint32 `protobuf:"varint,1,opt,name=layers,proto3" json:"layers,omitempty"`
+	// The minimum base spread when a vault quotes around reservation price.
+	SpreadMinPpm uint32 `protobuf:"varint,2,opt,name=spread_min_ppm,json=spreadMinPpm,proto3" json:"spread_min_ppm,omitempty"`
+	// The buffer amount to add to min_price_change_ppm to arrive at `spread`
+	// according to formula:
+	// `spread = max(spread_min_ppm, min_price_change_ppm + spread_buffer_ppm)`.
+	SpreadBufferPpm uint32 `protobuf:"varint,3,opt,name=spread_buffer_ppm,json=spreadBufferPpm,proto3" json:"spread_buffer_ppm,omitempty"`
+	// The factor that determines how aggressive a vault skews its orders.
+	SkewFactorPpm uint32 `protobuf:"varint,4,opt,name=skew_factor_ppm,json=skewFactorPpm,proto3" json:"skew_factor_ppm,omitempty"`
+	// The percentage of vault equity that each order is sized at.
+	OrderSizePctPpm uint32 `protobuf:"varint,5,opt,name=order_size_pct_ppm,json=orderSizePctPpm,proto3" json:"order_size_pct_ppm,omitempty"`
+	// The duration that a vault's orders are valid for.
+	OrderExpirationSeconds uint32 `protobuf:"varint,6,opt,name=order_expiration_seconds,json=orderExpirationSeconds,proto3" json:"order_expiration_seconds,omitempty"`
+	// The number of quote quantums in quote asset that a vault with no perpetual
+	// positions must have to activate, i.e. if a vault has no perpetual positions
+	// and has strictly less than this amount of quote asset, it will not
+	// activate.
+	ActivationThresholdQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,7,opt,name=activation_threshold_quote_quantums,json=activationThresholdQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"activation_threshold_quote_quantums"`
+}
+
+func (m *QuotingParams) Reset()         { *m = QuotingParams{} }
+func (m *QuotingParams) String() string { return proto.CompactTextString(m) }
+func (*QuotingParams) ProtoMessage()    {}
+func (*QuotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{1}
+}
+func (m *QuotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotingParams.Merge(m, src)
+}
+func (m *QuotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotingParams proto.InternalMessageInfo
+
+func (m *QuotingParams) GetLayers() uint32 {
+	if m != nil {
+		return m.Layers
+	}
+	return 0
+}
+
+func (m *QuotingParams) GetSpreadMinPpm() uint32 {
+	if m != nil {
+		return m.SpreadMinPpm
+	}
+	return 0
+}
+
+func (m *QuotingParams) GetSpreadBufferPpm() uint32 {
+	if m != nil {
+		return m.SpreadBufferPpm
+	}
+	return 0
+}
+
+func (m *QuotingParams) GetSkewFactorPpm() uint32 {
+	if m != nil {
+		return m.SkewFactorPpm
+	}
+	return 0
+}
+
+func (m *QuotingParams) GetOrderSizePctPpm() uint32 {
+	if m != nil {
+		return m.OrderSizePctPpm
+	}
+	return 0
+}
+
+func (m *QuotingParams) GetOrderExpirationSeconds() uint32 {
+	if m != nil {
+		return m.OrderExpirationSeconds
+	}
+	return 0
+}
+
+// VaultParams stores the parameters of a specific vault.
+type VaultParams struct {
+	// Status of the vault.
+	Status VaultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=dydxprotocol.vault.VaultStatus" json:"status,omitempty"`
+	// Quoting parameters of the vault. If not set, the vault quotes with the
+	// quoting parameters in module `Params`.
+	QuotingParams *QuotingParams `protobuf:"bytes,2,opt,name=quoting_params,json=quotingParams,proto3" json:"quoting_params,omitempty"`
+}
+
+func (m *VaultParams) Reset()         { *m = VaultParams{} }
+func (m *VaultParams) String() string { return proto.CompactTextString(m) }
+func (*VaultParams) ProtoMessage()    {}
+func (*VaultParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6043e0b8bfdbca9f, []int{2}
+}
+func (m *VaultParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultParams.Merge(m, src)
+}
+func (m *VaultParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultParams proto.InternalMessageInfo
+
+func (m *VaultParams) GetStatus() VaultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return VaultStatus_VAULT_STATUS_UNSPECIFIED
+}
+
+func (m *VaultParams) GetQuotingParams() *QuotingParams {
+	if m != nil {
+		return m.QuotingParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dydxprotocol.vault.Params")
+	proto.RegisterType((*QuotingParams)(nil), "dydxprotocol.vault.QuotingParams")
+	proto.RegisterType((*VaultParams)(nil), "dydxprotocol.vault.VaultParams")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/vault/params.proto", fileDescriptor_6043e0b8bfdbca9f) }
 
 var fileDescriptor_6043e0b8bfdbca9f = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0x13, 0x0a, 0x41, 0xb2, 0x5a, 0x2a, 0x22, 0x54, 0x45, 0x0c, 0x69, 0x05, 0x08, 0x55,
-	0x20, 0x92, 0x01, 0x24, 0x18, 0x51, 0x25, 0x10, 0x0c, 0x48, 0xfd, 0xc3, 0xc4, 0x62, 0x39, 0x8e,
-	0xdb, 0x58, 0x24, 0xb1, 0x6b, 0x3b, 0xa5, 0xed, 0xa7, 0x60, 0x63, 0xe0, 0x0b, 0x75, 0xec, 0x88,
-	0x18, 0xaa, 0x53, 0xfb, 0x45, 0x4e, 0x79, 0x9d, 0xeb, 0xdd, 0x6d, 0xb7, 0xd9, 0xbf, 0xe7, 0xf7,
-	0xfa, 0xb1, 0x64, 0xa3, 0x7e, 0xba, 0x49, 0xd7, 0x52, 0x09, 0x23, 0xa8, 0xc8, 0xe3, 0x15, 0xa9,
-	0x72, 0x13, 0x4b, 0xa2, 0x48, 0xa1, 0x23, 0xa0, 0xbe, 0x7f, 0x53, 0x88, 0x40, 0x78, 0xfa, 0x64,
-	0x21, 0x16, 0x02, 0x58, 0x5c, 0xaf, 0xac, 0xf9, 0xec, 0x6f, 0x0b, 0x79, 0x63, 0x18, 0xf5, 0x7b,
-	0xc8, 0xcb, 0xc9, 0x86, 0x29, 0x1d, 0xb8, 0x03, 0x77, 0xd8, 0x99, 0x36, 0x3b, 0xff, 0x05, 0x7a,
-	0xa4, 0xa5, 0x62, 0x24, 0xc5, 0x05, 0x2f, 0xb1, 0x94, 0x45, 0x70, 0x0f, 0xf2, 0xb6, 0xa5, 0xdf,
-	0x78, 0x39, 0x96, 0x85, 0xff, 0x0a, 0x3d, 0x6e, 0xac, 0xa4, 0x9a, 0xcf, 0x99, 0x02, 0xb1, 0x05,
-	0x62, 0xd7, 0x06, 0x23, 0xe0, 0xb5, 0xfb, 0x12, 0x75, 0xf5, 0x4f, 0xf6, 0x0b, 0xcf, 0x09, 0x35,
-	0xc2, 0x9a, 0xf7, 0xc1, 0xec, 0xd4, 0xf8, 0x33, 0xd0, 0xda, 0x7b, 0x8d, 0x7c, 0xa1, 0x52, 0xa6,
-	0xb0, 0xe6, 0x5b, 0x86, 0x25, 0x35, 0xa0, 0x3e, 0xb0, 0x87, 0x42, 0x32, 0xe3, 0x5b, 0x36, 0xa6,
-	0xa6, 0x96, 0x3f, 0xa0, 0xc0, 0xca, 0x6c, 0x2d, 0xb9, 0x22, 0x86, 0x8b, 0x12, 0x6b, 0x46, 0x45,
-	0x99, 0xea, 0xc0, 0x83, 0x91, 0x1e, 0xe4, 0x9f, 0xce, 0xf1, 0xcc, 0xa6, 0xfe, 0x1f, 0x17, 0x3d,
-	0x27, 0xd4, 0xf0, 0x95, 0x1d, 0x32, 0x99, 0x62, 0x3a, 0x13, 0x79, 0x8a, 0x97, 0x95, 0x30, 0x0c,
-	0x2f, 0x2b, 0x52, 0x9a, 0xaa, 0xd0, 0xc1, 0xc3, 0x81, 0x3b, 0x6c, 0x8f, 0xbe, 0xec, 0x0e, 0x7d,
-	0xe7, 0xff, 0xa1, 0xff, 0x71, 0xc1, 0x4d, 0x56, 0x25, 0x11, 0x15, 0x45, 0x7c, 0xfb, 0x3d, 0xde,
-	0xbd, 0xa1, 0x19, 0xe1, 0x65, 0x7c, 0x26, 0xa9, 0xd9, 0x48, 0xa6, 0xa3, 0x19, 0x53, 0x9c, 0xe4,
-	0x7c, 0x4b, 0x92, 0x9c, 0x7d, 0x2d, 0xcd, 0x74, 0x70, 0x5d, 0xfa, 0xfd, 0xaa, 0x73, 0x52, 0x57,
-	0x4e, 0x9a, 0xc6, 0xd1, 0x64, 0x77, 0x0c, 0xdd, 0xfd, 0x31, 0x74, 0x2f, 0x8e, 0xa1, 0xfb, 0xfb,
-	0x14, 0x3a, 0xfb, 0x53, 0xe8, 0xfc, 0x3b, 0x85, 0xce, 0x8f, 0xf7, 0x77, 0x6f, 0x5f, 0x37, 0x3f,
-	0x04, 0x2e, 0x91, 0x78, 0xc0, 0xdf, 0x5e, 0x06, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x00, 0xa7, 0x50,
-	0x44, 0x02, 0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xdf, 0xf6, 0x2b, 0xe0, 0xad, 0x1d, 0x58, 0x68, 0x8a, 0x76, 0x48, 0x4b, 0x41,
+	0x68, 0xe2, 0x4f, 0x2a, 0x0d, 0xa4, 0x71, 0x44, 0x95, 0x98, 0x86, 0x04, 0x52, 0xff, 0x20, 0x0e,
+	0x5c, 0x2c, 0x27, 0x71, 0x1b, 0x6b, 0x49, 0xec, 0xda, 0xce, 0xfa, 0xe7, 0xca, 0x1b, 0xd8, 0x8d,
+	0x3b, 0xaf, 0x66, 0xc7, 0x1d, 0x11, 0x87, 0x09, 0xb5, 0x6f, 0x04, 0xe5, 0x71, 0x58, 0x3b, 0xb1,
+	0x03, 0x47, 0x0e, 0x5c, 0xda, 0xf8, 0xfb, 0xfd, 0xf8, 0x79, 0x94, 0xef, 0xe3, 0x18, 0x35, 0xa2,
+	0x59, 0x34, 0x95, 0x4a, 0x18, 0x11, 0x8a, 0xa4, 0x7d, 0x4a, 0xf3, 0xc4, 0xb4, 0x25, 0x55, 0x34,
+	0xd5, 0x3e, 0xa8, 0x18, 0xaf, 0x03, 0x3e, 0x00, 0x7b, 0xf7, 0x47, 0x62, 0x24, 0x40, 0x6b, 0x17,
+	0x4f, 0x96, 0xdc, 0xf3, 0x6e, 0x28, 0x05, 0xbf, 0xd6, 0x6f, 0x7d, 0xde, 0x44, 0xd5, 0x2e, 0x94,
+	0xc6, 0xbb, 0xa8, 0x9a, 0xd0, 0x19, 0x53, 0xda, 0x75, 0x9a, 0xce, 0x7e, 0xad, 0x5f, 0xae, 0xf0,
+	0x23, 0x54, 0xd7, 0x52, 0x31, 0x1a, 0x91, 0x94, 0x67, 0x44, 0xca, 0xd4, 0xfd, 0x0f, 0xfc, 0x6d,
+	0xab, 0xbe, 0xe7, 0x59, 0x57, 0xa6, 0xf8, 0x09, 0xba, 0x57, 0x52, 0x41, 0x3e, 0x1c, 0x32, 0x05,
+	0xe0, 0x06, 0x80, 0x3b, 0xd6, 0xe8, 0x80, 0x5e, 0xb0, 0x8f, 0xd1, 0x8e, 0x3e, 0x61, 0x13, 0x32,
+	0xa4, 0xa1, 0x11, 0x96, 0xdc, 0x04, 0xb2, 0x56, 0xc8, 0x47, 0xa0, 0x16, 0xdc, 0x53, 0x84, 0x85,
+	0x8a, 0x98, 0x22, 0x9a, 0xcf, 0x19, 0x91, 0xa1, 0x01, 0xf4, 0x7f, 0x5b, 0x14, 0x9c, 0x01, 0x9f,
+	0xb3, 0x6e, 0x68, 0x0a, 0xf8, 0x15, 0x72, 0x2d, 0xcc, 0xa6, 0x92, 0x2b, 0x6a, 0xb8, 0xc8, 0x88,
+	0x66, 0xa1, 0xc8, 0x22, 0xed, 0x56, 0x61, 0xcb, 0x2e, 0xf8, 0x6f, 0xae, 0xec, 0x81, 0x75, 0xf1,
+	0x17, 0x07, 0x3d, 0xa4, 0xa1, 0xe1, 0xa7, 0x76, 0x93, 0x89, 0x15, 0xd3, 0xb1, 0x48, 0x22, 0x32,
+	0xce, 0x85, 0x61, 0x64, 0x9c, 0xd3, 0xcc, 0xe4, 0xa9, 0x76, 0x6f, 0x35, 0x9d, 0xfd, 0xed, 0xce,
+	0xf1, 0xf9, 0x65, 0xa3, 0xf2, 0xfd, 0xb2, 0xf1, 0x7a, 0xc4, 0x4d, 0x9c, 0x07, 0x7e, 0x28, 0xd2,
+	0xf6, 0xf5, 0x90, 0x5f, 0x3e, 0x0f, 0x63, 0xca, 0xb3, 0xf6, 0x95, 0x12, 0x99, 0x99, 0x64, 0xda,
+	0x1f, 0x30, 0xc5, 0x69, 0xc2, 0xe7, 0x34, 0x48, 0xd8, 0xdb, 0xcc, 0xf4, 0x9b, 0xab, 0xa6, 0x1f,
+	0x7e, 0xf5, 0xec, 0x15, 0x2d, 0x7b, 0x65, 0x47, 0xfc, 0x0c, 0xe1, 0x09, 0x37, 0x71, 0xa4, 0xe8,
+	0x84, 0x26, 0x64, 0xc8, 0x18, 0x04, 0x70, 0x1b, 0xde, 0xe6, 0xee, 0xca, 0x39, 0x62, 0xac, 0x4c,
+	0x60, 0x8d, 0x4e, 0x44, 0x78, 0x92, 0x4b, 0x12, 0x14, 0xff, 0xda, 0xbd, 0x63, 0x13, 0x58, 0xf9,
+	0xef, 0xc0, 0xee, 0x80, 0xdb, 0xfa, 0xba, 0x81, 0x6a, 0x45, 0x67, 0x9e, 0x8d, 0xfe, 0x1d, 0x86,
+	0xbf, 0xf4, 0x30, 0xb4, 0xce, 0x1c, 0xb4, 0xf5, 0xb1, 0xf8, 0x74, 0xcb, 0x11, 0x1d, 0xa2, 0xaa,
+	0x36, 0xd4, 0xe4, 0x76, 0x44, 0xf5, 0x83, 0x86, 0xff, 0xfb, 0xad, 0xe0, 0xc3, 0x86, 0x01, 0x60,
+	0xfd, 0x12, 0xc7, 0xc7, 0xa8, 0x3e, 0xb6, 0xc3, 0x26, 0xf6, 0x56, 0x81, 0x19, 0x6e, 0x1d, 0x3c,
+	0xb8, 0xa9, 0xc0, 0xb5, 0x63, 0xd1, 0xaf, 0x8d, 0xd7, 0x97, 0x9d, 0xde, 0xf9, 0xc2, 0x73, 0x2e,
+	0x16, 0x9e, 0xf3, 0x63, 0xe1, 0x39, 0x67, 0x4b, 0xaf, 0x72, 0xb1, 0xf4, 0x2a, 0xdf, 0x96, 0x5e,
+	0xe5, 0xd3, 0xe1, 0x9f, 0x07, 0x32, 0x2d, 0xaf, 0x25, 0xc8, 0x25, 0xa8, 0x82, 0xfe, 0xe2, 0xe7,
+	0x00, 0x49, 0xcf, 0xad, 0xb6, 0x04, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -174,6 +360,79 @@ func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalLockupBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalLockupBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WithdrawalFeePpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalFeePpm))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ActivationThresholdQuoteQuantums.Size()
+		i -= size
+		if _, err := m.ActivationThresholdQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.OrderExpirationSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderExpirationSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OrderSizePctPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OrderSizePctPpm))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SkewFactorPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SkewFactorPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SpreadBufferPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadBufferPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpreadMinPpm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SpreadMinPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Layers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Layers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -221,6 +480,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuotingParams != nil {
+		{
+			size, err := m.QuotingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -258,6 +557,57 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ActivationThresholdQuoteQuantums.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.WithdrawalFeePpm != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalFeePpm))
+	}
+	if m.WithdrawalLockupBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalLockupBlocks))
+	}
+	return n
+}
+
+func (m *QuotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Layers != 0 {
+		n += 1 + sovParams(uint64(m.Layers))
+	}
+	if m.SpreadMinPpm != 0 {
+		n += 1 + sovParams(uint64(m.SpreadMinPpm))
+	}
+	if m.SpreadBufferPpm != 0 {
+		n += 1 + sovParams(uint64(m.SpreadBufferPpm))
+	}
+	if m.SkewFactorPpm != 0 {
+		n += 1 + sovParams(uint64(m.SkewFactorPpm))
+	}
+	if m.OrderSizePctPpm != 0 {
+		n += 1 + sovParams(uint64(m.OrderSizePctPpm))
+	}
+	if m.OrderExpirationSeconds != 0 {
+		n += 1 + sovParams(uint64(m.OrderExpirationSeconds))
+	}
+	l = m.ActivationThresholdQuoteQuantums.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *VaultParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovParams(uint64(m.Status))
+	}
+	if m.QuotingParams != nil {
+		l = m.QuotingParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -443,6 +793,346 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalFeePpm", wireType)
+			}
+			m.WithdrawalFeePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalFeePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalLockupBlocks", wireType)
+			}
+			m.WithdrawalLockupBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalLockupBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layers", wireType)
+			}
+			m.Layers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadMinPpm", wireType)
+			}
+			m.SpreadMinPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadMinPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadBufferPpm", wireType)
+			}
+			m.SpreadBufferPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpreadBufferPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkewFactorPpm", wireType)
+			}
+			m.SkewFactorPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkewFactorPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSizePctPpm", wireType)
+			}
+			m.OrderSizePctPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSizePctPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpirationSeconds", wireType)
+			}
+			m.OrderExpirationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderExpirationSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThresholdQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActivationThresholdQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= VaultStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotingParams == nil {
+				m.QuotingParams = &QuotingParams{}
+			}
+			if err := m.QuotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
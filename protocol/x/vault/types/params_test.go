@@ -79,6 +79,19 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: types.ErrInvalidActivationThresholdQuoteQuantums,
 		},
+		"Failure - WithdrawalFeePpm is 1_000_000": {
+			params: types.Params{
+				Layers:                           2,
+				SpreadMinPpm:                     3_000,
+				SpreadBufferPpm:                  1_500,
+				SkewFactorPpm:                    500_000,
+				OrderSizePctPpm:                  100_000,
+				OrderExpirationSeconds:           5,
+				ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				WithdrawalFeePpm:                 1_000_000,
+			},
+			expectedErr: types.ErrInvalidWithdrawalFeePpm,
+		},
 	}
 
 	for name, tc := range tests {
@@ -88,3 +101,73 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestQuotingParams(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(
+		t,
+		types.QuotingParams{
+			Layers:                           params.Layers,
+			SpreadMinPpm:                     params.SpreadMinPpm,
+			SpreadBufferPpm:                  params.SpreadBufferPpm,
+			SkewFactorPpm:                    params.SkewFactorPpm,
+			OrderSizePctPpm:                  params.OrderSizePctPpm,
+			OrderExpirationSeconds:           params.OrderExpirationSeconds,
+			ActivationThresholdQuoteQuantums: params.ActivationThresholdQuoteQuantums,
+		},
+		params.QuotingParams(),
+	)
+}
+
+func TestValidateVaultParams(t *testing.T) {
+	defaultQuotingParams := types.DefaultParams().QuotingParams()
+	tests := map[string]struct {
+		// VaultParams to validate.
+		vaultParams types.VaultParams
+		// Expected error
+		expectedErr error
+	}{
+		"Success - No quoting params": {
+			vaultParams: types.VaultParams{
+				Status: types.VaultStatus_VAULT_STATUS_QUOTING,
+			},
+			expectedErr: nil,
+		},
+		"Success - With quoting params": {
+			vaultParams: types.VaultParams{
+				Status:        types.VaultStatus_VAULT_STATUS_CLOSE_ONLY,
+				QuotingParams: &defaultQuotingParams,
+			},
+			expectedErr: nil,
+		},
+		"Failure - Unspecified status": {
+			vaultParams: types.VaultParams{
+				Status:        types.VaultStatus_VAULT_STATUS_UNSPECIFIED,
+				QuotingParams: &defaultQuotingParams,
+			},
+			expectedErr: types.ErrUnspecifiedVaultStatus,
+		},
+		"Failure - Invalid quoting params": {
+			vaultParams: types.VaultParams{
+				Status: types.VaultStatus_VAULT_STATUS_STAND_BY,
+				QuotingParams: &types.QuotingParams{
+					Layers:                           2,
+					SpreadMinPpm:                     3_000,
+					SpreadBufferPpm:                  1_500,
+					SkewFactorPpm:                    500_000,
+					OrderSizePctPpm:                  100_000,
+					OrderExpirationSeconds:           0,
+					ActivationThresholdQuoteQuantums: dtypes.NewInt(1),
+				},
+			},
+			expectedErr: types.ErrInvalidOrderExpirationSeconds,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.vaultParams.Validate()
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	types "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -174,6 +175,10 @@ type QueryVaultResponse struct {
 	Equity       uint64             `protobuf:"varint,3,opt,name=equity,proto3" json:"equity,omitempty"`
 	Inventory    uint64             `protobuf:"varint,4,opt,name=inventory,proto3" json:"inventory,omitempty"`
 	TotalShares  uint64             `protobuf:"varint,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	// Parameters the vault operates with, i.e. its status and its quoting
+	// parameters, falling back to the module's quoting parameters if the vault
+	// does not override them.
+	VaultParams VaultParams `protobuf:"bytes,6,opt,name=vault_params,json=vaultParams,proto3" json:"vault_params"`
 }
 
 func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
@@ -244,6 +249,13 @@ func (m *QueryVaultResponse) GetTotalShares() uint64 {
 	return 0
 }
 
+func (m *QueryVaultResponse) GetVaultParams() VaultParams {
+	if m != nil {
+		return m.VaultParams
+	}
+	return VaultParams{}
+}
+
 // QueryAllVaultsRequest is a request type for the AllVaults RPC method.
 type QueryAllVaultsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -509,6 +521,127 @@ func (m *QueryOwnerSharesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRedeemableAmountRequest is a request type for the RedeemableAmount RPC
+// method.
+type QueryRedeemableAmountRequest struct {
+	Type   VaultType `protobuf:"varint,1,opt,name=type,proto3,enum=dydxprotocol.vault.VaultType" json:"type,omitempty"`
+	Number uint32    `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Owner  string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryRedeemableAmountRequest) Reset()         { *m = QueryRedeemableAmountRequest{} }
+func (m *QueryRedeemableAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemableAmountRequest) ProtoMessage()    {}
+func (*QueryRedeemableAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{9}
+}
+func (m *QueryRedeemableAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedeemableAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedeemableAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedeemableAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemableAmountRequest.Merge(m, src)
+}
+func (m *QueryRedeemableAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedeemableAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemableAmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemableAmountRequest proto.InternalMessageInfo
+
+func (m *QueryRedeemableAmountRequest) GetType() VaultType {
+	if m != nil {
+		return m.Type
+	}
+	return VaultType_VAULT_TYPE_UNSPECIFIED
+}
+
+func (m *QueryRedeemableAmountRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *QueryRedeemableAmountRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryRedeemableAmountResponse is a response type for the RedeemableAmount
+// RPC method.
+type QueryRedeemableAmountResponse struct {
+	// Shares of the vault owned by the owner.
+	Shares NumShares `protobuf:"bytes,1,opt,name=shares,proto3" json:"shares"`
+	// Number of quote quantums the owner would receive by redeeming all of
+	// their shares, net of the withdrawal fee.
+	RedeemableQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=redeemable_quote_quantums,json=redeemableQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"redeemable_quote_quantums"`
+	// The first block height at which the owner can withdraw.
+	UnlockBlockHeight uint32 `protobuf:"varint,3,opt,name=unlock_block_height,json=unlockBlockHeight,proto3" json:"unlock_block_height,omitempty"`
+}
+
+func (m *QueryRedeemableAmountResponse) Reset()         { *m = QueryRedeemableAmountResponse{} }
+func (m *QueryRedeemableAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemableAmountResponse) ProtoMessage()    {}
+func (*QueryRedeemableAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478fb8dc0ff21ea6, []int{10}
+}
+func (m *QueryRedeemableAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedeemableAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedeemableAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedeemableAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemableAmountResponse.Merge(m, src)
+}
+func (m *QueryRedeemableAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedeemableAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemableAmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemableAmountResponse proto.InternalMessageInfo
+
+func (m *QueryRedeemableAmountResponse) GetShares() NumShares {
+	if m != nil {
+		return m.Shares
+	}
+	return NumShares{}
+}
+
+func (m *QueryRedeemableAmountResponse) GetUnlockBlockHeight() uint32 {
+	if m != nil {
+		return m.UnlockBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.vault.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.vault.QueryParamsResponse")
@@ -519,61 +652,75 @@ func init() {
 	proto.RegisterType((*QueryOwnerSharesRequest)(nil), "dydxprotocol.vault.QueryOwnerSharesRequest")
 	proto.RegisterType((*OwnerShare)(nil), "dydxprotocol.vault.OwnerShare")
 	proto.RegisterType((*QueryOwnerSharesResponse)(nil), "dydxprotocol.vault.QueryOwnerSharesResponse")
+	proto.RegisterType((*QueryRedeemableAmountRequest)(nil), "dydxprotocol.vault.QueryRedeemableAmountRequest")
+	proto.RegisterType((*QueryRedeemableAmountResponse)(nil), "dydxprotocol.vault.QueryRedeemableAmountResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/vault/query.proto", fileDescriptor_478fb8dc0ff21ea6) }
 
 var fileDescriptor_478fb8dc0ff21ea6 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x42, 0xdb, 0xef, 0x63, 0x0a, 0x5f, 0xf2, 0x8d, 0x88, 0xa5, 0xe0, 0x02, 0x9b, 0xc8,
-	0x4f, 0xd9, 0xb5, 0x55, 0x23, 0x07, 0x63, 0x02, 0x07, 0x8d, 0x17, 0x81, 0xc5, 0x78, 0xf0, 0x60,
-	0xb3, 0x6d, 0x27, 0xcb, 0x26, 0xed, 0x4e, 0xd9, 0x99, 0xad, 0x34, 0x84, 0x8b, 0x89, 0x07, 0x6f,
-	0x26, 0xfe, 0x05, 0x7a, 0xf0, 0xe4, 0xd1, 0xbb, 0x57, 0x8e, 0x44, 0x2f, 0x9e, 0x8c, 0x01, 0xff,
-	0x0e, 0x63, 0xf6, 0x9d, 0x97, 0x76, 0xfb, 0x2b, 0x10, 0xc3, 0x65, 0xb3, 0xf3, 0xce, 0x33, 0xcf,
-	0xfb, 0xcc, 0x33, 0xcf, 0x0c, 0xd1, 0x2b, 0xcd, 0xca, 0x7e, 0x3d, 0xe0, 0x92, 0x97, 0x79, 0xd5,
-	0x6a, 0x38, 0x61, 0x55, 0x5a, 0x7b, 0x21, 0x0b, 0x9a, 0x26, 0x14, 0x29, 0x8d, 0xcf, 0x9b, 0x30,
-	0x9f, 0x1b, 0x77, 0xb9, 0xcb, 0xa1, 0x66, 0x45, 0x7f, 0x0a, 0x99, 0x9b, 0x76, 0x39, 0x77, 0xab,
-	0xcc, 0x72, 0xea, 0x9e, 0xe5, 0xf8, 0x3e, 0x97, 0x8e, 0xf4, 0xb8, 0x2f, 0x70, 0x76, 0xb9, 0xcc,
-	0x45, 0x8d, 0x0b, 0xab, 0xe4, 0x08, 0xa6, 0x1a, 0x58, 0x8d, 0x7c, 0x89, 0x49, 0x27, 0x6f, 0xd5,
-	0x1d, 0xd7, 0xf3, 0x01, 0x8c, 0xd8, 0x49, 0x85, 0x2d, 0xaa, 0x16, 0x6a, 0x80, 0x53, 0x4b, 0x1d,
-	0x72, 0x45, 0x58, 0x72, 0xca, 0x65, 0x1e, 0xfa, 0x52, 0xc4, 0xfe, 0x11, 0x3a, 0xd3, 0x67, 0x67,
-	0x75, 0x27, 0x70, 0x6a, 0x67, 0x5c, 0xfd, 0xb6, 0x0e, 0x5f, 0x35, 0x6f, 0x8c, 0x13, 0xba, 0x1d,
-	0x09, 0xdd, 0x82, 0x45, 0x36, 0xdb, 0x0b, 0x99, 0x90, 0xc6, 0x26, 0xb9, 0xd2, 0x51, 0x15, 0x75,
-	0xee, 0x0b, 0x46, 0xd7, 0x48, 0x5a, 0x91, 0x67, 0xb5, 0x59, 0x6d, 0x31, 0x53, 0xc8, 0x99, 0xbd,
-	0xc6, 0x99, 0x6a, 0xcd, 0x46, 0xf2, 0xe8, 0xc7, 0x4c, 0xc2, 0x46, 0xbc, 0xf1, 0x82, 0xfc, 0x0f,
-	0x84, 0xcf, 0x22, 0x08, 0x76, 0xa1, 0x79, 0x92, 0x94, 0xcd, 0x3a, 0x03, 0xb2, 0xff, 0x0a, 0xd7,
-	0xfb, 0x91, 0x01, 0xfe, 0x69, 0xb3, 0xce, 0x6c, 0x80, 0xd2, 0x09, 0x92, 0xf6, 0xc3, 0x5a, 0x89,
-	0x05, 0xd9, 0xa1, 0x59, 0x6d, 0x71, 0xcc, 0xc6, 0x91, 0xf1, 0x5b, 0xc3, 0x7d, 0x60, 0x03, 0x14,
-	0x7c, 0x9f, 0xfc, 0x0b, 0x3c, 0x45, 0xaf, 0x82, 0x92, 0xa7, 0x06, 0x76, 0x79, 0x5c, 0x41, 0xcd,
-	0xff, 0x34, 0xd4, 0x90, 0x6e, 0x93, 0xb1, 0xb6, 0xe1, 0x11, 0xc5, 0x10, 0x50, 0xcc, 0x77, 0x52,
-	0xc4, 0xce, 0xc7, 0xdc, 0x69, 0xfd, 0xb7, 0xd8, 0x46, 0x45, 0xac, 0x16, 0xe9, 0x67, 0x7b, 0xa1,
-	0x27, 0x9b, 0xd9, 0xe1, 0x59, 0x6d, 0x31, 0x69, 0xe3, 0x88, 0x4e, 0x93, 0x11, 0xcf, 0x6f, 0x30,
-	0x5f, 0xf2, 0xa0, 0x99, 0x4d, 0xc2, 0x54, 0xbb, 0x40, 0xe7, 0xc8, 0xa8, 0xe4, 0xd2, 0xa9, 0x16,
-	0xc5, 0xae, 0x13, 0x30, 0x91, 0x4d, 0x01, 0x20, 0x03, 0xb5, 0x1d, 0x28, 0x19, 0x45, 0x72, 0x15,
-	0xf6, 0xbf, 0x5e, 0xad, 0xc2, 0x6e, 0xce, 0x8e, 0x92, 0x3e, 0x24, 0xa4, 0x9d, 0x3d, 0x34, 0x61,
-	0xde, 0xc4, 0xbc, 0x45, 0x41, 0x35, 0xd5, 0x4d, 0xc0, 0xa0, 0x9a, 0x5b, 0x8e, 0xcb, 0x70, 0xad,
-	0x1d, 0x5b, 0x69, 0xbc, 0xd7, 0xc8, 0x44, 0x77, 0x07, 0x74, 0xf9, 0x01, 0x49, 0x83, 0x65, 0x51,
-	0x2c, 0x86, 0x7b, 0x0d, 0x52, 0x1e, 0xf7, 0x9e, 0x8e, 0x8d, 0xab, 0xe8, 0xa3, 0x0e, 0x89, 0xca,
-	0xe4, 0x85, 0x73, 0x25, 0x22, 0x49, 0x5c, 0xe3, 0x27, 0x8d, 0x5c, 0x83, 0x3e, 0x9b, 0x2f, 0x7d,
-	0x16, 0x28, 0x67, 0x2e, 0x3f, 0x6c, 0x5d, 0x96, 0x0e, 0xff, 0xb5, 0xa5, 0x82, 0x90, 0xb6, 0x50,
-	0x6a, 0x92, 0x14, 0x8f, 0x46, 0xa0, 0x70, 0x64, 0x23, 0xfb, 0xf5, 0xf3, 0xea, 0x38, 0x72, 0xae,
-	0x57, 0x2a, 0x01, 0x13, 0x62, 0x47, 0x06, 0x9e, 0xef, 0xda, 0x0a, 0x46, 0xef, 0x92, 0x34, 0xc6,
-	0x41, 0x39, 0xd6, 0x77, 0x4b, 0x4f, 0xc2, 0x1a, 0xda, 0x80, 0x60, 0xe3, 0xa3, 0x46, 0xb2, 0xbd,
-	0x1e, 0xe1, 0x49, 0xae, 0x93, 0x51, 0x20, 0x3f, 0x0b, 0x9a, 0x3a, 0x4f, 0xbd, 0x1f, 0x73, 0x7b,
-	0xb9, 0x9d, 0xe1, 0x6d, 0xaa, 0x4b, 0x3b, 0xcc, 0xc2, 0x97, 0x24, 0x49, 0x81, 0x50, 0x7a, 0x48,
-	0xd2, 0xea, 0x51, 0xa1, 0x83, 0x93, 0xd5, 0xf1, 0x7e, 0xe5, 0x16, 0xce, 0xc5, 0xa9, 0x86, 0x86,
-	0xf1, 0xea, 0xdb, 0xaf, 0x77, 0x43, 0xd3, 0x34, 0x67, 0x0d, 0x7c, 0x48, 0xe9, 0x1b, 0x8d, 0xa4,
-	0x20, 0x1a, 0xf4, 0xc6, 0x79, 0xc1, 0x56, 0xdd, 0x2f, 0x98, 0x7f, 0x23, 0x0f, 0xcd, 0x57, 0xe8,
-	0x92, 0x35, 0xe8, 0x91, 0xb6, 0x0e, 0xa2, 0x20, 0x1e, 0x5a, 0x07, 0x2a, 0x79, 0x87, 0xf4, 0xb5,
-	0x46, 0x46, 0x5a, 0x17, 0x90, 0x2e, 0x0d, 0x6c, 0xd4, 0xfd, 0x0c, 0xe4, 0x96, 0x2f, 0x02, 0x45,
-	0x5d, 0x73, 0xa0, 0x6b, 0x8a, 0x4e, 0x0e, 0xd4, 0x45, 0x3f, 0x68, 0x24, 0x13, 0x0b, 0x10, 0x5d,
-	0x19, 0x48, 0xdf, 0x7b, 0x15, 0x73, 0x37, 0x2f, 0x06, 0x46, 0x35, 0x6b, 0xa0, 0xa6, 0x40, 0x6f,
-	0xf5, 0x53, 0x13, 0x4f, 0x6b, 0xb7, 0x59, 0x1b, 0xdb, 0x47, 0x27, 0xba, 0x76, 0x7c, 0xa2, 0x6b,
-	0x3f, 0x4f, 0x74, 0xed, 0xed, 0xa9, 0x9e, 0x38, 0x3e, 0xd5, 0x13, 0xdf, 0x4f, 0xf5, 0xc4, 0xf3,
-	0x7b, 0xae, 0x27, 0x77, 0xc3, 0x92, 0x59, 0xe6, 0xb5, 0x2e, 0xd6, 0x3b, 0xab, 0xe5, 0x5d, 0xc7,
-	0xf3, 0xad, 0x56, 0x65, 0x1f, 0x3b, 0x45, 0xdc, 0xa2, 0x94, 0x86, 0xfa, 0xed, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x36, 0x27, 0x20, 0x64, 0x52, 0x08, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x89, 0x21, 0xcf, 0x0e, 0xa2, 0xd3, 0xd0, 0x3a, 0x6e, 0xea, 0xa4, 0x2b, 0xd1,
+	0x26, 0x2d, 0xdd, 0xad, 0x03, 0x88, 0x4a, 0xfc, 0x8c, 0x25, 0x20, 0xbd, 0xd0, 0x7a, 0x83, 0x38,
+	0x70, 0xc0, 0x1a, 0xdb, 0xa3, 0xf5, 0x8a, 0xf5, 0x8e, 0xb3, 0x3b, 0x6b, 0x6a, 0xa2, 0x48, 0x08,
+	0xc1, 0x81, 0x1b, 0x52, 0x4f, 0x1c, 0xe1, 0xc0, 0x89, 0x23, 0x67, 0xce, 0x39, 0x56, 0x70, 0x41,
+	0x1c, 0x2a, 0x94, 0xf0, 0x87, 0xa0, 0x7d, 0x33, 0xf6, 0xae, 0x7f, 0xac, 0x62, 0x50, 0x7b, 0x59,
+	0xed, 0xbc, 0x79, 0xef, 0x7b, 0xdf, 0x7c, 0xef, 0xcd, 0x1b, 0xa8, 0xb4, 0x07, 0xed, 0x87, 0xbd,
+	0x40, 0x48, 0xd1, 0x12, 0x9e, 0xd5, 0x67, 0x91, 0x27, 0xad, 0xc3, 0x88, 0x07, 0x03, 0x13, 0x8d,
+	0x94, 0xa6, 0xf7, 0x4d, 0xdc, 0x2f, 0xaf, 0x39, 0xc2, 0x11, 0x68, 0xb3, 0xe2, 0x3f, 0xe5, 0x59,
+	0xde, 0x70, 0x84, 0x70, 0x3c, 0x6e, 0xb1, 0x9e, 0x6b, 0x31, 0xdf, 0x17, 0x92, 0x49, 0x57, 0xf8,
+	0xa1, 0xde, 0xbd, 0xd9, 0x12, 0x61, 0x57, 0x84, 0x56, 0x93, 0x85, 0x5c, 0x25, 0xb0, 0xfa, 0xd5,
+	0x26, 0x97, 0xac, 0x6a, 0xf5, 0x98, 0xe3, 0xfa, 0xe8, 0xac, 0x7d, 0xd7, 0x95, 0x6f, 0x43, 0xa5,
+	0x50, 0x0b, 0xbd, 0xb5, 0x33, 0x46, 0x37, 0x8c, 0x9a, 0xac, 0xd5, 0x12, 0x91, 0x2f, 0xc3, 0xd4,
+	0xbf, 0x76, 0xdd, 0x9c, 0x71, 0xb2, 0x1e, 0x0b, 0x58, 0x77, 0x88, 0x35, 0xeb, 0xe8, 0xf8, 0x55,
+	0xfb, 0xc6, 0x1a, 0xd0, 0x7a, 0x4c, 0xf4, 0x01, 0x06, 0xd9, 0xfc, 0x30, 0xe2, 0xa1, 0x34, 0xee,
+	0xc3, 0xc5, 0x31, 0x6b, 0xd8, 0x13, 0x7e, 0xc8, 0xe9, 0x5d, 0xc8, 0x2b, 0xf0, 0x12, 0xd9, 0x22,
+	0xdb, 0x85, 0xdd, 0xb2, 0x39, 0x2d, 0x9c, 0xa9, 0x62, 0x6a, 0x4b, 0x27, 0x4f, 0x36, 0x17, 0x6c,
+	0xed, 0x6f, 0x7c, 0x06, 0x17, 0x10, 0xf0, 0x93, 0xd8, 0x45, 0x67, 0xa1, 0x55, 0x58, 0x92, 0x83,
+	0x1e, 0x47, 0xb0, 0x17, 0x76, 0xaf, 0xce, 0x02, 0x43, 0xff, 0x8f, 0x07, 0x3d, 0x6e, 0xa3, 0x2b,
+	0xbd, 0x04, 0x79, 0x3f, 0xea, 0x36, 0x79, 0x50, 0xca, 0x6d, 0x91, 0xed, 0x55, 0x5b, 0xaf, 0x8c,
+	0x93, 0x9c, 0x3e, 0x87, 0x4e, 0xa0, 0x09, 0xbf, 0x05, 0xcf, 0x23, 0x4e, 0xc3, 0x6d, 0x6b, 0xca,
+	0x57, 0x32, 0xb3, 0xdc, 0x6b, 0x6b, 0xce, 0xcf, 0xf5, 0xd5, 0x92, 0xd6, 0x61, 0x35, 0x11, 0x3c,
+	0x86, 0xc8, 0x21, 0xc4, 0xf5, 0x71, 0x88, 0x54, 0x7d, 0xcc, 0x83, 0xd1, 0xff, 0x08, 0xad, 0x18,
+	0xa6, 0x6c, 0x31, 0x7f, 0x7e, 0x18, 0xb9, 0x72, 0x50, 0x5a, 0xdc, 0x22, 0xdb, 0x4b, 0xb6, 0x5e,
+	0xd1, 0x0d, 0x58, 0x71, 0xfd, 0x3e, 0xf7, 0xa5, 0x08, 0x06, 0xa5, 0x25, 0xdc, 0x4a, 0x0c, 0xf4,
+	0x1a, 0x14, 0xa5, 0x90, 0xcc, 0x6b, 0x84, 0x1d, 0x16, 0xf0, 0xb0, 0xb4, 0x8c, 0x0e, 0x05, 0xb4,
+	0x1d, 0xa0, 0x89, 0xee, 0x43, 0x51, 0x9d, 0x54, 0x17, 0x28, 0x8f, 0x54, 0x37, 0x33, 0x4f, 0x3b,
+	0x56, 0xa5, 0x42, 0x3f, 0x31, 0x19, 0x0d, 0x78, 0x09, 0x95, 0xdc, 0xf3, 0x3c, 0xf4, 0x1c, 0x36,
+	0x05, 0xfd, 0x00, 0x20, 0xe9, 0x62, 0x2d, 0xe7, 0x75, 0x53, 0x77, 0x6e, 0xdc, 0xf2, 0xa6, 0xba,
+	0x53, 0xba, 0xe5, 0xcd, 0x07, 0xcc, 0xe1, 0x3a, 0xd6, 0x4e, 0x45, 0x1a, 0x3f, 0x12, 0xb8, 0x34,
+	0x99, 0x41, 0xd7, 0xeb, 0x1d, 0xc8, 0x23, 0x95, 0xb8, 0xc1, 0x16, 0xa7, 0xa5, 0x56, 0xfc, 0xa7,
+	0xeb, 0x6c, 0xeb, 0x28, 0xfa, 0xe1, 0x18, 0x45, 0x55, 0xae, 0x1b, 0xe7, 0x52, 0xd4, 0x20, 0x69,
+	0x8e, 0xbf, 0x10, 0xb8, 0x8c, 0x79, 0xee, 0x7f, 0xe1, 0xf3, 0x40, 0x69, 0xfc, 0xf4, 0xdb, 0x76,
+	0x42, 0xd2, 0xc5, 0xff, 0x2d, 0x69, 0x08, 0x90, 0x10, 0xa5, 0x26, 0x2c, 0x8b, 0x78, 0x85, 0x0c,
+	0x57, 0x6a, 0xa5, 0xdf, 0x7f, 0xbd, 0xbd, 0xa6, 0x31, 0xf7, 0xda, 0xed, 0x80, 0x87, 0xe1, 0x81,
+	0x0c, 0x5c, 0xdf, 0xb1, 0x95, 0x1b, 0x7d, 0x1d, 0xf2, 0xba, 0xb1, 0x94, 0x62, 0x33, 0x8f, 0xf4,
+	0x51, 0xd4, 0xd5, 0x32, 0x68, 0x67, 0xe3, 0x67, 0x02, 0xa5, 0x69, 0x8d, 0x74, 0x25, 0xf7, 0xa0,
+	0x88, 0xe0, 0xc3, 0x96, 0x55, 0xf5, 0xac, 0xcc, 0x42, 0x4e, 0xc2, 0xed, 0x82, 0x48, 0xa0, 0x9e,
+	0x5e, 0x31, 0x7f, 0x20, 0xb0, 0x81, 0x44, 0x6d, 0xde, 0xe6, 0xbc, 0xcb, 0x9a, 0x1e, 0xdf, 0xeb,
+	0xc6, 0x17, 0xf2, 0x19, 0x54, 0x74, 0xa4, 0xfd, 0xe2, 0x5c, 0xda, 0x1b, 0x8f, 0x72, 0x70, 0x35,
+	0x83, 0x9b, 0x56, 0xf2, 0xcd, 0x51, 0x75, 0xc8, 0x1c, 0xd5, 0x19, 0xce, 0x5d, 0x15, 0x42, 0xbf,
+	0x21, 0xb0, 0x1e, 0x8c, 0x90, 0x1b, 0x87, 0x91, 0x90, 0xf1, 0x97, 0xf9, 0x32, 0xea, 0xaa, 0x72,
+	0x17, 0x6b, 0xfb, 0x71, 0xc4, 0x5f, 0x4f, 0x36, 0xdf, 0x73, 0x5c, 0xd9, 0x89, 0x9a, 0x66, 0x4b,
+	0x74, 0xad, 0xf1, 0x57, 0xe3, 0xb5, 0xdb, 0xad, 0x0e, 0x73, 0x7d, 0x6b, 0x64, 0x69, 0xc7, 0x1a,
+	0x84, 0xe6, 0x01, 0x0f, 0x5c, 0xe6, 0xb9, 0x5f, 0xc6, 0xd8, 0xf7, 0x7c, 0x69, 0x5f, 0x4e, 0x52,
+	0xd5, 0xe3, 0x4c, 0x75, 0x9d, 0x88, 0x9a, 0x70, 0x31, 0xf2, 0x3d, 0xd1, 0xfa, 0xbc, 0xd1, 0xc4,
+	0x6f, 0x87, 0xbb, 0x4e, 0x47, 0xa2, 0x46, 0xab, 0xf6, 0x05, 0xb5, 0x55, 0x8b, 0x3f, 0xfb, 0xb8,
+	0xb1, 0xfb, 0x55, 0x1e, 0x96, 0x51, 0x15, 0x7a, 0x0c, 0x79, 0x35, 0x97, 0x68, 0xf6, 0x2c, 0x18,
+	0x7b, 0xbb, 0xca, 0x37, 0xce, 0xf5, 0x53, 0xc2, 0x1a, 0xc6, 0xd7, 0x7f, 0xfc, 0xf3, 0x28, 0xb7,
+	0x41, 0xcb, 0x56, 0xe6, 0x23, 0x4a, 0xbf, 0x23, 0xb0, 0x8c, 0xa5, 0xa7, 0x2f, 0x9f, 0x37, 0x8a,
+	0x54, 0xf6, 0x39, 0x27, 0x96, 0x51, 0xc5, 0xe4, 0xb7, 0xe8, 0x8e, 0x95, 0xf5, 0x40, 0x5b, 0x47,
+	0xb1, 0xc8, 0xc7, 0xd6, 0x91, 0xea, 0xac, 0x63, 0xfa, 0x2d, 0x81, 0x95, 0xd1, 0xc8, 0xa4, 0x3b,
+	0x99, 0x89, 0x26, 0x07, 0x77, 0xf9, 0xe6, 0x3c, 0xae, 0x9a, 0xd7, 0x35, 0xe4, 0x75, 0x85, 0xae,
+	0x67, 0xf2, 0xa2, 0x3f, 0x11, 0x28, 0xa4, 0xae, 0x3c, 0xbd, 0x95, 0x09, 0x3f, 0x3d, 0x3c, 0xcb,
+	0xaf, 0xcc, 0xe7, 0xac, 0xd9, 0xdc, 0x45, 0x36, 0xbb, 0xf4, 0xce, 0x2c, 0x36, 0xe9, 0xf9, 0x32,
+	0x25, 0xd6, 0x6f, 0x04, 0x5e, 0x9c, 0xbc, 0x52, 0xf4, 0x4e, 0x66, 0xf2, 0x8c, 0xc9, 0x50, 0xae,
+	0xfe, 0x87, 0x08, 0xcd, 0xf9, 0x7d, 0xe4, 0xfc, 0x2e, 0x7d, 0x7b, 0x16, 0xe7, 0xd4, 0x5d, 0x64,
+	0x18, 0x36, 0x49, 0xdc, 0x3a, 0xc2, 0x63, 0x1d, 0xd7, 0xea, 0x27, 0xa7, 0x15, 0xf2, 0xf8, 0xb4,
+	0x42, 0xfe, 0x3e, 0xad, 0x90, 0xef, 0xcf, 0x2a, 0x0b, 0x8f, 0xcf, 0x2a, 0x0b, 0x7f, 0x9e, 0x55,
+	0x16, 0x3e, 0x7d, 0x63, 0xfe, 0x7b, 0xfa, 0x50, 0xa7, 0xc5, 0xeb, 0xda, 0xcc, 0xa3, 0xfd, 0xd5,
+	0x7f, 0x07, 0x00, 0x92, 0x84, 0xee, 0x51, 0x0f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -596,6 +743,8 @@ type QueryClient interface {
 	AllVaults(ctx context.Context, in *QueryAllVaultsRequest, opts ...grpc.CallOption) (*QueryAllVaultsResponse, error)
 	// Queries owner shares of a vault.
 	OwnerShares(ctx context.Context, in *QueryOwnerSharesRequest, opts ...grpc.CallOption) (*QueryOwnerSharesResponse, error)
+	// Queries the amount an owner can redeem from a vault.
+	RedeemableAmount(ctx context.Context, in *QueryRedeemableAmountRequest, opts ...grpc.CallOption) (*QueryRedeemableAmountResponse, error)
 }
 
 type queryClient struct {
@@ -642,6 +791,15 @@ func (c *queryClient) OwnerShares(ctx context.Context, in *QueryOwnerSharesReque
 	return out, nil
 }
 
+func (c *queryClient) RedeemableAmount(ctx context.Context, in *QueryRedeemableAmountRequest, opts ...grpc.CallOption) (*QueryRedeemableAmountResponse, error) {
+	out := new(QueryRedeemableAmountResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Query/RedeemableAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the Params.
@@ -652,6 +810,8 @@ type QueryServer interface {
 	AllVaults(context.Context, *QueryAllVaultsRequest) (*QueryAllVaultsResponse, error)
 	// Queries owner shares of a vault.
 	OwnerShares(context.Context, *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error)
+	// Queries the amount an owner can redeem from a vault.
+	RedeemableAmount(context.Context, *QueryRedeemableAmountRequest) (*QueryRedeemableAmountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -670,6 +830,9 @@ func (*UnimplementedQueryServer) AllVaults(ctx context.Context, req *QueryAllVau
 func (*UnimplementedQueryServer) OwnerShares(ctx context.Context, req *QueryOwnerSharesRequest) (*QueryOwnerSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OwnerShares not implemented")
 }
+func (*UnimplementedQueryServer) RedeemableAmount(ctx context.Context, req *QueryRedeemableAmountRequest) (*QueryRedeemableAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemableAmount not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -747,6 +910,24 @@ func _Query_OwnerShares_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RedeemableAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedeemableAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedeemableAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Query/RedeemableAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedeemableAmount(ctx, req.(*QueryRedeemableAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.vault.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -767,6 +948,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OwnerShares",
 			Handler:    _Query_OwnerShares_Handler,
 		},
+		{
+			MethodName: "RedeemableAmount",
+			Handler:    _Query_RedeemableAmount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/vault/query.proto",
@@ -881,6 +1066,16 @@ func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.VaultParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.TotalShares != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TotalShares))
 		i--
@@ -1139,6 +1334,94 @@ func (m *QueryOwnerSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRedeemableAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedeemableAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedeemableAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedeemableAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedeemableAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedeemableAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnlockBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RedeemableQuoteQuantums.Size()
+		i -= size
+		if _, err := m.RedeemableQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1204,6 +1487,8 @@ func (m *QueryVaultResponse) Size() (n int) {
 	if m.TotalShares != 0 {
 		n += 1 + sovQuery(uint64(m.TotalShares))
 	}
+	l = m.VaultParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1294,6 +1579,41 @@ func (m *QueryOwnerSharesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRedeemableAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedeemableAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RedeemableQuoteQuantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UnlockBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UnlockBlockHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1673,6 +1993,39 @@ func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2262,6 +2615,261 @@ func (m *QueryOwnerSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRedeemableAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedeemableAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedeemableAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VaultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedeemableAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedeemableAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedeemableAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemableQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemableQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockBlockHeight", wireType)
+			}
+			m.UnlockBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockBlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -126,6 +126,111 @@ func (m *MsgDepositToVaultResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositToVaultResponse proto.InternalMessageInfo
 
+// MsgWithdrawFromVault is the Msg/WithdrawFromVault request type.
+type MsgWithdrawFromVault struct {
+	// The vault to withdraw from.
+	VaultId *VaultId `protobuf:"bytes,1,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	// The subaccount to withdraw to. The owner of the subaccount must own
+	// the shares being redeemed.
+	SubaccountId *types.SubaccountId `protobuf:"bytes,2,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id,omitempty"`
+	// Number of shares to redeem.
+	Shares *NumShares `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *MsgWithdrawFromVault) Reset()         { *m = MsgWithdrawFromVault{} }
+func (m *MsgWithdrawFromVault) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromVault) ProtoMessage()    {}
+func (*MsgWithdrawFromVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{2}
+}
+func (m *MsgWithdrawFromVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromVault.Merge(m, src)
+}
+func (m *MsgWithdrawFromVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromVault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromVault proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromVault) GetVaultId() *VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return nil
+}
+
+func (m *MsgWithdrawFromVault) GetSubaccountId() *types.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
+	}
+	return nil
+}
+
+func (m *MsgWithdrawFromVault) GetShares() *NumShares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// MsgWithdrawFromVaultResponse is the Msg/WithdrawFromVault response type.
+type MsgWithdrawFromVaultResponse struct {
+	// Number of quote quantums transferred to the subaccount, net of the
+	// withdrawal fee.
+	RedeemedQuoteQuantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,1,opt,name=redeemed_quote_quantums,json=redeemedQuoteQuantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"redeemed_quote_quantums"`
+}
+
+func (m *MsgWithdrawFromVaultResponse) Reset()         { *m = MsgWithdrawFromVaultResponse{} }
+func (m *MsgWithdrawFromVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromVaultResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{3}
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromVaultResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromVaultResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
@@ -137,7 +242,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced574c6017ce006, []int{2}
+	return fileDescriptor_ced574c6017ce006, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +293,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ced574c6017ce006, []int{3}
+	return fileDescriptor_ced574c6017ce006, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,49 +322,163 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetVaultParams is the Msg/SetVaultParams request type.
+type MsgSetVaultParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The vault to set parameters of.
+	VaultId VaultId `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id"`
+	// The parameters to set.
+	VaultParams VaultParams `protobuf:"bytes,3,opt,name=vault_params,json=vaultParams,proto3" json:"vault_params"`
+}
+
+func (m *MsgSetVaultParams) Reset()         { *m = MsgSetVaultParams{} }
+func (m *MsgSetVaultParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultParams) ProtoMessage()    {}
+func (*MsgSetVaultParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{6}
+}
+func (m *MsgSetVaultParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVaultParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultParams.Merge(m, src)
+}
+func (m *MsgSetVaultParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVaultParams proto.InternalMessageInfo
+
+func (m *MsgSetVaultParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetVaultParams) GetVaultId() VaultId {
+	if m != nil {
+		return m.VaultId
+	}
+	return VaultId{}
+}
+
+func (m *MsgSetVaultParams) GetVaultParams() VaultParams {
+	if m != nil {
+		return m.VaultParams
+	}
+	return VaultParams{}
+}
+
+// MsgSetVaultParamsResponse is the Msg/SetVaultParams response type.
+type MsgSetVaultParamsResponse struct {
+}
+
+func (m *MsgSetVaultParamsResponse) Reset()         { *m = MsgSetVaultParamsResponse{} }
+func (m *MsgSetVaultParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultParamsResponse) ProtoMessage()    {}
+func (*MsgSetVaultParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ced574c6017ce006, []int{7}
+}
+func (m *MsgSetVaultParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVaultParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVaultParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultParamsResponse.Merge(m, src)
+}
+func (m *MsgSetVaultParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVaultParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDepositToVault)(nil), "dydxprotocol.vault.MsgDepositToVault")
 	proto.RegisterType((*MsgDepositToVaultResponse)(nil), "dydxprotocol.vault.MsgDepositToVaultResponse")
+	proto.RegisterType((*MsgWithdrawFromVault)(nil), "dydxprotocol.vault.MsgWithdrawFromVault")
+	proto.RegisterType((*MsgWithdrawFromVaultResponse)(nil), "dydxprotocol.vault.MsgWithdrawFromVaultResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "dydxprotocol.vault.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dydxprotocol.vault.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetVaultParams)(nil), "dydxprotocol.vault.MsgSetVaultParams")
+	proto.RegisterType((*MsgSetVaultParamsResponse)(nil), "dydxprotocol.vault.MsgSetVaultParamsResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/vault/tx.proto", fileDescriptor_ced574c6017ce006) }
 
 var fileDescriptor_ced574c6017ce006 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x5b, 0x54, 0xe8, 0x92, 0x06, 0xb1, 0xaa, 0xd4, 0xc4, 0x91, 0x9c, 0x2a, 0x08, 0x54,
-	0x40, 0xb1, 0x45, 0x41, 0x05, 0xf5, 0x04, 0x11, 0x07, 0x22, 0x14, 0x89, 0x3a, 0xc0, 0x81, 0x4b,
-	0xd8, 0x78, 0x17, 0xc7, 0x52, 0xec, 0x75, 0x3d, 0xeb, 0x28, 0xe1, 0xc8, 0x17, 0x20, 0x71, 0xe6,
-	0x1f, 0x38, 0xf0, 0x11, 0xbd, 0x51, 0x71, 0x42, 0x1c, 0x2a, 0x94, 0x1c, 0xf8, 0x0d, 0xe4, 0xb5,
-	0xdd, 0xd8, 0x89, 0x91, 0x72, 0x49, 0x66, 0x67, 0xde, 0xec, 0x7b, 0xf3, 0x76, 0x8c, 0xea, 0x74,
-	0x4a, 0x27, 0x7e, 0xc0, 0x05, 0xb7, 0xf8, 0xc8, 0x18, 0x93, 0x70, 0x24, 0x0c, 0x31, 0xd1, 0x65,
-	0x06, 0xe3, 0x6c, 0x51, 0x97, 0x45, 0xb5, 0x66, 0x71, 0x70, 0x39, 0xf4, 0x65, 0xda, 0x88, 0x0f,
-	0x31, 0x5c, 0xdd, 0x8b, 0x4f, 0x86, 0x0b, 0xb6, 0x31, 0x7e, 0x10, 0xfd, 0x25, 0x85, 0xbb, 0x39,
-	0x12, 0x08, 0x07, 0xc4, 0xb2, 0x78, 0xe8, 0x09, 0xc8, 0xc4, 0x09, 0xb4, 0x51, 0xa0, 0xc7, 0x27,
-	0x01, 0x71, 0x53, 0x12, 0xad, 0x00, 0x20, 0x7f, 0x93, 0xfa, 0xae, 0xcd, 0x6d, 0x1e, 0x8b, 0x8b,
-	0xa2, 0x38, 0xdb, 0xfc, 0xba, 0x81, 0x6e, 0x76, 0xc1, 0x7e, 0xce, 0x7c, 0x0e, 0x8e, 0x78, 0xcd,
-	0xdf, 0x46, 0x1d, 0xf8, 0x08, 0x5d, 0x93, 0xad, 0x7d, 0x87, 0x56, 0x95, 0x7d, 0xe5, 0xe0, 0xfa,
-	0x61, 0x5d, 0x5f, 0x1d, 0x59, 0x97, 0xe0, 0x0e, 0x35, 0xaf, 0x8e, 0xe3, 0x00, 0xbf, 0x44, 0x3b,
-	0x0b, 0xe1, 0x51, 0xf3, 0x86, 0x6c, 0xbe, 0x93, 0x6f, 0xce, 0xcc, 0xa9, 0xf7, 0x2e, 0xe3, 0x0e,
-	0x35, 0xcb, 0x90, 0x39, 0x61, 0x8e, 0x2a, 0xa7, 0x21, 0x17, 0xac, 0x7f, 0x1a, 0x12, 0x4f, 0x84,
-	0x2e, 0x54, 0x37, 0xf7, 0x95, 0x83, 0x72, 0xfb, 0xc5, 0xd9, 0x45, 0xa3, 0xf4, 0xfb, 0xa2, 0xf1,
-	0xd4, 0x76, 0xc4, 0x30, 0x1c, 0xe8, 0x16, 0x77, 0x8d, 0xfc, 0xec, 0x8f, 0x5a, 0xd6, 0x90, 0x38,
-	0x9e, 0x71, 0x99, 0xa1, 0x62, 0xea, 0x33, 0xd0, 0x7b, 0x2c, 0x70, 0xc8, 0xc8, 0xf9, 0x48, 0x06,
-	0x23, 0xd6, 0xf1, 0x84, 0xb9, 0x23, 0xef, 0x3f, 0x49, 0xae, 0x3f, 0xc6, 0x9f, 0xfe, 0x7e, 0xbb,
-	0x97, 0x1f, 0xa0, 0x59, 0x47, 0xb5, 0x15, 0x7b, 0x4c, 0x06, 0x3e, 0xf7, 0x80, 0x35, 0xbf, 0x28,
-	0xe8, 0x46, 0x17, 0xec, 0x37, 0x3e, 0x25, 0x82, 0xbd, 0x92, 0x8f, 0x81, 0x8f, 0xd0, 0x36, 0x09,
-	0xc5, 0x90, 0x07, 0x8e, 0x98, 0x4a, 0xef, 0xb6, 0xdb, 0xd5, 0x9f, 0xdf, 0x5b, 0xbb, 0xc9, 0x42,
-	0x3c, 0xa3, 0x34, 0x60, 0x00, 0x3d, 0x11, 0x38, 0x9e, 0x6d, 0x2e, 0xa0, 0xf8, 0x09, 0xda, 0x8a,
-	0x9f, 0x33, 0xf1, 0x4c, 0x2d, 0x32, 0x3c, 0xe6, 0x68, 0x5f, 0x89, 0x1c, 0x30, 0x13, 0xfc, 0x71,
-	0x25, 0x92, 0xbd, 0xb8, 0xa9, 0x59, 0x43, 0x7b, 0x4b, 0xa2, 0x52, 0xc1, 0x87, 0x3f, 0x14, 0xb4,
-	0xd9, 0x05, 0x1b, 0x7f, 0x40, 0x95, 0xa5, 0x17, 0xbf, 0x5d, 0x44, 0xb7, 0x32, 0xb9, 0xda, 0x5a,
-	0x0b, 0x96, 0xf2, 0xe1, 0xf7, 0xa8, 0x9c, 0x33, 0xe7, 0xd6, 0x7f, 0xda, 0xb3, 0x20, 0xf5, 0xfe,
-	0x1a, 0xa0, 0x94, 0xa1, 0x7d, 0x72, 0x36, 0xd3, 0x94, 0xf3, 0x99, 0xa6, 0xfc, 0x99, 0x69, 0xca,
-	0xe7, 0xb9, 0x56, 0x3a, 0x9f, 0x6b, 0xa5, 0x5f, 0x73, 0xad, 0xf4, 0xee, 0xf1, 0xfa, 0xeb, 0x31,
-	0x49, 0xbf, 0xef, 0x68, 0x4b, 0x06, 0x5b, 0x32, 0xff, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x67, 0x36, 0x31, 0xb6, 0x02, 0x04, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0xb4, 0x52, 0xed, 0x34, 0x8d, 0x74, 0xa9, 0xb4, 0xdd, 0xea, 0xa6, 0x44, 0x94, 0xaa,
+	0x64, 0x57, 0xab, 0x56, 0x29, 0x1e, 0x34, 0x88, 0xb4, 0x48, 0xc4, 0x6e, 0xfc, 0x00, 0x2f, 0x71,
+	0x92, 0x99, 0x6e, 0x16, 0xb2, 0x99, 0x74, 0x67, 0x36, 0xb6, 0x9e, 0xc4, 0x5f, 0x20, 0x78, 0xf6,
+	0xe0, 0xcd, 0xa3, 0x07, 0x7f, 0x44, 0x8f, 0xc5, 0x93, 0x78, 0x28, 0xd2, 0x80, 0xfe, 0x0d, 0xd9,
+	0x99, 0xdd, 0xcd, 0x6e, 0x76, 0x83, 0x01, 0x3d, 0x78, 0x49, 0x66, 0xde, 0x79, 0xde, 0xaf, 0xe7,
+	0x7d, 0x9f, 0x04, 0x2e, 0xe3, 0x7d, 0xbc, 0xd7, 0x75, 0x29, 0xa7, 0x4d, 0xda, 0x36, 0x7a, 0xc8,
+	0x6b, 0x73, 0x83, 0xef, 0xe9, 0xc2, 0xa2, 0x28, 0xf1, 0x47, 0x5d, 0x3c, 0xaa, 0x4b, 0x4d, 0xca,
+	0x1c, 0xca, 0xea, 0xc2, 0x6c, 0xc8, 0x8b, 0x84, 0xab, 0x0b, 0xf2, 0x66, 0x38, 0xcc, 0x32, 0x7a,
+	0xd7, 0xfc, 0xaf, 0xe0, 0xe1, 0x52, 0x22, 0x09, 0xf3, 0x1a, 0xa8, 0xd9, 0xa4, 0x5e, 0x87, 0xb3,
+	0xd8, 0x39, 0x80, 0x16, 0x33, 0xea, 0xe9, 0x22, 0x17, 0x39, 0x61, 0x12, 0x2d, 0x03, 0x20, 0x3e,
+	0x83, 0xf7, 0x79, 0x8b, 0x5a, 0x54, 0x16, 0xe7, 0x9f, 0xa4, 0xb5, 0xf4, 0x61, 0x02, 0xce, 0x55,
+	0x99, 0x75, 0x9f, 0x74, 0x29, 0xb3, 0xf9, 0x13, 0xfa, 0xcc, 0xf7, 0x50, 0xd6, 0xe1, 0x29, 0xe1,
+	0x5a, 0xb7, 0xf1, 0x22, 0x58, 0x01, 0xab, 0x33, 0x6b, 0xcb, 0x7a, 0xba, 0x65, 0x5d, 0x80, 0xb7,
+	0xb0, 0x79, 0xb2, 0x27, 0x0f, 0xca, 0x43, 0x38, 0x3b, 0x28, 0xdc, 0x77, 0x9e, 0x10, 0xce, 0x17,
+	0x93, 0xce, 0xb1, 0x3e, 0xf5, 0x5a, 0x74, 0xde, 0xc2, 0x66, 0x9e, 0xc5, 0x6e, 0x0a, 0x85, 0x85,
+	0x5d, 0x8f, 0x72, 0x52, 0xdf, 0xf5, 0x50, 0x87, 0x7b, 0x0e, 0x5b, 0x9c, 0x5c, 0x01, 0xab, 0xf9,
+	0xca, 0xe6, 0xc1, 0x51, 0x31, 0xf7, 0xfd, 0xa8, 0x78, 0xd7, 0xb2, 0x79, 0xcb, 0x6b, 0xe8, 0x4d,
+	0xea, 0x18, 0xc9, 0xde, 0x6f, 0x94, 0x9b, 0x2d, 0x64, 0x77, 0x8c, 0xc8, 0x82, 0xf9, 0x7e, 0x97,
+	0x30, 0xbd, 0x46, 0x5c, 0x1b, 0xb5, 0xed, 0xd7, 0xa8, 0xd1, 0x26, 0x5b, 0x1d, 0x6e, 0xce, 0x8a,
+	0xf8, 0xdb, 0x41, 0xf8, 0x0d, 0xe5, 0xed, 0xaf, 0xcf, 0x97, 0x93, 0x0d, 0x94, 0x96, 0xe1, 0x52,
+	0x8a, 0x1e, 0x93, 0xb0, 0x2e, 0xed, 0x30, 0x52, 0xfa, 0x09, 0xe0, 0x7c, 0x95, 0x59, 0xcf, 0x6d,
+	0xde, 0xc2, 0x2e, 0x7a, 0xf5, 0xc0, 0xa5, 0xce, 0x7f, 0xc4, 0xdf, 0x4d, 0x38, 0xc5, 0x5a, 0xc8,
+	0x25, 0x92, 0xb7, 0x99, 0xb5, 0x73, 0x59, 0x25, 0x3c, 0xf2, 0x9c, 0x9a, 0x00, 0x99, 0x01, 0x38,
+	0x93, 0x85, 0x8f, 0x00, 0x9e, 0xcd, 0x6a, 0x34, 0x64, 0x42, 0x79, 0x03, 0xe0, 0x82, 0x4b, 0x30,
+	0x21, 0x0e, 0xc1, 0xf5, 0xa1, 0xa9, 0x81, 0x7f, 0x3c, 0xb5, 0x33, 0x61, 0xa2, 0xed, 0xf8, 0xf4,
+	0x4a, 0xef, 0x01, 0x3c, 0x5d, 0x65, 0xd6, 0xd3, 0x2e, 0x46, 0x9c, 0x3c, 0x16, 0xca, 0x50, 0xd6,
+	0xe1, 0x34, 0xf2, 0x78, 0x8b, 0xba, 0x36, 0xdf, 0x17, 0x75, 0x4c, 0x57, 0x16, 0xbf, 0x7e, 0x29,
+	0xcf, 0x07, 0xea, 0xbc, 0x87, 0xb1, 0x4b, 0x18, 0xab, 0x71, 0xd7, 0xee, 0x58, 0xe6, 0x00, 0xaa,
+	0xdc, 0x86, 0x53, 0x52, 0x5b, 0xc1, 0x00, 0xd4, 0x2c, 0xea, 0x64, 0x8e, 0xca, 0x09, 0xbf, 0x31,
+	0x33, 0xc0, 0x6f, 0x14, 0x7c, 0xf6, 0x06, 0x91, 0x4a, 0x4b, 0x70, 0x61, 0xa8, 0xa8, 0x68, 0x7b,
+	0xfa, 0x40, 0x48, 0xaf, 0x46, 0xb8, 0xe0, 0xf2, 0x2f, 0x4b, 0xbe, 0x13, 0x5b, 0xb9, 0x89, 0x3f,
+	0xae, 0x5c, 0x50, 0x75, 0xb4, 0x78, 0x9b, 0x30, 0x2f, 0xbd, 0x83, 0xb6, 0xe5, 0xc6, 0x14, 0x47,
+	0x46, 0x48, 0xf4, 0x3e, 0xd3, 0x1b, 0x98, 0x52, 0x04, 0x48, 0x01, 0x25, 0x9b, 0x0c, 0x29, 0x58,
+	0xfb, 0x34, 0x09, 0x27, 0xab, 0xcc, 0x52, 0x76, 0x60, 0x61, 0xe8, 0x17, 0xe8, 0x42, 0x56, 0xea,
+	0x94, 0x12, 0xd5, 0xf2, 0x58, 0xb0, 0x68, 0x4d, 0x29, 0x9c, 0x4b, 0x8b, 0x75, 0x75, 0x44, 0x8c,
+	0x14, 0x52, 0xbd, 0x3a, 0x2e, 0x32, 0x4a, 0xf8, 0x12, 0xe6, 0x13, 0x0b, 0x79, 0x7e, 0x44, 0x84,
+	0x38, 0x48, 0xbd, 0x32, 0x06, 0x28, 0xca, 0xb0, 0x03, 0x0b, 0x43, 0x1b, 0x34, 0x8a, 0xba, 0x24,
+	0x4c, 0x2d, 0x8f, 0x05, 0x0b, 0xf3, 0x54, 0xb6, 0x0f, 0x8e, 0x35, 0x70, 0x78, 0xac, 0x81, 0x1f,
+	0xc7, 0x1a, 0x78, 0xd7, 0xd7, 0x72, 0x87, 0x7d, 0x2d, 0xf7, 0xad, 0xaf, 0xe5, 0x5e, 0xdc, 0x1a,
+	0x5f, 0xd1, 0x7b, 0xe1, 0x1f, 0xa9, 0x2f, 0xec, 0xc6, 0x94, 0xb0, 0x5f, 0xff, 0x3d, 0x00, 0xfd,
+	0xaa, 0x97, 0xd5, 0x6b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -276,8 +495,12 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// DepositToVault deposits funds into a vault.
 	DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault redeems shares of a vault for quote quantums.
+	WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error)
 	// UpdateParams updates the Params in state.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetVaultParams sets the parameters of a specific vault.
+	SetVaultParams(ctx context.Context, in *MsgSetVaultParams, opts ...grpc.CallOption) (*MsgSetVaultParamsResponse, error)
 }
 
 type msgClient struct {
@@ -297,6 +520,15 @@ func (c *msgClient) DepositToVault(ctx context.Context, in *MsgDepositToVault, o
 	return out, nil
 }
 
+func (c *msgClient) WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error) {
+	out := new(MsgWithdrawFromVaultResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/WithdrawFromVault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/UpdateParams", in, out, opts...)
@@ -306,12 +538,25 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetVaultParams(ctx context.Context, in *MsgSetVaultParams, opts ...grpc.CallOption) (*MsgSetVaultParamsResponse, error) {
+	out := new(MsgSetVaultParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.vault.Msg/SetVaultParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// DepositToVault deposits funds into a vault.
 	DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault redeems shares of a vault for quote quantums.
+	WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error)
 	// UpdateParams updates the Params in state.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetVaultParams sets the parameters of a specific vault.
+	SetVaultParams(context.Context, *MsgSetVaultParams) (*MsgSetVaultParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -321,9 +566,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) DepositToVault(ctx context.Context, req *MsgDepositToVault) (*MsgDepositToVaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositToVault not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawFromVault(ctx context.Context, req *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromVault not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetVaultParams(ctx context.Context, req *MsgSetVaultParams) (*MsgSetVaultParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVaultParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -347,6 +598,24 @@ func _Msg_DepositToVault_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/WithdrawFromVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromVault(ctx, req.(*MsgWithdrawFromVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -365,6 +634,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetVaultParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVaultParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVaultParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.vault.Msg/SetVaultParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVaultParams(ctx, req.(*MsgSetVaultParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.vault.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -373,10 +660,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositToVault",
 			Handler:    _Msg_DepositToVault_Handler,
 		},
+		{
+			MethodName: "WithdrawFromVault",
+			Handler:    _Msg_WithdrawFromVault_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetVaultParams",
+			Handler:    _Msg_SetVaultParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/vault/tx.proto",
@@ -462,6 +757,98 @@ func (m *MsgDepositToVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawFromVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shares != nil {
+		{
+			size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubaccountId != nil {
+		{
+			size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VaultId != nil {
+		{
+			size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedeemedQuoteQuantums.Size()
+		i -= size
+		if _, err := m.RedeemedQuoteQuantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -525,15 +912,88 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *MsgSetVaultParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VaultParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.VaultId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgDepositToVault) Size() (n int) {
@@ -564,6 +1024,38 @@ func (m *MsgDepositToVaultResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawFromVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VaultId != nil {
+		l = m.VaultId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubaccountId != nil {
+		l = m.SubaccountId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Shares != nil {
+		l = m.Shares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedeemedQuoteQuantums.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -588,6 +1080,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetVaultParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VaultId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.VaultParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetVaultParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -799,7 +1317,7 @@ func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,17 +1340,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -842,27 +1360,31 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if m.VaultId == nil {
+				m.VaultId = &VaultId{}
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -889,7 +1411,46 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SubaccountId == nil {
+				m.SubaccountId = &types.SubaccountId{}
+			}
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shares == nil {
+				m.Shares = &NumShares{}
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -914,7 +1475,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -937,10 +1498,406 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedQuoteQuantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemedQuoteQuantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVaultParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVaultParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVaultParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVaultParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVaultParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVaultParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -52,6 +52,42 @@ func (VaultType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_32accb5830bb2860, []int{0}
 }
 
+// VaultStatus represents the status of a vault.
+type VaultStatus int32
+
+const (
+	// Default value, invalid and unused.
+	VaultStatus_VAULT_STATUS_UNSPECIFIED VaultStatus = 0
+	// Vault places orders on both sides of the book.
+	VaultStatus_VAULT_STATUS_QUOTING VaultStatus = 1
+	// Vault does not place orders and cancels its resting orders.
+	VaultStatus_VAULT_STATUS_STAND_BY VaultStatus = 2
+	// Vault only places orders that reduce its position.
+	VaultStatus_VAULT_STATUS_CLOSE_ONLY VaultStatus = 3
+)
+
+var VaultStatus_name = map[int32]string{
+	0: "VAULT_STATUS_UNSPECIFIED",
+	1: "VAULT_STATUS_QUOTING",
+	2: "VAULT_STATUS_STAND_BY",
+	3: "VAULT_STATUS_CLOSE_ONLY",
+}
+
+var VaultStatus_value = map[string]int32{
+	"VAULT_STATUS_UNSPECIFIED": 0,
+	"VAULT_STATUS_QUOTING":     1,
+	"VAULT_STATUS_STAND_BY":    2,
+	"VAULT_STATUS_CLOSE_ONLY":  3,
+}
+
+func (x VaultStatus) String() string {
+	return proto.EnumName(VaultStatus_name, int32(x))
+}
+
+func (VaultStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32accb5830bb2860, []int{1}
+}
+
 // VaultId uniquely identifies a vault by its type and number.
 type VaultId struct {
 	// Type of the vault.
@@ -148,6 +184,7 @@ var xxx_messageInfo_NumShares proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("dydxprotocol.vault.VaultType", VaultType_name, VaultType_value)
+	proto.RegisterEnum("dydxprotocol.vault.VaultStatus", VaultStatus_name, VaultStatus_value)
 	proto.RegisterType((*VaultId)(nil), "dydxprotocol.vault.VaultId")
 	proto.RegisterType((*NumShares)(nil), "dydxprotocol.vault.NumShares")
 }
@@ -155,26 +192,31 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/vault/vault.proto", fileDescriptor_32accb5830bb2860) }
 
 var fileDescriptor_32accb5830bb2860 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xa9, 0x4c, 0xa9,
-	0x28, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x90,
-	0x7a, 0x60, 0x41, 0x21, 0x21, 0x64, 0x79, 0x3d, 0xb0, 0x8c, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x58, 0x4c, 0x1f, 0xc4, 0x82, 0xa8, 0x54, 0x0a, 0xe1, 0x62, 0x0f, 0x03, 0x49, 0x7b, 0xa6, 0x08,
-	0x19, 0x72, 0xb1, 0x94, 0x54, 0x16, 0xa4, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x19, 0xc9, 0xea,
-	0x61, 0x9a, 0xa1, 0x07, 0x56, 0x1a, 0x52, 0x59, 0x90, 0x1a, 0x04, 0x56, 0x2a, 0x24, 0xc6, 0xc5,
-	0x96, 0x57, 0x9a, 0x9b, 0x94, 0x5a, 0x24, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1b, 0x04, 0xe5, 0x29,
-	0x95, 0x70, 0x71, 0xfa, 0x95, 0xe6, 0x06, 0x67, 0x24, 0x16, 0xa5, 0x16, 0x0b, 0xa5, 0x73, 0x71,
-	0xe5, 0x95, 0xe6, 0xc6, 0x17, 0x83, 0x79, 0x60, 0x85, 0x3c, 0x4e, 0x1e, 0x27, 0xee, 0xc9, 0x33,
-	0xdc, 0xba, 0x27, 0xef, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f,
-	0xea, 0x27, 0x13, 0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0xb8, 0x48, 0x0a, 0xc8, 0xc6, 0x62,
-	0xbd, 0xe0, 0xd4, 0xa2, 0xcc, 0xc4, 0x9c, 0xcc, 0xaa, 0xc4, 0xa4, 0x9c, 0x54, 0xcf, 0xbc, 0x92,
-	0x20, 0xce, 0x3c, 0x98, 0x45, 0x5a, 0x36, 0x5c, 0x9c, 0x70, 0x07, 0x0a, 0x49, 0x71, 0x89, 0x85,
-	0x39, 0x86, 0xfa, 0x84, 0xc4, 0x87, 0x44, 0x06, 0xb8, 0xc6, 0x87, 0xfa, 0x05, 0x07, 0xb8, 0x3a,
-	0x7b, 0xba, 0x79, 0xba, 0xba, 0x08, 0x30, 0x08, 0x09, 0x73, 0xf1, 0x23, 0xc9, 0x39, 0xfb, 0xf8,
-	0x3b, 0x09, 0x30, 0x3a, 0x05, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x39, 0xf1, 0x8e, 0xac, 0x80, 0x46, 0x06, 0xd8, 0xad, 0x49, 0x6c, 0x60, 0x71, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf0, 0x30, 0x49, 0x71, 0xaf, 0x01, 0x00, 0x00,
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcb, 0xee, 0xd2, 0x40,
+	0x14, 0xc6, 0x3b, 0x68, 0x30, 0x8c, 0xb7, 0x66, 0x44, 0xac, 0xa8, 0x85, 0xb0, 0x22, 0x24, 0xb6,
+	0xf1, 0x92, 0xb8, 0x71, 0x21, 0x85, 0xaa, 0x4d, 0x9a, 0x16, 0x98, 0x29, 0x09, 0x6e, 0x9a, 0x96,
+	0x36, 0xa5, 0x49, 0x2f, 0xa4, 0x9d, 0x1a, 0x30, 0xf1, 0x1d, 0x7c, 0x2c, 0x96, 0x2c, 0x8d, 0x0b,
+	0x62, 0xe0, 0x45, 0x0c, 0x03, 0x12, 0xaa, 0x9b, 0xff, 0x66, 0x32, 0xe7, 0xfb, 0x7d, 0x73, 0xce,
+	0xcc, 0x97, 0x81, 0xa2, 0xb7, 0xf6, 0x56, 0xcb, 0x2c, 0xa5, 0xe9, 0x3c, 0x8d, 0xe4, 0xaf, 0x4e,
+	0x11, 0xd1, 0xd3, 0x2a, 0x31, 0x11, 0xa1, 0x6b, 0x2e, 0x31, 0xd2, 0xac, 0x07, 0x69, 0x90, 0x32,
+	0x4d, 0x3e, 0xee, 0x4e, 0xce, 0x0e, 0x81, 0x77, 0xa6, 0x47, 0xac, 0x79, 0xe8, 0x15, 0xbc, 0x4d,
+	0xd7, 0x4b, 0x5f, 0x00, 0x6d, 0xd0, 0x7d, 0xf0, 0xfa, 0x85, 0xf4, 0x7f, 0x0f, 0x89, 0x59, 0xc9,
+	0x7a, 0xe9, 0x4f, 0x98, 0x15, 0x35, 0x60, 0x35, 0x29, 0x62, 0xd7, 0xcf, 0x84, 0x4a, 0x1b, 0x74,
+	0xef, 0x4f, 0xce, 0x55, 0x87, 0xc2, 0x9a, 0x51, 0xc4, 0x78, 0xe1, 0x64, 0x7e, 0x8e, 0x02, 0x08,
+	0x93, 0x22, 0xb6, 0x73, 0x56, 0x31, 0xe3, 0x3d, 0xe5, 0xf3, 0x66, 0xd7, 0xe2, 0x7e, 0xed, 0x5a,
+	0x1f, 0x82, 0x90, 0x2e, 0x0a, 0x57, 0x9a, 0xa7, 0xb1, 0x5c, 0x7e, 0xd3, 0xdb, 0x97, 0xf3, 0x85,
+	0x13, 0x26, 0xf2, 0x45, 0xf1, 0x8e, 0x13, 0x73, 0x09, 0xfb, 0x59, 0xe8, 0x44, 0xe1, 0x37, 0xc7,
+	0x8d, 0x7c, 0x2d, 0xa1, 0x93, 0x5a, 0xf2, 0x77, 0x50, 0xef, 0x3d, 0xac, 0x5d, 0x2e, 0x88, 0x9a,
+	0xb0, 0x31, 0xed, 0x5b, 0x3a, 0xb1, 0xc9, 0x6c, 0xa4, 0xda, 0x96, 0x81, 0x47, 0xea, 0x40, 0xfb,
+	0xa8, 0xa9, 0x43, 0x9e, 0x43, 0x8f, 0xe0, 0xc3, 0x2b, 0x36, 0xd0, 0x4d, 0x85, 0x07, 0xbd, 0xef,
+	0xf0, 0x2e, 0x3b, 0x8d, 0xa9, 0x43, 0x8b, 0x1c, 0x3d, 0x87, 0xc2, 0xc9, 0x83, 0x49, 0x9f, 0x58,
+	0xf8, 0x9f, 0x0e, 0x02, 0xac, 0x97, 0xe8, 0xd8, 0x32, 0x89, 0x66, 0x7c, 0xe2, 0x01, 0x7a, 0x0a,
+	0x1f, 0x97, 0x08, 0x26, 0x7d, 0x63, 0x68, 0x2b, 0x33, 0xbe, 0x82, 0x9e, 0xc1, 0x27, 0x25, 0x34,
+	0xd0, 0x4d, 0xac, 0xda, 0xa6, 0xa1, 0xcf, 0xf8, 0x5b, 0xca, 0x78, 0xb3, 0x17, 0xc1, 0x76, 0x2f,
+	0x82, 0xdf, 0x7b, 0x11, 0xfc, 0x38, 0x88, 0xdc, 0xf6, 0x20, 0x72, 0x3f, 0x0f, 0x22, 0xf7, 0xe5,
+	0xdd, 0xcd, 0x33, 0x5a, 0x9d, 0xff, 0x02, 0x8b, 0xca, 0xad, 0x32, 0xfd, 0xcd, 0x9f, 0x01, 0x00,
+	0x20, 0x16, 0x21, 0xa5, 0x2e, 0x02, 0x00, 0x00,
 }
 
 func (m *VaultId) Marshal() (dAtA []byte, err error) {